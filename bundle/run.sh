@@ -900,6 +900,8 @@ cat /configs/grpc-gateway.yaml |
   yq e "\
   .log.level = \"${LOG_LEVEL}\" |
   .apis.grpc.address = \"${GRPC_GATEWAY_ADDRESS}\" |
+  .apis.grpc.bulkUpdateJobs.enabled = true |
+  .apis.grpc.schedules.enabled = true |
   .apis.grpc.authorization.audience = \"${SERVICE_OAUTH_AUDIENCE}\" |
   .apis.grpc.authorization.endpoints[0].http.tls.useSystemCAPool = true |
   .apis.grpc.authorization.endpoints[0].authority = \"https://${OAUTH_ENDPOINT}\" |
@@ -915,7 +917,13 @@ cat /configs/grpc-gateway.yaml |
   .clients.eventBus.nats.leadResourceType.enabled = ${LEAD_RESOURCE_TYPE_ENABLED} |
   .clients.resourceAggregate.grpc.address = \"${RESOURCE_AGGREGATE_ADDRESS}\" |
   .clients.resourceDirectory.grpc.address = \"${RESOURCE_DIRECTORY_ADDRESS}\" |
-  .clients.certificateAuthority.grpc.address = \"${CERTIFICATE_AUTHORITY_ADDRESS}\"
+  .clients.certificateAuthority.grpc.address = \"${CERTIFICATE_AUTHORITY_ADDRESS}\" |
+  .clients.storage.mongoDB.uri = \"${MONGODB_URI}\" |
+  .clients.serviceAuthorization.provider.authority = \"https://${OAUTH_ENDPOINT}\" |
+  .clients.serviceAuthorization.provider.clientID = \"${DEVICE_OAUTH_CLIENT_ID}\" |
+  .clients.serviceAuthorization.provider.clientSecretFile = \"${OAUTH_DEVICE_SECRET_PATH}\" |
+  .clients.serviceAuthorization.provider.audience = \"${SERVICE_OAUTH_AUDIENCE}\" |
+  .clients.serviceAuthorization.provider.http.tls.useSystemCAPool = true
 " - > /data/grpc-gateway.yaml
 fi
 grpc-gateway --config=/data/grpc-gateway.yaml >$LOGS_PATH/grpc-gateway.log 2>&1 &
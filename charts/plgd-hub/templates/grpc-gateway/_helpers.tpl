@@ -53,4 +53,20 @@ app.kubernetes.io/name: {{ .Values.grpcgateway.name }}
 app.kubernetes.io/instance: {{ .Release.Name }}
 {{- end }}
 
+{{- define "plgd-hub.grpcgateway.serviceAuthorizationProvider" -}}
+{{- $ := . -}}
+{{- $provider := $.Values.grpcgateway.clients.serviceAuthorization.provider }}
+{{- if not $provider.clientID }}
+{{- $providers := $.Values.mockoauthserver.oauth }}
+{{- if not $.Values.mockoauthserver.enabled }}
+{{- $providers = required "At least one oauth provider must be specified for grpcgateway.clients.serviceAuthorization.provider or global.oauth.device" ( $.Values.coapgateway.apis.coap.authorization.providers | default $.Values.global.oauth.device ) }}
+{{- end }}
+{{- $provider = mergeOverwrite (deepCopy $provider) (first $providers) }}
+{{- end }}
+{{- $provider | toYaml }}
+{{- end }}
 
+{{- define "plgd-hub.grpcgateway.serviceAuthorizationSecretName" -}}
+  {{- $fullName := include "plgd-hub.grpcgateway.fullname" . -}}
+  {{- printf "%s-oauth" $fullName -}}
+{{- end }}
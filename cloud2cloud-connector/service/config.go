@@ -12,7 +12,7 @@ import (
 	"github.com/plgd-dev/hub/v2/pkg/net/http/server"
 	"github.com/plgd-dev/hub/v2/pkg/net/listener"
 	"github.com/plgd-dev/hub/v2/pkg/security/oauth2"
-	natsClient "github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/nats/client"
+	eventbusConfig "github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/config"
 )
 
 // Config represents application configuration
@@ -145,12 +145,12 @@ func (c *IdentityStoreConfig) Validate() error {
 }
 
 type EventBusConfig struct {
-	NATS natsClient.ConfigSubscriber `yaml:"nats" json:"nats"`
+	eventbusConfig.ConfigSubscriber `yaml:",inline" json:",inline"`
 }
 
 func (c *EventBusConfig) Validate() error {
-	if err := c.NATS.Validate(); err != nil {
-		return fmt.Errorf("nats.%w", err)
+	if err := c.ConfigSubscriber.Validate(); err != nil {
+		return err
 	}
 	return nil
 }
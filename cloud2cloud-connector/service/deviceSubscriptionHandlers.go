@@ -13,7 +13,6 @@ import (
 	"github.com/plgd-dev/hub/v2/pkg/log"
 	kitNetGrpc "github.com/plgd-dev/hub/v2/pkg/net/grpc"
 	pkgTime "github.com/plgd-dev/hub/v2/pkg/time"
-	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/nats/subscriber"
 	raEvents "github.com/plgd-dev/hub/v2/resource-aggregate/events"
 	raService "github.com/plgd-dev/hub/v2/resource-aggregate/service"
 	kitSync "github.com/plgd-dev/kit/v2/sync"
@@ -62,12 +61,12 @@ type DevicesSubscription struct {
 	data              *kitSync.Map // [deviceID]*deviceSubscription
 	rdClient          pb.GrpcGatewayClient
 	raClient          raService.ResourceAggregateClient
-	subscriber        *subscriber.Subscriber
+	subscriber        grpcClient.ResourceSubscriber
 	reconnectInterval time.Duration
 	tracerProvider    trace.TracerProvider
 }
 
-func NewDevicesSubscription(ctx context.Context, tracerProvider trace.TracerProvider, rdClient pb.GrpcGatewayClient, raClient raService.ResourceAggregateClient, subscriber *subscriber.Subscriber, reconnectInterval time.Duration) *DevicesSubscription {
+func NewDevicesSubscription(ctx context.Context, tracerProvider trace.TracerProvider, rdClient pb.GrpcGatewayClient, raClient raService.ResourceAggregateClient, subscriber grpcClient.ResourceSubscriber, reconnectInterval time.Duration) *DevicesSubscription {
 	return &DevicesSubscription{
 		data:              kitSync.NewMap(),
 		rdClient:          rdClient,
@@ -25,9 +25,7 @@ import (
 	cmClient "github.com/plgd-dev/hub/v2/pkg/security/certManager/client"
 	"github.com/plgd-dev/hub/v2/pkg/security/jwt/validator"
 	"github.com/plgd-dev/hub/v2/pkg/security/oauth2"
-	natsClient "github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/nats/client"
-	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/nats/subscriber"
-	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/utils"
+	eventbusConfig "github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/config"
 	raService "github.com/plgd-dev/hub/v2/resource-aggregate/service"
 	"go.opentelemetry.io/otel/trace"
 )
@@ -89,23 +87,12 @@ func newIdentityStoreClient(config IdentityStoreConfig, fileWatcher *fsnotify.Wa
 	return pbIS.NewIdentityStoreClient(isConn.GRPC()), closeIsConn, nil
 }
 
-func newSubscriber(config natsClient.ConfigSubscriber, fileWatcher *fsnotify.Watcher, logger log.Logger, tracerProvider trace.TracerProvider) (*subscriber.Subscriber, func(), error) {
-	var fl fn.FuncList
-	nats, err := natsClient.New(config.Config, fileWatcher, logger, tracerProvider)
-	if err != nil {
-		return nil, nil, fmt.Errorf("cannot create nats client: %w", err)
-	}
-	fl.AddFunc(nats.Close)
-
-	sub, err := subscriber.New(nats.GetConn(),
-		config.PendingLimits, config.LeadResourceType.IsEnabled(),
-		logger, subscriber.WithUnmarshaler(utils.Unmarshal))
+func newSubscriber(config eventbusConfig.ConfigSubscriber, fileWatcher *fsnotify.Watcher, logger log.Logger, tracerProvider trace.TracerProvider) (eventbusConfig.Subscriber, func(), error) {
+	sub, err := eventbusConfig.NewSubscriber(config, fileWatcher, logger, tracerProvider)
 	if err != nil {
 		return nil, nil, fmt.Errorf("cannot create subscriber: %w", err)
 	}
-	fl.AddFunc(sub.Close)
-
-	return sub, fl.ToFunction(), nil
+	return sub, sub.Close, nil
 }
 
 func newStore(ctx context.Context, config pkgMongo.Config, fileWatcher *fsnotify.Watcher, logger log.Logger, tracerProvider trace.TracerProvider) (*Store, func(), error) {
@@ -175,7 +162,7 @@ func newDevicesSubscription(ctx context.Context, config Config, raClient raServi
 	}
 	fl.AddFunc(closeGrpcClient)
 
-	sub, closeSub, err := newSubscriber(config.Clients.Eventbus.NATS, fileWatcher, logger, tracerProvider)
+	sub, closeSub, err := newSubscriber(config.Clients.Eventbus.ConfigSubscriber, fileWatcher, logger, tracerProvider)
 	if err != nil {
 		fl.Execute()
 		return nil, nil, fmt.Errorf("cannot create subscriber: %w", err)
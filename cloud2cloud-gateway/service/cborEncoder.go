@@ -3,7 +3,9 @@ package service
 import (
 	"net/http"
 
+	"github.com/plgd-dev/go-coap/v3/message"
 	"github.com/plgd-dev/hub/v2/cloud2cloud-connector/events"
+	"github.com/plgd-dev/hub/v2/pkg/senml"
 	"github.com/plgd-dev/kit/v2/codec/cbor"
 )
 
@@ -17,3 +19,33 @@ func newCBORResponseWriterEncoder(contentType string) func(w http.ResponseWriter
 		return cbor.WriteTo(w, v)
 	}
 }
+
+// toSenMLRepresentation converts v to the generic representation which can be flattened to the SenML records,
+// the structures of the responses are converted by their tags in the same way as for the CBOR encoder.
+func toSenMLRepresentation(v interface{}) (interface{}, error) {
+	data, err := cbor.Encode(v)
+	if err != nil {
+		return nil, err
+	}
+	var representation interface{}
+	err = cbor.Decode(data, &representation)
+	return representation, err
+}
+
+func senmlCBORResponseWriterEncoder(w http.ResponseWriter, v interface{}, status int) error {
+	if v == nil {
+		return nil
+	}
+	representation, err := toSenMLRepresentation(v)
+	if err != nil {
+		return err
+	}
+	data, err := senml.EncodeCBOR(representation)
+	if err != nil {
+		return err
+	}
+	w.Header().Set(events.ContentTypeKey, message.AppSenmlCbor.String())
+	w.WriteHeader(status)
+	_, err = w.Write(data)
+	return err
+}
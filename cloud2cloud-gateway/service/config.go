@@ -14,7 +14,7 @@ import (
 	cmClient "github.com/plgd-dev/hub/v2/pkg/security/certManager/client"
 	"github.com/plgd-dev/hub/v2/pkg/security/jwt/validator"
 	"github.com/plgd-dev/hub/v2/pkg/sync/task/queue"
-	natsClient "github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/nats/client"
+	eventbusConfig "github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/config"
 )
 
 // Config represents application configuration
@@ -100,12 +100,12 @@ func (c *ClientsConfig) Validate() error {
 }
 
 type EventBusConfig struct {
-	NATS natsClient.ConfigSubscriber `yaml:"nats" json:"nats"`
+	eventbusConfig.ConfigSubscriber `yaml:",inline" json:",inline"`
 }
 
 func (c *EventBusConfig) Validate() error {
-	if err := c.NATS.Validate(); err != nil {
-		return fmt.Errorf("nats.%w", err)
+	if err := c.ConfigSubscriber.Validate(); err != nil {
+		return err
 	}
 	return nil
 }
@@ -103,6 +103,10 @@ func getResponseWriterEncoder(accept []string) (responseWriterEncoderFunc, error
 			return newCBORResponseWriterEncoder(v), nil
 		case message.AppOcfCbor.String():
 			return newCBORResponseWriterEncoder(v), nil
+		case message.AppSenmlJSON.String():
+			return senmlJSONResponseWriterEncoder, nil
+		case message.AppSenmlCbor.String():
+			return senmlCBORResponseWriterEncoder, nil
 		case applicationMimeType + "/*":
 			return newCBORResponseWriterEncoder(message.AppOcfCbor.String()), nil
 		}
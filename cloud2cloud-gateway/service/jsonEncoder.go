@@ -5,6 +5,7 @@ import (
 
 	"github.com/plgd-dev/go-coap/v3/message"
 	"github.com/plgd-dev/hub/v2/cloud2cloud-connector/events"
+	"github.com/plgd-dev/hub/v2/pkg/senml"
 	"github.com/plgd-dev/kit/v2/codec/json"
 )
 
@@ -16,3 +17,21 @@ func jsonResponseWriterEncoder(w http.ResponseWriter, v interface{}, status int)
 	w.WriteHeader(status)
 	return json.WriteTo(w, v)
 }
+
+func senmlJSONResponseWriterEncoder(w http.ResponseWriter, v interface{}, status int) error {
+	if v == nil {
+		return nil
+	}
+	representation, err := toSenMLRepresentation(v)
+	if err != nil {
+		return err
+	}
+	data, err := senml.EncodeJSON(representation)
+	if err != nil {
+		return err
+	}
+	w.Header().Set(events.ContentTypeKey, message.AppSenmlJSON.String())
+	w.WriteHeader(status)
+	_, err = w.Write(data)
+	return err
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"io"
 	"net/http"
 	"strings"
 
@@ -14,6 +13,8 @@ import (
 	"github.com/plgd-dev/hub/v2/pkg/log"
 	kitNetHttp "github.com/plgd-dev/hub/v2/pkg/net/http"
 	"github.com/plgd-dev/hub/v2/resource-aggregate/commands"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/events"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -28,27 +29,13 @@ func getHref(deviceID, href string) string {
 }
 
 func (rh *RequestHandler) GetResourceLinks(ctx context.Context, deviceIdFilter []string) (map[string]schema.ResourceLinks, error) {
-	client, err := rh.gwClient.GetResourceLinks(ctx, &pbGRPC.GetResourceLinksRequest{
-		DeviceIdFilter: deviceIdFilter,
-	})
-	if err != nil {
-		return nil, fmt.Errorf("cannot get resource links: %w", err)
-	}
-	defer func() {
-		if errC := client.CloseSend(); errC != nil {
-			log.Errorf("failed to close client send stream: %w", errC)
-		}
-	}()
-
 	resourceLinks := make(map[string]schema.ResourceLinks)
-	for {
-		snapshot, err := client.Recv()
-		if errors.Is(err, io.EOF) {
-			break
-		}
-		if err != nil {
-			return nil, fmt.Errorf("cannot get resource links: %w", err)
-		}
+	err := pbGRPC.ForEachPage(func(pageToken string) (grpc.ServerStreamingClient[events.ResourceLinksPublished], error) {
+		return rh.gwClient.GetResourceLinks(ctx, &pbGRPC.GetResourceLinksRequest{
+			DeviceIdFilter: deviceIdFilter,
+			PageToken:      pageToken,
+		})
+	}, func(snapshot *events.ResourceLinksPublished) error {
 		_, ok := resourceLinks[snapshot.GetDeviceId()]
 		if !ok {
 			resourceLinks[snapshot.GetDeviceId()] = make(schema.ResourceLinks, 0, 32)
@@ -60,6 +47,10 @@ func (rh *RequestHandler) GetResourceLinks(ctx context.Context, deviceIdFilter [
 			links[i].ID = ""
 			resourceLinks[links[i].DeviceID] = append(resourceLinks[links[i].DeviceID], links[i])
 		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot get resource links: %w", err)
 	}
 	if len(resourceLinks) == 0 {
 		return nil, errors.New("cannot get resource links: not found")
@@ -81,36 +72,21 @@ func unmarshalContent(c *commands.Content, m interface{}) error {
 }
 
 func (rh *RequestHandler) RetrieveResources(ctx context.Context, resourceIdFilter []*pbGRPC.ResourceIdFilter, deviceIdFilter []string) (map[string][]Representation, error) {
-	client, err := rh.gwClient.GetResources(ctx, &pbGRPC.GetResourcesRequest{
-		DeviceIdFilter:   deviceIdFilter,
-		ResourceIdFilter: resourceIdFilter,
-	})
-	if err != nil {
-		return nil, fmt.Errorf("cannot retrieve resources values: %w", err)
-	}
-	defer func() {
-		if err := client.CloseSend(); err != nil {
-			log.Errorf("failed to close client send stream: %w", err)
-		}
-	}()
-
 	allResources := make(map[string][]Representation)
-	for {
-		content, err := client.Recv()
-		if errors.Is(err, io.EOF) {
-			break
-		}
-		if err != nil {
-			return nil, fmt.Errorf("cannot retrieve resources values: %w", err)
-		}
+	err := pbGRPC.ForEachPage(func(pageToken string) (grpc.ServerStreamingClient[pbGRPC.Resource], error) {
+		return rh.gwClient.GetResources(ctx, &pbGRPC.GetResourcesRequest{
+			DeviceIdFilter:   deviceIdFilter,
+			ResourceIdFilter: resourceIdFilter,
+			PageToken:        pageToken,
+		})
+	}, func(content *pbGRPC.Resource) error {
 		if content.GetData().GetResourceId().GetHref() == commands.StatusHref {
-			continue
+			return nil
 		}
 		var rep interface{}
-		err = unmarshalContent(content.GetData().GetContent(), &rep)
-		if err != nil {
+		if err := unmarshalContent(content.GetData().GetContent(), &rep); err != nil {
 			log.Errorf("cannot retrieve resources values: %v", err)
-			continue
+			return nil
 		}
 
 		_, ok := allResources[content.GetData().GetResourceId().GetDeviceId()]
@@ -122,6 +98,10 @@ func (rh *RequestHandler) RetrieveResources(ctx context.Context, resourceIdFilte
 			Representation: rep,
 			Status:         content.GetData().GetStatus(),
 		})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot retrieve resources values: %w", err)
 	}
 	if len(allResources) == 0 {
 		return nil, status.Errorf(codes.NotFound, "cannot retrieve resources values: not found")
@@ -2,16 +2,14 @@ package service
 
 import (
 	"context"
-	"errors"
 	"fmt"
-	"io"
 	"net/http"
 	"strings"
 
 	"github.com/plgd-dev/device/v2/schema/device"
 	pbGRPC "github.com/plgd-dev/hub/v2/grpc-gateway/pb"
-	"github.com/plgd-dev/hub/v2/pkg/log"
 	kitNetHttp "github.com/plgd-dev/hub/v2/pkg/net/http"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -38,30 +36,15 @@ type Device struct {
 }
 
 func (rh *RequestHandler) GetDevices(ctx context.Context, deviceIdFilter []string) ([]Device, error) {
-	getDevicesClient, err := rh.gwClient.GetDevices(ctx, &pbGRPC.GetDevicesRequest{
-		DeviceIdFilter: deviceIdFilter,
-	})
-	if err != nil {
-		return nil, fmt.Errorf("cannot get devices: %w", err)
-	}
-	defer func() {
-		if err := getDevicesClient.CloseSend(); err != nil {
-			log.Errorf("cannot close get devices client: %w", err)
-		}
-	}()
-
 	devices := make([]Device, 0, 32)
-	for {
-		grpcDevice, err := getDevicesClient.Recv()
-		if errors.Is(err, io.EOF) {
-			break
-		}
-		if err != nil {
-			return nil, fmt.Errorf("cannot get devices: %w", err)
-		}
-
+	err := pbGRPC.ForEachPage(func(pageToken string) (grpc.ServerStreamingClient[pbGRPC.Device], error) {
+		return rh.gwClient.GetDevices(ctx, &pbGRPC.GetDevicesRequest{
+			DeviceIdFilter: deviceIdFilter,
+			PageToken:      pageToken,
+		})
+	}, func(grpcDevice *pbGRPC.Device) error {
 		var d device.Device
-		if err = unmarshalContent(grpcDevice.GetData().GetContent(), &d); err != nil {
+		if err := unmarshalContent(grpcDevice.GetData().GetContent(), &d); err != nil {
 			d = grpcDevice.ToSchema()
 		}
 		if len(d.Interfaces) == 0 {
@@ -74,6 +57,10 @@ func (rh *RequestHandler) GetDevices(ctx context.Context, deviceIdFilter []strin
 			Device: d,
 			Status: toStatus(grpcDevice.GetMetadata().GetConnection().IsOnline()),
 		})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot get devices: %w", err)
 	}
 	if len(devices) == 0 {
 		return nil, status.Errorf(codes.NotFound, "cannot get devices: not found")
@@ -22,9 +22,8 @@ import (
 	"github.com/plgd-dev/hub/v2/pkg/security/jwt/validator"
 	"github.com/plgd-dev/hub/v2/pkg/sync/task/queue"
 	raClient "github.com/plgd-dev/hub/v2/resource-aggregate/client"
-	natsClient "github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/nats/client"
-	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/nats/subscriber"
-	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/utils"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus"
+	eventbusConfig "github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/config"
 	"go.opentelemetry.io/otel/trace"
 )
 
@@ -153,26 +152,16 @@ func newGrpcGatewayClient(config GrpcGatewayConfig, fileWatcher *fsnotify.Watche
 	return client, fl.ToFunction(), nil
 }
 
-func newResourceSubscriber(config Config, fileWatcher *fsnotify.Watcher, logger log.Logger, tracerProvider trace.TracerProvider) (*subscriber.Subscriber, func(), error) {
+func newResourceSubscriber(config Config, fileWatcher *fsnotify.Watcher, logger log.Logger, tracerProvider trace.TracerProvider) (eventbusConfig.Subscriber, func(), error) {
 	var fl fn.FuncList
-	nats, err := natsClient.New(config.Clients.Eventbus.NATS.Config, fileWatcher, logger, tracerProvider)
-	if err != nil {
-		return nil, nil, fmt.Errorf("cannot create nats client: %w", err)
-	}
-	fl.AddFunc(nats.Close)
-
 	pool, err := queue.New(config.TaskQueue)
 	if err != nil {
-		fl.Execute()
 		return nil, nil, fmt.Errorf("cannot create job queue %w", err)
 	}
 	fl.AddFunc(pool.Release)
 
-	resourceSubscriber, err := subscriber.New(nats.GetConn(),
-		config.Clients.Eventbus.NATS.PendingLimits, config.Clients.Eventbus.NATS.LeadResourceType.IsEnabled(),
-		logger,
-		subscriber.WithGoPool(func(f func()) error { return pool.Submit(f) }),
-		subscriber.WithUnmarshaler(utils.Unmarshal))
+	resourceSubscriber, err := eventbusConfig.NewSubscriber(config.Clients.Eventbus.ConfigSubscriber, fileWatcher, logger, tracerProvider,
+		eventbusConfig.WithGoPool(func(f func()) error { return pool.Submit(f) }))
 	if err != nil {
 		fl.Execute()
 		return nil, nil, fmt.Errorf("cannot create eventbus subscriber: %w", err)
@@ -182,7 +171,7 @@ func newResourceSubscriber(config Config, fileWatcher *fsnotify.Watcher, logger
 	return resourceSubscriber, fl.ToFunction(), nil
 }
 
-func newResourceAggregateClient(config ResourceAggregateConfig, subscriber *subscriber.Subscriber, fileWatcher *fsnotify.Watcher, logger log.Logger, tracerProvider trace.TracerProvider) (*raClient.Client, func(), error) {
+func newResourceAggregateClient(config ResourceAggregateConfig, subscriber eventbus.Subscriber, fileWatcher *fsnotify.Watcher, logger log.Logger, tracerProvider trace.TracerProvider) (*raClient.Client, func(), error) {
 	var fl fn.FuncList
 	conn, err := grpcClient.New(config.Connection, fileWatcher, logger, tracerProvider)
 	if err != nil {
@@ -18,6 +18,7 @@ import (
 	"github.com/plgd-dev/go-coap/v3/message/pool"
 	"github.com/plgd-dev/go-coap/v3/mux"
 	"github.com/plgd-dev/hub/v2/coap-gateway/uri"
+	"github.com/plgd-dev/hub/v2/pkg/senml"
 	"github.com/plgd-dev/hub/v2/resource-aggregate/commands"
 	"github.com/plgd-dev/hub/v2/resource-aggregate/events"
 )
@@ -100,6 +101,10 @@ func MakeMediaType(coapContentFormat int32, contentType string) (message.MediaTy
 		return message.AppCBOR, nil
 	case message.AppOcfCbor.String():
 		return message.AppOcfCbor, nil
+	case message.AppSenmlJSON.String():
+		return message.AppSenmlJSON, nil
+	case message.AppSenmlCbor.String():
+		return message.AppSenmlCbor, nil
 	default:
 		return message.TextPlain, fmt.Errorf("unknown content type coapContentFormat(%v), contentType(%v)", coapContentFormat, contentType)
 	}
@@ -366,6 +371,10 @@ func tryToGetResourceTypesFromContent(contentFormat int32, content []byte) []str
 		decode = cbor.Decode
 	case int32(message.AppJSON):
 		decode = json.Decode
+	case int32(message.AppSenmlJSON):
+		decode = senml.DecodeJSON
+	case int32(message.AppSenmlCbor):
+		decode = senml.DecodeCBOR
 	}
 	var c ct
 	if err := decode(content, &c); err == nil {
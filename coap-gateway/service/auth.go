@@ -48,7 +48,9 @@ func (s *Service) ValidateToken(ctx context.Context, token string) (pkgJwt.Claim
 	return pkgJwt.Claims(m), nil
 }
 
-func (s *Service) verifyDeviceID(tlsDeviceID string, claim pkgJwt.Claims) (string, error) {
+// verifyDeviceID resolves the device ID from the access token and the device ID verified by the connection, which is
+// the device ID from the certificate or the device ID of the OSCORE security context.
+func (s *Service) verifyDeviceID(securedDeviceID string, claim pkgJwt.Claims) (string, error) {
 	jwtDeviceID, err := claim.GetDeviceID(s.config.APIs.COAP.Authorization.DeviceIDClaim)
 	if err != nil {
 		return "", fmt.Errorf("cannot get device id claim from access token: %w", err)
@@ -56,20 +58,20 @@ func (s *Service) verifyDeviceID(tlsDeviceID string, claim pkgJwt.Claims) (strin
 	if s.config.APIs.COAP.Authorization.DeviceIDClaim != "" && jwtDeviceID == "" {
 		return "", fmt.Errorf("access token doesn't contain the required device id claim('%v')", s.config.APIs.COAP.Authorization.DeviceIDClaim)
 	}
-	if !s.config.APIs.COAP.TLS.IsEnabled() || !s.config.APIs.COAP.TLS.Embedded.ClientCertificateRequired {
-		return jwtDeviceID, nil
-	}
-	if tlsDeviceID == "" {
+	if securedDeviceID == "" {
+		if !s.config.APIs.COAP.TLS.IsEnabled() || !s.config.APIs.COAP.TLS.Embedded.ClientCertificateRequired {
+			return jwtDeviceID, nil
+		}
 		return "", errors.New("certificate of device doesn't contain device id")
 	}
-	if s.config.APIs.COAP.Authorization.DeviceIDClaim != "" && jwtDeviceID != tlsDeviceID {
-		return "", fmt.Errorf("access token issued to the device ('%v') used by the different device ('%v')", jwtDeviceID, tlsDeviceID)
+	if s.config.APIs.COAP.Authorization.DeviceIDClaim != "" && jwtDeviceID != securedDeviceID {
+		return "", fmt.Errorf("access token issued to the device ('%v') used by the different device ('%v')", jwtDeviceID, securedDeviceID)
 	}
-	return tlsDeviceID, nil
+	return securedDeviceID, nil
 }
 
-func (s *Service) VerifyAndResolveDeviceID(tlsDeviceID, paramDeviceID string, claim pkgJwt.Claims) (string, error) {
-	deviceID, err := s.verifyDeviceID(tlsDeviceID, claim)
+func (s *Service) VerifyAndResolveDeviceID(securedDeviceID, paramDeviceID string, claim pkgJwt.Claims) (string, error) {
+	deviceID, err := s.verifyDeviceID(securedDeviceID, claim)
 	if err != nil {
 		return "", err
 	}
@@ -93,6 +93,25 @@ func TestVerifyAndResolveDeviceID(t *testing.T) {
 			},
 			want: "tlsUser",
 		},
+		{
+			name: "non-matching oscore device id",
+			args: args{
+				cfg:         makeConfig("sub", false, false),
+				tlsDeviceID: "oscoreUser",
+				claim:       pkgJwt.Claims{"sub": "user"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid - oscore device id",
+			args: args{
+				cfg:           makeConfig("", false, false),
+				tlsDeviceID:   "oscoreUser",
+				paramDeviceID: "paramUser",
+				claim:         pkgJwt.Claims{},
+			},
+			want: "oscoreUser",
+		},
 		{
 			name: "valid - param deviceID",
 			args: args{
@@ -3,19 +3,25 @@ package service
 import (
 	"errors"
 	"fmt"
+	"net/url"
+	"slices"
 	"time"
 
 	"github.com/plgd-dev/hub/v2/pkg/config"
 	"github.com/plgd-dev/hub/v2/pkg/log"
 	coapService "github.com/plgd-dev/hub/v2/pkg/net/coap/service"
 	"github.com/plgd-dev/hub/v2/pkg/net/grpc/client"
+	"github.com/plgd-dev/hub/v2/pkg/net/http/server"
+	"github.com/plgd-dev/hub/v2/pkg/net/listener"
 	otelClient "github.com/plgd-dev/hub/v2/pkg/opentelemetry/collector/client"
 	"github.com/plgd-dev/hub/v2/pkg/security/jwt/validator"
 	"github.com/plgd-dev/hub/v2/pkg/security/oauth2"
 	"github.com/plgd-dev/hub/v2/pkg/security/oauth2/oauth"
+	"github.com/plgd-dev/hub/v2/pkg/security/oscore"
+	oscoreMongodb "github.com/plgd-dev/hub/v2/pkg/security/oscore/mongodb"
 	"github.com/plgd-dev/hub/v2/pkg/sync/task/queue"
 	pkgYaml "github.com/plgd-dev/hub/v2/pkg/yaml"
-	natsClient "github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/nats/client"
+	eventbusConfig "github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/config"
 	"gopkg.in/yaml.v3"
 )
 
@@ -64,15 +70,40 @@ type LogConfig = log.Config
 
 type APIsConfig struct {
 	COAP COAPConfigMarshalerUnmarshaler `yaml:"coap" json:"coap"`
+	HTTP HTTPConfig                     `yaml:"http" json:"http"`
 }
 
 func (c *APIsConfig) Validate() error {
 	if err := c.COAP.Validate(); err != nil {
 		return fmt.Errorf("coap.%w", err)
 	}
+	if err := c.HTTP.Validate(); err != nil {
+		return fmt.Errorf("http.%w", err)
+	}
+	if c.HTTP.Enabled && !c.COAP.Drain.Enabled {
+		return fmt.Errorf("http.enabled('%v') - %w", c.HTTP.Enabled, errors.New("coap.drain must be enabled"))
+	}
 	return nil
 }
 
+// HTTPConfig is the configuration of the administration API. The API is authorized by the client certificate, so
+// tls.caPool should contain only the CA of the administrators.
+type HTTPConfig struct {
+	Enabled    bool            `yaml:"enabled" json:"enabled"`
+	Connection listener.Config `yaml:",inline" json:",inline"`
+	Server     server.Config   `yaml:",inline" json:",inline"`
+}
+
+func (c *HTTPConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if !c.Connection.TLS.ClientCertificateRequired {
+		return fmt.Errorf("tls.clientCertificateRequired('%v')", c.Connection.TLS.ClientCertificateRequired)
+	}
+	return c.Connection.Validate()
+}
+
 type ProvidersConfig struct {
 	Name          string `yaml:"name" json:"name"`
 	oauth2.Config `yaml:",inline"`
@@ -145,6 +176,8 @@ type COAPConfig struct {
 	OwnerCacheExpiration       time.Duration       `yaml:"ownerCacheExpiration" json:"ownerCacheExpiration"`
 	SubscriptionBufferSize     int                 `yaml:"subscriptionBufferSize" json:"subscriptionBufferSize"`
 	RequireBatchObserveEnabled bool                `yaml:"requireBatchObserveEnabled" json:"requireBatchObserveEnabled"`
+	OSCORE                     OSCOREConfig        `yaml:"oscore" json:"oscore"`
+	Drain                      DrainConfig         `yaml:"drain" json:"drain"`
 
 	InjectedCOAPConfig InjectedCOAPConfig `yaml:"-" json:"-"`
 }
@@ -198,16 +231,94 @@ func (c *COAPConfig) Validate() error {
 	if err := c.Authorization.Validate(); err != nil {
 		return fmt.Errorf("authorization.%w", err)
 	}
+	if err := c.OSCORE.Validate(); err != nil {
+		return fmt.Errorf("oscore.%w", err)
+	}
+	if err := c.Drain.Validate(); err != nil {
+		return fmt.Errorf("drain.%w", err)
+	}
+	if c.OSCORE.Enabled && !slices.Contains(c.Protocols, coapService.UDP) {
+		return fmt.Errorf("oscore.enabled('%v') - %w", c.OSCORE.Enabled, errors.New("udp protocol is required"))
+	}
 	return c.Config.Validate()
 }
 
+// DrainConfig configures the drain of the device sessions, which is started by the administration API or by SIGTERM.
+// During the drain the new connections are refused.
+type DrainConfig struct {
+	Enabled bool `yaml:"enabled" json:"enabled"`
+	// CloseRate is the count of the sessions closed per second.
+	CloseRate float64 `yaml:"closeRate" json:"closeRate"`
+	// Timeout limits the duration of the drain, the remaining sessions are closed at once.
+	Timeout time.Duration `yaml:"timeout" json:"timeout"`
+	// OfflineGracePeriod delays the offline status of the drained devices, it isn't set when the device reconnects
+	// in the meantime.
+	OfflineGracePeriod time.Duration `yaml:"offlineGracePeriod" json:"offlineGracePeriod"`
+	// RedirectURI is set to the cloud configuration resource of the device before the session is closed,
+	// eg. coaps+tcp://coap-gateway-2.example.com:5684.
+	RedirectURI string `yaml:"redirectURI" json:"redirectURI"`
+}
+
+func (c *DrainConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.CloseRate <= 0 {
+		return fmt.Errorf("closeRate('%v')", c.CloseRate)
+	}
+	if c.Timeout <= 0 {
+		return fmt.Errorf("timeout('%v')", c.Timeout)
+	}
+	if c.OfflineGracePeriod < 0 {
+		return fmt.Errorf("offlineGracePeriod('%v')", c.OfflineGracePeriod)
+	}
+	if c.RedirectURI != "" {
+		if _, err := url.ParseRequestURI(c.RedirectURI); err != nil {
+			return fmt.Errorf("redirectURI('%v') - %w", c.RedirectURI, err)
+		}
+	}
+	return nil
+}
+
+// OSCOREConfig enables the OSCORE (RFC 8613) protected requests of the devices connected over the UDP without DTLS.
+type OSCOREConfig struct {
+	oscore.Config `yaml:",inline" json:",inline"`
+	// Storage reserves the sender sequence numbers of the security contexts, it must be shared by all instances of
+	// the coap-gateway.
+	Storage OSCOREStorageConfig `yaml:"storage" json:"storage"`
+}
+
+func (c *OSCOREConfig) Validate() error {
+	if err := c.Config.Validate(); err != nil {
+		return err
+	}
+	if !c.Enabled {
+		return nil
+	}
+	if err := c.Storage.Validate(); err != nil {
+		return fmt.Errorf("storage.%w", err)
+	}
+	return nil
+}
+
+type OSCOREStorageConfig struct {
+	MongoDB oscoreMongodb.Config `yaml:"mongoDB" json:"mongoDB"`
+}
+
+func (c *OSCOREStorageConfig) Validate() error {
+	if err := c.MongoDB.Validate(); err != nil {
+		return fmt.Errorf("mongoDB.%w", err)
+	}
+	return nil
+}
+
 type EventBusConfig struct {
-	NATS natsClient.ConfigSubscriber `yaml:"nats" json:"nats"`
+	eventbusConfig.ConfigSubscriber `yaml:",inline" json:",inline"`
 }
 
 func (c *EventBusConfig) Validate() error {
-	if err := c.NATS.Validate(); err != nil {
-		return fmt.Errorf("nats.%w", err)
+	if err := c.ConfigSubscriber.Validate(); err != nil {
+		return err
 	}
 	return nil
 }
@@ -2,19 +2,25 @@ package service
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/plgd-dev/hub/v2/pkg/log"
 	kitNetGrpc "github.com/plgd-dev/hub/v2/pkg/net/grpc"
 	pkgTime "github.com/plgd-dev/hub/v2/pkg/time"
+	raClient "github.com/plgd-dev/hub/v2/resource-aggregate/client"
 	"github.com/plgd-dev/hub/v2/resource-aggregate/commands"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type devicesStatusUpdater struct {
 	ctx               context.Context
 	logger            log.Logger
 	serviceInstanceID uuid.UUID
+	// pendingOffline counts the delayed offline statuses of the drained devices
+	pendingOffline sync.WaitGroup
 }
 
 func newDevicesStatusUpdater(ctx context.Context, serviceInstanceID uuid.UUID, logger log.Logger) *devicesStatusUpdater {
@@ -55,3 +61,27 @@ func (u *devicesStatusUpdater) updateOnlineStatus(ctx context.Context, client *s
 
 	return resp, err
 }
+
+// UpdateOfflineStatusAfter updates the device status to offline after the delay. When the device reconnects in the
+// meantime, the update is rejected by the resource-aggregate because the connection ID doesn't match.
+func (u *devicesStatusUpdater) UpdateOfflineStatusAfter(raClient *raClient.Client, accessToken string, req *commands.UpdateDeviceMetadataRequest, delay, timeout time.Duration) {
+	u.pendingOffline.Add(1)
+	time.AfterFunc(delay, func() {
+		defer u.pendingOffline.Done()
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+		_, err := raClient.UpdateDeviceMetadata(kitNetGrpc.CtxWithToken(ctx, accessToken), req)
+		if status.Code(err) == codes.InvalidArgument {
+			u.logger.Debugf("DeviceID %v: offline status of drained connection %v is skipped: %v", req.GetDeviceId(), req.GetCommandMetadata().GetConnectionId(), err)
+			return
+		}
+		if err != nil {
+			u.logger.Errorf("DeviceID %v: cannot update cloud device status: %w", req.GetDeviceId(), err)
+		}
+	})
+}
+
+// WaitForOfflineStatuses waits until the delayed offline statuses are updated.
+func (u *devicesStatusUpdater) WaitForOfflineStatuses() {
+	u.pendingOffline.Wait()
+}
@@ -0,0 +1,146 @@
+//go:build test
+// +build test
+
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/plgd-dev/hub/v2/pkg/log"
+	raClient "github.com/plgd-dev/hub/v2/resource-aggregate/client"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/commands"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/events"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	testDeviceID = "0b6b9a80-2b4b-4c4b-8e8c-6f2b0a3c7d11"
+	// connectionA is the connection ID of the fake coap connection
+	connectionA = "127.0.0.1:5684"
+	connectionB = "127.0.0.1:5685"
+)
+
+// fakeResourceAggregate handles the connection updates of the device by the device metadata aggregate of
+// the resource-aggregate.
+type fakeResourceAggregate struct {
+	client *raClient.Client
+
+	lock     sync.Mutex
+	snapshot *events.DeviceMetadataSnapshotTakenForCommand
+	version  uint64
+	codes    []codes.Code
+}
+
+func newFakeResourceAggregate() *fakeResourceAggregate {
+	ra := &fakeResourceAggregate{
+		snapshot: events.NewDeviceMetadataSnapshotTakenForCommand("owner", "owner", uuid.NewString()),
+	}
+	ra.client = raClient.New(ra, nil)
+	return ra
+}
+
+func (ra *fakeResourceAggregate) Invoke(ctx context.Context, _ string, args, _ any, _ ...grpc.CallOption) error {
+	req, ok := args.(*commands.UpdateDeviceMetadataRequest)
+	if !ok {
+		return status.Errorf(codes.Unimplemented, "unexpected request %T", args)
+	}
+	ra.lock.Lock()
+	defer ra.lock.Unlock()
+	_, err := ra.snapshot.HandleCommand(ctx, req, ra.version)
+	if err == nil {
+		ra.version++
+	}
+	ra.codes = append(ra.codes, status.Code(err))
+	return err
+}
+
+func (ra *fakeResourceAggregate) NewStream(context.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, errors.New("not supported")
+}
+
+func (ra *fakeResourceAggregate) connect(t *testing.T, connectionID string) {
+	_, err := ra.client.UpdateDeviceMetadata(context.Background(), &commands.UpdateDeviceMetadataRequest{
+		DeviceId: testDeviceID,
+		Update: &commands.UpdateDeviceMetadataRequest_Connection{
+			Connection: &commands.Connection{
+				Status:      commands.Connection_ONLINE,
+				ConnectedAt: time.Now().UnixNano(),
+			},
+		},
+		CommandMetadata: &commands.CommandMetadata{
+			ConnectionId: connectionID,
+		},
+	})
+	require.NoError(t, err)
+}
+
+func (ra *fakeResourceAggregate) isOnline() bool {
+	ra.lock.Lock()
+	defer ra.lock.Unlock()
+	return ra.snapshot.GetDeviceMetadataUpdated().GetConnection().IsOnline()
+}
+
+func (ra *fakeResourceAggregate) getConnectionID() string {
+	ra.lock.Lock()
+	defer ra.lock.Unlock()
+	return ra.snapshot.GetDeviceMetadataUpdated().GetConnection().GetId()
+}
+
+func (ra *fakeResourceAggregate) getCodes() []codes.Code {
+	ra.lock.Lock()
+	defer ra.lock.Unlock()
+	return append([]codes.Code(nil), ra.codes...)
+}
+
+func newOfflineRequest(connectionID string) *commands.UpdateDeviceMetadataRequest {
+	return &commands.UpdateDeviceMetadataRequest{
+		DeviceId: testDeviceID,
+		Update: &commands.UpdateDeviceMetadataRequest_Connection{
+			Connection: &commands.Connection{
+				Status: commands.Connection_OFFLINE,
+			},
+		},
+		CommandMetadata: &commands.CommandMetadata{
+			ConnectionId: connectionID,
+		},
+	}
+}
+
+func TestUpdateOfflineStatusAfter(t *testing.T) {
+	const delay = time.Millisecond * 100
+	u := newDevicesStatusUpdater(context.Background(), uuid.New(), log.Get())
+	ra := newFakeResourceAggregate()
+	ra.connect(t, connectionA)
+
+	startedAt := time.Now()
+	u.UpdateOfflineStatusAfter(ra.client, "token", newOfflineRequest(connectionA), delay, time.Second)
+	// the device is online during the delay
+	require.True(t, ra.isOnline())
+	u.WaitForOfflineStatuses()
+	require.GreaterOrEqual(t, time.Since(startedAt), delay)
+	require.False(t, ra.isOnline())
+	require.Equal(t, []codes.Code{codes.OK, codes.OK}, ra.getCodes())
+}
+
+func TestUpdateOfflineStatusAfterReconnect(t *testing.T) {
+	u := newDevicesStatusUpdater(context.Background(), uuid.New(), log.Get())
+	ra := newFakeResourceAggregate()
+	ra.connect(t, connectionA)
+
+	u.UpdateOfflineStatusAfter(ra.client, "token", newOfflineRequest(connectionA), time.Millisecond*100, time.Second)
+	// the device reconnects by the new connection before the delay expires
+	ra.connect(t, connectionB)
+	u.WaitForOfflineStatuses()
+	// the offline status of the previous connection is skipped
+	require.Equal(t, []codes.Code{codes.OK, codes.OK, codes.InvalidArgument}, ra.getCodes())
+	require.True(t, ra.isOnline())
+	require.Equal(t, connectionB, ra.getConnectionID())
+}
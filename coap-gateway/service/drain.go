@@ -0,0 +1,155 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/plgd-dev/device/v2/schema/cloud"
+	"github.com/plgd-dev/go-coap/v3/message"
+	coapCodes "github.com/plgd-dev/go-coap/v3/message/codes"
+	"github.com/plgd-dev/kit/v2/codec/cbor"
+	"go.uber.org/atomic"
+)
+
+// DrainStatus is the state of the drain returned by the administration API.
+type DrainStatus struct {
+	Draining          bool      `json:"draining"`
+	Completed         bool      `json:"completed"`
+	StartedAt         time.Time `json:"startedAt"`
+	RemainingSessions int64     `json:"remainingSessions"`
+}
+
+type drain struct {
+	started   atomic.Bool
+	startedAt atomic.Time
+	remaining atomic.Int64
+	done      chan struct{}
+}
+
+func newDrain() *drain {
+	return &drain{
+		done: make(chan struct{}),
+	}
+}
+
+func (d *drain) status() DrainStatus {
+	if !d.started.Load() {
+		return DrainStatus{}
+	}
+	completed := false
+	select {
+	case <-d.done:
+		completed = true
+	default:
+	}
+	return DrainStatus{
+		Draining:          true,
+		Completed:         completed,
+		StartedAt:         d.startedAt.Load(),
+		RemainingSessions: d.remaining.Load(),
+	}
+}
+
+// isDraining returns true when the drain has been started, the new connections are refused.
+func (s *Service) isDraining() bool {
+	return s.drain.started.Load()
+}
+
+func (s *Service) getSessions() []*session {
+	sessions := make([]*session, 0, s.sessions.Length())
+	s.sessions.Range(func(_, value interface{}) bool {
+		sessions = append(sessions, value.(*session))
+		return true
+	})
+	return sessions
+}
+
+// startDrain closes the sessions gradually by the configured rate. It returns false when the drain has been already
+// started.
+func (s *Service) startDrain() bool {
+	if !s.drain.started.CompareAndSwap(false, true) {
+		return false
+	}
+	s.drain.startedAt.Store(time.Now())
+	sessions := s.getSessions()
+	s.drain.remaining.Store(int64(len(sessions)))
+	s.logger.Infof("draining %v sessions", len(sessions))
+	go func() {
+		defer close(s.drain.done)
+		s.drainSessions(sessions)
+		s.logger.Infof("drain of sessions has been completed")
+	}()
+	return true
+}
+
+func (s *Service) drainSessions(sessions []*session) {
+	cfg := s.config.APIs.COAP.Drain
+	timeout := time.NewTimer(cfg.Timeout)
+	defer timeout.Stop()
+	ticker := time.NewTicker(max(time.Duration(float64(time.Second)/cfg.CloseRate), time.Millisecond))
+	defer ticker.Stop()
+	for i, client := range sessions {
+		select {
+		case <-ticker.C:
+			s.drainSession(client, cfg.RedirectURI)
+		case <-timeout.C:
+			s.logger.Warnf("drain timeout %v has been reached: closing remaining %v sessions", cfg.Timeout, len(sessions)-i)
+			for _, c := range sessions[i:] {
+				s.drainSession(c, "")
+			}
+			return
+		}
+	}
+}
+
+func (s *Service) drainSession(client *session, redirectURI string) {
+	defer s.drain.remaining.Dec()
+	select {
+	case <-client.coapConn.Done():
+		return
+	default:
+	}
+	client.drained.Store(true)
+	if _, err := client.GetAuthorizationContext(); err == nil && redirectURI != "" {
+		if err = client.redirect(redirectURI); err != nil {
+			client.Errorf("cannot redirect device to %v: %w", redirectURI, err)
+		}
+	}
+	client.Close()
+}
+
+// drainOnShutdown drains the sessions before the services are closed. It waits for the delayed offline statuses, so
+// the service heartbeat keeps the devices online until the grace period ends.
+func (s *Service) drainOnShutdown() {
+	s.startDrain()
+	<-s.drain.done
+	s.devicesStatusUpdater.WaitForOfflineStatuses()
+}
+
+// redirect asks the device to connect to the other coap-gateway by the update of its cloud configuration resource.
+func (c *session) redirect(redirectURI string) error {
+	body, err := cbor.Encode(cloud.ConfigurationUpdateRequest{
+		URL: redirectURI,
+	})
+	if err != nil {
+		return fmt.Errorf("cannot encode cloud configuration: %w", err)
+	}
+	ctx, cancel := context.WithTimeout(c.Context(), c.server.config.APIs.COAP.KeepAlive.Timeout)
+	defer cancel()
+	req, err := c.coapConn.NewPostRequest(ctx, cloud.ResourceURI, message.AppOcfCbor, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer c.ReleaseMessage(req)
+	resp, err := c.Do(req, "")
+	if err != nil {
+		return err
+	}
+	defer c.ReleaseMessage(resp)
+	if resp.Code() != coapCodes.Changed && resp.Code() != coapCodes.Created {
+		return fmt.Errorf("unexpected response code(%v)", resp.Code())
+	}
+	return nil
+}
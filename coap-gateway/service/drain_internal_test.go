@@ -0,0 +1,267 @@
+//go:build test
+// +build test
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/plgd-dev/go-coap/v3/mux"
+	"github.com/plgd-dev/go-coap/v3/pkg/cache"
+	"github.com/plgd-dev/hub/v2/coap-gateway/uri"
+	"github.com/plgd-dev/hub/v2/pkg/log"
+	coapService "github.com/plgd-dev/hub/v2/pkg/net/coap/service"
+	"github.com/plgd-dev/hub/v2/test/config"
+	kitSync "github.com/plgd-dev/kit/v2/sync"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeCoapConn is the connection of the device, which records when it was closed.
+type fakeCoapConn struct {
+	mux.Conn
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	lock     sync.Mutex
+	closedAt time.Time
+	onClose  []func()
+}
+
+func newFakeCoapConn() *fakeCoapConn {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &fakeCoapConn{
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
+
+func (c *fakeCoapConn) RemoteAddr() net.Addr {
+	return &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 5684}
+}
+
+func (c *fakeCoapConn) NetConn() net.Conn {
+	return nil
+}
+
+func (c *fakeCoapConn) Context() context.Context {
+	return c.ctx
+}
+
+func (c *fakeCoapConn) SetContextValue(interface{}, interface{}) {
+	// the session is not read from the context of the connection
+}
+
+func (c *fakeCoapConn) Sequence() uint64 {
+	return 0
+}
+
+func (c *fakeCoapConn) Done() <-chan struct{} {
+	return c.ctx.Done()
+}
+
+func (c *fakeCoapConn) AddOnClose(f func()) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.onClose = append(c.onClose, f)
+}
+
+func (c *fakeCoapConn) Close() error {
+	c.lock.Lock()
+	if !c.closedAt.IsZero() {
+		c.lock.Unlock()
+		return nil
+	}
+	c.closedAt = time.Now()
+	onClose := c.onClose
+	c.lock.Unlock()
+	c.cancel()
+	for _, f := range onClose {
+		f()
+	}
+	return nil
+}
+
+func (c *fakeCoapConn) getClosedAt() time.Time {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.closedAt
+}
+
+func newDrainTestService(t *testing.T, closeRate float64, timeout time.Duration) *Service {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	logger := log.Get()
+	s := &Service{
+		ctx:                  ctx,
+		logger:               logger,
+		sessions:             kitSync.NewMap(),
+		drain:                newDrain(),
+		devicesStatusUpdater: newDevicesStatusUpdater(ctx, uuid.New(), logger),
+	}
+	s.config.APIs.COAP.Drain = DrainConfig{
+		Enabled:   true,
+		CloseRate: closeRate,
+		Timeout:   timeout,
+	}
+	return s
+}
+
+// connectDevices opens the connections of the devices.
+func connectDevices(s *Service, count int) []*fakeCoapConn {
+	conns := make([]*fakeCoapConn, 0, count)
+	for range count {
+		conn := newFakeCoapConn()
+		s.coapConnOnNew(conn)
+		conns = append(conns, conn)
+	}
+	return conns
+}
+
+func waitForDrain(t *testing.T, s *Service) {
+	select {
+	case <-s.drain.done:
+	case <-time.After(time.Second * 10):
+		require.FailNow(t, "drain has not been completed")
+	}
+}
+
+func TestDrainClosesSessionsByRate(t *testing.T) {
+	const closeRate = 20
+	interval := time.Second / closeRate
+	s := newDrainTestService(t, closeRate, time.Minute)
+	conns := connectDevices(s, 3)
+	require.Equal(t, 3, s.sessions.Length())
+
+	startedAt := time.Now()
+	require.True(t, s.startDrain())
+	// the drain is started only once
+	require.False(t, s.startDrain())
+	status := s.drain.status()
+	require.True(t, status.Draining)
+	require.False(t, status.Completed)
+	require.Equal(t, int64(3), status.RemainingSessions)
+
+	waitForDrain(t, s)
+	closedAt := make([]time.Time, 0, len(conns))
+	for _, conn := range conns {
+		require.False(t, conn.getClosedAt().IsZero())
+		closedAt = append(closedAt, conn.getClosedAt())
+	}
+	slices.SortFunc(closedAt, time.Time.Compare)
+	prev := startedAt
+	for _, v := range closedAt {
+		// the sessions are closed one by one by the ticker of the rate
+		require.GreaterOrEqual(t, v.Sub(prev), interval/2)
+		prev = v
+	}
+	require.Equal(t, 0, s.sessions.Length())
+	status = s.drain.status()
+	require.True(t, status.Completed)
+	require.Equal(t, int64(0), status.RemainingSessions)
+}
+
+func TestDrainClosesRemainingSessionsOnTimeout(t *testing.T) {
+	const timeout = time.Millisecond * 200
+	// the first session would be closed after one second
+	s := newDrainTestService(t, 1, timeout)
+	conns := connectDevices(s, 3)
+	// the closed connection is skipped
+	require.NoError(t, conns[1].Close())
+
+	startedAt := time.Now()
+	require.True(t, s.startDrain())
+	waitForDrain(t, s)
+	for _, conn := range conns {
+		closedAt := conn.getClosedAt()
+		require.False(t, closedAt.IsZero())
+		require.Less(t, closedAt.Sub(startedAt), time.Second)
+	}
+	require.GreaterOrEqual(t, time.Since(startedAt), timeout)
+	require.Equal(t, 0, s.sessions.Length())
+	require.Equal(t, int64(0), s.drain.status().RemainingSessions)
+}
+
+func TestDrainRefusesNewConnections(t *testing.T) {
+	s := newDrainTestService(t, 1000, time.Minute)
+	conns := connectDevices(s, 1)
+	require.Equal(t, 1, s.sessions.Length())
+	require.True(t, conns[0].getClosedAt().IsZero())
+
+	require.True(t, s.startDrain())
+	waitForDrain(t, s)
+	conns = connectDevices(s, 1)
+	require.False(t, conns[0].getClosedAt().IsZero())
+	require.Equal(t, 0, s.sessions.Length())
+}
+
+func TestDrainOnShutdownWaitsForOfflineStatuses(t *testing.T) {
+	const gracePeriod = time.Millisecond * 200
+	s := newDrainTestService(t, 1000, time.Minute)
+	s.config.APIs.COAP.Drain.OfflineGracePeriod = gracePeriod
+	s.config.APIs.COAP.KeepAlive = &coapService.KeepAlive{Timeout: time.Second}
+	s.expirationClientCache = cache.NewCache[string, *session]()
+	ra := newFakeResourceAggregate()
+	s.raClient = ra.client
+
+	// the signed in device
+	connectDevices(s, 1)
+	ra.connect(t, connectionA)
+	for _, c := range s.getSessions() {
+		c.SetAuthorizationContext(&authorizationContext{
+			DeviceID:    testDeviceID,
+			AccessToken: config.CreateJwtToken(t, jwt.MapClaims{}),
+			UserID:      "owner",
+		})
+	}
+
+	startedAt := time.Now()
+	s.drainOnShutdown()
+	// the offline status of the drained device is updated after the grace period
+	require.GreaterOrEqual(t, time.Since(startedAt), gracePeriod)
+	require.False(t, ra.isOnline())
+	require.True(t, s.drain.status().Completed)
+}
+
+func TestDrainHTTPHandler(t *testing.T) {
+	s := newDrainTestService(t, 1000, time.Minute)
+	connectDevices(s, 2)
+	h := s.newHTTPHandler()
+	do := func(method string) (int, DrainStatus) {
+		req := httptest.NewRequest(method, uri.Drain, nil)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		var status DrainStatus
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&status))
+		return w.Code, status
+	}
+
+	code, status := do(http.MethodGet)
+	require.Equal(t, http.StatusOK, code)
+	require.False(t, status.Draining)
+
+	code, status = do(http.MethodPost)
+	require.Equal(t, http.StatusAccepted, code)
+	require.True(t, status.Draining)
+	require.False(t, status.StartedAt.IsZero())
+
+	// the repeated request doesn't start the drain again
+	code, status = do(http.MethodPost)
+	require.Equal(t, http.StatusOK, code)
+	require.True(t, status.Draining)
+
+	waitForDrain(t, s)
+	code, status = do(http.MethodGet)
+	require.Equal(t, http.StatusOK, code)
+	require.True(t, status.Completed)
+	require.Equal(t, int64(0), status.RemainingSessions)
+}
@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/plgd-dev/hub/v2/coap-gateway/uri"
+	"github.com/plgd-dev/hub/v2/pkg/fsnotify"
+	"github.com/plgd-dev/hub/v2/pkg/log"
+	pkgHttp "github.com/plgd-dev/hub/v2/pkg/net/http"
+	"github.com/plgd-dev/hub/v2/pkg/net/listener"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// httpService serves the administration API of the coap-gateway.
+type httpService struct {
+	httpServer *http.Server
+	listener   *listener.Server
+}
+
+func (s *Service) writeDrainStatus(w http.ResponseWriter, code int) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(s.drain.status()); err != nil {
+		s.logger.Errorf("cannot write drain status: %w", err)
+	}
+}
+
+// newHTTPHandler returns the handler of the drain API. POST starts the drain, the status is returned with
+// 202 Accepted when the drain has been started by the request and with 200 OK otherwise.
+func (s *Service) newHTTPHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(http.MethodGet+" "+uri.Drain, func(w http.ResponseWriter, _ *http.Request) {
+		s.writeDrainStatus(w, http.StatusOK)
+	})
+	mux.HandleFunc(http.MethodPost+" "+uri.Drain, func(w http.ResponseWriter, _ *http.Request) {
+		if !s.startDrain() {
+			s.writeDrainStatus(w, http.StatusOK)
+			return
+		}
+		s.writeDrainStatus(w, http.StatusAccepted)
+	})
+	return mux
+}
+
+func (s *Service) newHTTPService(fileWatcher *fsnotify.Watcher, logger log.Logger, tracerProvider trace.TracerProvider) (*httpService, error) {
+	config := s.config.APIs.HTTP
+	listener, err := listener.New(config.Connection, fileWatcher, logger, tracerProvider)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create http listener: %w", err)
+	}
+	return &httpService{
+		httpServer: &http.Server{
+			Handler:           pkgHttp.OpenTelemetryNewHandler(s.newHTTPHandler(), "coap-gateway", tracerProvider),
+			ReadTimeout:       config.Server.ReadTimeout,
+			ReadHeaderTimeout: config.Server.ReadHeaderTimeout,
+			WriteTimeout:      config.Server.WriteTimeout,
+			IdleTimeout:       config.Server.IdleTimeout,
+		},
+		listener: listener,
+	}, nil
+}
+
+// Serve starts the HTTP server and blocks
+func (s *httpService) Serve() error {
+	return s.httpServer.Serve(s.listener)
+}
+
+// Close ends serving
+func (s *httpService) Close() error {
+	return s.httpServer.Shutdown(context.Background())
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/plgd-dev/go-coap/v3/message"
 	"github.com/plgd-dev/go-coap/v3/message/pool"
+	"github.com/plgd-dev/hub/v2/pkg/senml"
 	"github.com/plgd-dev/kit/v2/codec/cbor"
 	"github.com/plgd-dev/kit/v2/codec/json"
 )
@@ -67,6 +68,16 @@ func decodeData(mt message.MediaType, data []byte) interface{} {
 			return nil
 		}
 		return res
+	case message.AppSenmlJSON, message.AppSenmlCbor:
+		decode := senml.DecodeJSON
+		if mt == message.AppSenmlCbor {
+			decode = senml.DecodeCBOR
+		}
+		err := decode(data, &res)
+		if err != nil {
+			return nil
+		}
+		return res
 	case message.AppXML:
 		return string(data)
 	default:
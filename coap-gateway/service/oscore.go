@@ -0,0 +1,354 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/plgd-dev/go-coap/v3/message"
+	coapCodes "github.com/plgd-dev/go-coap/v3/message/codes"
+	"github.com/plgd-dev/go-coap/v3/message/pool"
+	"github.com/plgd-dev/go-coap/v3/mux"
+	"github.com/plgd-dev/hub/v2/coap-gateway/service/observation"
+	"github.com/plgd-dev/hub/v2/pkg/fsnotify"
+	"github.com/plgd-dev/hub/v2/pkg/log"
+	"github.com/plgd-dev/hub/v2/pkg/security/oscore"
+	oscoreMongodb "github.com/plgd-dev/hub/v2/pkg/security/oscore/mongodb"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type oscoreContextElement struct {
+	context *oscore.Context
+	refs    int
+}
+
+// oscoreContexts holds the security contexts of the connected devices by the ID Context issued by the provisioning. The
+// context is shared by all sessions of the device, because the replay window of the recipient must be shared.
+type oscoreContexts struct {
+	masterKey []byte
+	store     oscore.SequenceNumberStore
+
+	mutex    sync.Mutex
+	contexts map[string]*oscoreContextElement
+}
+
+func newOSCOREContexts(ctx context.Context, config OSCOREConfig, fileWatcher *fsnotify.Watcher, logger log.Logger, tracerProvider trace.TracerProvider) (*oscoreContexts, func(), error) {
+	masterKey, err := config.MasterKey()
+	if err != nil {
+		return nil, nil, err
+	}
+	store, err := oscoreMongodb.New(ctx, &config.Storage.MongoDB, fileWatcher, logger, tracerProvider)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot create sequence numbers store: %w", err)
+	}
+	closeStore := func() {
+		if errC := store.Close(context.Background()); errC != nil {
+			logger.Errorf("cannot close sequence numbers store: %w", errC)
+		}
+	}
+	return &oscoreContexts{
+		masterKey: masterKey,
+		store:     store,
+		contexts:  make(map[string]*oscoreContextElement),
+	}, closeStore, nil
+}
+
+func (c *oscoreContexts) acquire(idContext []byte) (*oscore.Context, error) {
+	key := hex.EncodeToString(idContext)
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if e, ok := c.contexts[key]; ok {
+		e.refs++
+		return e.context, nil
+	}
+	ctx, err := oscore.NewHubContext(c.masterKey, idContext, oscore.WithSequenceNumberStore(c.store, oscore.DefaultPersistInterval))
+	if err != nil {
+		return nil, err
+	}
+	c.contexts[key] = &oscoreContextElement{
+		context: ctx,
+		refs:    1,
+	}
+	return ctx, nil
+}
+
+func (c *oscoreContexts) release(idContext []byte) {
+	key := hex.EncodeToString(idContext)
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	e, ok := c.contexts[key]
+	if !ok {
+		return
+	}
+	e.refs--
+	if e.refs <= 0 {
+		delete(c.contexts, key)
+	}
+}
+
+// getOSCOREContext returns the security context of the session or nil when the device doesn't use OSCORE.
+func (c *session) getOSCOREContext() *oscore.Context {
+	c.private.mutex.Lock()
+	defer c.private.mutex.Unlock()
+	return c.private.oscoreContext
+}
+
+func (c *session) getOSCOREDeviceID() string {
+	c.private.mutex.Lock()
+	defer c.private.mutex.Unlock()
+	return c.private.oscoreDeviceID
+}
+
+// resolveOSCOREContext returns the security context for the protected request. The first request of the session
+// must contain the kid context issued by the provisioning of the device.
+func (c *session) resolveOSCOREContext(req *pool.Message) (*oscore.Context, error) {
+	kidContext, err := oscore.GetKIDContext(req)
+	if err != nil {
+		return nil, err
+	}
+	c.private.mutex.Lock()
+	defer c.private.mutex.Unlock()
+	if c.private.oscoreContext != nil {
+		if kidContext != nil && !bytes.Equal(kidContext, c.private.oscoreContext.IDContext()) {
+			return nil, fmt.Errorf("%w: kid context(%x) of the different device", oscore.ErrSecurityContextNotMatched, kidContext)
+		}
+		return c.private.oscoreContext, nil
+	}
+	if kidContext == nil {
+		return nil, fmt.Errorf("%w: kid context is required", oscore.ErrSecurityContextNotMatched)
+	}
+	deviceID, err := oscore.DeviceIDFromIDContext(kidContext)
+	if err != nil {
+		return nil, err
+	}
+	ctx, err := c.server.oscoreContexts.acquire(kidContext)
+	if err != nil {
+		return nil, err
+	}
+	c.private.oscoreContext = ctx
+	c.private.oscoreDeviceID = deviceID
+	return ctx, nil
+}
+
+func (c *session) releaseOSCOREContext() {
+	c.private.mutex.Lock()
+	oscoreCtx := c.private.oscoreContext
+	c.private.oscoreContext = nil
+	c.private.oscoreDeviceID = ""
+	c.private.mutex.Unlock()
+	if oscoreCtx != nil {
+		c.server.oscoreContexts.release(oscoreCtx.IDContext())
+	}
+}
+
+func (c *session) writeOSCOREError(r *mux.Message, err error) {
+	err = statusErrorf(coapCodes.Unauthorized, "cannot verify OSCORE request: %w", err)
+	resp := c.createErrorResponse(err, r.Token())
+	defer c.ReleaseMessage(resp)
+	if errW := c.coapConn.WriteMessage(resp); errW != nil {
+		c.Errorf("cannot write message: %w", errW)
+	}
+	c.logRequestResponse(r, resp, err)
+}
+
+func (c *session) writeOSCOREEcho(r *mux.Message, oscoreCtx *oscore.Context, req *oscore.Request) {
+	resp := c.server.messagePool.AcquireMessage(c.Context())
+	defer c.ReleaseMessage(resp)
+	resp.SetCode(coapCodes.Unauthorized)
+	resp.SetToken(r.Token())
+	if err := oscoreCtx.ProtectResponse(resp, req); err != nil {
+		c.Errorf("cannot protect OSCORE response: %w", err)
+		return
+	}
+	if err := c.coapConn.WriteMessage(resp); err != nil {
+		c.Errorf("cannot write message: %w", err)
+	}
+}
+
+// oscoreHandler replaces the requests protected by OSCORE (RFC 8613) by the original requests before they are routed.
+// The request is stored by the token, so the responses written by session.WriteMessage are protected for it.
+func (s *Service) oscoreHandler(next mux.Handler) mux.Handler {
+	return mux.HandlerFunc(func(w mux.ResponseWriter, r *mux.Message) {
+		client, ok := w.Conn().Context().Value(clientKey).(*session)
+		if !ok {
+			next.ServeCOAP(w, r)
+			return
+		}
+		if !oscore.IsProtected(r.Message) {
+			if client.getOSCOREContext() != nil && r.Code() != coapCodes.Empty {
+				client.writeOSCOREError(r, errors.New("unprotected request is not allowed"))
+				return
+			}
+			next.ServeCOAP(w, r)
+			return
+		}
+		oscoreCtx, err := client.resolveOSCOREContext(r.Message)
+		if err != nil {
+			client.writeOSCOREError(r, err)
+			return
+		}
+		req, err := oscoreCtx.UnprotectRequest(r.Message)
+		if errors.Is(err, oscore.ErrEchoRequired) {
+			client.writeOSCOREEcho(r, oscoreCtx, req)
+			return
+		}
+		if err != nil {
+			client.writeOSCOREError(r, err)
+			return
+		}
+		client.oscoreRequests.Store(r.Token().String(), req)
+		next.ServeCOAP(w, r)
+		if w.Message().Code() != coapCodes.Empty {
+			if err = oscoreCtx.ProtectResponse(w.Message(), req); err != nil {
+				client.Errorf("cannot protect OSCORE response: %w", err)
+			}
+		}
+	})
+}
+
+// writeOSCOREMessage protects the response or the notification by the request of the same token. The request is
+// forgotten after the response without the Observe option.
+func (c *session) writeOSCOREMessage(oscoreCtx *oscore.Context, msg *pool.Message) {
+	token := msg.Token().String()
+	v, ok := c.oscoreRequests.Load(token)
+	if !ok {
+		c.Errorf("cannot write message: OSCORE request for token %v not found", msg.Token())
+		return
+	}
+	req := v.(*oscore.Request)
+	if _, err := msg.Observe(); err != nil {
+		c.oscoreRequests.Delete(token)
+	}
+	protected := c.server.messagePool.AcquireMessage(msg.Context())
+	defer c.ReleaseMessage(protected)
+	if err := msg.Clone(protected); err != nil {
+		c.Errorf("cannot write message: %w", err)
+		return
+	}
+	if err := oscoreCtx.ProtectResponse(protected, req); err != nil {
+		c.Errorf("cannot protect OSCORE response: %w", err)
+		return
+	}
+	if err := c.coapConn.WriteMessage(protected); err != nil {
+		c.Errorf("cannot write message: %w", err)
+	}
+}
+
+func getOSCOREEcho(resp *pool.Message) []byte {
+	if resp.Code() != coapCodes.Unauthorized {
+		return nil
+	}
+	echo, err := resp.GetOptionBytes(oscore.EchoOptionID)
+	if err != nil {
+		return nil
+	}
+	return echo
+}
+
+// doOSCORE sends the protected request to the device. When the device requires to verify the freshness of the request,
+// the request is sent again with the received Echo option (RFC 8613 appendix B.1.2).
+func (c *session) doOSCORE(oscoreCtx *oscore.Context, req *pool.Message) (*pool.Message, error) {
+	var echo []byte
+	for {
+		protected := c.coapConn.AcquireMessage(req.Context())
+		if err := req.Clone(protected); err != nil {
+			c.coapConn.ReleaseMessage(protected)
+			return nil, err
+		}
+		if echo != nil {
+			protected.SetOptionBytes(oscore.EchoOptionID, echo)
+		}
+		oscoreReq, err := oscoreCtx.ProtectRequest(protected, false)
+		if err != nil {
+			c.coapConn.ReleaseMessage(protected)
+			return nil, fmt.Errorf("cannot protect OSCORE request: %w", err)
+		}
+		resp, err := c.coapConn.Do(protected)
+		c.coapConn.ReleaseMessage(protected)
+		if err != nil {
+			return nil, err
+		}
+		if err = oscoreCtx.UnprotectResponse(resp, oscoreReq); err != nil {
+			c.coapConn.ReleaseMessage(resp)
+			return nil, fmt.Errorf("cannot verify OSCORE response: %w", err)
+		}
+		if echo == nil {
+			if echo = getOSCOREEcho(resp); echo != nil {
+				c.coapConn.ReleaseMessage(resp)
+				continue
+			}
+		}
+		return resp, nil
+	}
+}
+
+// oscoreObservation cancels the observation by the protected request, because the deregistration sent by go-coap
+// isn't protected and it is rejected by the device.
+type oscoreObservation struct {
+	observation.Observation
+	session *session
+	path    string
+	token   message.Token
+}
+
+func (o *oscoreObservation) Cancel(ctx context.Context, opts ...message.Option) error {
+	if o.Observation.Canceled() {
+		return nil
+	}
+	// releases the token of the observation, the device rejects the unprotected request
+	_ = o.Observation.Cancel(ctx, opts...)
+	oscoreCtx := o.session.getOSCOREContext()
+	if oscoreCtx == nil {
+		return errors.New("cannot cancel observation: OSCORE context not found")
+	}
+	req, err := o.session.coapConn.NewGetRequest(ctx, o.path, opts...)
+	if err != nil {
+		return fmt.Errorf("cannot cancel observation: %w", err)
+	}
+	defer o.session.coapConn.ReleaseMessage(req)
+	req.SetObserve(1)
+	req.SetToken(o.token)
+	resp, err := o.session.doOSCORE(oscoreCtx, req)
+	if err != nil {
+		return fmt.Errorf("cannot cancel observation: %w", err)
+	}
+	defer o.session.coapConn.ReleaseMessage(resp)
+	if resp.Code() != coapCodes.Content && resp.Code() != coapCodes.Valid {
+		return fmt.Errorf("cannot cancel observation: unexpected return code(%v)", resp.Code())
+	}
+	return nil
+}
+
+// observeOSCORE registers the protected observation. The freshness of the request is verified by the device before,
+// because the first request of the hub to the device is the discovery of the resources.
+func (c *session) observeOSCORE(oscoreCtx *oscore.Context, req *pool.Message, observeFunc func(req *pool.Message)) (observation.Observation, error) {
+	path, _ := req.Path()
+	protected := c.coapConn.AcquireMessage(req.Context())
+	defer c.coapConn.ReleaseMessage(protected)
+	if err := req.Clone(protected); err != nil {
+		return nil, err
+	}
+	oscoreReq, err := oscoreCtx.ProtectRequest(protected, false)
+	if err != nil {
+		return nil, fmt.Errorf("cannot protect OSCORE request: %w", err)
+	}
+	obs, err := c.coapConn.DoObserve(protected, func(n *pool.Message) {
+		if errU := oscoreCtx.UnprotectResponse(n, oscoreReq); errU != nil {
+			c.Errorf("cannot verify OSCORE notification: %w", errU)
+			return
+		}
+		observeFunc(n)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &oscoreObservation{
+		Observation: obs,
+		session:     c,
+		path:        path,
+		token:       req.Token(),
+	}, nil
+}
@@ -105,7 +105,7 @@ func getRefreshTokenDataFromClaims(ctx context.Context, client *session, accessT
 		return "", "", err
 	}
 
-	deviceID, err := client.server.VerifyAndResolveDeviceID(client.tlsDeviceID, req.DeviceID, claim)
+	deviceID, err := client.server.VerifyAndResolveDeviceID(client.securedDeviceID(), req.DeviceID, claim)
 	if err != nil {
 		return "", "", err
 	}
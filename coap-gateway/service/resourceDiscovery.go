@@ -53,8 +53,14 @@ func makeDiscoveryResp(isTLSListener bool, protocol coapService.Protocol, server
 	if isTLSListener {
 		ep = "coaps"
 	}
-	if protocol == coapService.TCP {
+	switch protocol {
+	case coapService.TCP:
 		ep += "+tcp"
+	case coapService.WS:
+		ep = "coap+ws"
+		if isTLSListener {
+			ep = "coap+wss"
+		}
 	}
 	ep = ep + "://" + serverAddr
 
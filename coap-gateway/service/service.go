@@ -43,10 +43,9 @@ import (
 	"github.com/plgd-dev/hub/v2/pkg/sync/task/queue"
 	raClient "github.com/plgd-dev/hub/v2/resource-aggregate/client"
 	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus"
-	natsClient "github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/nats/client"
-	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/nats/subscriber"
-	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/utils"
+	eventbusConfig "github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/config"
 	pbRD "github.com/plgd-dev/hub/v2/resource-directory/pb"
+	kitSync "github.com/plgd-dev/kit/v2/sync"
 	otelCodes "go.opentelemetry.io/otel/codes"
 	semconv "go.opentelemetry.io/otel/semconv/v1.10.0"
 	"go.opentelemetry.io/otel/trace"
@@ -72,8 +71,7 @@ type Service struct {
 	providers                  map[string]*oauth2.PlgdProvider
 	expirationClientCache      *cache.Cache[string, *session]
 	taskQueue                  *queue.Queue
-	natsClient                 *natsClient.Client
-	resourceSubscriber         *subscriber.Subscriber
+	resourceSubscriber         eventbusConfig.Subscriber
 	authInterceptor            Interceptor
 	jwtValidator               *jwt.Validator
 	sigs                       chan os.Signal
@@ -81,6 +79,9 @@ type Service struct {
 	subscriptionsCache         *subscription.SubscriptionsCache
 	messagePool                *pool.Pool
 	raClient                   *raClient.Client
+	oscoreContexts             *oscoreContexts
+	sessions                   *kitSync.Map
+	drain                      *drain
 	config                     Config
 }
 
@@ -236,79 +237,70 @@ func New(ctx context.Context, config Config, fileWatcher *fsnotify.Watcher, logg
 		return nil, fmt.Errorf("cannot create job queue %w", err)
 	}
 
-	nats, err := natsClient.New(config.Clients.Eventbus.NATS.Config, fileWatcher, logger, tracerProvider)
+	resourceSubscriber, err := eventbusConfig.NewSubscriber(config.Clients.Eventbus.ConfigSubscriber, fileWatcher, logger, tracerProvider,
+		eventbusConfig.WithGoPool(func(f func()) error { return queue.Submit(f) }),
+	)
 	if err != nil {
 		otelClient.Close()
 		queue.Release()
-		return nil, fmt.Errorf("cannot create nats client: %w", err)
-	}
-	nats.AddCloseFunc(otelClient.Close)
-	nats.AddCloseFunc(queue.Release)
-
-	resourceSubscriber, err := subscriber.New(nats.GetConn(),
-		config.Clients.Eventbus.NATS.PendingLimits, config.Clients.Eventbus.NATS.LeadResourceType.IsEnabled(),
-		logger,
-		subscriber.WithGoPool(func(f func()) error { return queue.Submit(f) }),
-		subscriber.WithUnmarshaler(utils.Unmarshal))
-	if err != nil {
-		nats.Close()
 		return nil, fmt.Errorf("cannot create eventbus subscriber: %w", err)
 	}
-	nats.AddCloseFunc(resourceSubscriber.Close)
+	resourceSubscriber.AddCloseFunc(otelClient.Close)
+	resourceSubscriber.AddCloseFunc(queue.Release)
 
 	raClient, closeRaClient, err := newResourceAggregateClient(config.Clients.ResourceAggregate, resourceSubscriber, fileWatcher, logger, tracerProvider)
 	if err != nil {
-		nats.Close()
+		resourceSubscriber.Close()
 		return nil, fmt.Errorf("cannot create resource-aggregate client: %w", err)
 	}
-	nats.AddCloseFunc(closeRaClient)
+	resourceSubscriber.AddCloseFunc(closeRaClient)
 
 	isClient, closeIsClient, err := newIdentityStoreClient(config.Clients.IdentityStore, fileWatcher, logger, tracerProvider)
 	if err != nil {
-		nats.Close()
+		resourceSubscriber.Close()
 		return nil, fmt.Errorf("cannot create identity-store client: %w", err)
 	}
-	nats.AddCloseFunc(closeIsClient)
+	resourceSubscriber.AddCloseFunc(closeIsClient)
 
 	rdClient, closeRdClient, err := newResourceDirectoryClient(config.Clients.ResourceDirectory, fileWatcher, logger, tracerProvider)
 	if err != nil {
-		nats.Close()
+		resourceSubscriber.Close()
 		return nil, fmt.Errorf("cannot create resource-directory client: %w", err)
 	}
-	nats.AddCloseFunc(closeRdClient)
+	resourceSubscriber.AddCloseFunc(closeRdClient)
 
 	certificateAuthorityClient, closeCertificateAuthorityClient, err := newCertificateAuthorityClient(config.Clients.CertificateAuthority, fileWatcher, logger, tracerProvider)
 	if err != nil {
-		nats.Close()
+		resourceSubscriber.Close()
 		return nil, fmt.Errorf("cannot create certificate-authority client: %w", err)
 	}
-	nats.AddCloseFunc(closeCertificateAuthorityClient)
+	resourceSubscriber.AddCloseFunc(closeCertificateAuthorityClient)
 
 	validator, err := validator.New(ctx, config.APIs.COAP.Authorization.Authority, fileWatcher, logger, tracerProvider)
 	if err != nil {
-		nats.Close()
+		resourceSubscriber.Close()
 		return nil, fmt.Errorf("cannot create jwt validator: %w", err)
 	}
-	nats.AddCloseFunc(validator.Close)
+	resourceSubscriber.AddCloseFunc(validator.Close)
 
 	providers, firstProvider, closeProviders, err := newProviders(ctx, config.APIs.COAP.Authorization, fileWatcher, logger, tracerProvider, validator.GetParser())
 	if err != nil {
-		nats.Close()
+		resourceSubscriber.Close()
 		return nil, fmt.Errorf("cannot create device providers error: %w", err)
 	}
-	nats.AddCloseFunc(closeProviders)
+	resourceSubscriber.AddCloseFunc(closeProviders)
 
 	if firstProvider == nil {
-		nats.Close()
+		resourceSubscriber.Close()
 		return nil, errors.New("device providers are empty")
 	}
 
-	ownerCache := idClient.NewOwnerCache(config.APIs.COAP.Authorization.OwnerClaim, config.APIs.COAP.OwnerCacheExpiration, nats.GetConn(), isClient, func(err error) {
+	ownerCache := idClient.NewOwnerCache(config.APIs.COAP.Authorization.OwnerClaim, config.APIs.COAP.OwnerCacheExpiration, resourceSubscriber, isClient, func(err error) {
 		logger.Errorf("ownerCache error: %w", err)
 	})
-	nats.AddCloseFunc(ownerCache.Close)
+	resourceSubscriber.AddCloseFunc(ownerCache.Close)
 
-	subscriptionsCache := subscription.NewSubscriptionsCache(resourceSubscriber.Conn(), func(err error) {
+	subscriptionsCache := subscription.NewSubscriptionsCache(resourceSubscriber, func(err error) {
 		logger.Errorf("subscriptionsCache error: %w", err)
 	})
 
@@ -317,7 +309,6 @@ func New(ctx context.Context, config Config, fileWatcher *fsnotify.Watcher, logg
 		config:     config,
 		instanceID: instanceID,
 
-		natsClient:                 nats,
 		raClient:                   raClient,
 		isClient:                   isClient,
 		rdClient:                   rdClient,
@@ -340,11 +331,23 @@ func New(ctx context.Context, config Config, fileWatcher *fsnotify.Watcher, logg
 		messagePool:        pool.New(config.APIs.COAP.MessagePoolSize, 1024),
 		logger:             logger,
 		tracerProvider:     tracerProvider,
+		sessions:           kitSync.NewMap(),
+		drain:              newDrain(),
+	}
+
+	if config.APIs.COAP.OSCORE.Enabled {
+		var closeOSCOREContexts func()
+		s.oscoreContexts, closeOSCOREContexts, err = newOSCOREContexts(ctx, config.APIs.COAP.OSCORE, fileWatcher, logger, tracerProvider)
+		if err != nil {
+			resourceSubscriber.Close()
+			return nil, fmt.Errorf("cannot create oscore security contexts: %w", err)
+		}
+		resourceSubscriber.AddCloseFunc(closeOSCOREContexts)
 	}
 
 	ss, err := s.createServices(fileWatcher, logger, tracerProvider)
 	if err != nil {
-		nats.Close()
+		resourceSubscriber.Close()
 		return nil, fmt.Errorf("cannot create services: %w", err)
 	}
 	return ss, nil
@@ -491,19 +494,29 @@ func defaultHandler(req *mux.Message, client *session) (*pool.Message, error) {
 
 const clientKey = "client"
 
+func getTLSInfoFromPeerCertificates(peerCertificates []*x509.Certificate, logger log.Logger) (deviceID string, validUntil time.Time) {
+	if len(peerCertificates) > 0 {
+		deviceID, err := coap.GetDeviceIDFromIdentityCertificate(peerCertificates[0])
+		if err == nil {
+			return deviceID, peerCertificates[0].NotAfter
+		}
+		logger.Warnf("cannot get deviceID from certificate %v: %v", peerCertificates[0].Subject.CommonName, err)
+		return "", peerCertificates[0].NotAfter
+	}
+	logger.Debugf("cannot get deviceID from certificate: certificate is not set")
+	return "", time.Time{}
+}
+
 func getTLSInfo(ctx context.Context, conn net.Conn, logger log.Logger) (deviceID string, validUntil time.Time) {
 	if tlsCon, ok := conn.(*tls.Conn); ok {
-		peerCertificates := tlsCon.ConnectionState().PeerCertificates
-		if len(peerCertificates) > 0 {
-			deviceID, err := coap.GetDeviceIDFromIdentityCertificate(peerCertificates[0])
-			if err == nil {
-				return deviceID, peerCertificates[0].NotAfter
-			}
-			logger.Warnf("cannot get deviceID from certificate %v: %v", peerCertificates[0].Subject.CommonName, err)
-			return "", peerCertificates[0].NotAfter
+		return getTLSInfoFromPeerCertificates(tlsCon.ConnectionState().PeerCertificates, logger)
+	}
+	if wsCon, ok := conn.(*coapService.WebSocketConn); ok {
+		if !wsCon.IsTLS() {
+			logger.Debugf("cannot get deviceID from certificate: websocket connection is not secured")
+			return "", time.Time{}
 		}
-		logger.Debugf("cannot get deviceID from certificate: certificate is not set")
-		return "", time.Time{}
+		return getTLSInfoFromPeerCertificates(wsCon.TLSConnectionState().PeerCertificates, logger)
 	}
 
 	dtlsCon, ok := conn.(*dtls.Conn)
@@ -540,10 +553,19 @@ func getTLSInfo(ctx context.Context, conn net.Conn, logger log.Logger) (deviceID
 }
 
 func (s *Service) coapConnOnNew(coapConn mux.Conn) {
+	if s.isDraining() {
+		s.logger.Debugf("connection from %v refused: coap-gateway is draining", coapConn.RemoteAddr())
+		if err := coapConn.Close(); err != nil {
+			s.logger.Errorf("cannot close connection: %w", err)
+		}
+		return
+	}
 	tlsDeviceID, tlsValidUntil := getTLSInfo(s.ctx, coapConn.NetConn(), s.logger)
 	client := newSession(s, coapConn, tlsDeviceID, tlsValidUntil)
 	coapConn.SetContextValue(clientKey, client)
+	s.sessions.Store(client, client)
 	coapConn.AddOnClose(func() {
+		s.sessions.Delete(client)
 		client.OnClose()
 	})
 }
@@ -617,7 +639,14 @@ func (s *Service) createServices(fileWatcher *fsnotify.Watcher, logger log.Logge
 		return nil, setHandlerError(plgdtime.ResourceURI, err)
 	}
 
-	services, err := coapService.New(s.ctx, s.config.APIs.COAP.Config, m, fileWatcher, logger, tracerProvider,
+	router := m
+	if s.oscoreContexts != nil {
+		// the protected requests don't contain the path, so they are routed after they are verified
+		router = mux.NewRouter()
+		router.DefaultHandle(s.oscoreHandler(m))
+	}
+
+	services, err := coapService.New(s.ctx, s.config.APIs.COAP.Config, router, fileWatcher, logger, tracerProvider,
 		coapService.WithOnNewConnection(s.coapConnOnNew),
 		coapService.WithOnInactivityConnection(s.onInactivityConnection),
 		coapService.WithMessagePool(s.messagePool),
@@ -629,12 +658,23 @@ func (s *Service) createServices(fileWatcher *fsnotify.Watcher, logger log.Logge
 	if err != nil {
 		return nil, fmt.Errorf("cannot create coap-gateway service: %w", err)
 	}
-	serviceHeartbeat, err := newServiceHeartbeat(s.instanceID, s.config.ServiceHeartbeat.TimeToLive, s.raClient, logger, services)
+	serviceHeartbeat, err := raClient.NewServiceHeartbeat(s.instanceID, s.config.ServiceHeartbeat.TimeToLive, s.raClient, logger, services)
 	if err != nil {
 		_ = services.Close()
 		return nil, fmt.Errorf("cannot create service heartbeat: %w", err)
 	}
 
 	services.Add(serviceHeartbeat)
-	return services, err
+	if s.config.APIs.HTTP.Enabled {
+		httpService, err := s.newHTTPService(fileWatcher, logger, tracerProvider)
+		if err != nil {
+			_ = services.Close()
+			return nil, fmt.Errorf("cannot create http service: %w", err)
+		}
+		services.Add(httpService)
+	}
+	if s.config.APIs.COAP.Drain.Enabled {
+		services.AddBeforeCloseFunc(s.drainOnShutdown)
+	}
+	return services, nil
 }
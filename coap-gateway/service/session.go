@@ -9,6 +9,7 @@ import (
 	"math"
 	"net"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/pion/dtls/v3"
@@ -30,6 +31,7 @@ import (
 	kitNetGrpc "github.com/plgd-dev/hub/v2/pkg/net/grpc"
 	"github.com/plgd-dev/hub/v2/pkg/opentelemetry/otelcoap"
 	pkgJwt "github.com/plgd-dev/hub/v2/pkg/security/jwt"
+	"github.com/plgd-dev/hub/v2/pkg/security/oscore"
 	"github.com/plgd-dev/hub/v2/pkg/sync/task/future"
 	"github.com/plgd-dev/hub/v2/resource-aggregate/commands"
 	"github.com/plgd-dev/hub/v2/resource-aggregate/events"
@@ -106,17 +108,23 @@ type session struct {
 	exchangeCache         *ExchangeCache
 	refreshCache          *RefreshCache
 	tlsDeviceID           string
+	oscoreRequests        *kitSync.Map
 	private               struct { // guarded by mutex
 		mutex                   sync.Mutex
 		authCtx                 *authorizationContext
 		deviceSubscriber        *grpcClient.DeviceSubscriber
 		deviceObserver          *future.Future
 		closeEventSubscriptions func()
+		oscoreContext           *oscore.Context
+		oscoreDeviceID          string
 	}
 
 	// blockSignOff is used to block sign off until all commands from hub are finished.
 	// eg: factory reset was send via /oic/mnt resource and sign off are called in parallel.
 	blockSignOff *semaphore.Weighted
+
+	// drained is set when the session is closed by the drain of the coap-gateway.
+	drained atomic.Bool
 }
 
 // newSession creates and initializes client
@@ -126,6 +134,7 @@ func newSession(server *Service, coapConn mux.Conn, tlsDeviceID string, tlsValid
 		coapConn:              coapConn,
 		tlsDeviceID:           tlsDeviceID,
 		resourceSubscriptions: kitSync.NewMap(),
+		oscoreRequests:        kitSync.NewMap(),
 		exchangeCache:         NewExchangeCache(),
 		refreshCache:          NewRefreshCache(),
 		tlsValidUntil:         tlsValidUntil,
@@ -137,6 +146,9 @@ func (c *session) deviceID() string {
 	if c.tlsDeviceID != "" {
 		return c.tlsDeviceID
 	}
+	if oscoreDeviceID := c.getOSCOREDeviceID(); oscoreDeviceID != "" {
+		return oscoreDeviceID
+	}
 	a, err := c.GetAuthorizationContext()
 	if err == nil {
 		return a.GetDeviceID()
@@ -144,7 +156,19 @@ func (c *session) deviceID() string {
 	return ""
 }
 
+// securedDeviceID returns the device ID verified by the connection: the device ID from the certificate when
+// the certificate is required or the device ID of the OSCORE security context.
+func (c *session) securedDeviceID() string {
+	if c.server.config.APIs.COAP.TLS.IsEnabled() && c.server.config.APIs.COAP.TLS.Embedded.ClientCertificateRequired {
+		return c.tlsDeviceID
+	}
+	return c.getOSCOREDeviceID()
+}
+
 func (c *session) Protocol() coapService.Protocol {
+	if _, ok := c.coapConn.NetConn().(*coapService.WebSocketConn); ok {
+		return coapService.WS
+	}
 	if _, ok := c.coapConn.(*coapTcpClient.Conn); ok {
 		return coapService.TCP
 	}
@@ -152,7 +176,12 @@ func (c *session) Protocol() coapService.Protocol {
 }
 
 func (c *session) GetApplicationProtocol() commands.Connection_Protocol {
-	switch c.coapConn.NetConn().(type) {
+	switch conn := c.coapConn.NetConn().(type) {
+	case *coapService.WebSocketConn:
+		if conn.IsTLS() {
+			return commands.Connection_COAPS_WS
+		}
+		return commands.Connection_COAP_WS
 	case *tls.Conn:
 		return commands.Connection_COAPS_TCP
 	case *dtls.Conn:
@@ -175,6 +204,10 @@ func (c *session) getSessionExpiration(validJWTUntil time.Time) time.Time {
 }
 
 func (c *session) WriteMessage(msg *pool.Message) {
+	if oscoreCtx := c.getOSCOREContext(); oscoreCtx != nil {
+		c.writeOSCOREMessage(oscoreCtx, msg)
+		return
+	}
 	if err := c.coapConn.WriteMessage(msg); err != nil {
 		c.Errorf("cannot write message: %w", err)
 	}
@@ -194,7 +227,12 @@ func (c *session) Observe(ctx context.Context, path string, observeFunc func(req
 		return nil, err
 	}
 	t := time.Now()
-	obs, err := c.coapConn.DoObserve(req, observeFunc)
+	var obs observation.Observation
+	if oscoreCtx := c.getOSCOREContext(); oscoreCtx != nil {
+		obs, err = c.observeOSCORE(oscoreCtx, req, observeFunc)
+	} else {
+		obs, err = c.coapConn.DoObserve(req, observeFunc)
+	}
 	logger := c.getLogger()
 	if err == nil && !WantToLog(codes.Content, logger) {
 		return obs, err
@@ -221,7 +259,13 @@ func (c *session) do(req *pool.Message) (*pool.Message, error) {
 
 	otelcoap.MessageSentEvent(ctx, otelcoap.MakeMessage(req))
 
-	resp, err := c.coapConn.Do(req)
+	var resp *pool.Message
+	var err error
+	if oscoreCtx := c.getOSCOREContext(); oscoreCtx != nil {
+		resp, err = c.doOSCORE(oscoreCtx, req)
+	} else {
+		resp, err = c.coapConn.Do(req)
+	}
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(otelCodes.Error, err.Error())
@@ -466,6 +510,7 @@ func (c *session) CleanUp(resetAuthContext bool) *authorizationContext {
 
 // OnClose is invoked when the coap connection was closed.
 func (c *session) OnClose() {
+	defer c.releaseOSCOREContext()
 	authCtx, _ := c.GetAuthorizationContext()
 	if authCtx.GetDeviceID() != "" {
 		// don't log health check connection
@@ -475,9 +520,7 @@ func (c *session) OnClose() {
 
 	if oldAuthCtx.GetDeviceID() != "" {
 		c.server.expirationClientCache.Delete(oldAuthCtx.GetDeviceID())
-		ctx, cancel := context.WithTimeout(context.Background(), c.server.config.APIs.COAP.KeepAlive.Timeout)
-		defer cancel()
-		_, err := c.server.raClient.UpdateDeviceMetadata(kitNetGrpc.CtxWithToken(ctx, oldAuthCtx.GetAccessToken()), &commands.UpdateDeviceMetadataRequest{
+		req := &commands.UpdateDeviceMetadataRequest{
 			DeviceId: authCtx.GetDeviceID(),
 			Update: &commands.UpdateDeviceMetadataRequest_Connection{
 				Connection: &commands.Connection{
@@ -488,7 +531,16 @@ func (c *session) OnClose() {
 				Sequence:     c.coapConn.Sequence(),
 				ConnectionId: c.RemoteAddr().String(),
 			},
-		})
+		}
+		if c.drained.Load() {
+			// the device is expected to reconnect, so the offline status is delayed to prevent flapping
+			c.server.devicesStatusUpdater.UpdateOfflineStatusAfter(c.server.raClient, oldAuthCtx.GetAccessToken(), req,
+				c.server.config.APIs.COAP.Drain.OfflineGracePeriod, c.server.config.APIs.COAP.KeepAlive.Timeout)
+			return
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), c.server.config.APIs.COAP.KeepAlive.Timeout)
+		defer cancel()
+		_, err := c.server.raClient.UpdateDeviceMetadata(kitNetGrpc.CtxWithToken(ctx, oldAuthCtx.GetAccessToken()), req)
 		if err != nil {
 			// Device will be still reported as online and it can fix his state by next calls online, offline commands.
 			c.Errorf("DeviceID %v: cannot handle sign out: cannot update cloud device status: %w", oldAuthCtx.GetDeviceID(), err)
@@ -222,7 +222,7 @@ func getSignInDataFromClaims(ctx context.Context, client *session, signIn CoapSi
 		return "", time.Time{}, err
 	}
 
-	deviceID, err := client.server.VerifyAndResolveDeviceID(client.tlsDeviceID, signIn.DeviceID, jwtClaims)
+	deviceID, err := client.server.VerifyAndResolveDeviceID(client.securedDeviceID(), signIn.DeviceID, jwtClaims)
 	if err != nil {
 		return "", time.Time{}, err
 	}
@@ -341,7 +341,7 @@ func getSignOutDataFromClaims(ctx context.Context, client *session, signOut Coap
 		return "", err
 	}
 
-	return client.server.VerifyAndResolveDeviceID(client.tlsDeviceID, signOut.DeviceID, jwtClaims)
+	return client.server.VerifyAndResolveDeviceID(client.securedDeviceID(), signOut.DeviceID, jwtClaims)
 }
 
 const errFmtSignOut = "cannot handle sign out: %w"
@@ -96,7 +96,7 @@ func getSignOffDataFromClaims(ctx context.Context, client *session, sod signOffD
 		return "", err
 	}
 
-	deviceID, err := client.server.VerifyAndResolveDeviceID(client.tlsDeviceID, sod.deviceID, jwtClaims)
+	deviceID, err := client.server.VerifyAndResolveDeviceID(client.securedDeviceID(), sod.deviceID, jwtClaims)
 	if err != nil {
 		return "", err
 	}
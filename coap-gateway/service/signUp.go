@@ -101,7 +101,7 @@ func getSignUpDataFromClaims(ctx context.Context, client *session, accessToken s
 		return "", "", errors.New("cannot determine owner")
 	}
 
-	deviceID, err := client.server.VerifyAndResolveDeviceID(client.tlsDeviceID, signUp.DeviceID, claim)
+	deviceID, err := client.server.VerifyAndResolveDeviceID(client.securedDeviceID(), signUp.DeviceID, claim)
 	if err != nil {
 		return "", "", err
 	}
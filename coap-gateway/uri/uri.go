@@ -20,3 +20,8 @@ const (
 	ResourceTypeQueryKey       = "rt"
 	ResourceTypeQueryKeyPrefix = ResourceTypeQueryKey + "="
 )
+
+// Administration API URIs.
+const (
+	Drain = ApiV1 + "/drain"
+)
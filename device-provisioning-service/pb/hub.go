@@ -9,11 +9,11 @@ import (
 	"time"
 
 	"github.com/plgd-dev/device/v2/schema"
-	"github.com/plgd-dev/hub/v2/device-provisioning-service/security/oauth/clientcredentials"
 	"github.com/plgd-dev/hub/v2/device-provisioning-service/uri"
 	"github.com/plgd-dev/hub/v2/pkg/config/property/urischeme"
 	"github.com/plgd-dev/hub/v2/pkg/net/grpc/client"
 	pkgCertManagerClient "github.com/plgd-dev/hub/v2/pkg/security/certManager/client"
+	"github.com/plgd-dev/hub/v2/pkg/security/oauth2/clientcredentials"
 	pkgTls "github.com/plgd-dev/hub/v2/pkg/security/tls"
 	"github.com/plgd-dev/hub/v2/pkg/strings"
 	"github.com/plgd-dev/kit/v2/security"
@@ -264,7 +264,7 @@ func (x Credential_CredentialType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Credential_CredentialType.Descriptor instead.
 func (Credential_CredentialType) EnumDescriptor() ([]byte, []int) {
-	return file_device_provisioning_service_pb_provisioningRecords_proto_rawDescGZIP(), []int{9, 0}
+	return file_device_provisioning_service_pb_provisioningRecords_proto_rawDescGZIP(), []int{10, 0}
 }
 
 type Credential_CredentialUsage int32
@@ -322,7 +322,7 @@ func (x Credential_CredentialUsage) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Credential_CredentialUsage.Descriptor instead.
 func (Credential_CredentialUsage) EnumDescriptor() ([]byte, []int) {
-	return file_device_provisioning_service_pb_provisioningRecords_proto_rawDescGZIP(), []int{9, 1}
+	return file_device_provisioning_service_pb_provisioningRecords_proto_rawDescGZIP(), []int{10, 1}
 }
 
 type Credential_CredentialRefreshMethod int32
@@ -380,7 +380,7 @@ func (x Credential_CredentialRefreshMethod) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Credential_CredentialRefreshMethod.Descriptor instead.
 func (Credential_CredentialRefreshMethod) EnumDescriptor() ([]byte, []int) {
-	return file_device_provisioning_service_pb_provisioningRecords_proto_rawDescGZIP(), []int{9, 2}
+	return file_device_provisioning_service_pb_provisioningRecords_proto_rawDescGZIP(), []int{10, 2}
 }
 
 type AccessControlConnectionSubject_ConnectionType int32
@@ -428,7 +428,7 @@ func (x AccessControlConnectionSubject_ConnectionType) Number() protoreflect.Enu
 
 // Deprecated: Use AccessControlConnectionSubject_ConnectionType.Descriptor instead.
 func (AccessControlConnectionSubject_ConnectionType) EnumDescriptor() ([]byte, []int) {
-	return file_device_provisioning_service_pb_provisioningRecords_proto_rawDescGZIP(), []int{14, 0}
+	return file_device_provisioning_service_pb_provisioningRecords_proto_rawDescGZIP(), []int{15, 0}
 }
 
 type AccessControlResource_Wildcard int32
@@ -484,7 +484,7 @@ func (x AccessControlResource_Wildcard) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use AccessControlResource_Wildcard.Descriptor instead.
 func (AccessControlResource_Wildcard) EnumDescriptor() ([]byte, []int) {
-	return file_device_provisioning_service_pb_provisioningRecords_proto_rawDescGZIP(), []int{15, 0}
+	return file_device_provisioning_service_pb_provisioningRecords_proto_rawDescGZIP(), []int{16, 0}
 }
 
 type AccessControl_Permission int32
@@ -544,7 +544,7 @@ func (x AccessControl_Permission) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use AccessControl_Permission.Descriptor instead.
 func (AccessControl_Permission) EnumDescriptor() ([]byte, []int) {
-	return file_device_provisioning_service_pb_provisioningRecords_proto_rawDescGZIP(), []int{16, 0}
+	return file_device_provisioning_service_pb_provisioningRecords_proto_rawDescGZIP(), []int{17, 0}
 }
 
 type GetProvisioningRecordsRequest struct {
@@ -1041,6 +1041,73 @@ func (x *CredentialPublicData) GetEncoding() CredentialPublicData_Encoding {
 	return CredentialPublicData_UNKNOWN
 }
 
+// OSCORE (RFC 8613) security context of the credential.
+type CredentialOSCORE struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Sender ID of the device.
+	SenderId []byte `protobuf:"bytes,1,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty" bson:"senderId,omitempty"` // @gotags: bson:"senderId,omitempty"
+	// Recipient ID of the device.
+	RecipientId []byte `protobuf:"bytes,2,opt,name=recipient_id,json=recipientId,proto3" json:"recipient_id,omitempty" bson:"recipientId,omitempty"` // @gotags: bson:"recipientId,omitempty"
+	// ID Context issued by the provisioning, the Master Secret is derived from it.
+	IdContext []byte `protobuf:"bytes,3,opt,name=id_context,json=idContext,proto3" json:"id_context,omitempty" bson:"idContext,omitempty"` // @gotags: bson:"idContext,omitempty"
+}
+
+func (x *CredentialOSCORE) Reset() {
+	*x = CredentialOSCORE{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CredentialOSCORE) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CredentialOSCORE) ProtoMessage() {}
+
+func (x *CredentialOSCORE) ProtoReflect() protoreflect.Message {
+	mi := &file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CredentialOSCORE.ProtoReflect.Descriptor instead.
+func (*CredentialOSCORE) Descriptor() ([]byte, []int) {
+	return file_device_provisioning_service_pb_provisioningRecords_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CredentialOSCORE) GetSenderId() []byte {
+	if x != nil {
+		return x.SenderId
+	}
+	return nil
+}
+
+func (x *CredentialOSCORE) GetRecipientId() []byte {
+	if x != nil {
+		return x.RecipientId
+	}
+	return nil
+}
+
+func (x *CredentialOSCORE) GetIdContext() []byte {
+	if x != nil {
+		return x.IdContext
+	}
+	return nil
+}
+
 type CredentialRoleID struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1053,7 +1120,7 @@ type CredentialRoleID struct {
 func (x *CredentialRoleID) Reset() {
 	*x = CredentialRoleID{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[8]
+		mi := &file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1066,7 +1133,7 @@ func (x *CredentialRoleID) String() string {
 func (*CredentialRoleID) ProtoMessage() {}
 
 func (x *CredentialRoleID) ProtoReflect() protoreflect.Message {
-	mi := &file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[8]
+	mi := &file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1079,7 +1146,7 @@ func (x *CredentialRoleID) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CredentialRoleID.ProtoReflect.Descriptor instead.
 func (*CredentialRoleID) Descriptor() ([]byte, []int) {
-	return file_device_provisioning_service_pb_provisioningRecords_proto_rawDescGZIP(), []int{8}
+	return file_device_provisioning_service_pb_provisioningRecords_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *CredentialRoleID) GetAuthority() string {
@@ -1121,12 +1188,14 @@ type Credential struct {
 	PublicData *CredentialPublicData `protobuf:"bytes,9,opt,name=public_data,json=publicData,proto3" json:"public_data,omitempty" bson:"publicData,omitempty"` // @gotags: bson:"publicData,omitempty"
 	// Role ID.
 	RoleId *CredentialRoleID `protobuf:"bytes,10,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty" bson:"roleId,omitempty"` // @gotags: bson:"roleId,omitempty"
+	// OSCORE security context, set for the OSCORE credential.
+	Oscore *CredentialOSCORE `protobuf:"bytes,11,opt,name=oscore,proto3" json:"oscore,omitempty" bson:"oscore,omitempty"` // @gotags: bson:"oscore,omitempty"
 }
 
 func (x *Credential) Reset() {
 	*x = Credential{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[9]
+		mi := &file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1139,7 +1208,7 @@ func (x *Credential) String() string {
 func (*Credential) ProtoMessage() {}
 
 func (x *Credential) ProtoReflect() protoreflect.Message {
-	mi := &file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[9]
+	mi := &file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1152,7 +1221,7 @@ func (x *Credential) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Credential.ProtoReflect.Descriptor instead.
 func (*Credential) Descriptor() ([]byte, []int) {
-	return file_device_provisioning_service_pb_provisioningRecords_proto_rawDescGZIP(), []int{9}
+	return file_device_provisioning_service_pb_provisioningRecords_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *Credential) GetId() int64 {
@@ -1225,6 +1294,13 @@ func (x *Credential) GetRoleId() *CredentialRoleID {
 	return nil
 }
 
+func (x *Credential) GetOscore() *CredentialOSCORE {
+	if x != nil {
+		return x.Oscore
+	}
+	return nil
+}
+
 type CredentialStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1241,7 +1317,7 @@ type CredentialStatus struct {
 func (x *CredentialStatus) Reset() {
 	*x = CredentialStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[10]
+		mi := &file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1254,7 +1330,7 @@ func (x *CredentialStatus) String() string {
 func (*CredentialStatus) ProtoMessage() {}
 
 func (x *CredentialStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[10]
+	mi := &file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1267,7 +1343,7 @@ func (x *CredentialStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CredentialStatus.ProtoReflect.Descriptor instead.
 func (*CredentialStatus) Descriptor() ([]byte, []int) {
-	return file_device_provisioning_service_pb_provisioningRecords_proto_rawDescGZIP(), []int{10}
+	return file_device_provisioning_service_pb_provisioningRecords_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *CredentialStatus) GetStatus() *ProvisionStatus {
@@ -1311,7 +1387,7 @@ type OwnershipStatus struct {
 func (x *OwnershipStatus) Reset() {
 	*x = OwnershipStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[11]
+		mi := &file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1324,7 +1400,7 @@ func (x *OwnershipStatus) String() string {
 func (*OwnershipStatus) ProtoMessage() {}
 
 func (x *OwnershipStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[11]
+	mi := &file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1337,7 +1413,7 @@ func (x *OwnershipStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OwnershipStatus.ProtoReflect.Descriptor instead.
 func (*OwnershipStatus) Descriptor() ([]byte, []int) {
-	return file_device_provisioning_service_pb_provisioningRecords_proto_rawDescGZIP(), []int{11}
+	return file_device_provisioning_service_pb_provisioningRecords_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *OwnershipStatus) GetStatus() *ProvisionStatus {
@@ -1365,7 +1441,7 @@ type AccessControlDeviceSubject struct {
 func (x *AccessControlDeviceSubject) Reset() {
 	*x = AccessControlDeviceSubject{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[12]
+		mi := &file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1378,7 +1454,7 @@ func (x *AccessControlDeviceSubject) String() string {
 func (*AccessControlDeviceSubject) ProtoMessage() {}
 
 func (x *AccessControlDeviceSubject) ProtoReflect() protoreflect.Message {
-	mi := &file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[12]
+	mi := &file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1391,7 +1467,7 @@ func (x *AccessControlDeviceSubject) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AccessControlDeviceSubject.ProtoReflect.Descriptor instead.
 func (*AccessControlDeviceSubject) Descriptor() ([]byte, []int) {
-	return file_device_provisioning_service_pb_provisioningRecords_proto_rawDescGZIP(), []int{12}
+	return file_device_provisioning_service_pb_provisioningRecords_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *AccessControlDeviceSubject) GetDeviceId() string {
@@ -1413,7 +1489,7 @@ type AccessControlRoleSubject struct {
 func (x *AccessControlRoleSubject) Reset() {
 	*x = AccessControlRoleSubject{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[13]
+		mi := &file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1426,7 +1502,7 @@ func (x *AccessControlRoleSubject) String() string {
 func (*AccessControlRoleSubject) ProtoMessage() {}
 
 func (x *AccessControlRoleSubject) ProtoReflect() protoreflect.Message {
-	mi := &file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[13]
+	mi := &file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1439,7 +1515,7 @@ func (x *AccessControlRoleSubject) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AccessControlRoleSubject.ProtoReflect.Descriptor instead.
 func (*AccessControlRoleSubject) Descriptor() ([]byte, []int) {
-	return file_device_provisioning_service_pb_provisioningRecords_proto_rawDescGZIP(), []int{13}
+	return file_device_provisioning_service_pb_provisioningRecords_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *AccessControlRoleSubject) GetAuthority() string {
@@ -1467,7 +1543,7 @@ type AccessControlConnectionSubject struct {
 func (x *AccessControlConnectionSubject) Reset() {
 	*x = AccessControlConnectionSubject{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[14]
+		mi := &file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1480,7 +1556,7 @@ func (x *AccessControlConnectionSubject) String() string {
 func (*AccessControlConnectionSubject) ProtoMessage() {}
 
 func (x *AccessControlConnectionSubject) ProtoReflect() protoreflect.Message {
-	mi := &file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[14]
+	mi := &file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1493,7 +1569,7 @@ func (x *AccessControlConnectionSubject) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AccessControlConnectionSubject.ProtoReflect.Descriptor instead.
 func (*AccessControlConnectionSubject) Descriptor() ([]byte, []int) {
-	return file_device_provisioning_service_pb_provisioningRecords_proto_rawDescGZIP(), []int{14}
+	return file_device_provisioning_service_pb_provisioningRecords_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *AccessControlConnectionSubject) GetType() AccessControlConnectionSubject_ConnectionType {
@@ -1521,7 +1597,7 @@ type AccessControlResource struct {
 func (x *AccessControlResource) Reset() {
 	*x = AccessControlResource{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[15]
+		mi := &file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1534,7 +1610,7 @@ func (x *AccessControlResource) String() string {
 func (*AccessControlResource) ProtoMessage() {}
 
 func (x *AccessControlResource) ProtoReflect() protoreflect.Message {
-	mi := &file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[15]
+	mi := &file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1547,7 +1623,7 @@ func (x *AccessControlResource) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AccessControlResource.ProtoReflect.Descriptor instead.
 func (*AccessControlResource) Descriptor() ([]byte, []int) {
-	return file_device_provisioning_service_pb_provisioningRecords_proto_rawDescGZIP(), []int{15}
+	return file_device_provisioning_service_pb_provisioningRecords_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *AccessControlResource) GetHref() string {
@@ -1596,7 +1672,7 @@ type AccessControl struct {
 func (x *AccessControl) Reset() {
 	*x = AccessControl{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[16]
+		mi := &file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1609,7 +1685,7 @@ func (x *AccessControl) String() string {
 func (*AccessControl) ProtoMessage() {}
 
 func (x *AccessControl) ProtoReflect() protoreflect.Message {
-	mi := &file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[16]
+	mi := &file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1622,7 +1698,7 @@ func (x *AccessControl) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AccessControl.ProtoReflect.Descriptor instead.
 func (*AccessControl) Descriptor() ([]byte, []int) {
-	return file_device_provisioning_service_pb_provisioningRecords_proto_rawDescGZIP(), []int{16}
+	return file_device_provisioning_service_pb_provisioningRecords_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *AccessControl) GetDeviceSubject() *AccessControlDeviceSubject {
@@ -1673,7 +1749,7 @@ type ACLStatus struct {
 func (x *ACLStatus) Reset() {
 	*x = ACLStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[17]
+		mi := &file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1686,7 +1762,7 @@ func (x *ACLStatus) String() string {
 func (*ACLStatus) ProtoMessage() {}
 
 func (x *ACLStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[17]
+	mi := &file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1699,7 +1775,7 @@ func (x *ACLStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ACLStatus.ProtoReflect.Descriptor instead.
 func (*ACLStatus) Descriptor() ([]byte, []int) {
-	return file_device_provisioning_service_pb_provisioningRecords_proto_rawDescGZIP(), []int{17}
+	return file_device_provisioning_service_pb_provisioningRecords_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ACLStatus) GetStatus() *ProvisionStatus {
@@ -1732,7 +1808,7 @@ type CloudStatus struct {
 func (x *CloudStatus) Reset() {
 	*x = CloudStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[18]
+		mi := &file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1745,7 +1821,7 @@ func (x *CloudStatus) String() string {
 func (*CloudStatus) ProtoMessage() {}
 
 func (x *CloudStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[18]
+	mi := &file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1758,7 +1834,7 @@ func (x *CloudStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CloudStatus.ProtoReflect.Descriptor instead.
 func (*CloudStatus) Descriptor() ([]byte, []int) {
-	return file_device_provisioning_service_pb_provisioningRecords_proto_rawDescGZIP(), []int{18}
+	return file_device_provisioning_service_pb_provisioningRecords_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *CloudStatus) GetStatus() *ProvisionStatus {
@@ -1823,7 +1899,7 @@ type ProvisioningRecord struct {
 func (x *ProvisioningRecord) Reset() {
 	*x = ProvisioningRecord{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[19]
+		mi := &file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1836,7 +1912,7 @@ func (x *ProvisioningRecord) String() string {
 func (*ProvisioningRecord) ProtoMessage() {}
 
 func (x *ProvisioningRecord) ProtoReflect() protoreflect.Message {
-	mi := &file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[19]
+	mi := &file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1849,7 +1925,7 @@ func (x *ProvisioningRecord) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProvisioningRecord.ProtoReflect.Descriptor instead.
 func (*ProvisioningRecord) Descriptor() ([]byte, []int) {
-	return file_device_provisioning_service_pb_provisioningRecords_proto_rawDescGZIP(), []int{19}
+	return file_device_provisioning_service_pb_provisioningRecords_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *ProvisioningRecord) GetId() string {
@@ -1952,7 +2028,7 @@ type DeleteProvisioningRecordsRequest struct {
 func (x *DeleteProvisioningRecordsRequest) Reset() {
 	*x = DeleteProvisioningRecordsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[20]
+		mi := &file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1965,7 +2041,7 @@ func (x *DeleteProvisioningRecordsRequest) String() string {
 func (*DeleteProvisioningRecordsRequest) ProtoMessage() {}
 
 func (x *DeleteProvisioningRecordsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[20]
+	mi := &file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1978,7 +2054,7 @@ func (x *DeleteProvisioningRecordsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteProvisioningRecordsRequest.ProtoReflect.Descriptor instead.
 func (*DeleteProvisioningRecordsRequest) Descriptor() ([]byte, []int) {
-	return file_device_provisioning_service_pb_provisioningRecords_proto_rawDescGZIP(), []int{20}
+	return file_device_provisioning_service_pb_provisioningRecords_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *DeleteProvisioningRecordsRequest) GetIdFilter() []string {
@@ -2014,7 +2090,7 @@ type DeleteProvisioningRecordsResponse struct {
 func (x *DeleteProvisioningRecordsResponse) Reset() {
 	*x = DeleteProvisioningRecordsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[21]
+		mi := &file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2027,7 +2103,7 @@ func (x *DeleteProvisioningRecordsResponse) String() string {
 func (*DeleteProvisioningRecordsResponse) ProtoMessage() {}
 
 func (x *DeleteProvisioningRecordsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[21]
+	mi := &file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2040,7 +2116,7 @@ func (x *DeleteProvisioningRecordsResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use DeleteProvisioningRecordsResponse.ProtoReflect.Descriptor instead.
 func (*DeleteProvisioningRecordsResponse) Descriptor() ([]byte, []int) {
-	return file_device_provisioning_service_pb_provisioningRecords_proto_rawDescGZIP(), []int{21}
+	return file_device_provisioning_service_pb_provisioningRecords_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *DeleteProvisioningRecordsResponse) GetCount() int64 {
@@ -2064,7 +2140,7 @@ type CloudStatus_Gateway struct {
 func (x *CloudStatus_Gateway) Reset() {
 	*x = CloudStatus_Gateway{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[22]
+		mi := &file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2077,7 +2153,7 @@ func (x *CloudStatus_Gateway) String() string {
 func (*CloudStatus_Gateway) ProtoMessage() {}
 
 func (x *CloudStatus_Gateway) ProtoReflect() protoreflect.Message {
-	mi := &file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[22]
+	mi := &file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2090,7 +2166,7 @@ func (x *CloudStatus_Gateway) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CloudStatus_Gateway.ProtoReflect.Descriptor instead.
 func (*CloudStatus_Gateway) Descriptor() ([]byte, []int) {
-	return file_device_provisioning_service_pb_provisioningRecords_proto_rawDescGZIP(), []int{18, 0}
+	return file_device_provisioning_service_pb_provisioningRecords_proto_rawDescGZIP(), []int{19, 0}
 }
 
 func (x *CloudStatus_Gateway) GetUri() string {
@@ -2193,276 +2269,287 @@ var file_device_provisioning_service_pb_provisioningRecords_proto_rawDesc = []by
 	0x57, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x4a, 0x57, 0x54, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03,
 	0x43, 0x57, 0x54, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x42, 0x41, 0x53, 0x45, 0x36, 0x34, 0x10,
 	0x04, 0x12, 0x07, 0x0a, 0x03, 0x55, 0x52, 0x49, 0x10, 0x05, 0x12, 0x07, 0x0a, 0x03, 0x50, 0x45,
-	0x4d, 0x10, 0x06, 0x12, 0x07, 0x0a, 0x03, 0x44, 0x45, 0x52, 0x10, 0x07, 0x22, 0x44, 0x0a, 0x10,
-	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x44,
-	0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x12,
-	0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f,
-	0x6c, 0x65, 0x22, 0x9e, 0x09, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
-	0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x4b, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32,
-	0x37, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f,
-	0x6e, 0x69, 0x6e, 0x67, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x43,
-	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x61, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18,
-	0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x4e, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x38, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
-	0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
-	0x6c, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x55, 0x73, 0x61, 0x67,
-	0x65, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x7c, 0x0a, 0x19, 0x73, 0x75, 0x70, 0x70,
-	0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x6d, 0x65,
-	0x74, 0x68, 0x6f, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x40, 0x2e, 0x64, 0x65,
+	0x4d, 0x10, 0x06, 0x12, 0x07, 0x0a, 0x03, 0x44, 0x45, 0x52, 0x10, 0x07, 0x22, 0x71, 0x0a, 0x10,
+	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x4f, 0x53, 0x43, 0x4f, 0x52, 0x45,
+	0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a,
+	0x0c, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0b, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x69, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22,
+	0x44, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x6f, 0x6c,
+	0x65, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0xe6, 0x09, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x4b, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0e, 0x32, 0x37, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70,
+	0x62, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x2e, 0x43, 0x72, 0x65,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x4e, 0x0a, 0x05, 0x75,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x38, 0x2e, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x55,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x7c, 0x0a, 0x19, 0x73,
+	0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x40,
+	0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x69, 0x6e, 0x67, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72,
+	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x52, 0x17, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x52, 0x65, 0x66, 0x72, 0x65,
+	0x73, 0x68, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x12, 0x59, 0x0a, 0x0d, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x34, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x62, 0x2e,
+	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0c, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c,
+	0x44, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x56, 0x0a, 0x0c,
+	0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x33, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70,
+	0x62, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x44, 0x61, 0x74, 0x61, 0x12, 0x53, 0x0a, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x64, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0a, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x44, 0x61, 0x74, 0x61, 0x12, 0x47, 0x0a, 0x07, 0x72, 0x6f, 0x6c,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65,
+	0x49, 0x64, 0x12, 0x46, 0x0a, 0x06, 0x6f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70,
+	0x62, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x4f, 0x53, 0x43, 0x4f,
+	0x52, 0x45, 0x52, 0x06, 0x6f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0xbe, 0x01, 0x0a, 0x0e, 0x43,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a,
+	0x05, 0x45, 0x4d, 0x50, 0x54, 0x59, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x59, 0x4d, 0x4d,
+	0x45, 0x54, 0x52, 0x49, 0x43, 0x5f, 0x50, 0x41, 0x49, 0x52, 0x5f, 0x57, 0x49, 0x53, 0x45, 0x10,
+	0x01, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x59, 0x4d, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x5f, 0x47,
+	0x52, 0x4f, 0x55, 0x50, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x41, 0x53, 0x59, 0x4d, 0x4d, 0x45,
+	0x54, 0x52, 0x49, 0x43, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x04, 0x12, 0x27,
+	0x0a, 0x23, 0x41, 0x53, 0x59, 0x4d, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x5f, 0x53, 0x49, 0x47,
+	0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43, 0x45, 0x52, 0x54, 0x49, 0x46,
+	0x49, 0x43, 0x41, 0x54, 0x45, 0x10, 0x08, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x49, 0x4e, 0x5f, 0x4f,
+	0x52, 0x5f, 0x50, 0x41, 0x53, 0x53, 0x57, 0x4f, 0x52, 0x44, 0x10, 0x10, 0x12, 0x1d, 0x0a, 0x19,
+	0x41, 0x53, 0x59, 0x4d, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x5f, 0x45, 0x4e, 0x43, 0x52, 0x59,
+	0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4b, 0x45, 0x59, 0x10, 0x20, 0x22, 0x62, 0x0a, 0x0f, 0x43,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x08,
+	0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x54, 0x52, 0x55, 0x53,
+	0x54, 0x5f, 0x43, 0x41, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x43, 0x45, 0x52, 0x54, 0x10, 0x02,
+	0x12, 0x0d, 0x0a, 0x09, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x43, 0x45, 0x52, 0x54, 0x10, 0x03, 0x12,
+	0x10, 0x0a, 0x0c, 0x4d, 0x46, 0x47, 0x5f, 0x54, 0x52, 0x55, 0x53, 0x54, 0x5f, 0x43, 0x41, 0x10,
+	0x04, 0x12, 0x0c, 0x0a, 0x08, 0x4d, 0x46, 0x47, 0x5f, 0x43, 0x45, 0x52, 0x54, 0x10, 0x05, 0x22,
+	0xbc, 0x01, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65,
+	0x66, 0x72, 0x65, 0x73, 0x68, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x0b, 0x0a, 0x07, 0x55,
+	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x52, 0x4f, 0x56,
+	0x49, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x10, 0x01, 0x12,
+	0x29, 0x0a, 0x25, 0x4b, 0x45, 0x59, 0x5f, 0x41, 0x47, 0x52, 0x45, 0x45, 0x4d, 0x45, 0x4e, 0x54,
+	0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x41, 0x4e, 0x44, 0x5f, 0x52, 0x41,
+	0x4e, 0x44, 0x4f, 0x4d, 0x5f, 0x50, 0x49, 0x4e, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x4b, 0x45,
+	0x59, 0x5f, 0x41, 0x47, 0x52, 0x45, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x50, 0x52, 0x4f, 0x54,
+	0x4f, 0x43, 0x4f, 0x4c, 0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18, 0x4b, 0x45, 0x59, 0x5f, 0x44, 0x49,
+	0x53, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49,
+	0x43, 0x45, 0x10, 0x04, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x4b, 0x43, 0x53, 0x31, 0x30, 0x5f, 0x52,
+	0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x54, 0x4f, 0x5f, 0x43, 0x41, 0x10, 0x05, 0x22, 0xb1,
+	0x02, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x45, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x38, 0x0a, 0x18, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x5f, 0x70, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x50, 0x65, 0x6d, 0x12, 0x50, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x5f, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x64, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e,
+	0x67, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x65, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x53, 0x68, 0x61,
+	0x72, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x12, 0x4a, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x64, 0x65,
 	0x76, 0x69, 0x63, 0x65, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67,
 	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
-	0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x17, 0x73,
-	0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x4d,
-	0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x12, 0x59, 0x0a, 0x0d, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x61, 0x6c, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e,
-	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69,
-	0x6e, 0x67, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x44,
-	0x61, 0x74, 0x61, 0x52, 0x0c, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74,
-	0x61, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x56, 0x0a, 0x0c, 0x70, 0x72, 0x69,
-	0x76, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x33, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f,
-	0x6e, 0x69, 0x6e, 0x67, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x43,
-	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
-	0x44, 0x61, 0x74, 0x61, 0x52, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74,
-	0x61, 0x12, 0x53, 0x0a, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x64, 0x61, 0x74, 0x61,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x70,
-	0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
-	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0a, 0x70, 0x75, 0x62, 0x6c,
-	0x69, 0x63, 0x44, 0x61, 0x74, 0x61, 0x12, 0x47, 0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69,
-	0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
-	0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
-	0x6c, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x22,
-	0xbe, 0x01, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x4d, 0x50, 0x54, 0x59, 0x10, 0x00, 0x12, 0x17, 0x0a,
-	0x13, 0x53, 0x59, 0x4d, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x5f, 0x50, 0x41, 0x49, 0x52, 0x5f,
-	0x57, 0x49, 0x53, 0x45, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x59, 0x4d, 0x4d, 0x45, 0x54,
-	0x52, 0x49, 0x43, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x41,
-	0x53, 0x59, 0x4d, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x49, 0x4e,
-	0x47, 0x10, 0x04, 0x12, 0x27, 0x0a, 0x23, 0x41, 0x53, 0x59, 0x4d, 0x4d, 0x45, 0x54, 0x52, 0x49,
-	0x43, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x43,
-	0x45, 0x52, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x45, 0x10, 0x08, 0x12, 0x13, 0x0a, 0x0f,
-	0x50, 0x49, 0x4e, 0x5f, 0x4f, 0x52, 0x5f, 0x50, 0x41, 0x53, 0x53, 0x57, 0x4f, 0x52, 0x44, 0x10,
-	0x10, 0x12, 0x1d, 0x0a, 0x19, 0x41, 0x53, 0x59, 0x4d, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x5f,
-	0x45, 0x4e, 0x43, 0x52, 0x59, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4b, 0x45, 0x59, 0x10, 0x20,
-	0x22, 0x62, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x55, 0x73,
-	0x61, 0x67, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0c, 0x0a,
-	0x08, 0x54, 0x52, 0x55, 0x53, 0x54, 0x5f, 0x43, 0x41, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x43,
-	0x45, 0x52, 0x54, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x43, 0x45,
-	0x52, 0x54, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x4d, 0x46, 0x47, 0x5f, 0x54, 0x52, 0x55, 0x53,
-	0x54, 0x5f, 0x43, 0x41, 0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x4d, 0x46, 0x47, 0x5f, 0x43, 0x45,
-	0x52, 0x54, 0x10, 0x05, 0x22, 0xbc, 0x01, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x61, 0x6c, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64,
-	0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x15, 0x0a,
-	0x11, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49,
-	0x43, 0x45, 0x10, 0x01, 0x12, 0x29, 0x0a, 0x25, 0x4b, 0x45, 0x59, 0x5f, 0x41, 0x47, 0x52, 0x45,
-	0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x41,
-	0x4e, 0x44, 0x5f, 0x52, 0x41, 0x4e, 0x44, 0x4f, 0x4d, 0x5f, 0x50, 0x49, 0x4e, 0x10, 0x02, 0x12,
-	0x1a, 0x0a, 0x16, 0x4b, 0x45, 0x59, 0x5f, 0x41, 0x47, 0x52, 0x45, 0x45, 0x4d, 0x45, 0x4e, 0x54,
-	0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18, 0x4b,
-	0x45, 0x59, 0x5f, 0x44, 0x49, 0x53, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
-	0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x10, 0x04, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x4b, 0x43,
-	0x53, 0x31, 0x30, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x54, 0x4f, 0x5f, 0x43,
-	0x41, 0x10, 0x05, 0x22, 0xb1, 0x02, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x45, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x73, 0x22, 0x6e, 0x0a, 0x0f, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x45, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x22, 0x39, 0x0a, 0x1a, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x22, 0x4c, 0x0a,
+	0x18, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x6f,
+	0x6c, 0x65, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0xb3, 0x01, 0x0a, 0x1e,
+	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x5f,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x4b, 0x2e, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e,
+	0x67, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22,
+	0x30, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x0e, 0x0a, 0x0a, 0x41, 0x4e, 0x4f, 0x4e, 0x5f, 0x43, 0x4c, 0x45, 0x41, 0x52, 0x10,
+	0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x43, 0x52, 0x59, 0x50, 0x54, 0x10,
+	0x01, 0x22, 0xa7, 0x02, 0x0a, 0x15, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68,
+	0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x72, 0x65, 0x66, 0x12,
+	0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x12, 0x58, 0x0a, 0x08, 0x77, 0x69, 0x6c, 0x64, 0x63, 0x61,
+	0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3c, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x57, 0x69,
+	0x6c, 0x64, 0x63, 0x61, 0x72, 0x64, 0x52, 0x08, 0x77, 0x69, 0x6c, 0x64, 0x63, 0x61, 0x72, 0x64,
+	0x22, 0x59, 0x0a, 0x08, 0x57, 0x69, 0x6c, 0x64, 0x63, 0x61, 0x72, 0x64, 0x12, 0x08, 0x0a, 0x04,
+	0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x4e, 0x4f, 0x4e, 0x43, 0x46, 0x47,
+	0x5f, 0x53, 0x45, 0x43, 0x5f, 0x45, 0x4e, 0x44, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x10, 0x01, 0x12,
+	0x1a, 0x0a, 0x16, 0x4e, 0x4f, 0x4e, 0x43, 0x46, 0x47, 0x5f, 0x4e, 0x4f, 0x4e, 0x53, 0x45, 0x43,
+	0x5f, 0x45, 0x4e, 0x44, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x4e,
+	0x4f, 0x4e, 0x43, 0x46, 0x47, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x03, 0x22, 0xac, 0x04, 0x0a, 0x0d,
+	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x5f, 0x0a,
+	0x0e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52,
+	0x0d, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x59,
+	0x0a, 0x0c, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x52, 0x6f, 0x6c, 0x65, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x0b, 0x72, 0x6f,
+	0x6c, 0x65, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x6b, 0x0a, 0x12, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x58, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x36, 0x2e, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x51, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x73, 0x22, 0x45, 0x0a, 0x0a, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x10, 0x00, 0x12, 0x08, 0x0a,
+	0x04, 0x52, 0x45, 0x41, 0x44, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x57, 0x52, 0x49, 0x54, 0x45,
+	0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x03, 0x12, 0x0a,
+	0x0a, 0x06, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x59, 0x10, 0x04, 0x22, 0xaf, 0x01, 0x0a, 0x09, 0x41,
+	0x43, 0x4c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x45, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
 	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63,
 	0x65, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x65, 0x72,
 	0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f,
 	0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x38, 0x0a, 0x18, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x63, 0x65, 0x72, 0x74,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x16, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x65, 0x72, 0x74, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x50, 0x65, 0x6d, 0x12, 0x50, 0x0a, 0x0e, 0x70, 0x72, 0x65,
-	0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x2a, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73,
-	0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x62,
-	0x2e, 0x50, 0x72, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x52, 0x0c, 0x70,
-	0x72, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x12, 0x4a, 0x0a, 0x0b, 0x63,
-	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x28, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69,
-	0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x62, 0x2e,
-	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x22, 0x6e, 0x0a, 0x0f, 0x4f, 0x77, 0x6e, 0x65, 0x72,
-	0x73, 0x68, 0x69, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x45, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x64, 0x65, 0x76,
-	0x69, 0x63, 0x65, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73,
-	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x22, 0x39, 0x0a, 0x1a, 0x41, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x75,
-	0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
-	0x49, 0x64, 0x22, 0x4c, 0x0a, 0x18, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74,
-	0x72, 0x6f, 0x6c, 0x52, 0x6f, 0x6c, 0x65, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1c,
-	0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04,
-	0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65,
-	0x22, 0xb3, 0x01, 0x0a, 0x1e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72,
-	0x6f, 0x6c, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x62, 0x6a,
-	0x65, 0x63, 0x74, 0x12, 0x5f, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x4b, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73,
-	0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x62,
-	0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x43, 0x6f,
-	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e,
-	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x22, 0x30, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x0a, 0x41, 0x4e, 0x4f, 0x4e, 0x5f, 0x43,
-	0x4c, 0x45, 0x41, 0x52, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x43,
-	0x52, 0x59, 0x50, 0x54, 0x10, 0x01, 0x22, 0xa7, 0x02, 0x0a, 0x15, 0x41, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x68, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x68, 0x72, 0x65, 0x66, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x69,
-	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x12, 0x58, 0x0a, 0x08, 0x77,
-	0x69, 0x6c, 0x64, 0x63, 0x61, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3c, 0x2e,
-	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69,
-	0x6e, 0x67, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x2e, 0x57, 0x69, 0x6c, 0x64, 0x63, 0x61, 0x72, 0x64, 0x52, 0x08, 0x77, 0x69, 0x6c,
-	0x64, 0x63, 0x61, 0x72, 0x64, 0x22, 0x59, 0x0a, 0x08, 0x57, 0x69, 0x6c, 0x64, 0x63, 0x61, 0x72,
-	0x64, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x4e,
-	0x4f, 0x4e, 0x43, 0x46, 0x47, 0x5f, 0x53, 0x45, 0x43, 0x5f, 0x45, 0x4e, 0x44, 0x50, 0x4f, 0x49,
-	0x4e, 0x54, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x4e, 0x4f, 0x4e, 0x43, 0x46, 0x47, 0x5f, 0x4e,
-	0x4f, 0x4e, 0x53, 0x45, 0x43, 0x5f, 0x45, 0x4e, 0x44, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x10, 0x02,
-	0x12, 0x0e, 0x0a, 0x0a, 0x4e, 0x4f, 0x4e, 0x43, 0x46, 0x47, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x03,
-	0x22, 0xac, 0x04, 0x0a, 0x0d, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72,
-	0x6f, 0x6c, 0x12, 0x5f, 0x0a, 0x0e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x73, 0x75, 0x62,
-	0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x64, 0x65, 0x76,
-	0x69, 0x63, 0x65, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x75, 0x62,
-	0x6a, 0x65, 0x63, 0x74, 0x52, 0x0d, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x75, 0x62, 0x6a,
-	0x65, 0x63, 0x74, 0x12, 0x59, 0x0a, 0x0c, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x73, 0x75, 0x62, 0x6a,
-	0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x64, 0x65, 0x76, 0x69,
-	0x63, 0x65, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43,
-	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x6f, 0x6c, 0x65, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63,
-	0x74, 0x52, 0x0b, 0x72, 0x6f, 0x6c, 0x65, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x6b,
-	0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x75, 0x62,
-	0x6a, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x64, 0x65, 0x76,
+	0x5b, 0x0a, 0x13, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e,
+	0x67, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x11, 0x61, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x22, 0xba, 0x02, 0x0a,
+	0x0b, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x45, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e,
+	0x67, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x4d, 0x0a, 0x08, 0x67, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x64, 0x65, 0x76,
 	0x69, 0x63, 0x65, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x58, 0x0a, 0x0b, 0x70,
-	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0e,
-	0x32, 0x36, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69,
-	0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x62, 0x2e,
-	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x50, 0x65,
-	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x51, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63,
-	0x65, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f,
-	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x09, 0x72,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x22, 0x45, 0x0a, 0x0a, 0x50, 0x65, 0x72, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45,
-	0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x52, 0x45, 0x41, 0x44, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05,
-	0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x45, 0x4c, 0x45, 0x54,
-	0x45, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x59, 0x10, 0x04, 0x22,
-	0xaf, 0x01, 0x0a, 0x09, 0x41, 0x43, 0x4c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x45, 0x0a,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e,
-	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69,
-	0x6e, 0x67, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f,
-	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x5b, 0x0a, 0x13, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x63,
-	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x2b, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73,
-	0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x62,
-	0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x11,
-	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x4c, 0x69, 0x73,
-	0x74, 0x22, 0xba, 0x02, 0x0a, 0x0b, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x45, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x2d, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73,
-	0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x62,
-	0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x76,
-	0x69, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x4d, 0x0a,
-	0x08, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x31, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f,
-	0x6e, 0x69, 0x6e, 0x67, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x43,
-	0x6c, 0x6f, 0x75, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77,
-	0x61, 0x79, 0x52, 0x08, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x73, 0x12, 0x29, 0x0a, 0x10,
-	0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64,
-	0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x1a, 0x2b, 0x0a, 0x07, 0x47, 0x61, 0x74, 0x65, 0x77,
-	0x61, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x75, 0x72, 0x69, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05,
-	0x52, 0x0c, 0x63, 0x6f, 0x61, 0x70, 0x5f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x22, 0x87,
-	0x05, 0x0a, 0x12, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x52,
-	0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
-	0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74,
-	0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x11, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64,
-	0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x4b, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x65, 0x73,
-	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x64,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x08, 0x67,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x5f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x47, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x1a, 0x2b, 0x0a, 0x07, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x4a,
+	0x04, 0x08, 0x02, 0x10, 0x03, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x52, 0x0c, 0x63, 0x6f, 0x61,
+	0x70, 0x5f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x22, 0x87, 0x05, 0x0a, 0x12, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x2e, 0x0a,
+	0x13, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x65, 0x6e, 0x72, 0x6f,
+	0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x23, 0x0a,
+	0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61,
+	0x74, 0x65, 0x12, 0x4b, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x4e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12,
+	0x39, 0x0a, 0x03, 0x61, 0x63, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x64,
 	0x65, 0x76, 0x69, 0x63, 0x65, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e,
-	0x67, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x74, 0x74, 0x65,
-	0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63,
-	0x65, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x61, 0x6c, 0x12, 0x39, 0x0a, 0x03, 0x61, 0x63, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x27, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73,
-	0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x62,
-	0x2e, 0x41, 0x43, 0x4c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x03, 0x61, 0x63, 0x6c, 0x12,
-	0x3f, 0x0a, 0x05, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29,
+	0x67, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x43, 0x4c, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x03, 0x61, 0x63, 0x6c, 0x12, 0x3f, 0x0a, 0x05, 0x63, 0x6c,
+	0x6f, 0x75, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x64, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x05, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x12, 0x4b, 0x0a, 0x09, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d,
 	0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
-	0x69, 0x6e, 0x67, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6c,
-	0x6f, 0x75, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x05, 0x63, 0x6c, 0x6f, 0x75, 0x64,
-	0x12, 0x4b, 0x0a, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x70, 0x72, 0x6f, 0x76,
-	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x70, 0x62, 0x2e, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x52, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x4a, 0x0a,
-	0x09, 0x70, 0x6c, 0x67, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x2d, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69,
-	0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x62, 0x2e,
-	0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
-	0x08, 0x70, 0x6c, 0x67, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6c, 0x6f, 0x63,
-	0x61, 0x6c, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x22, 0xa6, 0x01, 0x0a, 0x20, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x52,
-	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
-	0x09, 0x69, 0x64, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x08, 0x69, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x10, 0x64, 0x65,
-	0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x46, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x12, 0x3b, 0x0a, 0x1a, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65,
-	0x6e, 0x74, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x5f, 0x66, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x17, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c,
-	0x6d, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x22, 0x39, 0x0a, 0x21, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69,
-	0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x3e, 0x5a, 0x3c,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x6c, 0x67, 0x64, 0x2d,
-	0x64, 0x65, 0x76, 0x2f, 0x68, 0x75, 0x62, 0x2f, 0x76, 0x32, 0x2f, 0x64, 0x65, 0x76, 0x69, 0x63,
-	0x65, 0x2d, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x2d, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x62, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x69, 0x6e, 0x67, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x77,
+	0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x09, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x4a, 0x0a, 0x09, 0x70, 0x6c, 0x67, 0x64,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x08, 0x70, 0x6c, 0x67, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x65, 0x6e,
+	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x6c,
+	0x6f, 0x63, 0x61, 0x6c, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x22, 0xa6, 0x01, 0x0a, 0x20, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x64, 0x5f, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x69, 0x64, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x10, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f,
+	0x69, 0x64, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
+	0x3b, 0x0a, 0x1a, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x17, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x39, 0x0a, 0x21,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x3e, 0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x6c, 0x67, 0x64, 0x2d, 0x64, 0x65, 0x76, 0x2f, 0x68,
+	0x75, 0x62, 0x2f, 0x76, 0x32, 0x2f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x2d, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2f, 0x70, 0x62, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2478,7 +2565,7 @@ func file_device_provisioning_service_pb_provisioningRecords_proto_rawDescGZIP()
 }
 
 var file_device_provisioning_service_pb_provisioningRecords_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
-var file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
+var file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_device_provisioning_service_pb_provisioningRecords_proto_goTypes = []any{
 	(CredentialOptionalData_Encoding)(0),               // 0: deviceprovisioningservice.pb.CredentialOptionalData.Encoding
 	(CredentialPrivateData_Encoding)(0),                // 1: deviceprovisioningservice.pb.CredentialPrivateData.Encoding
@@ -2497,21 +2584,22 @@ var file_device_provisioning_service_pb_provisioningRecords_proto_goTypes = []an
 	(*CredentialOptionalData)(nil),                     // 14: deviceprovisioningservice.pb.CredentialOptionalData
 	(*CredentialPrivateData)(nil),                      // 15: deviceprovisioningservice.pb.CredentialPrivateData
 	(*CredentialPublicData)(nil),                       // 16: deviceprovisioningservice.pb.CredentialPublicData
-	(*CredentialRoleID)(nil),                           // 17: deviceprovisioningservice.pb.CredentialRoleID
-	(*Credential)(nil),                                 // 18: deviceprovisioningservice.pb.Credential
-	(*CredentialStatus)(nil),                           // 19: deviceprovisioningservice.pb.CredentialStatus
-	(*OwnershipStatus)(nil),                            // 20: deviceprovisioningservice.pb.OwnershipStatus
-	(*AccessControlDeviceSubject)(nil),                 // 21: deviceprovisioningservice.pb.AccessControlDeviceSubject
-	(*AccessControlRoleSubject)(nil),                   // 22: deviceprovisioningservice.pb.AccessControlRoleSubject
-	(*AccessControlConnectionSubject)(nil),             // 23: deviceprovisioningservice.pb.AccessControlConnectionSubject
-	(*AccessControlResource)(nil),                      // 24: deviceprovisioningservice.pb.AccessControlResource
-	(*AccessControl)(nil),                              // 25: deviceprovisioningservice.pb.AccessControl
-	(*ACLStatus)(nil),                                  // 26: deviceprovisioningservice.pb.ACLStatus
-	(*CloudStatus)(nil),                                // 27: deviceprovisioningservice.pb.CloudStatus
-	(*ProvisioningRecord)(nil),                         // 28: deviceprovisioningservice.pb.ProvisioningRecord
-	(*DeleteProvisioningRecordsRequest)(nil),           // 29: deviceprovisioningservice.pb.DeleteProvisioningRecordsRequest
-	(*DeleteProvisioningRecordsResponse)(nil),          // 30: deviceprovisioningservice.pb.DeleteProvisioningRecordsResponse
-	(*CloudStatus_Gateway)(nil),                        // 31: deviceprovisioningservice.pb.CloudStatus.Gateway
+	(*CredentialOSCORE)(nil),                           // 17: deviceprovisioningservice.pb.CredentialOSCORE
+	(*CredentialRoleID)(nil),                           // 18: deviceprovisioningservice.pb.CredentialRoleID
+	(*Credential)(nil),                                 // 19: deviceprovisioningservice.pb.Credential
+	(*CredentialStatus)(nil),                           // 20: deviceprovisioningservice.pb.CredentialStatus
+	(*OwnershipStatus)(nil),                            // 21: deviceprovisioningservice.pb.OwnershipStatus
+	(*AccessControlDeviceSubject)(nil),                 // 22: deviceprovisioningservice.pb.AccessControlDeviceSubject
+	(*AccessControlRoleSubject)(nil),                   // 23: deviceprovisioningservice.pb.AccessControlRoleSubject
+	(*AccessControlConnectionSubject)(nil),             // 24: deviceprovisioningservice.pb.AccessControlConnectionSubject
+	(*AccessControlResource)(nil),                      // 25: deviceprovisioningservice.pb.AccessControlResource
+	(*AccessControl)(nil),                              // 26: deviceprovisioningservice.pb.AccessControl
+	(*ACLStatus)(nil),                                  // 27: deviceprovisioningservice.pb.ACLStatus
+	(*CloudStatus)(nil),                                // 28: deviceprovisioningservice.pb.CloudStatus
+	(*ProvisioningRecord)(nil),                         // 29: deviceprovisioningservice.pb.ProvisioningRecord
+	(*DeleteProvisioningRecordsRequest)(nil),           // 30: deviceprovisioningservice.pb.DeleteProvisioningRecordsRequest
+	(*DeleteProvisioningRecordsResponse)(nil),          // 31: deviceprovisioningservice.pb.DeleteProvisioningRecordsResponse
+	(*CloudStatus_Gateway)(nil),                        // 32: deviceprovisioningservice.pb.CloudStatus.Gateway
 }
 var file_device_provisioning_service_pb_provisioningRecords_proto_depIdxs = []int32{
 	11, // 0: deviceprovisioningservice.pb.Attestation.x509:type_name -> deviceprovisioningservice.pb.X509Attestation
@@ -2524,33 +2612,34 @@ var file_device_provisioning_service_pb_provisioningRecords_proto_depIdxs = []in
 	14, // 7: deviceprovisioningservice.pb.Credential.optional_data:type_name -> deviceprovisioningservice.pb.CredentialOptionalData
 	15, // 8: deviceprovisioningservice.pb.Credential.private_data:type_name -> deviceprovisioningservice.pb.CredentialPrivateData
 	16, // 9: deviceprovisioningservice.pb.Credential.public_data:type_name -> deviceprovisioningservice.pb.CredentialPublicData
-	17, // 10: deviceprovisioningservice.pb.Credential.role_id:type_name -> deviceprovisioningservice.pb.CredentialRoleID
-	12, // 11: deviceprovisioningservice.pb.CredentialStatus.status:type_name -> deviceprovisioningservice.pb.ProvisionStatus
-	13, // 12: deviceprovisioningservice.pb.CredentialStatus.pre_shared_key:type_name -> deviceprovisioningservice.pb.PreSharedKey
-	18, // 13: deviceprovisioningservice.pb.CredentialStatus.credentials:type_name -> deviceprovisioningservice.pb.Credential
-	12, // 14: deviceprovisioningservice.pb.OwnershipStatus.status:type_name -> deviceprovisioningservice.pb.ProvisionStatus
-	6,  // 15: deviceprovisioningservice.pb.AccessControlConnectionSubject.type:type_name -> deviceprovisioningservice.pb.AccessControlConnectionSubject.ConnectionType
-	7,  // 16: deviceprovisioningservice.pb.AccessControlResource.wildcard:type_name -> deviceprovisioningservice.pb.AccessControlResource.Wildcard
-	21, // 17: deviceprovisioningservice.pb.AccessControl.device_subject:type_name -> deviceprovisioningservice.pb.AccessControlDeviceSubject
-	22, // 18: deviceprovisioningservice.pb.AccessControl.role_subject:type_name -> deviceprovisioningservice.pb.AccessControlRoleSubject
-	23, // 19: deviceprovisioningservice.pb.AccessControl.connection_subject:type_name -> deviceprovisioningservice.pb.AccessControlConnectionSubject
-	8,  // 20: deviceprovisioningservice.pb.AccessControl.permissions:type_name -> deviceprovisioningservice.pb.AccessControl.Permission
-	24, // 21: deviceprovisioningservice.pb.AccessControl.resources:type_name -> deviceprovisioningservice.pb.AccessControlResource
-	12, // 22: deviceprovisioningservice.pb.ACLStatus.status:type_name -> deviceprovisioningservice.pb.ProvisionStatus
-	25, // 23: deviceprovisioningservice.pb.ACLStatus.access_control_list:type_name -> deviceprovisioningservice.pb.AccessControl
-	12, // 24: deviceprovisioningservice.pb.CloudStatus.status:type_name -> deviceprovisioningservice.pb.ProvisionStatus
-	31, // 25: deviceprovisioningservice.pb.CloudStatus.gateways:type_name -> deviceprovisioningservice.pb.CloudStatus.Gateway
-	10, // 26: deviceprovisioningservice.pb.ProvisioningRecord.attestation:type_name -> deviceprovisioningservice.pb.Attestation
-	19, // 27: deviceprovisioningservice.pb.ProvisioningRecord.credential:type_name -> deviceprovisioningservice.pb.CredentialStatus
-	26, // 28: deviceprovisioningservice.pb.ProvisioningRecord.acl:type_name -> deviceprovisioningservice.pb.ACLStatus
-	27, // 29: deviceprovisioningservice.pb.ProvisioningRecord.cloud:type_name -> deviceprovisioningservice.pb.CloudStatus
-	20, // 30: deviceprovisioningservice.pb.ProvisioningRecord.ownership:type_name -> deviceprovisioningservice.pb.OwnershipStatus
-	12, // 31: deviceprovisioningservice.pb.ProvisioningRecord.plgd_time:type_name -> deviceprovisioningservice.pb.ProvisionStatus
-	32, // [32:32] is the sub-list for method output_type
-	32, // [32:32] is the sub-list for method input_type
-	32, // [32:32] is the sub-list for extension type_name
-	32, // [32:32] is the sub-list for extension extendee
-	0,  // [0:32] is the sub-list for field type_name
+	18, // 10: deviceprovisioningservice.pb.Credential.role_id:type_name -> deviceprovisioningservice.pb.CredentialRoleID
+	17, // 11: deviceprovisioningservice.pb.Credential.oscore:type_name -> deviceprovisioningservice.pb.CredentialOSCORE
+	12, // 12: deviceprovisioningservice.pb.CredentialStatus.status:type_name -> deviceprovisioningservice.pb.ProvisionStatus
+	13, // 13: deviceprovisioningservice.pb.CredentialStatus.pre_shared_key:type_name -> deviceprovisioningservice.pb.PreSharedKey
+	19, // 14: deviceprovisioningservice.pb.CredentialStatus.credentials:type_name -> deviceprovisioningservice.pb.Credential
+	12, // 15: deviceprovisioningservice.pb.OwnershipStatus.status:type_name -> deviceprovisioningservice.pb.ProvisionStatus
+	6,  // 16: deviceprovisioningservice.pb.AccessControlConnectionSubject.type:type_name -> deviceprovisioningservice.pb.AccessControlConnectionSubject.ConnectionType
+	7,  // 17: deviceprovisioningservice.pb.AccessControlResource.wildcard:type_name -> deviceprovisioningservice.pb.AccessControlResource.Wildcard
+	22, // 18: deviceprovisioningservice.pb.AccessControl.device_subject:type_name -> deviceprovisioningservice.pb.AccessControlDeviceSubject
+	23, // 19: deviceprovisioningservice.pb.AccessControl.role_subject:type_name -> deviceprovisioningservice.pb.AccessControlRoleSubject
+	24, // 20: deviceprovisioningservice.pb.AccessControl.connection_subject:type_name -> deviceprovisioningservice.pb.AccessControlConnectionSubject
+	8,  // 21: deviceprovisioningservice.pb.AccessControl.permissions:type_name -> deviceprovisioningservice.pb.AccessControl.Permission
+	25, // 22: deviceprovisioningservice.pb.AccessControl.resources:type_name -> deviceprovisioningservice.pb.AccessControlResource
+	12, // 23: deviceprovisioningservice.pb.ACLStatus.status:type_name -> deviceprovisioningservice.pb.ProvisionStatus
+	26, // 24: deviceprovisioningservice.pb.ACLStatus.access_control_list:type_name -> deviceprovisioningservice.pb.AccessControl
+	12, // 25: deviceprovisioningservice.pb.CloudStatus.status:type_name -> deviceprovisioningservice.pb.ProvisionStatus
+	32, // 26: deviceprovisioningservice.pb.CloudStatus.gateways:type_name -> deviceprovisioningservice.pb.CloudStatus.Gateway
+	10, // 27: deviceprovisioningservice.pb.ProvisioningRecord.attestation:type_name -> deviceprovisioningservice.pb.Attestation
+	20, // 28: deviceprovisioningservice.pb.ProvisioningRecord.credential:type_name -> deviceprovisioningservice.pb.CredentialStatus
+	27, // 29: deviceprovisioningservice.pb.ProvisioningRecord.acl:type_name -> deviceprovisioningservice.pb.ACLStatus
+	28, // 30: deviceprovisioningservice.pb.ProvisioningRecord.cloud:type_name -> deviceprovisioningservice.pb.CloudStatus
+	21, // 31: deviceprovisioningservice.pb.ProvisioningRecord.ownership:type_name -> deviceprovisioningservice.pb.OwnershipStatus
+	12, // 32: deviceprovisioningservice.pb.ProvisioningRecord.plgd_time:type_name -> deviceprovisioningservice.pb.ProvisionStatus
+	33, // [33:33] is the sub-list for method output_type
+	33, // [33:33] is the sub-list for method input_type
+	33, // [33:33] is the sub-list for extension type_name
+	33, // [33:33] is the sub-list for extension extendee
+	0,  // [0:33] is the sub-list for field type_name
 }
 
 func init() { file_device_provisioning_service_pb_provisioningRecords_proto_init() }
@@ -2656,7 +2745,7 @@ func file_device_provisioning_service_pb_provisioningRecords_proto_init() {
 			}
 		}
 		file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*CredentialRoleID); i {
+			switch v := v.(*CredentialOSCORE); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2668,7 +2757,7 @@ func file_device_provisioning_service_pb_provisioningRecords_proto_init() {
 			}
 		}
 		file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[9].Exporter = func(v any, i int) any {
-			switch v := v.(*Credential); i {
+			switch v := v.(*CredentialRoleID); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2680,7 +2769,7 @@ func file_device_provisioning_service_pb_provisioningRecords_proto_init() {
 			}
 		}
 		file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[10].Exporter = func(v any, i int) any {
-			switch v := v.(*CredentialStatus); i {
+			switch v := v.(*Credential); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2692,7 +2781,7 @@ func file_device_provisioning_service_pb_provisioningRecords_proto_init() {
 			}
 		}
 		file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[11].Exporter = func(v any, i int) any {
-			switch v := v.(*OwnershipStatus); i {
+			switch v := v.(*CredentialStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2704,7 +2793,7 @@ func file_device_provisioning_service_pb_provisioningRecords_proto_init() {
 			}
 		}
 		file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[12].Exporter = func(v any, i int) any {
-			switch v := v.(*AccessControlDeviceSubject); i {
+			switch v := v.(*OwnershipStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2716,7 +2805,7 @@ func file_device_provisioning_service_pb_provisioningRecords_proto_init() {
 			}
 		}
 		file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[13].Exporter = func(v any, i int) any {
-			switch v := v.(*AccessControlRoleSubject); i {
+			switch v := v.(*AccessControlDeviceSubject); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2728,7 +2817,7 @@ func file_device_provisioning_service_pb_provisioningRecords_proto_init() {
 			}
 		}
 		file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[14].Exporter = func(v any, i int) any {
-			switch v := v.(*AccessControlConnectionSubject); i {
+			switch v := v.(*AccessControlRoleSubject); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2740,7 +2829,7 @@ func file_device_provisioning_service_pb_provisioningRecords_proto_init() {
 			}
 		}
 		file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[15].Exporter = func(v any, i int) any {
-			switch v := v.(*AccessControlResource); i {
+			switch v := v.(*AccessControlConnectionSubject); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2752,7 +2841,7 @@ func file_device_provisioning_service_pb_provisioningRecords_proto_init() {
 			}
 		}
 		file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[16].Exporter = func(v any, i int) any {
-			switch v := v.(*AccessControl); i {
+			switch v := v.(*AccessControlResource); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2764,7 +2853,7 @@ func file_device_provisioning_service_pb_provisioningRecords_proto_init() {
 			}
 		}
 		file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[17].Exporter = func(v any, i int) any {
-			switch v := v.(*ACLStatus); i {
+			switch v := v.(*AccessControl); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2776,7 +2865,7 @@ func file_device_provisioning_service_pb_provisioningRecords_proto_init() {
 			}
 		}
 		file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[18].Exporter = func(v any, i int) any {
-			switch v := v.(*CloudStatus); i {
+			switch v := v.(*ACLStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2788,7 +2877,7 @@ func file_device_provisioning_service_pb_provisioningRecords_proto_init() {
 			}
 		}
 		file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[19].Exporter = func(v any, i int) any {
-			switch v := v.(*ProvisioningRecord); i {
+			switch v := v.(*CloudStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2800,7 +2889,7 @@ func file_device_provisioning_service_pb_provisioningRecords_proto_init() {
 			}
 		}
 		file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[20].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteProvisioningRecordsRequest); i {
+			switch v := v.(*ProvisioningRecord); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2812,7 +2901,7 @@ func file_device_provisioning_service_pb_provisioningRecords_proto_init() {
 			}
 		}
 		file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[21].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteProvisioningRecordsResponse); i {
+			switch v := v.(*DeleteProvisioningRecordsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2824,6 +2913,18 @@ func file_device_provisioning_service_pb_provisioningRecords_proto_init() {
 			}
 		}
 		file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteProvisioningRecordsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_device_provisioning_service_pb_provisioningRecords_proto_msgTypes[23].Exporter = func(v any, i int) any {
 			switch v := v.(*CloudStatus_Gateway); i {
 			case 0:
 				return &v.state
@@ -2842,7 +2943,7 @@ func file_device_provisioning_service_pb_provisioningRecords_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_device_provisioning_service_pb_provisioningRecords_proto_rawDesc,
 			NumEnums:      9,
-			NumMessages:   23,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
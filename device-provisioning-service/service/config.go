@@ -6,7 +6,6 @@ import (
 	"time"
 
 	"github.com/plgd-dev/hub/v2/device-provisioning-service/pb"
-	"github.com/plgd-dev/hub/v2/device-provisioning-service/security/oauth/clientcredentials"
 	"github.com/plgd-dev/hub/v2/device-provisioning-service/service/http"
 	"github.com/plgd-dev/hub/v2/device-provisioning-service/store/mongodb"
 	"github.com/plgd-dev/hub/v2/internal/math"
@@ -17,6 +16,8 @@ import (
 	"github.com/plgd-dev/hub/v2/pkg/net/grpc/client"
 	pkgHttp "github.com/plgd-dev/hub/v2/pkg/net/http"
 	pkgCertManagerClient "github.com/plgd-dev/hub/v2/pkg/security/certManager/client"
+	"github.com/plgd-dev/hub/v2/pkg/security/oauth2/clientcredentials"
+	"github.com/plgd-dev/hub/v2/pkg/security/oscore"
 	pkgTls "github.com/plgd-dev/hub/v2/pkg/security/tls"
 	"github.com/plgd-dev/hub/v2/pkg/strings"
 )
@@ -133,6 +134,8 @@ func (c *APIsConfig) Validate() error {
 
 type COAPConfig struct {
 	pkgCoapService.Config `yaml:",inline" json:",inline"`
+	// OSCORE provisions the OSCORE credential of the coap-gateway, the master key must be same as the master key of the coap-gateway.
+	OSCORE oscore.Config `yaml:"oscore" json:"oscore"`
 }
 
 func (c *COAPConfig) Validate() error {
@@ -140,6 +143,9 @@ func (c *COAPConfig) Validate() error {
 	c.TLS.Embedded.ClientCertificateRequired = true
 	enabled := true
 	c.TLS.Enabled = &enabled
+	if err := c.OSCORE.Validate(); err != nil {
+		return fmt.Errorf("oscore.%w", err)
+	}
 	return c.Config.Validate()
 }
 
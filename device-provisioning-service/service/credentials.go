@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"crypto/x509"
+	"encoding/hex"
 	"encoding/pem"
 	"errors"
 	"fmt"
@@ -19,11 +20,12 @@ import (
 	"github.com/plgd-dev/hub/v2/certificate-authority/pb"
 	"github.com/plgd-dev/hub/v2/coap-gateway/coapconv"
 	dpsPb "github.com/plgd-dev/hub/v2/device-provisioning-service/pb"
-	"github.com/plgd-dev/hub/v2/device-provisioning-service/security/oauth/clientcredentials"
 	"github.com/plgd-dev/hub/v2/device-provisioning-service/store"
 	"github.com/plgd-dev/hub/v2/identity-store/events"
 	"github.com/plgd-dev/hub/v2/pkg/log"
 	"github.com/plgd-dev/hub/v2/pkg/net/grpc"
+	"github.com/plgd-dev/hub/v2/pkg/security/oauth2/clientcredentials"
+	"github.com/plgd-dev/hub/v2/pkg/security/oscore"
 	"github.com/plgd-dev/kit/v2/codec/cbor"
 	"github.com/plgd-dev/kit/v2/security"
 )
@@ -38,6 +40,70 @@ type CredentialsRequest struct {
 	SelectedGateway cloud.Endpoint `json:"selectedGateway"`
 }
 
+// CredentialOSCORE is the OSCORE property of the credential, the identifiers are hex encoded.
+type CredentialOSCORE struct {
+	SenderID    string `json:"senderid"`
+	RecipientID string `json:"recipientid"`
+	IDContext   string `json:"idcontext,omitempty"`
+}
+
+// Credential extends the credential by the OSCORE property.
+type Credential struct {
+	credential.Credential
+	OSCORE *CredentialOSCORE `json:"oscore,omitempty"`
+}
+
+// CredentialsResponse contains the provisioned credentials of the device.
+type CredentialsResponse struct {
+	Credentials []Credential `json:"creds"`
+}
+
+// makeOSCORECredential creates the OSCORE credential of the device for the coap-gateway of the hub. The ID Context
+// is generated for each provisioning and the Master Secret is derived from it by the master key, so the coap-gateway
+// derives the same security context from the kid context of the device.
+func (s *Session) makeOSCORECredential(deviceID, hubID string) (Credential, error) {
+	idContext, err := oscore.NewDeviceIDContext(deviceID)
+	if err != nil {
+		return Credential{}, err
+	}
+	masterSecret, err := oscore.DeviceMasterSecret(s.server.oscoreMasterKey, idContext)
+	if err != nil {
+		return Credential{}, err
+	}
+	return Credential{
+		Credential: credential.Credential{
+			Subject: hubID,
+			Type:    credential.CredentialType_SYMMETRIC_PAIR_WISE,
+			PrivateData: &credential.CredentialPrivateData{
+				DataInternal: masterSecret,
+				Encoding:     credential.CredentialPrivateDataEncoding_RAW,
+			},
+			Tag: DPSTag,
+		},
+		OSCORE: &CredentialOSCORE{
+			SenderID:    hex.EncodeToString(oscore.DeviceSenderID),
+			RecipientID: hex.EncodeToString(oscore.HubSenderID),
+			IDContext:   hex.EncodeToString(idContext),
+		},
+	}, nil
+}
+
+// toPb converts the credential for the provisioning record. The master secret is derived on demand from the ID Context,
+// so it isn't stored.
+func (c Credential) toPb() *dpsPb.Credential {
+	cred := c.Credential
+	cred.PrivateData = nil
+	pbCred := dpsPb.CredentialToPb(cred)
+	if c.OSCORE == nil {
+		return pbCred
+	}
+	pbCred.Oscore = &dpsPb.CredentialOSCORE{}
+	pbCred.Oscore.SenderId, _ = hex.DecodeString(c.OSCORE.SenderID)
+	pbCred.Oscore.RecipientId, _ = hex.DecodeString(c.OSCORE.RecipientID)
+	pbCred.Oscore.IdContext, _ = hex.DecodeString(c.OSCORE.IDContext)
+	return pbCred
+}
+
 func (RequestHandle) ProcessCredentials(ctx context.Context, req *mux.Message, session *Session, linkedHubs []*LinkedHub, group *EnrollmentGroup) (*pool.Message, error) {
 	switch req.Code() {
 	case coapCodes.POST:
@@ -223,9 +289,25 @@ func provisionCredentials(ctx context.Context, req *mux.Message, session *Sessio
 		})
 	}
 
-	msgType, data, err := encodeResponse(credUpdResp, req.Options())
+	credResp := CredentialsResponse{
+		Credentials: make([]Credential, 0, len(credUpdResp.Credentials)+1),
+	}
+	for _, c := range credUpdResp.Credentials {
+		credResp.Credentials = append(credResp.Credentials, Credential{Credential: c})
+	}
+	credentials = dpsPb.CredentialsToPb(credUpdResp.Credentials)
+	if session.server.oscoreMasterKey != nil {
+		oscoreCred, errO := session.makeOSCORECredential(deviceID, linkedHub.cfg.GetHubId())
+		if errO != nil {
+			return nil, "", "", nil, nil, statusErrorf(coapCodes.InternalServerError, "cannot create oscore credential: %w", errO)
+		}
+		credResp.Credentials = append(credResp.Credentials, oscoreCred)
+		credentials = append(credentials, oscoreCred.toPb())
+	}
+
+	msgType, data, err := encodeResponse(credResp, req.Options())
 	if err != nil {
 		return nil, "", "", nil, nil, statusErrorf(coapCodes.BadRequest, "cannot encode credentials: %w", err)
 	}
-	return session.createResponse(coapCodes.Changed, req.Token(), msgType, data), deviceID, identityCertPem, psk, dpsPb.CredentialsToPb(credUpdResp.Credentials), nil
+	return session.createResponse(coapCodes.Changed, req.Token(), msgType, data), deviceID, identityCertPem, psk, credentials, nil
 }
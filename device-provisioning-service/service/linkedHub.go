@@ -7,10 +7,10 @@ import (
 
 	pbCA "github.com/plgd-dev/hub/v2/certificate-authority/pb"
 	"github.com/plgd-dev/hub/v2/device-provisioning-service/pb"
-	"github.com/plgd-dev/hub/v2/device-provisioning-service/security/oauth/clientcredentials"
 	"github.com/plgd-dev/hub/v2/pkg/fn"
 	"github.com/plgd-dev/hub/v2/pkg/fsnotify"
 	"github.com/plgd-dev/hub/v2/pkg/log"
+	"github.com/plgd-dev/hub/v2/pkg/security/oauth2/clientcredentials"
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/atomic"
 	"golang.org/x/oauth2"
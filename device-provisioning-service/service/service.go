@@ -44,6 +44,7 @@ type Service struct {
 	requestHandler        RequestHandler
 	tracerProvider        trace.TracerProvider
 	enrollmentGroupsCache *EnrollmentGroupsCache
+	oscoreMasterKey       []byte
 }
 
 const DPSTag = "dps"
@@ -169,6 +170,18 @@ func New(ctx context.Context, config Config, fileWatcher *fsnotify.Watcher, logg
 		}
 	}
 
+	var oscoreMasterKey []byte
+	if config.APIs.COAP.OSCORE.Enabled {
+		oscoreMasterKey, err = config.APIs.COAP.OSCORE.MasterKey()
+		if err != nil {
+			if httpService != nil {
+				httpService.Close()
+			}
+			closer.Execute()
+			return nil, fmt.Errorf("cannot load oscore master key: %w", err)
+		}
+	}
+
 	linkedHubCache := NewLinkedHubCache(ctx, config.Clients.Storage.CacheExpiration, store, fileWatcher, logger, tracerProvider)
 	s := Service{
 		config:         config,
@@ -184,6 +197,7 @@ func New(ctx context.Context, config Config, fileWatcher *fsnotify.Watcher, logg
 		requestHandler:        optCfg.requestHandler,
 		tracerProvider:        tracerProvider,
 		enrollmentGroupsCache: enrollmentGroupsCache,
+		oscoreMasterKey:       oscoreMasterKey,
 	}
 
 	ss, err := s.createServices(fileWatcher, logger, tracerProvider)
@@ -28,6 +28,10 @@ func ContentTypeToMediaType(contentType string) (message.MediaType, error) {
 		return message.AppOcfCbor, nil
 	case message.AppJSON.String():
 		return message.AppJSON, nil
+	case message.AppSenmlJSON.String():
+		return message.AppSenmlJSON, nil
+	case message.AppSenmlCbor.String():
+		return message.AppSenmlCbor, nil
 	default:
 		return message.TextPlain, fmt.Errorf("unknown content type '%v'", contentType)
 	}
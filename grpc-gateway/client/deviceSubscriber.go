@@ -14,7 +14,6 @@ import (
 	"github.com/plgd-dev/hub/v2/pkg/opentelemetry"
 	"github.com/plgd-dev/hub/v2/pkg/opentelemetry/propagation"
 	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus"
-	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/nats/subscriber"
 	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/utils"
 	"github.com/plgd-dev/hub/v2/resource-aggregate/events"
 	kitSync "github.com/plgd-dev/kit/v2/sync"
@@ -188,7 +187,14 @@ type DeviceSubscriber struct {
 
 type RetryFunc = func() (when time.Time, err error)
 
-func NewDeviceSubscriber(getContext func() (context.Context, context.CancelFunc), owner, deviceID string, factoryRetry func() RetryFunc, rdClient pbGRPC.GrpcGatewayClient, resourceSubscriber *subscriber.Subscriber, tracerProvider trace.TracerProvider) (*DeviceSubscriber, error) {
+// ResourceSubscriber is implemented by the subscribers of the eventbus.
+type ResourceSubscriber interface {
+	eventbus.Subscriber
+	AddReconnectFunc(f func()) uint64
+	RemoveReconnectFunc(id uint64)
+}
+
+func NewDeviceSubscriber(getContext func() (context.Context, context.CancelFunc), owner, deviceID string, factoryRetry func() RetryFunc, rdClient pbGRPC.GrpcGatewayClient, resourceSubscriber ResourceSubscriber, tracerProvider trace.TracerProvider) (*DeviceSubscriber, error) {
 	uuid, err := uuid.NewRandom()
 	if err != nil {
 		return nil, err
@@ -7,11 +7,12 @@ import (
 
 	"github.com/plgd-dev/go-coap/v3/message"
 	"github.com/plgd-dev/go-coap/v3/message/pool"
+	"github.com/plgd-dev/hub/v2/pkg/senml"
 	"github.com/plgd-dev/kit/v2/codec/cbor"
 	"github.com/plgd-dev/kit/v2/codec/json"
 )
 
-// GeneralMessageCodec encodes in application/vnd.ocf+cbor and decodes json/coap/text.
+// GeneralMessageCodec encodes in application/vnd.ocf+cbor and decodes json/coap/senml/text.
 type GeneralMessageCodec struct{}
 
 // ContentFormat used for encoding.
@@ -37,6 +38,18 @@ func (GeneralMessageCodec) Decode(m *pool.Message, v interface{}) error {
 		decoder = cbor.ReadFrom
 	case message.AppJSON:
 		decoder = json.ReadFrom
+	case message.AppSenmlJSON, message.AppSenmlCbor:
+		decode := senml.DecodeJSON
+		if mt == message.AppSenmlCbor {
+			decode = senml.DecodeCBOR
+		}
+		decoder = func(w io.Reader, v interface{}) error {
+			data, err := io.ReadAll(w)
+			if err != nil {
+				return err
+			}
+			return decode(data, v)
+		}
 	case message.TextPlain:
 		decoder = func(w io.Reader, v interface{}) error {
 			data, err := io.ReadAll(w)
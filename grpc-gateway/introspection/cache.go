@@ -0,0 +1,98 @@
+package introspection
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/plgd-dev/go-coap/v3/pkg/cache"
+	"github.com/plgd-dev/go-coap/v3/pkg/runner/periodic"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errorExpiration limits how long the failure of the retrieval of the document is cached, so the devices which
+// were offline are validated soon after they come online.
+const errorExpiration = time.Minute
+
+// GetDocumentFunc retrieves the introspection document of the device. When the device doesn't provide
+// the introspection document, nil is returned without the error.
+type GetDocumentFunc func(ctx context.Context, deviceID string) (*Document, error)
+
+type entry struct {
+	done chan struct{}
+	doc  *Document
+	err  error
+}
+
+// Cache caches the introspection documents of the devices. The document of the device is retrieved once
+// for all concurrent requests.
+type Cache struct {
+	getDocument GetDocumentFunc
+	expiration  time.Duration
+	timeout     time.Duration
+	cache       *cache.Cache[string, *entry]
+}
+
+// NewCache creates the cache. The retrieval of the document is limited by the timeout.
+func NewCache(ctx context.Context, getDocument GetDocumentFunc, expiration, timeout time.Duration) *Cache {
+	c := cache.NewCache[string, *entry]()
+	cleanupInterval := expiration / 2
+	if cleanupInterval < time.Second {
+		cleanupInterval = expiration
+	}
+	if cleanupInterval > time.Minute {
+		cleanupInterval = time.Minute
+	}
+	add := periodic.New(ctx.Done(), cleanupInterval)
+	add(func(now time.Time) bool {
+		c.CheckExpirations(now)
+		return true
+	})
+	return &Cache{
+		getDocument: getDocument,
+		expiration:  expiration,
+		timeout:     timeout,
+		cache:       c,
+	}
+}
+
+func isContextError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	code := status.Code(err)
+	return code == codes.Canceled || code == codes.DeadlineExceeded
+}
+
+// fill retrieves the document by the context detached from the cancellation of the request, so the other requests
+// waiting for the document don't fail when the request which started the retrieval is canceled.
+func (c *Cache) fill(ctx context.Context, deviceID string, e *cache.Element[*entry]) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), c.timeout)
+	defer cancel()
+	v := e.Data()
+	v.doc, v.err = c.getDocument(ctx, deviceID)
+	switch {
+	case isContextError(v.err):
+		// the next request retrieves the document again
+		e.ValidUntil.Store(time.Now())
+	case v.err != nil:
+		e.ValidUntil.Store(time.Now().Add(min(c.expiration, errorExpiration)))
+	}
+	close(v.done)
+}
+
+// Get returns the introspection document of the device, nil is returned when the device doesn't provide it.
+func (c *Cache) Get(ctx context.Context, deviceID string) (*Document, error) {
+	e, loaded := c.cache.LoadOrStore(deviceID, cache.NewElement(&entry{done: make(chan struct{})}, time.Now().Add(c.expiration), nil))
+	v := e.Data()
+	if !loaded {
+		go c.fill(ctx, deviceID, e)
+	}
+	select {
+	case <-v.done:
+		return v.doc, v.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
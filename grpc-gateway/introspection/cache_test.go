@@ -0,0 +1,59 @@
+package introspection_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/plgd-dev/hub/v2/grpc-gateway/introspection"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+)
+
+func TestCacheGetCanceledRequest(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	release := make(chan struct{})
+	var calls atomic.Int32
+	doc := &introspection.Document{}
+	c := introspection.NewCache(ctx, func(ctx context.Context, _ string) (*introspection.Document, error) {
+		calls.Inc()
+		select {
+		case <-release:
+			return doc, nil
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}, time.Minute, time.Second)
+
+	// the retrieval continues when the request which started it is canceled
+	reqCtx, reqCancel := context.WithCancel(ctx)
+	reqCancel()
+	_, err := c.Get(reqCtx, "dev")
+	require.ErrorIs(t, err, context.Canceled)
+	close(release)
+	got, err := c.Get(ctx, "dev")
+	require.NoError(t, err)
+	require.Same(t, doc, got)
+	require.Equal(t, int32(1), calls.Load())
+}
+
+func TestCacheGetDoesNotCacheTimeout(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var calls atomic.Int32
+	c := introspection.NewCache(ctx, func(ctx context.Context, _ string) (*introspection.Document, error) {
+		if calls.Inc() == 1 {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		}
+		return &introspection.Document{}, nil
+	}, time.Minute, 10*time.Millisecond)
+
+	_, err := c.Get(ctx, "dev")
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	got, err := c.Get(ctx, "dev")
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	require.Equal(t, int32(2), calls.Load())
+}
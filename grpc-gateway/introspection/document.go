@@ -0,0 +1,318 @@
+// Package introspection validates the content of the resource requests by the introspection document of the device.
+// The introspection document is the OpenAPI 2.0 description of the resources published by the device, it is provided
+// by the oic.wk.introspection resource.
+package introspection
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	kitJson "github.com/plgd-dev/kit/v2/codec/json"
+	"github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+const schemaURL = "schema.json"
+
+// Violation describes the part of the request which doesn't conform to the introspection document.
+type Violation struct {
+	// Path is the JSON path of the invalid value of the content, eg. $.range[1], or the name of the invalid field of the request.
+	Path    string
+	Message string
+}
+
+// ValidationError is returned when the request doesn't conform to the introspection document.
+type ValidationError struct {
+	Href       string
+	Violations []Violation
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, 0, len(e.Violations))
+	for _, v := range e.Violations {
+		msgs = append(msgs, v.Path+": "+v.Message)
+	}
+	return fmt.Sprintf("invalid request of resource('%v'): %v", e.Href, strings.Join(msgs, "; "))
+}
+
+type resourceSchema struct {
+	schema     *jsonschema.Schema
+	interfaces []string
+}
+
+// Document is the parsed introspection document of the device.
+type Document struct {
+	paths         map[string]interface{}
+	definitions   interface{}
+	resourceTypes map[string][]string
+
+	lock    sync.Mutex
+	schemas map[string]*resourceSchema
+}
+
+// toJSONValue converts the decoded CBOR or JSON content to the values of the encoding/json package.
+func toJSONValue(v interface{}) (interface{}, error) {
+	data, err := kitJson.Encode(v)
+	if err != nil {
+		return nil, err
+	}
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	var r interface{}
+	if err = d.Decode(&r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ParseDocument parses the decoded introspection document. The resource types of the published resources are used
+// to find the descriptions of the resources whose hrefs are not in the paths of the document.
+func ParseDocument(v interface{}, resourceTypes map[string][]string) (*Document, error) {
+	doc, err := toJSONValue(v)
+	if err != nil {
+		return nil, fmt.Errorf("cannot convert introspection document: %w", err)
+	}
+	m, ok := doc.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("invalid introspection document type(%T)", doc)
+	}
+	paths, ok := m["paths"].(map[string]interface{})
+	if !ok {
+		return nil, errors.New("introspection document doesn't contain paths")
+	}
+	return &Document{
+		paths:         paths,
+		definitions:   m["definitions"],
+		resourceTypes: resourceTypes,
+		schemas:       make(map[string]*resourceSchema),
+	}, nil
+}
+
+func (d *Document) resolveRef(schema map[string]interface{}) map[string]interface{} {
+	ref, ok := schema["$ref"].(string)
+	if !ok {
+		return schema
+	}
+	definitions, ok := d.definitions.(map[string]interface{})
+	if !ok {
+		return schema
+	}
+	if v, ok := definitions[strings.TrimPrefix(ref, "#/definitions/")].(map[string]interface{}); ok {
+		return v
+	}
+	return schema
+}
+
+func getParameters(path map[string]interface{}, operation string) []map[string]interface{} {
+	op, ok := path[operation].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	params, _ := op["parameters"].([]interface{})
+	r := make([]map[string]interface{}, 0, len(params))
+	for _, p := range params {
+		if v, ok := p.(map[string]interface{}); ok {
+			r = append(r, v)
+		}
+	}
+	return r
+}
+
+func appendStrings(dst []string, v interface{}) []string {
+	values, _ := v.([]interface{})
+	for _, val := range values {
+		if s, ok := val.(string); ok {
+			dst = append(dst, s)
+		}
+	}
+	return dst
+}
+
+// getPathResourceTypes returns the resource types declared by the rt property of the body of the operations.
+func (d *Document) getPathResourceTypes(path map[string]interface{}) []string {
+	var rts []string
+	for _, operation := range []string{"get", "post"} {
+		for _, p := range getParameters(path, operation) {
+			schema, ok := p["schema"].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			properties, _ := d.resolveRef(schema)["properties"].(map[string]interface{})
+			rt, ok := properties["rt"].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			rts = appendStrings(rts, rt["default"])
+			if items, ok := rt["items"].(map[string]interface{}); ok {
+				rts = appendStrings(rts, items["enum"])
+			}
+		}
+	}
+	return rts
+}
+
+func (d *Document) findPath(href string) (map[string]interface{}, bool) {
+	if path, ok := d.paths[href].(map[string]interface{}); ok {
+		return path, true
+	}
+	resourceTypes := d.resourceTypes[href]
+	if len(resourceTypes) == 0 {
+		return nil, false
+	}
+	keys := make([]string, 0, len(d.paths))
+	for k := range d.paths {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		path, ok := d.paths[k].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for _, rt := range d.getPathResourceTypes(path) {
+			if slices.Contains(resourceTypes, rt) {
+				return path, true
+			}
+		}
+	}
+	return nil, false
+}
+
+func (d *Document) compileSchema(bodySchema map[string]interface{}) (*jsonschema.Schema, error) {
+	// the definitions are added to the schema of the body, so the references to them are resolved within the schema
+	schema := make(map[string]interface{}, len(bodySchema)+1)
+	for k, v := range bodySchema {
+		schema[k] = v
+	}
+	if d.definitions != nil {
+		schema["definitions"] = d.definitions
+	}
+	data, err := json.Marshal(schema)
+	if err != nil {
+		return nil, err
+	}
+	c := jsonschema.NewCompiler()
+	c.Draft = jsonschema.Draft4
+	c.LoadURL = func(s string) (io.ReadCloser, error) {
+		return nil, fmt.Errorf("cannot load schema('%v'): remote references are not supported", s)
+	}
+	if err = c.AddResource(schemaURL, bytes.NewReader(data)); err != nil {
+		return nil, err
+	}
+	return c.Compile(schemaURL)
+}
+
+func (d *Document) newResourceSchema(href string) (*resourceSchema, error) {
+	path, ok := d.findPath(href)
+	if !ok {
+		return nil, nil
+	}
+	var rs resourceSchema
+	for _, p := range getParameters(path, "post") {
+		switch p["in"] {
+		case "query":
+			if p["name"] == "if" {
+				rs.interfaces = appendStrings(rs.interfaces, p["enum"])
+			}
+		case "body":
+			bodySchema, ok := p["schema"].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			schema, err := d.compileSchema(bodySchema)
+			if err != nil {
+				return nil, fmt.Errorf("cannot compile schema of resource('%v'): %w", href, err)
+			}
+			rs.schema = schema
+		}
+	}
+	return &rs, nil
+}
+
+func (d *Document) getResourceSchema(href string) (*resourceSchema, error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	if rs, ok := d.schemas[href]; ok {
+		return rs, nil
+	}
+	rs, err := d.newResourceSchema(href)
+	if err != nil {
+		return nil, err
+	}
+	d.schemas[href] = rs
+	return rs, nil
+}
+
+// toJSONPath converts the JSON pointer of the instance to the JSON path, eg. /range/1 to $.range[1].
+func toJSONPath(pointer string) string {
+	var b strings.Builder
+	b.WriteString("$")
+	if pointer == "" {
+		return b.String()
+	}
+	for _, token := range strings.Split(strings.TrimPrefix(pointer, "/"), "/") {
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+		if token != "" && strings.Trim(token, "0123456789") == "" {
+			b.WriteString("[" + token + "]")
+			continue
+		}
+		b.WriteString("." + token)
+	}
+	return b.String()
+}
+
+func collectViolations(err *jsonschema.ValidationError, violations []Violation) []Violation {
+	if len(err.Causes) == 0 {
+		return append(violations, Violation{
+			Path:    toJSONPath(err.InstanceLocation),
+			Message: err.Message,
+		})
+	}
+	for _, cause := range err.Causes {
+		violations = collectViolations(cause, violations)
+	}
+	return violations
+}
+
+// Validate validates the content of the update or the create request of the resource. The requests of the resources
+// which are not described by the document are not validated.
+func (d *Document) Validate(href, resourceInterface string, content interface{}) error {
+	rs, err := d.getResourceSchema(href)
+	if err != nil {
+		return err
+	}
+	if rs == nil {
+		return nil
+	}
+	if resourceInterface != "" && len(rs.interfaces) > 0 && !slices.Contains(rs.interfaces, resourceInterface) {
+		return &ValidationError{
+			Href: href,
+			Violations: []Violation{{
+				Path:    "resourceInterface",
+				Message: fmt.Sprintf("interface('%v') is not one of %v", resourceInterface, rs.interfaces),
+			}},
+		}
+	}
+	if rs.schema == nil {
+		return nil
+	}
+	instance, err := toJSONValue(content)
+	if err != nil {
+		return fmt.Errorf("cannot convert content of resource('%v'): %w", href, err)
+	}
+	err = rs.schema.Validate(instance)
+	var ve *jsonschema.ValidationError
+	if errors.As(err, &ve) {
+		return &ValidationError{
+			Href:       href,
+			Violations: collectViolations(ve, nil),
+		}
+	}
+	return err
+}
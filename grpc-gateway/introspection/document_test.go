@@ -0,0 +1,133 @@
+package introspection_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/plgd-dev/hub/v2/grpc-gateway/introspection"
+	"github.com/stretchr/testify/require"
+)
+
+const testDocument = `{
+	"swagger": "2.0",
+	"info": {"title": "light", "version": "1.0"},
+	"paths": {
+		"/light/1": {
+			"post": {
+				"parameters": [
+					{"name": "if", "in": "query", "type": "string", "enum": ["oic.if.a", "oic.if.baseline"]},
+					{"name": "body", "in": "body", "schema": {"$ref": "#/definitions/Light"}}
+				]
+			}
+		},
+		"/switchResURI": {
+			"get": {
+				"parameters": [
+					{"name": "body", "in": "body", "schema": {"$ref": "#/definitions/Switch"}}
+				]
+			},
+			"post": {
+				"parameters": [
+					{"name": "body", "in": "body", "schema": {"$ref": "#/definitions/Switch"}}
+				]
+			}
+		}
+	},
+	"definitions": {
+		"Light": {
+			"type": "object",
+			"properties": {
+				"power": {"type": "integer", "minimum": 0, "maximum": 100},
+				"range": {"type": "array", "items": {"type": "integer"}}
+			},
+			"additionalProperties": false
+		},
+		"Switch": {
+			"type": "object",
+			"properties": {
+				"rt": {"type": "array", "items": {"type": "string", "enum": ["oic.r.switch.binary"]}},
+				"value": {"type": "boolean"}
+			},
+			"required": ["value"]
+		}
+	}
+}`
+
+func parseTestDocument(t *testing.T) *introspection.Document {
+	var v interface{}
+	err := json.Unmarshal([]byte(testDocument), &v)
+	require.NoError(t, err)
+	doc, err := introspection.ParseDocument(v, map[string][]string{
+		"/switch/1": {"oic.r.switch.binary"},
+	})
+	require.NoError(t, err)
+	return doc
+}
+
+func TestDocumentValidate(t *testing.T) {
+	doc := parseTestDocument(t)
+	tests := []struct {
+		name              string
+		href              string
+		resourceInterface string
+		content           interface{}
+		wantViolations    []introspection.Violation
+	}{
+		{
+			name:    "valid",
+			href:    "/light/1",
+			content: map[interface{}]interface{}{"power": uint64(10), "range": []interface{}{int64(1), int64(2)}},
+		},
+		{
+			name:    "invalid value",
+			href:    "/light/1",
+			content: map[string]interface{}{"power": 1000},
+			wantViolations: []introspection.Violation{
+				{Path: "$.power", Message: "must be <= 100 but found 1000"},
+			},
+		},
+		{
+			name:    "invalid item",
+			href:    "/light/1",
+			content: map[string]interface{}{"range": []interface{}{1, "a"}},
+			wantViolations: []introspection.Violation{
+				{Path: "$.range[1]", Message: "expected integer, but got string"},
+			},
+		},
+		{
+			name:              "invalid interface",
+			href:              "/light/1",
+			resourceInterface: "oic.if.r",
+			content:           map[string]interface{}{"power": 1},
+			wantViolations: []introspection.Violation{
+				{Path: "resourceInterface", Message: "interface('oic.if.r') is not one of [oic.if.a oic.if.baseline]"},
+			},
+		},
+		{
+			name:    "resource type",
+			href:    "/switch/1",
+			content: map[string]interface{}{"value": "on"},
+			wantViolations: []introspection.Violation{
+				{Path: "$.value", Message: "expected boolean, but got string"},
+			},
+		},
+		{
+			name:    "unknown resource",
+			href:    "/unknown",
+			content: map[string]interface{}{"value": "on"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := doc.Validate(tt.href, tt.resourceInterface, tt.content)
+			if len(tt.wantViolations) == 0 {
+				require.NoError(t, err)
+				return
+			}
+			var ve *introspection.ValidationError
+			require.ErrorAs(t, err, &ve)
+			require.Equal(t, tt.href, ve.Href)
+			require.Equal(t, tt.wantViolations, ve.Violations)
+		})
+	}
+}
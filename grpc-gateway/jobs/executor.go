@@ -0,0 +1,613 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/plgd-dev/go-coap/v3/message"
+	"github.com/plgd-dev/hub/v2/grpc-gateway/pb"
+	"github.com/plgd-dev/hub/v2/grpc-gateway/store"
+	"github.com/plgd-dev/hub/v2/pkg/log"
+	pkgGrpc "github.com/plgd-dev/hub/v2/pkg/net/grpc"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/commands"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/events"
+	raService "github.com/plgd-dev/hub/v2/resource-aggregate/service"
+)
+
+// correlationIDPrefix distinguishes the update commands of the bulk update jobs from other commands.
+const correlationIDPrefix = "bulkUpdateJob"
+
+// CorrelationID returns the correlation ID of the update command of the device:
+// "bulkUpdateJob.{jobID}.{deviceID}".
+func CorrelationID(jobID, deviceID string) string {
+	return correlationIDPrefix + "." + jobID + "." + deviceID
+}
+
+// SplitCorrelationID returns the jobID and the deviceID of the correlation ID created by CorrelationID.
+func SplitCorrelationID(correlationID string) (string, string, bool) {
+	parts := strings.SplitN(correlationID, ".", 3)
+	if len(parts) != 3 || parts[0] != correlationIDPrefix || parts[1] == "" || parts[2] == "" {
+		return "", "", false
+	}
+	return parts[1], parts[2], true
+}
+
+// GetTokenFunc returns the access token of the owner, the commands of the jobs are executed by this token.
+type GetTokenFunc func(ctx context.Context, owner string) (string, error)
+
+// ValidateContentFunc validates the content of the command before it is sent, the same way as the content
+// of the commands received by the API is validated. The context contains the token of the owner.
+type ValidateContentFunc func(ctx context.Context, action string, resourceID *commands.ResourceId, resourceInterface string, content *pb.Content) error
+
+// runningJob holds the devices of the job processed by the executor.
+type runningJob struct {
+	job     *pb.BulkUpdateJob
+	queued  []string               // devices waiting for the update in the order of the job
+	pending map[string]*time.Timer // devices with the update in progress, the timer is set when the command is accepted
+}
+
+// Executor executes the bulk update jobs. It updates at most the concurrency limit of devices of the job at once,
+// the next device is updated when the ResourceUpdated event of a pending update is received or its time to live
+// expires. The state of the devices is stored, so the running jobs can be resumed after the restart.
+//
+// Each job is executed only by the instance of the service which holds the lease of the job. The leases are renewed
+// periodically, and the running jobs with the expired lease, eg. of the stopped instance, are taken over.
+type Executor struct {
+	ctx           context.Context
+	storage       store.Store
+	raClient      raService.ResourceAggregateClient
+	getToken      GetTokenFunc
+	validate      ValidateContentFunc
+	instanceID    string
+	leaseDuration time.Duration
+	logger        log.Logger
+
+	lock sync.Mutex
+	jobs map[string]*runningJob
+
+	done      chan struct{}
+	closeOnce sync.Once
+	wg        sync.WaitGroup
+}
+
+// NewExecutor creates the executor. When validate is nil, the content of the jobs is not validated.
+func NewExecutor(ctx context.Context, storage store.Store, raClient raService.ResourceAggregateClient, getToken GetTokenFunc, validate ValidateContentFunc, leaseDuration time.Duration, logger log.Logger) *Executor {
+	e := &Executor{
+		ctx:           ctx,
+		storage:       storage,
+		raClient:      raClient,
+		getToken:      getToken,
+		validate:      validate,
+		instanceID:    uuid.NewString(),
+		leaseDuration: leaseDuration,
+		logger:        logger,
+		jobs:          make(map[string]*runningJob),
+		done:          make(chan struct{}),
+	}
+	e.wg.Add(1)
+	go func() {
+		defer e.wg.Done()
+		e.runLeases()
+	}()
+	return e
+}
+
+// runLeases renews the leases of the running jobs and takes over the jobs with the expired lease.
+func (e *Executor) runLeases() {
+	t := time.NewTicker(e.leaseDuration / 3)
+	defer t.Stop()
+	for {
+		select {
+		case <-e.done:
+			return
+		case <-t.C:
+			e.renewLeases()
+			if err := e.ResumeJobs(e.ctx); err != nil {
+				e.logger.Errorf("cannot resume jobs: %w", err)
+			}
+		}
+	}
+}
+
+func (e *Executor) claim(ctx context.Context, jobID string) (*pb.BulkUpdateJob, error) {
+	return e.storage.ClaimJob(ctx, store.ClaimJobRequest{
+		JobID:      jobID,
+		InstanceID: e.instanceID,
+		LeaseUntil: time.Now().Add(e.leaseDuration).UnixNano(),
+	})
+}
+
+// renewLeases renews the leases of the running jobs. The job is stopped when its lease was lost, eg. when the job was
+// canceled or it was taken over by another instance after the lease expired.
+func (e *Executor) renewLeases() {
+	e.lock.Lock()
+	running := make([]*runningJob, 0, len(e.jobs))
+	for _, rj := range e.jobs {
+		running = append(running, rj)
+	}
+	e.lock.Unlock()
+	for _, rj := range running {
+		_, err := e.claim(e.ctx, rj.job.GetId())
+		if errors.Is(err, store.ErrNotFound) {
+			e.logger.Debugf("lease of job(%v) was lost", rj.job.GetId())
+			e.stop(rj)
+			continue
+		}
+		if err != nil {
+			e.logger.Errorf("cannot renew lease of job(%v): %w", rj.job.GetId(), err)
+		}
+	}
+}
+
+// stop stops the execution of the job by this instance.
+func (e *Executor) stop(rj *runningJob) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	if e.jobs[rj.job.GetId()] != rj {
+		return
+	}
+	stopTimersLocked(rj)
+	delete(e.jobs, rj.job.GetId())
+}
+
+func stopTimersLocked(rj *runningJob) {
+	for _, t := range rj.pending {
+		if t != nil {
+			t.Stop()
+		}
+	}
+}
+
+// CreateJob stores the job and starts its execution.
+func (e *Executor) CreateJob(ctx context.Context, owner string, deviceIDs []string, req *pb.CreateBulkUpdateJobRequest) (*pb.BulkUpdateJob, error) {
+	jobID := uuid.NewString()
+	devices := make([]*pb.BulkUpdateJob_Device, 0, len(deviceIDs))
+	for _, deviceID := range deviceIDs {
+		devices = append(devices, &pb.BulkUpdateJob_Device{
+			DeviceId:      deviceID,
+			CorrelationId: CorrelationID(jobID, deviceID),
+			Status:        pb.BulkUpdateJob_Device_QUEUED,
+		})
+	}
+	job, err := e.storage.CreateJob(ctx, &pb.BulkUpdateJob{
+		Id:                jobID,
+		Owner:             owner,
+		Href:              req.GetHref(),
+		ResourceInterface: req.GetResourceInterface(),
+		Content:           req.GetContent(),
+		Force:             req.GetForce(),
+		ConcurrencyLimit:  req.GetConcurrencyLimit(),
+		TimeToLive:        req.GetTimeToLive(),
+		Status:            pb.BulkUpdateJob_RUNNING,
+		Devices:           devices,
+		CreatedAt:         time.Now().UnixNano(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot create job: %w", err)
+	}
+	if job, err = e.claim(ctx, jobID); err != nil {
+		return nil, fmt.Errorf("cannot claim job: %w", err)
+	}
+	e.start(job)
+	return job, nil
+}
+
+func (e *Executor) isRunning(jobID string) bool {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	_, ok := e.jobs[jobID]
+	return ok
+}
+
+// ResumeJobs starts the execution of the running jobs loaded from the storage, which are not executed by
+// another instance. Only the jobs whose lease expired are loaded.
+func (e *Executor) ResumeJobs(ctx context.Context) error {
+	var jobIDs []string
+	err := e.storage.GetJobs(ctx, "", store.GetJobsQuery{
+		StatusFilter:       []pb.BulkUpdateJob_Status{pb.BulkUpdateJob_RUNNING},
+		LeaseExpiredBefore: time.Now().UnixNano(),
+	}, func(job *pb.BulkUpdateJob) error {
+		jobIDs = append(jobIDs, job.GetId())
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("cannot get running jobs: %w", err)
+	}
+	for _, jobID := range jobIDs {
+		if e.isRunning(jobID) {
+			continue
+		}
+		job, err := e.claim(ctx, jobID)
+		if errors.Is(err, store.ErrNotFound) {
+			// the job is executed by another instance or it was finished
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("cannot claim job(%v): %w", jobID, err)
+		}
+		e.logger.Debugf("resuming job(%v)", jobID)
+		e.start(job)
+	}
+	return nil
+}
+
+func (e *Executor) start(job *pb.BulkUpdateJob) {
+	rj := &runningJob{
+		job:     job,
+		pending: make(map[string]*time.Timer),
+	}
+	now := time.Now().UnixNano()
+	var expired []string
+	validUntil := make(map[string]int64)
+	for _, d := range job.GetDevices() {
+		switch d.GetStatus() {
+		case pb.BulkUpdateJob_Device_QUEUED:
+			rj.queued = append(rj.queued, d.GetDeviceId())
+		case pb.BulkUpdateJob_Device_PENDING:
+			rj.pending[d.GetDeviceId()] = nil
+			if d.GetValidUntil() > 0 && d.GetValidUntil() <= now {
+				expired = append(expired, d.GetDeviceId())
+				continue
+			}
+			validUntil[d.GetDeviceId()] = d.GetValidUntil()
+		}
+	}
+	e.lock.Lock()
+	if _, ok := e.jobs[job.GetId()]; ok {
+		// the job is already executed by this instance
+		e.lock.Unlock()
+		return
+	}
+	e.jobs[job.GetId()] = rj
+	for deviceID, v := range validUntil {
+		e.setTimeoutLocked(rj, deviceID, v)
+	}
+	e.lock.Unlock()
+	for _, deviceID := range expired {
+		e.timeoutDevice(rj, deviceID)
+	}
+	e.next(rj)
+}
+
+// setTimeoutLocked sets the timer of the pending device. A zero validUntil means the update never expires.
+func (e *Executor) setTimeoutLocked(rj *runningJob, deviceID string, validUntil int64) {
+	if validUntil <= 0 {
+		return
+	}
+	rj.pending[deviceID] = time.AfterFunc(time.Until(time.Unix(0, validUntil)), func() {
+		e.timeoutDevice(rj, deviceID)
+	})
+}
+
+// next starts the updates of the queued devices up to the concurrency limit. When all devices are processed the job is done.
+func (e *Executor) next(rj *runningJob) {
+	e.lock.Lock()
+	if e.jobs[rj.job.GetId()] != rj {
+		// job was canceled
+		e.lock.Unlock()
+		return
+	}
+	if len(rj.queued) == 0 && len(rj.pending) == 0 {
+		delete(e.jobs, rj.job.GetId())
+		e.lock.Unlock()
+		e.finishJob(rj.job)
+		return
+	}
+	var toUpdate []string
+	for len(rj.queued) > 0 && len(rj.pending) < int(rj.job.GetConcurrencyLimit()) {
+		deviceID := rj.queued[0]
+		rj.queued = rj.queued[1:]
+		rj.pending[deviceID] = nil
+		toUpdate = append(toUpdate, deviceID)
+	}
+	e.lock.Unlock()
+	for _, deviceID := range toUpdate {
+		go e.updateDevice(rj, deviceID)
+	}
+}
+
+// release removes the device from the pending devices and continues with the next device.
+func (e *Executor) release(rj *runningJob, deviceID string) {
+	e.lock.Lock()
+	t, ok := rj.pending[deviceID]
+	if ok {
+		if t != nil {
+			t.Stop()
+		}
+		delete(rj.pending, deviceID)
+	}
+	e.lock.Unlock()
+	if ok {
+		e.next(rj)
+	}
+}
+
+func (e *Executor) finishJob(job *pb.BulkUpdateJob) {
+	_, err := e.storage.UpdateJobStatus(e.ctx, store.UpdateJobStatusRequest{
+		JobID:        job.GetId(),
+		StatusFilter: []pb.BulkUpdateJob_Status{pb.BulkUpdateJob_RUNNING},
+		Status:       pb.BulkUpdateJob_DONE,
+	})
+	if err != nil && !errors.Is(err, store.ErrNotFound) {
+		e.logger.Errorf("cannot finish job(%v): %w", job.GetId(), err)
+		return
+	}
+	e.logger.Debugf("job(%v) finished", job.GetId())
+}
+
+func makeResourceUpdatedWithError(job *pb.BulkUpdateJob, deviceID string, err error) *events.ResourceUpdated {
+	return &events.ResourceUpdated{
+		ResourceId: commands.NewResourceID(deviceID, job.GetHref()),
+		Status:     commands.Status_ERROR,
+		Content: &commands.Content{
+			Data:        []byte(err.Error()),
+			ContentType: message.TextPlain.String(),
+		},
+		AuditContext: &commands.AuditContext{
+			CorrelationId: CorrelationID(job.GetId(), deviceID),
+			Owner:         job.GetOwner(),
+		},
+	}
+}
+
+// ctxWithOwnerToken returns the context with the access token of the owner of the job for the commands.
+func (e *Executor) ctxWithOwnerToken(job *pb.BulkUpdateJob) (context.Context, error) {
+	token, err := e.getToken(e.ctx, job.GetOwner())
+	if err != nil {
+		return nil, err
+	}
+	return pkgGrpc.CtxWithToken(e.ctx, token), nil
+}
+
+func (e *Executor) sendUpdate(job *pb.BulkUpdateJob, deviceID string) (*commands.UpdateResourceResponse, error) {
+	ctx, err := e.ctxWithOwnerToken(job)
+	if err != nil {
+		return nil, err
+	}
+	resourceID := commands.NewResourceID(deviceID, job.GetHref())
+	if e.validate != nil {
+		if err = e.validate(ctx, "update", resourceID, job.GetResourceInterface(), job.GetContent()); err != nil {
+			return nil, err
+		}
+	}
+	return e.raClient.UpdateResource(ctx, &commands.UpdateResourceRequest{
+		ResourceId:        resourceID,
+		CorrelationId:     CorrelationID(job.GetId(), deviceID),
+		ResourceInterface: job.GetResourceInterface(),
+		TimeToLive:        job.GetTimeToLive(),
+		Content: &commands.Content{
+			Data:              job.GetContent().GetData(),
+			ContentType:       job.GetContent().GetContentType(),
+			CoapContentFormat: -1,
+		},
+		CommandMetadata: &commands.CommandMetadata{
+			ConnectionId: job.GetId(),
+		},
+		Force: job.GetForce(),
+	})
+}
+
+func (e *Executor) updateDevice(rj *runningJob, deviceID string) {
+	job := rj.job
+	correlationID := CorrelationID(job.GetId(), deviceID)
+	res, err := e.sendUpdate(job, deviceID)
+	if err != nil {
+		e.logger.Debugf("cannot update resource(%v%v) by job(%v): %v", deviceID, job.GetHref(), job.GetId(), err)
+		_, errU := e.storage.UpdateJobDevice(e.ctx, store.UpdateJobDeviceRequest{
+			JobID:        job.GetId(),
+			StatusFilter: []pb.BulkUpdateJob_Device_Status{pb.BulkUpdateJob_Device_QUEUED},
+			Device: &pb.BulkUpdateJob_Device{
+				DeviceId:        deviceID,
+				CorrelationId:   correlationID,
+				Status:          pb.BulkUpdateJob_Device_DONE,
+				ResourceUpdated: makeResourceUpdatedWithError(job, deviceID, err),
+			},
+		})
+		if errU != nil && !errors.Is(errU, store.ErrNotFound) {
+			e.logger.Errorf("cannot update device(%v) of job(%v): %w", deviceID, job.GetId(), errU)
+		}
+		e.release(rj, deviceID)
+		return
+	}
+	_, err = e.storage.UpdateJobDevice(e.ctx, store.UpdateJobDeviceRequest{
+		JobID:        job.GetId(),
+		StatusFilter: []pb.BulkUpdateJob_Device_Status{pb.BulkUpdateJob_Device_QUEUED},
+		Device: &pb.BulkUpdateJob_Device{
+			DeviceId:      deviceID,
+			CorrelationId: correlationID,
+			Status:        pb.BulkUpdateJob_Device_PENDING,
+			ValidUntil:    res.GetValidUntil(),
+		},
+	})
+	if errors.Is(err, store.ErrNotFound) {
+		// the device was already finished by the ResourceUpdated event or the job was canceled
+		return
+	}
+	if err != nil {
+		e.logger.Errorf("cannot update device(%v) of job(%v): %w", deviceID, job.GetId(), err)
+	}
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	if _, ok := rj.pending[deviceID]; ok {
+		e.setTimeoutLocked(rj, deviceID, res.GetValidUntil())
+	}
+}
+
+func (e *Executor) timeoutDevice(rj *runningJob, deviceID string) {
+	job := rj.job
+	e.logger.Debugf("timeout for pending update of device(%v) by job(%v) reached", deviceID, job.GetId())
+	_, err := e.storage.UpdateJobDevice(e.ctx, store.UpdateJobDeviceRequest{
+		JobID:        job.GetId(),
+		StatusFilter: []pb.BulkUpdateJob_Device_Status{pb.BulkUpdateJob_Device_PENDING},
+		Device: &pb.BulkUpdateJob_Device{
+			DeviceId:      deviceID,
+			CorrelationId: CorrelationID(job.GetId(), deviceID),
+			Status:        pb.BulkUpdateJob_Device_TIMEOUT,
+		},
+	})
+	if err != nil && !errors.Is(err, store.ErrNotFound) {
+		e.logger.Errorf("cannot timeout device(%v) of job(%v): %w", deviceID, job.GetId(), err)
+	}
+	e.release(rj, deviceID)
+}
+
+func (e *Executor) finishDevice(ctx context.Context, updated *events.ResourceUpdated) error {
+	jobID, deviceID, ok := SplitCorrelationID(updated.GetAuditContext().GetCorrelationId())
+	if !ok || deviceID != updated.GetResourceId().GetDeviceId() {
+		return nil
+	}
+	_, err := e.storage.UpdateJobDevice(ctx, store.UpdateJobDeviceRequest{
+		JobID:        jobID,
+		StatusFilter: []pb.BulkUpdateJob_Device_Status{pb.BulkUpdateJob_Device_QUEUED, pb.BulkUpdateJob_Device_PENDING},
+		Device: &pb.BulkUpdateJob_Device{
+			DeviceId:        deviceID,
+			CorrelationId:   updated.GetAuditContext().GetCorrelationId(),
+			Status:          pb.BulkUpdateJob_Device_DONE,
+			ResourceUpdated: updated,
+		},
+	})
+	if err != nil && !errors.Is(err, store.ErrNotFound) {
+		return err
+	}
+	e.lock.Lock()
+	rj, ok := e.jobs[jobID]
+	e.lock.Unlock()
+	if ok {
+		e.release(rj, deviceID)
+	}
+	return nil
+}
+
+// Handle handles the ResourceUpdated events of the updates started by the jobs.
+func (e *Executor) Handle(ctx context.Context, iter eventbus.Iter) error {
+	for {
+		ev, ok := iter.Next(ctx)
+		if !ok {
+			return iter.Err()
+		}
+		if ev.EventType() != (&events.ResourceUpdated{}).EventType() {
+			continue
+		}
+		var updated events.ResourceUpdated
+		if err := ev.Unmarshal(&updated); err != nil {
+			e.logger.Errorf("cannot unmarshal ResourceUpdated event: %w", err)
+			continue
+		}
+		if err := e.finishDevice(ctx, &updated); err != nil {
+			e.logger.Errorf("cannot finish device(%v) update of job: %w", updated.GetResourceId().GetDeviceId(), err)
+		}
+	}
+}
+
+func (e *Executor) cancelPendingUpdate(job *pb.BulkUpdateJob, d *pb.BulkUpdateJob_Device) error {
+	ctx, err := e.ctxWithOwnerToken(job)
+	if err != nil {
+		return err
+	}
+	_, err = e.raClient.CancelPendingCommands(ctx, &commands.CancelPendingCommandsRequest{
+		ResourceId:          commands.NewResourceID(d.GetDeviceId(), job.GetHref()),
+		CorrelationIdFilter: []string{d.GetCorrelationId()},
+		CommandMetadata: &commands.CommandMetadata{
+			ConnectionId: job.GetId(),
+		},
+	})
+	return err
+}
+
+func (e *Executor) cancelDevice(job *pb.BulkUpdateJob, d *pb.BulkUpdateJob_Device) error {
+	if d.GetStatus() == pb.BulkUpdateJob_Device_PENDING {
+		// the pending command is removed from the resource aggregate, otherwise the device would be updated when it comes online
+		if err := e.cancelPendingUpdate(job, d); err != nil {
+			e.logger.Debugf("cannot cancel pending update of device(%v) by job(%v): %v", d.GetDeviceId(), job.GetId(), err)
+		}
+	}
+	_, err := e.storage.UpdateJobDevice(e.ctx, store.UpdateJobDeviceRequest{
+		JobID:        job.GetId(),
+		StatusFilter: []pb.BulkUpdateJob_Device_Status{pb.BulkUpdateJob_Device_QUEUED, pb.BulkUpdateJob_Device_PENDING},
+		Device: &pb.BulkUpdateJob_Device{
+			DeviceId:      d.GetDeviceId(),
+			CorrelationId: d.GetCorrelationId(),
+			Status:        pb.BulkUpdateJob_Device_CANCELED,
+		},
+	})
+	if err != nil && !errors.Is(err, store.ErrNotFound) {
+		return err
+	}
+	return nil
+}
+
+// CancelJob cancels the running job of the owner. The queued devices are not updated and the pending updates are canceled.
+func (e *Executor) CancelJob(ctx context.Context, owner, jobID string) (*pb.BulkUpdateJob, error) {
+	job, err := e.storage.UpdateJobStatus(ctx, store.UpdateJobStatusRequest{
+		JobID:        jobID,
+		Owner:        owner,
+		StatusFilter: []pb.BulkUpdateJob_Status{pb.BulkUpdateJob_RUNNING},
+		Status:       pb.BulkUpdateJob_CANCELED,
+	})
+	if err != nil {
+		return nil, err
+	}
+	e.lock.Lock()
+	if rj, ok := e.jobs[jobID]; ok {
+		stopTimersLocked(rj)
+		delete(e.jobs, jobID)
+	}
+	e.lock.Unlock()
+
+	for _, d := range job.GetDevices() {
+		if d.IsFinished() {
+			continue
+		}
+		if errC := e.cancelDevice(job, d); errC != nil {
+			e.logger.Errorf("cannot cancel device(%v) of job(%v): %w", d.GetDeviceId(), jobID, errC)
+		}
+	}
+	var canceled *pb.BulkUpdateJob
+	err = e.storage.GetJobs(ctx, owner, store.GetJobsQuery{IDFilter: []string{jobID}}, func(v *pb.BulkUpdateJob) error {
+		canceled = v
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot get canceled job: %w", err)
+	}
+	if canceled == nil {
+		return nil, store.ErrNotFound
+	}
+	return canceled, nil
+}
+
+// Close stops the running jobs and releases their leases. The jobs are resumed by ResumeJobs after the restart
+// or by another instance.
+func (e *Executor) Close() {
+	e.closeOnce.Do(func() {
+		close(e.done)
+	})
+	e.wg.Wait()
+	e.lock.Lock()
+	jobIDs := make([]string, 0, len(e.jobs))
+	for id, rj := range e.jobs {
+		stopTimersLocked(rj)
+		delete(e.jobs, id)
+		jobIDs = append(jobIDs, id)
+	}
+	e.lock.Unlock()
+	ctx, cancel := context.WithTimeout(context.Background(), e.leaseDuration)
+	defer cancel()
+	for _, jobID := range jobIDs {
+		// the expired lease can be claimed by any instance
+		_, err := e.storage.ClaimJob(ctx, store.ClaimJobRequest{
+			JobID:      jobID,
+			InstanceID: e.instanceID,
+			LeaseUntil: 0,
+		})
+		if err != nil && !errors.Is(err, store.ErrNotFound) {
+			e.logger.Errorf("cannot release lease of job(%v): %w", jobID, err)
+		}
+	}
+}
@@ -0,0 +1,180 @@
+package jobs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/plgd-dev/hub/v2/grpc-gateway/pb"
+	"github.com/plgd-dev/hub/v2/grpc-gateway/store"
+	"github.com/plgd-dev/hub/v2/grpc-gateway/test/memory"
+	"github.com/plgd-dev/hub/v2/pkg/log"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/commands"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/events"
+	"github.com/stretchr/testify/require"
+)
+
+func makeResourceUpdated(req *commands.UpdateResourceRequest) *events.ResourceUpdated {
+	return &events.ResourceUpdated{
+		ResourceId: req.GetResourceId(),
+		Status:     commands.Status_OK,
+		AuditContext: &commands.AuditContext{
+			CorrelationId: req.GetCorrelationId(),
+		},
+	}
+}
+
+func newTestExecutor() (*Executor, *memory.Store, *memory.ResourceAggregateClient) {
+	s := memory.NewStore()
+	ra := &memory.ResourceAggregateClient{}
+	return NewExecutor(context.Background(), s, ra, memory.GetToken, nil, time.Minute, log.Get()), s, ra
+}
+
+func TestCorrelationID(t *testing.T) {
+	jobID, deviceID, ok := SplitCorrelationID(CorrelationID("job", "device"))
+	require.True(t, ok)
+	require.Equal(t, "job", jobID)
+	require.Equal(t, "device", deviceID)
+
+	for _, v := range []string{"", "job.device", "other.job.device", "bulkUpdateJob..device"} {
+		_, _, ok = SplitCorrelationID(v)
+		require.False(t, ok, v)
+	}
+}
+
+func TestExecutorConcurrencyLimit(t *testing.T) {
+	e, s, ra := newTestExecutor()
+	defer e.Close()
+	ctx := context.Background()
+
+	deviceIDs := []string{"d1", "d2", "d3"}
+	job, err := e.CreateJob(ctx, "owner", deviceIDs, &pb.CreateBulkUpdateJobRequest{
+		Href:             "/light/1",
+		Content:          &pb.Content{ContentType: "application/json", Data: []byte(`{}`)},
+		ConcurrencyLimit: 2,
+		TimeToLive:       int64(time.Minute),
+	})
+	require.NoError(t, err)
+
+	for i := range deviceIDs {
+		// the next device is updated when the pending update is finished
+		expected := min(i+2, len(deviceIDs))
+		require.Eventually(t, func() bool {
+			return len(ra.Updates()) == expected
+		}, time.Second, time.Millisecond*10)
+		require.Never(t, func() bool {
+			return len(ra.Updates()) > expected
+		}, time.Millisecond*100, time.Millisecond*10)
+		update := ra.Update(deviceIDs[i])
+		require.NotNil(t, update)
+		require.Eventually(t, func() bool {
+			d := s.GetJob(job.GetId()).GetDevices()[i]
+			return d.GetStatus() == pb.BulkUpdateJob_Device_PENDING
+		}, time.Second, time.Millisecond*10)
+		err = e.finishDevice(ctx, makeResourceUpdated(update))
+		require.NoError(t, err)
+	}
+
+	require.Eventually(t, func() bool {
+		return s.GetJob(job.GetId()).GetStatus() == pb.BulkUpdateJob_DONE
+	}, time.Second, time.Millisecond*10)
+	for _, d := range s.GetJob(job.GetId()).GetDevices() {
+		require.Equal(t, pb.BulkUpdateJob_Device_DONE, d.GetStatus())
+		require.Equal(t, commands.Status_OK, d.GetResourceUpdated().GetStatus())
+	}
+}
+
+func TestExecutorTimeout(t *testing.T) {
+	e, s, _ := newTestExecutor()
+	defer e.Close()
+
+	job, err := e.CreateJob(context.Background(), "owner", []string{"d1"}, &pb.CreateBulkUpdateJobRequest{
+		Href:             "/light/1",
+		Content:          &pb.Content{ContentType: "application/json", Data: []byte(`{}`)},
+		ConcurrencyLimit: 1,
+		TimeToLive:       int64(time.Millisecond * 200),
+	})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		return s.GetJob(job.GetId()).GetStatus() == pb.BulkUpdateJob_DONE
+	}, time.Second*2, time.Millisecond*10)
+	require.Equal(t, pb.BulkUpdateJob_Device_TIMEOUT, s.GetJob(job.GetId()).GetDevices()[0].GetStatus())
+}
+
+func TestExecutorCancelAndResume(t *testing.T) {
+	e, s, ra := newTestExecutor()
+	ctx := context.Background()
+
+	job, err := e.CreateJob(ctx, "owner", []string{"d1", "d2", "d3"}, &pb.CreateBulkUpdateJobRequest{
+		Href:             "/light/1",
+		Content:          &pb.Content{ContentType: "application/json", Data: []byte(`{}`)},
+		ConcurrencyLimit: 1,
+		TimeToLive:       int64(time.Minute),
+	})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		return s.GetJob(job.GetId()).GetDevices()[0].GetStatus() == pb.BulkUpdateJob_Device_PENDING
+	}, time.Second, time.Millisecond*10)
+
+	// the restarted executor continues with the pending device
+	e.Close()
+	e = NewExecutor(ctx, s, ra, memory.GetToken, nil, time.Minute, log.Get())
+	defer e.Close()
+	err = e.ResumeJobs(ctx)
+	require.NoError(t, err)
+	err = e.finishDevice(ctx, makeResourceUpdated(ra.Update("d1")))
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		return s.GetJob(job.GetId()).GetDevices()[1].GetStatus() == pb.BulkUpdateJob_Device_PENDING
+	}, time.Second, time.Millisecond*10)
+
+	canceled, err := e.CancelJob(ctx, "owner", job.GetId())
+	require.NoError(t, err)
+	require.Equal(t, pb.BulkUpdateJob_CANCELED, canceled.GetStatus())
+	require.Equal(t, []pb.BulkUpdateJob_Device_Status{
+		pb.BulkUpdateJob_Device_DONE,
+		pb.BulkUpdateJob_Device_CANCELED,
+		pb.BulkUpdateJob_Device_CANCELED,
+	}, []pb.BulkUpdateJob_Device_Status{
+		canceled.GetDevices()[0].GetStatus(),
+		canceled.GetDevices()[1].GetStatus(),
+		canceled.GetDevices()[2].GetStatus(),
+	})
+	require.Equal(t, []string{CorrelationID(job.GetId(), "d2")}, ra.Canceled())
+	require.Len(t, ra.Updates(), 2)
+
+	_, err = e.CancelJob(ctx, "owner", job.GetId())
+	require.ErrorIs(t, err, store.ErrNotFound)
+}
+
+func TestExecutorJobIsExecutedByOneInstance(t *testing.T) {
+	e1, s, ra := newTestExecutor()
+	defer e1.Close()
+	ctx := context.Background()
+	job, err := e1.CreateJob(ctx, "owner", []string{"d1", "d2"}, &pb.CreateBulkUpdateJobRequest{
+		Href:             "/light/1",
+		Content:          &pb.Content{ContentType: "application/json", Data: []byte(`{}`)},
+		ConcurrencyLimit: 1,
+		TimeToLive:       int64(time.Minute),
+	})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		return len(ra.Updates()) == 1
+	}, time.Second, time.Millisecond*10)
+
+	// the job claimed by the first instance is not resumed by the second instance
+	e2 := NewExecutor(ctx, s, ra, memory.GetToken, nil, time.Minute, log.Get())
+	defer e2.Close()
+	err = e2.ResumeJobs(ctx)
+	require.NoError(t, err)
+	require.False(t, e2.isRunning(job.GetId()))
+	require.Never(t, func() bool {
+		return len(ra.Updates()) > 1
+	}, time.Millisecond*100, time.Millisecond*10)
+
+	// the job is taken over when the first instance stops
+	e1.Close()
+	err = e2.ResumeJobs(ctx)
+	require.NoError(t, err)
+	require.True(t, e2.isRunning(job.GetId()))
+}
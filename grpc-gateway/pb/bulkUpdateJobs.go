@@ -0,0 +1,38 @@
+package pb
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	JobIDKey           = "_id"       // must match with BulkUpdateJob.Id tag
+	JobOwnerKey        = "owner"     // must match with BulkUpdateJob.Owner tag
+	JobStatusKey       = "status"    // must match with BulkUpdateJob.Status tag
+	JobDevicesKey      = "devices"   // must match with BulkUpdateJob.Devices tag
+	JobCreatedAtKey    = "createdAt" // must match with BulkUpdateJob.CreatedAt tag
+	JobDeviceIDKey     = "deviceId"  // must match with BulkUpdateJob_Device.DeviceId tag
+	JobDeviceStatusKey = "status"    // must match with BulkUpdateJob_Device.Status tag
+)
+
+// minimal time to live of the command, see UpdateResourceRequest.time_to_live
+const minTimeToLive = int64(100 * time.Millisecond)
+
+func (req *CreateBulkUpdateJobRequest) Validate() error {
+	if req.GetHref() == "" {
+		return errors.New("invalid href")
+	}
+	if req.GetContent() == nil {
+		return errors.New("invalid content")
+	}
+	if req.GetTimeToLive() < 0 || (req.GetTimeToLive() > 0 && req.GetTimeToLive() < minTimeToLive) {
+		return fmt.Errorf("invalid timeToLive(%v)", time.Duration(req.GetTimeToLive()))
+	}
+	return nil
+}
+
+// IsFinished returns true when the update of the device is not queued or pending.
+func (d *BulkUpdateJob_Device) IsFinished() bool {
+	return d.GetStatus() != BulkUpdateJob_Device_QUEUED && d.GetStatus() != BulkUpdateJob_Device_PENDING
+}
@@ -3,3 +3,39 @@ package pb
 func (r *GetEventsRequest) ConvertHTTPResourceIDFilter() []*ResourceIdFilter {
 	return ResourceIdFilterFromString(r.GetHttpResourceIdFilter())
 }
+
+// ToEvent converts the stored event to the event of the subscription. The snapshot events don't
+// have the subscription counterpart, so nil is returned for them.
+func (r *GetEventsResponse) ToEvent() *Event {
+	switch v := r.GetType().(type) {
+	case *GetEventsResponse_ResourceLinksPublished:
+		return &Event{Type: &Event_ResourcePublished{ResourcePublished: v.ResourceLinksPublished}}
+	case *GetEventsResponse_ResourceLinksUnpublished:
+		return &Event{Type: &Event_ResourceUnpublished{ResourceUnpublished: v.ResourceLinksUnpublished}}
+	case *GetEventsResponse_ResourceChanged:
+		return &Event{Type: &Event_ResourceChanged{ResourceChanged: v.ResourceChanged}}
+	case *GetEventsResponse_ResourceUpdatePending:
+		return &Event{Type: &Event_ResourceUpdatePending{ResourceUpdatePending: v.ResourceUpdatePending}}
+	case *GetEventsResponse_ResourceUpdated:
+		return &Event{Type: &Event_ResourceUpdated{ResourceUpdated: v.ResourceUpdated}}
+	case *GetEventsResponse_ResourceRetrievePending:
+		return &Event{Type: &Event_ResourceRetrievePending{ResourceRetrievePending: v.ResourceRetrievePending}}
+	case *GetEventsResponse_ResourceRetrieved:
+		return &Event{Type: &Event_ResourceRetrieved{ResourceRetrieved: v.ResourceRetrieved}}
+	case *GetEventsResponse_ResourceDeletePending:
+		return &Event{Type: &Event_ResourceDeletePending{ResourceDeletePending: v.ResourceDeletePending}}
+	case *GetEventsResponse_ResourceDeleted:
+		return &Event{Type: &Event_ResourceDeleted{ResourceDeleted: v.ResourceDeleted}}
+	case *GetEventsResponse_ResourceCreatePending:
+		return &Event{Type: &Event_ResourceCreatePending{ResourceCreatePending: v.ResourceCreatePending}}
+	case *GetEventsResponse_ResourceCreated:
+		return &Event{Type: &Event_ResourceCreated{ResourceCreated: v.ResourceCreated}}
+	case *GetEventsResponse_DeviceMetadataUpdatePending:
+		return &Event{Type: &Event_DeviceMetadataUpdatePending{DeviceMetadataUpdatePending: v.DeviceMetadataUpdatePending}}
+	case *GetEventsResponse_DeviceMetadataUpdated:
+		return &Event{Type: &Event_DeviceMetadataUpdated{DeviceMetadataUpdated: v.DeviceMetadataUpdated}}
+	case *GetEventsResponse_DeviceLabelsUpdated:
+		return &Event{Type: &Event_DeviceLabelsUpdated{DeviceLabelsUpdated: v.DeviceLabelsUpdated}}
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package pb
+
+import (
+	"errors"
+	"io"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// NextPageTokenKey is the key of the response trailer metadata which contains the page_token of the next page.
+// The token is also set to the response header, so it is available before the items of the page are received
+// and the HTTP gateway can forward it as the Next-Page-Token header.
+const NextPageTokenKey = "next-page-token"
+
+// NextPageToken returns the page_token of the next page from the response header of the stream.
+// The empty string means that there are no more pages.
+func NextPageToken(stream grpc.ClientStream) (string, error) {
+	md, err := stream.Header()
+	if err != nil {
+		return "", err
+	}
+	return GetNextPageToken(md), nil
+}
+
+// GetNextPageToken returns the page_token of the next page from the metadata.
+func GetNextPageToken(md metadata.MD) string {
+	v := md.Get(NextPageTokenKey)
+	if len(v) == 0 {
+		return ""
+	}
+	return v[0]
+}
+
+// ForEachPage calls onItem for the items of all pages. The getPage is called with the page_token of each page,
+// starting with the empty one, until the response trailer contains no next page token.
+func ForEachPage[T any](getPage func(pageToken string) (grpc.ServerStreamingClient[T], error), onItem func(*T) error) error {
+	var pageToken string
+	for {
+		stream, err := getPage(pageToken)
+		if err != nil {
+			return err
+		}
+		for {
+			v, err := stream.Recv()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			if err = onItem(v); err != nil {
+				return err
+			}
+		}
+		pageToken = GetNextPageToken(stream.Trailer())
+		if pageToken == "" {
+			return nil
+		}
+	}
+}
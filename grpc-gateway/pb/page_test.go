@@ -0,0 +1,58 @@
+package pb
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+type testPageStream struct {
+	grpc.ClientStream
+	items   []*Device
+	trailer metadata.MD
+}
+
+func (s *testPageStream) Recv() (*Device, error) {
+	if len(s.items) == 0 {
+		return nil, io.EOF
+	}
+	d := s.items[0]
+	s.items = s.items[1:]
+	return d, nil
+}
+
+func (s *testPageStream) Trailer() metadata.MD {
+	return s.trailer
+}
+
+func TestForEachPage(t *testing.T) {
+	pages := map[string]*testPageStream{
+		"":  {items: []*Device{{Id: "a"}, {Id: "b"}}, trailer: metadata.Pairs(NextPageTokenKey, "b")},
+		"b": {items: []*Device{{Id: "c"}}, trailer: metadata.Pairs(NextPageTokenKey, "c")},
+		"c": {},
+	}
+	var tokens []string
+	var got []string
+	err := ForEachPage(func(pageToken string) (grpc.ServerStreamingClient[Device], error) {
+		tokens = append(tokens, pageToken)
+		return pages[pageToken], nil
+	}, func(d *Device) error {
+		got = append(got, d.GetId())
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"", "b", "c"}, tokens)
+	require.Equal(t, []string{"a", "b", "c"}, got)
+
+	errStop := errors.New("stop")
+	err = ForEachPage(func(string) (grpc.ServerStreamingClient[Device], error) {
+		return &testPageStream{items: []*Device{{Id: "a"}}}, nil
+	}, func(*Device) error {
+		return errStop
+	})
+	require.ErrorIs(t, err, errStop)
+}
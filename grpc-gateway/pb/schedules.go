@@ -0,0 +1,91 @@
+package pb
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+const (
+	ScheduleIDKey        = "_id"       // must match with Schedule.Id tag
+	ScheduleOwnerKey     = "owner"     // must match with Schedule.Owner tag
+	ScheduleStatusKey    = "status"    // must match with Schedule.Status tag
+	ScheduleNextRunAtKey = "nextRunAt" // must match with Schedule.NextRunAt tag
+	ScheduleRunsKey      = "runs"      // must match with Schedule.Runs tag
+	ScheduleCreatedAtKey = "createdAt" // must match with Schedule.CreatedAt tag
+)
+
+func (c *ScheduledCommand) Validate() error {
+	if c == nil {
+		return errors.New("invalid command")
+	}
+	if c.GetDeviceId() == "" {
+		return errors.New("invalid deviceId")
+	}
+	if c.GetHref() == "" {
+		return errors.New("invalid href")
+	}
+	switch c.GetType() {
+	case ScheduledCommand_UPDATE_RESOURCE, ScheduledCommand_CREATE_RESOURCE:
+		if c.GetContent() == nil {
+			return errors.New("invalid content")
+		}
+	case ScheduledCommand_DELETE_RESOURCE:
+	default:
+		return fmt.Errorf("invalid type(%v)", c.GetType())
+	}
+	if c.GetTimeToLive() < 0 || (c.GetTimeToLive() > 0 && c.GetTimeToLive() < minTimeToLive) {
+		return fmt.Errorf("invalid timeToLive(%v)", time.Duration(c.GetTimeToLive()))
+	}
+	return nil
+}
+
+// cronTimeZonePrefixes are the prefixes of the cron expression which set its time zone.
+var cronTimeZonePrefixes = []string{"CRON_TZ=", "TZ="}
+
+// ParseCron parses the cron expression of the schedule. The expression is evaluated in UTC, unless the time zone
+// is set by the prefix, eg. "CRON_TZ=Europe/Prague 0 22 * * *".
+func ParseCron(spec string) (cron.Schedule, error) {
+	for _, prefix := range cronTimeZonePrefixes {
+		if !strings.HasPrefix(spec, prefix) {
+			continue
+		}
+		tz, _, ok := strings.Cut(spec[len(prefix):], " ")
+		if !ok {
+			// the parser panics when the expression is missing after the time zone
+			return nil, errors.New("missing expression after time zone")
+		}
+		// the local time zone differs between the instances of the service
+		if tz == "" || tz == "Local" {
+			return nil, fmt.Errorf("invalid time zone('%v')", tz)
+		}
+		return cron.ParseStandard(spec)
+	}
+	return cron.ParseStandard("CRON_TZ=UTC " + spec)
+}
+
+func (req *CreateScheduleRequest) Validate() error {
+	if err := req.GetCommand().Validate(); err != nil {
+		return fmt.Errorf("command: %w", err)
+	}
+	if (req.GetCron() == "") == (req.GetRunAt() == 0) {
+		return errors.New("exactly one of cron and runAt must be set")
+	}
+	if req.GetRunAt() < 0 {
+		return fmt.Errorf("invalid runAt(%v)", req.GetRunAt())
+	}
+	if req.GetCron() != "" {
+		if _, err := ParseCron(req.GetCron()); err != nil {
+			return fmt.Errorf("invalid cron('%v'): %w", req.GetCron(), err)
+		}
+	}
+	return nil
+}
+
+// IsRecurring returns true when the schedule runs repeatedly by the cron expression.
+func (s *Schedule) IsRecurring() bool {
+	return s.GetCron() != ""
+}
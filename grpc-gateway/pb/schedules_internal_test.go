@@ -0,0 +1,50 @@
+package pb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCron(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	prague, err := time.LoadLocation("Europe/Prague")
+	require.NoError(t, err)
+
+	// the expression without the time zone is evaluated in UTC
+	c, err := ParseCron("0 22 * * *")
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2024, 1, 1, 22, 0, 0, 0, time.UTC).UnixNano(), c.Next(now).UnixNano())
+
+	for _, spec := range []string{"CRON_TZ=Europe/Prague 0 22 * * *", "TZ=Europe/Prague 0 22 * * *"} {
+		c, err = ParseCron(spec)
+		require.NoError(t, err, spec)
+		require.Equal(t, time.Date(2024, 1, 1, 22, 0, 0, 0, prague).UnixNano(), c.Next(now).UnixNano(), spec)
+	}
+
+	for _, spec := range []string{
+		"CRON_TZ=Invalid/Zone 0 22 * * *",
+		"CRON_TZ=Local 0 22 * * *",
+		"CRON_TZ= 0 22 * * *",
+		"CRON_TZ=Europe/Prague",
+		"0 22 * *",
+	} {
+		_, err = ParseCron(spec)
+		require.Error(t, err, spec)
+	}
+}
+
+func TestCreateScheduleRequestValidateTimeZone(t *testing.T) {
+	req := &CreateScheduleRequest{
+		Command: &ScheduledCommand{
+			Type:     ScheduledCommand_DELETE_RESOURCE,
+			DeviceId: "d1",
+			Href:     "/light/1",
+		},
+		Cron: "CRON_TZ=Europe/Prague 0 22 * * *",
+	}
+	require.NoError(t, req.Validate())
+	req.Cron = "CRON_TZ=Invalid/Zone 0 22 * * *"
+	require.Error(t, req.Validate())
+}
@@ -36,6 +36,13 @@ const (
 	GrpcGateway_CancelPendingMetadataUpdates_FullMethodName = "/grpcgateway.pb.GrpcGateway/CancelPendingMetadataUpdates"
 	GrpcGateway_GetDevicesMetadata_FullMethodName           = "/grpcgateway.pb.GrpcGateway/GetDevicesMetadata"
 	GrpcGateway_GetEvents_FullMethodName                    = "/grpcgateway.pb.GrpcGateway/GetEvents"
+	GrpcGateway_GetResourceHistory_FullMethodName           = "/grpcgateway.pb.GrpcGateway/GetResourceHistory"
+	GrpcGateway_CreateBulkUpdateJob_FullMethodName          = "/grpcgateway.pb.GrpcGateway/CreateBulkUpdateJob"
+	GrpcGateway_GetJobs_FullMethodName                      = "/grpcgateway.pb.GrpcGateway/GetJobs"
+	GrpcGateway_CancelJob_FullMethodName                    = "/grpcgateway.pb.GrpcGateway/CancelJob"
+	GrpcGateway_CreateSchedule_FullMethodName               = "/grpcgateway.pb.GrpcGateway/CreateSchedule"
+	GrpcGateway_GetSchedules_FullMethodName                 = "/grpcgateway.pb.GrpcGateway/GetSchedules"
+	GrpcGateway_DeleteSchedules_FullMethodName              = "/grpcgateway.pb.GrpcGateway/DeleteSchedules"
 )
 
 // GrpcGatewayClient is the client API for GrpcGateway service.
@@ -75,6 +82,20 @@ type GrpcGatewayClient interface {
 	GetDevicesMetadata(ctx context.Context, in *GetDevicesMetadataRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[events.DeviceMetadataUpdated], error)
 	// Get events for given combination of device id, resource id and timestamp
 	GetEvents(ctx context.Context, in *GetEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetEventsResponse], error)
+	// Get archived values of the resource for the time range. The values can be downsampled to buckets.
+	GetResourceHistory(ctx context.Context, in *GetResourceHistoryRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetResourceHistoryResponse], error)
+	// Create the job which updates the resource at all selected devices. The job is executed in the background.
+	CreateBulkUpdateJob(ctx context.Context, in *CreateBulkUpdateJobRequest, opts ...grpc.CallOption) (*BulkUpdateJob, error)
+	// Get jobs with the results of the devices.
+	GetJobs(ctx context.Context, in *GetJobsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BulkUpdateJob], error)
+	// Cancel the running job. The queued devices are not updated and the pending updates are canceled.
+	CancelJob(ctx context.Context, in *CancelJobRequest, opts ...grpc.CallOption) (*BulkUpdateJob, error)
+	// Create the schedule which executes the resource command at the given time or repeatedly by the cron expression.
+	CreateSchedule(ctx context.Context, in *CreateScheduleRequest, opts ...grpc.CallOption) (*Schedule, error)
+	// Get schedules with the latest runs.
+	GetSchedules(ctx context.Context, in *GetSchedulesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Schedule], error)
+	// Delete schedules. The pending commands of the previous runs are not canceled.
+	DeleteSchedules(ctx context.Context, in *DeleteSchedulesRequest, opts ...grpc.CallOption) (*DeleteSchedulesResponse, error)
 }
 
 type grpcGatewayClient struct {
@@ -302,6 +323,103 @@ func (c *grpcGatewayClient) GetEvents(ctx context.Context, in *GetEventsRequest,
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type GrpcGateway_GetEventsClient = grpc.ServerStreamingClient[GetEventsResponse]
 
+func (c *grpcGatewayClient) GetResourceHistory(ctx context.Context, in *GetResourceHistoryRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetResourceHistoryResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GrpcGateway_ServiceDesc.Streams[7], GrpcGateway_GetResourceHistory_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetResourceHistoryRequest, GetResourceHistoryResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GrpcGateway_GetResourceHistoryClient = grpc.ServerStreamingClient[GetResourceHistoryResponse]
+
+func (c *grpcGatewayClient) CreateBulkUpdateJob(ctx context.Context, in *CreateBulkUpdateJobRequest, opts ...grpc.CallOption) (*BulkUpdateJob, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkUpdateJob)
+	err := c.cc.Invoke(ctx, GrpcGateway_CreateBulkUpdateJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *grpcGatewayClient) GetJobs(ctx context.Context, in *GetJobsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BulkUpdateJob], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GrpcGateway_ServiceDesc.Streams[8], GrpcGateway_GetJobs_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetJobsRequest, BulkUpdateJob]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GrpcGateway_GetJobsClient = grpc.ServerStreamingClient[BulkUpdateJob]
+
+func (c *grpcGatewayClient) CancelJob(ctx context.Context, in *CancelJobRequest, opts ...grpc.CallOption) (*BulkUpdateJob, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkUpdateJob)
+	err := c.cc.Invoke(ctx, GrpcGateway_CancelJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *grpcGatewayClient) CreateSchedule(ctx context.Context, in *CreateScheduleRequest, opts ...grpc.CallOption) (*Schedule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Schedule)
+	err := c.cc.Invoke(ctx, GrpcGateway_CreateSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *grpcGatewayClient) GetSchedules(ctx context.Context, in *GetSchedulesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Schedule], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GrpcGateway_ServiceDesc.Streams[9], GrpcGateway_GetSchedules_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetSchedulesRequest, Schedule]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GrpcGateway_GetSchedulesClient = grpc.ServerStreamingClient[Schedule]
+
+func (c *grpcGatewayClient) DeleteSchedules(ctx context.Context, in *DeleteSchedulesRequest, opts ...grpc.CallOption) (*DeleteSchedulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteSchedulesResponse)
+	err := c.cc.Invoke(ctx, GrpcGateway_DeleteSchedules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GrpcGatewayServer is the server API for GrpcGateway service.
 // All implementations must embed UnimplementedGrpcGatewayServer
 // for forward compatibility.
@@ -339,6 +457,20 @@ type GrpcGatewayServer interface {
 	GetDevicesMetadata(*GetDevicesMetadataRequest, grpc.ServerStreamingServer[events.DeviceMetadataUpdated]) error
 	// Get events for given combination of device id, resource id and timestamp
 	GetEvents(*GetEventsRequest, grpc.ServerStreamingServer[GetEventsResponse]) error
+	// Get archived values of the resource for the time range. The values can be downsampled to buckets.
+	GetResourceHistory(*GetResourceHistoryRequest, grpc.ServerStreamingServer[GetResourceHistoryResponse]) error
+	// Create the job which updates the resource at all selected devices. The job is executed in the background.
+	CreateBulkUpdateJob(context.Context, *CreateBulkUpdateJobRequest) (*BulkUpdateJob, error)
+	// Get jobs with the results of the devices.
+	GetJobs(*GetJobsRequest, grpc.ServerStreamingServer[BulkUpdateJob]) error
+	// Cancel the running job. The queued devices are not updated and the pending updates are canceled.
+	CancelJob(context.Context, *CancelJobRequest) (*BulkUpdateJob, error)
+	// Create the schedule which executes the resource command at the given time or repeatedly by the cron expression.
+	CreateSchedule(context.Context, *CreateScheduleRequest) (*Schedule, error)
+	// Get schedules with the latest runs.
+	GetSchedules(*GetSchedulesRequest, grpc.ServerStreamingServer[Schedule]) error
+	// Delete schedules. The pending commands of the previous runs are not canceled.
+	DeleteSchedules(context.Context, *DeleteSchedulesRequest) (*DeleteSchedulesResponse, error)
 	mustEmbedUnimplementedGrpcGatewayServer()
 }
 
@@ -397,6 +529,27 @@ func (UnimplementedGrpcGatewayServer) GetDevicesMetadata(*GetDevicesMetadataRequ
 func (UnimplementedGrpcGatewayServer) GetEvents(*GetEventsRequest, grpc.ServerStreamingServer[GetEventsResponse]) error {
 	return status.Errorf(codes.Unimplemented, "method GetEvents not implemented")
 }
+func (UnimplementedGrpcGatewayServer) GetResourceHistory(*GetResourceHistoryRequest, grpc.ServerStreamingServer[GetResourceHistoryResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method GetResourceHistory not implemented")
+}
+func (UnimplementedGrpcGatewayServer) CreateBulkUpdateJob(context.Context, *CreateBulkUpdateJobRequest) (*BulkUpdateJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBulkUpdateJob not implemented")
+}
+func (UnimplementedGrpcGatewayServer) GetJobs(*GetJobsRequest, grpc.ServerStreamingServer[BulkUpdateJob]) error {
+	return status.Errorf(codes.Unimplemented, "method GetJobs not implemented")
+}
+func (UnimplementedGrpcGatewayServer) CancelJob(context.Context, *CancelJobRequest) (*BulkUpdateJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelJob not implemented")
+}
+func (UnimplementedGrpcGatewayServer) CreateSchedule(context.Context, *CreateScheduleRequest) (*Schedule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSchedule not implemented")
+}
+func (UnimplementedGrpcGatewayServer) GetSchedules(*GetSchedulesRequest, grpc.ServerStreamingServer[Schedule]) error {
+	return status.Errorf(codes.Unimplemented, "method GetSchedules not implemented")
+}
+func (UnimplementedGrpcGatewayServer) DeleteSchedules(context.Context, *DeleteSchedulesRequest) (*DeleteSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSchedules not implemented")
+}
 func (UnimplementedGrpcGatewayServer) mustEmbedUnimplementedGrpcGatewayServer() {}
 func (UnimplementedGrpcGatewayServer) testEmbeddedByValue()                     {}
 
@@ -653,6 +806,111 @@ func _GrpcGateway_GetEvents_Handler(srv interface{}, stream grpc.ServerStream) e
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type GrpcGateway_GetEventsServer = grpc.ServerStreamingServer[GetEventsResponse]
 
+func _GrpcGateway_GetResourceHistory_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetResourceHistoryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GrpcGatewayServer).GetResourceHistory(m, &grpc.GenericServerStream[GetResourceHistoryRequest, GetResourceHistoryResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GrpcGateway_GetResourceHistoryServer = grpc.ServerStreamingServer[GetResourceHistoryResponse]
+
+func _GrpcGateway_CreateBulkUpdateJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBulkUpdateJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GrpcGatewayServer).CreateBulkUpdateJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GrpcGateway_CreateBulkUpdateJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GrpcGatewayServer).CreateBulkUpdateJob(ctx, req.(*CreateBulkUpdateJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GrpcGateway_GetJobs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetJobsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GrpcGatewayServer).GetJobs(m, &grpc.GenericServerStream[GetJobsRequest, BulkUpdateJob]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GrpcGateway_GetJobsServer = grpc.ServerStreamingServer[BulkUpdateJob]
+
+func _GrpcGateway_CancelJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GrpcGatewayServer).CancelJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GrpcGateway_CancelJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GrpcGatewayServer).CancelJob(ctx, req.(*CancelJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GrpcGateway_CreateSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GrpcGatewayServer).CreateSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GrpcGateway_CreateSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GrpcGatewayServer).CreateSchedule(ctx, req.(*CreateScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GrpcGateway_GetSchedules_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetSchedulesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GrpcGatewayServer).GetSchedules(m, &grpc.GenericServerStream[GetSchedulesRequest, Schedule]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GrpcGateway_GetSchedulesServer = grpc.ServerStreamingServer[Schedule]
+
+func _GrpcGateway_DeleteSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GrpcGatewayServer).DeleteSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GrpcGateway_DeleteSchedules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GrpcGatewayServer).DeleteSchedules(ctx, req.(*DeleteSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // GrpcGateway_ServiceDesc is the grpc.ServiceDesc for GrpcGateway service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -696,6 +954,22 @@ var GrpcGateway_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelPendingMetadataUpdates",
 			Handler:    _GrpcGateway_CancelPendingMetadataUpdates_Handler,
 		},
+		{
+			MethodName: "CreateBulkUpdateJob",
+			Handler:    _GrpcGateway_CreateBulkUpdateJob_Handler,
+		},
+		{
+			MethodName: "CancelJob",
+			Handler:    _GrpcGateway_CancelJob_Handler,
+		},
+		{
+			MethodName: "CreateSchedule",
+			Handler:    _GrpcGateway_CreateSchedule_Handler,
+		},
+		{
+			MethodName: "DeleteSchedules",
+			Handler:    _GrpcGateway_DeleteSchedules_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -734,6 +1008,21 @@ var GrpcGateway_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _GrpcGateway_GetEvents_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetResourceHistory",
+			Handler:       _GrpcGateway_GetResourceHistory_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetJobs",
+			Handler:       _GrpcGateway_GetJobs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetSchedules",
+			Handler:       _GrpcGateway_GetSchedules_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "grpc-gateway/pb/service.proto",
 }
@@ -8,6 +8,11 @@ import (
 	"google.golang.org/grpc/peer"
 )
 
+// IsLabelsUpdate returns true when the request sets or removes labels or the display name of the device.
+func (req *UpdateDeviceMetadataRequest) IsLabelsUpdate() bool {
+	return len(req.GetSetLabels()) > 0 || len(req.GetRemoveLabels()) > 0 || req.GetSetDisplayName() != "" || req.GetRemoveDisplayName()
+}
+
 func (req *UpdateDeviceMetadataRequest) ToRACommand(ctx context.Context) (*commands.UpdateDeviceMetadataRequest, error) {
 	correlationUUID, err := uuid.NewRandom()
 	if err != nil {
@@ -27,11 +32,21 @@ func (req *UpdateDeviceMetadataRequest) ToRACommand(ctx context.Context) (*comma
 		},
 	}
 
-	if req.GetTwinForceSynchronization() {
+	switch {
+	case req.IsLabelsUpdate():
+		r.Update = &commands.UpdateDeviceMetadataRequest_Labels{
+			Labels: &commands.LabelsUpdate{
+				Set:               req.GetSetLabels(),
+				Remove:            req.GetRemoveLabels(),
+				SetDisplayName:    req.GetSetDisplayName(),
+				RemoveDisplayName: req.GetRemoveDisplayName(),
+			},
+		}
+	case req.GetTwinForceSynchronization():
 		r.Update = &commands.UpdateDeviceMetadataRequest_TwinForceSynchronization{
 			TwinForceSynchronization: req.GetTwinForceSynchronization(),
 		}
-	} else {
+	default:
 		r.Update = &commands.UpdateDeviceMetadataRequest_TwinEnabled{
 			TwinEnabled: req.GetTwinEnabled(),
 		}
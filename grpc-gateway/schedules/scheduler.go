@@ -0,0 +1,368 @@
+package schedules
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-co-op/gocron/v2"
+	"github.com/google/uuid"
+	"github.com/plgd-dev/hub/v2/grpc-gateway/jobs"
+	"github.com/plgd-dev/hub/v2/grpc-gateway/pb"
+	"github.com/plgd-dev/hub/v2/grpc-gateway/store"
+	"github.com/plgd-dev/hub/v2/pkg/log"
+	pkgGrpc "github.com/plgd-dev/hub/v2/pkg/net/grpc"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/commands"
+	raService "github.com/plgd-dev/hub/v2/resource-aggregate/service"
+)
+
+// correlationIDPrefix distinguishes the commands of the schedules from other commands.
+const correlationIDPrefix = "schedule"
+
+// MaxRuns is the number of the latest runs stored with the schedule.
+const MaxRuns = 16
+
+// CorrelationID returns the correlation ID of the command of the run: "schedule.{scheduleID}.{startedAt}".
+func CorrelationID(scheduleID string, startedAt int64) string {
+	return correlationIDPrefix + "." + scheduleID + "." + strconv.FormatInt(startedAt, 10)
+}
+
+// SplitCorrelationID returns the scheduleID and the start of the run of the correlation ID created by CorrelationID.
+func SplitCorrelationID(correlationID string) (string, int64, bool) {
+	parts := strings.Split(correlationID, ".")
+	if len(parts) != 3 || parts[0] != correlationIDPrefix || parts[1] == "" {
+		return "", 0, false
+	}
+	startedAt, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		return "", 0, false
+	}
+	return parts[1], startedAt, true
+}
+
+// Scheduler executes the commands of the schedules. The schedules are stored, so every instance of the service
+// loads them and the stored schedules are synchronized periodically. Each run is claimed in the storage
+// before the command is sent, so the command is sent once even when more instances run the schedule.
+type Scheduler struct {
+	ctx       context.Context
+	storage   store.Store
+	raClient  raService.ResourceAggregateClient
+	getToken  jobs.GetTokenFunc
+	validate  jobs.ValidateContentFunc
+	scheduler gocron.Scheduler
+	logger    log.Logger
+
+	lock sync.Mutex
+	jobs map[string]uuid.UUID // gocron jobs by the schedule ID
+}
+
+// New creates the scheduler. When validate is nil, the content of the commands is not validated.
+func New(ctx context.Context, storage store.Store, raClient raService.ResourceAggregateClient, getToken jobs.GetTokenFunc, validate jobs.ValidateContentFunc, syncInterval time.Duration, logger log.Logger) (*Scheduler, error) {
+	scheduler, err := gocron.NewScheduler(gocron.WithLocation(time.UTC))
+	if err != nil {
+		return nil, fmt.Errorf("cannot create scheduler: %w", err)
+	}
+	s := &Scheduler{
+		ctx:       ctx,
+		storage:   storage,
+		raClient:  raClient,
+		getToken:  getToken,
+		validate:  validate,
+		scheduler: scheduler,
+		logger:    logger,
+		jobs:      make(map[string]uuid.UUID),
+	}
+	if err = s.Sync(ctx); err != nil {
+		_ = scheduler.Shutdown()
+		return nil, err
+	}
+	// the schedules created or deleted by other instances of the service are synchronized periodically
+	_, err = scheduler.NewJob(gocron.DurationJob(syncInterval), gocron.NewTask(func() {
+		if errS := s.Sync(s.ctx); errS != nil {
+			s.logger.Errorf("cannot synchronize schedules: %w", errS)
+		}
+	}))
+	if err != nil {
+		_ = scheduler.Shutdown()
+		return nil, fmt.Errorf("cannot create synchronization of schedules: %w", err)
+	}
+	scheduler.Start()
+	return s, nil
+}
+
+// CreateSchedule stores the schedule and plans its runs.
+func (s *Scheduler) CreateSchedule(ctx context.Context, owner string, req *pb.CreateScheduleRequest) (*pb.Schedule, error) {
+	now := time.Now()
+	nextRunAt := req.GetRunAt()
+	if req.GetCron() != "" {
+		cron, err := pb.ParseCron(req.GetCron())
+		if err != nil {
+			return nil, fmt.Errorf("invalid cron('%v'): %w", req.GetCron(), err)
+		}
+		nextRunAt = cron.Next(now).UnixNano()
+	}
+	schedule, err := s.storage.CreateSchedule(ctx, &pb.Schedule{
+		Id:          uuid.NewString(),
+		Owner:       owner,
+		Command:     req.GetCommand(),
+		Cron:        req.GetCron(),
+		RunAt:       req.GetRunAt(),
+		Description: req.GetDescription(),
+		Status:      pb.Schedule_ACTIVE,
+		NextRunAt:   nextRunAt,
+		Runs:        []*pb.Schedule_Run{},
+		CreatedAt:   now.UnixNano(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot create schedule: %w", err)
+	}
+	if err = s.add(schedule); err != nil {
+		s.logger.Errorf("cannot plan schedule(%v): %w", schedule.GetId(), err)
+	}
+	return schedule, nil
+}
+
+// DeleteSchedules deletes the schedules of the owner and stops their runs.
+func (s *Scheduler) DeleteSchedules(ctx context.Context, owner string, idFilter []string) (int64, error) {
+	var ids []string
+	err := s.storage.GetSchedules(ctx, owner, store.GetSchedulesQuery{IDFilter: idFilter}, func(v *pb.Schedule) error {
+		ids = append(ids, v.GetId())
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("cannot get schedules: %w", err)
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	count, err := s.storage.DeleteSchedules(ctx, owner, store.GetSchedulesQuery{IDFilter: ids})
+	if err != nil {
+		return 0, fmt.Errorf("cannot delete schedules: %w", err)
+	}
+	for _, id := range ids {
+		s.remove(id)
+	}
+	return count, nil
+}
+
+// Sync plans the runs of the active stored schedules and stops the runs of the schedules which are not active anymore.
+func (s *Scheduler) Sync(ctx context.Context) error {
+	active := make(map[string]*pb.Schedule)
+	err := s.storage.GetSchedules(ctx, "", store.GetSchedulesQuery{StatusFilter: []pb.Schedule_Status{pb.Schedule_ACTIVE}}, func(v *pb.Schedule) error {
+		active[v.GetId()] = v
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("cannot get active schedules: %w", err)
+	}
+	s.lock.Lock()
+	var removed []string
+	for id := range s.jobs {
+		if _, ok := active[id]; !ok {
+			removed = append(removed, id)
+		}
+	}
+	s.lock.Unlock()
+	for _, id := range removed {
+		s.remove(id)
+	}
+	for _, schedule := range active {
+		if err := s.add(schedule); err != nil {
+			s.logger.Errorf("cannot plan schedule(%v): %w", schedule.GetId(), err)
+		}
+	}
+	return nil
+}
+
+func (s *Scheduler) add(schedule *pb.Schedule) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if _, ok := s.jobs[schedule.GetId()]; ok {
+		return nil
+	}
+	var def gocron.JobDefinition
+	switch {
+	case schedule.IsRecurring():
+		def = gocron.CronJob(schedule.GetCron(), false)
+	case time.Unix(0, schedule.GetRunAt()).After(time.Now()):
+		def = gocron.OneTimeJob(gocron.OneTimeJobStartDateTime(time.Unix(0, schedule.GetRunAt())))
+	default:
+		// the run was missed, eg. the service was not running at that time
+		def = gocron.OneTimeJob(gocron.OneTimeJobStartImmediately())
+	}
+	j, err := s.scheduler.NewJob(def, gocron.NewTask(s.run, schedule.GetId()), gocron.WithName(schedule.GetId()))
+	if err != nil {
+		return err
+	}
+	s.jobs[schedule.GetId()] = j.ID()
+	return nil
+}
+
+func (s *Scheduler) remove(scheduleID string) {
+	s.lock.Lock()
+	jobID, ok := s.jobs[scheduleID]
+	delete(s.jobs, scheduleID)
+	s.lock.Unlock()
+	if !ok {
+		return
+	}
+	if err := s.scheduler.RemoveJob(jobID); err != nil && !errors.Is(err, gocron.ErrJobNotFound) {
+		s.logger.Errorf("cannot remove job of schedule(%v): %w", scheduleID, err)
+	}
+}
+
+func (s *Scheduler) getSchedule(scheduleID string) (*pb.Schedule, error) {
+	var schedule *pb.Schedule
+	err := s.storage.GetSchedules(s.ctx, "", store.GetSchedulesQuery{IDFilter: []string{scheduleID}}, func(v *pb.Schedule) error {
+		schedule = v
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if schedule == nil {
+		return nil, store.ErrNotFound
+	}
+	return schedule, nil
+}
+
+// claim claims the run of the schedule. The runs of the recurring schedule are identified by the next run after them,
+// which is the same for all instances of the service running the schedule.
+func (s *Scheduler) claim(schedule *pb.Schedule, now time.Time) (*pb.Schedule, error) {
+	if !schedule.IsRecurring() {
+		return s.storage.ClaimScheduleRun(s.ctx, store.ClaimScheduleRunRequest{ScheduleID: schedule.GetId(), Done: true})
+	}
+	cron, err := pb.ParseCron(schedule.GetCron())
+	if err != nil {
+		return nil, fmt.Errorf("invalid cron('%v'): %w", schedule.GetCron(), err)
+	}
+	return s.storage.ClaimScheduleRun(s.ctx, store.ClaimScheduleRunRequest{ScheduleID: schedule.GetId(), NextRunAt: cron.Next(now).UnixNano()})
+}
+
+func (s *Scheduler) run(scheduleID string) {
+	now := time.Now()
+	schedule, err := s.getSchedule(scheduleID)
+	if errors.Is(err, store.ErrNotFound) {
+		// the schedule was deleted by another instance
+		s.remove(scheduleID)
+		return
+	}
+	if err != nil {
+		s.logger.Errorf("cannot get schedule(%v): %w", scheduleID, err)
+		return
+	}
+	if !schedule.IsRecurring() {
+		// the single run is planned again by Sync when it is not claimed
+		s.remove(scheduleID)
+	}
+	schedule, err = s.claim(schedule, now)
+	if errors.Is(err, store.ErrNotFound) {
+		// the run was claimed by another instance
+		return
+	}
+	if err != nil {
+		s.logger.Errorf("cannot claim run of schedule(%v): %w", scheduleID, err)
+		return
+	}
+	run := &pb.Schedule_Run{
+		StartedAt:     now.UnixNano(),
+		CorrelationId: CorrelationID(scheduleID, now.UnixNano()),
+	}
+	ttl := schedule.GetCommand().GetTimeToLive()
+	if !schedule.IsRecurring() && ttl > 0 && now.UnixNano() > schedule.GetRunAt()+ttl {
+		// the single run was missed longer than the command would be valid
+		run.Error = fmt.Sprintf("run at %v expired", time.Unix(0, schedule.GetRunAt()))
+	} else {
+		run.ValidUntil, err = s.execute(schedule, run.GetCorrelationId())
+		if err != nil {
+			s.logger.Debugf("cannot execute command of schedule(%v): %v", scheduleID, err)
+			run.Error = err.Error()
+		}
+	}
+	err = s.storage.AddScheduleRun(s.ctx, store.AddScheduleRunRequest{
+		ScheduleID: scheduleID,
+		Run:        run,
+		MaxRuns:    MaxRuns,
+	})
+	if err != nil && !errors.Is(err, store.ErrNotFound) {
+		s.logger.Errorf("cannot store run of schedule(%v): %w", scheduleID, err)
+	}
+}
+
+func (s *Scheduler) validateContent(ctx context.Context, action string, resourceID *commands.ResourceId, resourceInterface string, content *pb.Content) error {
+	if s.validate == nil {
+		return nil
+	}
+	return s.validate(ctx, action, resourceID, resourceInterface, content)
+}
+
+// execute sends the command of the schedule to the resource aggregate by the token of the owner and returns until
+// when the command is valid.
+func (s *Scheduler) execute(schedule *pb.Schedule, correlationID string) (int64, error) {
+	token, err := s.getToken(s.ctx, schedule.GetOwner())
+	if err != nil {
+		return 0, err
+	}
+	ctx := pkgGrpc.CtxWithToken(s.ctx, token)
+	c := schedule.GetCommand()
+	resourceID := commands.NewResourceID(c.GetDeviceId(), c.GetHref())
+	metadata := &commands.CommandMetadata{
+		ConnectionId: schedule.GetId(),
+	}
+	content := &commands.Content{
+		Data:              c.GetContent().GetData(),
+		ContentType:       c.GetContent().GetContentType(),
+		CoapContentFormat: -1,
+	}
+	switch c.GetType() {
+	case pb.ScheduledCommand_UPDATE_RESOURCE:
+		if err = s.validateContent(ctx, "update", resourceID, c.GetResourceInterface(), c.GetContent()); err != nil {
+			return 0, err
+		}
+		res, err := s.raClient.UpdateResource(ctx, &commands.UpdateResourceRequest{
+			ResourceId:        resourceID,
+			CorrelationId:     correlationID,
+			ResourceInterface: c.GetResourceInterface(),
+			TimeToLive:        c.GetTimeToLive(),
+			Content:           content,
+			CommandMetadata:   metadata,
+			Force:             c.GetForce(),
+		})
+		return res.GetValidUntil(), err
+	case pb.ScheduledCommand_CREATE_RESOURCE:
+		if err = s.validateContent(ctx, "create", resourceID, "", c.GetContent()); err != nil {
+			return 0, err
+		}
+		res, err := s.raClient.CreateResource(ctx, &commands.CreateResourceRequest{
+			ResourceId:      resourceID,
+			CorrelationId:   correlationID,
+			TimeToLive:      c.GetTimeToLive(),
+			Content:         content,
+			CommandMetadata: metadata,
+			Force:           c.GetForce(),
+		})
+		return res.GetValidUntil(), err
+	case pb.ScheduledCommand_DELETE_RESOURCE:
+		res, err := s.raClient.DeleteResource(ctx, &commands.DeleteResourceRequest{
+			ResourceId:        resourceID,
+			CorrelationId:     correlationID,
+			ResourceInterface: c.GetResourceInterface(),
+			TimeToLive:        c.GetTimeToLive(),
+			CommandMetadata:   metadata,
+			Force:             c.GetForce(),
+		})
+		return res.GetValidUntil(), err
+	}
+	return 0, fmt.Errorf("unsupported command type(%v)", c.GetType())
+}
+
+// Close stops the runs of the schedules. The schedules are planned again by New after the restart.
+func (s *Scheduler) Close() {
+	if err := s.scheduler.Shutdown(); err != nil {
+		s.logger.Errorf("cannot shutdown scheduler: %w", err)
+	}
+}
@@ -0,0 +1,117 @@
+package schedules
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/plgd-dev/hub/v2/grpc-gateway/pb"
+	"github.com/plgd-dev/hub/v2/grpc-gateway/store"
+	"github.com/plgd-dev/hub/v2/grpc-gateway/test/memory"
+	"github.com/plgd-dev/hub/v2/pkg/log"
+	raService "github.com/plgd-dev/hub/v2/resource-aggregate/service"
+	"github.com/stretchr/testify/require"
+)
+
+func newScheduler(t *testing.T, s store.Store, ra raService.ResourceAggregateClient) *Scheduler {
+	scheduler, err := New(context.Background(), s, ra, memory.GetToken, nil, time.Hour, log.Get())
+	require.NoError(t, err)
+	t.Cleanup(scheduler.Close)
+	return scheduler
+}
+
+func makeCreateScheduleRequest(cron string, runAt int64) *pb.CreateScheduleRequest {
+	return &pb.CreateScheduleRequest{
+		Command: &pb.ScheduledCommand{
+			Type:     pb.ScheduledCommand_UPDATE_RESOURCE,
+			DeviceId: "d1",
+			Href:     "/light/1",
+			Content: &pb.Content{
+				ContentType: "application/json",
+				Data:        []byte(`{"state":false}`),
+			},
+			TimeToLive: int64(time.Minute),
+		},
+		Cron:  cron,
+		RunAt: runAt,
+	}
+}
+
+func TestSchedulerSingleRun(t *testing.T) {
+	s := memory.NewStore()
+	ra := &memory.ResourceAggregateClient{}
+	// both instances of the service plan the run, but the command is sent once
+	scheduler := newScheduler(t, s, ra)
+	other := newScheduler(t, s, ra)
+
+	schedule, err := scheduler.CreateSchedule(context.Background(), "owner", makeCreateScheduleRequest("", time.Now().Add(200*time.Millisecond).UnixNano()))
+	require.NoError(t, err)
+	require.NoError(t, other.Sync(context.Background()))
+
+	require.Eventually(t, func() bool {
+		return len(s.GetSchedule(schedule.GetId()).GetRuns()) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	time.Sleep(200 * time.Millisecond)
+
+	updates := ra.Updates()
+	require.Len(t, updates, 1)
+	stored := s.GetSchedule(schedule.GetId())
+	require.Equal(t, pb.Schedule_DONE, stored.GetStatus())
+	require.Len(t, stored.GetRuns(), 1)
+	run := stored.GetRuns()[0]
+	require.Empty(t, run.GetError())
+	require.Equal(t, run.GetCorrelationId(), updates[0].GetCorrelationId())
+	require.Equal(t, int64(time.Minute), updates[0].GetTimeToLive())
+	id, startedAt, ok := SplitCorrelationID(run.GetCorrelationId())
+	require.True(t, ok)
+	require.Equal(t, schedule.GetId(), id)
+	require.Equal(t, run.GetStartedAt(), startedAt)
+}
+
+func TestSchedulerExpiredSingleRun(t *testing.T) {
+	s := memory.NewStore()
+	ra := &memory.ResourceAggregateClient{}
+	scheduler := newScheduler(t, s, ra)
+
+	// the run was missed longer than the time to live of the command
+	schedule, err := scheduler.CreateSchedule(context.Background(), "owner", makeCreateScheduleRequest("", time.Now().Add(-time.Hour).UnixNano()))
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		return len(s.GetSchedule(schedule.GetId()).GetRuns()) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Empty(t, ra.Updates())
+	require.NotEmpty(t, s.GetSchedule(schedule.GetId()).GetRuns()[0].GetError())
+}
+
+func TestSchedulerRecurringRunIsClaimedOnce(t *testing.T) {
+	s := memory.NewStore()
+	ra := &memory.ResourceAggregateClient{}
+	scheduler := newScheduler(t, s, ra)
+	other := newScheduler(t, s, ra)
+
+	schedule, err := scheduler.CreateSchedule(context.Background(), "owner", makeCreateScheduleRequest("0 22 * * *", 0))
+	require.NoError(t, err)
+	require.Positive(t, schedule.GetNextRunAt())
+	// the cron expression without the time zone is evaluated in UTC
+	nextRunAt := time.Unix(0, schedule.GetNextRunAt()).UTC()
+	require.Equal(t, 22, nextRunAt.Hour())
+	require.Equal(t, 0, nextRunAt.Minute())
+	// the store simulates the run which was planned before
+	s.SetScheduleNextRunAt(schedule.GetId(), time.Now().UnixNano())
+
+	scheduler.run(schedule.GetId())
+	other.run(schedule.GetId())
+	require.Len(t, ra.Updates(), 1)
+	stored := s.GetSchedule(schedule.GetId())
+	require.Equal(t, pb.Schedule_ACTIVE, stored.GetStatus())
+	require.Greater(t, stored.GetNextRunAt(), time.Now().UnixNano())
+	require.Len(t, stored.GetRuns(), 1)
+
+	count, err := other.DeleteSchedules(context.Background(), "owner", nil)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
+	require.NoError(t, scheduler.Sync(context.Background()))
+	scheduler.lock.Lock()
+	require.Empty(t, scheduler.jobs)
+	scheduler.lock.Unlock()
+}
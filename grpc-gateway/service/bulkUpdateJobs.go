@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/plgd-dev/hub/v2/grpc-gateway/pb"
+	"github.com/plgd-dev/hub/v2/grpc-gateway/store"
+	kitNetGrpc "github.com/plgd-dev/hub/v2/pkg/net/grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func (r *RequestHandler) getJobDeviceIDs(ctx context.Context, req *pb.CreateBulkUpdateJobRequest) ([]string, error) {
+	var deviceIDs []string
+	err := pb.ForEachPage(func(pageToken string) (grpc.ServerStreamingClient[pb.Device], error) {
+		return r.resourceDirectoryClient.GetDevices(ctx, &pb.GetDevicesRequest{
+			DeviceIdFilter: req.GetDeviceIdFilter(),
+			TypeFilter:     req.GetTypeFilter(),
+			StatusFilter:   req.GetStatusFilter(),
+			PageToken:      pageToken,
+		})
+	}, func(device *pb.Device) error {
+		deviceIDs = append(deviceIDs, device.GetId())
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return deviceIDs, nil
+}
+
+func (r *RequestHandler) CreateBulkUpdateJob(ctx context.Context, req *pb.CreateBulkUpdateJobRequest) (*pb.BulkUpdateJob, error) {
+	if r.jobExecutor == nil {
+		return nil, status.Errorf(codes.Unimplemented, "cannot create bulk update job: bulk update jobs are disabled")
+	}
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot create bulk update job: %v", err)
+	}
+	owner, err := kitNetGrpc.OwnerFromTokenMD(ctx, r.ownerCache.OwnerClaim())
+	if err != nil {
+		return nil, kitNetGrpc.ForwardErrorf(codes.Unauthenticated, "cannot create bulk update job: %v", err)
+	}
+	deviceIDs, err := r.getJobDeviceIDs(ctx, req)
+	if err != nil {
+		return nil, kitNetGrpc.ForwardErrorf(codes.Internal, "cannot create bulk update job: cannot get devices: %v", err)
+	}
+	// the defaults are resolved in the copy, the request of the caller is not modified
+	jobReq := proto.Clone(req).(*pb.CreateBulkUpdateJobRequest)
+	if jobReq.GetConcurrencyLimit() == 0 {
+		jobReq.ConcurrencyLimit = r.config.APIs.GRPC.BulkUpdateJobs.ConcurrencyLimit
+	}
+	if jobReq.GetTimeToLive() == 0 {
+		jobReq.TimeToLive = r.config.APIs.GRPC.BulkUpdateJobs.TimeToLive.Nanoseconds()
+	}
+	job, err := r.jobExecutor.CreateJob(ctx, owner, deviceIDs, jobReq)
+	if err != nil {
+		return nil, kitNetGrpc.ForwardErrorf(codes.Internal, "cannot create bulk update job: %v", err)
+	}
+	return job, nil
+}
+
+func (r *RequestHandler) GetJobs(req *pb.GetJobsRequest, srv pb.GrpcGateway_GetJobsServer) error {
+	if r.jobExecutor == nil {
+		return status.Errorf(codes.Unimplemented, "cannot get jobs: bulk update jobs are disabled")
+	}
+	owner, err := kitNetGrpc.OwnerFromTokenMD(srv.Context(), r.ownerCache.OwnerClaim())
+	if err != nil {
+		return kitNetGrpc.ForwardErrorf(codes.Unauthenticated, "cannot get jobs: %v", err)
+	}
+	err = r.jobStore.GetJobs(srv.Context(), owner, store.GetJobsQuery{IDFilter: req.GetIdFilter()}, func(job *pb.BulkUpdateJob) error {
+		return srv.Send(job)
+	})
+	if err != nil {
+		return kitNetGrpc.ForwardErrorf(codes.Internal, "cannot get jobs: %v", err)
+	}
+	return nil
+}
+
+func (r *RequestHandler) CancelJob(ctx context.Context, req *pb.CancelJobRequest) (*pb.BulkUpdateJob, error) {
+	if r.jobExecutor == nil {
+		return nil, status.Errorf(codes.Unimplemented, "cannot cancel job: bulk update jobs are disabled")
+	}
+	owner, err := kitNetGrpc.OwnerFromTokenMD(ctx, r.ownerCache.OwnerClaim())
+	if err != nil {
+		return nil, kitNetGrpc.ForwardErrorf(codes.Unauthenticated, "cannot cancel job: %v", err)
+	}
+	job, err := r.jobExecutor.CancelJob(ctx, owner, req.GetId())
+	if errors.Is(err, store.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "cannot cancel job: running job('%v') not found", req.GetId())
+	}
+	if err != nil {
+		return nil, kitNetGrpc.ForwardErrorf(codes.Internal, "cannot cancel job: %v", err)
+	}
+	return job, nil
+}
@@ -4,12 +4,14 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/plgd-dev/hub/v2/grpc-gateway/store/mongodb"
 	"github.com/plgd-dev/hub/v2/pkg/config"
 	"github.com/plgd-dev/hub/v2/pkg/log"
 	"github.com/plgd-dev/hub/v2/pkg/net/grpc/client"
 	"github.com/plgd-dev/hub/v2/pkg/net/grpc/server"
 	otelClient "github.com/plgd-dev/hub/v2/pkg/opentelemetry/collector/client"
-	natsClient "github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/nats/client"
+	"github.com/plgd-dev/hub/v2/pkg/security/oauth2/clientcredentials"
+	eventbusConfig "github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/config"
 )
 
 type Config struct {
@@ -28,6 +30,15 @@ func (c *Config) Validate() error {
 	if err := c.Clients.Validate(); err != nil {
 		return fmt.Errorf("clients.%w", err)
 	}
+	if !c.APIs.GRPC.BulkUpdateJobs.Enabled && !c.APIs.GRPC.Schedules.Enabled {
+		return nil
+	}
+	if err := c.Clients.Storage.Validate(); err != nil {
+		return fmt.Errorf("clients.storage.%w", err)
+	}
+	if err := c.Clients.ServiceAuthorization.Validate(); err != nil {
+		return fmt.Errorf("clients.serviceAuthorization.%w", err)
+	}
 	return nil
 }
 
@@ -37,9 +48,100 @@ type APIsConfig struct {
 }
 
 type GRPCConfig struct {
-	OwnerCacheExpiration   time.Duration `yaml:"ownerCacheExpiration" json:"ownerCacheExpiration"`
-	SubscriptionBufferSize int           `yaml:"subscriptionBufferSize" json:"subscriptionBufferSize"`
-	server.Config          `yaml:",inline" json:",inline"`
+	OwnerCacheExpiration          time.Duration          `yaml:"ownerCacheExpiration" json:"ownerCacheExpiration"`
+	SubscriptionBufferSize        int                    `yaml:"subscriptionBufferSize" json:"subscriptionBufferSize"`
+	SubscriptionMaxReplayedEvents int64                  `yaml:"subscriptionMaxReplayedEvents" json:"subscriptionMaxReplayedEvents"`
+	SubscriptionResumeWindow      time.Duration          `yaml:"subscriptionResumeWindow" json:"subscriptionResumeWindow"`
+	SubscriptionJqEvalTimeout     time.Duration          `yaml:"subscriptionJqEvalTimeout" json:"subscriptionJqEvalTimeout"`
+	MaxPageSize                   int64                  `yaml:"maxPageSize" json:"maxPageSize"`
+	BulkUpdateJobs                BulkUpdateJobsConfig   `yaml:"bulkUpdateJobs" json:"bulkUpdateJobs"`
+	Schedules                     SchedulesConfig        `yaml:"schedules" json:"schedules"`
+	SchemaValidation              SchemaValidationConfig `yaml:"schemaValidation" json:"schemaValidation"`
+	server.Config                 `yaml:",inline" json:",inline"`
+}
+
+// BulkUpdateJobsConfig contains the default values of the bulk update jobs.
+type BulkUpdateJobsConfig struct {
+	Enabled          bool          `yaml:"enabled" json:"enabled"`
+	ConcurrencyLimit uint32        `yaml:"concurrencyLimit" json:"concurrencyLimit"`
+	TimeToLive       time.Duration `yaml:"timeToLive" json:"timeToLive"`
+	// LeaseDuration is the duration of the claim of a running job by the instance. The job is taken over by
+	// another instance when the lease is not renewed.
+	LeaseDuration time.Duration `yaml:"leaseDuration" json:"leaseDuration"`
+}
+
+func (c *BulkUpdateJobsConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.ConcurrencyLimit == 0 {
+		return fmt.Errorf("concurrencyLimit('%v')", c.ConcurrencyLimit)
+	}
+	if c.TimeToLive < 100*time.Millisecond {
+		return fmt.Errorf("timeToLive('%v') - must be at least 100ms", c.TimeToLive)
+	}
+	if c.LeaseDuration < time.Second {
+		return fmt.Errorf("leaseDuration('%v') - must be at least 1s", c.LeaseDuration)
+	}
+	return nil
+}
+
+// SchedulesConfig configures the runner of the scheduled commands.
+type SchedulesConfig struct {
+	Enabled bool `yaml:"enabled" json:"enabled"`
+	// SyncInterval is the interval of the reloading of the schedules created or deleted by other instances.
+	SyncInterval time.Duration `yaml:"syncInterval" json:"syncInterval"`
+}
+
+func (c *SchedulesConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.SyncInterval <= 0 {
+		return fmt.Errorf("syncInterval('%v')", c.SyncInterval)
+	}
+	return nil
+}
+
+type SchemaValidationMode string
+
+const (
+	// SchemaValidationDisabled - the content of the requests is not validated.
+	SchemaValidationDisabled SchemaValidationMode = "disabled"
+	// SchemaValidationAdvisory - the violations of the schema are logged and the requests are processed.
+	SchemaValidationAdvisory SchemaValidationMode = "advisory"
+	// SchemaValidationStrict - the requests which violate the schema are rejected with InvalidArgument.
+	SchemaValidationStrict SchemaValidationMode = "strict"
+)
+
+// SchemaValidationConfig configures the validation of the content of the update and create requests by the schemas
+// from the introspection document of the device.
+type SchemaValidationConfig struct {
+	Mode            SchemaValidationMode `yaml:"mode" json:"mode"`
+	CacheExpiration time.Duration        `yaml:"cacheExpiration" json:"cacheExpiration"`
+	Timeout         time.Duration        `yaml:"timeout" json:"timeout"`
+}
+
+// IsEnabled returns true when the content of the requests is validated.
+func (c *SchemaValidationConfig) IsEnabled() bool {
+	return c.Mode == SchemaValidationAdvisory || c.Mode == SchemaValidationStrict
+}
+
+func (c *SchemaValidationConfig) Validate() error {
+	switch c.Mode {
+	case "", SchemaValidationDisabled:
+		return nil
+	case SchemaValidationAdvisory, SchemaValidationStrict:
+	default:
+		return fmt.Errorf("mode('%v') - supported values are %v, %v, %v", c.Mode, SchemaValidationDisabled, SchemaValidationAdvisory, SchemaValidationStrict)
+	}
+	if c.CacheExpiration <= 0 {
+		return fmt.Errorf("cacheExpiration('%v')", c.CacheExpiration)
+	}
+	if c.Timeout <= 0 {
+		return fmt.Errorf("timeout('%v')", c.Timeout)
+	}
+	return nil
 }
 
 func (c *GRPCConfig) Validate() error {
@@ -49,6 +151,27 @@ func (c *GRPCConfig) Validate() error {
 	if c.SubscriptionBufferSize < 0 {
 		return fmt.Errorf("subscriptionBufferSize('%v')", c.SubscriptionBufferSize)
 	}
+	if c.SubscriptionMaxReplayedEvents <= 0 {
+		return fmt.Errorf("subscriptionMaxReplayedEvents('%v')", c.SubscriptionMaxReplayedEvents)
+	}
+	if c.SubscriptionResumeWindow <= 0 {
+		return fmt.Errorf("subscriptionResumeWindow('%v')", c.SubscriptionResumeWindow)
+	}
+	if c.SubscriptionJqEvalTimeout <= 0 {
+		return fmt.Errorf("subscriptionJqEvalTimeout('%v')", c.SubscriptionJqEvalTimeout)
+	}
+	if c.MaxPageSize <= 0 {
+		return fmt.Errorf("maxPageSize('%v')", c.MaxPageSize)
+	}
+	if err := c.BulkUpdateJobs.Validate(); err != nil {
+		return fmt.Errorf("bulkUpdateJobs.%w", err)
+	}
+	if err := c.Schedules.Validate(); err != nil {
+		return fmt.Errorf("schedules.%w", err)
+	}
+	if err := c.SchemaValidation.Validate(); err != nil {
+		return fmt.Errorf("schemaValidation.%w", err)
+	}
 	return c.Config.Validate()
 }
 
@@ -71,22 +194,25 @@ func (c *IdentityStoreConfig) Validate() error {
 }
 
 type ClientsConfig struct {
-	IdentityStore          IdentityStoreConfig `yaml:"identityStore" json:"identityStore"`
-	Eventbus               EventBusConfig      `yaml:"eventBus" json:"eventBus"`
-	ResourceAggregate      GrpcServerConfig    `yaml:"resourceAggregate" json:"resourceAggregate"`
-	ResourceDirectory      GrpcServerConfig    `yaml:"resourceDirectory" json:"resourceDirectory"`
-	CertificateAuthority   GrpcServerConfig    `yaml:"certificateAuthority" json:"certificateAuthority"`
-	OpenTelemetryCollector otelClient.Config   `yaml:"openTelemetryCollector" json:"openTelemetryCollector"`
+	IdentityStore          IdentityStoreConfig        `yaml:"identityStore" json:"identityStore"`
+	Eventbus               EventBusConfig             `yaml:"eventBus" json:"eventBus"`
+	ResourceAggregate      GrpcServerConfig           `yaml:"resourceAggregate" json:"resourceAggregate"`
+	ResourceDirectory      GrpcServerConfig           `yaml:"resourceDirectory" json:"resourceDirectory"`
+	CertificateAuthority   GrpcServerConfig           `yaml:"certificateAuthority" json:"certificateAuthority"`
+	Storage                StorageConfig              `yaml:"storage" json:"storage"`
+	ServiceAuthorization   ServiceAuthorizationConfig `yaml:"serviceAuthorization" json:"serviceAuthorization"`
+	OpenTelemetryCollector otelClient.Config          `yaml:"openTelemetryCollector" json:"openTelemetryCollector"`
 }
 
 type EventBusConfig struct {
-	GoPoolSize int                         `yaml:"goPoolSize" json:"goPoolSize"`
-	NATS       natsClient.ConfigSubscriber `yaml:"nats" json:"nats"`
+	eventbusConfig.ConfigSubscriber `yaml:",inline" json:",inline"`
+
+	GoPoolSize int `yaml:"goPoolSize" json:"goPoolSize"`
 }
 
 func (c *EventBusConfig) Validate() error {
-	if err := c.NATS.Validate(); err != nil {
-		return fmt.Errorf("nats.%w", err)
+	if err := c.ConfigSubscriber.Validate(); err != nil {
+		return err
 	}
 	return nil
 }
@@ -113,6 +239,36 @@ func (c *ClientsConfig) Validate() error {
 	return nil
 }
 
+// StorageConfig is the storage of the bulk update jobs and the schedules. It is required only when one of them is enabled.
+type StorageConfig struct {
+	MongoDB mongodb.Config `yaml:"mongoDB" json:"mongoDB"`
+}
+
+func (c *StorageConfig) Validate() error {
+	if err := c.MongoDB.Validate(); err != nil {
+		return fmt.Errorf("mongoDB.%w", err)
+	}
+	return nil
+}
+
+// ServiceAuthorizationConfig configures the client credentials flow, which gets the access tokens of the owners for the
+// commands executed in the background by the bulk update jobs and the schedules, so the commands don't depend on the token of the user.
+// The token is requested with the owner claim of the job, so the client is privileged to get the token of any owner.
+type ServiceAuthorizationConfig struct {
+	Provider clientcredentials.Config `yaml:"provider" json:"provider"`
+}
+
+func (c *ServiceAuthorizationConfig) Validate() error {
+	if err := c.Provider.Validate(); err != nil {
+		return fmt.Errorf("provider.%w", err)
+	}
+	// the token is requested with the timeout, so it is valid at least for the timeout
+	if c.Provider.HTTP.Timeout <= 0 {
+		return fmt.Errorf("provider.http.timeout('%v')", c.Provider.HTTP.Timeout)
+	}
+	return nil
+}
+
 type GrpcServerConfig struct {
 	Connection client.Config `yaml:"grpc" json:"grpc"`
 }
@@ -69,6 +69,9 @@ func handleResourceRequest[ReqRA reqRACommand, Event commands.EventContent, Asyn
 }
 
 func (r *RequestHandler) CreateResource(ctx context.Context, req *pb.CreateResourceRequest) (*pb.CreateResourceResponse, error) {
+	if err := r.validateResourceContent(ctx, "create", req.GetResourceId(), "", req.GetContent()); err != nil {
+		return nil, resourceError("create", err)
+	}
 	var resp pb.CreateResourceResponse
 	return &resp, handleResourceRequest(
 		ctx,
@@ -10,11 +10,17 @@ import (
 )
 
 func (r *RequestHandler) GetDevices(req *pb.GetDevicesRequest, srv pb.GrpcGateway_GetDevicesServer) error {
+	if err := r.validatePageSize(req.GetPageSize()); err != nil {
+		return err
+	}
 	ctx := srv.Context()
 	rd, err := r.resourceDirectoryClient.GetDevices(ctx, req)
 	if err != nil {
 		return kitNetGrpc.ForwardErrorf(codes.Internal, "cannot get devices: %v", err)
 	}
+	if err = forwardNextPageToken(rd, srv); err != nil {
+		return err
+	}
 	for {
 		resp, err := rd.Recv()
 		if errors.Is(err, io.EOF) {
@@ -5,6 +5,7 @@ import (
 	"crypto/tls"
 	"errors"
 	"io"
+	"math"
 	"testing"
 	"time"
 
@@ -22,7 +23,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
 )
 
 func TestRequestHandlerGetDevices(t *testing.T) {
@@ -104,4 +107,11 @@ func TestRequestHandlerGetDevices(t *testing.T) {
 			pbTest.CmpDeviceValues(t, tt.want, devices)
 		})
 	}
+
+	t.Run("huge page size", func(t *testing.T) {
+		client, err := c.GetDevices(ctx, &pb.GetDevicesRequest{PageSize: math.MaxInt64})
+		require.NoError(t, err)
+		_, err = client.Recv()
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
 }
@@ -10,11 +10,17 @@ import (
 )
 
 func (r *RequestHandler) GetEvents(req *pb.GetEventsRequest, srv pb.GrpcGateway_GetEventsServer) error {
+	if err := r.validatePageSize(req.GetPageSize()); err != nil {
+		return err
+	}
 	ctx := srv.Context()
 	rd, err := r.resourceDirectoryClient.GetEvents(ctx, req)
 	if err != nil {
 		return kitNetGrpc.ForwardErrorf(codes.Internal, "cannot get events: %v", err)
 	}
+	if err = forwardNextPageToken(rd, srv); err != nil {
+		return err
+	}
 	for {
 		resp, err := rd.Recv()
 		if errors.Is(err, io.EOF) {
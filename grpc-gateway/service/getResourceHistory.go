@@ -0,0 +1,32 @@
+package service
+
+import (
+	"errors"
+	"io"
+
+	"github.com/plgd-dev/hub/v2/grpc-gateway/pb"
+	kitNetGrpc "github.com/plgd-dev/hub/v2/pkg/net/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+func (r *RequestHandler) GetResourceHistory(req *pb.GetResourceHistoryRequest, srv pb.GrpcGateway_GetResourceHistoryServer) error {
+	ctx := srv.Context()
+	rd, err := r.resourceDirectoryClient.GetResourceHistory(ctx, req)
+	if err != nil {
+		return kitNetGrpc.ForwardErrorf(codes.Internal, "cannot get resource history: %v", err)
+	}
+	for {
+		resp, err := rd.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return kitNetGrpc.ForwardErrorf(codes.Internal, "cannot receive resource history: %v", err)
+		}
+		err = srv.Send(resp)
+		if err != nil {
+			return kitNetGrpc.ForwardErrorf(codes.Internal, "cannot send resource history: %v", err)
+		}
+	}
+	return nil
+}
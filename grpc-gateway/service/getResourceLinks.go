@@ -10,11 +10,17 @@ import (
 )
 
 func (r *RequestHandler) GetResourceLinks(req *pb.GetResourceLinksRequest, srv pb.GrpcGateway_GetResourceLinksServer) error {
+	if err := r.validatePageSize(req.GetPageSize()); err != nil {
+		return err
+	}
 	ctx := srv.Context()
 	rd, err := r.resourceDirectoryClient.GetResourceLinks(ctx, req)
 	if err != nil {
 		return kitNetGrpc.ForwardErrorf(codes.Internal, "cannot get resource links: %v", err)
 	}
+	if err = forwardNextPageToken(rd, srv); err != nil {
+		return err
+	}
 	for {
 		resp, err := rd.Recv()
 		if errors.Is(err, io.EOF) {
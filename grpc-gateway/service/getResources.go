@@ -10,11 +10,17 @@ import (
 )
 
 func (r *RequestHandler) GetResources(req *pb.GetResourcesRequest, srv pb.GrpcGateway_GetResourcesServer) error {
+	if err := r.validatePageSize(req.GetPageSize()); err != nil {
+		return err
+	}
 	ctx := srv.Context()
 	rd, err := r.resourceDirectoryClient.GetResources(ctx, req)
 	if err != nil {
 		return kitNetGrpc.ForwardErrorf(codes.Internal, "cannot retrieve resources values: %v", err)
 	}
+	if err = forwardNextPageToken(rd, srv); err != nil {
+		return err
+	}
 	for {
 		resp, err := rd.Recv()
 		if errors.Is(err, io.EOF) {
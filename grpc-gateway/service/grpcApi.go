@@ -1,10 +1,18 @@
 package service
 
 import (
+	"context"
 	"fmt"
+	"time"
 
+	"github.com/google/uuid"
 	pbCA "github.com/plgd-dev/hub/v2/certificate-authority/pb"
+	"github.com/plgd-dev/hub/v2/grpc-gateway/introspection"
+	"github.com/plgd-dev/hub/v2/grpc-gateway/jobs"
 	"github.com/plgd-dev/hub/v2/grpc-gateway/pb"
+	"github.com/plgd-dev/hub/v2/grpc-gateway/schedules"
+	"github.com/plgd-dev/hub/v2/grpc-gateway/store"
+	storeMongo "github.com/plgd-dev/hub/v2/grpc-gateway/store/mongodb"
 	"github.com/plgd-dev/hub/v2/grpc-gateway/subscription"
 	isClient "github.com/plgd-dev/hub/v2/identity-store/client"
 	pbIS "github.com/plgd-dev/hub/v2/identity-store/pb"
@@ -14,10 +22,11 @@ import (
 	"github.com/plgd-dev/hub/v2/pkg/net/grpc/client"
 	"github.com/plgd-dev/hub/v2/pkg/net/grpc/server"
 	raClient "github.com/plgd-dev/hub/v2/resource-aggregate/client"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/commands"
 	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus"
-	naClient "github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/nats/client"
-	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/nats/subscriber"
-	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/utils"
+	eventbusConfig "github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/config"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/events"
+	raService "github.com/plgd-dev/hub/v2/resource-aggregate/service"
 	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
 )
@@ -29,16 +38,20 @@ type RequestHandler struct {
 	resourceDirectoryClient    pb.GrpcGatewayClient
 	resourceAggregateClient    *raClient.Client
 	certificateAuthorityClient pbCA.CertificateAuthorityClient
-	resourceSubscriber         *subscriber.Subscriber
+	resourceSubscriber         eventbusConfig.Subscriber
 	ownerCache                 *isClient.OwnerCache
 	subscriptionsCache         *subscription.SubscriptionsCache
+	jobStore                   store.Store
+	jobExecutor                *jobs.Executor
+	scheduler                  *schedules.Scheduler
+	introspectionCache         *introspection.Cache
 	logger                     log.Logger
 	config                     Config
 	closeFunc                  func()
 }
 
-func addHandler(svr *server.Server, config Config, fileWatcher *fsnotify.Watcher, logger log.Logger, tracerProvider trace.TracerProvider, goroutinePoolGo func(func()) error) error {
-	handler, err := newRequestHandlerFromConfig(config, fileWatcher, logger, tracerProvider, goroutinePoolGo)
+func addHandler(ctx context.Context, svr *server.Server, config Config, fileWatcher *fsnotify.Watcher, logger log.Logger, tracerProvider trace.TracerProvider, goroutinePoolGo func(func()) error) error {
+	handler, err := newRequestHandlerFromConfig(ctx, config, fileWatcher, logger, tracerProvider, goroutinePoolGo)
 	if err != nil {
 		return err
 	}
@@ -108,7 +121,85 @@ func newResourceAggregateClient(config GrpcServerConfig, resourceSubscriber even
 	return raClient, closeRaConn, nil
 }
 
-func newRequestHandlerFromConfig(config Config, fileWatcher *fsnotify.Watcher, logger log.Logger, tracerProvider trace.TracerProvider, goroutinePoolGo func(func()) error) (*RequestHandler, error) {
+func newJobStore(ctx context.Context, config StorageConfig, fileWatcher *fsnotify.Watcher, logger log.Logger, tracerProvider trace.TracerProvider) (store.Store, func(), error) {
+	jobStore, err := storeMongo.New(ctx, &config.MongoDB, fileWatcher, logger, tracerProvider)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot create mongodb store: %w", err)
+	}
+	closeJobStore := func() {
+		if err := jobStore.Close(context.Background()); err != nil {
+			logger.Errorf("error occurs during close connection to mongodb: %w", err)
+		}
+	}
+	return jobStore, closeJobStore, nil
+}
+
+func newJobExecutor(ctx context.Context, jobStore store.Store, leaseDuration time.Duration, getToken jobs.GetTokenFunc, validate jobs.ValidateContentFunc, raClient raService.ResourceAggregateClient, resourceSubscriber eventbus.Subscriber, logger log.Logger) (*jobs.Executor, func(), error) {
+	var closeFunc fn.FuncList
+	executor := jobs.NewExecutor(ctx, jobStore, raClient, getToken, validate, leaseDuration, logger)
+	closeFunc.AddFunc(executor.Close)
+
+	subjects := resourceSubscriber.GetResourceEventSubjects("*", commands.NewResourceID("*", "*"), (&events.ResourceUpdated{}).EventType())
+	observer, err := resourceSubscriber.Subscribe(ctx, uuid.NewString(), subjects, executor)
+	if err != nil {
+		closeFunc.Execute()
+		return nil, nil, fmt.Errorf("cannot subscribe to resource updated events: %w", err)
+	}
+	closeFunc.AddFunc(func() {
+		if err := observer.Close(); err != nil {
+			logger.Errorf("error occurs during close subscription to resource updated events: %w", err)
+		}
+	})
+	if err = executor.ResumeJobs(ctx); err != nil {
+		closeFunc.Execute()
+		return nil, nil, err
+	}
+	return executor, closeFunc.ToFunction(), nil
+}
+
+// newJobsAndSchedules creates the bulk update job executor and the scheduler, when they are enabled. The storage,
+// the owner token provider and the validation of the content are shared by both of them.
+func newJobsAndSchedules(ctx context.Context, config Config, raClient *raClient.Client, validate jobs.ValidateContentFunc, resourceSubscriber eventbus.Subscriber, fileWatcher *fsnotify.Watcher, logger log.Logger, tracerProvider trace.TracerProvider) (store.Store, *jobs.Executor, *schedules.Scheduler, func(), error) {
+	var closeFunc fn.FuncList
+	if !config.APIs.GRPC.BulkUpdateJobs.Enabled && !config.APIs.GRPC.Schedules.Enabled {
+		return nil, nil, nil, closeFunc.ToFunction(), nil
+	}
+	jobStore, closeJobStore, err := newJobStore(ctx, config.Clients.Storage, fileWatcher, logger, tracerProvider)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+	closeFunc.AddFunc(closeJobStore)
+	ownerTokenProvider, err := newOwnerTokenProvider(ctx, config.Clients.ServiceAuthorization, config.APIs.GRPC.Authorization.OwnerClaim, fileWatcher, logger, tracerProvider)
+	if err != nil {
+		closeFunc.Execute()
+		return nil, nil, nil, nil, err
+	}
+	closeFunc.AddFunc(ownerTokenProvider.Close)
+
+	var jobExecutor *jobs.Executor
+	if config.APIs.GRPC.BulkUpdateJobs.Enabled {
+		executor, closeJobExecutor, err := newJobExecutor(ctx, jobStore, config.APIs.GRPC.BulkUpdateJobs.LeaseDuration, ownerTokenProvider.GetToken, validate, raClient, resourceSubscriber, logger)
+		if err != nil {
+			closeFunc.Execute()
+			return nil, nil, nil, nil, fmt.Errorf("cannot create bulk update job executor: %w", err)
+		}
+		closeFunc.AddFunc(closeJobExecutor)
+		jobExecutor = executor
+	}
+
+	var scheduler *schedules.Scheduler
+	if config.APIs.GRPC.Schedules.Enabled {
+		scheduler, err = schedules.New(ctx, jobStore, raClient, ownerTokenProvider.GetToken, validate, config.APIs.GRPC.Schedules.SyncInterval, logger)
+		if err != nil {
+			closeFunc.Execute()
+			return nil, nil, nil, nil, fmt.Errorf("cannot create scheduler: %w", err)
+		}
+		closeFunc.AddFunc(scheduler.Close)
+	}
+	return jobStore, jobExecutor, scheduler, closeFunc.ToFunction(), nil
+}
+
+func newRequestHandlerFromConfig(ctx context.Context, config Config, fileWatcher *fsnotify.Watcher, logger log.Logger, tracerProvider trace.TracerProvider, goroutinePoolGo func(func()) error) (*RequestHandler, error) {
 	var closeFunc fn.FuncList
 	idClient, closeIdClient, err := newIdentityStoreClient(config.Clients.IdentityStore, fileWatcher, logger, tracerProvider)
 	if err != nil {
@@ -117,14 +208,17 @@ func newRequestHandlerFromConfig(config Config, fileWatcher *fsnotify.Watcher, l
 	}
 	closeFunc.AddFunc(closeIdClient)
 
-	natsClient, err := naClient.New(config.Clients.Eventbus.NATS.Config, fileWatcher, logger, tracerProvider)
+	resourceSubscriber, err := eventbusConfig.NewSubscriber(config.Clients.Eventbus.ConfigSubscriber, fileWatcher, logger, tracerProvider,
+		eventbusConfig.WithGoPool(goroutinePoolGo),
+	)
 	if err != nil {
-		return nil, fmt.Errorf("cannot create nats client: %w", err)
+		closeFunc.Execute()
+		return nil, fmt.Errorf("cannot create eventbus subscriber: %w", err)
 	}
-	closeFunc.AddFunc(natsClient.Close)
+	closeFunc.AddFunc(resourceSubscriber.Close)
 
 	ownerCache := isClient.NewOwnerCache(config.APIs.GRPC.Authorization.OwnerClaim, config.APIs.GRPC.OwnerCacheExpiration,
-		natsClient.GetConn(), idClient, func(err error) {
+		resourceSubscriber, idClient, func(err error) {
 			logger.Errorf("error occurs during processing of event by ownerCache: %v", err)
 		})
 	closeFunc.AddFunc(ownerCache.Close)
@@ -136,18 +230,6 @@ func newRequestHandlerFromConfig(config Config, fileWatcher *fsnotify.Watcher, l
 	}
 	closeFunc.AddFunc(closeResourceDirectoryClient)
 
-	resourceSubscriber, err := subscriber.New(natsClient.GetConn(),
-		config.Clients.Eventbus.NATS.PendingLimits, config.Clients.Eventbus.NATS.LeadResourceType.IsEnabled(),
-		logger,
-		subscriber.WithGoPool(goroutinePoolGo),
-		subscriber.WithUnmarshaler(utils.Unmarshal),
-	)
-	if err != nil {
-		closeFunc.Execute()
-		return nil, fmt.Errorf("cannot create eventbus subscriber: %w", err)
-	}
-	closeFunc.AddFunc(resourceSubscriber.Close)
-
 	resourceAggregateClient, closeResourceAggregateClient, err := newResourceAggregateClient(config.Clients.ResourceAggregate, resourceSubscriber,
 		fileWatcher, logger, tracerProvider)
 	if err != nil {
@@ -163,11 +245,11 @@ func newRequestHandlerFromConfig(config Config, fileWatcher *fsnotify.Watcher, l
 	}
 	closeFunc.AddFunc(closeCertificateAuthorityClient)
 
-	subscriptionsCache := subscription.NewSubscriptionsCache(natsClient.GetConn(), func(err error) {
+	subscriptionsCache := subscription.NewSubscriptionsCache(resourceSubscriber, func(err error) {
 		logger.Errorf("error occurs during processing of event by subscriptionCache: %v", err)
 	})
 
-	return &RequestHandler{
+	h := &RequestHandler{
 		idClient:                   idClient,
 		resourceDirectoryClient:    resourceDirectoryClient,
 		resourceAggregateClient:    resourceAggregateClient,
@@ -176,9 +258,22 @@ func newRequestHandlerFromConfig(config Config, fileWatcher *fsnotify.Watcher, l
 		ownerCache:                 ownerCache,
 		subscriptionsCache:         subscriptionsCache,
 		config:                     config,
-		closeFunc:                  closeFunc.ToFunction(),
 		logger:                     logger,
-	}, nil
+	}
+	if config.APIs.GRPC.SchemaValidation.IsEnabled() {
+		h.introspectionCache = introspection.NewCache(ctx, h.getIntrospectionDocument, config.APIs.GRPC.SchemaValidation.CacheExpiration, config.APIs.GRPC.SchemaValidation.Timeout)
+	}
+
+	// the commands of the jobs and the schedules are validated as the commands received by the API
+	jobStore, jobExecutor, scheduler, closeJobs, err := newJobsAndSchedules(ctx, config, resourceAggregateClient, h.validateResourceContent, resourceSubscriber, fileWatcher, logger, tracerProvider)
+	if err != nil {
+		closeFunc.Execute()
+		return nil, err
+	}
+	closeFunc.AddFunc(closeJobs)
+	h.jobStore, h.jobExecutor, h.scheduler = jobStore, jobExecutor, scheduler
+	h.closeFunc = closeFunc.ToFunction()
+	return h, nil
 }
 
 func (r *RequestHandler) Close() {
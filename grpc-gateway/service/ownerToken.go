@@ -0,0 +1,51 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/plgd-dev/hub/v2/pkg/fsnotify"
+	"github.com/plgd-dev/hub/v2/pkg/log"
+	"github.com/plgd-dev/hub/v2/pkg/security/oauth2/clientcredentials"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ownerTokenProvider gets the access tokens of the owners by the client credentials flow. The tokens are cached until
+// they expire, so the long running jobs always execute the commands by the valid token of the owner.
+type ownerTokenProvider struct {
+	cache      *clientcredentials.Cache
+	ownerClaim string
+	timeout    time.Duration
+}
+
+func newOwnerTokenProvider(ctx context.Context, config ServiceAuthorizationConfig, ownerClaim string, fileWatcher *fsnotify.Watcher, logger log.Logger, tracerProvider trace.TracerProvider) (*ownerTokenProvider, error) {
+	cache, err := clientcredentials.New(ctx, config.Provider, fileWatcher, logger, tracerProvider, time.Minute)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create client credentials token cache: %w", err)
+	}
+	return &ownerTokenProvider{
+		cache:      cache,
+		ownerClaim: ownerClaim,
+		timeout:    config.Provider.HTTP.Timeout,
+	}, nil
+}
+
+// GetToken returns the access token of the owner, which is valid at least for the timeout of the provider.
+func (p *ownerTokenProvider) GetToken(ctx context.Context, owner string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+	token, err := p.cache.GetToken(ctx, owner, map[string]string{
+		p.ownerClaim: owner,
+	}, map[string]interface{}{
+		p.ownerClaim: owner,
+	})
+	if err != nil {
+		return "", fmt.Errorf("cannot get token of owner('%v'): %w", owner, err)
+	}
+	return token.AccessToken, nil
+}
+
+func (p *ownerTokenProvider) Close() {
+	p.cache.Close()
+}
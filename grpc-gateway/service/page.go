@@ -0,0 +1,36 @@
+package service
+
+import (
+	"github.com/plgd-dev/hub/v2/grpc-gateway/pb"
+	kitNetGrpc "github.com/plgd-dev/hub/v2/pkg/net/grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// validatePageSize rejects the page size, which exceeds the maximal page size, before the request is forwarded
+// to the resource-directory.
+func (r *RequestHandler) validatePageSize(pageSize int64) error {
+	maxPageSize := r.config.APIs.GRPC.MaxPageSize
+	if pageSize < 0 || pageSize > maxPageSize {
+		return status.Errorf(codes.InvalidArgument, "invalid page size(%v) - must be between 0 and %v", pageSize, maxPageSize)
+	}
+	return nil
+}
+
+// forwardNextPageToken sets the next page token from the resource-directory response to the response header
+// and the response trailer.
+func forwardNextPageToken(rd grpc.ClientStream, srv grpc.ServerStream) error {
+	token, err := pb.NextPageToken(rd)
+	if err != nil || token == "" {
+		// the error of the stream is returned by Recv
+		return nil
+	}
+	md := metadata.Pairs(pb.NextPageTokenKey, token)
+	if err = srv.SetHeader(md); err != nil {
+		return kitNetGrpc.ForwardErrorf(codes.Internal, "cannot set next page token: %v", err)
+	}
+	srv.SetTrailer(md)
+	return nil
+}
@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+
+	"github.com/plgd-dev/hub/v2/grpc-gateway/pb"
+	"github.com/plgd-dev/hub/v2/grpc-gateway/store"
+	kitNetGrpc "github.com/plgd-dev/hub/v2/pkg/net/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (r *RequestHandler) CreateSchedule(ctx context.Context, req *pb.CreateScheduleRequest) (*pb.Schedule, error) {
+	if r.scheduler == nil {
+		return nil, status.Errorf(codes.Unimplemented, "cannot create schedule: schedules are disabled")
+	}
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot create schedule: %v", err)
+	}
+	owner, err := kitNetGrpc.OwnerFromTokenMD(ctx, r.ownerCache.OwnerClaim())
+	if err != nil {
+		return nil, kitNetGrpc.ForwardErrorf(codes.Unauthenticated, "cannot create schedule: %v", err)
+	}
+	// the command is executed later by the token of the owner, so the device is checked now
+	deviceID := req.GetCommand().GetDeviceId()
+	ok, err := r.ownerCache.OwnsDevice(ctx, deviceID)
+	if err != nil {
+		return nil, kitNetGrpc.ForwardErrorf(codes.Internal, "cannot create schedule: cannot check owner of the device: %v", err)
+	}
+	if !ok {
+		return nil, status.Errorf(codes.PermissionDenied, "cannot create schedule: device('%v') is not owned by the user", deviceID)
+	}
+	schedule, err := r.scheduler.CreateSchedule(ctx, owner, req)
+	if err != nil {
+		return nil, kitNetGrpc.ForwardErrorf(codes.Internal, "cannot create schedule: %v", err)
+	}
+	return schedule, nil
+}
+
+func (r *RequestHandler) GetSchedules(req *pb.GetSchedulesRequest, srv pb.GrpcGateway_GetSchedulesServer) error {
+	if r.scheduler == nil {
+		return status.Errorf(codes.Unimplemented, "cannot get schedules: schedules are disabled")
+	}
+	owner, err := kitNetGrpc.OwnerFromTokenMD(srv.Context(), r.ownerCache.OwnerClaim())
+	if err != nil {
+		return kitNetGrpc.ForwardErrorf(codes.Unauthenticated, "cannot get schedules: %v", err)
+	}
+	err = r.jobStore.GetSchedules(srv.Context(), owner, store.GetSchedulesQuery{IDFilter: req.GetIdFilter()}, func(schedule *pb.Schedule) error {
+		return srv.Send(schedule)
+	})
+	if err != nil {
+		return kitNetGrpc.ForwardErrorf(codes.Internal, "cannot get schedules: %v", err)
+	}
+	return nil
+}
+
+func (r *RequestHandler) DeleteSchedules(ctx context.Context, req *pb.DeleteSchedulesRequest) (*pb.DeleteSchedulesResponse, error) {
+	if r.scheduler == nil {
+		return nil, status.Errorf(codes.Unimplemented, "cannot delete schedules: schedules are disabled")
+	}
+	owner, err := kitNetGrpc.OwnerFromTokenMD(ctx, r.ownerCache.OwnerClaim())
+	if err != nil {
+		return nil, kitNetGrpc.ForwardErrorf(codes.Unauthenticated, "cannot delete schedules: %v", err)
+	}
+	count, err := r.scheduler.DeleteSchedules(ctx, owner, req.GetIdFilter())
+	if err != nil {
+		return nil, kitNetGrpc.ForwardErrorf(codes.Internal, "cannot delete schedules: %v", err)
+	}
+	return &pb.DeleteSchedulesResponse{Count: count}, nil
+}
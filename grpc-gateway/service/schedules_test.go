@@ -0,0 +1,53 @@
+package service_test
+
+import (
+	"context"
+	"crypto/tls"
+	"testing"
+	"time"
+
+	"github.com/plgd-dev/hub/v2/grpc-gateway/pb"
+	kitNetGrpc "github.com/plgd-dev/hub/v2/pkg/net/grpc"
+	"github.com/plgd-dev/hub/v2/test"
+	"github.com/plgd-dev/hub/v2/test/config"
+	oauthTest "github.com/plgd-dev/hub/v2/test/oauth-server/test"
+	"github.com/plgd-dev/hub/v2/test/service"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+)
+
+func TestRequestHandlerCreateScheduleNotOwnedDevice(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), config.TEST_TIMEOUT)
+	defer cancel()
+
+	tearDown := service.SetUp(ctx, t)
+	defer tearDown()
+	ctx = kitNetGrpc.CtxWithToken(ctx, oauthTest.GetDefaultAccessToken(t))
+
+	conn, err := grpc.NewClient(config.GRPC_GW_HOST, grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{
+		RootCAs: test.GetRootCertificatePool(t),
+	})))
+	require.NoError(t, err)
+	defer func() {
+		_ = conn.Close()
+	}()
+	c := pb.NewGrpcGatewayClient(conn)
+
+	_, err = c.CreateSchedule(ctx, &pb.CreateScheduleRequest{
+		Command: &pb.ScheduledCommand{
+			Type:     pb.ScheduledCommand_UPDATE_RESOURCE,
+			DeviceId: "notOwnedDevice",
+			Href:     "/light/1",
+			Content: &pb.Content{
+				ContentType: "application/json",
+				Data:        []byte(`{"state":false}`),
+			},
+		},
+		RunAt: time.Now().Add(time.Hour).UnixNano(),
+	})
+	require.Error(t, err)
+	require.Equal(t, codes.PermissionDenied, status.Convert(err).Code())
+}
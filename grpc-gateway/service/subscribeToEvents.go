@@ -5,7 +5,9 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"slices"
 	"sync"
+	"time"
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/plgd-dev/hub/v2/grpc-gateway/pb"
@@ -18,26 +20,44 @@ import (
 var ErrNotFound = errors.New("not found")
 
 type subscriptions struct {
+	ctx                context.Context
 	owner              string
 	send               func(e *pb.Event) error
 	subscriptionsCache *subscription.SubscriptionsCache
 	leadRTEnabled      bool
+	loadDeviceLabels   subscription.LoadDeviceLabelsFunc
+	replayEvents       subscription.ReplayEventsFunc
+	sendReplayed       func(e *pb.Event) error
+	resumeWindow       time.Duration
+	jqEvalTimeout      time.Duration
 
 	subs map[string]*subscription.Sub
 }
 
 func newSubscriptions(
+	ctx context.Context,
 	owner string,
 	subscriptionsCache *subscription.SubscriptionsCache,
 	leadRTEnabled bool,
+	loadDeviceLabels subscription.LoadDeviceLabelsFunc,
+	replayEvents subscription.ReplayEventsFunc,
+	resumeWindow time.Duration,
+	jqEvalTimeout time.Duration,
 	send func(e *pb.Event) error,
+	sendReplayed func(e *pb.Event) error,
 ) *subscriptions {
 	return &subscriptions{
+		ctx:                ctx,
 		owner:              owner,
 		subs:               make(map[string]*subscription.Sub),
 		send:               send,
 		subscriptionsCache: subscriptionsCache,
 		leadRTEnabled:      leadRTEnabled,
+		loadDeviceLabels:   loadDeviceLabels,
+		replayEvents:       replayEvents,
+		sendReplayed:       sendReplayed,
+		resumeWindow:       resumeWindow,
+		jqEvalTimeout:      jqEvalTimeout,
 	}
 }
 
@@ -66,7 +86,12 @@ func NewOperationProcessed(subscriptionId, correlationId string, code pb.Event_O
 }
 
 func (s *subscriptions) createSubscription(req *pb.SubscribeToEvents) error {
-	sub := subscription.New(s.send, req.GetCorrelationId(), s.leadRTEnabled, req.GetCreateSubscription())
+	sub := subscription.New(s.send, req.GetCorrelationId(), s.leadRTEnabled, req.GetCreateSubscription(),
+		subscription.WithLoadDeviceLabels(s.loadDeviceLabels),
+		subscription.WithReplayEvents(s.replayEvents, s.sendReplayed),
+		subscription.WithResumeWindow(s.resumeWindow),
+		subscription.WithContentFilterTimeout(s.jqEvalTimeout),
+		subscription.WithContext(s.ctx))
 	err := s.send(NewOperationProcessed(sub.Id(), req.GetCorrelationId(), pb.Event_OperationProcessed_ErrorStatus_OK, ""))
 	if err != nil {
 		return err
@@ -135,6 +160,16 @@ func (h *subscribeToEventsHandler) send(e *pb.Event) error {
 	return nil
 }
 
+// sendReplayed waits until the event is passed to the grpc goroutine, so the replayed events are not dropped.
+func (h *subscribeToEventsHandler) sendReplayed(e *pb.Event) error {
+	select {
+	case <-h.srv.Context().Done():
+		return h.srv.Context().Err()
+	case h.sendChan <- e:
+	}
+	return nil
+}
+
 func (h *subscribeToEventsHandler) processNextRequest(subs *subscriptions) (bool, error) {
 	req, err := h.srv.Recv()
 	if errors.Is(err, io.EOF) {
@@ -166,6 +201,116 @@ func (h *subscribeToEventsHandler) processNextRequest(subs *subscriptions) (bool
 	return true, nil
 }
 
+// getDeviceLabels returns the labels of the devices of the owner, which are used to filter events by the label selector.
+// The devices are loaded by the pages of at most maxPageSize devices. The devices without labels are omitted, because
+// they are evaluated as the devices unknown to the filter.
+func (r *RequestHandler) getDeviceLabels(ctx context.Context) (map[string]map[string]string, error) {
+	req := pb.GetDevicesRequest{
+		PageSize: r.config.APIs.GRPC.MaxPageSize,
+	}
+	deviceLabels := make(map[string]map[string]string)
+	for {
+		nextPageToken, err := r.getDevicesPage(ctx, &req, func(device *pb.Device) {
+			if len(device.GetLabels()) > 0 {
+				deviceLabels[device.GetId()] = device.GetLabels()
+			}
+		})
+		if err != nil {
+			return nil, err
+		}
+		if nextPageToken == "" {
+			return deviceLabels, nil
+		}
+		req.PageToken = nextPageToken
+	}
+}
+
+// getDevicesPage calls onDevice for the devices of the page and returns the token of the next page.
+func (r *RequestHandler) getDevicesPage(ctx context.Context, req *pb.GetDevicesRequest, onDevice func(*pb.Device)) (string, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	rd, err := r.resourceDirectoryClient.GetDevices(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	nextPageToken, err := pb.NextPageToken(rd)
+	if err != nil {
+		return "", err
+	}
+	for {
+		device, err := rd.Recv()
+		if errors.Is(err, io.EOF) {
+			return nextPageToken, nil
+		}
+		if err != nil {
+			return "", err
+		}
+		onDevice(device)
+	}
+}
+
+// getStoredEvents returns the events stored after the timestamp, which are used to resume the subscription. At most
+// subscriptionMaxReplayedEvents events are loaded, otherwise the subscription cannot be resumed. The events are loaded
+// by the pages of at most maxPageSize events.
+func (r *RequestHandler) getStoredEvents(ctx context.Context, req *pb.SubscribeToEvents_CreateSubscription, timestamp int64) ([]*pb.Event, error) {
+	maxEvents := r.config.APIs.GRPC.SubscriptionMaxReplayedEvents
+	getEventsReq := pb.GetEventsRequest{
+		TimestampFilter: timestamp,
+		PageSize:        min(maxEvents+1, r.config.APIs.GRPC.MaxPageSize),
+	}
+	if len(req.GetHrefFilter()) == 0 && len(req.GetResourceIdFilter()) == 0 && !slices.Contains(req.GetDeviceIdFilter(), "*") {
+		// the other filters are applied by the subscription
+		getEventsReq.DeviceIdFilter = req.GetDeviceIdFilter()
+	}
+	var stored []*pb.Event
+	var received int64
+	for {
+		nextPageToken, err := r.getStoredEventsPage(ctx, &getEventsReq, func(ev *pb.GetEventsResponse) error {
+			received++
+			if received > maxEvents {
+				return fmt.Errorf("too many events to replay, more than %v events are stored after the resume token", maxEvents)
+			}
+			if e := ev.ToEvent(); e != nil {
+				stored = append(stored, e)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		if nextPageToken == "" {
+			return stored, nil
+		}
+		getEventsReq.PageToken = nextPageToken
+	}
+}
+
+// getStoredEventsPage calls onEvent for the events of the page and returns the token of the next page.
+func (r *RequestHandler) getStoredEventsPage(ctx context.Context, req *pb.GetEventsRequest, onEvent func(*pb.GetEventsResponse) error) (string, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	rd, err := r.resourceDirectoryClient.GetEvents(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	nextPageToken, err := pb.NextPageToken(rd)
+	if err != nil {
+		return "", err
+	}
+	for {
+		ev, err := rd.Recv()
+		if errors.Is(err, io.EOF) {
+			return nextPageToken, nil
+		}
+		if err != nil {
+			return "", err
+		}
+		if err = onEvent(ev); err != nil {
+			return "", err
+		}
+	}
+}
+
 func (r *RequestHandler) SubscribeToEvents(srv pb.GrpcGateway_SubscribeToEventsServer) (errRet error) {
 	var wg sync.WaitGroup
 	wg.Add(1)
@@ -198,7 +343,14 @@ func (r *RequestHandler) SubscribeToEvents(srv pb.GrpcGateway_SubscribeToEventsS
 		return err
 	}
 
-	subs := newSubscriptions(owner, r.subscriptionsCache, r.config.Clients.Eventbus.NATS.LeadResourceType.IsEnabled(), h.send)
+	loadDeviceLabels := func() (map[string]map[string]string, error) {
+		return r.getDeviceLabels(ctx)
+	}
+	replayEvents := func(req *pb.SubscribeToEvents_CreateSubscription, timestamp int64) ([]*pb.Event, error) {
+		return r.getStoredEvents(ctx, req, timestamp)
+	}
+	subs := newSubscriptions(ctx, owner, r.subscriptionsCache, r.config.Clients.Eventbus.LeadResourceTypeEnabled(), loadDeviceLabels, replayEvents,
+		r.config.APIs.GRPC.SubscriptionResumeWindow, r.config.APIs.GRPC.SubscriptionJqEvalTimeout, h.send, h.sendReplayed)
 	defer subs.close()
 
 	for {
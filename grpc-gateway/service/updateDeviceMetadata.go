@@ -13,6 +13,17 @@ func (r *RequestHandler) UpdateDeviceMetadata(ctx context.Context, req *pb.Updat
 	if err != nil {
 		return nil, kitNetGrpc.ForwardErrorf(codes.Internal, "cannot update device('%v') metadata: %v", req.GetDeviceId(), err)
 	}
+	if req.IsLabelsUpdate() {
+		// labels are stored in the hub, so there is no need to wait for the device
+		resp, err := r.resourceAggregateClient.UpdateDeviceMetadata(ctx, updateMetadata)
+		if err != nil {
+			return nil, kitNetGrpc.ForwardErrorf(codes.Internal, "cannot update device('%v') labels: %v", req.GetDeviceId(), err)
+		}
+		return &pb.UpdateDeviceMetadataResponse{
+			Labels:      resp.GetLabels(),
+			DisplayName: resp.GetDisplayName(),
+		}, nil
+	}
 	metadataUpdated, err := r.resourceAggregateClient.SyncUpdateDeviceMetadata(ctx, "*", updateMetadata)
 	if err != nil {
 		return nil, kitNetGrpc.ForwardErrorf(codes.Internal, "cannot update device('%v') metadata: %v", req.GetDeviceId(), err)
@@ -7,6 +7,9 @@ import (
 )
 
 func (r *RequestHandler) UpdateResource(ctx context.Context, req *pb.UpdateResourceRequest) (*pb.UpdateResourceResponse, error) {
+	if err := r.validateResourceContent(ctx, "update", req.GetResourceId(), req.GetResourceInterface(), req.GetContent()); err != nil {
+		return nil, resourceError("update", err)
+	}
 	var resp pb.UpdateResourceResponse
 	return &resp, handleResourceRequest(
 		ctx,
@@ -0,0 +1,155 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+
+	"github.com/plgd-dev/device/v2/schema/introspection"
+	introspectionValidator "github.com/plgd-dev/hub/v2/grpc-gateway/introspection"
+	"github.com/plgd-dev/hub/v2/grpc-gateway/pb"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/commands"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type introspectionURLInfo struct {
+	URLInfo []struct {
+		URL string `json:"url"`
+	} `json:"urlInfo"`
+}
+
+// getPublishedResourceTypes returns the resource types of the published resources of the device by their hrefs.
+func (r *RequestHandler) getPublishedResourceTypes(ctx context.Context, deviceID string) (map[string][]string, error) {
+	client, err := r.resourceDirectoryClient.GetResourceLinks(ctx, &pb.GetResourceLinksRequest{
+		DeviceIdFilter: []string{deviceID},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot get resource links: %w", err)
+	}
+	resourceTypes := make(map[string][]string)
+	for {
+		links, err := client.Recv()
+		if errors.Is(err, io.EOF) {
+			return resourceTypes, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("cannot receive resource links: %w", err)
+		}
+		for _, res := range links.GetResources() {
+			resourceTypes[res.GetHref()] = res.GetResourceTypes()
+		}
+	}
+}
+
+func (r *RequestHandler) retrieveFromDevice(ctx context.Context, deviceID, href string, v interface{}) error {
+	resp, err := r.GetResourceFromDevice(ctx, &pb.GetResourceFromDeviceRequest{
+		ResourceId: commands.NewResourceID(deviceID, href),
+		TimeToLive: int64(r.config.APIs.GRPC.SchemaValidation.Timeout),
+	})
+	if err != nil {
+		return err
+	}
+	return commands.DecodeContent(resp.GetData().GetContent(), v)
+}
+
+func findIntrospectionHref(resourceTypes map[string][]string) (string, bool) {
+	for href, types := range resourceTypes {
+		for _, rt := range types {
+			if rt == introspection.ResourceType {
+				return href, true
+			}
+		}
+	}
+	return "", false
+}
+
+// getIntrospectionDocument retrieves the introspection document of the device. The introspection resource provides
+// the URL of the document, which is retrieved from the device as another resource. The retrieval is limited
+// by the timeout of the introspection cache.
+func (r *RequestHandler) getIntrospectionDocument(ctx context.Context, deviceID string) (*introspectionValidator.Document, error) {
+	resourceTypes, err := r.getPublishedResourceTypes(ctx, deviceID)
+	if err != nil {
+		return nil, err
+	}
+	introspectionHref, ok := findIntrospectionHref(resourceTypes)
+	if !ok {
+		return nil, nil
+	}
+	var info introspectionURLInfo
+	if err = r.retrieveFromDevice(ctx, deviceID, introspectionHref, &info); err != nil {
+		return nil, fmt.Errorf("cannot retrieve introspection resource: %w", err)
+	}
+	if len(info.URLInfo) == 0 {
+		return nil, nil
+	}
+	u, err := url.Parse(info.URLInfo[0].URL)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse url('%v') of introspection document: %w", info.URLInfo[0].URL, err)
+	}
+	var doc interface{}
+	if err = r.retrieveFromDevice(ctx, deviceID, u.Path, &doc); err != nil {
+		return nil, fmt.Errorf("cannot retrieve introspection document: %w", err)
+	}
+	return introspectionValidator.ParseDocument(doc, resourceTypes)
+}
+
+func validationErrorToStatus(err *introspectionValidator.ValidationError) error {
+	br := &errdetails.BadRequest{}
+	for _, v := range err.Violations {
+		br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       v.Path,
+			Description: v.Message,
+		})
+	}
+	s, detailsErr := status.New(codes.InvalidArgument, err.Error()).WithDetails(br)
+	if detailsErr != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return s.Err()
+}
+
+// validateResourceContent validates the content of the request, the bulk update job or the schedule by the
+// introspection document of the device before the command is stored as pending. In the advisory mode the violations are only logged. When the document or
+// the content cannot be obtained, the request is not validated and the device decides.
+func (r *RequestHandler) validateResourceContent(ctx context.Context, action string, resourceID *commands.ResourceId, resourceInterface string, content *pb.Content) error {
+	if r.introspectionCache == nil {
+		return nil
+	}
+	doc, err := r.introspectionCache.Get(ctx, resourceID.GetDeviceId())
+	if err != nil {
+		r.logger.Debugf("cannot get introspection document of device %v: %v", resourceID.GetDeviceId(), err)
+		return nil
+	}
+	if doc == nil {
+		return nil
+	}
+	href := resourceID.GetHref()
+	if len(href) > 0 && href[0] != '/' {
+		href = "/" + href
+	}
+	var v interface{}
+	if err = commands.DecodeContent(&commands.Content{
+		ContentType: content.GetContentType(),
+		Data:        content.GetData(),
+	}, &v); err != nil {
+		r.logger.Debugf("cannot decode content of resource %v to validate it: %v", resourceID.ToString(), err)
+		return nil
+	}
+	err = doc.Validate(href, resourceInterface, v)
+	var ve *introspectionValidator.ValidationError
+	if !errors.As(err, &ve) {
+		if err != nil {
+			r.logger.Debugf("cannot validate content of resource %v: %v", resourceID.ToString(), err)
+		}
+		return nil
+	}
+	if r.config.APIs.GRPC.SchemaValidation.Mode != SchemaValidationStrict {
+		r.logger.Warnf("%v of resource %v doesn't conform to introspection document: %v", action, resourceID.ToString(), ve)
+		return nil
+	}
+	return validationErrorToStatus(ve)
+}
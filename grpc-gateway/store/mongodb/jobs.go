@@ -0,0 +1,158 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/plgd-dev/hub/v2/grpc-gateway/pb"
+	"github.com/plgd-dev/hub/v2/grpc-gateway/store"
+	"github.com/plgd-dev/hub/v2/pkg/mongodb"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// The lease of the job is stored with the job, but it is not the part of the pb.BulkUpdateJob returned to the clients.
+const (
+	jobLeaseInstanceIDKey = "leaseInstanceId"
+	jobLeaseUntilKey      = "leaseUntil"
+)
+
+func (s *Store) CreateJob(ctx context.Context, job *pb.BulkUpdateJob) (*pb.BulkUpdateJob, error) {
+	if job.GetId() == "" || job.GetOwner() == "" {
+		return nil, store.ErrInvalidArgument
+	}
+	_, err := s.Store.Collection(jobsCol).InsertOne(ctx, job)
+	if err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+func toJobsFilter(owner string, query store.GetJobsQuery) bson.D {
+	filter := bson.D{}
+	if owner != "" {
+		filter = append(filter, bson.E{Key: pb.JobOwnerKey, Value: owner})
+	}
+	if len(query.IDFilter) > 0 {
+		filter = append(filter, bson.E{Key: pb.JobIDKey, Value: bson.M{mongodb.In: query.IDFilter}})
+	}
+	if len(query.StatusFilter) > 0 {
+		filter = append(filter, bson.E{Key: pb.JobStatusKey, Value: bson.M{mongodb.In: query.StatusFilter}})
+	}
+	if query.LeaseExpiredBefore > 0 {
+		filter = append(filter, leaseExpiredFilter(query.LeaseExpiredBefore))
+	}
+	return filter
+}
+
+// leaseExpiredFilter matches the jobs which are not claimed or whose lease expired before the timestamp.
+func leaseExpiredFilter(before int64) bson.E {
+	return bson.E{Key: "$or", Value: bson.A{
+		bson.M{jobLeaseUntilKey: bson.M{"$exists": false}},
+		bson.M{jobLeaseUntilKey: bson.M{"$lt": before}},
+	}}
+}
+
+func processCursor[T any](ctx context.Context, cr *mongo.Cursor, process store.Process[T]) error {
+	var errors *multierror.Error
+	iter := store.MongoIterator[T]{
+		Cursor: cr,
+	}
+	for {
+		var stored T
+		if !iter.Next(ctx, &stored) {
+			break
+		}
+		err := process(&stored)
+		if err != nil {
+			errors = multierror.Append(errors, err)
+			break
+		}
+	}
+	errors = multierror.Append(errors, iter.Err())
+	errClose := cr.Close(ctx)
+	errors = multierror.Append(errors, errClose)
+	return errors.ErrorOrNil()
+}
+
+func (s *Store) GetJobs(ctx context.Context, owner string, query store.GetJobsQuery, process store.ProcessJobs) error {
+	opts := options.Find().SetSort(bson.D{{Key: pb.JobCreatedAtKey, Value: 1}})
+	cur, err := s.Store.Collection(jobsCol).Find(ctx, toJobsFilter(owner, query), opts)
+	if err != nil {
+		return err
+	}
+	return processCursor(ctx, cur, process)
+}
+
+func findOneAndUpdate[T any](ctx context.Context, col *mongo.Collection, filter bson.D, update bson.D) (*T, error) {
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	res := col.FindOneAndUpdate(ctx, filter, update, opts)
+	if err := res.Err(); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, store.ErrNotFound
+		}
+		return nil, err
+	}
+	var v T
+	if err := res.Decode(&v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+func (s *Store) UpdateJobDevice(ctx context.Context, req store.UpdateJobDeviceRequest) (*pb.BulkUpdateJob, error) {
+	if req.JobID == "" || req.Device.GetDeviceId() == "" {
+		return nil, store.ErrInvalidArgument
+	}
+	elemMatch := bson.M{pb.JobDeviceIDKey: req.Device.GetDeviceId()}
+	if len(req.StatusFilter) > 0 {
+		elemMatch[pb.JobDeviceStatusKey] = bson.M{mongodb.In: req.StatusFilter}
+	}
+	filter := bson.D{
+		{Key: pb.JobIDKey, Value: req.JobID},
+		{Key: pb.JobDevicesKey, Value: bson.M{"$elemMatch": elemMatch}},
+	}
+	update := bson.D{
+		{Key: mongodb.Set, Value: bson.M{pb.JobDevicesKey + ".$": req.Device}},
+	}
+	return findOneAndUpdate[pb.BulkUpdateJob](ctx, s.Store.Collection(jobsCol), filter, update)
+}
+
+func (s *Store) UpdateJobStatus(ctx context.Context, req store.UpdateJobStatusRequest) (*pb.BulkUpdateJob, error) {
+	if req.JobID == "" {
+		return nil, store.ErrInvalidArgument
+	}
+	filter := toJobsFilter(req.Owner, store.GetJobsQuery{
+		IDFilter:     []string{req.JobID},
+		StatusFilter: req.StatusFilter,
+	})
+	update := bson.D{
+		{Key: mongodb.Set, Value: bson.M{pb.JobStatusKey: req.Status}},
+	}
+	return findOneAndUpdate[pb.BulkUpdateJob](ctx, s.Store.Collection(jobsCol), filter, update)
+}
+
+func (s *Store) ClaimJob(ctx context.Context, req store.ClaimJobRequest) (*pb.BulkUpdateJob, error) {
+	if req.JobID == "" || req.InstanceID == "" {
+		return nil, store.ErrInvalidArgument
+	}
+	filter := bson.D{
+		{Key: pb.JobIDKey, Value: req.JobID},
+		{Key: pb.JobStatusKey, Value: pb.BulkUpdateJob_RUNNING},
+		{Key: "$or", Value: bson.A{
+			bson.M{jobLeaseUntilKey: bson.M{"$exists": false}},
+			bson.M{jobLeaseUntilKey: bson.M{"$lt": time.Now().UnixNano()}},
+			bson.M{jobLeaseInstanceIDKey: req.InstanceID},
+		}},
+	}
+	update := bson.D{
+		{Key: mongodb.Set, Value: bson.M{
+			jobLeaseInstanceIDKey: req.InstanceID,
+			jobLeaseUntilKey:      req.LeaseUntil,
+		}},
+	}
+	return findOneAndUpdate[pb.BulkUpdateJob](ctx, s.Store.Collection(jobsCol), filter, update)
+}
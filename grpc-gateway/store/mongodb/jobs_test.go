@@ -0,0 +1,186 @@
+package mongodb_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/plgd-dev/hub/v2/grpc-gateway/pb"
+	"github.com/plgd-dev/hub/v2/grpc-gateway/store"
+	"github.com/plgd-dev/hub/v2/grpc-gateway/test"
+	"github.com/plgd-dev/hub/v2/test/config"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func makeJob(owner string, deviceIDs ...string) *pb.BulkUpdateJob {
+	job := &pb.BulkUpdateJob{
+		Id:    uuid.NewString(),
+		Owner: owner,
+		Href:  "/light/1",
+		Content: &pb.Content{
+			ContentType: "application/json",
+			Data:        []byte(`{"state":true}`),
+		},
+		ConcurrencyLimit: 1,
+		TimeToLive:       int64(time.Minute),
+		Status:           pb.BulkUpdateJob_RUNNING,
+		CreatedAt:        time.Now().UnixNano(),
+	}
+	for _, deviceID := range deviceIDs {
+		job.Devices = append(job.Devices, &pb.BulkUpdateJob_Device{
+			DeviceId:      deviceID,
+			CorrelationId: uuid.NewString(),
+			Status:        pb.BulkUpdateJob_Device_QUEUED,
+		})
+	}
+	return job
+}
+
+func getJobs(ctx context.Context, t *testing.T, s store.Store, owner string, query store.GetJobsQuery) []*pb.BulkUpdateJob {
+	var jobs []*pb.BulkUpdateJob
+	err := s.GetJobs(ctx, owner, query, func(v *pb.BulkUpdateJob) error {
+		jobs = append(jobs, v)
+		return nil
+	})
+	require.NoError(t, err)
+	return jobs
+}
+
+func TestJobs(t *testing.T) {
+	s, cleanUpStore := test.NewMongoStore(t)
+	defer cleanUpStore()
+
+	ctx, cancel := context.WithTimeout(context.Background(), config.TEST_TIMEOUT)
+	defer cancel()
+
+	job1 := makeJob("owner1", "d1", "d2")
+	_, err := s.CreateJob(ctx, job1)
+	require.NoError(t, err)
+	job2 := makeJob("owner2", "d1")
+	job2.CreatedAt = job1.GetCreatedAt() + 1
+	_, err = s.CreateJob(ctx, job2)
+	require.NoError(t, err)
+	_, err = s.CreateJob(ctx, job1)
+	require.Error(t, err)
+
+	jobs := getJobs(ctx, t, s, "owner1", store.GetJobsQuery{})
+	require.Len(t, jobs, 1)
+	require.True(t, proto.Equal(job1, jobs[0]))
+	jobs = getJobs(ctx, t, s, "", store.GetJobsQuery{StatusFilter: []pb.BulkUpdateJob_Status{pb.BulkUpdateJob_RUNNING}})
+	require.Len(t, jobs, 2)
+	jobs = getJobs(ctx, t, s, "owner1", store.GetJobsQuery{IDFilter: []string{job2.GetId()}})
+	require.Empty(t, jobs)
+
+	// the device is updated only in the filtered status
+	pending := &pb.BulkUpdateJob_Device{
+		DeviceId:      "d2",
+		CorrelationId: job1.GetDevices()[1].GetCorrelationId(),
+		Status:        pb.BulkUpdateJob_Device_PENDING,
+		ValidUntil:    time.Now().Add(time.Minute).UnixNano(),
+	}
+	updated, err := s.UpdateJobDevice(ctx, store.UpdateJobDeviceRequest{
+		JobID:        job1.GetId(),
+		StatusFilter: []pb.BulkUpdateJob_Device_Status{pb.BulkUpdateJob_Device_QUEUED},
+		Device:       pending,
+	})
+	require.NoError(t, err)
+	require.True(t, proto.Equal(job1.GetDevices()[0], updated.GetDevices()[0]))
+	require.True(t, proto.Equal(pending, updated.GetDevices()[1]))
+	_, err = s.UpdateJobDevice(ctx, store.UpdateJobDeviceRequest{
+		JobID:        job1.GetId(),
+		StatusFilter: []pb.BulkUpdateJob_Device_Status{pb.BulkUpdateJob_Device_QUEUED},
+		Device:       pending,
+	})
+	require.ErrorIs(t, err, store.ErrNotFound)
+	_, err = s.UpdateJobDevice(ctx, store.UpdateJobDeviceRequest{
+		JobID:  job1.GetId(),
+		Device: &pb.BulkUpdateJob_Device{DeviceId: "unknown"},
+	})
+	require.ErrorIs(t, err, store.ErrNotFound)
+
+	// the status of the job is updated only for the owner and in the filtered status
+	_, err = s.UpdateJobStatus(ctx, store.UpdateJobStatusRequest{
+		JobID:  job1.GetId(),
+		Owner:  "owner2",
+		Status: pb.BulkUpdateJob_CANCELED,
+	})
+	require.ErrorIs(t, err, store.ErrNotFound)
+	updated, err = s.UpdateJobStatus(ctx, store.UpdateJobStatusRequest{
+		JobID:        job1.GetId(),
+		Owner:        "owner1",
+		StatusFilter: []pb.BulkUpdateJob_Status{pb.BulkUpdateJob_RUNNING},
+		Status:       pb.BulkUpdateJob_CANCELED,
+	})
+	require.NoError(t, err)
+	require.Equal(t, pb.BulkUpdateJob_CANCELED, updated.GetStatus())
+	_, err = s.UpdateJobStatus(ctx, store.UpdateJobStatusRequest{
+		JobID:        job1.GetId(),
+		StatusFilter: []pb.BulkUpdateJob_Status{pb.BulkUpdateJob_RUNNING},
+		Status:       pb.BulkUpdateJob_DONE,
+	})
+	require.ErrorIs(t, err, store.ErrNotFound)
+	jobs = getJobs(ctx, t, s, "", store.GetJobsQuery{StatusFilter: []pb.BulkUpdateJob_Status{pb.BulkUpdateJob_RUNNING}})
+	require.Len(t, jobs, 1)
+	require.Equal(t, job2.GetId(), jobs[0].GetId())
+}
+
+func TestClaimJob(t *testing.T) {
+	s, cleanUpStore := test.NewMongoStore(t)
+	defer cleanUpStore()
+
+	ctx, cancel := context.WithTimeout(context.Background(), config.TEST_TIMEOUT)
+	defer cancel()
+
+	job := makeJob("owner1", "d1")
+	_, err := s.CreateJob(ctx, job)
+	require.NoError(t, err)
+
+	claimed, err := s.ClaimJob(ctx, store.ClaimJobRequest{
+		JobID:      job.GetId(),
+		InstanceID: "i1",
+		LeaseUntil: time.Now().Add(time.Minute).UnixNano(),
+	})
+	require.NoError(t, err)
+	require.True(t, proto.Equal(job, claimed))
+	// the claimed job is not loaded with the expired lease until its lease expires
+	running := store.GetJobsQuery{StatusFilter: []pb.BulkUpdateJob_Status{pb.BulkUpdateJob_RUNNING}, LeaseExpiredBefore: time.Now().UnixNano()}
+	require.Empty(t, getJobs(ctx, t, s, "", running))
+	running.LeaseExpiredBefore = time.Now().Add(2 * time.Minute).UnixNano()
+	require.Len(t, getJobs(ctx, t, s, "", running), 1)
+	// the lease is held by the first instance
+	_, err = s.ClaimJob(ctx, store.ClaimJobRequest{
+		JobID:      job.GetId(),
+		InstanceID: "i2",
+		LeaseUntil: time.Now().Add(time.Minute).UnixNano(),
+	})
+	require.ErrorIs(t, err, store.ErrNotFound)
+	// the holder renews and then releases the lease
+	_, err = s.ClaimJob(ctx, store.ClaimJobRequest{
+		JobID:      job.GetId(),
+		InstanceID: "i1",
+		LeaseUntil: 0,
+	})
+	require.NoError(t, err)
+	_, err = s.ClaimJob(ctx, store.ClaimJobRequest{
+		JobID:      job.GetId(),
+		InstanceID: "i2",
+		LeaseUntil: time.Now().Add(time.Minute).UnixNano(),
+	})
+	require.NoError(t, err)
+
+	// only the running jobs are claimed
+	_, err = s.UpdateJobStatus(ctx, store.UpdateJobStatusRequest{
+		JobID:  job.GetId(),
+		Owner:  "owner1",
+		Status: pb.BulkUpdateJob_DONE,
+	})
+	require.NoError(t, err)
+	_, err = s.ClaimJob(ctx, store.ClaimJobRequest{
+		JobID:      job.GetId(),
+		InstanceID: "i2",
+		LeaseUntil: time.Now().Add(time.Minute).UnixNano(),
+	})
+	require.ErrorIs(t, err, store.ErrNotFound)
+}
@@ -0,0 +1,95 @@
+package mongodb
+
+import (
+	"context"
+
+	"github.com/plgd-dev/hub/v2/grpc-gateway/pb"
+	"github.com/plgd-dev/hub/v2/grpc-gateway/store"
+	"github.com/plgd-dev/hub/v2/pkg/mongodb"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func (s *Store) CreateSchedule(ctx context.Context, schedule *pb.Schedule) (*pb.Schedule, error) {
+	if schedule.GetId() == "" || schedule.GetOwner() == "" {
+		return nil, store.ErrInvalidArgument
+	}
+	_, err := s.Store.Collection(schedulesCol).InsertOne(ctx, schedule)
+	if err != nil {
+		return nil, err
+	}
+	return schedule, nil
+}
+
+func toSchedulesFilter(owner string, query store.GetSchedulesQuery) bson.D {
+	filter := bson.D{}
+	if owner != "" {
+		filter = append(filter, bson.E{Key: pb.ScheduleOwnerKey, Value: owner})
+	}
+	if len(query.IDFilter) > 0 {
+		filter = append(filter, bson.E{Key: pb.ScheduleIDKey, Value: bson.M{mongodb.In: query.IDFilter}})
+	}
+	if len(query.StatusFilter) > 0 {
+		filter = append(filter, bson.E{Key: pb.ScheduleStatusKey, Value: bson.M{mongodb.In: query.StatusFilter}})
+	}
+	return filter
+}
+
+func (s *Store) GetSchedules(ctx context.Context, owner string, query store.GetSchedulesQuery, process store.ProcessSchedules) error {
+	opts := options.Find().SetSort(bson.D{{Key: pb.ScheduleCreatedAtKey, Value: 1}})
+	cur, err := s.Store.Collection(schedulesCol).Find(ctx, toSchedulesFilter(owner, query), opts)
+	if err != nil {
+		return err
+	}
+	return processCursor(ctx, cur, process)
+}
+
+func (s *Store) DeleteSchedules(ctx context.Context, owner string, query store.GetSchedulesQuery) (int64, error) {
+	res, err := s.Store.Collection(schedulesCol).DeleteMany(ctx, toSchedulesFilter(owner, query))
+	if err != nil {
+		return 0, err
+	}
+	return res.DeletedCount, nil
+}
+
+func (s *Store) ClaimScheduleRun(ctx context.Context, req store.ClaimScheduleRunRequest) (*pb.Schedule, error) {
+	if req.ScheduleID == "" {
+		return nil, store.ErrInvalidArgument
+	}
+	filter := bson.D{
+		{Key: pb.ScheduleIDKey, Value: req.ScheduleID},
+		{Key: pb.ScheduleStatusKey, Value: pb.Schedule_ACTIVE},
+	}
+	var update bson.D
+	if req.Done {
+		update = bson.D{
+			{Key: mongodb.Set, Value: bson.M{pb.ScheduleStatusKey: pb.Schedule_DONE, pb.ScheduleNextRunAtKey: int64(0)}},
+		}
+	} else {
+		filter = append(filter, bson.E{Key: pb.ScheduleNextRunAtKey, Value: bson.M{"$lt": req.NextRunAt}})
+		update = bson.D{
+			{Key: mongodb.Set, Value: bson.M{pb.ScheduleNextRunAtKey: req.NextRunAt}},
+		}
+	}
+	return findOneAndUpdate[pb.Schedule](ctx, s.Store.Collection(schedulesCol), filter, update)
+}
+
+func (s *Store) AddScheduleRun(ctx context.Context, req store.AddScheduleRunRequest) error {
+	if req.ScheduleID == "" || req.Run == nil || req.MaxRuns <= 0 {
+		return store.ErrInvalidArgument
+	}
+	update := bson.D{
+		{Key: "$push", Value: bson.M{pb.ScheduleRunsKey: bson.M{
+			"$each":  []*pb.Schedule_Run{req.Run},
+			"$slice": -req.MaxRuns,
+		}}},
+	}
+	res, err := s.Store.Collection(schedulesCol).UpdateOne(ctx, bson.D{{Key: pb.ScheduleIDKey, Value: req.ScheduleID}}, update)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return store.ErrNotFound
+	}
+	return nil
+}
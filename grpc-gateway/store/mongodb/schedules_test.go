@@ -0,0 +1,111 @@
+package mongodb_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/plgd-dev/hub/v2/grpc-gateway/pb"
+	"github.com/plgd-dev/hub/v2/grpc-gateway/store"
+	"github.com/plgd-dev/hub/v2/grpc-gateway/test"
+	"github.com/plgd-dev/hub/v2/test/config"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func makeSchedule(owner, cron string, nextRunAt int64) *pb.Schedule {
+	return &pb.Schedule{
+		Id:    uuid.NewString(),
+		Owner: owner,
+		Command: &pb.ScheduledCommand{
+			Type:     pb.ScheduledCommand_UPDATE_RESOURCE,
+			DeviceId: "d1",
+			Href:     "/light/1",
+			Content: &pb.Content{
+				ContentType: "application/json",
+				Data:        []byte(`{"state":false}`),
+			},
+			TimeToLive: int64(time.Minute),
+		},
+		Cron:      cron,
+		Status:    pb.Schedule_ACTIVE,
+		NextRunAt: nextRunAt,
+		Runs:      []*pb.Schedule_Run{},
+		CreatedAt: time.Now().UnixNano(),
+	}
+}
+
+func getSchedules(ctx context.Context, t *testing.T, s store.Store, owner string, query store.GetSchedulesQuery) []*pb.Schedule {
+	var schedules []*pb.Schedule
+	err := s.GetSchedules(ctx, owner, query, func(v *pb.Schedule) error {
+		schedules = append(schedules, v)
+		return nil
+	})
+	require.NoError(t, err)
+	return schedules
+}
+
+func TestSchedules(t *testing.T) {
+	s, cleanUpStore := test.NewMongoStore(t)
+	defer cleanUpStore()
+
+	ctx, cancel := context.WithTimeout(context.Background(), config.TEST_TIMEOUT)
+	defer cancel()
+
+	recurring := makeSchedule("owner1", "0 22 * * *", 100)
+	_, err := s.CreateSchedule(ctx, recurring)
+	require.NoError(t, err)
+	single := makeSchedule("owner2", "", 100)
+	single.RunAt = 100
+	single.CreatedAt = recurring.GetCreatedAt() + 1
+	_, err = s.CreateSchedule(ctx, single)
+	require.NoError(t, err)
+	_, err = s.CreateSchedule(ctx, recurring)
+	require.Error(t, err)
+
+	schedules := getSchedules(ctx, t, s, "owner1", store.GetSchedulesQuery{})
+	require.Len(t, schedules, 1)
+	require.True(t, proto.Equal(recurring, schedules[0]))
+	schedules = getSchedules(ctx, t, s, "", store.GetSchedulesQuery{StatusFilter: []pb.Schedule_Status{pb.Schedule_ACTIVE}})
+	require.Len(t, schedules, 2)
+
+	// the run of the recurring schedule is claimed once
+	claimed, err := s.ClaimScheduleRun(ctx, store.ClaimScheduleRunRequest{ScheduleID: recurring.GetId(), NextRunAt: 200})
+	require.NoError(t, err)
+	require.Equal(t, int64(200), claimed.GetNextRunAt())
+	_, err = s.ClaimScheduleRun(ctx, store.ClaimScheduleRunRequest{ScheduleID: recurring.GetId(), NextRunAt: 200})
+	require.ErrorIs(t, err, store.ErrNotFound)
+
+	// the single run is claimed once and the schedule is done
+	claimed, err = s.ClaimScheduleRun(ctx, store.ClaimScheduleRunRequest{ScheduleID: single.GetId(), Done: true})
+	require.NoError(t, err)
+	require.Equal(t, pb.Schedule_DONE, claimed.GetStatus())
+	_, err = s.ClaimScheduleRun(ctx, store.ClaimScheduleRunRequest{ScheduleID: single.GetId(), Done: true})
+	require.ErrorIs(t, err, store.ErrNotFound)
+
+	// only the latest runs are kept
+	for i := range 3 {
+		err = s.AddScheduleRun(ctx, store.AddScheduleRunRequest{
+			ScheduleID: recurring.GetId(),
+			Run:        &pb.Schedule_Run{StartedAt: int64(i), CorrelationId: uuid.NewString()},
+			MaxRuns:    2,
+		})
+		require.NoError(t, err)
+	}
+	schedules = getSchedules(ctx, t, s, "owner1", store.GetSchedulesQuery{IDFilter: []string{recurring.GetId()}})
+	require.Len(t, schedules, 1)
+	require.Len(t, schedules[0].GetRuns(), 2)
+	require.Equal(t, int64(1), schedules[0].GetRuns()[0].GetStartedAt())
+	require.Equal(t, int64(2), schedules[0].GetRuns()[1].GetStartedAt())
+
+	// schedules of other owners are not deleted
+	count, err := s.DeleteSchedules(ctx, "owner1", store.GetSchedulesQuery{IDFilter: []string{single.GetId()}})
+	require.NoError(t, err)
+	require.Equal(t, int64(0), count)
+	count, err = s.DeleteSchedules(ctx, "owner1", store.GetSchedulesQuery{})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
+	err = s.AddScheduleRun(ctx, store.AddScheduleRunRequest{ScheduleID: recurring.GetId(), Run: &pb.Schedule_Run{}, MaxRuns: 2})
+	require.ErrorIs(t, err, store.ErrNotFound)
+}
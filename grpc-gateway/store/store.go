@@ -0,0 +1,121 @@
+package store
+
+import (
+	"context"
+	"errors"
+
+	"github.com/plgd-dev/hub/v2/grpc-gateway/pb"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type Iterator[T any] interface {
+	Next(ctx context.Context, v *T) bool
+	Err() error
+}
+
+type (
+	Process[T any]   func(v *T) error
+	ProcessJobs      = Process[pb.BulkUpdateJob]
+	ProcessSchedules = Process[pb.Schedule]
+)
+
+var (
+	ErrNotFound        = errors.New("not found")
+	ErrInvalidArgument = errors.New("invalid argument")
+)
+
+type MongoIterator[T any] struct {
+	Cursor *mongo.Cursor
+}
+
+func (i *MongoIterator[T]) Next(ctx context.Context, s *T) bool {
+	if !i.Cursor.Next(ctx) {
+		return false
+	}
+	err := i.Cursor.Decode(s)
+	return err == nil
+}
+
+func (i *MongoIterator[T]) Err() error {
+	return i.Cursor.Err()
+}
+
+type GetJobsQuery struct {
+	IDFilter     []string                  // filter by the job ID, empty means all jobs
+	StatusFilter []pb.BulkUpdateJob_Status // filter by the job status, empty means all statuses
+	// LeaseExpiredBefore filters the jobs which are not claimed or whose lease expired before the unix timestamp
+	// in nanoseconds, 0 means all jobs.
+	LeaseExpiredBefore int64
+}
+
+type UpdateJobDeviceRequest struct {
+	JobID        string
+	StatusFilter []pb.BulkUpdateJob_Device_Status // the device is updated only when it is in one of the statuses, empty means any status
+	Device       *pb.BulkUpdateJob_Device         // the device is identified by the DeviceId
+}
+
+type UpdateJobStatusRequest struct {
+	JobID        string
+	Owner        string                    // empty means any owner
+	StatusFilter []pb.BulkUpdateJob_Status // the job is updated only when it is in one of the statuses, empty means any status
+	Status       pb.BulkUpdateJob_Status
+}
+
+type ClaimJobRequest struct {
+	JobID string
+	// InstanceID identifies the instance of the service executing the job.
+	InstanceID string
+	// LeaseUntil is the unix timestamp in nanoseconds until which the job is claimed by the instance.
+	LeaseUntil int64
+}
+
+type GetSchedulesQuery struct {
+	IDFilter     []string             // filter by the schedule ID, empty means all schedules
+	StatusFilter []pb.Schedule_Status // filter by the schedule status, empty means all statuses
+}
+
+type ClaimScheduleRunRequest struct {
+	ScheduleID string
+	// NextRunAt of the recurring schedule after the claimed run. The run is claimed only when the stored
+	// NextRunAt is lower, so the run is claimed once even when more instances of the service run the schedule.
+	NextRunAt int64
+	// Done is set for the single run of the schedule, the schedule is claimed only when it is ACTIVE and it is set to DONE.
+	Done bool
+}
+
+type AddScheduleRunRequest struct {
+	ScheduleID string
+	Run        *pb.Schedule_Run
+	MaxRuns    int // maximal number of the stored runs, the oldest runs are removed
+}
+
+type Store interface {
+	// CreateJob creates a new job. If the job already exists, it will throw an error.
+	CreateJob(ctx context.Context, job *pb.BulkUpdateJob) (*pb.BulkUpdateJob, error)
+	// GetJobs loads jobs from the database. Empty owner means jobs of all owners.
+	GetJobs(ctx context.Context, owner string, query GetJobsQuery, p ProcessJobs) error
+	// UpdateJobDevice updates the device of the job and returns the updated job.
+	// ErrNotFound is returned when the job or the device in the filtered status doesn't exist.
+	UpdateJobDevice(ctx context.Context, req UpdateJobDeviceRequest) (*pb.BulkUpdateJob, error)
+	// UpdateJobStatus updates the status of the job and returns the updated job.
+	// ErrNotFound is returned when the job in the filtered status doesn't exist.
+	UpdateJobStatus(ctx context.Context, req UpdateJobStatusRequest) (*pb.BulkUpdateJob, error)
+	// ClaimJob claims the running job for the instance and returns the job. The job is claimed when its lease
+	// expired or it is already claimed by the instance, so the job is executed only by one instance of the service.
+	// ErrNotFound is returned when the running job doesn't exist or it is claimed by another instance.
+	ClaimJob(ctx context.Context, req ClaimJobRequest) (*pb.BulkUpdateJob, error)
+
+	// CreateSchedule creates a new schedule. If the schedule already exists, it will throw an error.
+	CreateSchedule(ctx context.Context, schedule *pb.Schedule) (*pb.Schedule, error)
+	// GetSchedules loads schedules from the database. Empty owner means schedules of all owners.
+	GetSchedules(ctx context.Context, owner string, query GetSchedulesQuery, p ProcessSchedules) error
+	// DeleteSchedules deletes the schedules of the owner and returns the number of deleted schedules.
+	DeleteSchedules(ctx context.Context, owner string, query GetSchedulesQuery) (int64, error)
+	// ClaimScheduleRun claims the run of the active schedule and returns the updated schedule.
+	// ErrNotFound is returned when the run was already claimed or the schedule doesn't exist.
+	ClaimScheduleRun(ctx context.Context, req ClaimScheduleRunRequest) (*pb.Schedule, error)
+	// AddScheduleRun appends the run to the history of the schedule.
+	AddScheduleRun(ctx context.Context, req AddScheduleRunRequest) error
+
+	Close(ctx context.Context) error
+}
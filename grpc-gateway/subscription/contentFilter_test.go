@@ -0,0 +1,65 @@
+package subscription
+
+import (
+	"testing"
+
+	"github.com/plgd-dev/go-coap/v3/message"
+	"github.com/plgd-dev/hub/v2/grpc-gateway/pb"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/commands"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/events"
+	"github.com/plgd-dev/hub/v2/snippet-service/jq"
+	"github.com/plgd-dev/kit/v2/codec/json"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubIsFilteredContent(t *testing.T) {
+	toEvent := func(v any) *pb.Event {
+		data, err := json.Encode(v)
+		require.NoError(t, err)
+		return &pb.Event{Type: &pb.Event_ResourceChanged{ResourceChanged: &events.ResourceChanged{
+			ResourceId: commands.NewResourceID("d1", "/temperature"),
+			Content: &commands.Content{
+				ContentType: message.AppJSON.String(),
+				Data:        data,
+			},
+		}}}
+	}
+
+	var sent []*pb.Event
+	s := New(func(e *pb.Event) error {
+		sent = append(sent, e)
+		return nil
+	}, "", false, &pb.SubscribeToEvents_CreateSubscription{
+		EventFilter: []pb.SubscribeToEvents_CreateSubscription_Event{pb.SubscribeToEvents_CreateSubscription_RESOURCE_CHANGED},
+	})
+	contentFilter, err := jq.ParseJQCondition(".temperature > 80")
+	require.NoError(t, err)
+	s.contentFilter = contentFilter
+
+	tests := []struct {
+		name string
+		ev   *pb.Event
+		want bool
+	}{
+		{name: "matches", ev: toEvent(map[string]any{"temperature": 81}), want: true},
+		{name: "not matches", ev: toEvent(map[string]any{"temperature": 80})},
+		{name: "missing attribute", ev: toEvent(map[string]any{"humidity": 81})},
+		{name: "invalid content", ev: &pb.Event{Type: &pb.Event_ResourceChanged{ResourceChanged: &events.ResourceChanged{
+			ResourceId: commands.NewResourceID("d1", "/temperature"),
+		}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sent = nil
+			err := s.ProcessEvent(tt.ev, FilterBitmaskResourceChanged)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, len(sent) == 1)
+		})
+	}
+
+	// the evaluation is canceled with the subscription
+	require.NoError(t, s.Close())
+	sent = nil
+	require.NoError(t, s.ProcessEvent(toEvent(map[string]any{"temperature": 81}), FilterBitmaskResourceChanged))
+	require.Empty(t, sent)
+}
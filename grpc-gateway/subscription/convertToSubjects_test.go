@@ -129,6 +129,7 @@ func TestConvertToSubjects(t *testing.T) {
 				req: &pb.SubscribeToEvents_CreateSubscription{
 					EventFilter: []pb.SubscribeToEvents_CreateSubscription_Event{
 						pb.SubscribeToEvents_CreateSubscription_DEVICE_METADATA_UPDATED, pb.SubscribeToEvents_CreateSubscription_DEVICE_METADATA_UPDATE_PENDING,
+						pb.SubscribeToEvents_CreateSubscription_DEVICE_LABELS_UPDATED,
 					},
 				},
 			},
@@ -143,6 +144,7 @@ func TestConvertToSubjects(t *testing.T) {
 					DeviceIdFilter: []string{"a"},
 					EventFilter: []pb.SubscribeToEvents_CreateSubscription_Event{
 						pb.SubscribeToEvents_CreateSubscription_DEVICE_METADATA_UPDATED, pb.SubscribeToEvents_CreateSubscription_DEVICE_METADATA_UPDATE_PENDING,
+						pb.SubscribeToEvents_CreateSubscription_DEVICE_LABELS_UPDATED,
 					},
 				},
 			},
@@ -46,6 +46,7 @@ func TestEventsFilterToBitmask(t *testing.T) {
 					pb.SubscribeToEvents_CreateSubscription_RESOURCE_CREATE_PENDING,
 					pb.SubscribeToEvents_CreateSubscription_RESOURCE_CREATED,
 					pb.SubscribeToEvents_CreateSubscription_RESOURCE_CHANGED,
+					pb.SubscribeToEvents_CreateSubscription_DEVICE_LABELS_UPDATED,
 				},
 			},
 			want: FilterBitmaskResourceCreatePending |
@@ -62,7 +63,8 @@ func TestEventsFilterToBitmask(t *testing.T) {
 				FilterBitmaskDeviceUnregistered |
 				FilterBitmaskResourceChanged |
 				FilterBitmaskResourcesPublished |
-				FilterBitmaskResourcesUnpublished,
+				FilterBitmaskResourcesUnpublished |
+				FilterBitmaskDeviceLabelsUpdated,
 		},
 	}
 	for _, tt := range tests {
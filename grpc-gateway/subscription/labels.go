@@ -0,0 +1,63 @@
+package subscription
+
+import (
+	"sync"
+
+	"github.com/plgd-dev/hub/v2/grpc-gateway/pb"
+	"github.com/plgd-dev/hub/v2/pkg/labels"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/utils"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/events"
+)
+
+// LoadDeviceLabelsFunc returns the labels of the devices of the owner, the key is the deviceID.
+type LoadDeviceLabelsFunc = func() (map[string]map[string]string, error)
+
+// deviceLabelsFilter filters devices by the labels. The labels are updated by the DeviceLabelsUpdated events.
+type deviceLabelsFilter struct {
+	selector labels.Selector
+
+	lock   sync.Mutex
+	labels map[string]map[string]string
+}
+
+func newDeviceLabelsFilter(selector labels.Selector) *deviceLabelsFilter {
+	return &deviceLabelsFilter{
+		selector: selector,
+		labels:   make(map[string]map[string]string),
+	}
+}
+
+// load sets the labels of the devices which were not updated by the DeviceLabelsUpdated events yet.
+func (f *deviceLabelsFilter) load(deviceLabels map[string]map[string]string) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	for deviceID, l := range deviceLabels {
+		if _, ok := f.labels[deviceID]; !ok {
+			f.labels[deviceID] = l
+		}
+	}
+}
+
+func (f *deviceLabelsFilter) processEvent(e *pb.Event, _ FilterBitmask) error {
+	ev := e.GetDeviceLabelsUpdated()
+	if ev == nil {
+		return nil
+	}
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.labels[ev.GetDeviceId()] = ev.GetLabels()
+	return nil
+}
+
+func (f *deviceLabelsFilter) matches(deviceID string) bool {
+	if f == nil {
+		return true
+	}
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return f.selector.Matches(f.labels[deviceID])
+}
+
+func (f *deviceLabelsFilter) subject(owner string) string {
+	return utils.GetDeviceMetadataEventSubject(owner, "*", (&events.DeviceLabelsUpdated{}).EventType())[0]
+}
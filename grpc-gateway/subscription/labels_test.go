@@ -0,0 +1,53 @@
+package subscription
+
+import (
+	"testing"
+
+	"github.com/plgd-dev/hub/v2/grpc-gateway/pb"
+	"github.com/plgd-dev/hub/v2/pkg/labels"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/events"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeviceLabelsFilter(t *testing.T) {
+	selector, err := labels.Parse("site=brno")
+	require.NoError(t, err)
+	f := newDeviceLabelsFilter(selector)
+	f.load(map[string]map[string]string{
+		"d1": {"site": "brno"},
+		"d2": {"site": "praha"},
+	})
+	require.True(t, f.matches("d1"))
+	require.False(t, f.matches("d2"))
+	require.False(t, f.matches("d3"))
+
+	// the labels from the event are newer than the loaded ones
+	err = f.processEvent(&pb.Event{Type: &pb.Event_DeviceLabelsUpdated{DeviceLabelsUpdated: &events.DeviceLabelsUpdated{
+		DeviceId: "d2",
+		Labels:   map[string]string{"site": "brno"},
+	}}}, FilterBitmaskDeviceLabelsUpdated)
+	require.NoError(t, err)
+	f.load(map[string]map[string]string{
+		"d2": {"site": "praha"},
+	})
+	require.True(t, f.matches("d2"))
+
+	var nilFilter *deviceLabelsFilter
+	require.True(t, nilFilter.matches("d3"))
+}
+
+func TestSubIsFilteredDeviceLabelsUpdated(t *testing.T) {
+	selector, err := labels.Parse("site=brno")
+	require.NoError(t, err)
+	s := New(nil, "", false, &pb.SubscribeToEvents_CreateSubscription{LabelSelector: "site=brno"})
+	s.deviceLabelsFilter = newDeviceLabelsFilter(selector)
+	s.deviceLabelsFilter.load(map[string]map[string]string{
+		"d1": {"site": "brno"},
+	})
+
+	// the device leaves the selection
+	require.True(t, s.isFilteredDeviceLabelsUpdated(&events.DeviceLabelsUpdated{DeviceId: "d1", Labels: map[string]string{"site": "praha"}}))
+	// the device enters the selection
+	require.True(t, s.isFilteredDeviceLabelsUpdated(&events.DeviceLabelsUpdated{DeviceId: "d2", Labels: map[string]string{"site": "brno"}}))
+	require.False(t, s.isFilteredDeviceLabelsUpdated(&events.DeviceLabelsUpdated{DeviceId: "d2", Labels: map[string]string{"site": "praha"}}))
+}
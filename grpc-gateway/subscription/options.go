@@ -0,0 +1,110 @@
+package subscription
+
+import (
+	"context"
+	"time"
+)
+
+type Option interface {
+	apply(o *options)
+}
+
+type options struct {
+	loadDeviceLabels     LoadDeviceLabelsFunc
+	replayEvents         ReplayEventsFunc
+	sendReplayed         SendEventFunc
+	resumeWindow         time.Duration
+	contentFilterTimeout time.Duration
+	ctx                  context.Context
+}
+
+type LoadDeviceLabelsOpt struct {
+	loadDeviceLabels LoadDeviceLabelsFunc
+}
+
+func (o LoadDeviceLabelsOpt) apply(opts *options) {
+	opts.loadDeviceLabels = o.loadDeviceLabels
+}
+
+// WithLoadDeviceLabels sets the function used to load the current labels of the devices when the subscription
+// has the label selector set. Without it, only the labels from the DeviceLabelsUpdated events are used.
+func WithLoadDeviceLabels(f LoadDeviceLabelsFunc) LoadDeviceLabelsOpt {
+	return LoadDeviceLabelsOpt{
+		loadDeviceLabels: f,
+	}
+}
+
+type ReplayEventsOpt struct {
+	replayEvents ReplayEventsFunc
+	send         SendEventFunc
+}
+
+func (o ReplayEventsOpt) apply(opts *options) {
+	opts.replayEvents = o.replayEvents
+	opts.sendReplayed = o.send
+}
+
+// WithReplayEvents sets the function used to load the stored events when the subscription is resumed by
+// the resume_from token. The replayed events are sent by the send function, which can block until the client
+// consumes them. When send is nil, the send function of the subscription is used.
+func WithReplayEvents(replayEvents ReplayEventsFunc, send SendEventFunc) ReplayEventsOpt {
+	return ReplayEventsOpt{
+		replayEvents: replayEvents,
+		send:         send,
+	}
+}
+
+type ResumeWindowOpt struct {
+	resumeWindow time.Duration
+}
+
+func (o ResumeWindowOpt) apply(opts *options) {
+	if o.resumeWindow > 0 {
+		opts.resumeWindow = o.resumeWindow
+	}
+}
+
+// WithResumeWindow sets the maximal delay of the event sent after the events of other aggregates with the newer
+// timestamp, eg. the event published by the outbox relay of the resource-aggregate. The resume token contains
+// the versions of all aggregates sent within the window, and the stored events of the window are replayed.
+// DefaultResumeWindow is used by default.
+func WithResumeWindow(resumeWindow time.Duration) ResumeWindowOpt {
+	return ResumeWindowOpt{
+		resumeWindow: resumeWindow,
+	}
+}
+
+type ContentFilterTimeoutOpt struct {
+	contentFilterTimeout time.Duration
+}
+
+func (o ContentFilterTimeoutOpt) apply(opts *options) {
+	if o.contentFilterTimeout > 0 {
+		opts.contentFilterTimeout = o.contentFilterTimeout
+	}
+}
+
+// WithContentFilterTimeout sets the maximal duration of the evaluation of the jq expression filter for the content
+// of a resource. The expressions are provided by the clients, so the evaluation of an expression which doesn't
+// terminate is canceled and the content doesn't match the filter. DefaultContentFilterTimeout is used by default.
+func WithContentFilterTimeout(contentFilterTimeout time.Duration) ContentFilterTimeoutOpt {
+	return ContentFilterTimeoutOpt{
+		contentFilterTimeout: contentFilterTimeout,
+	}
+}
+
+type ContextOpt struct {
+	ctx context.Context
+}
+
+func (o ContextOpt) apply(opts *options) {
+	opts.ctx = o.ctx
+}
+
+// WithContext sets the context of the subscription, eg. the context of the stream of the client. The evaluation of
+// the jq expression filter is canceled with it or when the subscription is closed.
+func WithContext(ctx context.Context) ContextOpt {
+	return ContextOpt{
+		ctx: ctx,
+	}
+}
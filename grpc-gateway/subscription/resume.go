@@ -0,0 +1,335 @@
+package subscription
+
+import (
+	"cmp"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/plgd-dev/hub/v2/grpc-gateway/pb"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/events"
+)
+
+// ReplayEventsFunc returns the events of the subscription stored in the event store with the timestamp
+// greater than the given one.
+type ReplayEventsFunc = func(req *pb.SubscribeToEvents_CreateSubscription, timestamp int64) ([]*pb.Event, error)
+
+// DefaultResumeWindow is the default maximal delay of the event of another aggregate, which is sent after the events
+// with the newer timestamp. It covers the default delay and interval of the outbox relay of the resource-aggregate.
+const DefaultResumeWindow = time.Minute
+
+// ResumeToken is the position of the event in the event store.
+type ResumeToken struct {
+	AggregateID string `json:"a"`
+	Version     uint64 `json:"v"`
+	Timestamp   int64  `json:"t"`
+	// Window is the interval in nanoseconds before the timestamp, from which the stored events are replayed.
+	Window int64 `json:"w,omitempty"`
+	// Versions contains the versions of all other aggregates sent within the window before the event.
+	Versions map[string]uint64 `json:"s,omitempty"`
+}
+
+func (t ResumeToken) Encode() string {
+	data, err := json.Marshal(t)
+	if err != nil {
+		// ResumeToken contains only strings and numbers
+		panic(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func DecodeResumeToken(token string) (ResumeToken, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return ResumeToken{}, fmt.Errorf("invalid resume token: %w", err)
+	}
+	var t ResumeToken
+	if err = json.Unmarshal(data, &t); err != nil {
+		return ResumeToken{}, fmt.Errorf("invalid resume token: %w", err)
+	}
+	if t.AggregateID == "" {
+		return ResumeToken{}, errors.New("invalid resume token: aggregateID is not set")
+	}
+	return t, nil
+}
+
+type storedEvent interface {
+	AggregateID() string
+	GetEventMetadata() *events.EventMetadata
+}
+
+func getStoredEvent(e *pb.Event) storedEvent {
+	m := e.ProtoReflect()
+	fd := m.WhichOneof(m.Descriptor().Oneofs().ByName("type"))
+	if fd == nil {
+		return nil
+	}
+	v, ok := m.Get(fd).Message().Interface().(storedEvent)
+	if !ok {
+		return nil
+	}
+	return v
+}
+
+// MakeResumeToken returns the resume token of the event. The events which are not stored
+// in the event store don't have the resume token.
+func MakeResumeToken(e *pb.Event) (ResumeToken, bool) {
+	v := getStoredEvent(e)
+	if v == nil || v.GetEventMetadata() == nil {
+		return ResumeToken{}, false
+	}
+	return ResumeToken{
+		AggregateID: v.AggregateID(),
+		Version:     v.GetEventMetadata().GetVersion(),
+		Timestamp:   v.GetEventMetadata().GetTimestamp(),
+	}, true
+}
+
+func eventToBitmask(e *pb.Event) FilterBitmask {
+	switch e.GetType().(type) {
+	case *pb.Event_ResourcePublished:
+		return FilterBitmaskResourcesPublished
+	case *pb.Event_ResourceUnpublished:
+		return FilterBitmaskResourcesUnpublished
+	case *pb.Event_ResourceChanged:
+		return FilterBitmaskResourceChanged
+	case *pb.Event_ResourceUpdatePending:
+		return FilterBitmaskResourceUpdatePending
+	case *pb.Event_ResourceUpdated:
+		return FilterBitmaskResourceUpdated
+	case *pb.Event_ResourceRetrievePending:
+		return FilterBitmaskResourceRetrievePending
+	case *pb.Event_ResourceRetrieved:
+		return FilterBitmaskResourceRetrieved
+	case *pb.Event_ResourceDeletePending:
+		return FilterBitmaskResourceDeletePending
+	case *pb.Event_ResourceDeleted:
+		return FilterBitmaskResourceDeleted
+	case *pb.Event_ResourceCreatePending:
+		return FilterBitmaskResourceCreatePending
+	case *pb.Event_ResourceCreated:
+		return FilterBitmaskResourceCreated
+	case *pb.Event_DeviceMetadataUpdatePending:
+		return FilterBitmaskDeviceMetadataUpdatePending
+	case *pb.Event_DeviceMetadataUpdated:
+		return FilterBitmaskDeviceMetadataUpdated
+	case *pb.Event_DeviceLabelsUpdated:
+		return FilterBitmaskDeviceLabelsUpdated
+	}
+	return 0
+}
+
+type sentVersion struct {
+	version   uint64
+	timestamp int64
+}
+
+// sentVersions contains the versions of the aggregates sent within the window before the newest sent event. The older
+// versions are pruned at most once per window, so the pruning doesn't iterate the versions for each event.
+type sentVersions struct {
+	window   int64
+	versions map[string]sentVersion // aggregateID -> last sent event
+	newest   int64
+	prunedAt int64
+}
+
+func newSentVersions(window time.Duration) sentVersions {
+	return sentVersions{
+		window:   int64(window),
+		versions: make(map[string]sentVersion),
+	}
+}
+
+// isSent returns true when the version or a newer version of the aggregate was sent.
+func (s *sentVersions) isSent(aggregateID string, version uint64) bool {
+	v, ok := s.versions[aggregateID]
+	return ok && v.version >= version
+}
+
+func (s *sentVersions) record(aggregateID string, v sentVersion) {
+	if !s.isSent(aggregateID, v.version) {
+		s.versions[aggregateID] = v
+	}
+	s.newest = max(s.newest, v.timestamp)
+	if s.newest-s.prunedAt >= s.window {
+		s.prune()
+	}
+}
+
+// prune drops the versions out of the window.
+func (s *sentVersions) prune() {
+	for id, v := range s.versions {
+		if v.timestamp < s.newest-s.window {
+			delete(s.versions, id)
+		}
+	}
+	s.prunedAt = s.newest
+}
+
+// sentEvents tracks the versions of the recently sent events of the aggregates, which are encoded in the resume
+// tokens. The event is recorded after it was sent, so the token never contains a version which was not sent.
+type sentEvents struct {
+	lock     sync.Mutex
+	versions sentVersions
+}
+
+func newSentEvents(window time.Duration) *sentEvents {
+	return &sentEvents{
+		versions: newSentVersions(window),
+	}
+}
+
+// makeToken returns the resume token of the event with the versions of all other aggregates sent within the window.
+func (s *sentEvents) makeToken(e *pb.Event) (ResumeToken, bool) {
+	t, ok := MakeResumeToken(e)
+	if !ok {
+		return ResumeToken{}, false
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	t.Window = s.versions.window
+	for aggregateID, v := range s.versions.versions {
+		if aggregateID == t.AggregateID || v.timestamp < t.Timestamp-t.Window {
+			continue
+		}
+		if t.Versions == nil {
+			t.Versions = make(map[string]uint64, len(s.versions.versions))
+		}
+		t.Versions[aggregateID] = v.version
+	}
+	return t, true
+}
+
+// record records the sent event.
+func (s *sentEvents) record(e *pb.Event) {
+	t, ok := MakeResumeToken(e)
+	if !ok {
+		return
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.versions.record(t.AggregateID, sentVersion{version: t.Version, timestamp: t.Timestamp})
+}
+
+// load loads the versions sent before the subscription was resumed.
+func (s *sentEvents) load(token ResumeToken) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for aggregateID, version := range token.Versions {
+		s.versions.record(aggregateID, sentVersion{version: version, timestamp: token.Timestamp})
+	}
+	s.versions.record(token.AggregateID, sentVersion{version: token.Version, timestamp: token.Timestamp})
+}
+
+// resumeState buffers the live events during the replay of the stored events and drops the events
+// which were already sent. After the replay, the versions are kept only within the window, because the live events
+// published late by the outbox relay of the resource-aggregate can be already replayed.
+type resumeState struct {
+	lock      sync.Mutex
+	replaying bool
+	buffered  []*pb.Event
+	versions  sentVersions
+}
+
+func newResumeState(token ResumeToken, window time.Duration) *resumeState {
+	r := &resumeState{
+		replaying: true,
+		versions:  newSentVersions(window),
+	}
+	for aggregateID, version := range token.Versions {
+		r.versions.record(aggregateID, sentVersion{version: version, timestamp: token.Timestamp})
+	}
+	r.versions.record(token.AggregateID, sentVersion{version: token.Version, timestamp: token.Timestamp})
+	return r
+}
+
+// replayFrom returns the timestamp after which the stored events are replayed.
+func replayFrom(token ResumeToken) int64 {
+	return max(token.Timestamp-token.Window, 0)
+}
+
+// markSentLocked returns false when the event or a newer event of the aggregate was already sent.
+func (r *resumeState) markSentLocked(e *pb.Event) bool {
+	t, ok := MakeResumeToken(e)
+	if !ok {
+		return true
+	}
+	if r.versions.isSent(t.AggregateID, t.Version) {
+		return false
+	}
+	r.versions.record(t.AggregateID, sentVersion{version: t.Version, timestamp: t.Timestamp})
+	return true
+}
+
+// live returns true when the live event shall be sent. During the replay, the event is buffered.
+func (r *resumeState) live(e *pb.Event) bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.replaying {
+		r.buffered = append(r.buffered, e)
+		return false
+	}
+	return r.markSentLocked(e)
+}
+
+// filter returns the events which were not sent yet.
+func (r *resumeState) filter(evs []*pb.Event) []*pb.Event {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.filterLocked(evs)
+}
+
+func (r *resumeState) filterLocked(evs []*pb.Event) []*pb.Event {
+	toSend := make([]*pb.Event, 0, len(evs))
+	for _, e := range evs {
+		if r.markSentLocked(e) {
+			toSend = append(toSend, e)
+		}
+	}
+	return toSend
+}
+
+// nextBuffered returns the live events buffered during the replay. When no event is buffered, the replay is finished.
+func (r *resumeState) nextBuffered() []*pb.Event {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	buffered := r.buffered
+	r.buffered = nil
+	if len(buffered) == 0 {
+		r.replaying = false
+		// the versions of the replayed events out of the window are not needed anymore
+		r.versions.prune()
+		return nil
+	}
+	return r.filterLocked(buffered)
+}
+
+// replay sends the stored events and then the buffered live events. The events are sent without the lock, so the live
+// events are buffered meanwhile.
+func (r *resumeState) replay(stored []*pb.Event, send SendEventFunc) error {
+	slices.SortStableFunc(stored, func(a, b *pb.Event) int {
+		ta, _ := MakeResumeToken(a)
+		tb, _ := MakeResumeToken(b)
+		return cmp.Compare(ta.Timestamp, tb.Timestamp)
+	})
+	batch := r.filter(stored)
+	for len(batch) > 0 || r.isReplaying() {
+		for _, e := range batch {
+			if err := send(e); err != nil {
+				return err
+			}
+		}
+		batch = r.nextBuffered()
+	}
+	return nil
+}
+
+func (r *resumeState) isReplaying() bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.replaying
+}
@@ -0,0 +1,215 @@
+package subscription
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/plgd-dev/hub/v2/grpc-gateway/pb"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/commands"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/events"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResumeToken(t *testing.T) {
+	_, ok := MakeResumeToken(&pb.Event{Type: &pb.Event_DeviceRegistered_{DeviceRegistered: &pb.Event_DeviceRegistered{}}})
+	require.False(t, ok)
+
+	token, ok := MakeResumeToken(newResourceChanged("/a", 3, 30))
+	require.True(t, ok)
+	require.Equal(t, ResumeToken{AggregateID: commands.NewResourceID(testDeviceID, "/a").ToUUID().String(), Version: 3, Timestamp: 30}, token)
+
+	decoded, err := DecodeResumeToken(token.Encode())
+	require.NoError(t, err)
+	require.Equal(t, token, decoded)
+
+	_, err = DecodeResumeToken("invalid")
+	require.Error(t, err)
+}
+
+const testDeviceID = "a4e00a78-8d4a-4d06-7a3a-5ef0e1e4a6b2"
+
+func newResourceChanged(href string, version uint64, timestamp int64) *pb.Event {
+	return &pb.Event{Type: &pb.Event_ResourceChanged{ResourceChanged: &events.ResourceChanged{
+		ResourceId: commands.NewResourceID(testDeviceID, href),
+		EventMetadata: &events.EventMetadata{
+			Version:   version,
+			Timestamp: timestamp,
+		},
+	}}}
+}
+
+func TestSubResume(t *testing.T) {
+	const ts = int64(time.Hour)
+	aggregateID := func(href string) string {
+		return commands.NewResourceID(testDeviceID, href).ToUUID().String()
+	}
+	token, ok := MakeResumeToken(newResourceChanged("/a", 1, ts+10))
+	require.True(t, ok)
+	token.Window = int64(DefaultResumeWindow)
+	// "/c" was sent out of order before the token
+	token.Versions = map[string]uint64{aggregateID("/c"): 1}
+
+	var sent []*pb.Event
+	send := func(e *pb.Event) error {
+		sent = append(sent, e)
+		return nil
+	}
+	var replayedFrom int64
+	replayEvents := func(*pb.SubscribeToEvents_CreateSubscription, int64) ([]*pb.Event, error) {
+		return []*pb.Event{
+			newResourceChanged("/c", 1, ts+15), // already received by the client
+			newResourceChanged("/b", 1, ts+12),
+			newResourceChanged("/a", 1, ts+10), // already received by the client
+			newResourceChanged("/a", 2, ts+11),
+		}, nil
+	}
+	s := New(send, "", false, &pb.SubscribeToEvents_CreateSubscription{
+		ResumeFrom: token.Encode(),
+	}, WithReplayEvents(func(req *pb.SubscribeToEvents_CreateSubscription, timestamp int64) ([]*pb.Event, error) {
+		replayedFrom = timestamp
+		return replayEvents(req, timestamp)
+	}, nil))
+	init := s.init
+	_, err := s.initResume(init)
+	require.NoError(t, err)
+
+	// live events are buffered during the replay
+	require.NoError(t, s.ProcessEvent(newResourceChanged("/b", 1, ts+12), FilterBitmaskResourceChanged))
+	require.NoError(t, s.ProcessEvent(newResourceChanged("/a", 3, ts+13), FilterBitmaskResourceChanged))
+	require.Empty(t, sent)
+
+	require.NoError(t, s.replay(init, token))
+	// live event which was already replayed
+	require.NoError(t, s.ProcessEvent(newResourceChanged("/a", 2, ts+11), FilterBitmaskResourceChanged))
+	require.NoError(t, s.ProcessEvent(newResourceChanged("/b", 2, ts+14), FilterBitmaskResourceChanged))
+
+	require.Equal(t, token.Timestamp-int64(DefaultResumeWindow), replayedFrom)
+	got := make([]ResumeToken, 0, len(sent))
+	for _, e := range sent {
+		v, err := DecodeResumeToken(e.GetResumeToken())
+		require.NoError(t, err)
+		got = append(got, v)
+	}
+	// the tokens contain the versions of the other sent aggregates
+	window := int64(DefaultResumeWindow)
+	require.Equal(t, []ResumeToken{
+		{AggregateID: aggregateID("/a"), Version: 2, Timestamp: ts + 11, Window: window, Versions: map[string]uint64{aggregateID("/c"): 1}},
+		{AggregateID: aggregateID("/b"), Version: 1, Timestamp: ts + 12, Window: window, Versions: map[string]uint64{aggregateID("/a"): 2, aggregateID("/c"): 1}},
+		{AggregateID: aggregateID("/a"), Version: 3, Timestamp: ts + 13, Window: window, Versions: map[string]uint64{aggregateID("/b"): 1, aggregateID("/c"): 1}},
+		{AggregateID: aggregateID("/b"), Version: 2, Timestamp: ts + 14, Window: window, Versions: map[string]uint64{aggregateID("/a"): 3, aggregateID("/c"): 1}},
+	}, got)
+}
+
+func TestSubResumeSendDoesNotBlockLiveEvents(t *testing.T) {
+	token, ok := MakeResumeToken(newResourceChanged("/a", 1, 10))
+	require.True(t, ok)
+	sendReplayed := make(chan *pb.Event)
+	s := New(nil, "", false, &pb.SubscribeToEvents_CreateSubscription{
+		ResumeFrom: token.Encode(),
+	}, WithReplayEvents(func(*pb.SubscribeToEvents_CreateSubscription, int64) ([]*pb.Event, error) {
+		return []*pb.Event{newResourceChanged("/a", 2, 11)}, nil
+	}, func(e *pb.Event) error {
+		sendReplayed <- e
+		return nil
+	}))
+	init := s.init
+	_, err := s.initResume(init)
+	require.NoError(t, err)
+	replayed := make(chan error, 1)
+	go func() {
+		replayed <- s.replay(init, token)
+	}()
+
+	// the replay is blocked by the client, the live events are still buffered
+	processed := make(chan error, 1)
+	go func() {
+		processed <- s.ProcessEvent(newResourceChanged("/a", 3, 12), FilterBitmaskResourceChanged)
+	}()
+	select {
+	case err := <-processed:
+		require.NoError(t, err)
+	case <-time.After(time.Second):
+		require.FailNow(t, "live event is blocked by the replay")
+	}
+	require.Equal(t, uint64(2), (<-sendReplayed).GetResourceChanged().GetEventMetadata().GetVersion())
+	require.Equal(t, uint64(3), (<-sendReplayed).GetResourceChanged().GetEventMetadata().GetVersion())
+	require.NoError(t, <-replayed)
+}
+
+func TestResumeTokenVersions(t *testing.T) {
+	const window = time.Second
+	s := newSentEvents(window)
+	const ts = int64(time.Hour)
+	for i := range 100 {
+		s.record(newResourceChanged("/"+strconv.Itoa(i), 1, ts+int64(i)))
+	}
+	// all aggregates sent within the window are in the token
+	token, ok := s.makeToken(newResourceChanged("/new", 1, ts+100))
+	require.True(t, ok)
+	require.Equal(t, int64(window), token.Window)
+	require.Len(t, token.Versions, 100)
+
+	// the aggregates out of the window are dropped
+	s.record(newResourceChanged("/late", 1, ts+int64(window)+100))
+	token, ok = s.makeToken(newResourceChanged("/new", 2, ts+int64(window)+101))
+	require.True(t, ok)
+	require.Equal(t, map[string]uint64{commands.NewResourceID(testDeviceID, "/late").ToUUID().String(): 1}, token.Versions)
+	require.Len(t, s.versions.versions, 1)
+}
+
+func TestSubResumeLatePublishedEvent(t *testing.T) {
+	const window = time.Second
+	const ts = int64(time.Hour)
+	token, ok := MakeResumeToken(newResourceChanged("/a", 1, ts))
+	require.True(t, ok)
+	token.Window = int64(window)
+	var sent []*pb.Event
+	send := func(e *pb.Event) error {
+		sent = append(sent, e)
+		return nil
+	}
+	var replayedFrom int64
+	s := New(send, "", false, &pb.SubscribeToEvents_CreateSubscription{
+		ResumeFrom: token.Encode(),
+	}, WithReplayEvents(func(_ *pb.SubscribeToEvents_CreateSubscription, timestamp int64) ([]*pb.Event, error) {
+		replayedFrom = timestamp
+		return []*pb.Event{
+			newResourceChanged("/b", 1, ts-int64(window)/2),
+			newResourceChanged("/a", 2, ts+1),
+		}, nil
+	}, nil), WithResumeWindow(window))
+	init := s.init
+	_, err := s.initResume(init)
+	require.NoError(t, err)
+	require.NoError(t, s.replay(init, token))
+	// the stored events of the whole window of the token are replayed
+	require.Equal(t, ts-int64(window), replayedFrom)
+	require.Len(t, sent, 2)
+
+	// the replayed event which is published late by the outbox relay is not sent again
+	require.NoError(t, s.ProcessEvent(newResourceChanged("/b", 1, ts-int64(window)/2), FilterBitmaskResourceChanged))
+	require.Len(t, sent, 2)
+	require.NoError(t, s.ProcessEvent(newResourceChanged("/b", 2, ts+2), FilterBitmaskResourceChanged))
+	require.Len(t, sent, 3)
+
+	// the versions out of the window are pruned after the replay
+	require.NoError(t, s.ProcessEvent(newResourceChanged("/c", 1, ts+2*int64(window)), FilterBitmaskResourceChanged))
+	require.Len(t, sent, 4)
+	require.Len(t, s.resume.versions.versions, 1)
+}
+
+func TestSubResumeInvalid(t *testing.T) {
+	s := New(nil, "", false, &pb.SubscribeToEvents_CreateSubscription{ResumeFrom: "abc"})
+	_, err := s.initResume(s.init)
+	require.Error(t, err) // replay is not supported
+
+	s = New(nil, "", true, &pb.SubscribeToEvents_CreateSubscription{
+		ResumeFrom:             ResumeToken{AggregateID: "a", Version: 1}.Encode(),
+		LeadResourceTypeFilter: []string{"oic.wk.d"},
+	}, WithReplayEvents(func(*pb.SubscribeToEvents_CreateSubscription, int64) ([]*pb.Event, error) {
+		return nil, nil
+	}, nil))
+	_, err = s.initResume(s.init)
+	require.Error(t, err)
+}
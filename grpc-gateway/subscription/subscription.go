@@ -1,19 +1,27 @@
 package subscription
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"slices"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/plgd-dev/hub/v2/grpc-gateway/pb"
 	"github.com/plgd-dev/hub/v2/pkg/fn"
+	"github.com/plgd-dev/hub/v2/pkg/labels"
 	"github.com/plgd-dev/hub/v2/pkg/strings"
 	"github.com/plgd-dev/hub/v2/resource-aggregate/commands"
 	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/utils"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/events"
+	"github.com/plgd-dev/hub/v2/snippet-service/jq"
 	"go.uber.org/atomic"
 )
 
+// DefaultContentFilterTimeout is the default maximal duration of the evaluation of the jq expression filter.
+const DefaultContentFilterTimeout = 100 * time.Millisecond
+
 type SendEventFunc = func(e *pb.Event) error
 
 type set map[uuid.UUID]struct{}
@@ -47,7 +55,11 @@ type subjectFilters struct {
 }
 
 type subInit struct {
-	filters subjectFilters
+	filters          subjectFilters
+	loadDeviceLabels LoadDeviceLabelsFunc
+	replayEvents     ReplayEventsFunc
+	sendReplayed     SendEventFunc
+	resumeWindow     time.Duration
 }
 
 type Sub struct {
@@ -57,10 +69,18 @@ type Sub struct {
 	id            string
 	correlationID string
 	init          *subInit
-
-	filteredDeviceIDs   set
-	filteredHrefIDs     set
-	filteredResourceIDs set
+	// ctx is canceled when the subscription is closed
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	filteredDeviceIDs    set
+	filteredHrefIDs      set
+	filteredResourceIDs  set
+	deviceLabelsFilter   *deviceLabelsFilter
+	contentFilter        *jq.Condition
+	contentFilterTimeout time.Duration
+	resume               *resumeState
+	sent                 *sentEvents
 
 	closed      atomic.Bool
 	closeAtomic atomic.Value
@@ -86,6 +106,52 @@ func isFilteredResourceIDs(filteredResourceIDs set, filteredHrefs set, resourceI
 	return filteredHrefs.Has(utils.HrefToID(resourceID.GetHref())) || filteredResourceIDs.Has(resourceID.ToUUID())
 }
 
+func (s *Sub) isFilteredDevice(deviceID string) bool {
+	return isFilteredDevice(s.filteredDeviceIDs, deviceID) && s.deviceLabelsFilter.matches(deviceID)
+}
+
+func (s *Sub) isFilteredDeviceLabelsUpdated(ev *events.DeviceLabelsUpdated) bool {
+	if !isFilteredDevice(s.filteredDeviceIDs, ev.GetDeviceId()) {
+		return false
+	}
+	if s.deviceLabelsFilter == nil {
+		return true
+	}
+	// the device is selected by the new labels or it was selected by the previous labels
+	return s.deviceLabelsFilter.selector.Matches(ev.GetLabels()) || s.deviceLabelsFilter.matches(ev.GetDeviceId())
+}
+
+func decodeContent(content *commands.Content) (any, error) {
+	var data map[string]any
+	err := commands.DecodeContent(content, &data)
+	if err == nil {
+		return data, nil
+	}
+	// content could be a single value or an array
+	var v any
+	err = commands.DecodeContent(content, &v)
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode content: %w", err)
+	}
+	return v, nil
+}
+
+// isFilteredContent evaluates the jq expression filter against the content of the resource. The content
+// which cannot be decoded or evaluated within the contentFilterTimeout doesn't match the filter.
+func (s *Sub) isFilteredContent(content *commands.Content) bool {
+	if s.contentFilter == nil {
+		return true
+	}
+	v, err := decodeContent(content)
+	if err != nil {
+		return false
+	}
+	ctx, cancel := context.WithTimeout(s.ctx, s.contentFilterTimeout)
+	defer cancel()
+	ok, err := s.contentFilter.Eval(ctx, v)
+	return err == nil && ok
+}
+
 func (s *Sub) Id() string {
 	return s.id
 }
@@ -124,9 +190,85 @@ func (s *Sub) setFilters(filter *commands.ResourceId) (bool, error) {
 	return true, nil
 }
 
+func (s *Sub) initDeviceLabelsFilter(owner string, loadDeviceLabels LoadDeviceLabelsFunc, subCache *SubscriptionsCache) (func(), error) {
+	selector, err := labels.Parse(s.req.GetLabelSelector())
+	if err != nil {
+		return nil, err
+	}
+	if selector.Empty() {
+		return func() {
+			// Do nothing because no labels are tracked.
+		}, nil
+	}
+	f := newDeviceLabelsFilter(selector)
+	// subscribe before loading, so no update of labels is missed
+	closeSub, err := subCache.Subscribe(f.subject(owner), f.processEvent)
+	if err != nil {
+		return nil, err
+	}
+	if loadDeviceLabels != nil {
+		deviceLabels, err := loadDeviceLabels()
+		if err != nil {
+			closeSub()
+			return nil, fmt.Errorf("cannot load labels of devices: %w", err)
+		}
+		f.load(deviceLabels)
+	}
+	s.deviceLabelsFilter = f
+	return closeSub, nil
+}
+
+func (s *Sub) initResume(init *subInit) (ResumeToken, error) {
+	if s.req.GetResumeFrom() == "" {
+		return ResumeToken{}, nil
+	}
+	if init.filters.leadResourceTypeFilter.enabled && len(init.filters.leadResourceTypeFilter.filter) > 0 {
+		return ResumeToken{}, errors.New("resumeFrom cannot be combined with leadResourceTypeFilter")
+	}
+	if init.replayEvents == nil {
+		return ResumeToken{}, errors.New("resumeFrom is not supported")
+	}
+	token, err := DecodeResumeToken(s.req.GetResumeFrom())
+	if err != nil {
+		return ResumeToken{}, err
+	}
+	// the live events are buffered until the stored events are replayed
+	s.resume = newResumeState(token, init.resumeWindow)
+	s.sent.load(token)
+	return token, nil
+}
+
+// replay sends the stored events after the resume token and then the live events buffered during the replay.
+func (s *Sub) replay(init *subInit, token ResumeToken) error {
+	// events of the other aggregates could be sent out of order, so the events within the window before the token
+	// are loaded too and the already sent ones are dropped by the versions of the token
+	stored, err := init.replayEvents(s.req, replayFrom(token))
+	if err != nil {
+		return fmt.Errorf("cannot replay events: %w", err)
+	}
+	filtered := make([]*pb.Event, 0, len(stored))
+	for _, e := range stored {
+		ok, err := s.isFilteredEvent(e, eventToBitmask(e))
+		if err == nil && ok {
+			filtered = append(filtered, e)
+		}
+	}
+	send := init.sendReplayed
+	if send == nil {
+		send = s.send
+	}
+	return s.resume.replay(filtered, func(e *pb.Event) error {
+		return s.sendEvent(send, e)
+	})
+}
+
 func (s *Sub) Init(owner string, subCache *SubscriptionsCache) error {
 	init := s.init
 	s.init = nil
+	token, err := s.initResume(init)
+	if err != nil {
+		return err
+	}
 	for _, filter := range init.filters.resourceFilters {
 		wantContinue, err := s.setFilters(filter)
 		if err != nil {
@@ -136,8 +278,20 @@ func (s *Sub) Init(owner string, subCache *SubscriptionsCache) error {
 			break
 		}
 	}
-	subjects := ConvertToSubjects(owner, init.filters, s.filter)
+	if s.req.GetJqExpressionFilter() != "" {
+		contentFilter, err := jq.ParseJQCondition(s.req.GetJqExpressionFilter())
+		if err != nil {
+			return fmt.Errorf("invalid jqExpressionFilter: %w", err)
+		}
+		s.contentFilter = contentFilter
+	}
 	var closeFn fn.FuncList
+	closeLabels, err := s.initDeviceLabelsFilter(owner, init.loadDeviceLabels, subCache)
+	if err != nil {
+		return err
+	}
+	closeFn.AddFunc(closeLabels)
+	subjects := ConvertToSubjects(owner, init.filters, s.filter)
 	for _, subject := range subjects {
 		closeSub, err := subCache.Subscribe(subject, s.ProcessEvent)
 		if err != nil {
@@ -146,6 +300,12 @@ func (s *Sub) Init(owner string, subCache *SubscriptionsCache) error {
 		}
 		closeFn.AddFunc(closeSub)
 	}
+	if s.resume != nil {
+		if err := s.replay(init, token); err != nil {
+			closeFn.Execute()
+			return err
+		}
+	}
 
 	s.closeAtomic.Store(closeFn.Execute)
 	return nil
@@ -159,33 +319,36 @@ func (s *Sub) isFilteredEventByType(e *pb.Event) (bool, error) {
 	case *pb.Event_DeviceUnregistered_:
 		return true, nil
 	case *pb.Event_ResourcePublished:
-		return isFilteredDevice(s.filteredDeviceIDs, ev.ResourcePublished.GetDeviceId()), nil
+		return s.isFilteredDevice(ev.ResourcePublished.GetDeviceId()), nil
 	case *pb.Event_ResourceUnpublished:
-		return isFilteredDevice(s.filteredDeviceIDs, ev.ResourceUnpublished.GetDeviceId()), nil
+		return s.isFilteredDevice(ev.ResourceUnpublished.GetDeviceId()), nil
 	case *pb.Event_ResourceChanged:
-		return isFilteredDevice(s.filteredDeviceIDs, ev.ResourceChanged.GroupID()) && isFilteredResourceIDs(s.filteredResourceIDs, s.filteredHrefIDs, ev.ResourceChanged.GetResourceId()), nil
+		return s.isFilteredDevice(ev.ResourceChanged.GroupID()) && isFilteredResourceIDs(s.filteredResourceIDs, s.filteredHrefIDs, ev.ResourceChanged.GetResourceId()) &&
+			s.isFilteredContent(ev.ResourceChanged.GetContent()), nil
 	// case *pb.Event_OperationProcessed_:
 	// case *pb.Event_SubscriptionCanceled_:
 	case *pb.Event_ResourceUpdatePending:
-		return isFilteredDevice(s.filteredDeviceIDs, ev.ResourceUpdatePending.GroupID()) && isFilteredResourceIDs(s.filteredResourceIDs, s.filteredHrefIDs, ev.ResourceUpdatePending.GetResourceId()), nil
+		return s.isFilteredDevice(ev.ResourceUpdatePending.GroupID()) && isFilteredResourceIDs(s.filteredResourceIDs, s.filteredHrefIDs, ev.ResourceUpdatePending.GetResourceId()), nil
 	case *pb.Event_ResourceUpdated:
-		return isFilteredDevice(s.filteredDeviceIDs, ev.ResourceUpdated.GroupID()) && isFilteredResourceIDs(s.filteredResourceIDs, s.filteredHrefIDs, ev.ResourceUpdated.GetResourceId()), nil
+		return s.isFilteredDevice(ev.ResourceUpdated.GroupID()) && isFilteredResourceIDs(s.filteredResourceIDs, s.filteredHrefIDs, ev.ResourceUpdated.GetResourceId()), nil
 	case *pb.Event_ResourceRetrievePending:
-		return isFilteredDevice(s.filteredDeviceIDs, ev.ResourceRetrievePending.GroupID()) && isFilteredResourceIDs(s.filteredResourceIDs, s.filteredHrefIDs, ev.ResourceRetrievePending.GetResourceId()), nil
+		return s.isFilteredDevice(ev.ResourceRetrievePending.GroupID()) && isFilteredResourceIDs(s.filteredResourceIDs, s.filteredHrefIDs, ev.ResourceRetrievePending.GetResourceId()), nil
 	case *pb.Event_ResourceRetrieved:
-		return isFilteredDevice(s.filteredDeviceIDs, ev.ResourceRetrieved.GroupID()) && isFilteredResourceIDs(s.filteredResourceIDs, s.filteredHrefIDs, ev.ResourceRetrieved.GetResourceId()), nil
+		return s.isFilteredDevice(ev.ResourceRetrieved.GroupID()) && isFilteredResourceIDs(s.filteredResourceIDs, s.filteredHrefIDs, ev.ResourceRetrieved.GetResourceId()), nil
 	case *pb.Event_ResourceDeletePending:
-		return isFilteredDevice(s.filteredDeviceIDs, ev.ResourceDeletePending.GroupID()) && isFilteredResourceIDs(s.filteredResourceIDs, s.filteredHrefIDs, ev.ResourceDeletePending.GetResourceId()), nil
+		return s.isFilteredDevice(ev.ResourceDeletePending.GroupID()) && isFilteredResourceIDs(s.filteredResourceIDs, s.filteredHrefIDs, ev.ResourceDeletePending.GetResourceId()), nil
 	case *pb.Event_ResourceDeleted:
-		return isFilteredDevice(s.filteredDeviceIDs, ev.ResourceDeleted.GroupID()) && isFilteredResourceIDs(s.filteredResourceIDs, s.filteredHrefIDs, ev.ResourceDeleted.GetResourceId()), nil
+		return s.isFilteredDevice(ev.ResourceDeleted.GroupID()) && isFilteredResourceIDs(s.filteredResourceIDs, s.filteredHrefIDs, ev.ResourceDeleted.GetResourceId()), nil
 	case *pb.Event_ResourceCreatePending:
-		return isFilteredDevice(s.filteredDeviceIDs, ev.ResourceCreatePending.GroupID()) && isFilteredResourceIDs(s.filteredResourceIDs, s.filteredHrefIDs, ev.ResourceCreatePending.GetResourceId()), nil
+		return s.isFilteredDevice(ev.ResourceCreatePending.GroupID()) && isFilteredResourceIDs(s.filteredResourceIDs, s.filteredHrefIDs, ev.ResourceCreatePending.GetResourceId()), nil
 	case *pb.Event_ResourceCreated:
-		return isFilteredDevice(s.filteredDeviceIDs, ev.ResourceCreated.GroupID()) && isFilteredResourceIDs(s.filteredResourceIDs, s.filteredHrefIDs, ev.ResourceCreated.GetResourceId()), nil
+		return s.isFilteredDevice(ev.ResourceCreated.GroupID()) && isFilteredResourceIDs(s.filteredResourceIDs, s.filteredHrefIDs, ev.ResourceCreated.GetResourceId()), nil
 	case *pb.Event_DeviceMetadataUpdatePending:
-		return isFilteredDevice(s.filteredDeviceIDs, ev.DeviceMetadataUpdatePending.GroupID()), nil
+		return s.isFilteredDevice(ev.DeviceMetadataUpdatePending.GroupID()), nil
 	case *pb.Event_DeviceMetadataUpdated:
-		return isFilteredDevice(s.filteredDeviceIDs, ev.DeviceMetadataUpdated.GroupID()), nil
+		return s.isFilteredDevice(ev.DeviceMetadataUpdated.GroupID()), nil
+	case *pb.Event_DeviceLabelsUpdated:
+		return s.isFilteredDeviceLabelsUpdated(ev.DeviceLabelsUpdated), nil
 	}
 	return false, fmt.Errorf("unknown event type('%T')", e.GetType())
 }
@@ -208,15 +371,26 @@ func (s *Sub) ProcessEvent(e *pb.Event, eventType FilterBitmask) error {
 	if !ok {
 		return nil
 	}
+	if s.resume != nil && !s.resume.live(e) {
+		return nil
+	}
+	return s.sendEvent(s.send, e)
+}
+
+func (s *Sub) sendEvent(send SendEventFunc, e *pb.Event) error {
 	ev := pb.Event{
 		SubscriptionId: s.id,
 		CorrelationId:  s.correlationID,
 		Type:           e.GetType(),
 	}
-	err = s.send(&ev)
+	if t, ok := s.sent.makeToken(e); ok {
+		ev.ResumeToken = t.Encode()
+	}
+	err := send(&ev)
 	if err != nil {
 		return fmt.Errorf("correlationId: %v, subscriptionId: %v: cannot send event ('%v'): %w", s.correlationID, s.Id(), e, err)
 	}
+	s.sent.record(e)
 	return nil
 }
 
@@ -227,6 +401,7 @@ func (s *Sub) Close() error {
 	}
 	closeCache := s.closeAtomic.Load().(func())
 	closeCache()
+	s.cancel()
 	return nil
 }
 
@@ -379,12 +554,21 @@ func getFilters(req *pb.SubscribeToEvents_CreateSubscription, leadRTFilterEnable
 	return sf, bitmask
 }
 
-func New(send SendEventFunc, correlationID string, leadRTEnabled bool, req *pb.SubscribeToEvents_CreateSubscription) *Sub {
+func New(send SendEventFunc, correlationID string, leadRTEnabled bool, req *pb.SubscribeToEvents_CreateSubscription, opts ...Option) *Sub {
+	o := options{
+		resumeWindow:         DefaultResumeWindow,
+		contentFilterTimeout: DefaultContentFilterTimeout,
+		ctx:                  context.Background(),
+	}
+	for _, opt := range opts {
+		opt.apply(&o)
+	}
 	// for backward compatibility and http api
 	req.ResourceIdFilter = append(req.ResourceIdFilter, req.ConvertHTTPResourceIDFilter()...)
 
 	filters, bitmask := getFilters(req, leadRTEnabled)
 	id := uuid.NewString()
+	ctx, cancel := context.WithCancel(o.ctx)
 	var closeAtomic atomic.Value
 	closeAtomic.Store(func() {
 		// Do nothing because it will be replaced in Init function.
@@ -395,12 +579,20 @@ func New(send SendEventFunc, correlationID string, leadRTEnabled bool, req *pb.S
 		req:    req,
 		id:     id,
 		init: &subInit{
-			filters: filters,
+			filters:          filters,
+			loadDeviceLabels: o.loadDeviceLabels,
+			replayEvents:     o.replayEvents,
+			sendReplayed:     o.sendReplayed,
+			resumeWindow:     o.resumeWindow,
 		},
-		filteredHrefIDs:     make(set),
-		filteredDeviceIDs:   make(set),
-		filteredResourceIDs: make(set),
-		correlationID:       correlationID,
-		closeAtomic:         closeAtomic,
+		filteredHrefIDs:      make(set),
+		filteredDeviceIDs:    make(set),
+		filteredResourceIDs:  make(set),
+		correlationID:        correlationID,
+		ctx:                  ctx,
+		cancel:               cancel,
+		contentFilterTimeout: o.contentFilterTimeout,
+		sent:                 newSentEvents(o.resumeWindow),
+		closeAtomic:          closeAtomic,
 	}
 }
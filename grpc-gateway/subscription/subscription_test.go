@@ -207,7 +207,7 @@ func prepareServicesAndSubscription(t *testing.T, owner, correlationID string, l
 		natsConn.Close()
 	})
 
-	subCache := subscription.NewSubscriptionsCache(resourceSubscriber.Conn(), func(err error) { log.Get().Error(err) })
+	subCache := subscription.NewSubscriptionsCache(resourceSubscriber, func(err error) { log.Get().Error(err) })
 
 	s := subscription.New(sendEvent, correlationID, leadRTEnabled, req)
 	err = s.Init(owner, subCache)
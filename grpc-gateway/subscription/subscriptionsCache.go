@@ -7,9 +7,9 @@ import (
 	"sync/atomic"
 
 	"github.com/hashicorp/go-multierror"
-	nats "github.com/nats-io/nats.go"
 	"github.com/plgd-dev/hub/v2/grpc-gateway/pb"
 	isEvents "github.com/plgd-dev/hub/v2/identity-store/events"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus"
 	eventbusPb "github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/pb"
 	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/utils"
 	"github.com/plgd-dev/hub/v2/resource-aggregate/events"
@@ -23,8 +23,8 @@ type (
 )
 
 type eventSubject struct {
-	senders      map[uint64]SendEventWithTypeFunc
-	subscription *nats.Subscription
+	senders     map[uint64]SendEventWithTypeFunc
+	unsubscribe func() error
 	sync.Mutex
 }
 
@@ -71,9 +71,9 @@ func sendToSenders(ev *pb.Event, bit FilterBitmask, senders []func(e *pb.Event,
 	return errors.ErrorOrNil()
 }
 
-func (d *eventSubject) handleRegistrationsEvent(msg *nats.Msg) error {
+func (d *eventSubject) handleRegistrationsEvent(data []byte) error {
 	var e isEvents.Event
-	if err := utils.Unmarshal(msg.Data, &e); err != nil {
+	if err := utils.Unmarshal(data, &e); err != nil {
 		return err
 	}
 	ev, bit := registrationEventToGrpcEvent(&e)
@@ -231,6 +231,18 @@ func convDeviceMetadataUpdatePending(ev *eventbusPb.Event) (*pb.Event, FilterBit
 	}, FilterBitmaskDeviceMetadataUpdatePending, nil
 }
 
+func convDeviceLabelsUpdated(ev *eventbusPb.Event) (*pb.Event, FilterBitmask, error) {
+	var e events.DeviceLabelsUpdated
+	if err := utils.Unmarshal(ev.GetData(), &e); err != nil {
+		return nil, 0, fmt.Errorf(errorUnmarshalEventFmt, ev.GetEventType(), err)
+	}
+	return &pb.Event{
+		Type: &pb.Event_DeviceLabelsUpdated{
+			DeviceLabelsUpdated: &e,
+		},
+	}, FilterBitmaskDeviceLabelsUpdated, nil
+}
+
 func convDeviceMetadataUpdated(ev *eventbusPb.Event) (*pb.Event, FilterBitmask, error) {
 	var e events.DeviceMetadataUpdated
 	if err := utils.Unmarshal(ev.GetData(), &e); err != nil {
@@ -254,6 +266,7 @@ var devicesEventToGrpcPb = map[string]devicesEventToGrpcFunc{
 	(&events.ResourceDeleted{}).EventType():             convResourceDeleted,
 	(&events.DeviceMetadataUpdatePending{}).EventType(): convDeviceMetadataUpdatePending,
 	(&events.DeviceMetadataUpdated{}).EventType():       convDeviceMetadataUpdated,
+	(&events.DeviceLabelsUpdated{}).EventType():         convDeviceLabelsUpdated,
 	(&events.ResourceChanged{}).EventType():             convResourceChanged,
 	(&events.ResourceLinksPublished{}).EventType():      convResourcesPublished,
 	(&events.ResourceLinksUnpublished{}).EventType():    convResourcesUnpublished,
@@ -269,9 +282,9 @@ func (d *eventSubject) copySenders() []SendEventWithTypeFunc {
 	return senders
 }
 
-func (d *eventSubject) handleDevicesEvent(msg *nats.Msg) error {
+func (d *eventSubject) handleDevicesEvent(data []byte) error {
 	var e eventbusPb.Event
-	if err := proto.Unmarshal(msg.Data, &e); err != nil {
+	if err := proto.Unmarshal(data, &e); err != nil {
 		return err
 	}
 
@@ -286,13 +299,13 @@ func (d *eventSubject) handleDevicesEvent(msg *nats.Msg) error {
 	return sendToSenders(ev, bit, d.copySenders())
 }
 
-func (d *eventSubject) handleEvent(msg *nats.Msg) error {
-	if strings.Contains(msg.Subject, "."+isEvents.Registrations) {
-		return d.handleRegistrationsEvent(msg)
-	} else if strings.Contains(msg.Subject, "."+utils.Devices) {
-		return d.handleDevicesEvent(msg)
+func (d *eventSubject) handleEvent(subject string, data []byte) error {
+	if strings.Contains(subject, "."+isEvents.Registrations) {
+		return d.handleRegistrationsEvent(data)
+	} else if strings.Contains(subject, "."+utils.Devices) {
+		return d.handleDevicesEvent(data)
 	}
-	return fmt.Errorf("cannot process event from unknown subject(%v)", msg.Subject)
+	return fmt.Errorf("cannot process event from unknown subject(%v)", subject)
 }
 
 func (d *eventSubject) AddHandlerLocked(id uint64, h SendEventWithTypeFunc) bool {
@@ -308,36 +321,36 @@ func (d *eventSubject) RemoveHandlerLocked(v uint64) bool {
 	return len(d.senders) == 0
 }
 
-func (d *eventSubject) subscribeLocked(subject string, subscribe func(subj string, cb nats.MsgHandler) (*nats.Subscription, error), handle func(msg *nats.Msg)) error {
-	if d.subscription == nil {
-		sub, err := subscribe(subject, handle)
+func (d *eventSubject) subscribeLocked(subject string, subscriber eventbus.RawSubscriber, handle eventbus.RawHandlerFunc) error {
+	if d.unsubscribe == nil {
+		unsubscribe, err := subscriber.SubscribeRaw(subject, handle)
 		if err != nil {
 			return err
 		}
-		d.subscription = sub
+		d.unsubscribe = unsubscribe
 	}
 	return nil
 }
 
 type SubscriptionsCache struct {
-	subjects  *kitSync.Map
-	conn      *nats.Conn
-	errFunc   ErrFunc
-	handlerID uint64
+	subjects   *kitSync.Map
+	subscriber eventbus.RawSubscriber
+	errFunc    ErrFunc
+	handlerID  uint64
 }
 
-func NewSubscriptionsCache(conn *nats.Conn, errFunc ErrFunc) *SubscriptionsCache {
+func NewSubscriptionsCache(subscriber eventbus.RawSubscriber, errFunc ErrFunc) *SubscriptionsCache {
 	c := &SubscriptionsCache{
-		subjects: kitSync.NewMap(),
-		conn:     conn,
-		errFunc:  errFunc,
+		subjects:   kitSync.NewMap(),
+		subscriber: subscriber,
+		errFunc:    errFunc,
 	}
 	return c
 }
 
 func (c *SubscriptionsCache) makeCloseFunc(subject string, id uint64) func() {
 	return func() {
-		var sub *nats.Subscription
+		var unsubscribe func() error
 		c.subjects.ReplaceWithFunc(subject, func(oldValue interface{}, oldLoaded bool) (newValue interface{}, doDelete bool) {
 			if !oldLoaded {
 				return nil, true
@@ -346,13 +359,13 @@ func (c *SubscriptionsCache) makeCloseFunc(subject string, id uint64) func() {
 			s.Lock()
 			defer s.Unlock()
 			if s.RemoveHandlerLocked(id) {
-				sub = s.subscription
+				unsubscribe = s.unsubscribe
 				return nil, true
 			}
 			return s, false
 		})
-		if sub != nil {
-			err := sub.Unsubscribe()
+		if unsubscribe != nil {
+			err := unsubscribe()
 			if err != nil {
 				c.errFunc(fmt.Errorf("cannot unsubscribe from subject('%v'): %w", subject, err))
 			}
@@ -376,7 +389,7 @@ func (c *SubscriptionsCache) executeOnLockedeventSubject(subject string, fn func
 	return fn(s)
 }
 
-// Subscribe register onEvents handler and creates a NATS subscription, if it does not exist.
+// Subscribe register onEvents handler and creates a eventbus subscription, if it does not exist.
 // To free subscription call the returned close function.
 func (c *SubscriptionsCache) Subscribe(subject string, onEvent SendEventWithTypeFunc) (closeFn func(), err error) {
 	closeFunc := func() {
@@ -390,9 +403,9 @@ func (c *SubscriptionsCache) Subscribe(subject string, onEvent SendEventWithType
 			}
 			closeFunc = c.makeCloseFunc(subject, handlerID)
 		}
-		if s.subscription == nil {
-			errS := s.subscribeLocked(subject, c.conn.Subscribe, func(msg *nats.Msg) {
-				if errH := s.handleEvent(msg); errH != nil {
+		if s.unsubscribe == nil {
+			errS := s.subscribeLocked(subject, c.subscriber, func(subject string, data []byte) {
+				if errH := s.handleEvent(subject, data); errH != nil {
 					c.errFunc(errH)
 				}
 			})
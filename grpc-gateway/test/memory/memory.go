@@ -0,0 +1,10 @@
+// Package memory provides the in-memory implementations of the dependencies of the bulk update jobs
+// and the schedules used by the tests.
+package memory
+
+import "context"
+
+// GetToken returns the token of the owner.
+func GetToken(_ context.Context, owner string) (string, error) {
+	return "token-" + owner, nil
+}
@@ -0,0 +1,60 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/plgd-dev/hub/v2/resource-aggregate/commands"
+	raService "github.com/plgd-dev/hub/v2/resource-aggregate/service"
+	"google.golang.org/grpc"
+)
+
+// ResourceAggregateClient records the update and the cancel commands. The other commands are not implemented.
+type ResourceAggregateClient struct {
+	raService.ResourceAggregateClient
+	lock     sync.Mutex
+	updates  []*commands.UpdateResourceRequest
+	canceled []string
+}
+
+func (c *ResourceAggregateClient) UpdateResource(_ context.Context, in *commands.UpdateResourceRequest, _ ...grpc.CallOption) (*commands.UpdateResourceResponse, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.updates = append(c.updates, in)
+	return &commands.UpdateResourceResponse{ValidUntil: time.Now().Add(time.Duration(in.GetTimeToLive())).UnixNano()}, nil
+}
+
+func (c *ResourceAggregateClient) CancelPendingCommands(_ context.Context, in *commands.CancelPendingCommandsRequest, _ ...grpc.CallOption) (*commands.CancelPendingCommandsResponse, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.canceled = append(c.canceled, in.GetCorrelationIdFilter()...)
+	return &commands.CancelPendingCommandsResponse{}, nil
+}
+
+// Updates returns the received update commands.
+func (c *ResourceAggregateClient) Updates() []*commands.UpdateResourceRequest {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return slices.Clone(c.updates)
+}
+
+// Update returns the first update command of the device or nil.
+func (c *ResourceAggregateClient) Update(deviceID string) *commands.UpdateResourceRequest {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for _, u := range c.updates {
+		if u.GetResourceId().GetDeviceId() == deviceID {
+			return u
+		}
+	}
+	return nil
+}
+
+// Canceled returns the correlation IDs of the canceled commands.
+func (c *ResourceAggregateClient) Canceled() []string {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return slices.Clone(c.canceled)
+}
@@ -0,0 +1,203 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/plgd-dev/hub/v2/grpc-gateway/pb"
+	"github.com/plgd-dev/hub/v2/grpc-gateway/store"
+	"google.golang.org/protobuf/proto"
+)
+
+type lease struct {
+	instanceID string
+	until      int64
+}
+
+// Store keeps the jobs and the schedules in memory. The other methods of store.Store are not implemented.
+type Store struct {
+	store.Store
+	lock      sync.Mutex
+	jobs      map[string]*pb.BulkUpdateJob
+	leases    map[string]lease
+	schedules map[string]*pb.Schedule
+}
+
+func NewStore() *Store {
+	return &Store{
+		jobs:      make(map[string]*pb.BulkUpdateJob),
+		leases:    make(map[string]lease),
+		schedules: make(map[string]*pb.Schedule),
+	}
+}
+
+func (s *Store) CreateJob(_ context.Context, job *pb.BulkUpdateJob) (*pb.BulkUpdateJob, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.jobs[job.GetId()] = proto.Clone(job).(*pb.BulkUpdateJob)
+	return job, nil
+}
+
+func (s *Store) GetJobs(_ context.Context, owner string, query store.GetJobsQuery, p store.ProcessJobs) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for _, job := range s.jobs {
+		if (owner != "" && job.GetOwner() != owner) ||
+			(len(query.IDFilter) > 0 && !slices.Contains(query.IDFilter, job.GetId())) ||
+			(len(query.StatusFilter) > 0 && !slices.Contains(query.StatusFilter, job.GetStatus())) {
+			continue
+		}
+		if l, ok := s.leases[job.GetId()]; ok && query.LeaseExpiredBefore > 0 && l.until >= query.LeaseExpiredBefore {
+			continue
+		}
+		if err := p(proto.Clone(job).(*pb.BulkUpdateJob)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Store) UpdateJobDevice(_ context.Context, req store.UpdateJobDeviceRequest) (*pb.BulkUpdateJob, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	job, ok := s.jobs[req.JobID]
+	if !ok {
+		return nil, store.ErrNotFound
+	}
+	for i, d := range job.GetDevices() {
+		if d.GetDeviceId() != req.Device.GetDeviceId() {
+			continue
+		}
+		if len(req.StatusFilter) > 0 && !slices.Contains(req.StatusFilter, d.GetStatus()) {
+			return nil, store.ErrNotFound
+		}
+		job.Devices[i] = req.Device
+		return proto.Clone(job).(*pb.BulkUpdateJob), nil
+	}
+	return nil, store.ErrNotFound
+}
+
+func (s *Store) UpdateJobStatus(_ context.Context, req store.UpdateJobStatusRequest) (*pb.BulkUpdateJob, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	job, ok := s.jobs[req.JobID]
+	if !ok || (req.Owner != "" && job.GetOwner() != req.Owner) || (len(req.StatusFilter) > 0 && !slices.Contains(req.StatusFilter, job.GetStatus())) {
+		return nil, store.ErrNotFound
+	}
+	job.Status = req.Status
+	return proto.Clone(job).(*pb.BulkUpdateJob), nil
+}
+
+func (s *Store) ClaimJob(_ context.Context, req store.ClaimJobRequest) (*pb.BulkUpdateJob, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	job, ok := s.jobs[req.JobID]
+	if !ok || job.GetStatus() != pb.BulkUpdateJob_RUNNING {
+		return nil, store.ErrNotFound
+	}
+	if l, ok := s.leases[req.JobID]; ok && l.instanceID != req.InstanceID && l.until >= time.Now().UnixNano() {
+		return nil, store.ErrNotFound
+	}
+	s.leases[req.JobID] = lease{instanceID: req.InstanceID, until: req.LeaseUntil}
+	return proto.Clone(job).(*pb.BulkUpdateJob), nil
+}
+
+// GetJob returns the copy of the stored job.
+func (s *Store) GetJob(id string) *pb.BulkUpdateJob {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return proto.Clone(s.jobs[id]).(*pb.BulkUpdateJob)
+}
+
+func (s *Store) CreateSchedule(_ context.Context, schedule *pb.Schedule) (*pb.Schedule, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.schedules[schedule.GetId()] = proto.Clone(schedule).(*pb.Schedule)
+	return schedule, nil
+}
+
+func matchSchedule(schedule *pb.Schedule, owner string, query store.GetSchedulesQuery) bool {
+	return (owner == "" || schedule.GetOwner() == owner) &&
+		(len(query.IDFilter) == 0 || slices.Contains(query.IDFilter, schedule.GetId())) &&
+		(len(query.StatusFilter) == 0 || slices.Contains(query.StatusFilter, schedule.GetStatus()))
+}
+
+func (s *Store) GetSchedules(_ context.Context, owner string, query store.GetSchedulesQuery, p store.ProcessSchedules) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for _, schedule := range s.schedules {
+		if !matchSchedule(schedule, owner, query) {
+			continue
+		}
+		if err := p(proto.Clone(schedule).(*pb.Schedule)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Store) DeleteSchedules(_ context.Context, owner string, query store.GetSchedulesQuery) (int64, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	var count int64
+	for id, schedule := range s.schedules {
+		if matchSchedule(schedule, owner, query) {
+			delete(s.schedules, id)
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (s *Store) ClaimScheduleRun(_ context.Context, req store.ClaimScheduleRunRequest) (*pb.Schedule, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	schedule, ok := s.schedules[req.ScheduleID]
+	if !ok || schedule.GetStatus() != pb.Schedule_ACTIVE {
+		return nil, store.ErrNotFound
+	}
+	if req.Done {
+		schedule.Status = pb.Schedule_DONE
+		schedule.NextRunAt = 0
+		return proto.Clone(schedule).(*pb.Schedule), nil
+	}
+	if schedule.GetNextRunAt() >= req.NextRunAt {
+		return nil, store.ErrNotFound
+	}
+	schedule.NextRunAt = req.NextRunAt
+	return proto.Clone(schedule).(*pb.Schedule), nil
+}
+
+func (s *Store) AddScheduleRun(_ context.Context, req store.AddScheduleRunRequest) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	schedule, ok := s.schedules[req.ScheduleID]
+	if !ok {
+		return store.ErrNotFound
+	}
+	schedule.Runs = append(schedule.Runs, req.Run)
+	if len(schedule.GetRuns()) > req.MaxRuns {
+		schedule.Runs = schedule.GetRuns()[len(schedule.GetRuns())-req.MaxRuns:]
+	}
+	return nil
+}
+
+// GetSchedule returns the copy of the stored schedule.
+func (s *Store) GetSchedule(id string) *pb.Schedule {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return proto.Clone(s.schedules[id]).(*pb.Schedule)
+}
+
+// SetScheduleNextRunAt overwrites the planned run of the stored schedule.
+func (s *Store) SetScheduleNextRunAt(id string, nextRunAt int64) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.schedules[id].NextRunAt = nextRunAt
+}
+
+func (s *Store) Close(context.Context) error {
+	return nil
+}
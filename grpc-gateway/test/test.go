@@ -10,11 +10,14 @@ import (
 
 	"github.com/plgd-dev/hub/v2/grpc-gateway/client"
 	"github.com/plgd-dev/hub/v2/grpc-gateway/service"
+	storeMongo "github.com/plgd-dev/hub/v2/grpc-gateway/store/mongodb"
 	"github.com/plgd-dev/hub/v2/pkg/fsnotify"
 	"github.com/plgd-dev/hub/v2/pkg/log"
+	"github.com/plgd-dev/hub/v2/pkg/mongodb"
 	"github.com/plgd-dev/hub/v2/test"
 	"github.com/plgd-dev/hub/v2/test/config"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
 )
 
 func NewTestClient(t *testing.T) *client.Client {
@@ -40,6 +43,19 @@ func MakeConfig(t require.TestingT) service.Config {
 	cfg.APIs.GRPC.Config = config.MakeGrpcServerConfig(config.GRPC_GW_HOST)
 	cfg.APIs.GRPC.OwnerCacheExpiration = time.Minute
 	cfg.APIs.GRPC.SubscriptionBufferSize = 1000
+	cfg.APIs.GRPC.SubscriptionMaxReplayedEvents = 10000
+	cfg.APIs.GRPC.SubscriptionResumeWindow = time.Minute
+	cfg.APIs.GRPC.SubscriptionJqEvalTimeout = 100 * time.Millisecond
+	cfg.APIs.GRPC.MaxPageSize = 1000
+	cfg.APIs.GRPC.BulkUpdateJobs.Enabled = true
+	cfg.APIs.GRPC.BulkUpdateJobs.ConcurrencyLimit = 16
+	cfg.APIs.GRPC.BulkUpdateJobs.TimeToLive = time.Minute
+	cfg.APIs.GRPC.BulkUpdateJobs.LeaseDuration = time.Second * 30
+	cfg.APIs.GRPC.Schedules.Enabled = true
+	cfg.APIs.GRPC.Schedules.SyncInterval = time.Second * 10
+	cfg.APIs.GRPC.SchemaValidation.Mode = service.SchemaValidationDisabled
+	cfg.APIs.GRPC.SchemaValidation.CacheExpiration = time.Minute
+	cfg.APIs.GRPC.SchemaValidation.Timeout = time.Second * 10
 	cfg.APIs.GRPC.TLS.ClientCertificateRequired = false
 
 	cfg.Clients.IdentityStore.Connection = config.MakeGrpcClientConfig(config.IDENTITY_STORE_HOST)
@@ -48,6 +64,19 @@ func MakeConfig(t require.TestingT) service.Config {
 	cfg.Clients.ResourceAggregate.Connection = config.MakeGrpcClientConfig(config.RESOURCE_AGGREGATE_HOST)
 	cfg.Clients.ResourceDirectory.Connection = config.MakeGrpcClientConfig(config.RESOURCE_DIRECTORY_HOST)
 	cfg.Clients.CertificateAuthority.Connection = config.MakeGrpcClientConfig(config.CERTIFICATE_AUTHORITY_HOST)
+	cfg.Clients.Storage.MongoDB.Mongo = mongodb.Config{
+		MaxPoolSize:     16,
+		MaxConnIdleTime: time.Minute * 4,
+		URI:             config.MONGODB_URI,
+		Database:        "grpcGateway",
+		TLS:             config.MakeTLSClientConfig(),
+	}
+	authCfg := config.MakeAuthorizationConfig()
+	cfg.Clients.ServiceAuthorization.Provider.Authority = authCfg.Endpoints[0].Authority
+	cfg.Clients.ServiceAuthorization.Provider.Audience = authCfg.Audience
+	cfg.Clients.ServiceAuthorization.Provider.HTTP = authCfg.Endpoints[0].HTTP
+	cfg.Clients.ServiceAuthorization.Provider.ClientID = config.OAUTH_MANAGER_CLIENT_ID
+	cfg.Clients.ServiceAuthorization.Provider.ClientSecretFile = config.CA_POOL
 	cfg.Clients.OpenTelemetryCollector = config.MakeOpenTelemetryCollectorClient()
 
 	err := cfg.Validate()
@@ -83,3 +112,26 @@ func New(t require.TestingT, cfg service.Config) func() {
 		require.NoError(t, errC)
 	}
 }
+
+func NewMongoStore(t require.TestingT) (*storeMongo.Store, func()) {
+	cfg := MakeConfig(t)
+	logger := log.NewLogger(cfg.Log)
+
+	fileWatcher, err := fsnotify.NewWatcher(logger)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	store, err := storeMongo.New(ctx, &cfg.Clients.Storage.MongoDB, fileWatcher, logger, noop.NewTracerProvider())
+	require.NoError(t, err)
+
+	cleanUp := func() {
+		err := store.Clear(ctx)
+		require.NoError(t, err)
+		_ = store.Close(ctx)
+
+		err = fileWatcher.Close()
+		require.NoError(t, err)
+	}
+
+	return store, cleanUp
+}
@@ -0,0 +1,136 @@
+package graphql
+
+import (
+	"bytes"
+	"context"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	graphqlGo "github.com/graph-gophers/graphql-go"
+	"github.com/plgd-dev/go-coap/v3/message"
+	"github.com/plgd-dev/hub/v2/grpc-gateway/pb"
+	"github.com/plgd-dev/hub/v2/http-gateway/serverMux"
+	"github.com/plgd-dev/hub/v2/pkg/log"
+	pkgHttp "github.com/plgd-dev/hub/v2/pkg/net/http"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+//go:embed schema.graphql
+var schema string
+
+// maxQueryDepth limits the nesting of the queries, eg. devices { resources { ... } } has the depth 2.
+const maxQueryDepth = 8
+
+type request struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// Handler serves the GraphQL requests. The queries and the mutations are answered by the JSON response,
+// the subscriptions are streamed as Server-Sent Events when the client accepts text/event-stream.
+type Handler struct {
+	schema *graphqlGo.Schema
+	logger log.Logger
+}
+
+func New(client pb.GrpcGatewayClient, logger log.Logger) (*Handler, error) {
+	s, err := graphqlGo.ParseSchema(schema, &Resolver{client: client},
+		graphqlGo.UseStringDescriptions(),
+		graphqlGo.MaxDepth(maxQueryDepth),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse graphql schema: %w", err)
+	}
+	return &Handler{
+		schema: s,
+		logger: logger,
+	}, nil
+}
+
+func acceptsEventStream(r *http.Request) bool {
+	return strings.Contains(r.Header.Get(pkgHttp.AcceptHeaderKey), pkgHttp.TextEventStreamContentType)
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req request
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		serverMux.WriteError(w, status.Errorf(codes.InvalidArgument, "cannot decode graphql request: %v", err))
+		return
+	}
+	if acceptsEventStream(r) {
+		h.subscribe(w, r, req)
+		return
+	}
+	resp := h.schema.Exec(r.Context(), req.Query, req.OperationName, req.Variables)
+	data, err := json.Marshal(resp)
+	if err != nil {
+		serverMux.WriteError(w, fmt.Errorf("cannot marshal graphql response: %w", err))
+		return
+	}
+	w.Header().Set(pkgHttp.ContentTypeHeaderKey, message.AppJSON.String())
+	if _, err = w.Write(data); err != nil {
+		h.logger.Debugf("cannot write graphql response: %v", err)
+	}
+}
+
+func writeSSE(w http.ResponseWriter, rc *http.ResponseController, eventType string, data []byte) error {
+	var buf bytes.Buffer
+	buf.WriteString("event: " + eventType + "\n")
+	if data != nil {
+		buf.WriteString("data: ")
+		buf.Write(data)
+		buf.WriteString("\n")
+	}
+	buf.WriteString("\n")
+	if _, err := w.Write(buf.Bytes()); err != nil {
+		return err
+	}
+	return rc.Flush()
+}
+
+// subscribe streams the responses of the operation, each response is sent as the "next" event and the end of the stream
+// is signaled by the "complete" event. Queries and mutations are executed as subscriptions with a single response.
+func (h *Handler) subscribe(w http.ResponseWriter, r *http.Request, req request) {
+	ctx, cancel := context.WithCancel(r.Context())
+	responses, err := h.schema.Subscribe(ctx, req.Query, req.OperationName, req.Variables)
+	if err != nil {
+		cancel()
+		serverMux.WriteError(w, status.Errorf(codes.InvalidArgument, "cannot subscribe: %v", err))
+		return
+	}
+	defer func() {
+		// the responses must be drained, otherwise the goroutine of the subscription is blocked forever
+		cancel()
+		for range responses {
+		}
+	}()
+	rc := http.NewResponseController(w)
+	// the stream lives until the client disconnects, so the write timeout of the server cannot be applied
+	if err = rc.SetWriteDeadline(time.Time{}); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		h.logger.Errorf("cannot reset write deadline of graphql stream: %w", err)
+	}
+	w.Header().Set(pkgHttp.ContentTypeHeaderKey, pkgHttp.TextEventStreamContentType)
+	w.Header().Set(pkgHttp.CacheControlHeaderKey, "no-cache")
+	w.WriteHeader(http.StatusOK)
+	for resp := range responses {
+		data, err := json.Marshal(resp)
+		if err != nil {
+			h.logger.Errorf("cannot marshal graphql response: %w", err)
+			return
+		}
+		if err = writeSSE(w, rc, "next", data); err != nil {
+			h.logger.Debugf("cannot write to graphql stream: %v", err)
+			return
+		}
+	}
+	if err = writeSSE(w, rc, "complete", nil); err != nil {
+		h.logger.Debugf("cannot write to graphql stream: %v", err)
+	}
+}
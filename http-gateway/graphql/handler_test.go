@@ -0,0 +1,183 @@
+package graphql_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/plgd-dev/go-coap/v3/message"
+	"github.com/plgd-dev/hub/v2/grpc-gateway/pb"
+	"github.com/plgd-dev/hub/v2/http-gateway/graphql"
+	"github.com/plgd-dev/hub/v2/http-gateway/uri"
+	"github.com/plgd-dev/hub/v2/pkg/log"
+	pkgHttp "github.com/plgd-dev/hub/v2/pkg/net/http"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/commands"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/events"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+type stream[T any] struct {
+	grpc.ClientStream
+	items []*T
+}
+
+func (s *stream[T]) Recv() (*T, error) {
+	if len(s.items) == 0 {
+		return nil, io.EOF
+	}
+	v := s.items[0]
+	s.items = s.items[1:]
+	return v, nil
+}
+
+// Trailer returns no next page token, all items are in one page.
+func (s *stream[T]) Trailer() metadata.MD {
+	return nil
+}
+
+type fakeClient struct {
+	pb.GrpcGatewayClient
+	devices       []*pb.Device
+	lock          sync.Mutex
+	resourcesReqs []*pb.GetResourcesRequest
+	updateReq     *pb.UpdateResourceRequest
+}
+
+func (c *fakeClient) GetDevices(context.Context, *pb.GetDevicesRequest, ...grpc.CallOption) (grpc.ServerStreamingClient[pb.Device], error) {
+	if c.devices != nil {
+		return &stream[pb.Device]{items: c.devices}, nil
+	}
+	return &stream[pb.Device]{items: []*pb.Device{
+		{Id: "d1", Name: "light", Labels: map[string]string{"site": "brno", "rack": "r1"}, DisplayName: "lobby light"},
+	}}, nil
+}
+
+func makeResource(deviceID, href string) *pb.Resource {
+	return &pb.Resource{
+		Types: []string{"core.light"},
+		Data: &events.ResourceChanged{
+			ResourceId: commands.NewResourceID(deviceID, href),
+			Content: &commands.Content{
+				ContentType: message.AppJSON.String(),
+				Data:        []byte(`{"power":1}`),
+			},
+			Status:        commands.Status_OK,
+			EventMetadata: &events.EventMetadata{Timestamp: 42},
+		},
+	}
+}
+
+// GetResources returns the resource /light/1 of each device of the device filter and the resources of the resource filter.
+func (c *fakeClient) GetResources(_ context.Context, req *pb.GetResourcesRequest, _ ...grpc.CallOption) (grpc.ServerStreamingClient[pb.Resource], error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.resourcesReqs = append(c.resourcesReqs, req)
+	var items []*pb.Resource
+	for _, deviceID := range req.GetDeviceIdFilter() {
+		items = append(items, makeResource(deviceID, "/light/1"))
+	}
+	for _, f := range req.GetResourceIdFilter() {
+		items = append(items, makeResource(f.GetResourceId().GetDeviceId(), f.GetResourceId().GetHref()))
+	}
+	return &stream[pb.Resource]{items: items}, nil
+}
+
+func (c *fakeClient) UpdateResource(_ context.Context, req *pb.UpdateResourceRequest, _ ...grpc.CallOption) (*pb.UpdateResourceResponse, error) {
+	c.updateReq = req
+	return &pb.UpdateResourceResponse{
+		Data: &events.ResourceUpdated{
+			ResourceId: req.GetResourceId(),
+			Status:     commands.Status_OK,
+		},
+	}, nil
+}
+
+func doRequest(t *testing.T, h http.Handler, query, accept string) *httptest.ResponseRecorder {
+	body, err := json.Marshal(map[string]interface{}{"query": query})
+	require.NoError(t, err)
+	r := httptest.NewRequest(http.MethodPost, uri.GraphQL, strings.NewReader(string(body)))
+	if accept != "" {
+		r.Header.Set(pkgHttp.AcceptHeaderKey, accept)
+	}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	return w
+}
+
+func TestHandlerQuery(t *testing.T) {
+	c := &fakeClient{}
+	h, err := graphql.New(c, log.Get())
+	require.NoError(t, err)
+
+	w := doRequest(t, h, `{ devices { id name labels { key value } displayName resources(hrefFilter: ["/light/1"]) { href status content timestamp } } }`, "")
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `{"data":{"devices":[{
+		"id":"d1",
+		"name":"light",
+		"labels":[{"key":"rack","value":"r1"},{"key":"site","value":"brno"}],
+		"displayName":"lobby light",
+		"resources":[{"href":"/light/1","status":"OK","content":{"power":1},"timestamp":"42"}]
+	}]}}`, w.Body.String())
+	require.Len(t, c.resourcesReqs, 1)
+	require.Equal(t, "d1", c.resourcesReqs[0].GetResourceIdFilter()[0].GetResourceId().GetDeviceId())
+	require.Equal(t, "/light/1", c.resourcesReqs[0].GetResourceIdFilter()[0].GetResourceId().GetHref())
+}
+
+func TestHandlerMutation(t *testing.T) {
+	c := &fakeClient{}
+	h, err := graphql.New(c, log.Get())
+	require.NoError(t, err)
+
+	w := doRequest(t, h, `mutation { updateResource(deviceId: "d1", href: "/light/1", content: {power: 2}, timeToLive: "1000000000") { deviceId href status } }`, "")
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `{"data":{"updateResource":{"deviceId":"d1","href":"/light/1","status":"OK"}}}`, w.Body.String())
+	require.Equal(t, message.AppJSON.String(), c.updateReq.GetContent().GetContentType())
+	require.JSONEq(t, `{"power":2}`, string(c.updateReq.GetContent().GetData()))
+	require.Equal(t, int64(1000000000), c.updateReq.GetTimeToLive())
+
+	// queries are sent as a single event when the client accepts the event stream
+	w = doRequest(t, h, `{ devices { id } }`, pkgHttp.TextEventStreamContentType)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "event: next\ndata: {\"data\":{\"devices\":[{\"id\":\"d1\"}]}}\n\nevent: complete\n\n", w.Body.String())
+}
+
+func TestHandlerQueryBatchesResourcesOfDevices(t *testing.T) {
+	c := &fakeClient{devices: []*pb.Device{{Id: "d1"}, {Id: "d2"}, {Id: "d3"}}}
+	h, err := graphql.New(c, log.Get())
+	require.NoError(t, err)
+
+	// the resources of all devices are loaded by a single request
+	w := doRequest(t, h, `{ devices { id resources { deviceId href } } }`, "")
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `{"data":{"devices":[
+		{"id":"d1","resources":[{"deviceId":"d1","href":"/light/1"}]},
+		{"id":"d2","resources":[{"deviceId":"d2","href":"/light/1"}]},
+		{"id":"d3","resources":[{"deviceId":"d3","href":"/light/1"}]}
+	]}}`, w.Body.String())
+	require.Len(t, c.resourcesReqs, 1)
+	require.Equal(t, []string{"d1", "d2", "d3"}, c.resourcesReqs[0].GetDeviceIdFilter())
+
+	// the empty href filter is the same as no filter, the resources are not requested for all devices of the user
+	c.resourcesReqs = nil
+	w = doRequest(t, h, `{ devices { id resources(hrefFilter: []) { href } } }`, "")
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Len(t, c.resourcesReqs, 1)
+	require.Equal(t, []string{"d1", "d2", "d3"}, c.resourcesReqs[0].GetDeviceIdFilter())
+	require.Empty(t, c.resourcesReqs[0].GetResourceIdFilter())
+
+	// the different filters of the aliased fields are loaded separately
+	c.resourcesReqs = nil
+	w = doRequest(t, h, `{ devices { id a: resources(hrefFilter: ["/a"]) { href } b: resources(hrefFilter: ["/b"]) { href } } }`, "")
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Len(t, c.resourcesReqs, 2)
+	for _, req := range c.resourcesReqs {
+		require.Len(t, req.GetResourceIdFilter(), 3)
+	}
+}
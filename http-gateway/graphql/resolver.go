@@ -0,0 +1,444 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/plgd-dev/go-coap/v3/message"
+	"github.com/plgd-dev/hub/v2/grpc-gateway/pb"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/commands"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/events"
+	"google.golang.org/grpc"
+)
+
+// Resolver is the root resolver of the schema. The resolvers call the grpc-gateway with the context of the HTTP request,
+// so the JWT token of the caller is forwarded.
+type Resolver struct {
+	client pb.GrpcGatewayClient
+}
+
+type resourceIDInput struct {
+	DeviceId string
+	Href     string
+}
+
+type labelInput struct {
+	Key   string
+	Value string
+}
+
+func toResourceIDFilter(v *[]resourceIDInput) []*pb.ResourceIdFilter {
+	filter := make([]*pb.ResourceIdFilter, 0, len(deref(v)))
+	for _, r := range deref(v) {
+		filter = append(filter, &pb.ResourceIdFilter{
+			ResourceId: commands.NewResourceID(r.DeviceId, r.Href),
+		})
+	}
+	return filter
+}
+
+func toString(v *string) string {
+	if v == nil {
+		return ""
+	}
+	return *v
+}
+
+func toBool(v *bool) bool {
+	if v == nil {
+		return false
+	}
+	return *v
+}
+
+func toContent(content JSON) (*pb.Content, error) {
+	data, err := json.Marshal(content.Value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid content: %w", err)
+	}
+	return &pb.Content{
+		ContentType: message.AppJSON.String(),
+		Data:        data,
+	}, nil
+}
+
+func recvAll[T any](stream grpc.ServerStreamingClient[T], err error) ([]*T, error) {
+	if err != nil {
+		return nil, err
+	}
+	var res []*T
+	for {
+		v, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return res, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, v)
+	}
+}
+
+// recvAllPages receives the items of all pages, getPage is called with the page token of each page.
+func recvAllPages[T any](getPage func(pageToken string) (grpc.ServerStreamingClient[T], error)) ([]*T, error) {
+	var res []*T
+	err := pb.ForEachPage(getPage, func(v *T) error {
+		res = append(res, v)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (r *Resolver) Devices(ctx context.Context, args struct {
+	DeviceIdFilter *[]string
+	TypeFilter     *[]string
+	LabelSelector  *string
+},
+) ([]*deviceResolver, error) {
+	devices, err := recvAllPages(func(pageToken string) (grpc.ServerStreamingClient[pb.Device], error) {
+		return r.client.GetDevices(ctx, &pb.GetDevicesRequest{
+			DeviceIdFilter: deref(args.DeviceIdFilter),
+			TypeFilter:     deref(args.TypeFilter),
+			LabelSelector:  toString(args.LabelSelector),
+			PageToken:      pageToken,
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot get devices: %w", err)
+	}
+	deviceIDs := make([]string, 0, len(devices))
+	for _, d := range devices {
+		deviceIDs = append(deviceIDs, d.GetId())
+	}
+	resources := newResourcesLoader(r.client, deviceIDs)
+	res := make([]*deviceResolver, 0, len(devices))
+	for _, d := range devices {
+		res = append(res, &deviceResolver{client: r.client, device: d, resources: resources})
+	}
+	return res, nil
+}
+
+func (r *Resolver) Device(ctx context.Context, args struct {
+	Id string
+},
+) (*deviceResolver, error) {
+	devices, err := r.Devices(ctx, struct {
+		DeviceIdFilter *[]string
+		TypeFilter     *[]string
+		LabelSelector  *string
+	}{
+		DeviceIdFilter: &[]string{args.Id},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(devices) == 0 {
+		return nil, nil
+	}
+	return devices[0], nil
+}
+
+func getResourceLinks(ctx context.Context, client pb.GrpcGatewayClient, req *pb.GetResourceLinksRequest) ([]*resourceLinkResolver, error) {
+	links, err := recvAllPages(func(pageToken string) (grpc.ServerStreamingClient[events.ResourceLinksPublished], error) {
+		req.PageToken = pageToken
+		return client.GetResourceLinks(ctx, req)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot get resource links: %w", err)
+	}
+	res := make([]*resourceLinkResolver, 0, len(links))
+	for _, l := range links {
+		for _, resource := range l.GetResources() {
+			res = append(res, &resourceLinkResolver{resource: resource})
+		}
+	}
+	return res, nil
+}
+
+func (r *Resolver) ResourceLinks(ctx context.Context, args struct {
+	DeviceIdFilter *[]string
+	TypeFilter     *[]string
+},
+) ([]*resourceLinkResolver, error) {
+	return getResourceLinks(ctx, r.client, &pb.GetResourceLinksRequest{
+		DeviceIdFilter: deref(args.DeviceIdFilter),
+		TypeFilter:     deref(args.TypeFilter),
+	})
+}
+
+func getResources(ctx context.Context, client pb.GrpcGatewayClient, req *pb.GetResourcesRequest) ([]*resourceResolver, error) {
+	resources, err := recvAllPages(func(pageToken string) (grpc.ServerStreamingClient[pb.Resource], error) {
+		req.PageToken = pageToken
+		return client.GetResources(ctx, req)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot get resources: %w", err)
+	}
+	res := make([]*resourceResolver, 0, len(resources))
+	for _, resource := range resources {
+		res = append(res, &resourceResolver{resource: resource})
+	}
+	return res, nil
+}
+
+func (r *Resolver) Resources(ctx context.Context, args struct {
+	DeviceIdFilter   *[]string
+	ResourceIdFilter *[]resourceIDInput
+	TypeFilter       *[]string
+	LabelSelector    *string
+},
+) ([]*resourceResolver, error) {
+	return getResources(ctx, r.client, &pb.GetResourcesRequest{
+		DeviceIdFilter:   deref(args.DeviceIdFilter),
+		ResourceIdFilter: toResourceIDFilter(args.ResourceIdFilter),
+		TypeFilter:       deref(args.TypeFilter),
+		LabelSelector:    toString(args.LabelSelector),
+	})
+}
+
+func (r *Resolver) PendingCommands(ctx context.Context, args struct {
+	DeviceIdFilter   *[]string
+	ResourceIdFilter *[]resourceIDInput
+	TypeFilter       *[]string
+	CommandFilter    *[]string
+},
+) ([]*pendingCommandResolver, error) {
+	commandFilter := make([]pb.GetPendingCommandsRequest_Command, 0, len(deref(args.CommandFilter)))
+	for _, c := range deref(args.CommandFilter) {
+		commandFilter = append(commandFilter, pb.GetPendingCommandsRequest_Command(pb.GetPendingCommandsRequest_Command_value[c]))
+	}
+	cmds, err := recvAll(r.client.GetPendingCommands(ctx, &pb.GetPendingCommandsRequest{
+		DeviceIdFilter:   deref(args.DeviceIdFilter),
+		ResourceIdFilter: toResourceIDFilter(args.ResourceIdFilter),
+		TypeFilter:       deref(args.TypeFilter),
+		CommandFilter:    commandFilter,
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("cannot get pending commands: %w", err)
+	}
+	res := make([]*pendingCommandResolver, 0, len(cmds))
+	for _, cmd := range cmds {
+		if v := newPendingCommandResolver(cmd); v != nil {
+			res = append(res, v)
+		}
+	}
+	return res, nil
+}
+
+func (r *Resolver) Events(ctx context.Context, args struct {
+	DeviceIdFilter   *[]string
+	ResourceIdFilter *[]resourceIDInput
+	TimestampFilter  *Int64
+},
+) ([]*eventResolver, error) {
+	evs, err := recvAllPages(func(pageToken string) (grpc.ServerStreamingClient[pb.GetEventsResponse], error) {
+		return r.client.GetEvents(ctx, &pb.GetEventsRequest{
+			DeviceIdFilter:   deref(args.DeviceIdFilter),
+			ResourceIdFilter: toResourceIDFilter(args.ResourceIdFilter),
+			TimestampFilter:  args.TimestampFilter.value(),
+			PageToken:        pageToken,
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot get events: %w", err)
+	}
+	res := make([]*eventResolver, 0, len(evs))
+	for _, ev := range evs {
+		e := ev.ToEvent()
+		if e == nil {
+			continue
+		}
+		if v := newEventResolver(e); v != nil {
+			res = append(res, v)
+		}
+	}
+	return res, nil
+}
+
+func (r *Resolver) UpdateResource(ctx context.Context, args struct {
+	DeviceId          string
+	Href              string
+	Content           JSON
+	ResourceInterface *string
+	TimeToLive        *Int64
+	Force             *bool
+},
+) (*commandResultResolver, error) {
+	content, err := toContent(args.Content)
+	if err != nil {
+		return nil, fmt.Errorf("cannot update resource: %w", err)
+	}
+	resp, err := r.client.UpdateResource(ctx, &pb.UpdateResourceRequest{
+		ResourceId:        commands.NewResourceID(args.DeviceId, args.Href),
+		ResourceInterface: toString(args.ResourceInterface),
+		TimeToLive:        args.TimeToLive.value(),
+		Content:           content,
+		Force:             toBool(args.Force),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot update resource: %w", err)
+	}
+	return newCommandResultResolver(resp.GetData()), nil
+}
+
+func (r *Resolver) CreateResource(ctx context.Context, args struct {
+	DeviceId   string
+	Href       string
+	Content    JSON
+	TimeToLive *Int64
+	Force      *bool
+},
+) (*commandResultResolver, error) {
+	content, err := toContent(args.Content)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create resource: %w", err)
+	}
+	resp, err := r.client.CreateResource(ctx, &pb.CreateResourceRequest{
+		ResourceId: commands.NewResourceID(args.DeviceId, args.Href),
+		TimeToLive: args.TimeToLive.value(),
+		Content:    content,
+		Force:      toBool(args.Force),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot create resource: %w", err)
+	}
+	return newCommandResultResolver(resp.GetData()), nil
+}
+
+func (r *Resolver) DeleteResource(ctx context.Context, args struct {
+	DeviceId          string
+	Href              string
+	ResourceInterface *string
+	TimeToLive        *Int64
+	Force             *bool
+},
+) (*commandResultResolver, error) {
+	resp, err := r.client.DeleteResource(ctx, &pb.DeleteResourceRequest{
+		ResourceId:        commands.NewResourceID(args.DeviceId, args.Href),
+		ResourceInterface: toString(args.ResourceInterface),
+		TimeToLive:        args.TimeToLive.value(),
+		Force:             toBool(args.Force),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot delete resource: %w", err)
+	}
+	return newCommandResultResolver(resp.GetData()), nil
+}
+
+func (r *Resolver) UpdateDeviceMetadata(ctx context.Context, args struct {
+	DeviceId                 string
+	TwinEnabled              *bool
+	TwinForceSynchronization *bool
+	SetLabels                *[]labelInput
+	RemoveLabels             *[]string
+	SetDisplayName           *string
+	RemoveDisplayName        *bool
+	TimeToLive               *Int64
+},
+) (*deviceMetadataUpdateResultResolver, error) {
+	req := pb.UpdateDeviceMetadataRequest{
+		DeviceId:   args.DeviceId,
+		TimeToLive: args.TimeToLive.value(),
+	}
+	switch {
+	case args.SetLabels != nil || args.RemoveLabels != nil || args.SetDisplayName != nil || args.RemoveDisplayName != nil:
+		if len(deref(args.SetLabels)) > 0 {
+			req.SetLabels = make(map[string]string, len(deref(args.SetLabels)))
+		}
+		for _, l := range deref(args.SetLabels) {
+			req.SetLabels[l.Key] = l.Value
+		}
+		req.RemoveLabels = deref(args.RemoveLabels)
+		req.SetDisplayName = toString(args.SetDisplayName)
+		req.RemoveDisplayName = toBool(args.RemoveDisplayName)
+	case toBool(args.TwinForceSynchronization):
+		req.TwinForceSynchronization = true
+	case args.TwinEnabled != nil:
+		req.TwinEnabled = *args.TwinEnabled
+	default:
+		return nil, errors.New("cannot update device metadata: twinEnabled, twinForceSynchronization, setLabels or removeLabels must be set")
+	}
+	resp, err := r.client.UpdateDeviceMetadata(ctx, &req)
+	if err != nil {
+		return nil, fmt.Errorf("cannot update device metadata: %w", err)
+	}
+	return &deviceMetadataUpdateResultResolver{deviceID: args.DeviceId, resp: resp}, nil
+}
+
+type eventsArgs struct {
+	EventFilter            *[]string
+	DeviceIdFilter         *[]string
+	HrefFilter             *[]string
+	ResourceIdFilter       *[]resourceIDInput
+	LeadResourceTypeFilter *[]string
+	LabelSelector          *string
+	JqExpressionFilter     *string
+	ResumeFrom             *string
+}
+
+func (args eventsArgs) toCreateSubscription() *pb.SubscribeToEvents_CreateSubscription {
+	eventFilter := make([]pb.SubscribeToEvents_CreateSubscription_Event, 0, len(deref(args.EventFilter)))
+	for _, e := range deref(args.EventFilter) {
+		eventFilter = append(eventFilter, pb.SubscribeToEvents_CreateSubscription_Event(pb.SubscribeToEvents_CreateSubscription_Event_value[e]))
+	}
+	return &pb.SubscribeToEvents_CreateSubscription{
+		EventFilter:            eventFilter,
+		DeviceIdFilter:         deref(args.DeviceIdFilter),
+		HrefFilter:             deref(args.HrefFilter),
+		ResourceIdFilter:       toResourceIDFilter(args.ResourceIdFilter),
+		LeadResourceTypeFilter: deref(args.LeadResourceTypeFilter),
+		LabelSelector:          toString(args.LabelSelector),
+		JqExpressionFilter:     toString(args.JqExpressionFilter),
+		ResumeFrom:             toString(args.ResumeFrom),
+	}
+}
+
+// SubscribeToEvents is the resolver of Subscription.subscribeToEvents. The events are sent until the context
+// of the request is canceled or the subscription is closed by the grpc-gateway.
+func (r *Resolver) SubscribeToEvents(ctx context.Context, args eventsArgs) (<-chan *eventResolver, error) {
+	stream, err := r.client.SubscribeToEvents(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot subscribe to events: %w", err)
+	}
+	err = stream.Send(&pb.SubscribeToEvents{
+		Action: &pb.SubscribeToEvents_CreateSubscription_{
+			CreateSubscription: args.toCreateSubscription(),
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot subscribe to events: %w", err)
+	}
+	events := make(chan *eventResolver)
+	go func() {
+		defer close(events)
+		for {
+			ev, err := stream.Recv()
+			if err != nil {
+				return
+			}
+			if op := ev.GetOperationProcessed(); op != nil {
+				if op.GetErrorStatus().GetCode() == pb.Event_OperationProcessed_ErrorStatus_OK {
+					continue
+				}
+				// the subscription cannot be created, the stream is completed
+				return
+			}
+			e := newEventResolver(ev)
+			if e == nil {
+				continue
+			}
+			select {
+			case events <- e:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return events, nil
+}
@@ -0,0 +1,69 @@
+package graphql
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	kitJson "github.com/plgd-dev/kit/v2/codec/json"
+)
+
+// Int64 is the GraphQL scalar for int64 values, it is serialized as a string.
+type Int64 int64
+
+func (Int64) ImplementsGraphQLType(name string) bool {
+	return name == "Int64"
+}
+
+func (v *Int64) UnmarshalGraphQL(input interface{}) error {
+	switch input := input.(type) {
+	case int32:
+		*v = Int64(input)
+	case int64:
+		*v = Int64(input)
+	case float64:
+		*v = Int64(input)
+	case string:
+		i, err := strconv.ParseInt(input, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid Int64('%v'): %w", input, err)
+		}
+		*v = Int64(i)
+	default:
+		return fmt.Errorf("invalid Int64 type('%T')", input)
+	}
+	return nil
+}
+
+func (v Int64) MarshalJSON() ([]byte, error) {
+	return strconv.AppendQuote(nil, strconv.FormatInt(int64(v), 10)), nil
+}
+
+func (v *Int64) value() int64 {
+	if v == nil {
+		return 0
+	}
+	return int64(*v)
+}
+
+// JSON is the GraphQL scalar for any JSON value.
+type JSON struct {
+	Value interface{}
+}
+
+func (JSON) ImplementsGraphQLType(name string) bool {
+	return name == "JSON"
+}
+
+func (v *JSON) UnmarshalGraphQL(input interface{}) error {
+	v.Value = input
+	return nil
+}
+
+func (v JSON) MarshalJSON() ([]byte, error) {
+	if raw, ok := v.Value.(json.RawMessage); ok {
+		return raw, nil
+	}
+	// the decoded cbor content contains map[interface{}]interface{}, which is not supported by encoding/json
+	return kitJson.Encode(v.Value)
+}
@@ -0,0 +1,500 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/plgd-dev/hub/v2/grpc-gateway/pb"
+	"github.com/plgd-dev/hub/v2/http-gateway/serverMux"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/commands"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/events"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+type labelResolver struct {
+	key   string
+	value string
+}
+
+func (r labelResolver) Key() string {
+	return r.key
+}
+
+func (r labelResolver) Value() string {
+	return r.value
+}
+
+func toLabels(labels map[string]string) []labelResolver {
+	res := make([]labelResolver, 0, len(labels))
+	for k, v := range labels {
+		res = append(res, labelResolver{key: k, value: v})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].key < res[j].key
+	})
+	return res
+}
+
+// decodeContent decodes the content of the resource, the content which cannot be decoded is returned as null.
+func decodeContent(content *commands.Content) *JSON {
+	if content == nil || len(content.GetData()) == 0 {
+		return nil
+	}
+	var v interface{}
+	if err := commands.DecodeContent(content, &v); err != nil {
+		return nil
+	}
+	return &JSON{Value: v}
+}
+
+// resourcesBatch holds the resources of the devices loaded by a single GetResources request.
+type resourcesBatch struct {
+	once      sync.Once
+	resources map[string][]*resourceResolver // by the device ID
+	err       error
+}
+
+// resourcesLoader loads the resources of all devices resolved by the query at once, so the query devices { resources }
+// calls GetResources once for each combination of the filters instead of once for each device.
+type resourcesLoader struct {
+	client    pb.GrpcGatewayClient
+	deviceIDs []string
+
+	lock    sync.Mutex
+	batches map[string]*resourcesBatch // by the filters
+}
+
+func newResourcesLoader(client pb.GrpcGatewayClient, deviceIDs []string) *resourcesLoader {
+	return &resourcesLoader{
+		client:    client,
+		deviceIDs: deviceIDs,
+		batches:   make(map[string]*resourcesBatch),
+	}
+}
+
+func (l *resourcesLoader) getBatch(hrefFilter, typeFilter []string) *resourcesBatch {
+	key := fmt.Sprintf("%q|%q", hrefFilter, typeFilter)
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	b, ok := l.batches[key]
+	if !ok {
+		b = &resourcesBatch{}
+		l.batches[key] = b
+	}
+	return b
+}
+
+func (l *resourcesLoader) makeRequest(hrefFilter, typeFilter []string) *pb.GetResourcesRequest {
+	req := pb.GetResourcesRequest{
+		TypeFilter: typeFilter,
+	}
+	if len(hrefFilter) == 0 {
+		req.DeviceIdFilter = l.deviceIDs
+		return &req
+	}
+	req.ResourceIdFilter = make([]*pb.ResourceIdFilter, 0, len(l.deviceIDs)*len(hrefFilter))
+	for _, deviceID := range l.deviceIDs {
+		for _, href := range hrefFilter {
+			req.ResourceIdFilter = append(req.ResourceIdFilter, &pb.ResourceIdFilter{
+				ResourceId: commands.NewResourceID(deviceID, href),
+			})
+		}
+	}
+	return &req
+}
+
+// load returns the resources of the device. The resources of all devices of the loader are requested by the first call
+// with the filters, the other calls with the same filters wait for it.
+func (l *resourcesLoader) load(ctx context.Context, deviceID string, hrefFilter, typeFilter []string) ([]*resourceResolver, error) {
+	b := l.getBatch(hrefFilter, typeFilter)
+	b.once.Do(func() {
+		var resources []*resourceResolver
+		resources, b.err = getResources(ctx, l.client, l.makeRequest(hrefFilter, typeFilter))
+		b.resources = make(map[string][]*resourceResolver, len(l.deviceIDs))
+		for _, r := range resources {
+			b.resources[r.DeviceId()] = append(b.resources[r.DeviceId()], r)
+		}
+	})
+	if b.err != nil {
+		return nil, b.err
+	}
+	return b.resources[deviceID], nil
+}
+
+type deviceResolver struct {
+	client    pb.GrpcGatewayClient
+	device    *pb.Device
+	resources *resourcesLoader
+}
+
+func (r *deviceResolver) Id() string {
+	return r.device.GetId()
+}
+
+func (r *deviceResolver) Name() string {
+	return r.device.GetName()
+}
+
+func (r *deviceResolver) Types() []string {
+	return r.device.GetTypes()
+}
+
+func (r *deviceResolver) Interfaces() []string {
+	return r.device.GetInterfaces()
+}
+
+func (r *deviceResolver) ModelNumber() string {
+	return r.device.GetModelNumber()
+}
+
+func (r *deviceResolver) ProtocolIndependentId() string {
+	return r.device.GetProtocolIndependentId()
+}
+
+func (r *deviceResolver) OwnershipStatus() string {
+	return r.device.GetOwnershipStatus().String()
+}
+
+func (r *deviceResolver) Labels() []labelResolver {
+	return toLabels(r.device.GetLabels())
+}
+
+func (r *deviceResolver) DisplayName() string {
+	return r.device.GetDisplayName()
+}
+
+func (r *deviceResolver) Metadata() *deviceMetadataResolver {
+	return &deviceMetadataResolver{metadata: r.device.GetMetadata()}
+}
+
+func (r *deviceResolver) ResourceLinks(ctx context.Context) ([]*resourceLinkResolver, error) {
+	return getResourceLinks(ctx, r.client, &pb.GetResourceLinksRequest{
+		DeviceIdFilter: []string{r.device.GetId()},
+	})
+}
+
+func (r *deviceResolver) Resources(ctx context.Context, args struct {
+	HrefFilter *[]string
+	TypeFilter *[]string
+},
+) ([]*resourceResolver, error) {
+	return r.resources.load(ctx, r.device.GetId(), deref(args.HrefFilter), deref(args.TypeFilter))
+}
+
+type deviceMetadataResolver struct {
+	metadata *pb.Device_Metadata
+}
+
+func (r *deviceMetadataResolver) ConnectionStatus() string {
+	return r.metadata.GetConnection().GetStatus().String()
+}
+
+func (r *deviceMetadataResolver) TwinEnabled() bool {
+	return r.metadata.GetTwinEnabled()
+}
+
+func (r *deviceMetadataResolver) TwinSynchronizationState() string {
+	return r.metadata.GetTwinSynchronization().GetState().String()
+}
+
+type resourceLinkResolver struct {
+	resource *commands.Resource
+}
+
+func (r *resourceLinkResolver) DeviceId() string {
+	return r.resource.GetDeviceId()
+}
+
+func (r *resourceLinkResolver) Href() string {
+	return r.resource.GetHref()
+}
+
+func (r *resourceLinkResolver) ResourceTypes() []string {
+	return r.resource.GetResourceTypes()
+}
+
+func (r *resourceLinkResolver) Interfaces() []string {
+	return r.resource.GetInterfaces()
+}
+
+type resourceResolver struct {
+	resource *pb.Resource
+}
+
+func (r *resourceResolver) DeviceId() string {
+	return r.resource.GetData().GetResourceId().GetDeviceId()
+}
+
+func (r *resourceResolver) Href() string {
+	return r.resource.GetData().GetResourceId().GetHref()
+}
+
+func (r *resourceResolver) Types() []string {
+	return r.resource.GetTypes()
+}
+
+func (r *resourceResolver) Status() string {
+	return r.resource.GetData().GetStatus().String()
+}
+
+func (r *resourceResolver) Content() *JSON {
+	return decodeContent(r.resource.GetData().GetContent())
+}
+
+func (r *resourceResolver) Timestamp() Int64 {
+	return Int64(r.resource.GetData().GetEventMetadata().GetTimestamp())
+}
+
+type pendingCommandResolver struct {
+	commandType   pb.GetPendingCommandsRequest_Command
+	resourceID    *commands.ResourceId
+	deviceID      string
+	auditContext  *commands.AuditContext
+	validUntil    int64
+	content       *commands.Content
+	hasResourceID bool
+}
+
+func newPendingCommandResolver(cmd *pb.PendingCommand) *pendingCommandResolver {
+	type resourcePendingCommand interface {
+		GetResourceId() *commands.ResourceId
+		GetAuditContext() *commands.AuditContext
+		GetValidUntil() int64
+	}
+	var r pendingCommandResolver
+	var rc resourcePendingCommand
+	switch v := cmd.GetCommand().(type) {
+	case *pb.PendingCommand_ResourceCreatePending:
+		r.commandType = pb.GetPendingCommandsRequest_RESOURCE_CREATE
+		r.content = v.ResourceCreatePending.GetContent()
+		rc = v.ResourceCreatePending
+	case *pb.PendingCommand_ResourceRetrievePending:
+		r.commandType = pb.GetPendingCommandsRequest_RESOURCE_RETRIEVE
+		rc = v.ResourceRetrievePending
+	case *pb.PendingCommand_ResourceUpdatePending:
+		r.commandType = pb.GetPendingCommandsRequest_RESOURCE_UPDATE
+		r.content = v.ResourceUpdatePending.GetContent()
+		rc = v.ResourceUpdatePending
+	case *pb.PendingCommand_ResourceDeletePending:
+		r.commandType = pb.GetPendingCommandsRequest_RESOURCE_DELETE
+		rc = v.ResourceDeletePending
+	case *pb.PendingCommand_DeviceMetadataUpdatePending:
+		r.commandType = pb.GetPendingCommandsRequest_DEVICE_METADATA_UPDATE
+		r.deviceID = v.DeviceMetadataUpdatePending.GetDeviceId()
+		r.auditContext = v.DeviceMetadataUpdatePending.GetAuditContext()
+		r.validUntil = v.DeviceMetadataUpdatePending.GetValidUntil()
+		return &r
+	default:
+		return nil
+	}
+	r.resourceID = rc.GetResourceId()
+	r.deviceID = rc.GetResourceId().GetDeviceId()
+	r.auditContext = rc.GetAuditContext()
+	r.validUntil = rc.GetValidUntil()
+	r.hasResourceID = true
+	return &r
+}
+
+func (r *pendingCommandResolver) Type() string {
+	return r.commandType.String()
+}
+
+func (r *pendingCommandResolver) DeviceId() string {
+	return r.deviceID
+}
+
+func (r *pendingCommandResolver) Href() *string {
+	if !r.hasResourceID {
+		return nil
+	}
+	href := r.resourceID.GetHref()
+	return &href
+}
+
+func (r *pendingCommandResolver) CorrelationId() string {
+	return r.auditContext.GetCorrelationId()
+}
+
+func (r *pendingCommandResolver) ValidUntil() Int64 {
+	return Int64(r.validUntil)
+}
+
+func (r *pendingCommandResolver) Content() *JSON {
+	return decodeContent(r.content)
+}
+
+type commandResultResolver struct {
+	resourceID *commands.ResourceId
+	status     commands.Status
+	content    *commands.Content
+}
+
+type resourceCommandResult interface {
+	GetResourceId() *commands.ResourceId
+	GetStatus() commands.Status
+	GetContent() *commands.Content
+}
+
+func newCommandResultResolver(v resourceCommandResult) *commandResultResolver {
+	return &commandResultResolver{
+		resourceID: v.GetResourceId(),
+		status:     v.GetStatus(),
+		content:    v.GetContent(),
+	}
+}
+
+func (r *commandResultResolver) DeviceId() string {
+	return r.resourceID.GetDeviceId()
+}
+
+func (r *commandResultResolver) Href() string {
+	return r.resourceID.GetHref()
+}
+
+func (r *commandResultResolver) Status() string {
+	return r.status.String()
+}
+
+func (r *commandResultResolver) Content() *JSON {
+	return decodeContent(r.content)
+}
+
+type deviceMetadataUpdateResultResolver struct {
+	deviceID string
+	resp     *pb.UpdateDeviceMetadataResponse
+}
+
+func (r *deviceMetadataUpdateResultResolver) DeviceId() string {
+	return r.deviceID
+}
+
+func (r *deviceMetadataUpdateResultResolver) TwinEnabled() *bool {
+	if r.resp.GetData() == nil {
+		return nil
+	}
+	v := r.resp.GetData().GetTwinEnabled()
+	return &v
+}
+
+func (r *deviceMetadataUpdateResultResolver) Labels() []labelResolver {
+	return toLabels(r.resp.GetLabels())
+}
+
+func (r *deviceMetadataUpdateResultResolver) DisplayName() string {
+	return r.resp.GetDisplayName()
+}
+
+var eventTypes = map[protoreflect.Name]pb.SubscribeToEvents_CreateSubscription_Event{
+	"device_registered":              pb.SubscribeToEvents_CreateSubscription_REGISTERED,
+	"device_unregistered":            pb.SubscribeToEvents_CreateSubscription_UNREGISTERED,
+	"resource_published":             pb.SubscribeToEvents_CreateSubscription_RESOURCE_PUBLISHED,
+	"resource_unpublished":           pb.SubscribeToEvents_CreateSubscription_RESOURCE_UNPUBLISHED,
+	"resource_changed":               pb.SubscribeToEvents_CreateSubscription_RESOURCE_CHANGED,
+	"resource_update_pending":        pb.SubscribeToEvents_CreateSubscription_RESOURCE_UPDATE_PENDING,
+	"resource_updated":               pb.SubscribeToEvents_CreateSubscription_RESOURCE_UPDATED,
+	"resource_retrieve_pending":      pb.SubscribeToEvents_CreateSubscription_RESOURCE_RETRIEVE_PENDING,
+	"resource_retrieved":             pb.SubscribeToEvents_CreateSubscription_RESOURCE_RETRIEVED,
+	"resource_delete_pending":        pb.SubscribeToEvents_CreateSubscription_RESOURCE_DELETE_PENDING,
+	"resource_deleted":               pb.SubscribeToEvents_CreateSubscription_RESOURCE_DELETED,
+	"resource_create_pending":        pb.SubscribeToEvents_CreateSubscription_RESOURCE_CREATE_PENDING,
+	"resource_created":               pb.SubscribeToEvents_CreateSubscription_RESOURCE_CREATED,
+	"device_metadata_update_pending": pb.SubscribeToEvents_CreateSubscription_DEVICE_METADATA_UPDATE_PENDING,
+	"device_metadata_updated":        pb.SubscribeToEvents_CreateSubscription_DEVICE_METADATA_UPDATED,
+	"device_labels_updated":          pb.SubscribeToEvents_CreateSubscription_DEVICE_LABELS_UPDATED,
+}
+
+type eventResolver struct {
+	event       *pb.Event
+	eventType   pb.SubscribeToEvents_CreateSubscription_Event
+	payload     proto.Message
+	resumeToken string
+}
+
+// newEventResolver returns nil for the events which are not the events of the resources or the devices.
+func newEventResolver(e *pb.Event) *eventResolver {
+	m := e.ProtoReflect()
+	fd := m.WhichOneof(m.Descriptor().Oneofs().ByName("type"))
+	if fd == nil {
+		return nil
+	}
+	eventType, ok := eventTypes[fd.Name()]
+	if !ok {
+		return nil
+	}
+	return &eventResolver{
+		event:       e,
+		eventType:   eventType,
+		payload:     m.Get(fd).Message().Interface(),
+		resumeToken: e.GetResumeToken(),
+	}
+}
+
+func (r *eventResolver) Type() string {
+	return r.eventType.String()
+}
+
+func (r *eventResolver) DeviceId() *string {
+	type resourceEvent interface {
+		GetResourceId() *commands.ResourceId
+	}
+	type deviceEvent interface {
+		GetDeviceId() string
+	}
+	switch v := r.payload.(type) {
+	case resourceEvent:
+		deviceID := v.GetResourceId().GetDeviceId()
+		return &deviceID
+	case deviceEvent:
+		deviceID := v.GetDeviceId()
+		return &deviceID
+	}
+	return nil
+}
+
+func (r *eventResolver) Href() *string {
+	type resourceEvent interface {
+		GetResourceId() *commands.ResourceId
+	}
+	if v, ok := r.payload.(resourceEvent); ok {
+		href := v.GetResourceId().GetHref()
+		return &href
+	}
+	return nil
+}
+
+func (r *eventResolver) Timestamp() *Int64 {
+	type storedEvent interface {
+		GetEventMetadata() *events.EventMetadata
+	}
+	if v, ok := r.payload.(storedEvent); ok {
+		t := Int64(v.GetEventMetadata().GetTimestamp())
+		return &t
+	}
+	return nil
+}
+
+func (r *eventResolver) ResumeToken() *string {
+	if r.resumeToken == "" {
+		return nil
+	}
+	return &r.resumeToken
+}
+
+func (r *eventResolver) Data() (JSON, error) {
+	data, err := serverMux.NewJsonMarshaler().Marshal(r.payload)
+	if err != nil {
+		return JSON{}, err
+	}
+	return JSON{Value: json.RawMessage(data)}, nil
+}
+
+func deref[T any](v *[]T) []T {
+	if v == nil {
+		return nil
+	}
+	return *v
+}
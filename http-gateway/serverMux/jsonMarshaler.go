@@ -8,6 +8,7 @@ import (
 	jsoniter "github.com/json-iterator/go"
 	"github.com/plgd-dev/go-coap/v3/message"
 	"github.com/plgd-dev/hub/v2/pkg/log"
+	"github.com/plgd-dev/hub/v2/pkg/senml"
 	"github.com/plgd-dev/kit/v2/codec/cbor"
 	"github.com/plgd-dev/kit/v2/codec/json"
 	"google.golang.org/protobuf/encoding/protojson"
@@ -72,6 +73,20 @@ func replaceContent(val map[interface{}]interface{}) (interface{}, bool) {
 			return nil, false
 		}
 		return v, true
+	case message.AppSenmlJSON.String():
+		var v interface{}
+		err := senml.DecodeJSON(data, &v)
+		if err != nil {
+			return nil, false
+		}
+		return v, true
+	case message.AppSenmlCbor.String():
+		var v interface{}
+		err := senml.DecodeCBOR(data, &v)
+		if err != nil {
+			return nil, false
+		}
+		return v, true
 	case message.TextPlain.String():
 		return string(data), true
 	}
@@ -64,17 +64,32 @@ func (c *WebSocketConfig) Validate() error {
 	return nil
 }
 
+type ServerSentEventsConfig struct {
+	HeartbeatInterval time.Duration `yaml:"heartbeatInterval" json:"heartbeatInterval"`
+}
+
+func (c *ServerSentEventsConfig) Validate() error {
+	if c.HeartbeatInterval <= 0 {
+		return fmt.Errorf("heartbeatInterval('%v')", c.HeartbeatInterval)
+	}
+	return nil
+}
+
 type HTTPConfig struct {
-	Connection    listener.Config  `yaml:",inline" json:",inline"`
-	WebSocket     WebSocketConfig  `yaml:"webSocket" json:"webSocket"`
-	Authorization validator.Config `yaml:"authorization" json:"authorization"`
-	Server        server.Config    `yaml:",inline" json:",inline"`
+	Connection       listener.Config        `yaml:",inline" json:",inline"`
+	WebSocket        WebSocketConfig        `yaml:"webSocket" json:"webSocket"`
+	ServerSentEvents ServerSentEventsConfig `yaml:"serverSentEvents" json:"serverSentEvents"`
+	Authorization    validator.Config       `yaml:"authorization" json:"authorization"`
+	Server           server.Config          `yaml:",inline" json:",inline"`
 }
 
 func (c *HTTPConfig) Validate() error {
 	if err := c.WebSocket.Validate(); err != nil {
 		return fmt.Errorf("webSocket.%w", err)
 	}
+	if err := c.ServerSentEvents.Validate(); err != nil {
+		return fmt.Errorf("serverSentEvents.%w", err)
+	}
 	if err := c.Authorization.Validate(); err != nil {
 		return fmt.Errorf("authorization.%w", err)
 	}
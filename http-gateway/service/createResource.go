@@ -22,7 +22,7 @@ func (requestHandler *RequestHandler) createResource(w http.ResponseWriter, r *h
 		return
 	}
 
-	newBody, err := createContentBody(r.Body)
+	newBody, err := createContentBody(contentType, r.Body)
 	if err != nil {
 		serverMux.WriteError(w, pkgGrpc.ForwardErrorf(codes.InvalidArgument, "cannot create resource('%v%v'): %v", deviceID, href, err))
 		return
@@ -26,7 +26,7 @@ func (requestHandler *RequestHandler) getDeviceResources(w http.ResponseWriter,
 		return
 	}
 	for key, values := range r.URL.Query() {
-		if key == uri.TypeFilterQueryKey {
+		if key == uri.TypeFilterQueryKey || key == uri.PageSizeQueryKey || key == uri.PageTokenQueryKey {
 			for _, v := range values {
 				q.Add(key, v)
 			}
@@ -33,8 +33,13 @@ func (requestHandler *RequestHandler) getEvents(w http.ResponseWriter, r *http.R
 		DeviceIDFilter   []string `url:"deviceIdFilter,omitempty"`
 		ResourceIDFilter []string `url:"httpResourceIdFilter,omitempty"`
 		TimestampFilter  int64    `url:"timestampFilter,omitempty"`
+		PageSize         string   `url:"pageSize,omitempty"`
+		PageToken        string   `url:"pageToken,omitempty"`
+	}
+	opt := Options{
+		PageSize:  r.URL.Query().Get(uri.PageSizeQueryKey),
+		PageToken: r.URL.Query().Get(uri.PageTokenQueryKey),
 	}
-	opt := Options{}
 	if resourceID != "" {
 		opt.ResourceIDFilter = append(opt.ResourceIDFilter, resourceID)
 	} else if deviceID != "" {
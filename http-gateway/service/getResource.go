@@ -11,11 +11,13 @@ import (
 	"github.com/google/go-querystring/query"
 	"github.com/gorilla/mux"
 	"github.com/plgd-dev/device/v2/pkg/codec/json"
+	"github.com/plgd-dev/go-coap/v3/message"
 	"github.com/plgd-dev/hub/v2/grpc-gateway/pb"
 	"github.com/plgd-dev/hub/v2/http-gateway/serverMux"
 	"github.com/plgd-dev/hub/v2/http-gateway/uri"
 	pkgGrpc "github.com/plgd-dev/hub/v2/pkg/net/grpc"
 	pkgHttp "github.com/plgd-dev/hub/v2/pkg/net/http"
+	"github.com/plgd-dev/hub/v2/pkg/senml"
 	"github.com/plgd-dev/hub/v2/resource-aggregate/commands"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/encoding/protojson"
@@ -168,6 +170,46 @@ func (requestHandler *RequestHandler) filterOnlyContent(rec *httptest.ResponseRe
 	return true
 }
 
+// senMLEncoders encodes the content to the SenML media types accepted by the client.
+var senMLEncoders = map[string]func(v interface{}) ([]byte, error){
+	message.AppSenmlJSON.String(): senml.EncodeJSON,
+	message.AppSenmlCbor.String(): senml.EncodeCBOR,
+}
+
+// toSenMLContent converts the content filtered by filterOnlyContent to the SenML media type. It returns
+// false when the media type is not SenML or the content cannot be converted.
+func (requestHandler *RequestHandler) toSenMLContent(rec *httptest.ResponseRecorder, mediaType string) bool {
+	encode, ok := senMLEncoders[mediaType]
+	if !ok || rec.Code != http.StatusOK || rec.Body.Len() == 0 {
+		return false
+	}
+	var v interface{}
+	err := json.Decode(rec.Body.Bytes(), &v)
+	if err != nil {
+		requestHandler.logger.Debugf("senml content: cannot decode content: %v", err)
+		return false
+	}
+	body, err := encode(v)
+	if err != nil {
+		requestHandler.logger.Debugf("senml content: cannot encode content: %v", err)
+		return false
+	}
+	rec.Body.Reset()
+	_, _ = rec.Body.Write(body)
+	rec.Header().Set(pkgHttp.ContentTypeHeaderKey, mediaType)
+	rec.Header().Del(pkgHttp.ContentLengthHeaderKey)
+	return true
+}
+
+// writeRecordedResponse copies the recorded response without changes, e.g. the binary SenML content.
+func writeRecordedResponse(w http.ResponseWriter, rec *httptest.ResponseRecorder) {
+	for k, v := range rec.Header() {
+		w.Header()[k] = v
+	}
+	w.WriteHeader(rec.Code)
+	_, _ = w.Write(rec.Body.Bytes())
+}
+
 func (requestHandler *RequestHandler) getResource(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	deviceID := vars[uri.DeviceIDKey]
@@ -187,6 +229,10 @@ func (requestHandler *RequestHandler) getResource(w http.ResponseWriter, r *http
 			filterPath = []string{"data", "content"}
 		}
 		allowEmptyContent = requestHandler.filterOnlyContent(rec, filterPath...)
+		if !allowEmptyContent && requestHandler.toSenMLContent(rec, getAccept(r)) {
+			writeRecordedResponse(w, rec)
+			return
+		}
 	}
 	toSimpleResponse(w, rec, allowEmptyContent, func(w http.ResponseWriter, err error) {
 		serverMux.WriteError(w, pkgGrpc.ForwardErrorf(codes.InvalidArgument, "cannot get resource('%v/%v') from the device: %v", deviceID, resourceHref, err))
@@ -0,0 +1,64 @@
+package service
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/plgd-dev/go-coap/v3/message"
+	"github.com/plgd-dev/hub/v2/pkg/log"
+	pkgHttp "github.com/plgd-dev/hub/v2/pkg/net/http"
+	"github.com/plgd-dev/hub/v2/pkg/senml"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToSenMLContent(t *testing.T) {
+	requestHandler := &RequestHandler{logger: log.NewLogger(log.MakeDefaultConfig())}
+	newRecorder := func() *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		_, _ = rec.WriteString(`{"power":5,"state":true}`)
+		return rec
+	}
+	want := map[string]interface{}{
+		"power": int64(5),
+		"state": true,
+	}
+	tests := []struct {
+		name      string
+		mediaType string
+		decode    func(data []byte, v interface{}) error
+	}{
+		{
+			name:      "json",
+			mediaType: message.AppSenmlJSON.String(),
+			decode:    senml.DecodeJSON,
+		},
+		{
+			name:      "cbor",
+			mediaType: message.AppSenmlCbor.String(),
+			decode:    senml.DecodeCBOR,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := newRecorder()
+			require.True(t, requestHandler.toSenMLContent(rec, tt.mediaType))
+			require.Equal(t, tt.mediaType, rec.Header().Get(pkgHttp.ContentTypeHeaderKey))
+			var got interface{}
+			err := tt.decode(rec.Body.Bytes(), &got)
+			require.NoError(t, err)
+			require.Equal(t, want, got)
+
+			w := httptest.NewRecorder()
+			writeRecordedResponse(w, rec)
+			require.Equal(t, http.StatusOK, w.Code)
+			require.Equal(t, tt.mediaType, w.Header().Get(pkgHttp.ContentTypeHeaderKey))
+			require.Equal(t, rec.Body.Bytes(), w.Body.Bytes())
+		})
+	}
+
+	// other media types are not converted
+	rec := newRecorder()
+	require.False(t, requestHandler.toSenMLContent(rec, message.AppJSON.String()))
+	require.JSONEq(t, `{"power":5,"state":true}`, rec.Body.String())
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"io"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -28,6 +27,7 @@ import (
 	"github.com/plgd-dev/hub/v2/pkg/security/openid"
 	"github.com/plgd-dev/hub/v2/resource-aggregate/events"
 	wotTD "github.com/web-of-things-open-source/thingdescription-go/thingDescription"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 )
 
@@ -50,24 +50,20 @@ const (
 )
 
 func (requestHandler *RequestHandler) getResourceLinks(ctx context.Context, deviceFilter []string, typeFilter []string) ([]*events.ResourceLinksPublished, error) {
-	client, err := requestHandler.client.GrpcGatewayClient().GetResourceLinks(ctx, &pb.GetResourceLinksRequest{
-		DeviceIdFilter: deviceFilter,
-		TypeFilter:     typeFilter,
+	links := make([]*events.ResourceLinksPublished, 0, 16)
+	err := pb.ForEachPage(func(pageToken string) (grpc.ServerStreamingClient[events.ResourceLinksPublished], error) {
+		return requestHandler.client.GrpcGatewayClient().GetResourceLinks(ctx, &pb.GetResourceLinksRequest{
+			DeviceIdFilter: deviceFilter,
+			TypeFilter:     typeFilter,
+			PageToken:      pageToken,
+		})
+	}, func(link *events.ResourceLinksPublished) error {
+		links = append(links, link)
+		return nil
 	})
 	if err != nil {
 		return nil, fmt.Errorf("cannot get resource links: %w", err)
 	}
-	links := make([]*events.ResourceLinksPublished, 0, 16)
-	for {
-		link, err := client.Recv()
-		if errors.Is(err, io.EOF) {
-			break
-		}
-		if err != nil {
-			return nil, fmt.Errorf("cannot receive resource link: %w", err)
-		}
-		links = append(links, link)
-	}
 	return links, nil
 }
 
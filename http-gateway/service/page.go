@@ -0,0 +1,20 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/plgd-dev/hub/v2/grpc-gateway/pb"
+)
+
+// NextPageTokenHeaderKey is the HTTP response header with the pageToken of the next page.
+const NextPageTokenHeaderKey = "Next-Page-Token"
+
+// outgoingHeaderMatcher sets the next page token to the NextPageTokenHeaderKey header, the other metadata
+// are forwarded as by default.
+func outgoingHeaderMatcher(key string) (string, bool) {
+	if key == pb.NextPageTokenKey {
+		return NextPageTokenHeaderKey, true
+	}
+	return fmt.Sprintf("%s%s", runtime.MetadataHeaderPrefix, key), true
+}
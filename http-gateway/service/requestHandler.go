@@ -11,6 +11,7 @@ import (
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/plgd-dev/hub/v2/grpc-gateway/client"
 	"github.com/plgd-dev/hub/v2/grpc-gateway/pb"
+	"github.com/plgd-dev/hub/v2/http-gateway/graphql"
 	"github.com/plgd-dev/hub/v2/http-gateway/grpc-websocket-proxy/wsproxy"
 	"github.com/plgd-dev/hub/v2/http-gateway/serverMux"
 	"github.com/plgd-dev/hub/v2/http-gateway/uri"
@@ -179,6 +180,7 @@ func NewRequestHandler(config *Config, r *mux.Router, client *client.Client, ope
 		mux: serverMux.New(
 			runtime.WithMarshalerOption(ApplicationSubscribeToEventsMIMEWildcard, newSubscribeToEventsMarshaler(serverMux.NewJsonMarshaler())),
 			runtime.WithMarshalerOption(ApplicationSubscribeToEventsProtoJsonContentType, serverMux.NewJsonpbMarshaler()),
+			runtime.WithOutgoingHeaderMatcher(outgoingHeaderMatcher),
 		),
 	}
 	// Aliases
@@ -193,6 +195,12 @@ func NewRequestHandler(config *Config, r *mux.Router, client *client.Client, ope
 	r.HandleFunc(uri.Configuration, requestHandler.getHubConfiguration).Methods(http.MethodGet)
 	r.HandleFunc(uri.HubConfiguration, requestHandler.getHubConfiguration).Methods(http.MethodGet)
 	r.HandleFunc(uri.Things, requestHandler.getThings).Methods(http.MethodGet)
+	r.HandleFunc(uri.SubscribeToEventsSSE, requestHandler.subscribeToEventsSSE).Methods(http.MethodGet)
+	graphqlHandler, err := graphql.New(client.GrpcGatewayClient(), logger)
+	if err != nil {
+		return nil, err
+	}
+	r.Handle(uri.GraphQL, graphqlHandler).Methods(http.MethodPost)
 
 	r.PathPrefix(uri.Devices).Methods(http.MethodPost).MatcherFunc(resourceLinksMatcher).HandlerFunc(requestHandler.createResource)
 	r.PathPrefix(uri.Devices).Methods(http.MethodGet).MatcherFunc(resourcePendingCommandsMatcher).HandlerFunc(requestHandler.getResourcePendingCommands)
@@ -0,0 +1,195 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/plgd-dev/hub/v2/grpc-gateway/pb"
+	"github.com/plgd-dev/hub/v2/http-gateway/serverMux"
+	"github.com/plgd-dev/hub/v2/http-gateway/uri"
+	pkgHttp "github.com/plgd-dev/hub/v2/pkg/net/http"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func parseEventFilter(values []string) ([]pb.SubscribeToEvents_CreateSubscription_Event, error) {
+	eventFilter := make([]pb.SubscribeToEvents_CreateSubscription_Event, 0, len(values))
+	for _, v := range values {
+		e, ok := pb.SubscribeToEvents_CreateSubscription_Event_value[v]
+		if !ok {
+			return nil, fmt.Errorf("invalid %v('%v')", uri.EventFilterQueryKey, v)
+		}
+		eventFilter = append(eventFilter, pb.SubscribeToEvents_CreateSubscription_Event(e))
+	}
+	return eventFilter, nil
+}
+
+// newSSECreateSubscription creates the subscription from the query parameters. The Last-Event-ID header,
+// which is set by the client when it reconnects, takes precedence over the resumeFrom query parameter.
+func newSSECreateSubscription(r *http.Request) (*pb.SubscribeToEvents_CreateSubscription, error) {
+	q := r.URL.Query()
+	eventFilter, err := parseEventFilter(q[uri.EventFilterQueryKey])
+	if err != nil {
+		return nil, err
+	}
+	resumeFrom := q.Get(uri.ResumeFromQueryKey)
+	if lastEventID := r.Header.Get(pkgHttp.LastEventIDHeaderKey); lastEventID != "" {
+		resumeFrom = lastEventID
+	}
+	return &pb.SubscribeToEvents_CreateSubscription{
+		EventFilter:            eventFilter,
+		DeviceIdFilter:         q[uri.DeviceIdFilterQueryKey],
+		HttpResourceIdFilter:   q[uri.HttpResourceIdFilterQueryKey],
+		HrefFilter:             q[uri.HrefFilterQueryKey],
+		LeadResourceTypeFilter: q[uri.LeadResourceTypeFilterQueryKey],
+		LabelSelector:          q.Get(uri.LabelSelectorQueryKey),
+		JqExpressionFilter:     q.Get(uri.JqExpressionFilterQueryKey),
+		ResumeFrom:             resumeFrom,
+	}, nil
+}
+
+type sseWriter struct {
+	w         http.ResponseWriter
+	rc        *http.ResponseController
+	marshaler runtime.Marshaler
+}
+
+func (s *sseWriter) write(data []byte) error {
+	if _, err := s.w.Write(data); err != nil {
+		return err
+	}
+	return s.rc.Flush()
+}
+
+// writeEvent writes the event as the SSE message. The resume token of the event is used as the id of the message,
+// so the client sends it back in the Last-Event-ID header when it reconnects.
+func (s *sseWriter) writeEvent(eventType string, e *pb.Event) error {
+	data, err := s.marshaler.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("cannot marshal event: %w", err)
+	}
+	var buf bytes.Buffer
+	if eventType != "" {
+		buf.WriteString("event: " + eventType + "\n")
+	}
+	if e.GetResumeToken() != "" {
+		buf.WriteString("id: " + e.GetResumeToken() + "\n")
+	}
+	for _, line := range bytes.Split(data, []byte("\n")) {
+		buf.WriteString("data: ")
+		buf.Write(line)
+		buf.WriteString("\n")
+	}
+	buf.WriteString("\n")
+	return s.write(buf.Bytes())
+}
+
+func (s *sseWriter) writeHeartbeat() error {
+	return s.write([]byte(": heartbeat\n\n"))
+}
+
+type recvEvent struct {
+	event *pb.Event
+	err   error
+}
+
+func recvEvents(ctx context.Context, stream pb.GrpcGateway_SubscribeToEventsClient) <-chan recvEvent {
+	events := make(chan recvEvent)
+	go func() {
+		defer close(events)
+		for {
+			ev, err := stream.Recv()
+			select {
+			case events <- recvEvent{event: ev, err: err}:
+			case <-ctx.Done():
+				return
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+	return events
+}
+
+func (requestHandler *RequestHandler) subscribeToEventsSSE(w http.ResponseWriter, r *http.Request) {
+	createSubscription, err := newSSECreateSubscription(r)
+	if err != nil {
+		serverMux.WriteError(w, status.Errorf(codes.InvalidArgument, "cannot subscribe to events: %v", err))
+		return
+	}
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+	stream, err := requestHandler.client.GrpcGatewayClient().SubscribeToEvents(ctx)
+	if err != nil {
+		serverMux.WriteError(w, fmt.Errorf("cannot subscribe to events: %w", err))
+		return
+	}
+	err = stream.Send(&pb.SubscribeToEvents{
+		Action: &pb.SubscribeToEvents_CreateSubscription_{
+			CreateSubscription: createSubscription,
+		},
+	})
+	if err != nil {
+		serverMux.WriteError(w, fmt.Errorf("cannot subscribe to events: %w", err))
+		return
+	}
+
+	rc := http.NewResponseController(w)
+	// the stream lives until the client disconnects, so the write timeout of the server cannot be applied
+	if err = rc.SetWriteDeadline(time.Time{}); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		requestHandler.logger.Errorf("cannot reset write deadline of events stream: %w", err)
+	}
+	w.Header().Set(pkgHttp.ContentTypeHeaderKey, pkgHttp.TextEventStreamContentType)
+	w.Header().Set(pkgHttp.CacheControlHeaderKey, "no-cache")
+	w.WriteHeader(http.StatusOK)
+	sw := sseWriter{
+		w:         w,
+		rc:        rc,
+		marshaler: serverMux.NewJsonMarshaler(),
+	}
+	if err = rc.Flush(); err != nil {
+		requestHandler.logger.Errorf("cannot flush events stream: %w", err)
+		return
+	}
+
+	heartbeat := time.NewTicker(requestHandler.config.APIs.HTTP.ServerSentEvents.HeartbeatInterval)
+	defer heartbeat.Stop()
+	events := recvEvents(ctx, stream)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-heartbeat.C:
+			err = sw.writeHeartbeat()
+		case ev, ok := <-events:
+			if !ok {
+				return
+			}
+			if ev.err != nil {
+				if status.Code(ev.err) != codes.Canceled {
+					requestHandler.logger.Debugf("events stream was closed: %v", ev.err)
+				}
+				return
+			}
+			if op := ev.event.GetOperationProcessed(); op != nil {
+				if op.GetErrorStatus().GetCode() == pb.Event_OperationProcessed_ErrorStatus_OK {
+					continue
+				}
+				// the subscription cannot be created, the client is informed and the stream is closed
+				_ = sw.writeEvent("error", ev.event)
+				return
+			}
+			err = sw.writeEvent("", ev.event)
+		}
+		if err != nil {
+			requestHandler.logger.Debugf("cannot write to events stream: %v", err)
+			return
+		}
+	}
+}
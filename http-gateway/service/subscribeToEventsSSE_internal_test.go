@@ -0,0 +1,60 @@
+package service
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/plgd-dev/hub/v2/grpc-gateway/pb"
+	"github.com/plgd-dev/hub/v2/http-gateway/serverMux"
+	"github.com/plgd-dev/hub/v2/http-gateway/uri"
+	pkgHttp "github.com/plgd-dev/hub/v2/pkg/net/http"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSSECreateSubscription(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, uri.SubscribeToEventsSSE+"?eventFilter=RESOURCE_CHANGED&eventFilter=DEVICE_METADATA_UPDATED&deviceIdFilter=d1&hrefFilter=/light&resumeFrom=a", nil)
+	got, err := newSSECreateSubscription(r)
+	require.NoError(t, err)
+	require.Equal(t, []pb.SubscribeToEvents_CreateSubscription_Event{
+		pb.SubscribeToEvents_CreateSubscription_RESOURCE_CHANGED,
+		pb.SubscribeToEvents_CreateSubscription_DEVICE_METADATA_UPDATED,
+	}, got.GetEventFilter())
+	require.Equal(t, []string{"d1"}, got.GetDeviceIdFilter())
+	require.Equal(t, []string{"/light"}, got.GetHrefFilter())
+	require.Equal(t, "a", got.GetResumeFrom())
+
+	// Last-Event-ID is sent by the client when it reconnects
+	r.Header.Set(pkgHttp.LastEventIDHeaderKey, "b")
+	got, err = newSSECreateSubscription(r)
+	require.NoError(t, err)
+	require.Equal(t, "b", got.GetResumeFrom())
+
+	r = httptest.NewRequest(http.MethodGet, uri.SubscribeToEventsSSE+"?eventFilter=INVALID", nil)
+	_, err = newSSECreateSubscription(r)
+	require.Error(t, err)
+}
+
+func TestSSEWriter(t *testing.T) {
+	w := httptest.NewRecorder()
+	sw := sseWriter{
+		w:         w,
+		rc:        http.NewResponseController(w),
+		marshaler: serverMux.NewJsonMarshaler(),
+	}
+	err := sw.writeEvent("", &pb.Event{SubscriptionId: "s1", ResumeToken: "t1"})
+	require.NoError(t, err)
+	err = sw.writeHeartbeat()
+	require.NoError(t, err)
+	err = sw.writeEvent("error", &pb.Event{SubscriptionId: "s1"})
+	require.NoError(t, err)
+
+	messages := strings.Split(w.Body.String(), "\n\n")
+	require.Len(t, messages, 4)
+	require.True(t, strings.HasPrefix(messages[0], "id: t1\ndata: {"))
+	require.Contains(t, messages[0], `"subscriptionId":"s1"`)
+	require.Equal(t, ": heartbeat", messages[1])
+	require.True(t, strings.HasPrefix(messages[2], "event: error\ndata: {"))
+	require.Empty(t, messages[3])
+}
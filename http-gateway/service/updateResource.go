@@ -18,15 +18,27 @@ import (
 	"google.golang.org/protobuf/encoding/protojson"
 )
 
-func createContentBody(body io.ReadCloser) (io.ReadCloser, error) {
+// contentMediaType returns the media type of the request body, the body is JSON unless it is SenML.
+func contentMediaType(contentType string) message.MediaType {
+	switch contentType {
+	case message.AppSenmlJSON.String():
+		return message.AppSenmlJSON
+	case message.AppSenmlCbor.String():
+		return message.AppSenmlCbor
+	}
+	return message.AppJSON
+}
+
+func createContentBody(contentType string, body io.ReadCloser) (io.ReadCloser, error) {
 	data, err := io.ReadAll(body)
 	if err != nil {
 		return nil, fmt.Errorf("read body: %w", err)
 	}
 
+	mediaType := contentMediaType(contentType)
 	req := commands.Content{
-		ContentType:       message.AppJSON.String(),
-		CoapContentFormat: int32(message.AppJSON),
+		ContentType:       mediaType.String(),
+		CoapContentFormat: int32(mediaType),
 		Data:              data,
 	}
 	reqData, err := protojson.Marshal(&req)
@@ -48,7 +60,7 @@ func (requestHandler *RequestHandler) updateResource(w http.ResponseWriter, r *h
 		return
 	}
 
-	newBody, err := createContentBody(r.Body)
+	newBody, err := createContentBody(contentType, r.Body)
 	if err != nil {
 		serverMux.WriteError(w, pkgGrpc.ForwardErrorf(codes.InvalidArgument, "cannot update resource('/%v%v'): %v", deviceID, href, err))
 		return
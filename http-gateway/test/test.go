@@ -57,6 +57,7 @@ func MakeConfig(t require.TestingT, enableUI bool) service.Config {
 	cfg.APIs.HTTP.Connection.TLS.ClientCertificateRequired = false
 	cfg.APIs.HTTP.WebSocket.StreamBodyLimit = 256 * 1024
 	cfg.APIs.HTTP.WebSocket.PingFrequency = 10 * time.Second
+	cfg.APIs.HTTP.ServerSentEvents.HeartbeatInterval = 15 * time.Second
 	cfg.APIs.HTTP.Server = config.MakeHttpServerConfig()
 
 	cfg.Clients.GrpcGateway.Connection = config.MakeGrpcClientConfig(config.GRPC_GW_HOST)
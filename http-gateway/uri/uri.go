@@ -24,6 +24,15 @@ const (
 	OnlyContentQueryKey            = "onlyContent"
 	IncludeHiddenResourcesQueryKey = "includeHiddenResources"
 	ForceQueryKey                  = "force"
+	AsOfQueryKey                   = "asOf"
+	PageSizeQueryKey               = "pageSize"
+	PageTokenQueryKey              = "pageToken"
+	LabelSelectorQueryKey          = "labelSelector"
+	EventFilterQueryKey            = "eventFilter"
+	HrefFilterQueryKey             = "hrefFilter"
+	LeadResourceTypeFilterQueryKey = "leadResourceTypeFilter"
+	JqExpressionFilterQueryKey     = "jqExpressionFilter"
+	ResumeFromQueryKey             = "resumeFrom"
 	IssuerIDKey                    = "issuerId"
 
 	AliasInterfaceQueryKey        = "interface"
@@ -39,11 +48,17 @@ const (
 	PendingCommandsPathKey        = "pending-commands"
 	PendingMetadataUpdatesPathKey = "pending-metadata-updates"
 	EventsPathKey                 = "events"
+	HistoryPathKey                = "history"
 	ThingsPathKey                 = "things"
 
 	API               string = "/api/v1"
 	APIWS             string = API + "/ws"
 	SubscribeToEvents string = APIWS + "/" + EventsPathKey
+	APISSE            string = API + "/sse"
+	// (HTTP) GET /api/v1/sse/events?eventFilter={event}&deviceIdFilter={deviceId}&resumeFrom={token} -> rpc SubscribeToEvents as text/event-stream
+	SubscribeToEventsSSE string = APISSE + "/" + EventsPathKey
+	// (HTTP) POST /api/v1/graphql -> GraphQL queries, mutations and subscriptions (Accept: text/event-stream) over the grpc-gateway
+	GraphQL string = API + "/graphql"
 
 	// hub configuration
 	HubConfiguration = "/.well-known/hub-configuration"
@@ -58,6 +73,9 @@ const (
 	AliasDeviceThing = Things + "/{" + DeviceIDKey + "}"
 
 	// (GRPC + HTTP) GET /api/v1/devices -> rpc GetDevices
+	// (GRPC + HTTP) GET /api/v1/devices?asOf={timestamp} -> rpc GetDevices + asOf
+	// (GRPC + HTTP) GET /api/v1/devices?pageSize={size}&pageToken={token} -> rpc GetDevices + pageSize + pageToken
+	// (GRPC + HTTP) GET /api/v1/devices?labelSelector={selector} -> rpc GetDevices + labelSelector
 	// (GRPC + HTTP) DELETE /api/v1/devices -> rpc DeleteDevices
 	Devices = API + "/devices"
 	// (HTTP ALIAS) GET /api/v1/devices/{deviceId} -> rpc GetDevices + deviceIdFilter
@@ -65,10 +83,15 @@ const (
 	AliasDevice = Devices + "/{" + DeviceIDKey + "}"
 
 	// (GRPC + HTTP) GET /api/v1/resource-links -> rpc GetResourceLinks
+	// (GRPC + HTTP) GET /api/v1/resource-links?asOf={timestamp} -> rpc GetResourceLinks + asOf
+	// (GRPC + HTTP) GET /api/v1/resource-links?pageSize={size}&pageToken={token} -> rpc GetResourceLinks + pageSize + pageToken
 	ResourceLinks = API + "/" + ResourceLinksPathKey
 	// (HTTP ALIAS) GET /api/v1/devices/{deviceId}/resource-links
 	AliasDeviceResourceLinks = AliasDevice + "/" + ResourceLinksPathKey
 
+	// (GRPC + HTTP) GET /api/v1/resources?asOf={timestamp} -> rpc GetResources + asOf
+	// (GRPC + HTTP) GET /api/v1/resources?pageSize={size}&pageToken={token} -> rpc GetResources + pageSize + pageToken
+	// (GRPC + HTTP) GET /api/v1/resources?labelSelector={selector} -> rpc GetResources + labelSelector
 	Resources = API + "/" + ResourcesPathKey
 
 	// (GRPC + HTTP) GET /api/v1/devices/devices-metadata
@@ -105,8 +128,13 @@ const (
 	// (HTTP ALIAS) DELETE /api/v1/devices/{deviceId}/resources/{resourceHref}/pending-commands == rpc CancelPendingCommands + deviceIdFilter
 	AliasResourcePendingCommands = AliasDeviceResource + "/" + PendingCommandsPathKey
 
+	// (GRPC + HTTP) GET /api/v1/devices/{deviceId}/history/{resourceHref}?timeFrom={timestamp}&timeTo={timestamp}&limit={limit} -> rpc GetResourceHistory
+	// (GRPC + HTTP) GET /api/v1/devices/{deviceId}/history/{resourceHref}?downsampling.bucketDuration={duration}&downsampling.properties={property} -> rpc GetResourceHistory + downsampling
+	DeviceResourceHistory = AliasDevice + "/" + HistoryPathKey + "/{" + ResourceHrefKey + "}"
+
 	// (GRPC + HTTP) GET /api/v1/events -> rpc GetEvents
 	// (GRPC + HTTP) GET /api/v1/events?timestampFilter={timestamp} -> rpc GetEvents + timestampFilter
+	// (GRPC + HTTP) GET /api/v1/events?pageSize={size}&pageToken={token} -> rpc GetEvents + pageSize + pageToken
 	Events = API + "/" + EventsPathKey
 
 	// (HTTP ALIAS) GET /api/v1/devices/{deviceId}/events == rpc GetEvents + deviceIdFilter
@@ -140,4 +168,13 @@ var QueryCaseInsensitive = map[string]string{
 	strings.ToLower(OnlyContentQueryKey):            OnlyContentQueryKey,
 	strings.ToLower(IncludeHiddenResourcesQueryKey): IncludeHiddenResourcesQueryKey,
 	strings.ToLower(ForceQueryKey):                  ForceQueryKey,
+	strings.ToLower(AsOfQueryKey):                   AsOfQueryKey,
+	strings.ToLower(PageSizeQueryKey):               PageSizeQueryKey,
+	strings.ToLower(PageTokenQueryKey):              PageTokenQueryKey,
+	strings.ToLower(LabelSelectorQueryKey):          LabelSelectorQueryKey,
+	strings.ToLower(EventFilterQueryKey):            EventFilterQueryKey,
+	strings.ToLower(HrefFilterQueryKey):             HrefFilterQueryKey,
+	strings.ToLower(LeadResourceTypeFilterQueryKey): LeadResourceTypeFilterQueryKey,
+	strings.ToLower(JqExpressionFilterQueryKey):     JqExpressionFilterQueryKey,
+	strings.ToLower(ResumeFromQueryKey):             ResumeFromQueryKey,
 }
@@ -9,11 +9,11 @@ import (
 	"sync/atomic"
 	"time"
 
-	nats "github.com/nats-io/nats.go"
 	"github.com/plgd-dev/hub/v2/identity-store/events"
 	pbIS "github.com/plgd-dev/hub/v2/identity-store/pb"
 	kitNetGrpc "github.com/plgd-dev/hub/v2/pkg/net/grpc"
 	"github.com/plgd-dev/hub/v2/pkg/strings"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus"
 	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/utils"
 	kitSync "github.com/plgd-dev/kit/v2/sync"
 	"google.golang.org/grpc/codes"
@@ -22,7 +22,7 @@ import (
 
 type ownerSubject struct {
 	handlers      map[uint64]func(e *events.Event)
-	subscription  *nats.Subscription
+	unsubscribe   func() error
 	devices       strings.SortedSlice
 	validUntil    time.Time
 	devicesSynced bool
@@ -37,9 +37,9 @@ func newOwnerSubject(validUntil time.Time) *ownerSubject {
 	}
 }
 
-func (d *ownerSubject) Handle(msg *nats.Msg) error {
+func (d *ownerSubject) Handle(data []byte) error {
 	var e events.Event
-	if err := utils.Unmarshal(msg.Data, &e); err != nil {
+	if err := utils.Unmarshal(data, &e); err != nil {
 		return err
 	}
 	d.Lock()
@@ -80,20 +80,20 @@ func (d *ownerSubject) updateDevicesLocked(devices []string) ([]string, []string
 	return added, removed
 }
 
-func (d *ownerSubject) subscribeLocked(owner string, subscribe func(subj string, cb nats.MsgHandler) (*nats.Subscription, error), handle func(msg *nats.Msg)) error {
-	if d.subscription == nil {
-		sub, err := subscribe(events.GetRegistrationSubject(owner), handle)
+func (d *ownerSubject) subscribeLocked(owner string, subscriber eventbus.RawSubscriber, handle eventbus.RawHandlerFunc) error {
+	if d.unsubscribe == nil {
+		unsubscribe, err := subscriber.SubscribeRaw(events.GetRegistrationSubject(owner), handle)
 		if err != nil {
 			return err
 		}
-		d.subscription = sub
+		d.unsubscribe = unsubscribe
 	}
 	return nil
 }
 
 func (d *ownerSubject) syncDevicesLocked(ctx context.Context, owner string, cache *OwnerCache) (added []string, removed []string, err error) {
-	err = d.subscribeLocked(owner, cache.conn.Subscribe, func(msg *nats.Msg) {
-		if errH := d.Handle(msg); errH != nil {
+	err = d.subscribeLocked(owner, cache.subscriber, func(_ string, data []byte) {
+		if errH := d.Handle(data); errH != nil {
 			cache.errFunc(errH)
 		}
 	})
@@ -115,7 +115,7 @@ type ErrFunc = func(err error)
 
 type OwnerCache struct {
 	owners     *kitSync.Map
-	conn       *nats.Conn
+	subscriber eventbus.RawSubscriber
 	ownerClaim string
 	errFunc    ErrFunc
 	isClient   pbIS.IdentityStoreClient
@@ -126,10 +126,10 @@ type OwnerCache struct {
 	wg   sync.WaitGroup
 }
 
-func NewOwnerCache(ownerClaim string, expiration time.Duration, conn *nats.Conn, isClient pbIS.IdentityStoreClient, errFunc ErrFunc) *OwnerCache {
+func NewOwnerCache(ownerClaim string, expiration time.Duration, subscriber eventbus.RawSubscriber, isClient pbIS.IdentityStoreClient, errFunc ErrFunc) *OwnerCache {
 	c := &OwnerCache{
 		owners:     kitSync.NewMap(),
-		conn:       conn,
+		subscriber: subscriber,
 		ownerClaim: ownerClaim,
 		errFunc:    errFunc,
 		isClient:   isClient,
@@ -207,7 +207,7 @@ func (c *OwnerCache) executeOnLockedOwnerSubject(owner string, fn func(*ownerSub
 	return fn(s)
 }
 
-// Subscribe register onEvents handler and creates a NATS subscription, if it does not exist.
+// Subscribe register onEvents handler and creates a eventbus subscription, if it does not exist.
 // To free subscription call the returned close function.
 func (c *OwnerCache) Subscribe(owner string, onEvent func(e *events.Event)) (func(), error) {
 	closeFunc := func() {
@@ -221,9 +221,9 @@ func (c *OwnerCache) Subscribe(owner string, onEvent func(e *events.Event)) (fun
 			}
 			closeFunc = c.makeCloseFunc(owner, handlerID)
 		}
-		if s.subscription == nil {
-			errS := s.subscribeLocked(owner, c.conn.Subscribe, func(msg *nats.Msg) {
-				if errH := s.Handle(msg); errH != nil {
+		if s.unsubscribe == nil {
+			errS := s.subscribeLocked(owner, c.subscriber, func(_ string, data []byte) {
+				if errH := s.Handle(data); errH != nil {
 					c.errFunc(errH)
 				}
 			})
@@ -241,7 +241,7 @@ func (c *OwnerCache) Subscribe(owner string, onEvent func(e *events.Event)) (fun
 	return closeFunc, nil
 }
 
-// Update updates devices in cache and subscribe to NATS for updating them.
+// Update updates devices in cache and subscribe to eventbus for updating them.
 func (c *OwnerCache) Update(ctx context.Context) (added []string, removed []string, err error) {
 	owner, err := kitNetGrpc.OwnerFromTokenMD(ctx, c.ownerClaim)
 	if err != nil {
@@ -363,7 +363,7 @@ func (c *OwnerCache) checkExpiration() {
 	expiredOwners := c.getExpiredOwnerSubjects(now)
 
 	for _, o := range expiredOwners {
-		var unsubscribeSubscription *nats.Subscription
+		var unsubscribe func() error
 		c.owners.ReplaceWithFunc(o, func(oldValue interface{}, oldLoaded bool) (newValue interface{}, doDelete bool) {
 			if !oldLoaded {
 				return nil, true
@@ -375,13 +375,13 @@ func (c *OwnerCache) checkExpiration() {
 				return s, false
 			}
 			if s.validUntil.Before(now) {
-				unsubscribeSubscription = s.subscription
+				unsubscribe = s.unsubscribe
 				return nil, true
 			}
 			return s, false
 		})
-		if unsubscribeSubscription != nil {
-			if err := unsubscribeSubscription.Unsubscribe(); err != nil {
+		if unsubscribe != nil {
+			if err := unsubscribe(); err != nil {
 				c.errFunc(fmt.Errorf("cannot unsubscribe owner('%v'): %w", o, err))
 			}
 		}
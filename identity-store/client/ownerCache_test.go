@@ -83,7 +83,7 @@ func TestOwnerCacheSubscribe(t *testing.T) {
 	}()
 
 	cacheExpiration := time.Second
-	cache := idClient.NewOwnerCache("sub", cacheExpiration, subscriber.Conn(), c, func(err error) { fmt.Printf("%v\n", err) })
+	cache := idClient.NewOwnerCache("sub", cacheExpiration, subscriber, c, func(err error) { fmt.Printf("%v\n", err) })
 	defer cache.Close()
 
 	// test 2 subscription to same owner
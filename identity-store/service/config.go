@@ -9,7 +9,7 @@ import (
 	"github.com/plgd-dev/hub/v2/pkg/log"
 	"github.com/plgd-dev/hub/v2/pkg/net/grpc/server"
 	otelClient "github.com/plgd-dev/hub/v2/pkg/opentelemetry/collector/client"
-	natsClient "github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/nats/client"
+	eventbusConfig "github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/config"
 )
 
 // Config provides defaults and enables configuring via env variables.
@@ -67,14 +67,11 @@ func (c *ClientsConfig) Validate() error {
 }
 
 type EventBusConfig struct {
-	NATS natsClient.ConfigPublisher `yaml:"nats" json:"nats"`
+	eventbusConfig.ConfigPublisher `yaml:",inline" json:",inline"`
 }
 
 func (c *EventBusConfig) Validate() error {
-	if err := c.NATS.Validate(); err != nil {
-		return fmt.Errorf("nats.%w", err)
-	}
-	return nil
+	return c.ConfigPublisher.Validate()
 }
 
 type StorageConfig = config.Config
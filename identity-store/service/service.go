@@ -16,9 +16,7 @@ import (
 	otelClient "github.com/plgd-dev/hub/v2/pkg/opentelemetry/collector/client"
 	"github.com/plgd-dev/hub/v2/pkg/security/jwt/validator"
 	"github.com/plgd-dev/hub/v2/pkg/service"
-	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/nats/client"
-	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/nats/publisher"
-	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/utils"
+	eventbusConfig "github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/config"
 	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
 )
@@ -51,7 +49,7 @@ func newPersistence(ctx context.Context, config config.Config, fileWatcher *fsno
 type Service struct {
 	pb.UnimplementedIdentityStoreServer
 	persistence Persistence
-	publisher   *publisher.Publisher
+	publisher   eventbusConfig.Publisher
 	ownerClaim  string
 	hubID       string
 	logger      log.Logger
@@ -64,7 +62,7 @@ type Server struct {
 	cfg        Config
 }
 
-func NewService(persistence Persistence, publisher *publisher.Publisher, ownerClaim, hubID string, logger log.Logger) *Service {
+func NewService(persistence Persistence, publisher eventbusConfig.Publisher, ownerClaim, hubID string, logger log.Logger) *Service {
 	return &Service{
 		persistence: persistence,
 		ownerClaim:  ownerClaim,
@@ -74,7 +72,7 @@ func NewService(persistence Persistence, publisher *publisher.Publisher, ownerCl
 	}
 }
 
-func NewServer(ctx context.Context, cfg Config, fileWatcher *fsnotify.Watcher, logger log.Logger, tracerProvider trace.TracerProvider, publisher *publisher.Publisher, grpcOpts ...grpc.ServerOption) (*Server, error) {
+func NewServer(ctx context.Context, cfg Config, fileWatcher *fsnotify.Watcher, logger log.Logger, tracerProvider trace.TracerProvider, publisher eventbusConfig.Publisher, grpcOpts ...grpc.ServerOption) (*Server, error) {
 	grpcServer, err := server.New(cfg.APIs.GRPC.BaseConfig, fileWatcher, logger, tracerProvider, nil, grpcOpts...)
 	if err != nil {
 		return nil, fmt.Errorf("cannot create grpc listener: %w", err)
@@ -105,40 +103,33 @@ func New(ctx context.Context, cfg Config, fileWatcher *fsnotify.Watcher, logger
 	}
 	tracerProvider := otelClient.GetTracerProvider()
 
-	naClient, err := client.New(cfg.Clients.Eventbus.NATS.Config, fileWatcher, logger, tracerProvider)
+	publisher, err := eventbusConfig.NewPublisher(cfg.Clients.Eventbus.ConfigPublisher, fileWatcher, logger, tracerProvider)
 	if err != nil {
 		otelClient.Close()
-		return nil, fmt.Errorf("cannot create nats client %w", err)
+		return nil, fmt.Errorf("cannot create eventbus publisher %w", err)
 	}
-	publisher, err := publisher.New(naClient.GetConn(), cfg.Clients.Eventbus.NATS.JetStream, publisher.WithMarshaler(utils.Marshal))
-	if err != nil {
-		otelClient.Close()
-		naClient.Close()
-		return nil, fmt.Errorf("cannot create nats publisher %w", err)
-	}
-	naClient.AddCloseFunc(otelClient.Close)
-	naClient.AddCloseFunc(publisher.Close)
+	publisher.AddCloseFunc(otelClient.Close)
 	validator, err := validator.New(ctx, cfg.APIs.GRPC.Authorization.Config, fileWatcher, logger, tracerProvider)
 	if err != nil {
-		naClient.Close()
+		publisher.Close()
 		return nil, fmt.Errorf("cannot create validator: %w", err)
 	}
 	interceptor := server.NewAuth(validator, server.WithDisabledTokenForwarding())
 	opts, err := server.MakeDefaultOptions(interceptor, logger, tracerProvider)
 	if err != nil {
 		validator.Close()
-		naClient.Close()
+		publisher.Close()
 		return nil, fmt.Errorf("cannot create grpc server options: %w", err)
 	}
 
 	s, err := NewServer(ctx, cfg, fileWatcher, logger, tracerProvider, publisher, opts...)
 	if err != nil {
 		validator.Close()
-		naClient.Close()
+		publisher.Close()
 		return nil, fmt.Errorf("cannot create server: %w", err)
 	}
 	s.grpcServer.AddCloseFunc(validator.Close)
-	s.grpcServer.AddCloseFunc(naClient.Close)
+	s.grpcServer.AddCloseFunc(publisher.Close)
 
 	// IdentityStore needs to stop gracefully to ensure that all commands are processed.
 	s.grpcServer.SetGracefulStop(true)
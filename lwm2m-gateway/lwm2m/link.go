@@ -0,0 +1,163 @@
+package lwm2m
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	// ResourceTypePrefix is the prefix of the resource types of the LwM2M objects, eg. oma.lwm2m.3303 for the temperature object.
+	ResourceTypePrefix = "oma.lwm2m."
+	// RootResourceType marks the alternate path of the LwM2M objects in the links of the Register operation.
+	RootResourceType = "oma.lwm2m"
+
+	// SecurityObjectID is the object with the credentials of the client.
+	SecurityObjectID = 0
+	// OSCOREObjectID is the object with the OSCORE keys of the client.
+	OSCOREObjectID = 21
+)
+
+// Link is the object or the object instance of the LwM2M client in the CoRE link format (RFC 6690).
+type Link struct {
+	// Href is the path of the link at the client including the alternate path.
+	Href       string
+	ObjectID   uint16
+	InstanceID int32 // -1 when the link is the object without the instance
+	Attributes map[string]string
+}
+
+// IsInstance returns true when the link is the object instance.
+func (l Link) IsInstance() bool {
+	return l.InstanceID >= 0
+}
+
+// ResourceType returns the resource type of the object.
+func (l Link) ResourceType() string {
+	return ResourceTypePrefix + strconv.FormatUint(uint64(l.ObjectID), 10)
+}
+
+// IsConfidential returns true when the object contains the credentials of the client, which are not shared with the hub.
+func (l Link) IsConfidential() bool {
+	return l.ObjectID == SecurityObjectID || l.ObjectID == OSCOREObjectID
+}
+
+func splitLinks(body string) []string {
+	links := make([]string, 0, 8)
+	var quoted, inURI bool
+	start := 0
+	for i := range len(body) {
+		switch body[i] {
+		case '"':
+			quoted = !quoted
+		case '<':
+			inURI = !quoted
+		case '>':
+			inURI = false
+		case ',':
+			if !quoted && !inURI {
+				links = append(links, body[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(links, body[start:])
+}
+
+func parseLink(v string) (string, map[string]string, error) {
+	v = strings.TrimSpace(v)
+	if !strings.HasPrefix(v, "<") {
+		return "", nil, fmt.Errorf("invalid link('%v'): missing '<'", v)
+	}
+	end := strings.Index(v, ">")
+	if end < 0 {
+		return "", nil, fmt.Errorf("invalid link('%v'): missing '>'", v)
+	}
+	path := v[1:end]
+	attributes := make(map[string]string)
+	for _, attr := range strings.Split(v[end+1:], ";") {
+		attr = strings.TrimSpace(attr)
+		if attr == "" {
+			continue
+		}
+		key, value, _ := strings.Cut(attr, "=")
+		attributes[key] = strings.Trim(value, `"`)
+	}
+	return path, attributes, nil
+}
+
+func parseObjectPath(path string) (uint16, int32, error) {
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	if len(parts) == 0 || len(parts) > 2 {
+		return 0, 0, fmt.Errorf("invalid path('%v')", path)
+	}
+	objectID, err := strconv.ParseUint(parts[0], 10, 16)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid object of path('%v'): %w", path, err)
+	}
+	instanceID := int32(-1)
+	if len(parts) == 2 {
+		v, err := strconv.ParseUint(parts[1], 10, 16)
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid object instance of path('%v'): %w", path, err)
+		}
+		instanceID = int32(v)
+	}
+	return uint16(objectID), instanceID, nil
+}
+
+// ParseLinks parses the objects and the object instances sent by the Register or Update operations.
+func ParseLinks(body []byte) ([]Link, error) {
+	if len(strings.TrimSpace(string(body))) == 0 {
+		return nil, nil
+	}
+	type rawLink struct {
+		path       string
+		attributes map[string]string
+	}
+	rawLinks := make([]rawLink, 0, 8)
+	rootPath := ""
+	for _, v := range splitLinks(string(body)) {
+		path, attributes, err := parseLink(v)
+		if err != nil {
+			return nil, err
+		}
+		if attributes["rt"] == RootResourceType {
+			rootPath = strings.TrimSuffix(path, "/")
+			continue
+		}
+		rawLinks = append(rawLinks, rawLink{path: path, attributes: attributes})
+	}
+	links := make([]Link, 0, len(rawLinks))
+	for _, l := range rawLinks {
+		if !strings.HasPrefix(l.path, rootPath+"/") {
+			return nil, fmt.Errorf("invalid path('%v'): the alternate path('%v') is not used", l.path, rootPath)
+		}
+		objectID, instanceID, err := parseObjectPath(strings.TrimPrefix(l.path, rootPath))
+		if err != nil {
+			return nil, err
+		}
+		links = append(links, Link{
+			Href:       l.path,
+			ObjectID:   objectID,
+			InstanceID: instanceID,
+			Attributes: l.attributes,
+		})
+	}
+	if len(links) == 0 {
+		return nil, errors.New("objects are not set")
+	}
+	return links, nil
+}
+
+// Instances returns the object instances which are shared with the hub.
+func (r *Registration) Instances() []Link {
+	instances := make([]Link, 0, len(r.Links))
+	for _, l := range r.Links {
+		if l.IsInstance() && !l.IsConfidential() {
+			instances = append(instances, l)
+		}
+	}
+	return instances
+}
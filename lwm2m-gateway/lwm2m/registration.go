@@ -0,0 +1,142 @@
+package lwm2m
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Query parameters of the Register and Update operations of the LwM2M client.
+const (
+	EndpointQueryKey = "ep"
+	LifetimeQueryKey = "lt"
+	VersionQueryKey  = "lwm2m"
+	BindingQueryKey  = "b"
+	QueueQueryKey    = "Q"
+)
+
+// DefaultLifetime is the lifetime of the registration when the client doesn't set it.
+const DefaultLifetime = 86400 * time.Second
+
+// Registration of the LwM2M client created by the Register operation and updated by the Update operation.
+type Registration struct {
+	Endpoint string
+	Lifetime time.Duration
+	Version  string
+	Binding  string
+	Queue    bool
+	Links    []Link
+}
+
+// DeviceID returns the ID of the device in the hub. The endpoint name in the form urn:uuid:{uuid} is used directly,
+// other endpoint names are converted to the UUID.
+func DeviceID(endpoint string) string {
+	if u, err := uuid.Parse(endpoint); err == nil && u != uuid.Nil {
+		return u.String()
+	}
+	return uuid.NewSHA1(uuid.NameSpaceURL, []byte(endpoint)).String()
+}
+
+// DeviceID returns the ID of the device in the hub.
+func (r *Registration) DeviceID() string {
+	return DeviceID(r.Endpoint)
+}
+
+// SupportsSenML returns true when the client supports the SenML content formats, which are mandatory since LwM2M 1.1.
+func (r *Registration) SupportsSenML() bool {
+	if r.Version == "" {
+		return false
+	}
+	v, err := strconv.ParseFloat(r.Version, 64)
+	return err == nil && v >= 1.1
+}
+
+func parseQueries(queries []string) (url.Values, error) {
+	values := make(url.Values)
+	for _, q := range queries {
+		v, err := url.ParseQuery(q)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse query('%v'): %w", q, err)
+		}
+		for key, val := range v {
+			values[key] = append(values[key], val...)
+		}
+	}
+	return values, nil
+}
+
+func parseLifetime(lt string) (time.Duration, error) {
+	v, err := strconv.ParseUint(lt, 10, 32)
+	if err != nil || v == 0 {
+		return 0, fmt.Errorf("invalid %v('%v')", LifetimeQueryKey, lt)
+	}
+	return time.Duration(v) * time.Second, nil
+}
+
+func (r *Registration) update(values url.Values) error {
+	if values.Has(LifetimeQueryKey) {
+		lt, err := parseLifetime(values.Get(LifetimeQueryKey))
+		if err != nil {
+			return err
+		}
+		r.Lifetime = lt
+	}
+	if values.Has(BindingQueryKey) {
+		r.Binding = values.Get(BindingQueryKey)
+	}
+	if values.Has(QueueQueryKey) {
+		r.Queue = true
+	}
+	return nil
+}
+
+// ParseRegister parses the queries and the links of the Register operation.
+func ParseRegister(queries []string, body []byte) (*Registration, error) {
+	values, err := parseQueries(queries)
+	if err != nil {
+		return nil, err
+	}
+	r := Registration{
+		Endpoint: values.Get(EndpointQueryKey),
+		Version:  values.Get(VersionQueryKey),
+		Binding:  "U",
+		Lifetime: DefaultLifetime,
+	}
+	if r.Endpoint == "" {
+		return nil, errors.New("endpoint name is not set")
+	}
+	if err = r.update(values); err != nil {
+		return nil, err
+	}
+	r.Links, err = ParseLinks(body)
+	if err != nil {
+		return nil, err
+	}
+	if len(r.Links) == 0 {
+		return nil, errors.New("objects are not set")
+	}
+	return &r, nil
+}
+
+// Update applies the queries and the links of the Update operation. The links are updated only when they are sent.
+func (r *Registration) Update(queries []string, body []byte) (*Registration, error) {
+	values, err := parseQueries(queries)
+	if err != nil {
+		return nil, err
+	}
+	u := *r
+	if err = u.update(values); err != nil {
+		return nil, err
+	}
+	if len(body) > 0 {
+		u.Links, err = ParseLinks(body)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &u, nil
+}
@@ -0,0 +1,141 @@
+package lwm2m_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/plgd-dev/hub/v2/lwm2m-gateway/lwm2m"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRegister(t *testing.T) {
+	type args struct {
+		queries []string
+		body    string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *lwm2m.Registration
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			args: args{
+				queries: []string{"ep=sensor-1", "lt=300", "lwm2m=1.1", "b=U"},
+				body:    `</1/0>,</3/0>;ver=1.1,</3303>,</3303/0>,</3303/1>`,
+			},
+			want: &lwm2m.Registration{
+				Endpoint: "sensor-1",
+				Lifetime: 300 * time.Second,
+				Version:  "1.1",
+				Binding:  "U",
+				Links: []lwm2m.Link{
+					{Href: "/1/0", ObjectID: 1, InstanceID: 0, Attributes: map[string]string{}},
+					{Href: "/3/0", ObjectID: 3, InstanceID: 0, Attributes: map[string]string{"ver": "1.1"}},
+					{Href: "/3303", ObjectID: 3303, InstanceID: -1, Attributes: map[string]string{}},
+					{Href: "/3303/0", ObjectID: 3303, InstanceID: 0, Attributes: map[string]string{}},
+					{Href: "/3303/1", ObjectID: 3303, InstanceID: 1, Attributes: map[string]string{}},
+				},
+			},
+		},
+		{
+			name: "alternate path",
+			args: args{
+				queries: []string{"ep=sensor-2&Q"},
+				body:    `</lwm2m>;rt="oma.lwm2m";ct=110, </lwm2m/3/0>`,
+			},
+			want: &lwm2m.Registration{
+				Endpoint: "sensor-2",
+				Lifetime: lwm2m.DefaultLifetime,
+				Binding:  "U",
+				Queue:    true,
+				Links: []lwm2m.Link{
+					{Href: "/lwm2m/3/0", ObjectID: 3, InstanceID: 0, Attributes: map[string]string{}},
+				},
+			},
+		},
+		{
+			name: "missing endpoint",
+			args: args{
+				queries: []string{"lt=300"},
+				body:    `</3/0>`,
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid lifetime",
+			args: args{
+				queries: []string{"ep=sensor", "lt=0"},
+				body:    `</3/0>`,
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid link",
+			args: args{
+				queries: []string{"ep=sensor"},
+				body:    `</3/a>`,
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing objects",
+			args: args{
+				queries: []string{"ep=sensor"},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := lwm2m.ParseRegister(tt.args.queries, []byte(tt.args.body))
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRegistrationUpdate(t *testing.T) {
+	r, err := lwm2m.ParseRegister([]string{"ep=sensor", "lwm2m=1.0"}, []byte(`</3/0>,</0/0>,</3303/0>`))
+	require.NoError(t, err)
+	require.False(t, r.SupportsSenML())
+	require.Equal(t, []string{"/3/0", "/3303/0"}, hrefs(r.Instances()))
+
+	// the links are kept when they are not sent
+	u, err := r.Update([]string{"lt=60"}, nil)
+	require.NoError(t, err)
+	require.Equal(t, time.Minute, u.Lifetime)
+	require.Equal(t, r.Links, u.Links)
+	require.Equal(t, lwm2m.DefaultLifetime, r.Lifetime)
+
+	u, err = u.Update(nil, []byte(`</3/0>`))
+	require.NoError(t, err)
+	require.Equal(t, []string{"/3/0"}, hrefs(u.Instances()))
+	require.Equal(t, "oma.lwm2m.3", u.Instances()[0].ResourceType())
+
+	_, err = u.Update([]string{"lt=abc"}, nil)
+	require.Error(t, err)
+}
+
+func TestDeviceID(t *testing.T) {
+	id := uuid.NewString()
+	require.Equal(t, id, lwm2m.DeviceID("urn:uuid:"+id))
+	require.Equal(t, lwm2m.DeviceID("sensor"), lwm2m.DeviceID("sensor"))
+	require.NotEqual(t, lwm2m.DeviceID("sensor"), lwm2m.DeviceID("sensor-1"))
+	_, err := uuid.Parse(lwm2m.DeviceID("urn:imei:490154203237518"))
+	require.NoError(t, err)
+}
+
+func hrefs(links []lwm2m.Link) []string {
+	v := make([]string, 0, len(links))
+	for _, l := range links {
+		v = append(v, l.Href)
+	}
+	return v
+}
@@ -0,0 +1,220 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/plgd-dev/device/v2/schema/device"
+	"github.com/plgd-dev/go-coap/v3/message"
+	"github.com/plgd-dev/go-coap/v3/message/codes"
+	"github.com/plgd-dev/go-coap/v3/message/pool"
+	"github.com/plgd-dev/hub/v2/coap-gateway/coapconv"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/commands"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/events"
+)
+
+// isLocalResource returns true for the resources maintained by the gateway.
+func isLocalResource(href string) bool {
+	return href == device.ResourceURI || href == commands.StatusHref
+}
+
+// newLocalResponse creates the response of the resource maintained by the gateway.
+func (c *session) newLocalResponse(ctx context.Context, code codes.Code, contentFormat message.MediaType, body []byte) *pool.Message {
+	msg := c.server.messagePool.AcquireMessage(ctx)
+	msg.SetCode(code)
+	msg.SetSequence(c.coapConn.Sequence())
+	if body != nil {
+		msg.SetContentFormat(contentFormat)
+		msg.SetBody(bytes.NewReader(body))
+	}
+	return msg
+}
+
+func (c *session) newErrorResponse(ctx context.Context, code codes.Code, err error) *pool.Message {
+	return c.newLocalResponse(ctx, code, message.TextPlain, []byte(err.Error()))
+}
+
+// sendRequest sends the request created from the pending command to the client. The response or the error is
+// returned as the message which is used to confirm the command.
+func (c *session) sendRequest(ctx context.Context, correlationID string, newRequest func(ctx context.Context, messagePool *pool.Pool) (*pool.Message, error)) *pool.Message {
+	coapCtx, cancel := context.WithTimeout(ctx, c.server.config.APIs.LwM2M.Timeout)
+	defer cancel()
+	req, err := newRequest(coapCtx, c.server.messagePool)
+	if err != nil {
+		return c.newErrorResponse(ctx, codes.BadRequest, err)
+	}
+	defer c.server.messagePool.ReleaseMessage(req)
+	resp, err := c.do(req, correlationID)
+	if err != nil {
+		return c.newErrorResponse(ctx, codes.ServiceUnavailable, err)
+	}
+	return resp
+}
+
+func (c *session) ctxToConfirm(ctx context.Context) (context.Context, error) {
+	if c.deviceID() == "" {
+		return nil, errors.New("device is not registered")
+	}
+	return c.server.ctxWithToken(ctx)
+}
+
+func (c *session) UpdateResource(ctx context.Context, event *events.ResourceUpdatePending) error {
+	sendConfirmCtx, err := c.ctxToConfirm(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot update resource /%v%v: %w", event.GetResourceId().GetDeviceId(), event.GetResourceId().GetHref(), err)
+	}
+	var resp *pool.Message
+	if isLocalResource(event.GetResourceId().GetHref()) {
+		resp = c.newLocalResponse(ctx, codes.MethodNotAllowed, 0, nil)
+	} else {
+		resp = c.sendRequest(ctx, event.GetAuditContext().GetCorrelationId(), func(ctx context.Context, messagePool *pool.Pool) (*pool.Message, error) {
+			return coapconv.NewCoapResourceUpdateRequest(ctx, messagePool, event)
+		})
+	}
+	defer c.server.messagePool.ReleaseMessage(resp)
+	request := coapconv.NewConfirmResourceUpdateRequest(event.GetResourceId(), event.GetAuditContext().GetCorrelationId(), c.RemoteAddr().String(), resp)
+	_, err = c.server.raClient.ConfirmResourceUpdate(sendConfirmCtx, request)
+	return err
+}
+
+func (c *session) retrieveLocalResource(ctx context.Context, href string) *pool.Message {
+	if href == commands.StatusHref {
+		return c.newLocalResponse(ctx, codes.Content, 0, nil)
+	}
+	_, r := c.getRegistration()
+	if r == nil {
+		return c.newErrorResponse(ctx, codes.NotFound, errors.New("device is not registered"))
+	}
+	data, err := newDeviceResourceContent(r)
+	if err != nil {
+		return c.newErrorResponse(ctx, codes.InternalServerError, err)
+	}
+	return c.newLocalResponse(ctx, codes.Content, message.AppOcfCbor, data)
+}
+
+func (c *session) RetrieveResource(ctx context.Context, event *events.ResourceRetrievePending) error {
+	sendConfirmCtx, err := c.ctxToConfirm(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot retrieve resource /%v%v: %w", event.GetResourceId().GetDeviceId(), event.GetResourceId().GetHref(), err)
+	}
+	var resp *pool.Message
+	if isLocalResource(event.GetResourceId().GetHref()) {
+		resp = c.retrieveLocalResource(ctx, event.GetResourceId().GetHref())
+	} else {
+		resp = c.sendRequest(ctx, event.GetAuditContext().GetCorrelationId(), func(ctx context.Context, messagePool *pool.Pool) (*pool.Message, error) {
+			req, err := coapconv.NewCoapResourceRetrieveRequest(ctx, messagePool, event)
+			if err != nil {
+				return nil, err
+			}
+			if _, r := c.getRegistration(); r != nil && r.SupportsSenML() {
+				req.SetAccept(message.AppSenmlCbor)
+			}
+			return req, nil
+		})
+	}
+	defer c.server.messagePool.ReleaseMessage(resp)
+	request := coapconv.NewConfirmResourceRetrieveRequest(event.GetResourceId(), event.GetAuditContext().GetCorrelationId(), c.RemoteAddr().String(), resp)
+	_, err = c.server.raClient.ConfirmResourceRetrieve(sendConfirmCtx, request)
+	return err
+}
+
+func (c *session) DeleteResource(ctx context.Context, event *events.ResourceDeletePending) error {
+	sendConfirmCtx, err := c.ctxToConfirm(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot delete resource /%v%v: %w", event.GetResourceId().GetDeviceId(), event.GetResourceId().GetHref(), err)
+	}
+	var resp *pool.Message
+	if isLocalResource(event.GetResourceId().GetHref()) {
+		resp = c.newLocalResponse(ctx, codes.Forbidden, 0, nil)
+	} else {
+		resp = c.sendRequest(ctx, event.GetAuditContext().GetCorrelationId(), func(ctx context.Context, messagePool *pool.Pool) (*pool.Message, error) {
+			return coapconv.NewCoapResourceDeleteRequest(ctx, messagePool, event)
+		})
+	}
+	defer c.server.messagePool.ReleaseMessage(resp)
+	request := coapconv.NewConfirmResourceDeleteRequest(event.GetResourceId(), event.GetAuditContext().GetCorrelationId(), c.RemoteAddr().String(), resp)
+	_, err = c.server.raClient.ConfirmResourceDelete(sendConfirmCtx, request)
+	return err
+}
+
+func (c *session) CreateResource(ctx context.Context, event *events.ResourceCreatePending) error {
+	sendConfirmCtx, err := c.ctxToConfirm(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot create resource /%v%v: %w", event.GetResourceId().GetDeviceId(), event.GetResourceId().GetHref(), err)
+	}
+	var resp *pool.Message
+	if isLocalResource(event.GetResourceId().GetHref()) {
+		resp = c.newLocalResponse(ctx, codes.Forbidden, 0, nil)
+	} else {
+		resp = c.sendRequest(ctx, event.GetAuditContext().GetCorrelationId(), func(ctx context.Context, messagePool *pool.Pool) (*pool.Message, error) {
+			return coapconv.NewCoapResourceCreateRequest(ctx, messagePool, event)
+		})
+	}
+	defer c.server.messagePool.ReleaseMessage(resp)
+	request := coapconv.NewConfirmResourceCreateRequest(event.GetResourceId(), event.GetAuditContext().GetCorrelationId(), c.RemoteAddr().String(), resp)
+	_, err = c.server.raClient.ConfirmResourceCreate(sendConfirmCtx, request)
+	return err
+}
+
+func (c *session) confirmDeviceMetadataUpdate(ctx context.Context, event *events.DeviceMetadataUpdatePending) error {
+	r := &commands.ConfirmDeviceMetadataUpdateRequest{
+		DeviceId:        event.GetDeviceId(),
+		CorrelationId:   event.GetAuditContext().GetCorrelationId(),
+		CommandMetadata: c.newCommandMetadata(),
+		Status:          commands.Status_OK,
+	}
+	if event.GetTwinForceSynchronization() {
+		r.Confirm = &commands.ConfirmDeviceMetadataUpdateRequest_TwinForceSynchronization{
+			TwinForceSynchronization: true,
+		}
+	} else {
+		r.Confirm = &commands.ConfirmDeviceMetadataUpdateRequest_TwinEnabled{
+			TwinEnabled: event.GetTwinEnabled(),
+		}
+	}
+	_, err := c.server.raClient.ConfirmDeviceMetadataUpdate(ctx, r)
+	return err
+}
+
+func (c *session) UpdateDeviceMetadata(ctx context.Context, event *events.DeviceMetadataUpdatePending) error {
+	switch event.GetUpdatePending().(type) {
+	case *events.DeviceMetadataUpdatePending_TwinEnabled:
+	case *events.DeviceMetadataUpdatePending_TwinForceSynchronization:
+	default:
+		return nil
+	}
+	sendConfirmCtx, err := c.ctxToConfirm(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot update device('%v') metadata: %w", event.GetDeviceId(), err)
+	}
+	if err = c.confirmDeviceMetadataUpdate(sendConfirmCtx, event); err != nil {
+		return fmt.Errorf("cannot update device('%v') metadata: %w", event.GetDeviceId(), err)
+	}
+	_, r := c.getRegistration()
+	if r == nil {
+		return nil
+	}
+	if event.GetTwinForceSynchronization() {
+		// the observations are created again to get the current content of the object instances
+		c.cancelObservations()
+	} else {
+		c.setTwinEnabled(event.GetTwinEnabled())
+	}
+	// the observations are created in the connection context, because they live until the twin is disabled
+	err = c.server.taskQueue.Submit(func() {
+		if errS := c.synchronizeDeviceTwin(c.Context(), r); errS != nil {
+			c.Errorf("cannot synchronize device twin: %w", errS)
+		}
+	})
+	if err != nil {
+		return fmt.Errorf("cannot update device('%v') metadata: %w", event.GetDeviceId(), err)
+	}
+	return nil
+}
+
+func (c *session) OnDeviceSubscriberReconnectError(err error) {
+	c.Errorf("cannot reconnect device %v subscriber to resource directory or eventbus - closing the device connection: %w", c.deviceID(), err)
+	c.Close()
+}
@@ -0,0 +1,176 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/plgd-dev/device/v2/schema/device"
+	"github.com/plgd-dev/go-coap/v3/message"
+	"github.com/plgd-dev/go-coap/v3/message/codes"
+	"github.com/plgd-dev/go-coap/v3/message/pool"
+	"github.com/plgd-dev/hub/v2/lwm2m-gateway/lwm2m"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/commands"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/events"
+	"github.com/plgd-dev/kit/v2/codec/cbor"
+	"github.com/stretchr/testify/require"
+)
+
+type sentRequest struct {
+	code   codes.Code
+	path   string
+	accept message.MediaType
+	body   string
+}
+
+// clientHandler answers the requests sent to the LwM2M client by the code and the body.
+type clientHandler struct {
+	lock     sync.Mutex
+	requests []sentRequest
+	code     codes.Code
+	body     string
+	err      error
+}
+
+func (h *clientHandler) do(req *pool.Message) (*pool.Message, error) {
+	path, _ := req.Options().Path()
+	accept, _ := req.Options().Accept()
+	var body string
+	if data, err := req.ReadBody(); err == nil {
+		body = string(data)
+	}
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	h.requests = append(h.requests, sentRequest{code: req.Code(), path: path, accept: accept, body: body})
+	if h.err != nil {
+		return nil, h.err
+	}
+	resp := pool.NewMessage(req.Context())
+	resp.SetCode(h.code)
+	if h.body != "" {
+		resp.SetContentFormat(message.TextPlain)
+		resp.SetBody(bytes.NewReader([]byte(h.body)))
+	}
+	return resp, nil
+}
+
+func (h *clientHandler) getRequests() []sentRequest {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	return append([]sentRequest(nil), h.requests...)
+}
+
+func newRegisteredSession(t *testing.T, s *Service, h *clientHandler) *session {
+	c, coapConn := newTestSession(t, s, nil)
+	coapConn.do = h.do
+	r, err := lwm2m.ParseRegister([]string{"ep=sensor", "lwm2m=1.1"}, []byte(`</3303/1>`))
+	require.NoError(t, err)
+	c.setRegistration("registrationID", r)
+	return c
+}
+
+func newResourceUpdatePending(deviceID, href string) *events.ResourceUpdatePending {
+	return &events.ResourceUpdatePending{
+		ResourceId: commands.NewResourceID(deviceID, href),
+		Content: &commands.Content{
+			Data:              []byte("21"),
+			ContentType:       message.TextPlain.String(),
+			CoapContentFormat: int32(message.TextPlain),
+		},
+		AuditContext: commands.NewAuditContext("owner", "correlationID", "owner"),
+	}
+}
+
+func getConfirmResourceUpdate(t *testing.T, conn *fakeGrpcConn) *commands.ConfirmResourceUpdateRequest {
+	requests := conn.getRequests("ConfirmResourceUpdate")
+	require.Len(t, requests, 1)
+	conn.reset()
+	return requests[0].(*commands.ConfirmResourceUpdateRequest)
+}
+
+func TestUpdateResourceConfirmation(t *testing.T) {
+	s, conn := newTestService(t)
+	h := &clientHandler{code: codes.Changed}
+	c := newRegisteredSession(t, s, h)
+	deviceID := c.deviceID()
+
+	err := c.UpdateResource(context.Background(), newResourceUpdatePending(deviceID, "/3303/1"))
+	require.NoError(t, err)
+	require.Equal(t, []sentRequest{{code: codes.POST, path: "/3303/1", body: "21"}}, h.getRequests())
+	confirm := getConfirmResourceUpdate(t, conn)
+	require.Equal(t, commands.NewResourceID(deviceID, "/3303/1").ToString(), confirm.GetResourceId().ToString())
+	require.Equal(t, "correlationID", confirm.GetCorrelationId())
+	require.Equal(t, commands.Status_OK, confirm.GetStatus())
+
+	// the resource maintained by the gateway cannot be updated
+	err = c.UpdateResource(context.Background(), newResourceUpdatePending(deviceID, device.ResourceURI))
+	require.NoError(t, err)
+	require.Len(t, h.getRequests(), 1)
+	require.Equal(t, commands.Status_METHOD_NOT_ALLOWED, getConfirmResourceUpdate(t, conn).GetStatus())
+
+	// the client doesn't respond
+	h.err = errors.New("timeout")
+	err = c.UpdateResource(context.Background(), newResourceUpdatePending(deviceID, "/3303/1"))
+	require.NoError(t, err)
+	require.Equal(t, commands.Status_UNAVAILABLE, getConfirmResourceUpdate(t, conn).GetStatus())
+}
+
+func TestRetrieveResourceConfirmation(t *testing.T) {
+	s, conn := newTestService(t)
+	h := &clientHandler{code: codes.Content, body: "21.5"}
+	c := newRegisteredSession(t, s, h)
+	deviceID := c.deviceID()
+
+	newResourceRetrievePending := func(href string) *events.ResourceRetrievePending {
+		return &events.ResourceRetrievePending{
+			ResourceId:   commands.NewResourceID(deviceID, href),
+			AuditContext: commands.NewAuditContext("owner", "correlationID", "owner"),
+		}
+	}
+	getConfirm := func() *commands.ConfirmResourceRetrieveRequest {
+		requests := conn.getRequests("ConfirmResourceRetrieve")
+		require.Len(t, requests, 1)
+		conn.reset()
+		return requests[0].(*commands.ConfirmResourceRetrieveRequest)
+	}
+
+	err := c.RetrieveResource(context.Background(), newResourceRetrievePending("/3303/1"))
+	require.NoError(t, err)
+	// the client of LwM2M 1.1 supports SenML
+	require.Equal(t, []sentRequest{{code: codes.GET, path: "/3303/1", accept: message.AppSenmlCbor}}, h.getRequests())
+	confirm := getConfirm()
+	require.Equal(t, "correlationID", confirm.GetCorrelationId())
+	require.Equal(t, commands.Status_OK, confirm.GetStatus())
+	require.Equal(t, message.TextPlain.String(), confirm.GetContent().GetContentType())
+	require.Equal(t, []byte("21.5"), confirm.GetContent().GetData())
+
+	// the content of /oic/d is created by the gateway
+	err = c.RetrieveResource(context.Background(), newResourceRetrievePending(device.ResourceURI))
+	require.NoError(t, err)
+	require.Len(t, h.getRequests(), 1)
+	confirm = getConfirm()
+	require.Equal(t, commands.Status_OK, confirm.GetStatus())
+	require.Equal(t, message.AppOcfCbor.String(), confirm.GetContent().GetContentType())
+	var d device.Device
+	err = cbor.Decode(confirm.GetContent().GetData(), &d)
+	require.NoError(t, err)
+	require.Equal(t, deviceID, d.ID)
+	require.Equal(t, "sensor", d.Name)
+}
+
+func TestCommandsOfUnregisteredDevice(t *testing.T) {
+	s, conn := newTestService(t)
+	c, _ := newTestSession(t, s, nil)
+	deviceID := lwm2m.DeviceID("sensor")
+
+	err := c.UpdateResource(context.Background(), newResourceUpdatePending(deviceID, "/3303/1"))
+	require.Error(t, err)
+	err = c.RetrieveResource(context.Background(), &events.ResourceRetrievePending{
+		ResourceId: commands.NewResourceID(deviceID, "/3303/1"),
+	})
+	require.Error(t, err)
+	require.Empty(t, conn.getRequests("ConfirmResourceUpdate"))
+	require.Empty(t, conn.getRequests("ConfirmResourceRetrieve"))
+}
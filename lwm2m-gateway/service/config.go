@@ -0,0 +1,172 @@
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/plgd-dev/hub/v2/pkg/config"
+	"github.com/plgd-dev/hub/v2/pkg/log"
+	coapService "github.com/plgd-dev/hub/v2/pkg/net/coap/service"
+	"github.com/plgd-dev/hub/v2/pkg/net/grpc/client"
+	otelClient "github.com/plgd-dev/hub/v2/pkg/opentelemetry/collector/client"
+	"github.com/plgd-dev/hub/v2/pkg/security/oauth2/clientcredentials"
+	"github.com/plgd-dev/hub/v2/pkg/sync/task/queue"
+	eventbusConfig "github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/config"
+)
+
+// Config represent application configuration
+type Config struct {
+	Log              LogConfig              `yaml:"log" json:"log"`
+	APIs             APIsConfig             `yaml:"apis" json:"apis"`
+	Clients          ClientsConfig          `yaml:"clients" json:"clients"`
+	TaskQueue        queue.Config           `yaml:"taskQueue" json:"taskQueue"`
+	ServiceHeartbeat ServiceHeartbeatConfig `yaml:"serviceHeartbeat" json:"serviceHeartbeat"`
+}
+
+func (c *Config) Validate() error {
+	if err := c.Log.Validate(); err != nil {
+		return fmt.Errorf("log.%w", err)
+	}
+	if err := c.APIs.Validate(); err != nil {
+		return fmt.Errorf("apis.%w", err)
+	}
+	if err := c.Clients.Validate(); err != nil {
+		return fmt.Errorf("clients.%w", err)
+	}
+	if err := c.TaskQueue.Validate(); err != nil {
+		return fmt.Errorf("taskQueue.%w", err)
+	}
+	if err := c.ServiceHeartbeat.Validate(); err != nil {
+		return fmt.Errorf("serviceHeartbeat.%w", err)
+	}
+	return nil
+}
+
+type ServiceHeartbeatConfig struct {
+	TimeToLive time.Duration `yaml:"timeToLive" json:"timeToLive"`
+}
+
+func (c *ServiceHeartbeatConfig) Validate() error {
+	minTimeToLive := time.Second
+	if c.TimeToLive < minTimeToLive {
+		return fmt.Errorf("timeToLive('%v') - is less than %v", c.TimeToLive, minTimeToLive)
+	}
+	return nil
+}
+
+type LogConfig = log.Config
+
+type APIsConfig struct {
+	LwM2M LwM2MConfig `yaml:"lwm2m" json:"lwm2m"`
+}
+
+func (c *APIsConfig) Validate() error {
+	if err := c.LwM2M.Validate(); err != nil {
+		return fmt.Errorf("lwm2m.%w", err)
+	}
+	return nil
+}
+
+// AuthorizationConfig configures the owner of the LwM2M devices. The devices don't have the access tokens,
+// so the gateway gets the token of the owner by the client credentials flow.
+type AuthorizationConfig struct {
+	OwnerClaim string                   `yaml:"ownerClaim" json:"ownerClaim"`
+	Owner      string                   `yaml:"owner" json:"owner"`
+	Provider   clientcredentials.Config `yaml:"provider" json:"provider"`
+}
+
+func (c *AuthorizationConfig) Validate() error {
+	if c.OwnerClaim == "" {
+		return fmt.Errorf("ownerClaim('%v')", c.OwnerClaim)
+	}
+	if c.Owner == "" {
+		return fmt.Errorf("owner('%v')", c.Owner)
+	}
+	if err := c.Provider.Validate(); err != nil {
+		return fmt.Errorf("provider.%w", err)
+	}
+	return nil
+}
+
+type LwM2MConfig struct {
+	coapService.Config `yaml:",inline" json:",inline"`
+	Authorization      AuthorizationConfig `yaml:"authorization" json:"authorization"`
+	// MaxLifetime limits the lifetime of the registration requested by the client.
+	MaxLifetime time.Duration `yaml:"maxLifetime" json:"maxLifetime"`
+	// Timeout of the requests sent to the client.
+	Timeout time.Duration `yaml:"timeout" json:"timeout"`
+}
+
+func (c *LwM2MConfig) Validate() error {
+	if c.MaxLifetime < time.Second {
+		return fmt.Errorf("maxLifetime('%v')", c.MaxLifetime)
+	}
+	if c.Timeout <= 0 {
+		return fmt.Errorf("timeout('%v')", c.Timeout)
+	}
+	if err := c.Authorization.Validate(); err != nil {
+		return fmt.Errorf("authorization.%w", err)
+	}
+	// the devices are authenticated only by the certificates, the endpoint name must match the common name
+	if !c.TLS.IsEnabled() {
+		return fmt.Errorf("tls.enabled('%v') - must be enabled", *c.TLS.Enabled)
+	}
+	if !c.TLS.Embedded.ClientCertificateRequired {
+		return fmt.Errorf("tls.clientCertificateRequired('%v') - must be enabled", c.TLS.Embedded.ClientCertificateRequired)
+	}
+	return c.Config.Validate()
+}
+
+type EventBusConfig struct {
+	eventbusConfig.ConfigSubscriber `yaml:",inline" json:",inline"`
+}
+
+func (c *EventBusConfig) Validate() error {
+	if err := c.ConfigSubscriber.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
+
+type GrpcServerConfig struct {
+	Connection client.Config `yaml:"grpc" json:"grpc"`
+}
+
+func (c *GrpcServerConfig) Validate() error {
+	if err := c.Connection.Validate(); err != nil {
+		return fmt.Errorf("grpc.%w", err)
+	}
+	return nil
+}
+
+type ClientsConfig struct {
+	Eventbus               EventBusConfig    `yaml:"eventBus" json:"eventBus"`
+	OpenTelemetryCollector otelClient.Config `yaml:"openTelemetryCollector" json:"openTelemetryCollector"`
+	IdentityStore          GrpcServerConfig  `yaml:"identityStore" json:"identityStore"`
+	ResourceDirectory      GrpcServerConfig  `yaml:"resourceDirectory" json:"resourceDirectory"`
+	ResourceAggregate      GrpcServerConfig  `yaml:"resourceAggregate" json:"resourceAggregate"`
+}
+
+func (c *ClientsConfig) Validate() error {
+	if err := c.IdentityStore.Validate(); err != nil {
+		return fmt.Errorf("identityStore.%w", err)
+	}
+	if err := c.Eventbus.Validate(); err != nil {
+		return fmt.Errorf("eventbus.%w", err)
+	}
+	if err := c.ResourceAggregate.Validate(); err != nil {
+		return fmt.Errorf("resourceAggregate.%w", err)
+	}
+	if err := c.ResourceDirectory.Validate(); err != nil {
+		return fmt.Errorf("resourceDirectory.%w", err)
+	}
+	if err := c.OpenTelemetryCollector.Validate(); err != nil {
+		return fmt.Errorf("openTelemetryCollector.%w", err)
+	}
+	return nil
+}
+
+// String return string representation of Config
+func (c Config) String() string {
+	return config.ToString(c)
+}
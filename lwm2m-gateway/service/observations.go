@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+
+	"github.com/plgd-dev/go-coap/v3/message"
+	"github.com/plgd-dev/go-coap/v3/message/codes"
+	"github.com/plgd-dev/go-coap/v3/message/pool"
+	"github.com/plgd-dev/go-coap/v3/mux"
+	"github.com/plgd-dev/hub/v2/coap-gateway/coapconv"
+	"github.com/plgd-dev/hub/v2/lwm2m-gateway/lwm2m"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/commands"
+)
+
+func acceptOption(mediaType message.MediaType) message.Option {
+	buf := make([]byte, 4)
+	n, _ := message.EncodeUint32(buf, uint32(mediaType))
+	return message.Option{ID: message.Accept, Value: buf[:n]}
+}
+
+// notifyResourceChanged stores the content of the object instance to the device twin.
+func (c *session) notifyResourceChanged(deviceID string, l lwm2m.Link, notification *pool.Message) error {
+	// the content of the resource is up to date, codes.Valid is used to indicate that the resource has not changed.
+	bodySize, err := notification.BodySize()
+	if err != nil {
+		return err
+	}
+	if notification.Code() == codes.Valid && bodySize == 0 {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(c.Context(), c.server.config.APIs.LwM2M.Timeout)
+	defer cancel()
+	ctx, err = c.server.ctxWithToken(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = c.server.raClient.NotifyResourceChanged(ctx, coapconv.NewNotifyResourceChangedRequest(commands.NewResourceID(deviceID, l.Href), []string{l.ResourceType()}, c.RemoteAddr().String(), notification))
+	return err
+}
+
+// Callback executed when the Observe notification is received.
+//
+// This function is executed in the coap connection-goroutine, any operation on the connection (read, write, ...)
+// will cause a deadlock . To avoid this problem the operation must be executed inside the taskQueue.
+func (c *session) onObserveResource(deviceID string, l lwm2m.Link, notification *pool.Message) {
+	notification.Hijack()
+	err := c.server.taskQueue.SubmitForOneWorker(deviceID+l.Href, func() {
+		defer c.server.messagePool.ReleaseMessage(notification)
+		if err := c.notifyResourceChanged(deviceID, l, notification); err != nil {
+			c.Errorf("cannot notify resource /%v%v content changed: %w", deviceID, l.Href, err)
+		}
+	})
+	if err != nil {
+		c.server.messagePool.ReleaseMessage(notification)
+		c.Errorf("cannot notify resource /%v%v content changed: %w", deviceID, l.Href, err)
+	}
+}
+
+func (c *session) observe(r *lwm2m.Registration, l lwm2m.Link) (mux.Observation, error) {
+	ctx, cancel := context.WithTimeout(c.Context(), c.server.config.APIs.LwM2M.Timeout)
+	defer cancel()
+	opts := make([]message.Option, 0, 1)
+	if r.SupportsSenML() {
+		opts = append(opts, acceptOption(message.AppSenmlCbor))
+	}
+	deviceID := r.DeviceID()
+	return c.coapConn.Observe(ctx, l.Href, func(notification *pool.Message) {
+		c.onObserveResource(deviceID, l, notification)
+	}, opts...)
+}
+
+// observeInstances observes the object instances of the registration and cancels the observations
+// of the object instances which are not registered anymore.
+func (c *session) observeInstances(r *lwm2m.Registration) {
+	instances := r.Instances()
+	wanted := make(map[string]struct{}, len(instances))
+	for _, l := range instances {
+		wanted[l.Href] = struct{}{}
+	}
+	c.private.mutex.Lock()
+	if c.private.observations == nil {
+		c.private.observations = make(map[string]mux.Observation)
+	}
+	toCancel := make([]mux.Observation, 0, len(c.private.observations))
+	for href, obs := range c.private.observations {
+		if _, ok := wanted[href]; !ok {
+			toCancel = append(toCancel, obs)
+			delete(c.private.observations, href)
+		}
+	}
+	toObserve := make([]lwm2m.Link, 0, len(instances))
+	for _, l := range instances {
+		if _, ok := c.private.observations[l.Href]; !ok {
+			toObserve = append(toObserve, l)
+		}
+	}
+	c.private.mutex.Unlock()
+
+	c.cancel(toCancel)
+	for _, l := range toObserve {
+		obs, err := c.observe(r, l)
+		if err != nil {
+			c.Errorf("cannot observe resource %v: %w", l.Href, err)
+			continue
+		}
+		c.private.mutex.Lock()
+		// the observations were canceled by the clean up in the meantime
+		canceled := c.private.observations == nil
+		if !canceled {
+			c.private.observations[l.Href] = obs
+		}
+		c.private.mutex.Unlock()
+		if canceled {
+			c.cancel([]mux.Observation{obs})
+		}
+	}
+}
+
+func (c *session) cancel(observations []mux.Observation) {
+	for _, obs := range observations {
+		ctx, cancel := context.WithTimeout(context.Background(), c.server.config.APIs.LwM2M.Timeout)
+		if err := obs.Cancel(ctx); err != nil {
+			c.Debugf("cannot cancel observation: %v", err)
+		}
+		cancel()
+	}
+}
+
+// cancelObservations cancels all observations of the object instances.
+func (c *session) cancelObservations() {
+	c.private.mutex.Lock()
+	observations := make([]mux.Observation, 0, len(c.private.observations))
+	for _, obs := range c.private.observations {
+		observations = append(observations, obs)
+	}
+	c.private.observations = nil
+	c.private.mutex.Unlock()
+	c.cancel(observations)
+}
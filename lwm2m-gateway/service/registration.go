@@ -0,0 +1,447 @@
+package service
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pion/dtls/v3"
+	"github.com/plgd-dev/device/v2/schema"
+	"github.com/plgd-dev/device/v2/schema/device"
+	"github.com/plgd-dev/device/v2/schema/interfaces"
+	"github.com/plgd-dev/go-coap/v3/message"
+	coapCodes "github.com/plgd-dev/go-coap/v3/message/codes"
+	"github.com/plgd-dev/go-coap/v3/message/pool"
+	"github.com/plgd-dev/go-coap/v3/mux"
+	"github.com/plgd-dev/go-coap/v3/pkg/cache"
+	"github.com/plgd-dev/hub/v2/coap-gateway/coapconv"
+	grpcClient "github.com/plgd-dev/hub/v2/grpc-gateway/client"
+	pbGRPC "github.com/plgd-dev/hub/v2/grpc-gateway/pb"
+	pbIS "github.com/plgd-dev/hub/v2/identity-store/pb"
+	"github.com/plgd-dev/hub/v2/lwm2m-gateway/lwm2m"
+	"github.com/plgd-dev/hub/v2/lwm2m-gateway/uri"
+	pkgTime "github.com/plgd-dev/hub/v2/pkg/time"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/commands"
+	"github.com/plgd-dev/kit/v2/codec/cbor"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func readRegistrationRequest(req *mux.Message) ([]string, []byte, error) {
+	queries, err := req.Options().Queries()
+	if err != nil && !errors.Is(err, message.ErrOptionNotFound) {
+		return nil, nil, fmt.Errorf("cannot read queries: %w", err)
+	}
+	if req.Body() == nil {
+		return queries, nil, nil
+	}
+	body, err := io.ReadAll(req.Body())
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot read body: %w", err)
+	}
+	return queries, body, nil
+}
+
+// getPeerCommonName returns the common name of the certificate of the client, it is empty when the client
+// doesn't use the certificate.
+func getPeerCommonName(ctx context.Context, conn mux.Conn) (string, error) {
+	var peerCertificates [][]byte
+	switch c := conn.NetConn().(type) {
+	case *dtls.Conn:
+		if err := c.HandshakeContext(ctx); err != nil {
+			return "", fmt.Errorf("handshake failed: %w", err)
+		}
+		cs, ok := c.ConnectionState()
+		if !ok {
+			return "", nil
+		}
+		peerCertificates = cs.PeerCertificates
+	case *tls.Conn:
+		if err := c.HandshakeContext(ctx); err != nil {
+			return "", fmt.Errorf("handshake failed: %w", err)
+		}
+		for _, cert := range c.ConnectionState().PeerCertificates {
+			peerCertificates = append(peerCertificates, cert.Raw)
+		}
+	}
+	if len(peerCertificates) == 0 {
+		return "", nil
+	}
+	cert, err := x509.ParseCertificate(peerCertificates[0])
+	if err != nil {
+		return "", fmt.Errorf("cannot parse certificate: %w", err)
+	}
+	return cert.Subject.CommonName, nil
+}
+
+func (c *session) registrationValidUntil(r *lwm2m.Registration) time.Time {
+	lifetime := r.Lifetime
+	if lifetime > c.server.config.APIs.LwM2M.MaxLifetime {
+		lifetime = c.server.config.APIs.LwM2M.MaxLifetime
+	}
+	return time.Now().Add(lifetime)
+}
+
+// closeOtherSessions closes the previous connections of the device, the device twin is maintained only by one connection.
+func (c *session) closeOtherSessions(deviceID string) {
+	c.server.registrations.Range(func(_ string, value *cache.Element[*session]) bool {
+		s := value.Data()
+		if s != c && s.deviceID() == deviceID {
+			s.Debugf("device registered from another connection %v", c.RemoteAddr())
+			s.Close()
+		}
+		return true
+	})
+}
+
+func (c *session) setDeviceOnline(ctx context.Context, deviceID string) (bool, error) {
+	for {
+		resp, err := c.server.raClient.UpdateDeviceMetadata(ctx, &commands.UpdateDeviceMetadataRequest{
+			DeviceId: deviceID,
+			Update: &commands.UpdateDeviceMetadataRequest_Connection{
+				Connection: &commands.Connection{
+					Status:      commands.Connection_ONLINE,
+					ConnectedAt: time.Now().UnixNano(),
+					Protocol:    c.GetApplicationProtocol(),
+					ServiceId:   c.server.instanceID.String(),
+				},
+			},
+			CommandMetadata: c.newCommandMetadata(),
+		})
+		if err == nil {
+			return resp.GetTwinEnabled(), nil
+		}
+		if s, ok := status.FromError(err); !ok || s.Code() != codes.PermissionDenied {
+			return false, err
+		}
+		// the device was just added to the identity-store and the resource-aggregate still doesn't know the owner
+		select {
+		case <-ctx.Done():
+			return false, err
+		case <-time.After(time.Millisecond * 100):
+		}
+	}
+}
+
+func toResourceLink(deviceID string, l lwm2m.Link) *commands.Resource {
+	return &commands.Resource{
+		Href:          l.Href,
+		DeviceId:      deviceID,
+		ResourceTypes: []string{l.ResourceType()},
+		Interfaces:    []string{interfaces.OC_IF_BASELINE},
+		Policy: &commands.Policy{
+			BitFlags: commands.ToPolicyBitFlags(schema.Observable | schema.Discoverable),
+		},
+	}
+}
+
+func (c *session) getPublishedHrefs(ctx context.Context, deviceID string) (map[string]struct{}, error) {
+	client, err := c.server.rdClient.GetResourceLinks(ctx, &pbGRPC.GetResourceLinksRequest{
+		DeviceIdFilter: []string{deviceID},
+	})
+	if err != nil {
+		return nil, err
+	}
+	hrefs := make(map[string]struct{})
+	for {
+		links, err := client.Recv()
+		if errors.Is(err, io.EOF) {
+			return hrefs, nil
+		}
+		if status.Code(err) == codes.NotFound {
+			return hrefs, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range links.GetResources() {
+			hrefs[r.GetHref()] = struct{}{}
+		}
+	}
+}
+
+// syncResourceLinks publishes the object instances of the registration as the resources of the device
+// and unpublishes the resources which are not registered anymore.
+func (c *session) syncResourceLinks(ctx context.Context, r *lwm2m.Registration) error {
+	deviceID := r.DeviceID()
+	published, err := c.getPublishedHrefs(ctx, deviceID)
+	if err != nil {
+		return fmt.Errorf("cannot get published resources: %w", err)
+	}
+	resources := make([]*commands.Resource, 0, len(r.Links)+1)
+	resources = append(resources, &commands.Resource{
+		Href:          device.ResourceURI,
+		DeviceId:      deviceID,
+		ResourceTypes: []string{device.ResourceType},
+		Interfaces:    []string{interfaces.OC_IF_BASELINE},
+		Policy: &commands.Policy{
+			BitFlags: commands.ToPolicyBitFlags(schema.Observable | schema.Discoverable),
+		},
+	})
+	for _, l := range r.Instances() {
+		resources = append(resources, toResourceLink(deviceID, l))
+	}
+	for _, res := range resources {
+		delete(published, res.GetHref())
+	}
+	if len(published) > 0 {
+		hrefs := make([]string, 0, len(published))
+		for href := range published {
+			hrefs = append(hrefs, href)
+		}
+		_, err = c.server.raClient.UnpublishResourceLinks(ctx, &commands.UnpublishResourceLinksRequest{
+			Hrefs:           hrefs,
+			DeviceId:        deviceID,
+			CommandMetadata: c.newCommandMetadata(),
+		})
+		if err != nil {
+			return fmt.Errorf("cannot unpublish resources: %w", err)
+		}
+	}
+	_, err = c.server.raClient.PublishResourceLinks(ctx, &commands.PublishResourceLinksRequest{
+		Resources:       resources,
+		DeviceId:        deviceID,
+		CommandMetadata: c.newCommandMetadata(),
+	})
+	if err != nil {
+		return fmt.Errorf("cannot publish resources: %w", err)
+	}
+	return nil
+}
+
+func newDeviceResourceContent(r *lwm2m.Registration) ([]byte, error) {
+	return cbor.Encode(device.Device{
+		ID:            r.DeviceID(),
+		Name:          r.Endpoint,
+		ResourceTypes: []string{device.ResourceType},
+		Interfaces:    []string{interfaces.OC_IF_BASELINE},
+	})
+}
+
+// notifyDeviceResourceChanged sets the content of /oic/d, which is maintained by the gateway because LwM2M clients don't have it.
+func (c *session) notifyDeviceResourceChanged(ctx context.Context, r *lwm2m.Registration) error {
+	data, err := newDeviceResourceContent(r)
+	if err != nil {
+		return err
+	}
+	_, err = c.server.raClient.NotifyResourceChanged(ctx, &commands.NotifyResourceChangedRequest{
+		ResourceId:      commands.NewResourceID(r.DeviceID(), device.ResourceURI),
+		CommandMetadata: c.newCommandMetadata(),
+		Content: &commands.Content{
+			Data:              data,
+			ContentType:       message.AppOcfCbor.String(),
+			CoapContentFormat: int32(message.AppOcfCbor),
+		},
+		Status: commands.Status_OK,
+	})
+	return err
+}
+
+func (c *session) updateTwinSynchronizationState(ctx context.Context, deviceID string, state commands.TwinSynchronization_State) error {
+	ctx, cancel := context.WithTimeout(ctx, c.server.config.APIs.LwM2M.Timeout)
+	defer cancel()
+	ctx, err := c.server.ctxWithToken(ctx)
+	if err != nil {
+		return err
+	}
+	twinSynchronization := &commands.TwinSynchronization{
+		State: state,
+	}
+	switch state {
+	case commands.TwinSynchronization_SYNCING:
+		twinSynchronization.SyncingAt = time.Now().UnixNano()
+	case commands.TwinSynchronization_IN_SYNC:
+		twinSynchronization.InSyncAt = time.Now().UnixNano()
+	}
+	_, err = c.server.raClient.UpdateDeviceMetadata(ctx, &commands.UpdateDeviceMetadataRequest{
+		DeviceId: deviceID,
+		Update: &commands.UpdateDeviceMetadataRequest_TwinSynchronization{
+			TwinSynchronization: twinSynchronization,
+		},
+		CommandMetadata: c.newCommandMetadata(),
+	})
+	return err
+}
+
+func (c *session) setNewDeviceSubscriber(ctx context.Context, deviceID string) error {
+	timeout := c.server.config.APIs.LwM2M.Timeout
+	deviceSubscriber, err := grpcClient.NewDeviceSubscriber(c.GetContext, c.server.config.APIs.LwM2M.Authorization.Owner, deviceID,
+		func() func() (when time.Time, err error) {
+			var count uint64
+			delayFn := pkgTime.GetRandomDelayGenerator(timeout / 4)
+			return func() (when time.Time, err error) {
+				count++
+				next := time.Now().Add(timeout + delayFn())
+				c.Debugf("next iteration %v of retrying reconnect to grpc-client will be at %v", count, next)
+				return next, nil
+			}
+		}, c.server.rdClient, c.server.resourceSubscriber, c.server.tracerProvider)
+	if err != nil {
+		return fmt.Errorf("cannot create device subscription for device %v: %w", deviceID, err)
+	}
+	if old := c.replaceDeviceSubscriber(deviceSubscriber); old != nil {
+		if err = old.Close(); err != nil {
+			c.Errorf("failed to close replaced device subscriber: %w", err)
+		}
+	}
+	deviceSubscriber.SubscribeToPendingCommands(ctx, grpcClient.NewDeviceSubscriptionHandlers(c))
+	return nil
+}
+
+// synchronizeDeviceTwin observes the object instances when the device twin is enabled.
+func (c *session) synchronizeDeviceTwin(ctx context.Context, r *lwm2m.Registration) error {
+	deviceID := r.DeviceID()
+	if !c.isTwinEnabled() {
+		c.cancelObservations()
+		return nil
+	}
+	if err := c.updateTwinSynchronizationState(ctx, deviceID, commands.TwinSynchronization_SYNCING); err != nil {
+		return err
+	}
+	c.observeInstances(r)
+	return c.updateTwinSynchronizationState(ctx, deviceID, commands.TwinSynchronization_IN_SYNC)
+}
+
+func (c *session) newRegistrationResponse(req *mux.Message, code coapCodes.Code) *pool.Message {
+	resp := c.server.messagePool.AcquireMessage(req.Context())
+	resp.SetCode(code)
+	resp.SetToken(req.Token())
+	return resp
+}
+
+// register handles the Register operation of the LwM2M client.
+func (c *session) register(req *mux.Message) (*pool.Message, error) {
+	queries, body, err := readRegistrationRequest(req)
+	if err != nil {
+		return nil, statusErrorf(coapCodes.BadRequest, "cannot handle register: %w", err)
+	}
+	r, err := lwm2m.ParseRegister(queries, body)
+	if err != nil {
+		return nil, statusErrorf(coapCodes.BadRequest, "cannot handle register: %w", err)
+	}
+	commonName, err := getPeerCommonName(req.Context(), c.coapConn)
+	if err != nil {
+		return nil, statusErrorf(coapCodes.Forbidden, "cannot handle register: %w", err)
+	}
+	if commonName == "" {
+		return nil, statusErrorf(coapCodes.Unauthorized, "cannot handle register: endpoint('%v') without verified certificate", r.Endpoint)
+	}
+	if commonName != r.Endpoint {
+		return nil, statusErrorf(coapCodes.Forbidden, "cannot handle register: endpoint('%v') doesn't match the certificate('%v')", r.Endpoint, commonName)
+	}
+	// the client registers again on the same connection, eg. after the restart
+	if old := c.cleanUp(); old != nil && old.DeviceID() != r.DeviceID() {
+		c.setDeviceOffline(req.Context(), old.DeviceID())
+	}
+	deviceID := r.DeviceID()
+	c.closeOtherSessions(deviceID)
+
+	ctx, cancel := context.WithTimeout(req.Context(), c.server.config.APIs.LwM2M.Timeout)
+	defer cancel()
+	ctx, err = c.server.ctxWithToken(ctx)
+	if err != nil {
+		return nil, statusErrorf(coapCodes.ServiceUnavailable, "cannot handle register: %w", err)
+	}
+	if _, err = c.server.isClient.AddDevice(ctx, &pbIS.AddDeviceRequest{DeviceId: deviceID}); err != nil {
+		return nil, statusErrorf(coapconv.GrpcErr2CoapCode(err, coapconv.Create), "cannot handle register: cannot add device %v: %w", deviceID, err)
+	}
+	twinEnabled, err := c.setDeviceOnline(ctx, deviceID)
+	if err != nil {
+		return nil, statusErrorf(coapconv.GrpcErr2CoapCode(err, coapconv.Update), "cannot handle register: cannot set device %v online: %w", deviceID, err)
+	}
+	registrationID := uuid.NewString()
+	c.setRegistration(registrationID, r)
+	c.setTwinEnabled(twinEnabled)
+	c.server.registrations.Store(registrationID, cache.NewElement(c, c.registrationValidUntil(r), func(s *session) {
+		s.Debugf("registration expired")
+		s.Close()
+	}))
+	if err = c.syncResourceLinks(ctx, r); err != nil {
+		c.Close()
+		return nil, statusErrorf(coapCodes.ServiceUnavailable, "cannot handle register: %w", err)
+	}
+	if err = c.notifyDeviceResourceChanged(ctx, r); err != nil {
+		c.Close()
+		return nil, statusErrorf(coapCodes.ServiceUnavailable, "cannot handle register: cannot set content of %v: %w", device.ResourceURI, err)
+	}
+	if err = c.setNewDeviceSubscriber(ctx, deviceID); err != nil {
+		c.Close()
+		return nil, statusErrorf(coapCodes.ServiceUnavailable, "cannot handle register: %w", err)
+	}
+
+	resp := c.newRegistrationResponse(req, coapCodes.Created)
+	resp.AddOptionString(message.LocationPath, uri.RegistrationPath[1:])
+	resp.AddOptionString(message.LocationPath, registrationID)
+	// the client must receive the response before the observations are created
+	if err = c.coapConn.WriteMessage(resp); err != nil {
+		c.server.messagePool.ReleaseMessage(resp)
+		c.Close()
+		return nil, fmt.Errorf("cannot handle register: cannot write response: %w", err)
+	}
+	c.logRequestResponse(req, resp, nil)
+	c.server.messagePool.ReleaseMessage(resp)
+	if err = c.synchronizeDeviceTwin(c.Context(), r); err != nil {
+		c.Errorf("cannot synchronize device twin: %w", err)
+	}
+	return nil, nil
+}
+
+func (c *session) checkRegistrationID(registrationID string) (*lwm2m.Registration, error) {
+	id, r := c.getRegistration()
+	if r == nil || id != registrationID {
+		// the client registers again when the registration is not found
+		return nil, statusErrorf(coapCodes.NotFound, "registration('%v') not found", registrationID)
+	}
+	return r, nil
+}
+
+// updateRegistration handles the Update operation of the LwM2M client.
+func (c *session) updateRegistration(req *mux.Message, registrationID string) (*pool.Message, error) {
+	r, err := c.checkRegistrationID(registrationID)
+	if err != nil {
+		return nil, err
+	}
+	queries, body, err := readRegistrationRequest(req)
+	if err != nil {
+		return nil, statusErrorf(coapCodes.BadRequest, "cannot handle update: %w", err)
+	}
+	u, err := r.Update(queries, body)
+	if err != nil {
+		return nil, statusErrorf(coapCodes.BadRequest, "cannot handle update: %w", err)
+	}
+	c.setRegistration(registrationID, u)
+	if e := c.server.registrations.Load(registrationID); e != nil {
+		e.ValidUntil.Store(c.registrationValidUntil(u))
+	}
+	if len(body) > 0 {
+		ctx, cancel := context.WithTimeout(req.Context(), c.server.config.APIs.LwM2M.Timeout)
+		defer cancel()
+		ctx, err = c.server.ctxWithToken(ctx)
+		if err != nil {
+			return nil, statusErrorf(coapCodes.ServiceUnavailable, "cannot handle update: %w", err)
+		}
+		if err = c.syncResourceLinks(ctx, u); err != nil {
+			return nil, statusErrorf(coapCodes.ServiceUnavailable, "cannot handle update: %w", err)
+		}
+		if c.isTwinEnabled() {
+			c.observeInstances(u)
+		}
+	}
+	return c.newRegistrationResponse(req, coapCodes.Changed), nil
+}
+
+// deregister handles the Deregister operation of the LwM2M client.
+func (c *session) deregister(req *mux.Message, registrationID string) (*pool.Message, error) {
+	if _, err := c.checkRegistrationID(registrationID); err != nil {
+		return nil, err
+	}
+	if r := c.cleanUp(); r != nil {
+		c.setDeviceOffline(req.Context(), r.DeviceID())
+	}
+	return c.newRegistrationResponse(req, coapCodes.Deleted), nil
+}
@@ -0,0 +1,173 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/plgd-dev/device/v2/schema"
+	"github.com/plgd-dev/device/v2/schema/device"
+	"github.com/plgd-dev/go-coap/v3/message"
+	"github.com/plgd-dev/go-coap/v3/message/codes"
+	"github.com/plgd-dev/go-coap/v3/message/pool"
+	"github.com/plgd-dev/go-coap/v3/message/status"
+	"github.com/plgd-dev/go-coap/v3/mux"
+	pbIS "github.com/plgd-dev/hub/v2/identity-store/pb"
+	"github.com/plgd-dev/hub/v2/lwm2m-gateway/lwm2m"
+	"github.com/plgd-dev/hub/v2/lwm2m-gateway/uri"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/commands"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/events"
+	"github.com/plgd-dev/kit/v2/codec/cbor"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDeviceResourceContent(t *testing.T) {
+	r, err := lwm2m.ParseRegister([]string{"ep=sensor"}, []byte(`</3/0>`))
+	require.NoError(t, err)
+	data, err := newDeviceResourceContent(r)
+	require.NoError(t, err)
+	var d device.Device
+	err = cbor.Decode(data, &d)
+	require.NoError(t, err)
+	require.Equal(t, r.DeviceID(), d.ID)
+	require.Equal(t, "sensor", d.Name)
+	require.Equal(t, []string{device.ResourceType}, d.ResourceTypes)
+}
+
+func TestToResourceLink(t *testing.T) {
+	r, err := lwm2m.ParseRegister([]string{"ep=sensor"}, []byte(`</3303/1>`))
+	require.NoError(t, err)
+	res := toResourceLink(r.DeviceID(), r.Instances()[0])
+	require.Equal(t, "/3303/1", res.GetHref())
+	require.Equal(t, r.DeviceID(), res.GetDeviceId())
+	require.Equal(t, []string{"oma.lwm2m.3303"}, res.GetResourceTypes())
+	require.Equal(t, commands.ToPolicyBitFlags(schema.Observable|schema.Discoverable), res.GetPolicy().GetBitFlags())
+}
+
+func TestIsLocalResource(t *testing.T) {
+	require.True(t, isLocalResource(device.ResourceURI))
+	require.True(t, isLocalResource(commands.StatusHref))
+	require.False(t, isLocalResource("/3/0"))
+}
+
+func newRegistrationRequest(t *testing.T, code codes.Code, path string, queries []string, body string) *mux.Message {
+	msg := pool.NewMessage(context.Background())
+	msg.SetCode(code)
+	msg.SetToken(message.Token("token"))
+	require.NoError(t, msg.SetPath(path))
+	for _, q := range queries {
+		msg.AddQuery(q)
+	}
+	if body != "" {
+		msg.SetContentFormat(message.AppLinkFormat)
+		msg.SetBody(bytes.NewReader([]byte(body)))
+	}
+	return &mux.Message{Message: msg}
+}
+
+func requireCoapCode(t *testing.T, code codes.Code, err error) {
+	require.Error(t, err)
+	s, ok := status.FromError(err)
+	require.True(t, ok, err)
+	require.Equal(t, code, s.Code(), err)
+}
+
+func getUpdateDeviceMetadataRequests(conn *fakeGrpcConn) []*commands.UpdateDeviceMetadataRequest {
+	var res []*commands.UpdateDeviceMetadataRequest
+	for _, r := range conn.getRequests("UpdateDeviceMetadata") {
+		res = append(res, r.(*commands.UpdateDeviceMetadataRequest))
+	}
+	return res
+}
+
+func TestRegistration(t *testing.T) {
+	s, conn := newTestService(t)
+	c, coapConn := newTestSession(t, s, newTLSConn(t, "sensor"))
+	deviceID := lwm2m.DeviceID("sensor")
+
+	resp, err := registrationHandler(newRegistrationRequest(t, codes.POST, uri.RegistrationPath, []string{"ep=sensor", "lt=60"}, "</3/0>,</3303/1>"), c)
+	require.NoError(t, err)
+	// the response is written by the handler before the observations are created
+	require.Nil(t, resp)
+	registrationID, r := c.getRegistration()
+	require.NotNil(t, r)
+	require.Equal(t, []writtenMessage{{code: codes.Created, locationPath: uri.RegistrationPath + "/" + registrationID}}, coapConn.getWritten())
+	require.NotNil(t, s.registrations.Load(registrationID))
+
+	addDevice := conn.getRequests("AddDevice")
+	require.Len(t, addDevice, 1)
+	require.Equal(t, deviceID, addDevice[0].(*pbIS.AddDeviceRequest).GetDeviceId())
+	online := getUpdateDeviceMetadataRequests(conn)
+	require.Len(t, online, 1)
+	require.Equal(t, deviceID, online[0].GetDeviceId())
+	require.Equal(t, commands.Connection_ONLINE, online[0].GetConnection().GetStatus())
+	publish := conn.getRequests("PublishResourceLinks")
+	require.Len(t, publish, 1)
+	var hrefs []string
+	for _, res := range publish[0].(*commands.PublishResourceLinksRequest).GetResources() {
+		hrefs = append(hrefs, res.GetHref())
+	}
+	require.Equal(t, []string{device.ResourceURI, "/3/0", "/3303/1"}, hrefs)
+	notify := conn.getRequests("NotifyResourceChanged")
+	require.Len(t, notify, 1)
+	require.Equal(t, device.ResourceURI, notify[0].(*commands.NotifyResourceChangedRequest).GetResourceId().GetHref())
+
+	// the object instance /3/0 is not registered anymore, so it is unpublished
+	conn.reset()
+	conn.setStream("GetResourceLinks", &events.ResourceLinksPublished{
+		DeviceId:  deviceID,
+		Resources: publish[0].(*commands.PublishResourceLinksRequest).GetResources(),
+	})
+	resp, err = registrationHandler(newRegistrationRequest(t, codes.POST, uri.RegistrationPath+"/"+registrationID, []string{"lt=120"}, "</3303/1>"), c)
+	require.NoError(t, err)
+	require.Equal(t, codes.Changed, resp.Code())
+	unpublish := conn.getRequests("UnpublishResourceLinks")
+	require.Len(t, unpublish, 1)
+	require.Equal(t, []string{"/3/0"}, unpublish[0].(*commands.UnpublishResourceLinksRequest).GetHrefs())
+	_, r = c.getRegistration()
+	require.Equal(t, 120*time.Second, r.Lifetime)
+	require.Len(t, r.Instances(), 1)
+	require.Equal(t, "/3303/1", r.Instances()[0].Href)
+
+	_, err = registrationHandler(newRegistrationRequest(t, codes.POST, uri.RegistrationPath+"/unknown", nil, ""), c)
+	requireCoapCode(t, codes.NotFound, err)
+
+	conn.reset()
+	resp, err = registrationHandler(newRegistrationRequest(t, codes.DELETE, uri.RegistrationPath+"/"+registrationID, nil, ""), c)
+	require.NoError(t, err)
+	require.Equal(t, codes.Deleted, resp.Code())
+	offline := getUpdateDeviceMetadataRequests(conn)
+	require.Len(t, offline, 1)
+	require.Equal(t, deviceID, offline[0].GetDeviceId())
+	require.Equal(t, commands.Connection_OFFLINE, offline[0].GetConnection().GetStatus())
+	_, r = c.getRegistration()
+	require.Nil(t, r)
+	require.Nil(t, s.registrations.Load(registrationID))
+	require.False(t, coapConn.isClosed())
+
+	// the client must register again
+	_, err = registrationHandler(newRegistrationRequest(t, codes.DELETE, uri.RegistrationPath+"/"+registrationID, nil, ""), c)
+	requireCoapCode(t, codes.NotFound, err)
+}
+
+func TestRegistrationRejected(t *testing.T) {
+	s, conn := newTestService(t)
+
+	// the client without the certificate
+	c, _ := newTestSession(t, s, nil)
+	_, err := registrationHandler(newRegistrationRequest(t, codes.POST, uri.RegistrationPath, []string{"ep=sensor"}, "</3/0>"), c)
+	requireCoapCode(t, codes.Unauthorized, err)
+
+	// the certificate of another endpoint
+	c, _ = newTestSession(t, s, newTLSConn(t, "other"))
+	_, err = registrationHandler(newRegistrationRequest(t, codes.POST, uri.RegistrationPath, []string{"ep=sensor"}, "</3/0>"), c)
+	requireCoapCode(t, codes.Forbidden, err)
+
+	// the device is not added to the hub
+	for _, method := range []string{"AddDevice", "UpdateDeviceMetadata", "PublishResourceLinks"} {
+		require.Empty(t, conn.getRequests(method), method)
+	}
+	_, r := c.getRegistration()
+	require.Nil(t, r)
+}
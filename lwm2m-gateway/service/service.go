@@ -0,0 +1,314 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	coapCodes "github.com/plgd-dev/go-coap/v3/message/codes"
+	"github.com/plgd-dev/go-coap/v3/message/pool"
+	"github.com/plgd-dev/go-coap/v3/message/status"
+	"github.com/plgd-dev/go-coap/v3/mux"
+	"github.com/plgd-dev/go-coap/v3/pkg/cache"
+	"github.com/plgd-dev/go-coap/v3/pkg/runner/periodic"
+	pbGRPC "github.com/plgd-dev/hub/v2/grpc-gateway/pb"
+	pbIS "github.com/plgd-dev/hub/v2/identity-store/pb"
+	"github.com/plgd-dev/hub/v2/lwm2m-gateway/uri"
+	"github.com/plgd-dev/hub/v2/pkg/fsnotify"
+	"github.com/plgd-dev/hub/v2/pkg/log"
+	coapService "github.com/plgd-dev/hub/v2/pkg/net/coap/service"
+	kitNetGrpc "github.com/plgd-dev/hub/v2/pkg/net/grpc"
+	grpcClient "github.com/plgd-dev/hub/v2/pkg/net/grpc/client"
+	otelClient "github.com/plgd-dev/hub/v2/pkg/opentelemetry/collector/client"
+	"github.com/plgd-dev/hub/v2/pkg/security/oauth2/clientcredentials"
+	"github.com/plgd-dev/hub/v2/pkg/service"
+	"github.com/plgd-dev/hub/v2/pkg/sync/task/queue"
+	raClient "github.com/plgd-dev/hub/v2/resource-aggregate/client"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus"
+	eventbusConfig "github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/config"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/oauth2"
+)
+
+// tokenCache provides the access tokens obtained by the client credentials flow.
+type tokenCache interface {
+	GetToken(ctx context.Context, key string, urlValues map[string]string, requiredClaims map[string]interface{}) (*oauth2.Token, error)
+}
+
+// Service is a configuration of lwm2m-gateway
+type Service struct {
+	ctx                context.Context
+	instanceID         uuid.UUID
+	tracerProvider     trace.TracerProvider
+	logger             log.Logger
+	isClient           pbIS.IdentityStoreClient
+	rdClient           pbGRPC.GrpcGatewayClient
+	raClient           *raClient.Client
+	resourceSubscriber eventbusConfig.Subscriber
+	tokenCache         tokenCache
+	taskQueue          *queue.Queue
+	messagePool        *pool.Pool
+	// registrations expire when the client doesn't update them in their lifetime
+	registrations *cache.Cache[string, *session]
+	config        Config
+}
+
+func newRegistrationsCache(ctx context.Context, interval time.Duration) *cache.Cache[string, *session] {
+	registrations := cache.NewCache[string, *session]()
+	add := periodic.New(ctx.Done(), interval)
+	add(func(now time.Time) bool {
+		registrations.CheckExpirations(now)
+		return true
+	})
+	return registrations
+}
+
+func newResourceAggregateClient(config GrpcServerConfig, resourceSubscriber eventbus.Subscriber, fileWatcher *fsnotify.Watcher, logger log.Logger, tracerProvider trace.TracerProvider) (*raClient.Client, func(), error) {
+	raConn, err := grpcClient.New(config.Connection, fileWatcher, logger, tracerProvider)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot create connection to resource-aggregate: %w", err)
+	}
+	closeRaConn := func() {
+		if err := raConn.Close(); err != nil {
+			if kitNetGrpc.IsContextCanceled(err) {
+				return
+			}
+			logger.Errorf("error occurs during close connection to resource-aggregate: %v", err)
+		}
+	}
+	raClient := raClient.New(raConn.GRPC(), resourceSubscriber)
+	return raClient, closeRaConn, nil
+}
+
+func newIdentityStoreClient(config GrpcServerConfig, fileWatcher *fsnotify.Watcher, logger log.Logger, tracerProvider trace.TracerProvider) (pbIS.IdentityStoreClient, func(), error) {
+	isConn, err := grpcClient.New(config.Connection, fileWatcher, logger, tracerProvider)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot create connection to identity-store: %w", err)
+	}
+	closeIsConn := func() {
+		if err := isConn.Close(); err != nil {
+			if kitNetGrpc.IsContextCanceled(err) {
+				return
+			}
+			logger.Errorf("error occurs during close connection to identity-store: %v", err)
+		}
+	}
+	return pbIS.NewIdentityStoreClient(isConn.GRPC()), closeIsConn, nil
+}
+
+func newResourceDirectoryClient(config GrpcServerConfig, fileWatcher *fsnotify.Watcher, logger log.Logger, tracerProvider trace.TracerProvider) (pbGRPC.GrpcGatewayClient, func(), error) {
+	rdConn, err := grpcClient.New(config.Connection, fileWatcher, logger, tracerProvider)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot create connection to resource-directory: %w", err)
+	}
+	closeRdConn := func() {
+		if err := rdConn.Close(); err != nil {
+			if kitNetGrpc.IsContextCanceled(err) {
+				return
+			}
+			logger.Errorf("error occurs during close connection to resource-directory: %v", err)
+		}
+	}
+	return pbGRPC.NewGrpcGatewayClient(rdConn.GRPC()), closeRdConn, nil
+}
+
+// New creates server.
+func New(ctx context.Context, config Config, fileWatcher *fsnotify.Watcher, logger log.Logger) (*service.Service, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	otelClient, err := otelClient.New(ctx, config.Clients.OpenTelemetryCollector, "lwm2m-gateway", fileWatcher, logger)
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("cannot create open telemetry collector client: %w", err)
+	}
+	otelClient.AddCloseFunc(cancel)
+	tracerProvider := otelClient.GetTracerProvider()
+
+	queue, err := queue.New(config.TaskQueue)
+	if err != nil {
+		otelClient.Close()
+		return nil, fmt.Errorf("cannot create job queue %w", err)
+	}
+
+	resourceSubscriber, err := eventbusConfig.NewSubscriber(config.Clients.Eventbus.ConfigSubscriber, fileWatcher, logger, tracerProvider,
+		eventbusConfig.WithGoPool(func(f func()) error { return queue.Submit(f) }),
+	)
+	if err != nil {
+		otelClient.Close()
+		queue.Release()
+		return nil, fmt.Errorf("cannot create eventbus subscriber: %w", err)
+	}
+	resourceSubscriber.AddCloseFunc(otelClient.Close)
+	resourceSubscriber.AddCloseFunc(queue.Release)
+
+	raClient, closeRaClient, err := newResourceAggregateClient(config.Clients.ResourceAggregate, resourceSubscriber, fileWatcher, logger, tracerProvider)
+	if err != nil {
+		resourceSubscriber.Close()
+		return nil, fmt.Errorf("cannot create resource-aggregate client: %w", err)
+	}
+	resourceSubscriber.AddCloseFunc(closeRaClient)
+
+	isClient, closeIsClient, err := newIdentityStoreClient(config.Clients.IdentityStore, fileWatcher, logger, tracerProvider)
+	if err != nil {
+		resourceSubscriber.Close()
+		return nil, fmt.Errorf("cannot create identity-store client: %w", err)
+	}
+	resourceSubscriber.AddCloseFunc(closeIsClient)
+
+	rdClient, closeRdClient, err := newResourceDirectoryClient(config.Clients.ResourceDirectory, fileWatcher, logger, tracerProvider)
+	if err != nil {
+		resourceSubscriber.Close()
+		return nil, fmt.Errorf("cannot create resource-directory client: %w", err)
+	}
+	resourceSubscriber.AddCloseFunc(closeRdClient)
+
+	tokenCache, err := clientcredentials.New(ctx, config.APIs.LwM2M.Authorization.Provider, fileWatcher, logger, tracerProvider, time.Minute)
+	if err != nil {
+		resourceSubscriber.Close()
+		return nil, fmt.Errorf("cannot create token cache: %w", err)
+	}
+	resourceSubscriber.AddCloseFunc(tokenCache.Close)
+
+	s := Service{
+		ctx:                ctx,
+		instanceID:         uuid.New(),
+		tracerProvider:     tracerProvider,
+		logger:             logger,
+		isClient:           isClient,
+		rdClient:           rdClient,
+		raClient:           raClient,
+		resourceSubscriber: resourceSubscriber,
+		tokenCache:         tokenCache,
+		taskQueue:          queue,
+		messagePool:        pool.New(config.APIs.LwM2M.MessagePoolSize, 1024),
+		registrations:      newRegistrationsCache(ctx, time.Second),
+		config:             config,
+	}
+
+	ss, err := s.createServices(fileWatcher, logger, tracerProvider)
+	if err != nil {
+		resourceSubscriber.Close()
+		return nil, fmt.Errorf("cannot create services: %w", err)
+	}
+	ss.AddCloseFunc(resourceSubscriber.Close)
+	return ss, nil
+}
+
+// getToken returns the access token of the owner of the devices, which is valid at least for the timeout of the requests.
+func (s *Service) getToken(ctx context.Context) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.config.APIs.LwM2M.Timeout)
+	defer cancel()
+	authorization := s.config.APIs.LwM2M.Authorization
+	token, err := s.tokenCache.GetToken(ctx, authorization.Owner, map[string]string{
+		authorization.OwnerClaim: authorization.Owner,
+	}, map[string]interface{}{
+		authorization.OwnerClaim: authorization.Owner,
+	})
+	if err != nil {
+		return "", fmt.Errorf("cannot get token of owner('%v'): %w", authorization.Owner, err)
+	}
+	return token.AccessToken, nil
+}
+
+// ctxWithToken returns the context with the access token of the owner of the devices for the requests to the hub.
+func (s *Service) ctxWithToken(ctx context.Context) (context.Context, error) {
+	token, err := s.getToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return kitNetGrpc.CtxWithToken(ctx, token), nil
+}
+
+func statusErrorf(code coapCodes.Code, fmt string, args ...interface{}) error {
+	msg := pool.NewMessage(context.Background())
+	msg.SetCode(code)
+	return status.Errorf(msg, fmt, args...)
+}
+
+const sessionKey = "session"
+
+func getSession(conn mux.Conn) (*session, bool) {
+	s, ok := conn.Context().Value(sessionKey).(*session)
+	return s, ok
+}
+
+func (s *Service) onNewConnection(conn mux.Conn) {
+	client := newSession(s, conn)
+	conn.SetContextValue(sessionKey, client)
+	conn.AddOnClose(client.OnClose)
+}
+
+func (s *Service) processRequest(w mux.ResponseWriter, req *mux.Message, handler func(req *mux.Message, client *session) (*pool.Message, error)) {
+	client, ok := getSession(w.Conn())
+	if !ok {
+		s.logger.Errorf("cannot handle request from %v: session not found", w.Conn().RemoteAddr())
+		return
+	}
+	// the requests are processed by the task queue, because the handlers send requests to the client and to the hub
+	req.Hijack()
+	err := s.taskQueue.Submit(func() {
+		resp, err := handler(req, client)
+		if err != nil {
+			resp = client.createErrorResponse(err, req.Token())
+		}
+		if resp == nil {
+			// the response was already sent by the handler
+			return
+		}
+		client.logRequestResponse(req, resp, err)
+		defer s.messagePool.ReleaseMessage(resp)
+		if errW := w.Conn().WriteMessage(resp); errW != nil {
+			client.Errorf("cannot write response: %w", errW)
+		}
+	})
+	if err != nil {
+		client.Errorf("cannot handle request by task queue: %w", err)
+		client.Close()
+	}
+}
+
+func registrationHandler(req *mux.Message, client *session) (*pool.Message, error) {
+	path, _ := req.Options().Path()
+	if path == uri.RegistrationPath {
+		if req.Code() != coapCodes.POST {
+			return nil, statusErrorf(coapCodes.MethodNotAllowed, "unsupported method %v", req.Code())
+		}
+		return client.register(req)
+	}
+	registrationID := strings.TrimPrefix(path, uri.RegistrationPath+"/")
+	if registrationID == path || registrationID == "" || strings.Contains(registrationID, "/") {
+		return nil, statusErrorf(coapCodes.NotFound, "unknown path %v", path)
+	}
+	switch req.Code() {
+	case coapCodes.POST:
+		return client.updateRegistration(req, registrationID)
+	case coapCodes.DELETE:
+		return client.deregister(req, registrationID)
+	default:
+		return nil, statusErrorf(coapCodes.MethodNotAllowed, "unsupported method %v", req.Code())
+	}
+}
+
+// createServices setup services for lwm2m-gateway.
+func (s *Service) createServices(fileWatcher *fsnotify.Watcher, logger log.Logger, tracerProvider trace.TracerProvider) (*service.Service, error) {
+	m := mux.NewRouter()
+	m.DefaultHandle(mux.HandlerFunc(func(w mux.ResponseWriter, r *mux.Message) {
+		s.processRequest(w, r, registrationHandler)
+	}))
+
+	services, err := coapService.New(s.ctx, s.config.APIs.LwM2M.Config, m, fileWatcher, logger, tracerProvider,
+		coapService.WithOnNewConnection(s.onNewConnection),
+		coapService.WithMessagePool(s.messagePool),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create lwm2m-gateway service: %w", err)
+	}
+	serviceHeartbeat, err := raClient.NewServiceHeartbeat(s.instanceID, s.config.ServiceHeartbeat.TimeToLive, s.raClient, logger, services)
+	if err != nil {
+		_ = services.Close()
+		return nil, fmt.Errorf("cannot create service heartbeat: %w", err)
+	}
+	services.Add(serviceHeartbeat)
+	return services, nil
+}
@@ -0,0 +1,291 @@
+package service
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"io"
+	"math/big"
+	"net"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/plgd-dev/go-coap/v3/message/codes"
+	"github.com/plgd-dev/go-coap/v3/message/pool"
+	"github.com/plgd-dev/go-coap/v3/mux"
+	pbGRPC "github.com/plgd-dev/hub/v2/grpc-gateway/pb"
+	pbIS "github.com/plgd-dev/hub/v2/identity-store/pb"
+	"github.com/plgd-dev/hub/v2/pkg/log"
+	"github.com/plgd-dev/hub/v2/pkg/sync/task/queue"
+	raClient "github.com/plgd-dev/hub/v2/resource-aggregate/client"
+	eventbusConfig "github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/config"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+	"golang.org/x/oauth2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+// fakeGrpcConn records the requests to the hub. The unary calls are answered by the empty responses and the streams
+// return the messages configured by the name of the method.
+type fakeGrpcConn struct {
+	lock     sync.Mutex
+	requests map[string][]proto.Message
+	streams  map[string][]proto.Message
+}
+
+func newFakeGrpcConn() *fakeGrpcConn {
+	return &fakeGrpcConn{
+		requests: make(map[string][]proto.Message),
+		streams:  make(map[string][]proto.Message),
+	}
+}
+
+func methodName(fullMethod string) string {
+	return fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+}
+
+func (c *fakeGrpcConn) Invoke(_ context.Context, method string, args, _ any, _ ...grpc.CallOption) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	name := methodName(method)
+	c.requests[name] = append(c.requests[name], proto.Clone(args.(proto.Message)))
+	return nil
+}
+
+func (c *fakeGrpcConn) NewStream(ctx context.Context, _ *grpc.StreamDesc, method string, _ ...grpc.CallOption) (grpc.ClientStream, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return &fakeClientStream{ctx: ctx, items: slices.Clone(c.streams[methodName(method)])}, nil
+}
+
+func (c *fakeGrpcConn) setStream(method string, items ...proto.Message) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.streams[method] = items
+}
+
+func (c *fakeGrpcConn) getRequests(method string) []proto.Message {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return slices.Clone(c.requests[method])
+}
+
+func (c *fakeGrpcConn) reset() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.requests = make(map[string][]proto.Message)
+}
+
+type fakeClientStream struct {
+	ctx   context.Context
+	items []proto.Message
+}
+
+func (s *fakeClientStream) Header() (metadata.MD, error) {
+	return nil, nil
+}
+
+func (s *fakeClientStream) Trailer() metadata.MD {
+	return nil
+}
+
+func (s *fakeClientStream) CloseSend() error {
+	return nil
+}
+
+func (s *fakeClientStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeClientStream) SendMsg(any) error {
+	return nil
+}
+
+func (s *fakeClientStream) RecvMsg(m any) error {
+	if len(s.items) == 0 {
+		return io.EOF
+	}
+	proto.Merge(m.(proto.Message), s.items[0])
+	s.items = s.items[1:]
+	return nil
+}
+
+type fakeTokenCache struct{}
+
+func (fakeTokenCache) GetToken(context.Context, string, map[string]string, map[string]interface{}) (*oauth2.Token, error) {
+	return &oauth2.Token{AccessToken: "token"}, nil
+}
+
+type writtenMessage struct {
+	code         codes.Code
+	locationPath string
+}
+
+// fakeCoapConn is the connection of the LwM2M client, the requests sent to the client are answered by the do function.
+type fakeCoapConn struct {
+	mux.Conn
+	ctx     context.Context
+	cancel  context.CancelFunc
+	netConn net.Conn
+	do      func(req *pool.Message) (*pool.Message, error)
+
+	lock    sync.Mutex
+	written []writtenMessage
+	closed  bool
+}
+
+func (c *fakeCoapConn) RemoteAddr() net.Addr {
+	return &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 5684}
+}
+
+func (c *fakeCoapConn) NetConn() net.Conn {
+	return c.netConn
+}
+
+func (c *fakeCoapConn) Context() context.Context {
+	return c.ctx
+}
+
+func (c *fakeCoapConn) Sequence() uint64 {
+	return 0
+}
+
+func (c *fakeCoapConn) Done() <-chan struct{} {
+	return c.ctx.Done()
+}
+
+func (c *fakeCoapConn) WriteMessage(m *pool.Message) error {
+	locationPath, _ := m.Options().LocationPath()
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.written = append(c.written, writtenMessage{code: m.Code(), locationPath: locationPath})
+	return nil
+}
+
+func (c *fakeCoapConn) Do(req *pool.Message) (*pool.Message, error) {
+	return c.do(req)
+}
+
+func (c *fakeCoapConn) Close() error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.closed = true
+	c.cancel()
+	return nil
+}
+
+func (c *fakeCoapConn) getWritten() []writtenMessage {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return slices.Clone(c.written)
+}
+
+func (c *fakeCoapConn) isClosed() bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.closed
+}
+
+func newTestService(t *testing.T) (*Service, *fakeGrpcConn) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	logger := log.Get()
+	subscriber, err := eventbusConfig.NewSubscriber(eventbusConfig.ConfigSubscriber{Use: eventbusConfig.InProcess}, nil, logger, noop.NewTracerProvider())
+	require.NoError(t, err)
+	t.Cleanup(subscriber.Close)
+	q, err := queue.New(queue.Config{GoPoolSize: 4, Size: 16})
+	require.NoError(t, err)
+	t.Cleanup(q.Release)
+
+	var cfg Config
+	cfg.APIs.LwM2M.MaxLifetime = time.Hour
+	cfg.APIs.LwM2M.Timeout = time.Second * 5
+	cfg.APIs.LwM2M.Authorization.OwnerClaim = "sub"
+	cfg.APIs.LwM2M.Authorization.Owner = "owner"
+	conn := newFakeGrpcConn()
+	return &Service{
+		ctx:                ctx,
+		instanceID:         uuid.New(),
+		tracerProvider:     noop.NewTracerProvider(),
+		logger:             logger,
+		isClient:           pbIS.NewIdentityStoreClient(conn),
+		rdClient:           pbGRPC.NewGrpcGatewayClient(conn),
+		raClient:           raClient.New(conn, subscriber),
+		resourceSubscriber: subscriber,
+		tokenCache:         fakeTokenCache{},
+		taskQueue:          q,
+		messagePool:        pool.New(0, 1024),
+		registrations:      newRegistrationsCache(ctx, time.Second),
+		config:             cfg,
+	}, conn
+}
+
+func newTestSession(t *testing.T, s *Service, netConn net.Conn) (*session, *fakeCoapConn) {
+	ctx, cancel := context.WithCancel(context.Background())
+	coapConn := &fakeCoapConn{
+		ctx:     ctx,
+		cancel:  cancel,
+		netConn: netConn,
+		do: func(*pool.Message) (*pool.Message, error) {
+			return nil, context.DeadlineExceeded
+		},
+	}
+	c := newSession(s, coapConn)
+	t.Cleanup(func() {
+		c.cleanUp()
+		cancel()
+	})
+	return c, coapConn
+}
+
+func newCertificate(t *testing.T, commonName string) tls.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	require.NoError(t, err)
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+}
+
+// newTLSConn returns the server side of the TLS connection of the client with the certificate of the common name.
+func newTLSConn(t *testing.T, commonName string) *tls.Conn {
+	serverConn, clientConn := net.Pipe()
+	server := tls.Server(serverConn, &tls.Config{
+		Certificates:           []tls.Certificate{newCertificate(t, "lwm2m-gateway")},
+		ClientAuth:             tls.RequireAnyClientCert,
+		SessionTicketsDisabled: true,
+		MinVersion:             tls.VersionTLS12,
+	})
+	client := tls.Client(clientConn, &tls.Config{
+		Certificates:       []tls.Certificate{newCertificate(t, commonName)},
+		InsecureSkipVerify: true, //nolint:gosec
+		MinVersion:         tls.VersionTLS12,
+	})
+	t.Cleanup(func() {
+		// tls.Conn.Close waits for the close notification to be written, but nobody reads the pipe
+		_ = clientConn.Close()
+		_ = serverConn.Close()
+	})
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- client.Handshake()
+	}()
+	require.NoError(t, server.Handshake())
+	require.NoError(t, <-errCh)
+	return server
+}
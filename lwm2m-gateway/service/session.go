@@ -0,0 +1,259 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/pion/dtls/v3"
+	"github.com/plgd-dev/go-coap/v3/message"
+	"github.com/plgd-dev/go-coap/v3/message/codes"
+	"github.com/plgd-dev/go-coap/v3/message/pool"
+	"github.com/plgd-dev/go-coap/v3/message/status"
+	"github.com/plgd-dev/go-coap/v3/mux"
+	coapgwMessage "github.com/plgd-dev/hub/v2/coap-gateway/service/message"
+	grpcClient "github.com/plgd-dev/hub/v2/grpc-gateway/client"
+	"github.com/plgd-dev/hub/v2/lwm2m-gateway/lwm2m"
+	"github.com/plgd-dev/hub/v2/pkg/log"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/commands"
+)
+
+// session of the connection of the LwM2M client.
+type session struct {
+	coapConn mux.Conn
+	server   *Service
+	private  struct { // guarded by mutex
+		mutex            sync.Mutex
+		registrationID   string
+		registration     *lwm2m.Registration
+		deviceSubscriber *grpcClient.DeviceSubscriber
+		twinEnabled      bool
+		observations     map[string]mux.Observation
+	}
+}
+
+// newSession creates and initializes session
+func newSession(server *Service, coapConn mux.Conn) *session {
+	return &session{
+		server:   server,
+		coapConn: coapConn,
+	}
+}
+
+func (c *session) getRegistration() (string, *lwm2m.Registration) {
+	c.private.mutex.Lock()
+	defer c.private.mutex.Unlock()
+	return c.private.registrationID, c.private.registration
+}
+
+func (c *session) setRegistration(registrationID string, registration *lwm2m.Registration) {
+	c.private.mutex.Lock()
+	defer c.private.mutex.Unlock()
+	c.private.registrationID = registrationID
+	c.private.registration = registration
+}
+
+func (c *session) deviceID() string {
+	_, r := c.getRegistration()
+	if r == nil {
+		return ""
+	}
+	return r.DeviceID()
+}
+
+func (c *session) isTwinEnabled() bool {
+	c.private.mutex.Lock()
+	defer c.private.mutex.Unlock()
+	return c.private.twinEnabled
+}
+
+func (c *session) setTwinEnabled(twinEnabled bool) bool {
+	c.private.mutex.Lock()
+	defer c.private.mutex.Unlock()
+	previous := c.private.twinEnabled
+	c.private.twinEnabled = twinEnabled
+	return previous
+}
+
+func (c *session) replaceDeviceSubscriber(deviceSubscriber *grpcClient.DeviceSubscriber) *grpcClient.DeviceSubscriber {
+	c.private.mutex.Lock()
+	defer c.private.mutex.Unlock()
+	s := c.private.deviceSubscriber
+	c.private.deviceSubscriber = deviceSubscriber
+	return s
+}
+
+func (c *session) closeDeviceSubscriber() error {
+	s := c.replaceDeviceSubscriber(nil)
+	if s == nil {
+		return nil
+	}
+	return s.Close()
+}
+
+func (c *session) GetApplicationProtocol() commands.Connection_Protocol {
+	switch c.coapConn.NetConn().(type) {
+	case *dtls.Conn:
+		return commands.Connection_COAPS
+	case *net.UDPConn:
+		return commands.Connection_COAP
+	}
+	return commands.Connection_UNKNOWN
+}
+
+func (c *session) RemoteAddr() net.Addr {
+	return c.coapConn.RemoteAddr()
+}
+
+func (c *session) Context() context.Context {
+	return c.coapConn.Context()
+}
+
+// GetContext returns the context with the token of the owner, it is used by the device subscriber.
+func (c *session) GetContext() (context.Context, context.CancelFunc) {
+	ctx, err := c.server.ctxWithToken(c.Context())
+	if err != nil {
+		c.Errorf("cannot get token: %w", err)
+		return c.Context(), func() {
+			// no-op
+		}
+	}
+	return ctx, func() {
+		// no-op
+	}
+}
+
+func (c *session) newCommandMetadata() *commands.CommandMetadata {
+	return &commands.CommandMetadata{
+		ConnectionId: c.RemoteAddr().String(),
+		Sequence:     c.coapConn.Sequence(),
+	}
+}
+
+// Close closes coap connection
+func (c *session) Close() {
+	if err := c.coapConn.Close(); err != nil {
+		c.Errorf("cannot close client: %w", err)
+	}
+}
+
+// cleanUp cancels the observations and the device subscription of the registration. It returns the removed registration.
+func (c *session) cleanUp() *lwm2m.Registration {
+	registrationID, registration := c.getRegistration()
+	if registration == nil {
+		return nil
+	}
+	c.setRegistration("", nil)
+	c.server.registrations.Delete(registrationID)
+	c.cancelObservations()
+	if err := c.closeDeviceSubscriber(); err != nil {
+		c.Errorf("cleanUp error: failed to close device %v subscription: %w", registration.DeviceID(), err)
+	}
+	return registration
+}
+
+func (c *session) setDeviceOffline(ctx context.Context, deviceID string) {
+	ctx, cancel := context.WithTimeout(ctx, c.server.config.APIs.LwM2M.Timeout)
+	defer cancel()
+	ctx, err := c.server.ctxWithToken(ctx)
+	if err == nil {
+		_, err = c.server.raClient.UpdateDeviceMetadata(ctx, &commands.UpdateDeviceMetadataRequest{
+			DeviceId: deviceID,
+			Update: &commands.UpdateDeviceMetadataRequest_Connection{
+				Connection: &commands.Connection{
+					Status: commands.Connection_OFFLINE,
+				},
+			},
+			CommandMetadata: c.newCommandMetadata(),
+		})
+	}
+	if err != nil {
+		// Device will be still reported as online and it can fix his state by next calls online, offline commands.
+		c.Errorf("cannot update device %v status to offline: %w", deviceID, err)
+	}
+}
+
+// OnClose is invoked when the coap connection was closed.
+func (c *session) OnClose() {
+	registration := c.cleanUp()
+	if registration == nil {
+		return
+	}
+	c.Debugf("close device connection")
+	c.setDeviceOffline(context.Background(), registration.DeviceID())
+}
+
+func (c *session) createErrorResponse(err error, token message.Token) *pool.Message {
+	if err == nil {
+		return nil
+	}
+	s, ok := status.FromError(err)
+	code := codes.BadRequest
+	if ok {
+		code = s.Code()
+	}
+	msg := c.server.messagePool.AcquireMessage(c.Context())
+	msg.SetCode(code)
+	msg.SetToken(token)
+	// Don't set content format for diagnostic message: https://tools.ietf.org/html/rfc7252#section-5.5.2
+	msg.SetBody(bytes.NewReader([]byte(err.Error())))
+	return msg
+}
+
+func (c *session) getLogger() log.Logger {
+	logger := c.server.logger
+	if deviceID := c.deviceID(); deviceID != "" {
+		logger = logger.With(log.DeviceIDKey, deviceID)
+	}
+	return logger.With("remoteAddr", c.RemoteAddr().String())
+}
+
+func (c *session) Errorf(fmt string, args ...interface{}) {
+	c.getLogger().Errorf(fmt, args...)
+}
+
+func (c *session) Debugf(fmt string, args ...interface{}) {
+	c.getLogger().Debugf(fmt, args...)
+}
+
+func (c *session) logRequestResponse(req *mux.Message, resp *pool.Message, err error) {
+	logger := c.getLogger().With(log.ProtocolKey, "LWM2M")
+	if req != nil {
+		logger = logger.With(log.RequestKey, coapgwMessage.ToJson(req.Message, c.server.config.Log.DumpBody, false))
+	}
+	if err != nil {
+		logger = logger.With(log.ErrorKey, err.Error())
+	}
+	if resp == nil {
+		logger.Debug("finished unary call from the device")
+		return
+	}
+	logger = logger.With(log.ResponseKey, coapgwMessage.ToJson(resp, c.server.config.Log.DumpBody, false))
+	msg := fmt.Sprintf("finished unary call from the device with code %v", resp.Code())
+	if resp.Code() >= codes.BadRequest {
+		logger.Warn(msg)
+		return
+	}
+	logger.Debug(msg)
+}
+
+// do sends the request to the client and waits for the response.
+func (c *session) do(req *pool.Message, correlationID string) (*pool.Message, error) {
+	t := time.Now()
+	resp, err := c.coapConn.Do(req)
+	logger := c.getLogger().With(log.ProtocolKey, "LWM2M", log.StartTimeKey, t, log.DurationMSKey, log.DurationToMilliseconds(time.Since(t)),
+		log.RequestKey, coapgwMessage.ToJson(req, c.server.config.Log.DumpBody, true))
+	if correlationID != "" {
+		logger = logger.With(log.CorrelationIDKey, correlationID)
+	}
+	if err != nil {
+		_ = logger.LogAndReturnError(fmt.Errorf("finished unary call to the device with error: %w", err))
+		return nil, err
+	}
+	logger.With(log.ResponseKey, coapgwMessage.ToJson(resp, c.server.config.Log.DumpBody, false)).
+		Debugf("finished unary call to the device with code %v", resp.Code())
+	return resp, nil
+}
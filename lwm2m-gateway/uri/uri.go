@@ -0,0 +1,8 @@
+package uri
+
+// LwM2M Gateway URIs.
+const (
+	// RegistrationPath is the path of the Register operation, the Update and the Deregister operations
+	// use the location returned by the Register operation.
+	RegistrationPath = "/rd"
+)
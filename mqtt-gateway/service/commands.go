@@ -0,0 +1,322 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/eclipse/paho.mqtt.golang/packets"
+	"github.com/plgd-dev/go-coap/v3/message"
+	"github.com/plgd-dev/hub/v2/coap-gateway/coapconv"
+	grpcClient "github.com/plgd-dev/hub/v2/grpc-gateway/client"
+	"github.com/plgd-dev/hub/v2/mqtt-gateway/topic"
+	pkgTime "github.com/plgd-dev/hub/v2/pkg/time"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/commands"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/events"
+	"github.com/plgd-dev/kit/v2/codec/cbor"
+)
+
+// commandResponse is the result of the command published by the device to the response topic.
+type commandResponse struct {
+	status  commands.Status
+	content *commands.Content
+}
+
+func newErrorResponse(status commands.Status, err error) commandResponse {
+	return commandResponse{
+		status: status,
+		content: &commands.Content{
+			Data:              []byte(err.Error()),
+			ContentType:       message.TextPlain.String(),
+			CoapContentFormat: int32(message.TextPlain),
+		},
+	}
+}
+
+func newEmptyResponse(status commands.Status) commandResponse {
+	return commandResponse{
+		status:  status,
+		content: newJSONContent(nil),
+	}
+}
+
+// toJSON converts the content of the command to the JSON payload of the MQTT publish.
+func toJSON(content *commands.Content) ([]byte, error) {
+	if len(content.GetData()) == 0 {
+		return nil, nil
+	}
+	mediaType, err := coapconv.MakeMediaType(content.GetCoapContentFormat(), content.GetContentType())
+	if err != nil {
+		return nil, err
+	}
+	switch mediaType {
+	case message.AppJSON:
+		return content.GetData(), nil
+	case message.AppCBOR, message.AppOcfCbor:
+		v, err := cbor.ToJSON(content.GetData())
+		if err != nil {
+			return nil, fmt.Errorf("cannot convert content to JSON: %w", err)
+		}
+		return []byte(v), nil
+	}
+	return nil, fmt.Errorf("unsupported content type %v", mediaType)
+}
+
+func (c *session) addPendingCommand(correlationID string) (chan commandResponse, error) {
+	c.private.mutex.Lock()
+	defer c.private.mutex.Unlock()
+	if _, ok := c.private.pendingCommands[correlationID]; ok {
+		return nil, fmt.Errorf("command with correlationID('%v') is already pending", correlationID)
+	}
+	ch := make(chan commandResponse, 1)
+	c.private.pendingCommands[correlationID] = ch
+	return ch, nil
+}
+
+func (c *session) removePendingCommand(correlationID string) {
+	c.private.mutex.Lock()
+	defer c.private.mutex.Unlock()
+	delete(c.private.pendingCommands, correlationID)
+}
+
+// deliverCommandResponse passes the response published by the device to the pending command.
+func (c *session) deliverCommandResponse(correlationID string, status commands.Status, payload []byte) error {
+	if len(payload) > 0 && !json.Valid(payload) {
+		return errors.New("content is not valid JSON")
+	}
+	c.private.mutex.Lock()
+	ch, ok := c.private.pendingCommands[correlationID]
+	delete(c.private.pendingCommands, correlationID)
+	c.private.mutex.Unlock()
+	if !ok {
+		return fmt.Errorf("command with correlationID('%v') is not pending", correlationID)
+	}
+	ch <- commandResponse{
+		status:  status,
+		content: newJSONContent(payload),
+	}
+	return nil
+}
+
+// newCommandPacket creates the PUBLISH packet of the command. The device of MQTT 5 gets the correlation ID
+// also in the Correlation Data property.
+func (c *session) newCommandPacket(topicName, correlationID string, payload []byte) (packetWriter, error) {
+	c.private.mutex.Lock()
+	protocolVersion := c.private.protocolVersion
+	maximumPacketSize := c.private.maximumPacketSize
+	c.private.mutex.Unlock()
+	if protocolVersion != protocolVersion5 {
+		p := packets.NewControlPacket(packets.Publish).(*packets.PublishPacket)
+		p.TopicName = topicName
+		p.Payload = payload
+		return p, nil
+	}
+	p := newPublishV5(topicName, []byte(correlationID), payload)
+	if maximumPacketSize > 0 && len(p) > int(maximumPacketSize) {
+		return nil, fmt.Errorf("packet size(%v) exceeds the maximum packet size(%v) of the device", len(p), maximumPacketSize)
+	}
+	return p, nil
+}
+
+// sendCommand publishes the command to the device and waits for the response of the device.
+func (c *session) sendCommand(ctx context.Context, method topic.Method, resourceID *commands.ResourceId, correlationID string, content *commands.Content) commandResponse {
+	t := topic.Command(resourceID.GetDeviceId(), method, correlationID, resourceID.GetHref())
+	if !c.isSubscribed(t) {
+		return newErrorResponse(commands.Status_UNAVAILABLE, fmt.Errorf("device is not subscribed to topic('%v')", t))
+	}
+	payload, err := toJSON(content)
+	if err != nil {
+		return newErrorResponse(commands.Status_BAD_REQUEST, err)
+	}
+	ch, err := c.addPendingCommand(correlationID)
+	if err != nil {
+		return newErrorResponse(commands.Status_BAD_REQUEST, err)
+	}
+	defer c.removePendingCommand(correlationID)
+
+	p, err := c.newCommandPacket(t, correlationID, payload)
+	if err != nil {
+		return newErrorResponse(commands.Status_BAD_REQUEST, err)
+	}
+	if err = c.writePacket(p); err != nil {
+		return newErrorResponse(commands.Status_UNAVAILABLE, fmt.Errorf("cannot publish command: %w", err))
+	}
+	timer := time.NewTimer(c.server.config.APIs.MQTT.Timeout)
+	defer timer.Stop()
+	select {
+	case resp := <-ch:
+		return resp
+	case <-timer.C:
+		return newErrorResponse(commands.Status_UNAVAILABLE, errors.New("timeout"))
+	case <-ctx.Done():
+		return newErrorResponse(commands.Status_UNAVAILABLE, ctx.Err())
+	case <-c.Context().Done():
+		return newErrorResponse(commands.Status_UNAVAILABLE, errors.New("connection was closed"))
+	}
+}
+
+func (c *session) ctxToConfirm(ctx context.Context) (context.Context, error) {
+	if c.deviceID() == "" {
+		return nil, errors.New("device is not connected")
+	}
+	return c.ctxWithToken(ctx), nil
+}
+
+func (c *session) UpdateResource(ctx context.Context, event *events.ResourceUpdatePending) error {
+	sendConfirmCtx, err := c.ctxToConfirm(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot update resource /%v%v: %w", event.GetResourceId().GetDeviceId(), event.GetResourceId().GetHref(), err)
+	}
+	var resp commandResponse
+	if event.GetResourceId().GetHref() == commands.StatusHref {
+		resp = newEmptyResponse(commands.Status_METHOD_NOT_ALLOWED)
+	} else {
+		resp = c.sendCommand(ctx, topic.MethodUpdate, event.GetResourceId(), event.GetAuditContext().GetCorrelationId(), event.GetContent())
+	}
+	_, err = c.server.raClient.ConfirmResourceUpdate(sendConfirmCtx, &commands.ConfirmResourceUpdateRequest{
+		ResourceId:      event.GetResourceId(),
+		CorrelationId:   event.GetAuditContext().GetCorrelationId(),
+		Status:          resp.status,
+		Content:         resp.content,
+		CommandMetadata: c.newCommandMetadata(),
+	})
+	return err
+}
+
+func (c *session) RetrieveResource(ctx context.Context, event *events.ResourceRetrievePending) error {
+	sendConfirmCtx, err := c.ctxToConfirm(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot retrieve resource /%v%v: %w", event.GetResourceId().GetDeviceId(), event.GetResourceId().GetHref(), err)
+	}
+	var resp commandResponse
+	if event.GetResourceId().GetHref() == commands.StatusHref {
+		resp = newEmptyResponse(commands.Status_OK)
+	} else {
+		resp = c.sendCommand(ctx, topic.MethodRetrieve, event.GetResourceId(), event.GetAuditContext().GetCorrelationId(), nil)
+	}
+	_, err = c.server.raClient.ConfirmResourceRetrieve(sendConfirmCtx, &commands.ConfirmResourceRetrieveRequest{
+		ResourceId:      event.GetResourceId(),
+		CorrelationId:   event.GetAuditContext().GetCorrelationId(),
+		Status:          resp.status,
+		Content:         resp.content,
+		CommandMetadata: c.newCommandMetadata(),
+	})
+	return err
+}
+
+func (c *session) DeleteResource(ctx context.Context, event *events.ResourceDeletePending) error {
+	sendConfirmCtx, err := c.ctxToConfirm(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot delete resource /%v%v: %w", event.GetResourceId().GetDeviceId(), event.GetResourceId().GetHref(), err)
+	}
+	var resp commandResponse
+	if event.GetResourceId().GetHref() == commands.StatusHref {
+		resp = newEmptyResponse(commands.Status_FORBIDDEN)
+	} else {
+		resp = c.sendCommand(ctx, topic.MethodDelete, event.GetResourceId(), event.GetAuditContext().GetCorrelationId(), nil)
+	}
+	_, err = c.server.raClient.ConfirmResourceDelete(sendConfirmCtx, &commands.ConfirmResourceDeleteRequest{
+		ResourceId:      event.GetResourceId(),
+		CorrelationId:   event.GetAuditContext().GetCorrelationId(),
+		Status:          resp.status,
+		Content:         resp.content,
+		CommandMetadata: c.newCommandMetadata(),
+	})
+	return err
+}
+
+func (c *session) CreateResource(ctx context.Context, event *events.ResourceCreatePending) error {
+	sendConfirmCtx, err := c.ctxToConfirm(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot create resource /%v%v: %w", event.GetResourceId().GetDeviceId(), event.GetResourceId().GetHref(), err)
+	}
+	var resp commandResponse
+	if event.GetResourceId().GetHref() == commands.StatusHref {
+		resp = newEmptyResponse(commands.Status_FORBIDDEN)
+	} else {
+		resp = c.sendCommand(ctx, topic.MethodCreate, event.GetResourceId(), event.GetAuditContext().GetCorrelationId(), event.GetContent())
+	}
+	_, err = c.server.raClient.ConfirmResourceCreate(sendConfirmCtx, &commands.ConfirmResourceCreateRequest{
+		ResourceId:      event.GetResourceId(),
+		CorrelationId:   event.GetAuditContext().GetCorrelationId(),
+		Status:          resp.status,
+		Content:         resp.content,
+		CommandMetadata: c.newCommandMetadata(),
+	})
+	return err
+}
+
+func (c *session) UpdateDeviceMetadata(ctx context.Context, event *events.DeviceMetadataUpdatePending) error {
+	switch event.GetUpdatePending().(type) {
+	case *events.DeviceMetadataUpdatePending_TwinEnabled:
+	case *events.DeviceMetadataUpdatePending_TwinForceSynchronization:
+	default:
+		return nil
+	}
+	sendConfirmCtx, err := c.ctxToConfirm(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot update device('%v') metadata: %w", event.GetDeviceId(), err)
+	}
+	r := &commands.ConfirmDeviceMetadataUpdateRequest{
+		DeviceId:        event.GetDeviceId(),
+		CorrelationId:   event.GetAuditContext().GetCorrelationId(),
+		CommandMetadata: c.newCommandMetadata(),
+		Status:          commands.Status_OK,
+	}
+	if event.GetTwinForceSynchronization() {
+		// the device publishes the contents of the resources on its own, so the next publishes synchronize the twin
+		r.Confirm = &commands.ConfirmDeviceMetadataUpdateRequest_TwinForceSynchronization{
+			TwinForceSynchronization: true,
+		}
+	} else {
+		r.Confirm = &commands.ConfirmDeviceMetadataUpdateRequest_TwinEnabled{
+			TwinEnabled: event.GetTwinEnabled(),
+		}
+	}
+	if _, err = c.server.raClient.ConfirmDeviceMetadataUpdate(sendConfirmCtx, r); err != nil {
+		return fmt.Errorf("cannot update device('%v') metadata: %w", event.GetDeviceId(), err)
+	}
+	if !event.GetTwinForceSynchronization() {
+		c.setTwinEnabled(event.GetTwinEnabled())
+	}
+	return nil
+}
+
+func (c *session) OnDeviceSubscriberReconnectError(err error) {
+	c.Errorf("cannot reconnect device %v subscriber to resource directory or eventbus - closing the device connection: %w", c.deviceID(), err)
+	c.Close()
+}
+
+func (c *session) setNewDeviceSubscriber(ctx context.Context, deviceID string) error {
+	c.private.mutex.Lock()
+	owner := c.private.owner
+	c.private.mutex.Unlock()
+	timeout := c.server.config.APIs.MQTT.Timeout
+	deviceSubscriber, err := grpcClient.NewDeviceSubscriber(c.GetContext, owner, deviceID,
+		func() func() (when time.Time, err error) {
+			var count uint64
+			delayFn := pkgTime.GetRandomDelayGenerator(timeout / 4)
+			return func() (when time.Time, err error) {
+				count++
+				next := time.Now().Add(timeout + delayFn())
+				c.Debugf("next iteration %v of retrying reconnect to grpc-client will be at %v", count, next)
+				return next, nil
+			}
+		}, c.server.rdClient, c.server.resourceSubscriber, c.server.tracerProvider)
+	if err != nil {
+		return fmt.Errorf("cannot create device subscription for device %v: %w", deviceID, err)
+	}
+	c.private.mutex.Lock()
+	old := c.private.deviceSubscriber
+	c.private.deviceSubscriber = deviceSubscriber
+	c.private.mutex.Unlock()
+	if old != nil {
+		if err = old.Close(); err != nil {
+			c.Errorf("failed to close replaced device subscriber: %w", err)
+		}
+	}
+	deviceSubscriber.SubscribeToPendingCommands(ctx, grpcClient.NewDeviceSubscriptionHandlers(c))
+	return nil
+}
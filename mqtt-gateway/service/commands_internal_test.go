@@ -0,0 +1,62 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/plgd-dev/go-coap/v3/message"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/commands"
+	"github.com/plgd-dev/kit/v2/codec/cbor"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToJSON(t *testing.T) {
+	data, err := cbor.Encode(map[string]interface{}{"state": true})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		content *commands.Content
+		want    []byte
+		wantErr bool
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name: "json",
+			content: &commands.Content{
+				Data:              []byte(`{"state":true}`),
+				CoapContentFormat: int32(message.AppJSON),
+			},
+			want: []byte(`{"state":true}`),
+		},
+		{
+			name: "cbor",
+			content: &commands.Content{
+				Data:              data,
+				ContentType:       message.AppOcfCbor.String(),
+				CoapContentFormat: -1,
+			},
+			want: []byte(`{"state":true}`),
+		},
+		{
+			name: "unsupported",
+			content: &commands.Content{
+				Data:              []byte("text"),
+				CoapContentFormat: int32(message.TextPlain),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := toJSON(tt.content)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
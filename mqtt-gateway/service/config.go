@@ -0,0 +1,168 @@
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/plgd-dev/hub/v2/pkg/config"
+	"github.com/plgd-dev/hub/v2/pkg/log"
+	"github.com/plgd-dev/hub/v2/pkg/net/grpc/client"
+	"github.com/plgd-dev/hub/v2/pkg/net/listener"
+	otelClient "github.com/plgd-dev/hub/v2/pkg/opentelemetry/collector/client"
+	"github.com/plgd-dev/hub/v2/pkg/security/jwt/validator"
+	"github.com/plgd-dev/hub/v2/pkg/sync/task/queue"
+	eventbusConfig "github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/config"
+)
+
+// Config represent application configuration
+type Config struct {
+	Log              LogConfig              `yaml:"log" json:"log"`
+	APIs             APIsConfig             `yaml:"apis" json:"apis"`
+	Clients          ClientsConfig          `yaml:"clients" json:"clients"`
+	TaskQueue        queue.Config           `yaml:"taskQueue" json:"taskQueue"`
+	ServiceHeartbeat ServiceHeartbeatConfig `yaml:"serviceHeartbeat" json:"serviceHeartbeat"`
+}
+
+func (c *Config) Validate() error {
+	if err := c.Log.Validate(); err != nil {
+		return fmt.Errorf("log.%w", err)
+	}
+	if err := c.APIs.Validate(); err != nil {
+		return fmt.Errorf("apis.%w", err)
+	}
+	if err := c.Clients.Validate(); err != nil {
+		return fmt.Errorf("clients.%w", err)
+	}
+	if err := c.TaskQueue.Validate(); err != nil {
+		return fmt.Errorf("taskQueue.%w", err)
+	}
+	if err := c.ServiceHeartbeat.Validate(); err != nil {
+		return fmt.Errorf("serviceHeartbeat.%w", err)
+	}
+	return nil
+}
+
+type ServiceHeartbeatConfig struct {
+	TimeToLive time.Duration `yaml:"timeToLive" json:"timeToLive"`
+}
+
+func (c *ServiceHeartbeatConfig) Validate() error {
+	minTimeToLive := time.Second
+	if c.TimeToLive < minTimeToLive {
+		return fmt.Errorf("timeToLive('%v') - is less than %v", c.TimeToLive, minTimeToLive)
+	}
+	return nil
+}
+
+type LogConfig = log.Config
+
+type APIsConfig struct {
+	MQTT MQTTConfig `yaml:"mqtt" json:"mqtt"`
+}
+
+func (c *APIsConfig) Validate() error {
+	if err := c.MQTT.Validate(); err != nil {
+		return fmt.Errorf("mqtt.%w", err)
+	}
+	return nil
+}
+
+type AuthorizationConfig struct {
+	OwnerClaim       string `yaml:"ownerClaim" json:"ownerClaim"`
+	validator.Config `yaml:",inline" json:",inline"`
+}
+
+func (c *AuthorizationConfig) Validate() error {
+	if c.OwnerClaim == "" {
+		return fmt.Errorf("ownerClaim('%v')", c.OwnerClaim)
+	}
+	return c.Config.Validate()
+}
+
+type MQTTConfig struct {
+	listener.Config      `yaml:",inline" json:",inline"`
+	Authorization        AuthorizationConfig `yaml:"authorization" json:"authorization"`
+	OwnerCacheExpiration time.Duration       `yaml:"ownerCacheExpiration" json:"ownerCacheExpiration"`
+	// ConnectTimeout limits the time of the TLS handshake and of the CONNECT packet of the new connection.
+	ConnectTimeout time.Duration `yaml:"connectTimeout" json:"connectTimeout"`
+	// Timeout of the commands sent to the device.
+	Timeout time.Duration `yaml:"timeout" json:"timeout"`
+	// MaxPacketSize limits the size of the packets received from the device. The devices of MQTT 5 get it by the CONNACK packet.
+	MaxPacketSize uint32 `yaml:"maxPacketSize" json:"maxPacketSize"`
+}
+
+func (c *MQTTConfig) Validate() error {
+	if !c.TLS.ClientCertificateRequired {
+		return fmt.Errorf("tls.clientCertificateRequired('%v') - devices are identified by the certificate", c.TLS.ClientCertificateRequired)
+	}
+	if c.OwnerCacheExpiration <= 0 {
+		return fmt.Errorf("ownerCacheExpiration('%v')", c.OwnerCacheExpiration)
+	}
+	if c.ConnectTimeout <= 0 {
+		return fmt.Errorf("connectTimeout('%v')", c.ConnectTimeout)
+	}
+	if c.Timeout <= 0 {
+		return fmt.Errorf("timeout('%v')", c.Timeout)
+	}
+	if c.MaxPacketSize == 0 {
+		return fmt.Errorf("maxPacketSize('%v')", c.MaxPacketSize)
+	}
+	if err := c.Authorization.Validate(); err != nil {
+		return fmt.Errorf("authorization.%w", err)
+	}
+	return c.Config.Validate()
+}
+
+type EventBusConfig struct {
+	eventbusConfig.ConfigSubscriber `yaml:",inline" json:",inline"`
+}
+
+func (c *EventBusConfig) Validate() error {
+	if err := c.ConfigSubscriber.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
+
+type GrpcServerConfig struct {
+	Connection client.Config `yaml:"grpc" json:"grpc"`
+}
+
+func (c *GrpcServerConfig) Validate() error {
+	if err := c.Connection.Validate(); err != nil {
+		return fmt.Errorf("grpc.%w", err)
+	}
+	return nil
+}
+
+type ClientsConfig struct {
+	Eventbus               EventBusConfig    `yaml:"eventBus" json:"eventBus"`
+	OpenTelemetryCollector otelClient.Config `yaml:"openTelemetryCollector" json:"openTelemetryCollector"`
+	IdentityStore          GrpcServerConfig  `yaml:"identityStore" json:"identityStore"`
+	ResourceDirectory      GrpcServerConfig  `yaml:"resourceDirectory" json:"resourceDirectory"`
+	ResourceAggregate      GrpcServerConfig  `yaml:"resourceAggregate" json:"resourceAggregate"`
+}
+
+func (c *ClientsConfig) Validate() error {
+	if err := c.IdentityStore.Validate(); err != nil {
+		return fmt.Errorf("identityStore.%w", err)
+	}
+	if err := c.Eventbus.Validate(); err != nil {
+		return fmt.Errorf("eventbus.%w", err)
+	}
+	if err := c.ResourceAggregate.Validate(); err != nil {
+		return fmt.Errorf("resourceAggregate.%w", err)
+	}
+	if err := c.ResourceDirectory.Validate(); err != nil {
+		return fmt.Errorf("resourceDirectory.%w", err)
+	}
+	if err := c.OpenTelemetryCollector.Validate(); err != nil {
+		return fmt.Errorf("openTelemetryCollector.%w", err)
+	}
+	return nil
+}
+
+// String return string representation of Config
+func (c Config) String() string {
+	return config.ToString(c)
+}
@@ -0,0 +1,55 @@
+package service
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/eclipse/paho.mqtt.golang/packets"
+)
+
+// maxRemainingLengthBytes is the maximal number of bytes of the Remaining Length field (MQTT 3.1.1 section 2.2.3).
+const maxRemainingLengthBytes = 4
+
+// readPacket reads the MQTT control packet of the protocol version from the reader. The packets with the remaining length
+// greater than maxPacketSize are rejected before the payload is read. The version of the CONNECT packet is
+// determined by its protocol level.
+func readPacket(r io.Reader, maxPacketSize uint32, protocolVersion byte) (packets.ControlPacket, error) {
+	header := make([]byte, 1, 1+maxRemainingLengthBytes)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, err
+	}
+	var remainingLength uint32
+	var multiplier uint32 = 1
+	b := make([]byte, 1)
+	for i := 0; ; i++ {
+		if i == maxRemainingLengthBytes {
+			return nil, errors.New("malformed remaining length")
+		}
+		if _, err := io.ReadFull(r, b); err != nil {
+			return nil, err
+		}
+		header = append(header, b[0])
+		remainingLength += uint32(b[0]&0x7f) * multiplier
+		if b[0]&0x80 == 0 {
+			break
+		}
+		multiplier *= 128
+	}
+	if remainingLength > maxPacketSize {
+		return nil, fmt.Errorf("packet size(%v) exceeds the limit(%v)", remainingLength, maxPacketSize)
+	}
+	body := make([]byte, remainingLength)
+	if _, err := io.ReadFull(r, body); err != nil {
+		return nil, err
+	}
+	fh := newFixedHeader(header[0], remainingLength)
+	if fh.MessageType == packets.Connect {
+		protocolVersion = connectProtocolVersion(body)
+	}
+	if protocolVersion == protocolVersion5 {
+		return decodePacketV5(fh, body)
+	}
+	return packets.ReadPacket(io.MultiReader(bytes.NewReader(header), bytes.NewReader(body)))
+}
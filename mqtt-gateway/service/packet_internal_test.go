@@ -0,0 +1,35 @@
+package service
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/eclipse/paho.mqtt.golang/packets"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadPacket(t *testing.T) {
+	p := packets.NewControlPacket(packets.Publish).(*packets.PublishPacket)
+	p.TopicName = "plgd/0b6b9a80-2b4b-4c4b-8e8c-6f2b0a3c7d11/res/light/1"
+	p.Payload = bytes.Repeat([]byte("a"), 200)
+	var buf bytes.Buffer
+	require.NoError(t, p.Write(&buf))
+
+	got, err := readPacket(bytes.NewReader(buf.Bytes()), 1024, protocolVersion311)
+	require.NoError(t, err)
+	publish, ok := got.(*packets.PublishPacket)
+	require.True(t, ok)
+	require.Equal(t, p.TopicName, publish.TopicName)
+	require.Equal(t, p.Payload, publish.Payload)
+
+	_, err = readPacket(bytes.NewReader(buf.Bytes()), 100, protocolVersion311)
+	require.Error(t, err)
+
+	// remaining length is encoded by more than 4 bytes
+	_, err = readPacket(bytes.NewReader([]byte{0x30, 0xff, 0xff, 0xff, 0xff, 0x01}), 1024, protocolVersion311)
+	require.Error(t, err)
+
+	// truncated packet
+	_, err = readPacket(bytes.NewReader(buf.Bytes()[:buf.Len()-1]), 1024, protocolVersion311)
+	require.Error(t, err)
+}
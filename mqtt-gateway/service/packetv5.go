@@ -0,0 +1,470 @@
+package service
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"unicode/utf8"
+
+	"github.com/eclipse/paho.mqtt.golang/packets"
+)
+
+// paho.mqtt.golang implements only the packets of MQTT 3.1 and 3.1.1. The packets of MQTT 5 sent by the device are
+// decoded to the packets of paho, the properties which are not used by the gateway are skipped. The packets sent
+// by the gateway which differ from MQTT 3.1.1 are encoded here (MQTT 5 section 3).
+
+// Protocol levels of the CONNECT packet (MQTT 5 section 3.1.2.2).
+const (
+	protocolVersion311 = 4
+	protocolVersion5   = 5
+)
+
+// Reason codes of MQTT 5 (section 2.4).
+const (
+	reasonSuccess                    = 0x00
+	reasonNoSubscriptionExisted      = 0x11
+	reasonProtocolError              = 0x82
+	reasonUnsupportedProtocolVersion = 0x84
+	reasonClientIdentifierNotValid   = 0x85
+	reasonBadUserNameOrPassword      = 0x86
+	reasonNotAuthorized              = 0x87
+	reasonServerUnavailable          = 0x88
+)
+
+// connackReasonCodes maps the return codes of MQTT 3.1.1 to the reason codes of the CONNACK packet.
+var connackReasonCodes = map[byte]byte{
+	packets.Accepted:                        reasonSuccess,
+	packets.ErrRefusedBadProtocolVersion:    reasonUnsupportedProtocolVersion,
+	packets.ErrRefusedIDRejected:            reasonClientIdentifierNotValid,
+	packets.ErrRefusedServerUnavailable:     reasonServerUnavailable,
+	packets.ErrRefusedBadUsernameOrPassword: reasonBadUserNameOrPassword,
+	packets.ErrRefusedNotAuthorised:         reasonNotAuthorized,
+	packets.ErrProtocolViolation:            reasonProtocolError,
+}
+
+// Identifiers of the properties (MQTT 5 section 2.2.2.2).
+const (
+	propertyPayloadFormatIndicator          = 0x01
+	propertyMessageExpiryInterval           = 0x02
+	propertyContentType                     = 0x03
+	propertyResponseTopic                   = 0x08
+	propertyCorrelationData                 = 0x09
+	propertySubscriptionIdentifier          = 0x0B
+	propertySessionExpiryInterval           = 0x11
+	propertyAssignedClientIdentifier        = 0x12
+	propertyServerKeepAlive                 = 0x13
+	propertyAuthenticationMethod            = 0x15
+	propertyAuthenticationData              = 0x16
+	propertyRequestProblemInformation       = 0x17
+	propertyWillDelayInterval               = 0x18
+	propertyRequestResponseInformation      = 0x19
+	propertyResponseInformation             = 0x1A
+	propertyServerReference                 = 0x1C
+	propertyReasonString                    = 0x1F
+	propertyReceiveMaximum                  = 0x21
+	propertyTopicAliasMaximum               = 0x22
+	propertyTopicAlias                      = 0x23
+	propertyMaximumQoS                      = 0x24
+	propertyRetainAvailable                 = 0x25
+	propertyUserProperty                    = 0x26
+	propertyMaximumPacketSize               = 0x27
+	propertyWildcardSubscriptionAvailable   = 0x28
+	propertySubscriptionIdentifierAvailable = 0x29
+	propertySharedSubscriptionAvailable     = 0x2A
+)
+
+type propertyType int
+
+const (
+	propertyTypeByte propertyType = iota
+	propertyTypeUint16
+	propertyTypeUint32
+	propertyTypeVarint
+	propertyTypeString
+	propertyTypeBinary
+	propertyTypeStringPair
+)
+
+var propertyTypes = map[uint32]propertyType{
+	propertyPayloadFormatIndicator:          propertyTypeByte,
+	propertyMessageExpiryInterval:           propertyTypeUint32,
+	propertyContentType:                     propertyTypeString,
+	propertyResponseTopic:                   propertyTypeString,
+	propertyCorrelationData:                 propertyTypeBinary,
+	propertySubscriptionIdentifier:          propertyTypeVarint,
+	propertySessionExpiryInterval:           propertyTypeUint32,
+	propertyAssignedClientIdentifier:        propertyTypeString,
+	propertyServerKeepAlive:                 propertyTypeUint16,
+	propertyAuthenticationMethod:            propertyTypeString,
+	propertyAuthenticationData:              propertyTypeBinary,
+	propertyRequestProblemInformation:       propertyTypeByte,
+	propertyWillDelayInterval:               propertyTypeUint32,
+	propertyRequestResponseInformation:      propertyTypeByte,
+	propertyResponseInformation:             propertyTypeString,
+	propertyServerReference:                 propertyTypeString,
+	propertyReasonString:                    propertyTypeString,
+	propertyReceiveMaximum:                  propertyTypeUint16,
+	propertyTopicAliasMaximum:               propertyTypeUint16,
+	propertyTopicAlias:                      propertyTypeUint16,
+	propertyMaximumQoS:                      propertyTypeByte,
+	propertyRetainAvailable:                 propertyTypeByte,
+	propertyUserProperty:                    propertyTypeStringPair,
+	propertyMaximumPacketSize:               propertyTypeUint32,
+	propertyWildcardSubscriptionAvailable:   propertyTypeByte,
+	propertySubscriptionIdentifierAvailable: propertyTypeByte,
+	propertySharedSubscriptionAvailable:     propertyTypeByte,
+}
+
+// properties used by the gateway, the others are skipped.
+type properties struct {
+	correlationData      []byte
+	topicAlias           uint16
+	maximumPacketSize    uint32
+	authenticationMethod string
+}
+
+// connectPacketV5 is the CONNECT packet of MQTT 5 with the properties of the connection.
+type connectPacketV5 struct {
+	*packets.ConnectPacket
+	properties properties
+}
+
+var errMalformedPacket = errors.New("malformed packet")
+
+// decoder reads the fields of the packet body. The first error is kept and the following reads return zero values.
+type decoder struct {
+	data []byte
+	err  error
+}
+
+func (d *decoder) next(n int) []byte {
+	if d.err != nil {
+		return nil
+	}
+	if len(d.data) < n {
+		d.err = errMalformedPacket
+		return nil
+	}
+	v := d.data[:n]
+	d.data = d.data[n:]
+	return v
+}
+
+func (d *decoder) readByte() byte {
+	if v := d.next(1); v != nil {
+		return v[0]
+	}
+	return 0
+}
+
+func (d *decoder) readUint16() uint16 {
+	if v := d.next(2); v != nil {
+		return binary.BigEndian.Uint16(v)
+	}
+	return 0
+}
+
+func (d *decoder) readUint32() uint32 {
+	if v := d.next(4); v != nil {
+		return binary.BigEndian.Uint32(v)
+	}
+	return 0
+}
+
+// readVarint reads the Variable Byte Integer (MQTT 5 section 1.5.5).
+func (d *decoder) readVarint() uint32 {
+	var value uint32
+	var multiplier uint32 = 1
+	for i := 0; i < maxRemainingLengthBytes; i++ {
+		b := d.readByte()
+		if d.err != nil {
+			return 0
+		}
+		value += uint32(b&0x7f) * multiplier
+		if b&0x80 == 0 {
+			return value
+		}
+		multiplier *= 128
+	}
+	d.err = errMalformedPacket
+	return 0
+}
+
+func (d *decoder) readBinary() []byte {
+	n := d.readUint16()
+	return d.next(int(n))
+}
+
+func (d *decoder) readString() string {
+	v := d.readBinary()
+	if d.err == nil && !utf8.Valid(v) {
+		d.err = errors.New("invalid UTF-8 string")
+	}
+	return string(v)
+}
+
+func (d *decoder) rest() []byte {
+	if d.err != nil {
+		return nil
+	}
+	v := d.data
+	d.data = nil
+	return v
+}
+
+func (d *decoder) skipProperty(id uint32) {
+	t, ok := propertyTypes[id]
+	if !ok {
+		if d.err == nil {
+			d.err = fmt.Errorf("unknown property(%#x)", id)
+		}
+		return
+	}
+	switch t {
+	case propertyTypeByte:
+		d.readByte()
+	case propertyTypeUint16:
+		d.readUint16()
+	case propertyTypeUint32:
+		d.readUint32()
+	case propertyTypeVarint:
+		d.readVarint()
+	case propertyTypeString, propertyTypeBinary:
+		d.readBinary()
+	case propertyTypeStringPair:
+		d.readBinary()
+		d.readBinary()
+	}
+}
+
+func (d *decoder) readProperties() properties {
+	var p properties
+	length := d.readVarint()
+	pd := decoder{data: d.next(int(length))}
+	if d.err != nil {
+		return p
+	}
+	for pd.err == nil && len(pd.data) > 0 {
+		switch id := pd.readVarint(); id {
+		case propertyCorrelationData:
+			p.correlationData = pd.readBinary()
+		case propertyTopicAlias:
+			p.topicAlias = pd.readUint16()
+		case propertyMaximumPacketSize:
+			p.maximumPacketSize = pd.readUint32()
+		case propertyAuthenticationMethod:
+			p.authenticationMethod = pd.readString()
+		default:
+			pd.skipProperty(id)
+		}
+	}
+	d.err = pd.err
+	return p
+}
+
+// connectProtocolVersion returns the protocol level of the CONNECT packet body of the MQTT protocol.
+func connectProtocolVersion(body []byte) byte {
+	d := decoder{data: body}
+	name := d.readString()
+	version := d.readByte()
+	if d.err != nil || name != "MQTT" {
+		return 0
+	}
+	return version
+}
+
+func newFixedHeader(b byte, remainingLength uint32) packets.FixedHeader {
+	return packets.FixedHeader{
+		MessageType:     b >> 4,
+		Dup:             (b>>3)&0x01 > 0,
+		Qos:             (b >> 1) & 0x03,
+		Retain:          b&0x01 > 0,
+		RemainingLength: int(remainingLength),
+	}
+}
+
+// decodePacketV5 decodes the body of the packet of MQTT 5 sent by the device.
+func decodePacketV5(fh packets.FixedHeader, body []byte) (packets.ControlPacket, error) {
+	d := &decoder{data: body}
+	var p packets.ControlPacket
+	switch fh.MessageType {
+	case packets.Connect:
+		p = d.decodeConnect(fh)
+	case packets.Publish:
+		p = d.decodePublish(fh)
+	case packets.Pubrel:
+		// the reason code and the properties are optional and they are ignored
+		p = &packets.PubrelPacket{FixedHeader: fh, MessageID: d.readUint16()}
+	case packets.Subscribe:
+		p = d.decodeSubscribe(fh)
+	case packets.Unsubscribe:
+		p = d.decodeUnsubscribe(fh)
+	case packets.Pingreq:
+		p = &packets.PingreqPacket{FixedHeader: fh}
+	case packets.Disconnect:
+		p = &packets.DisconnectPacket{FixedHeader: fh}
+	default:
+		return nil, fmt.Errorf("unsupported packet type(%v)", fh.MessageType)
+	}
+	if d.err != nil {
+		return nil, fmt.Errorf("cannot decode %v packet: %w", packets.PacketNames[fh.MessageType], d.err)
+	}
+	return p, nil
+}
+
+func (d *decoder) decodeConnect(fh packets.FixedHeader) *connectPacketV5 {
+	p := &packets.ConnectPacket{FixedHeader: fh}
+	p.ProtocolName = d.readString()
+	p.ProtocolVersion = d.readByte()
+	flags := d.readByte()
+	p.ReservedBit = flags & 0x01
+	p.CleanSession = flags&0x02 > 0
+	p.WillFlag = flags&0x04 > 0
+	p.WillQos = (flags >> 3) & 0x03
+	p.WillRetain = flags&0x20 > 0
+	p.PasswordFlag = flags&0x40 > 0
+	p.UsernameFlag = flags&0x80 > 0
+	p.Keepalive = d.readUint16()
+	props := d.readProperties()
+	p.ClientIdentifier = d.readString()
+	if p.WillFlag {
+		// the will properties are ignored
+		d.readProperties()
+		p.WillTopic = d.readString()
+		p.WillMessage = d.readBinary()
+	}
+	if p.UsernameFlag {
+		p.Username = d.readString()
+	}
+	if p.PasswordFlag {
+		p.Password = d.readBinary()
+	}
+	if d.err == nil && props.authenticationMethod != "" {
+		d.err = fmt.Errorf("unsupported authentication method('%v')", props.authenticationMethod)
+	}
+	return &connectPacketV5{
+		ConnectPacket: p,
+		properties:    props,
+	}
+}
+
+func (d *decoder) decodePublish(fh packets.FixedHeader) *packets.PublishPacket {
+	p := &packets.PublishPacket{FixedHeader: fh}
+	p.TopicName = d.readString()
+	if fh.Qos > 0 {
+		p.MessageID = d.readUint16()
+	}
+	props := d.readProperties()
+	p.Payload = d.rest()
+	if d.err == nil && props.topicAlias != 0 {
+		// the gateway doesn't announce the Topic Alias Maximum, so the device must not use the topic aliases
+		d.err = errors.New("topic alias is not supported")
+	}
+	return p
+}
+
+func (d *decoder) decodeSubscribe(fh packets.FixedHeader) *packets.SubscribePacket {
+	p := &packets.SubscribePacket{FixedHeader: fh}
+	p.MessageID = d.readUint16()
+	// the subscription identifier is ignored, the gateway announces that it is not available
+	d.readProperties()
+	for d.err == nil && len(d.data) > 0 {
+		p.Topics = append(p.Topics, d.readString())
+		// the retain handling and the no local options aren't used, because the gateway doesn't retain messages
+		p.Qoss = append(p.Qoss, d.readByte()&0x03)
+	}
+	if d.err == nil && len(p.Topics) == 0 {
+		d.err = errors.New("no topic filter")
+	}
+	return p
+}
+
+func (d *decoder) decodeUnsubscribe(fh packets.FixedHeader) *packets.UnsubscribePacket {
+	p := &packets.UnsubscribePacket{FixedHeader: fh}
+	p.MessageID = d.readUint16()
+	d.readProperties()
+	for d.err == nil && len(d.data) > 0 {
+		p.Topics = append(p.Topics, d.readString())
+	}
+	if d.err == nil && len(p.Topics) == 0 {
+		d.err = errors.New("no topic filter")
+	}
+	return p
+}
+
+// rawPacket is the encoded packet.
+type rawPacket []byte
+
+func (p rawPacket) Write(w io.Writer) error {
+	_, err := w.Write(p)
+	return err
+}
+
+func appendVarint(b []byte, v uint32) []byte {
+	for {
+		digit := byte(v % 128)
+		v /= 128
+		if v > 0 {
+			digit |= 0x80
+		}
+		b = append(b, digit)
+		if v == 0 {
+			return b
+		}
+	}
+}
+
+func appendBinary(b, v []byte) []byte {
+	b = binary.BigEndian.AppendUint16(b, uint16(len(v)))
+	return append(b, v...)
+}
+
+// newRawPacket creates the packet from the first byte of the fixed header, the variable header with
+// the properties and the payload.
+func newRawPacket(header byte, variableHeader, props, payload []byte) rawPacket {
+	remainingLength := len(variableHeader) + len(props) + len(payload)
+	remainingLength += len(appendVarint(nil, uint32(len(props))))
+	b := make([]byte, 0, 1+maxRemainingLengthBytes+remainingLength)
+	b = append(b, header)
+	b = appendVarint(b, uint32(remainingLength))
+	b = append(b, variableHeader...)
+	b = appendVarint(b, uint32(len(props)))
+	b = append(b, props...)
+	return append(b, payload...)
+}
+
+// newConnackV5 creates the CONNACK packet with the limits of the gateway.
+func newConnackV5(reasonCode byte, maximumPacketSize uint32) rawPacket {
+	props := []byte{
+		// the gateway doesn't keep the retained messages and it doesn't implement the shared subscriptions
+		// and the subscription identifiers
+		propertyRetainAvailable, 0,
+		propertySharedSubscriptionAvailable, 0,
+		propertySubscriptionIdentifierAvailable, 0,
+	}
+	if maximumPacketSize > 0 {
+		props = append(props, propertyMaximumPacketSize)
+		props = binary.BigEndian.AppendUint32(props, maximumPacketSize)
+	}
+	// session present flag is not set, the gateway doesn't keep the sessions
+	return newRawPacket(packets.Connack<<4, []byte{0, reasonCode}, props, nil)
+}
+
+func newSubackV5(messageID uint16, reasonCodes []byte) rawPacket {
+	return newRawPacket(packets.Suback<<4, binary.BigEndian.AppendUint16(nil, messageID), nil, reasonCodes)
+}
+
+func newUnsubackV5(messageID uint16, reasonCodes []byte) rawPacket {
+	return newRawPacket(packets.Unsuback<<4, binary.BigEndian.AppendUint16(nil, messageID), nil, reasonCodes)
+}
+
+// newPublishV5 creates the PUBLISH packet with QoS 0 and the correlation data property.
+func newPublishV5(topicName string, correlationData, payload []byte) rawPacket {
+	var props []byte
+	if len(correlationData) > 0 {
+		props = append(props, propertyCorrelationData)
+		props = appendBinary(props, correlationData)
+	}
+	return newRawPacket(packets.Publish<<4, appendBinary(nil, []byte(topicName)), props, payload)
+}
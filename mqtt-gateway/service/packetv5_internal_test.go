@@ -0,0 +1,149 @@
+package service
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/eclipse/paho.mqtt.golang/packets"
+	"github.com/stretchr/testify/require"
+)
+
+// newConnectV5 encodes the CONNECT packet of MQTT 5 sent by the device.
+func newConnectV5(clientID, username, password string, props []byte) []byte {
+	variableHeader := appendBinary(nil, []byte("MQTT"))
+	// clean start, will flag, will QoS 1, password and username flags
+	variableHeader = append(variableHeader, protocolVersion5, 0x02|0x04|0x08|0x40|0x80)
+	variableHeader = binary.BigEndian.AppendUint16(variableHeader, 30)
+	payload := appendBinary(nil, []byte(clientID))
+	// will properties with the will delay interval
+	payload = append(payload, 5, propertyWillDelayInterval, 0, 0, 0, 10)
+	payload = appendBinary(payload, []byte("plgd/will"))
+	payload = appendBinary(payload, []byte("offline"))
+	payload = appendBinary(payload, []byte(username))
+	payload = appendBinary(payload, []byte(password))
+	return newRawPacket(packets.Connect<<4, variableHeader, props, payload)
+}
+
+func TestReadConnectV5(t *testing.T) {
+	props := []byte{propertySessionExpiryInterval, 0, 0, 0, 60, propertyReceiveMaximum, 0, 16}
+	props = append(props, propertyUserProperty)
+	props = appendBinary(props, []byte("key"))
+	props = appendBinary(props, []byte("value"))
+	props = append(props, propertyMaximumPacketSize, 0, 0, 0x10, 0)
+
+	p, err := readPacket(bytes.NewReader(newConnectV5("device", "owner", "token", props)), 1024, 0)
+	require.NoError(t, err)
+	connect, ok := p.(*connectPacketV5)
+	require.True(t, ok)
+	require.Equal(t, "MQTT", connect.ProtocolName)
+	require.Equal(t, byte(protocolVersion5), connect.ProtocolVersion)
+	require.True(t, connect.CleanSession)
+	require.Equal(t, uint16(30), connect.Keepalive)
+	require.Equal(t, "device", connect.ClientIdentifier)
+	require.Equal(t, "plgd/will", connect.WillTopic)
+	require.Equal(t, []byte("offline"), connect.WillMessage)
+	require.Equal(t, byte(1), connect.WillQos)
+	require.Equal(t, "owner", connect.Username)
+	require.Equal(t, []byte("token"), connect.Password)
+	require.Equal(t, uint32(4096), connect.properties.maximumPacketSize)
+	require.Equal(t, byte(packets.Accepted), validateConnect(connect.ConnectPacket))
+
+	// enhanced authentication is not supported
+	props = append([]byte{propertyAuthenticationMethod}, appendBinary(nil, []byte("SCRAM-SHA-1"))...)
+	_, err = readPacket(bytes.NewReader(newConnectV5("device", "owner", "token", props)), 1024, 0)
+	require.Error(t, err)
+
+	// unknown property
+	_, err = readPacket(bytes.NewReader(newConnectV5("device", "owner", "token", []byte{0x7f, 0})), 1024, 0)
+	require.Error(t, err)
+
+	// the length of the properties exceeds the packet
+	raw := newConnectV5("device", "owner", "token", nil)
+	raw[12] = 0x40
+	_, err = readPacket(bytes.NewReader(raw), 1024, 0)
+	require.Error(t, err)
+
+	// CONNECT of MQTT 3.1.1 is decoded by paho
+	p311 := packets.NewControlPacket(packets.Connect).(*packets.ConnectPacket)
+	p311.ProtocolName = "MQTT"
+	p311.ProtocolVersion = protocolVersion311
+	p311.CleanSession = true
+	p311.ClientIdentifier = "device"
+	var buf bytes.Buffer
+	require.NoError(t, p311.Write(&buf))
+	p, err = readPacket(&buf, 1024, 0)
+	require.NoError(t, err)
+	connect311, ok := p.(*packets.ConnectPacket)
+	require.True(t, ok)
+	require.Equal(t, "device", connect311.ClientIdentifier)
+	require.Equal(t, byte(packets.Accepted), validateConnect(connect311))
+}
+
+func TestReadPublishV5(t *testing.T) {
+	const topicName = "plgd/0b6b9a80-2b4b-4c4b-8e8c-6f2b0a3c7d11/res/light/1"
+	props := []byte{propertyPayloadFormatIndicator, 1}
+	props = append(props, propertyContentType)
+	props = appendBinary(props, []byte("application/json"))
+	props = append(props, propertyCorrelationData)
+	props = appendBinary(props, []byte("correlationID"))
+	variableHeader := binary.BigEndian.AppendUint16(appendBinary(nil, []byte(topicName)), 7)
+	raw := newRawPacket(packets.Publish<<4|1<<1, variableHeader, props, []byte(`{"state":true}`))
+
+	p, err := readPacket(bytes.NewReader(raw), 1024, protocolVersion5)
+	require.NoError(t, err)
+	publish, ok := p.(*packets.PublishPacket)
+	require.True(t, ok)
+	require.Equal(t, topicName, publish.TopicName)
+	require.Equal(t, byte(1), publish.Qos)
+	require.Equal(t, uint16(7), publish.MessageID)
+	require.Equal(t, []byte(`{"state":true}`), publish.Payload)
+
+	// the gateway doesn't support the topic aliases
+	raw = newRawPacket(packets.Publish<<4, appendBinary(nil, nil), []byte{propertyTopicAlias, 0, 1}, nil)
+	_, err = readPacket(bytes.NewReader(raw), 1024, protocolVersion5)
+	require.Error(t, err)
+
+	// the properties are missing
+	raw = []byte{packets.Publish << 4, 2, 0, 0}
+	_, err = readPacket(bytes.NewReader(raw), 1024, protocolVersion5)
+	require.Error(t, err)
+}
+
+func TestReadSubscribeV5(t *testing.T) {
+	const filter = "plgd/0b6b9a80-2b4b-4c4b-8e8c-6f2b0a3c7d11/cmd/#"
+	payload := append(appendBinary(nil, []byte(filter)), 0x01|0x04)
+	raw := newRawPacket(packets.Subscribe<<4|0x02, []byte{0, 3}, []byte{propertySubscriptionIdentifier, 5}, payload)
+	p, err := readPacket(bytes.NewReader(raw), 1024, protocolVersion5)
+	require.NoError(t, err)
+	subscribe, ok := p.(*packets.SubscribePacket)
+	require.True(t, ok)
+	require.Equal(t, uint16(3), subscribe.MessageID)
+	require.Equal(t, []string{filter}, subscribe.Topics)
+	require.Equal(t, []byte{1}, subscribe.Qoss)
+
+	raw = newRawPacket(packets.Unsubscribe<<4|0x02, []byte{0, 4}, nil, appendBinary(nil, []byte(filter)))
+	p, err = readPacket(bytes.NewReader(raw), 1024, protocolVersion5)
+	require.NoError(t, err)
+	unsubscribe, ok := p.(*packets.UnsubscribePacket)
+	require.True(t, ok)
+	require.Equal(t, uint16(4), unsubscribe.MessageID)
+	require.Equal(t, []string{filter}, unsubscribe.Topics)
+
+	// no topic filter
+	raw = newRawPacket(packets.Subscribe<<4|0x02, []byte{0, 5}, nil, nil)
+	_, err = readPacket(bytes.NewReader(raw), 1024, protocolVersion5)
+	require.Error(t, err)
+}
+
+func TestNewConnackV5(t *testing.T) {
+	require.Equal(t, rawPacket{
+		packets.Connack << 4, 14,
+		0, reasonNotAuthorized,
+		11,
+		propertyRetainAvailable, 0,
+		propertySharedSubscriptionAvailable, 0,
+		propertySubscriptionIdentifierAvailable, 0,
+		propertyMaximumPacketSize, 0, 0, 0x04, 0,
+	}, newConnackV5(connackReasonCodes[packets.ErrRefusedNotAuthorised], 1024))
+}
@@ -0,0 +1,267 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/eclipse/paho.mqtt.golang/packets"
+	"github.com/plgd-dev/device/v2/schema"
+	"github.com/plgd-dev/go-coap/v3/message"
+	"github.com/plgd-dev/hub/v2/mqtt-gateway/topic"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/commands"
+)
+
+// subackFailure is the return code of the rejected topic filter (MQTT 3.1.1 section 3.9.3).
+const subackFailure = 0x80
+
+func (c *session) handleSubscribe(p *packets.SubscribePacket) error {
+	deviceID := c.deviceID()
+	resp := packets.NewControlPacket(packets.Suback).(*packets.SubackPacket)
+	resp.MessageID = p.MessageID
+	resp.ReturnCodes = make([]byte, 0, len(p.Topics))
+	subscribed := false
+	c.private.mutex.Lock()
+	for _, filter := range p.Topics {
+		// the device can subscribe only to its own commands
+		if !topic.IsCommandFilter(deviceID, filter) {
+			resp.ReturnCodes = append(resp.ReturnCodes, subackFailure)
+			continue
+		}
+		// the commands are published with QoS 0, because they are confirmed by the response of the device
+		c.private.subscriptions[filter] = 0
+		resp.ReturnCodes = append(resp.ReturnCodes, 0)
+		subscribed = true
+	}
+	startSubscriber := subscribed && c.private.deviceSubscriber == nil
+	c.private.mutex.Unlock()
+	for i, code := range resp.ReturnCodes {
+		if code == subackFailure {
+			c.Debugf("subscription to topic filter('%v') was rejected", p.Topics[i])
+		}
+	}
+	if err := c.writeSuback(resp); err != nil {
+		return err
+	}
+	if !startSubscriber {
+		return nil
+	}
+	// the pending commands are delivered once the device is subscribed to them
+	return c.setNewDeviceSubscriber(c.Context(), deviceID)
+}
+
+// writeSuback writes the SUBACK packet, the rejected topic filters are reported as not authorized to the device of MQTT 5.
+func (c *session) writeSuback(p *packets.SubackPacket) error {
+	if c.protocolVersion() != protocolVersion5 {
+		return c.writePacket(p)
+	}
+	reasonCodes := make([]byte, 0, len(p.ReturnCodes))
+	for _, code := range p.ReturnCodes {
+		if code == subackFailure {
+			code = reasonNotAuthorized
+		}
+		reasonCodes = append(reasonCodes, code)
+	}
+	return c.writePacket(newSubackV5(p.MessageID, reasonCodes))
+}
+
+func (c *session) handleUnsubscribe(p *packets.UnsubscribePacket) error {
+	reasonCodes := make([]byte, 0, len(p.Topics))
+	c.private.mutex.Lock()
+	for _, filter := range p.Topics {
+		code := byte(reasonSuccess)
+		if _, ok := c.private.subscriptions[filter]; !ok {
+			code = reasonNoSubscriptionExisted
+		}
+		reasonCodes = append(reasonCodes, code)
+		delete(c.private.subscriptions, filter)
+	}
+	c.private.mutex.Unlock()
+	if c.protocolVersion() == protocolVersion5 {
+		return c.writePacket(newUnsubackV5(p.MessageID, reasonCodes))
+	}
+	resp := packets.NewControlPacket(packets.Unsuback).(*packets.UnsubackPacket)
+	resp.MessageID = p.MessageID
+	return c.writePacket(resp)
+}
+
+// isSubscribed returns true when the device is subscribed to the topic.
+func (c *session) isSubscribed(t string) bool {
+	c.private.mutex.Lock()
+	defer c.private.mutex.Unlock()
+	for filter := range c.private.subscriptions {
+		if topic.Match(filter, t) {
+			return true
+		}
+	}
+	return false
+}
+
+// markQoS2Received returns false when the QoS 2 publish with the message ID was already received.
+func (c *session) markQoS2Received(messageID uint16) bool {
+	c.private.mutex.Lock()
+	defer c.private.mutex.Unlock()
+	if _, ok := c.private.receivedQoS2[messageID]; ok {
+		return false
+	}
+	c.private.receivedQoS2[messageID] = struct{}{}
+	return true
+}
+
+func (c *session) handlePubrel(p *packets.PubrelPacket) error {
+	c.private.mutex.Lock()
+	delete(c.private.receivedQoS2, p.MessageID)
+	c.private.mutex.Unlock()
+	resp := packets.NewControlPacket(packets.Pubcomp).(*packets.PubcompPacket)
+	resp.MessageID = p.MessageID
+	return c.writePacket(resp)
+}
+
+func (c *session) handlePublish(p *packets.PublishPacket) error {
+	process := true
+	if p.Qos == 2 {
+		// the duplicate is acknowledged again, but it is not processed (MQTT 3.1.1 section 4.3.3)
+		process = c.markQoS2Received(p.MessageID)
+	}
+	if process {
+		if err := c.processPublish(p.TopicName, p.Payload); err != nil {
+			// the publish isn't rejected, because MQTT 3.1.1 doesn't allow it, so the message is dropped
+			c.Errorf("cannot process publish to topic('%v'): %w", p.TopicName, err)
+		}
+	}
+	switch p.Qos {
+	case 1:
+		resp := packets.NewControlPacket(packets.Puback).(*packets.PubackPacket)
+		resp.MessageID = p.MessageID
+		return c.writePacket(resp)
+	case 2:
+		resp := packets.NewControlPacket(packets.Pubrec).(*packets.PubrecPacket)
+		resp.MessageID = p.MessageID
+		return c.writePacket(resp)
+	}
+	return nil
+}
+
+func (c *session) processPublish(topicName string, payload []byte) error {
+	t, err := topic.Parse(topicName)
+	if err != nil {
+		return err
+	}
+	deviceID := c.deviceID()
+	if t.DeviceID != deviceID {
+		return fmt.Errorf("device %v cannot publish to topic of device %v", deviceID, t.DeviceID)
+	}
+	switch t.Kind {
+	case topic.ResponseKind:
+		return c.deliverCommandResponse(t.CorrelationID, t.Status, payload)
+	case topic.ResourceLinksKind:
+		// the links and the contents are processed in order by one worker, so the links are published before the contents
+		return c.server.taskQueue.SubmitForOneWorker(deviceID, func() {
+			if err := c.publishResourceLinks(deviceID, payload); err != nil {
+				c.Errorf("cannot publish resource links: %w", err)
+			}
+		})
+	case topic.ResourceKind:
+		return c.server.taskQueue.SubmitForOneWorker(deviceID, func() {
+			if err := c.notifyResourceChanged(deviceID, t.Href, payload); err != nil {
+				c.Errorf("cannot notify resource /%v%v content changed: %w", deviceID, t.Href, err)
+			}
+		})
+	}
+	return fmt.Errorf("unsupported topic kind('%v')", t.Kind)
+}
+
+func parseResourceLinks(deviceID string, payload []byte) (schema.ResourceLinks, error) {
+	var links schema.ResourceLinks
+	if err := json.Unmarshal(payload, &links); err != nil {
+		return nil, fmt.Errorf("cannot decode resource links: %w", err)
+	}
+	for i := range links {
+		if !strings.HasPrefix(links[i].Href, "/") || links[i].Href == "/" {
+			return nil, fmt.Errorf("invalid href('%v') of resource link", links[i].Href)
+		}
+		if links[i].DeviceID != "" && links[i].DeviceID != deviceID {
+			return nil, fmt.Errorf("invalid device id('%v') of resource link %v", links[i].DeviceID, links[i].Href)
+		}
+		links[i].DeviceID = deviceID
+	}
+	return links, nil
+}
+
+func (c *session) publishLinks(ctx context.Context, deviceID string, links schema.ResourceLinks) error {
+	if len(links) == 0 {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, c.server.config.APIs.MQTT.Timeout)
+	defer cancel()
+	_, err := c.server.raClient.PublishResourceLinks(c.ctxWithToken(ctx), &commands.PublishResourceLinksRequest{
+		Resources:       commands.SchemaResourceLinksToResources(links, time.Time{}),
+		DeviceId:        deviceID,
+		CommandMetadata: c.newCommandMetadata(),
+	})
+	if err != nil {
+		return err
+	}
+	c.private.mutex.Lock()
+	defer c.private.mutex.Unlock()
+	for _, l := range links {
+		c.private.hrefs[l.Href] = struct{}{}
+	}
+	return nil
+}
+
+func (c *session) publishResourceLinks(deviceID string, payload []byte) error {
+	links, err := parseResourceLinks(deviceID, payload)
+	if err != nil {
+		return err
+	}
+	return c.publishLinks(c.Context(), deviceID, links)
+}
+
+func (c *session) isHrefPublished(href string) bool {
+	c.private.mutex.Lock()
+	defer c.private.mutex.Unlock()
+	_, ok := c.private.hrefs[href]
+	return ok
+}
+
+func newJSONContent(data []byte) *commands.Content {
+	if len(data) == 0 {
+		return &commands.Content{
+			CoapContentFormat: -1,
+		}
+	}
+	return &commands.Content{
+		Data:              data,
+		ContentType:       message.AppJSON.String(),
+		CoapContentFormat: int32(message.AppJSON),
+	}
+}
+
+// notifyResourceChanged stores the content of the resource to the device twin.
+func (c *session) notifyResourceChanged(deviceID, href string, payload []byte) error {
+	if !c.isTwinEnabled() {
+		return nil
+	}
+	if !c.isHrefPublished(href) {
+		// the resource wasn't announced by the links, so the link is published before the content
+		if err := c.publishLinks(c.Context(), deviceID, schema.ResourceLinks{{Href: href, DeviceID: deviceID}}); err != nil {
+			return fmt.Errorf("cannot publish resource link: %w", err)
+		}
+	}
+	if len(payload) > 0 && !json.Valid(payload) {
+		return errors.New("content is not valid JSON")
+	}
+	ctx, cancel := context.WithTimeout(c.Context(), c.server.config.APIs.MQTT.Timeout)
+	defer cancel()
+	_, err := c.server.raClient.NotifyResourceChanged(c.ctxWithToken(ctx), &commands.NotifyResourceChangedRequest{
+		ResourceId:      commands.NewResourceID(deviceID, href),
+		Content:         newJSONContent(payload),
+		CommandMetadata: c.newCommandMetadata(),
+		Status:          commands.Status_OK,
+	})
+	return err
+}
@@ -0,0 +1,25 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseResourceLinks(t *testing.T) {
+	const deviceID = "0b6b9a80-2b4b-4c4b-8e8c-6f2b0a3c7d11"
+	links, err := parseResourceLinks(deviceID, []byte(`[{"href":"/light/1","rt":["core.light"]},{"href":"/switch/1","di":"`+deviceID+`"}]`))
+	require.NoError(t, err)
+	require.Len(t, links, 2)
+	require.Equal(t, "/light/1", links[0].Href)
+	require.Equal(t, []string{"core.light"}, links[0].ResourceTypes)
+	require.Equal(t, deviceID, links[0].DeviceID)
+	require.Equal(t, deviceID, links[1].DeviceID)
+
+	_, err = parseResourceLinks(deviceID, []byte(`{"href":"/light/1"}`))
+	require.Error(t, err)
+	_, err = parseResourceLinks(deviceID, []byte(`[{"href":"light/1"}]`))
+	require.Error(t, err)
+	_, err = parseResourceLinks(deviceID, []byte(`[{"href":"/light/1","di":"9a8d6c2e-4c1b-4f0e-a3d5-2b7f1e6c8a90"}]`))
+	require.Error(t, err)
+}
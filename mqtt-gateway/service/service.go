@@ -0,0 +1,299 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	pbGRPC "github.com/plgd-dev/hub/v2/grpc-gateway/pb"
+	idClient "github.com/plgd-dev/hub/v2/identity-store/client"
+	pbIS "github.com/plgd-dev/hub/v2/identity-store/pb"
+	"github.com/plgd-dev/hub/v2/pkg/fsnotify"
+	"github.com/plgd-dev/hub/v2/pkg/log"
+	kitNetGrpc "github.com/plgd-dev/hub/v2/pkg/net/grpc"
+	grpcClient "github.com/plgd-dev/hub/v2/pkg/net/grpc/client"
+	"github.com/plgd-dev/hub/v2/pkg/net/listener"
+	otelClient "github.com/plgd-dev/hub/v2/pkg/opentelemetry/collector/client"
+	pkgJwt "github.com/plgd-dev/hub/v2/pkg/security/jwt"
+	"github.com/plgd-dev/hub/v2/pkg/security/jwt/validator"
+	"github.com/plgd-dev/hub/v2/pkg/service"
+	"github.com/plgd-dev/hub/v2/pkg/sync/task/queue"
+	raClient "github.com/plgd-dev/hub/v2/resource-aggregate/client"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus"
+	eventbusConfig "github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/config"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Service is a configuration of mqtt-gateway
+type Service struct {
+	ctx                context.Context
+	cancel             context.CancelFunc
+	instanceID         uuid.UUID
+	tracerProvider     trace.TracerProvider
+	logger             log.Logger
+	isClient           pbIS.IdentityStoreClient
+	rdClient           pbGRPC.GrpcGatewayClient
+	raClient           *raClient.Client
+	resourceSubscriber eventbusConfig.Subscriber
+	jwtValidator       *pkgJwt.Validator
+	ownerCache         *idClient.OwnerCache
+	taskQueue          *queue.Queue
+	listener           *listener.Server
+	config             Config
+
+	sessionsMutex  sync.Mutex
+	sessions       map[*session]struct{}
+	sessionsClosed bool
+	sessionsWg     sync.WaitGroup
+}
+
+func newResourceAggregateClient(config GrpcServerConfig, resourceSubscriber eventbus.Subscriber, fileWatcher *fsnotify.Watcher, logger log.Logger, tracerProvider trace.TracerProvider) (*raClient.Client, func(), error) {
+	raConn, err := grpcClient.New(config.Connection, fileWatcher, logger, tracerProvider)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot create connection to resource-aggregate: %w", err)
+	}
+	closeRaConn := func() {
+		if err := raConn.Close(); err != nil {
+			if kitNetGrpc.IsContextCanceled(err) {
+				return
+			}
+			logger.Errorf("error occurs during close connection to resource-aggregate: %v", err)
+		}
+	}
+	raClient := raClient.New(raConn.GRPC(), resourceSubscriber)
+	return raClient, closeRaConn, nil
+}
+
+func newIdentityStoreClient(config GrpcServerConfig, fileWatcher *fsnotify.Watcher, logger log.Logger, tracerProvider trace.TracerProvider) (pbIS.IdentityStoreClient, func(), error) {
+	isConn, err := grpcClient.New(config.Connection, fileWatcher, logger, tracerProvider)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot create connection to identity-store: %w", err)
+	}
+	closeIsConn := func() {
+		if err := isConn.Close(); err != nil {
+			if kitNetGrpc.IsContextCanceled(err) {
+				return
+			}
+			logger.Errorf("error occurs during close connection to identity-store: %v", err)
+		}
+	}
+	return pbIS.NewIdentityStoreClient(isConn.GRPC()), closeIsConn, nil
+}
+
+func newResourceDirectoryClient(config GrpcServerConfig, fileWatcher *fsnotify.Watcher, logger log.Logger, tracerProvider trace.TracerProvider) (pbGRPC.GrpcGatewayClient, func(), error) {
+	rdConn, err := grpcClient.New(config.Connection, fileWatcher, logger, tracerProvider)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot create connection to resource-directory: %w", err)
+	}
+	closeRdConn := func() {
+		if err := rdConn.Close(); err != nil {
+			if kitNetGrpc.IsContextCanceled(err) {
+				return
+			}
+			logger.Errorf("error occurs during close connection to resource-directory: %v", err)
+		}
+	}
+	return pbGRPC.NewGrpcGatewayClient(rdConn.GRPC()), closeRdConn, nil
+}
+
+// New creates server.
+func New(ctx context.Context, config Config, fileWatcher *fsnotify.Watcher, logger log.Logger) (*service.Service, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	otelClient, err := otelClient.New(ctx, config.Clients.OpenTelemetryCollector, "mqtt-gateway", fileWatcher, logger)
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("cannot create open telemetry collector client: %w", err)
+	}
+	otelClient.AddCloseFunc(cancel)
+	tracerProvider := otelClient.GetTracerProvider()
+
+	queue, err := queue.New(config.TaskQueue)
+	if err != nil {
+		otelClient.Close()
+		return nil, fmt.Errorf("cannot create job queue %w", err)
+	}
+
+	resourceSubscriber, err := eventbusConfig.NewSubscriber(config.Clients.Eventbus.ConfigSubscriber, fileWatcher, logger, tracerProvider,
+		eventbusConfig.WithGoPool(func(f func()) error { return queue.Submit(f) }),
+	)
+	if err != nil {
+		otelClient.Close()
+		queue.Release()
+		return nil, fmt.Errorf("cannot create eventbus subscriber: %w", err)
+	}
+	resourceSubscriber.AddCloseFunc(otelClient.Close)
+	resourceSubscriber.AddCloseFunc(queue.Release)
+
+	raClient, closeRaClient, err := newResourceAggregateClient(config.Clients.ResourceAggregate, resourceSubscriber, fileWatcher, logger, tracerProvider)
+	if err != nil {
+		resourceSubscriber.Close()
+		return nil, fmt.Errorf("cannot create resource-aggregate client: %w", err)
+	}
+	resourceSubscriber.AddCloseFunc(closeRaClient)
+
+	isClient, closeIsClient, err := newIdentityStoreClient(config.Clients.IdentityStore, fileWatcher, logger, tracerProvider)
+	if err != nil {
+		resourceSubscriber.Close()
+		return nil, fmt.Errorf("cannot create identity-store client: %w", err)
+	}
+	resourceSubscriber.AddCloseFunc(closeIsClient)
+
+	rdClient, closeRdClient, err := newResourceDirectoryClient(config.Clients.ResourceDirectory, fileWatcher, logger, tracerProvider)
+	if err != nil {
+		resourceSubscriber.Close()
+		return nil, fmt.Errorf("cannot create resource-directory client: %w", err)
+	}
+	resourceSubscriber.AddCloseFunc(closeRdClient)
+
+	validator, err := validator.New(ctx, config.APIs.MQTT.Authorization.Config, fileWatcher, logger, tracerProvider)
+	if err != nil {
+		resourceSubscriber.Close()
+		return nil, fmt.Errorf("cannot create jwt validator: %w", err)
+	}
+	resourceSubscriber.AddCloseFunc(validator.Close)
+
+	ownerCache := idClient.NewOwnerCache(config.APIs.MQTT.Authorization.OwnerClaim, config.APIs.MQTT.OwnerCacheExpiration, resourceSubscriber, isClient, func(err error) {
+		logger.Errorf("ownerCache error: %w", err)
+	})
+	resourceSubscriber.AddCloseFunc(ownerCache.Close)
+
+	listener, err := listener.New(config.APIs.MQTT.Config, fileWatcher, logger, tracerProvider)
+	if err != nil {
+		resourceSubscriber.Close()
+		return nil, fmt.Errorf("cannot create mqtt listener: %w", err)
+	}
+
+	ctx, cancelSessions := context.WithCancel(ctx)
+	s := &Service{
+		ctx:                ctx,
+		cancel:             cancelSessions,
+		instanceID:         uuid.New(),
+		tracerProvider:     tracerProvider,
+		logger:             logger,
+		isClient:           isClient,
+		rdClient:           rdClient,
+		raClient:           raClient,
+		resourceSubscriber: resourceSubscriber,
+		jwtValidator:       validator.GetParser(),
+		ownerCache:         ownerCache,
+		taskQueue:          queue,
+		listener:           listener,
+		config:             config,
+		sessions:           make(map[*session]struct{}),
+	}
+
+	services, err := s.createServices()
+	if err != nil {
+		_ = listener.Close()
+		resourceSubscriber.Close()
+		return nil, err
+	}
+	services.AddCloseFunc(resourceSubscriber.Close)
+	return services, nil
+}
+
+func (s *Service) createServices() (*service.Service, error) {
+	services := service.New(s)
+	serviceHeartbeat, err := raClient.NewServiceHeartbeat(s.instanceID, s.config.ServiceHeartbeat.TimeToLive, s.raClient, s.logger, services)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create service heartbeat: %w", err)
+	}
+	services.Add(serviceHeartbeat)
+	return services, nil
+}
+
+// ValidateToken validates the access token sent by the device in the password of the CONNECT packet.
+func (s *Service) ValidateToken(ctx context.Context, token string) (pkgJwt.Claims, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.config.APIs.MQTT.ConnectTimeout)
+	defer cancel()
+	m, err := s.jwtValidator.ParseWithContext(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	return pkgJwt.Claims(m), nil
+}
+
+func (s *Service) addSession(c *session) bool {
+	s.sessionsMutex.Lock()
+	defer s.sessionsMutex.Unlock()
+	if s.sessionsClosed {
+		return false
+	}
+	s.sessions[c] = struct{}{}
+	s.sessionsWg.Add(1)
+	return true
+}
+
+func (s *Service) removeSession(c *session) {
+	s.sessionsMutex.Lock()
+	defer s.sessionsMutex.Unlock()
+	if _, ok := s.sessions[c]; ok {
+		delete(s.sessions, c)
+		s.sessionsWg.Done()
+	}
+}
+
+// closeSessions closes the connections of the devices except the session.
+func (s *Service) closeSessions(deviceID string, except *session) {
+	s.sessionsMutex.Lock()
+	sessions := make([]*session, 0, 1)
+	for c := range s.sessions {
+		if c != except && c.deviceID() == deviceID {
+			sessions = append(sessions, c)
+		}
+	}
+	s.sessionsMutex.Unlock()
+	for _, c := range sessions {
+		c.Debugf("device connected from another connection %v", except.RemoteAddr())
+		c.Close()
+	}
+}
+
+// Serve accepts the connections of the devices.
+func (s *Service) Serve() error {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return nil
+			}
+			var netErr net.Error
+			if errors.As(err, &netErr) && netErr.Timeout() {
+				time.Sleep(time.Millisecond * 10)
+				continue
+			}
+			return fmt.Errorf("cannot accept connection: %w", err)
+		}
+		c := newSession(s, conn)
+		if !s.addSession(c) {
+			_ = conn.Close()
+			return nil
+		}
+		go func() {
+			defer s.removeSession(c)
+			c.run()
+		}()
+	}
+}
+
+// Close stops accepting the connections, closes the connections of the devices and waits until they are cleaned up.
+func (s *Service) Close() error {
+	err := s.listener.Close()
+	s.sessionsMutex.Lock()
+	s.sessionsClosed = true
+	sessions := make([]*session, 0, len(s.sessions))
+	for c := range s.sessions {
+		sessions = append(sessions, c)
+	}
+	s.sessionsMutex.Unlock()
+	for _, c := range sessions {
+		c.Close()
+	}
+	s.sessionsWg.Wait()
+	s.cancel()
+	return err
+}
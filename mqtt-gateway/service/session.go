@@ -0,0 +1,470 @@
+package service
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/eclipse/paho.mqtt.golang/packets"
+	"github.com/plgd-dev/device/v2/pkg/net/coap"
+	grpcClient "github.com/plgd-dev/hub/v2/grpc-gateway/client"
+	"github.com/plgd-dev/hub/v2/identity-store/events"
+	"github.com/plgd-dev/hub/v2/pkg/log"
+	kitNetGrpc "github.com/plgd-dev/hub/v2/pkg/net/grpc"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/commands"
+	"go.uber.org/atomic"
+)
+
+var errDisconnect = errors.New("device sent disconnect")
+
+// session of the connection of the MQTT client.
+type session struct {
+	conn       net.Conn
+	server     *Service
+	ctx        context.Context
+	cancel     context.CancelFunc
+	sequence   atomic.Uint64
+	writeMutex sync.Mutex
+	private    struct { // guarded by mutex
+		mutex                   sync.Mutex
+		deviceID                string
+		owner                   string
+		token                   string
+		twinEnabled             bool
+		online                  bool
+		deviceSubscriber        *grpcClient.DeviceSubscriber
+		closeEventsSubscription func()
+		tokenExpiration         *time.Timer
+		// subscriptions of the device, topic filter -> granted QoS
+		subscriptions map[string]byte
+		// hrefs of the resources published to the resource-aggregate
+		hrefs map[string]struct{}
+		// commands waiting for the response of the device, correlationID -> response
+		pendingCommands map[string]chan commandResponse
+		// message IDs of the QoS 2 publishes waiting for PUBREL
+		receivedQoS2 map[uint16]struct{}
+		// protocol level of the CONNECT packet
+		protocolVersion byte
+		// maximum packet size accepted by the device of MQTT 5, 0 means no limit
+		maximumPacketSize uint32
+	}
+}
+
+// packetWriter is the packet of paho or the encoded packet of MQTT 5.
+type packetWriter interface {
+	Write(w io.Writer) error
+}
+
+// newSession creates and initializes session
+func newSession(server *Service, conn net.Conn) *session {
+	ctx, cancel := context.WithCancel(server.ctx)
+	c := &session{
+		server: server,
+		conn:   conn,
+		ctx:    ctx,
+		cancel: cancel,
+	}
+	c.private.subscriptions = make(map[string]byte)
+	c.private.hrefs = make(map[string]struct{})
+	c.private.pendingCommands = make(map[string]chan commandResponse)
+	c.private.receivedQoS2 = make(map[uint16]struct{})
+	return c
+}
+
+func (c *session) deviceID() string {
+	c.private.mutex.Lock()
+	defer c.private.mutex.Unlock()
+	return c.private.deviceID
+}
+
+func (c *session) isTwinEnabled() bool {
+	c.private.mutex.Lock()
+	defer c.private.mutex.Unlock()
+	return c.private.twinEnabled
+}
+
+func (c *session) setTwinEnabled(twinEnabled bool) {
+	c.private.mutex.Lock()
+	defer c.private.mutex.Unlock()
+	c.private.twinEnabled = twinEnabled
+}
+
+func (c *session) protocolVersion() byte {
+	c.private.mutex.Lock()
+	defer c.private.mutex.Unlock()
+	return c.private.protocolVersion
+}
+
+func (c *session) setProtocolVersion(protocolVersion byte, maximumPacketSize uint32) {
+	c.private.mutex.Lock()
+	defer c.private.mutex.Unlock()
+	c.private.protocolVersion = protocolVersion
+	c.private.maximumPacketSize = maximumPacketSize
+}
+
+func (c *session) setOnline(twinEnabled bool) {
+	c.private.mutex.Lock()
+	defer c.private.mutex.Unlock()
+	c.private.online = true
+	c.private.twinEnabled = twinEnabled
+}
+
+func (c *session) RemoteAddr() net.Addr {
+	return c.conn.RemoteAddr()
+}
+
+func (c *session) Context() context.Context {
+	return c.ctx
+}
+
+// ctxWithToken returns the context with the access token of the device, which was sent in the CONNECT packet.
+func (c *session) ctxWithToken(ctx context.Context) context.Context {
+	c.private.mutex.Lock()
+	defer c.private.mutex.Unlock()
+	return kitNetGrpc.CtxWithToken(ctx, c.private.token)
+}
+
+// GetContext returns the context with the token of the device, it is used by the device subscriber.
+func (c *session) GetContext() (context.Context, context.CancelFunc) {
+	return c.ctxWithToken(c.Context()), func() {
+		// no-op
+	}
+}
+
+func (c *session) newCommandMetadata() *commands.CommandMetadata {
+	return &commands.CommandMetadata{
+		ConnectionId: c.RemoteAddr().String(),
+		Sequence:     c.sequence.Inc(),
+	}
+}
+
+// Close closes the connection of the device.
+func (c *session) Close() {
+	c.cancel()
+	if err := c.conn.Close(); err != nil && !errors.Is(err, net.ErrClosed) {
+		c.Errorf("cannot close client: %w", err)
+	}
+}
+
+func (c *session) getLogger() log.Logger {
+	logger := c.server.logger
+	if deviceID := c.deviceID(); deviceID != "" {
+		logger = logger.With(log.DeviceIDKey, deviceID)
+	}
+	return logger.With("remoteAddr", c.RemoteAddr().String())
+}
+
+func (c *session) Errorf(fmt string, args ...interface{}) {
+	c.getLogger().Errorf(fmt, args...)
+}
+
+func (c *session) Debugf(fmt string, args ...interface{}) {
+	c.getLogger().Debugf(fmt, args...)
+}
+
+// writePacket writes the packet to the device. The packets are written from the read loop and from the commands.
+func (c *session) writePacket(p packetWriter) error {
+	c.writeMutex.Lock()
+	defer c.writeMutex.Unlock()
+	return p.Write(c.conn)
+}
+
+func (c *session) writeConnack(returnCode byte) error {
+	if c.protocolVersion() == protocolVersion5 {
+		return c.writePacket(newConnackV5(connackReasonCodes[returnCode], c.server.config.APIs.MQTT.MaxPacketSize))
+	}
+	p := packets.NewControlPacket(packets.Connack).(*packets.ConnackPacket)
+	p.ReturnCode = returnCode
+	return c.writePacket(p)
+}
+
+// run serves the connection until it is closed.
+func (c *session) run() {
+	defer c.onClose()
+	connectPacket, err := c.connect()
+	if err != nil {
+		c.Debugf("cannot establish connection: %v", err)
+		return
+	}
+	c.Debugf("device connected")
+	c.server.closeSessions(c.deviceID(), c)
+	err = c.serve(connectPacket.Keepalive)
+	if err == nil || errors.Is(err, errDisconnect) || errors.Is(err, io.EOF) || errors.Is(err, net.ErrClosed) {
+		return
+	}
+	c.Debugf("connection closed: %v", err)
+}
+
+func deviceIDFromConnectionState(state tls.ConnectionState) (string, error) {
+	if len(state.PeerCertificates) == 0 {
+		return "", errors.New("device certificate is not set")
+	}
+	return coap.GetDeviceIDFromIdentityCertificate(state.PeerCertificates[0])
+}
+
+// connect performs the TLS handshake and handles the CONNECT packet of the device.
+func (c *session) connect() (*packets.ConnectPacket, error) {
+	deadline := time.Now().Add(c.server.config.APIs.MQTT.ConnectTimeout)
+	if err := c.conn.SetDeadline(deadline); err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithDeadline(c.Context(), deadline)
+	defer cancel()
+	tlsConn, ok := c.conn.(*tls.Conn)
+	if !ok {
+		return nil, fmt.Errorf("unsupported connection type %T", c.conn)
+	}
+	if err := tlsConn.HandshakeContext(ctx); err != nil {
+		return nil, fmt.Errorf("tls handshake failed: %w", err)
+	}
+	deviceID, err := deviceIDFromConnectionState(tlsConn.ConnectionState())
+	if err != nil {
+		return nil, fmt.Errorf("cannot get device id from certificate: %w", err)
+	}
+	p, err := readPacket(c.conn, c.server.config.APIs.MQTT.MaxPacketSize, 0)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read connect packet: %w", err)
+	}
+	var connectPacket *packets.ConnectPacket
+	switch v := p.(type) {
+	case *packets.ConnectPacket:
+		connectPacket = v
+		c.setProtocolVersion(v.ProtocolVersion, 0)
+	case *connectPacketV5:
+		connectPacket = v.ConnectPacket
+		c.setProtocolVersion(v.ProtocolVersion, v.properties.maximumPacketSize)
+	default:
+		return nil, fmt.Errorf("unexpected packet %v, connect packet is expected", p)
+	}
+	if returnCode, err := c.authenticate(ctx, deviceID, connectPacket); err != nil {
+		if errW := c.writeConnack(returnCode); errW != nil {
+			c.Debugf("cannot write connack: %v", errW)
+		}
+		return nil, err
+	}
+	twinEnabled, err := c.setDeviceOnline(ctx, deviceID)
+	if err != nil {
+		if errW := c.writeConnack(packets.ErrRefusedServerUnavailable); errW != nil {
+			c.Debugf("cannot write connack: %v", errW)
+		}
+		return nil, fmt.Errorf("cannot set device %v online: %w", deviceID, err)
+	}
+	c.setOnline(twinEnabled)
+	if err = c.writeConnack(packets.Accepted); err != nil {
+		return nil, fmt.Errorf("cannot write connack: %w", err)
+	}
+	if err = c.conn.SetDeadline(time.Time{}); err != nil {
+		return nil, err
+	}
+	return connectPacket, nil
+}
+
+// validateConnect validates the CONNECT packet, paho validates only the packets of MQTT 3.1 and 3.1.1.
+func validateConnect(p *packets.ConnectPacket) byte {
+	if p.ProtocolName != "MQTT" || p.ProtocolVersion != protocolVersion5 {
+		return p.Validate()
+	}
+	if p.ReservedBit != 0 {
+		return packets.ErrProtocolViolation
+	}
+	return packets.Accepted
+}
+
+// authenticate validates the access token from the password of the CONNECT packet and checks that the user
+// from the username owns the device identified by the certificate. The CONNACK return code is returned on failure.
+func (c *session) authenticate(ctx context.Context, deviceID string, p *packets.ConnectPacket) (byte, error) {
+	if returnCode := validateConnect(p); returnCode != packets.Accepted {
+		return returnCode, fmt.Errorf("invalid connect packet: %v", packets.ConnackReturnCodes[returnCode])
+	}
+	if p.ClientIdentifier != "" && p.ClientIdentifier != deviceID {
+		return packets.ErrRefusedIDRejected, fmt.Errorf("client identifier('%v') doesn't match the device id('%v') from the certificate", p.ClientIdentifier, deviceID)
+	}
+	if !p.UsernameFlag || !p.PasswordFlag {
+		return packets.ErrRefusedBadUsernameOrPassword, errors.New("username and password are required")
+	}
+	token := string(p.Password)
+	claims, err := c.server.ValidateToken(ctx, token)
+	if err != nil {
+		return packets.ErrRefusedNotAuthorised, fmt.Errorf("invalid access token: %w", err)
+	}
+	ownerClaim := c.server.config.APIs.MQTT.Authorization.OwnerClaim
+	if err = claims.ValidateOwnerClaim(ownerClaim, p.Username); err != nil {
+		return packets.ErrRefusedNotAuthorised, err
+	}
+	expiration, err := claims.GetExpirationTime()
+	if err != nil {
+		return packets.ErrRefusedNotAuthorised, fmt.Errorf("invalid access token: %w", err)
+	}
+
+	c.private.mutex.Lock()
+	c.private.deviceID = deviceID
+	c.private.owner = p.Username
+	c.private.token = token
+	c.private.mutex.Unlock()
+
+	// subscribe to updates before checking cache, so when the device gets removed during connect
+	// the client will always be closed
+	if err = c.subscribeToDeviceEvents(p.Username, deviceID); err != nil {
+		return packets.ErrRefusedServerUnavailable, err
+	}
+	ok, err := c.server.ownerCache.OwnsDevice(c.ctxWithToken(ctx), deviceID)
+	if err != nil {
+		return packets.ErrRefusedServerUnavailable, fmt.Errorf("cannot check owner of the device: %w", err)
+	}
+	if !ok {
+		return packets.ErrRefusedNotAuthorised, fmt.Errorf("device %v is not owned by %v", deviceID, p.Username)
+	}
+	if expiration != nil {
+		c.private.mutex.Lock()
+		c.private.tokenExpiration = time.AfterFunc(time.Until(expiration.Time), func() {
+			c.Debugf("access token has expired")
+			c.Close()
+		})
+		c.private.mutex.Unlock()
+	}
+	return packets.Accepted, nil
+}
+
+func (c *session) subscribeToDeviceEvents(owner, deviceID string) error {
+	closeFn, err := c.server.ownerCache.Subscribe(owner, func(e *events.Event) {
+		evt := e.GetDevicesUnregistered()
+		if evt == nil {
+			return
+		}
+		if evt.GetOwner() != owner {
+			return
+		}
+		if !slices.Contains(evt.GetDeviceIds(), deviceID) {
+			return
+		}
+		c.Close()
+	})
+	if err != nil {
+		return fmt.Errorf("cannot subscribe to device events: %w", err)
+	}
+	c.private.mutex.Lock()
+	c.private.closeEventsSubscription = closeFn
+	c.private.mutex.Unlock()
+	return nil
+}
+
+func (c *session) setDeviceOnline(ctx context.Context, deviceID string) (bool, error) {
+	resp, err := c.server.raClient.UpdateDeviceMetadata(c.ctxWithToken(ctx), &commands.UpdateDeviceMetadataRequest{
+		DeviceId: deviceID,
+		Update: &commands.UpdateDeviceMetadataRequest_Connection{
+			Connection: &commands.Connection{
+				Status:      commands.Connection_ONLINE,
+				ConnectedAt: time.Now().UnixNano(),
+				Protocol:    commands.Connection_MQTT,
+				ServiceId:   c.server.instanceID.String(),
+			},
+		},
+		CommandMetadata: c.newCommandMetadata(),
+	})
+	if err != nil {
+		return false, err
+	}
+	return resp.GetTwinEnabled(), nil
+}
+
+func (c *session) setDeviceOffline(ctx context.Context, deviceID string) {
+	ctx, cancel := context.WithTimeout(ctx, c.server.config.APIs.MQTT.Timeout)
+	defer cancel()
+	_, err := c.server.raClient.UpdateDeviceMetadata(c.ctxWithToken(ctx), &commands.UpdateDeviceMetadataRequest{
+		DeviceId: deviceID,
+		Update: &commands.UpdateDeviceMetadataRequest_Connection{
+			Connection: &commands.Connection{
+				Status: commands.Connection_OFFLINE,
+			},
+		},
+		CommandMetadata: c.newCommandMetadata(),
+	})
+	if err != nil {
+		// Device will be still reported as online and it can fix his state by next calls online, offline commands.
+		c.Errorf("cannot update device %v status to offline: %w", deviceID, err)
+	}
+}
+
+// cleanUp releases the resources of the connection. It returns the device ID and whether the device was set online.
+func (c *session) cleanUp() (string, bool) {
+	c.private.mutex.Lock()
+	deviceID := c.private.deviceID
+	online := c.private.online
+	c.private.online = false
+	deviceSubscriber := c.private.deviceSubscriber
+	c.private.deviceSubscriber = nil
+	closeEventsSubscription := c.private.closeEventsSubscription
+	c.private.closeEventsSubscription = nil
+	if c.private.tokenExpiration != nil {
+		c.private.tokenExpiration.Stop()
+		c.private.tokenExpiration = nil
+	}
+	c.private.mutex.Unlock()
+
+	if closeEventsSubscription != nil {
+		closeEventsSubscription()
+	}
+	if deviceSubscriber != nil {
+		if err := deviceSubscriber.Close(); err != nil {
+			c.Errorf("cleanUp error: failed to close device %v subscription: %w", deviceID, err)
+		}
+	}
+	return deviceID, online
+}
+
+// onClose is invoked when the connection was closed.
+func (c *session) onClose() {
+	c.Close()
+	deviceID, online := c.cleanUp()
+	if !online {
+		return
+	}
+	c.Debugf("close device connection")
+	c.setDeviceOffline(context.Background(), deviceID)
+}
+
+// serve reads the packets of the connected device until the connection is closed.
+func (c *session) serve(keepalive uint16) error {
+	for {
+		if keepalive > 0 {
+			// the server disconnects the client after one and a half times the keep alive period (MQTT 3.1.1 section 3.1.2.10)
+			if err := c.conn.SetReadDeadline(time.Now().Add(time.Duration(keepalive) * time.Second * 3 / 2)); err != nil {
+				return err
+			}
+		}
+		p, err := readPacket(c.conn, c.server.config.APIs.MQTT.MaxPacketSize, c.protocolVersion())
+		if err != nil {
+			return err
+		}
+		if err = c.handlePacket(p); err != nil {
+			return err
+		}
+	}
+}
+
+func (c *session) handlePacket(p packets.ControlPacket) error {
+	switch v := p.(type) {
+	case *packets.PingreqPacket:
+		return c.writePacket(packets.NewControlPacket(packets.Pingresp))
+	case *packets.SubscribePacket:
+		return c.handleSubscribe(v)
+	case *packets.UnsubscribePacket:
+		return c.handleUnsubscribe(v)
+	case *packets.PublishPacket:
+		return c.handlePublish(v)
+	case *packets.PubrelPacket:
+		return c.handlePubrel(v)
+	case *packets.DisconnectPacket:
+		return errDisconnect
+	case *packets.ConnectPacket, *connectPacketV5:
+		return errors.New("protocol violation: connect packet was sent twice")
+	default:
+		return fmt.Errorf("unexpected packet %v", p)
+	}
+}
@@ -0,0 +1,168 @@
+package service
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/eclipse/paho.mqtt.golang/packets"
+	"github.com/plgd-dev/hub/v2/mqtt-gateway/topic"
+	"github.com/plgd-dev/hub/v2/pkg/log"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/commands"
+	"github.com/stretchr/testify/require"
+)
+
+const testDeviceID = "0b6b9a80-2b4b-4c4b-8e8c-6f2b0a3c7d11"
+
+// newTestSession returns the session of the device and the connection of the device.
+func newTestSession(t *testing.T) (*session, net.Conn) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	var cfg Config
+	cfg.APIs.MQTT.MaxPacketSize = 1024
+	cfg.APIs.MQTT.Timeout = time.Second * 5
+	s := &Service{
+		ctx:    ctx,
+		logger: log.Get(),
+		config: cfg,
+	}
+	serverConn, deviceConn := net.Pipe()
+	t.Cleanup(func() {
+		_ = serverConn.Close()
+		_ = deviceConn.Close()
+	})
+	c := newSession(s, serverConn)
+	c.private.deviceID = testDeviceID
+	return c, deviceConn
+}
+
+// readPacketOfDevice reads the packet sent by the gateway to the device.
+func readPacketOfDevice(t *testing.T, r io.Reader) (packets.FixedHeader, *decoder) {
+	header := make([]byte, 1)
+	_, err := io.ReadFull(r, header)
+	require.NoError(t, err)
+	lengthDecoder := &decoder{}
+	b := make([]byte, 1)
+	for len(lengthDecoder.data) == 0 || b[0]&0x80 != 0 {
+		_, err = io.ReadFull(r, b)
+		require.NoError(t, err)
+		lengthDecoder.data = append(lengthDecoder.data, b[0])
+	}
+	remainingLength := lengthDecoder.readVarint()
+	require.NoError(t, lengthDecoder.err)
+	body := make([]byte, remainingLength)
+	_, err = io.ReadFull(r, body)
+	require.NoError(t, err)
+	return newFixedHeader(header[0], remainingLength), &decoder{data: body}
+}
+
+// writeAsync writes the packet in the background, because the pipe blocks the writer until the device reads the packet.
+func writeAsync(fn func() error) <-chan error {
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- fn()
+	}()
+	return errCh
+}
+
+func TestSessionAuthenticateV5(t *testing.T) {
+	c, _ := newTestSession(t)
+	p := packets.NewControlPacket(packets.Connect).(*packets.ConnectPacket)
+	p.ProtocolName = "MQTT"
+	p.ProtocolVersion = protocolVersion5
+	p.ClientIdentifier = "otherDevice"
+	// the connection of MQTT 5 passes the validation and it is rejected by the client identifier
+	returnCode, err := c.authenticate(context.Background(), testDeviceID, p)
+	require.Error(t, err)
+	require.Equal(t, byte(packets.ErrRefusedIDRejected), returnCode)
+
+	p.ProtocolVersion = 6
+	returnCode, err = c.authenticate(context.Background(), testDeviceID, p)
+	require.Error(t, err)
+	require.Equal(t, byte(packets.ErrRefusedBadProtocolVersion), returnCode)
+}
+
+func TestSessionConnackV5(t *testing.T) {
+	c, deviceConn := newTestSession(t)
+	c.setProtocolVersion(protocolVersion5, 0)
+	errCh := writeAsync(func() error {
+		return c.writeConnack(packets.ErrRefusedNotAuthorised)
+	})
+	fh, d := readPacketOfDevice(t, deviceConn)
+	require.NoError(t, <-errCh)
+	require.Equal(t, byte(packets.Connack), fh.MessageType)
+	require.Equal(t, byte(0), d.readByte())
+	require.Equal(t, byte(reasonNotAuthorized), d.readByte())
+	props := d.readProperties()
+	require.NoError(t, d.err)
+	require.Equal(t, uint32(1024), props.maximumPacketSize)
+}
+
+func TestSessionSubscriptionsV5(t *testing.T) {
+	c, deviceConn := newTestSession(t)
+	c.setProtocolVersion(protocolVersion5, 0)
+	filter := topic.Prefix + "/" + testDeviceID + "/" + topic.CommandKind + "/#"
+	c.private.subscriptions[filter] = 0
+
+	errCh := writeAsync(func() error {
+		return c.writeSuback(&packets.SubackPacket{MessageID: 1, ReturnCodes: []byte{0, subackFailure}})
+	})
+	fh, d := readPacketOfDevice(t, deviceConn)
+	require.NoError(t, <-errCh)
+	require.Equal(t, byte(packets.Suback), fh.MessageType)
+	require.Equal(t, uint16(1), d.readUint16())
+	d.readProperties()
+	require.Equal(t, []byte{0, reasonNotAuthorized}, d.rest())
+
+	errCh = writeAsync(func() error {
+		return c.handlePacket(&packets.UnsubscribePacket{MessageID: 2, Topics: []string{filter, "plgd/unknown"}})
+	})
+	fh, d = readPacketOfDevice(t, deviceConn)
+	require.NoError(t, <-errCh)
+	require.Equal(t, byte(packets.Unsuback), fh.MessageType)
+	require.Equal(t, uint16(2), d.readUint16())
+	d.readProperties()
+	require.Equal(t, []byte{reasonSuccess, reasonNoSubscriptionExisted}, d.rest())
+	require.False(t, c.isSubscribed(topic.Command(testDeviceID, topic.MethodRetrieve, "correlationID", "/light/1")))
+}
+
+func TestSessionCommandV5(t *testing.T) {
+	c, deviceConn := newTestSession(t)
+	c.setProtocolVersion(protocolVersion5, 0)
+	c.private.subscriptions[topic.Prefix+"/"+testDeviceID+"/"+topic.CommandKind+"/#"] = 0
+	resourceID := commands.NewResourceID(testDeviceID, "/light/1")
+	content := &commands.Content{
+		Data:              []byte(`{"state":true}`),
+		CoapContentFormat: -1,
+		ContentType:       "application/json",
+	}
+
+	respCh := make(chan commandResponse, 1)
+	go func() {
+		respCh <- c.sendCommand(context.Background(), topic.MethodUpdate, resourceID, "correlationID", content)
+	}()
+	fh, d := readPacketOfDevice(t, deviceConn)
+	require.Equal(t, byte(packets.Publish), fh.MessageType)
+	require.Equal(t, byte(0), fh.Qos)
+	require.Equal(t, topic.Command(testDeviceID, topic.MethodUpdate, "correlationID", "/light/1"), d.readString())
+	props := d.readProperties()
+	require.Equal(t, []byte("correlationID"), props.correlationData)
+	require.Equal(t, []byte(`{"state":true}`), d.rest())
+	require.NoError(t, d.err)
+
+	// the device publishes the response of MQTT 5 to the response topic
+	raw := newRawPacket(packets.Publish<<4, appendBinary(nil, []byte(topic.Response(testDeviceID, "correlationID", commands.Status_OK))), nil, []byte(`{"state":true}`))
+	p, err := decodePacketV5(newFixedHeader(raw[0], uint32(len(raw)-2)), raw[2:])
+	require.NoError(t, err)
+	require.NoError(t, c.handlePacket(p))
+	resp := <-respCh
+	require.Equal(t, commands.Status_OK, resp.status)
+	require.Equal(t, []byte(`{"state":true}`), resp.content.GetData())
+
+	// the command exceeds the maximum packet size of the device
+	c.setProtocolVersion(protocolVersion5, 16)
+	resp = c.sendCommand(context.Background(), topic.MethodUpdate, resourceID, "correlationID", content)
+	require.Equal(t, commands.Status_BAD_REQUEST, resp.status)
+}
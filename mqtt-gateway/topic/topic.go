@@ -0,0 +1,165 @@
+package topic
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/commands"
+)
+
+// Topics used by the devices. The {deviceId} is the ID of the device from the identity certificate.
+//
+//	plgd/{deviceId}/links                        device publishes the resource links (JSON array of the OCF resource links)
+//	plgd/{deviceId}/res/{href}                   device publishes the content of the resource
+//	plgd/{deviceId}/cmd/{method}/{correlationId}/{href}  gateway publishes the pending command to the device
+//	plgd/{deviceId}/resp/{correlationId}/{status}         device publishes the result of the command
+//
+// The devices of MQTT 5 get the correlationId of the command also in the Correlation Data property.
+const (
+	Prefix = "plgd"
+
+	ResourceLinksKind = "links"
+	ResourceKind      = "res"
+	CommandKind       = "cmd"
+	ResponseKind      = "resp"
+
+	SingleLevelWildcard = "+"
+	MultiLevelWildcard  = "#"
+)
+
+// Method of the pending command delivered to the device.
+type Method string
+
+const (
+	MethodUpdate   Method = "update"
+	MethodRetrieve Method = "retrieve"
+	MethodDelete   Method = "delete"
+	MethodCreate   Method = "create"
+)
+
+// Topic is the parsed topic published by the device.
+type Topic struct {
+	DeviceID string
+	Kind     string
+	// Href of the resource for ResourceKind
+	Href string
+	// CorrelationID and Status of the command for ResponseKind
+	CorrelationID string
+	Status        commands.Status
+}
+
+func hrefFromLevels(levels []string) (string, error) {
+	if len(levels) == 0 {
+		return "", errors.New("href is not set")
+	}
+	for _, l := range levels {
+		if l == "" {
+			return "", errors.New("href contains empty level")
+		}
+	}
+	return "/" + strings.Join(levels, "/"), nil
+}
+
+func parseStatus(v string) (commands.Status, error) {
+	s, ok := commands.Status_value[strings.ToUpper(v)]
+	if !ok {
+		return commands.Status_UNKNOWN, fmt.Errorf("invalid status('%v')", v)
+	}
+	return commands.Status(s), nil
+}
+
+// Parse parses the topic published by the device.
+func Parse(t string) (Topic, error) {
+	levels := strings.Split(t, "/")
+	if len(levels) < 3 || levels[0] != Prefix {
+		return Topic{}, fmt.Errorf("invalid topic('%v'): unknown prefix", t)
+	}
+	if _, err := uuid.Parse(levels[1]); err != nil {
+		return Topic{}, fmt.Errorf("invalid topic('%v'): invalid deviceId: %w", t, err)
+	}
+	v := Topic{
+		DeviceID: levels[1],
+		Kind:     levels[2],
+	}
+	var err error
+	switch v.Kind {
+	case ResourceLinksKind:
+		if len(levels) != 3 {
+			return Topic{}, fmt.Errorf("invalid topic('%v'): unexpected levels", t)
+		}
+	case ResourceKind:
+		v.Href, err = hrefFromLevels(levels[3:])
+		if err != nil {
+			return Topic{}, fmt.Errorf("invalid topic('%v'): %w", t, err)
+		}
+	case ResponseKind:
+		if len(levels) != 5 || levels[3] == "" {
+			return Topic{}, fmt.Errorf("invalid topic('%v'): expected %v/{deviceId}/%v/{correlationId}/{status}", t, Prefix, ResponseKind)
+		}
+		v.CorrelationID = levels[3]
+		v.Status, err = parseStatus(levels[4])
+		if err != nil {
+			return Topic{}, fmt.Errorf("invalid topic('%v'): %w", t, err)
+		}
+	default:
+		return Topic{}, fmt.Errorf("invalid topic('%v'): unknown kind('%v')", t, v.Kind)
+	}
+	return v, nil
+}
+
+// Resource returns the topic of the resource content.
+func Resource(deviceID, href string) string {
+	return Prefix + "/" + deviceID + "/" + ResourceKind + "/" + strings.TrimPrefix(href, "/")
+}
+
+// Command returns the topic of the pending command.
+func Command(deviceID string, method Method, correlationID, href string) string {
+	return Prefix + "/" + deviceID + "/" + CommandKind + "/" + string(method) + "/" + correlationID + "/" + strings.TrimPrefix(href, "/")
+}
+
+// Response returns the topic of the result of the command.
+func Response(deviceID, correlationID string, status commands.Status) string {
+	return Prefix + "/" + deviceID + "/" + ResponseKind + "/" + correlationID + "/" + status.String()
+}
+
+// ValidateFilter checks the topic filter of the subscription according to MQTT 3.1.1 section 4.7.1.
+func ValidateFilter(filter string) error {
+	if filter == "" {
+		return errors.New("empty topic filter")
+	}
+	levels := strings.Split(filter, "/")
+	for i, l := range levels {
+		if l == MultiLevelWildcard && i != len(levels)-1 {
+			return fmt.Errorf("invalid topic filter('%v'): '%v' must be the last level", filter, MultiLevelWildcard)
+		}
+		if l != MultiLevelWildcard && l != SingleLevelWildcard && strings.ContainsAny(l, MultiLevelWildcard+SingleLevelWildcard) {
+			return fmt.Errorf("invalid topic filter('%v'): wildcard must occupy entire level", filter)
+		}
+	}
+	return nil
+}
+
+// Match returns true when the topic matches the topic filter.
+func Match(filter, t string) bool {
+	filterLevels := strings.Split(filter, "/")
+	levels := strings.Split(t, "/")
+	for i, f := range filterLevels {
+		if f == MultiLevelWildcard {
+			return true
+		}
+		if i >= len(levels) {
+			return false
+		}
+		if f != SingleLevelWildcard && f != levels[i] {
+			return false
+		}
+	}
+	return len(filterLevels) == len(levels)
+}
+
+// IsCommandFilter returns true when the topic filter matches only the commands of the device.
+func IsCommandFilter(deviceID, filter string) bool {
+	return strings.HasPrefix(filter, Prefix+"/"+deviceID+"/"+CommandKind+"/") && ValidateFilter(filter) == nil
+}
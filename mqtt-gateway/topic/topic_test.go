@@ -0,0 +1,109 @@
+package topic_test
+
+import (
+	"testing"
+
+	"github.com/plgd-dev/hub/v2/mqtt-gateway/topic"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/commands"
+	"github.com/stretchr/testify/require"
+)
+
+const deviceID = "0b6b9a80-2b4b-4c4b-8e8c-6f2b0a3c7d11"
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		topic   string
+		want    topic.Topic
+		wantErr bool
+	}{
+		{
+			name:  "links",
+			topic: "plgd/" + deviceID + "/links",
+			want:  topic.Topic{DeviceID: deviceID, Kind: topic.ResourceLinksKind},
+		},
+		{
+			name:  "resource",
+			topic: topic.Resource(deviceID, "/light/1"),
+			want:  topic.Topic{DeviceID: deviceID, Kind: topic.ResourceKind, Href: "/light/1"},
+		},
+		{
+			name:  "response",
+			topic: topic.Response(deviceID, "c1", commands.Status_BAD_REQUEST),
+			want:  topic.Topic{DeviceID: deviceID, Kind: topic.ResponseKind, CorrelationID: "c1", Status: commands.Status_BAD_REQUEST},
+		},
+		{
+			name:  "response with lower case status",
+			topic: "plgd/" + deviceID + "/resp/c1/ok",
+			want:  topic.Topic{DeviceID: deviceID, Kind: topic.ResponseKind, CorrelationID: "c1", Status: commands.Status_OK},
+		},
+		{
+			name:    "invalid prefix",
+			topic:   "oic/" + deviceID + "/links",
+			wantErr: true,
+		},
+		{
+			name:    "invalid deviceId",
+			topic:   "plgd/abc/links",
+			wantErr: true,
+		},
+		{
+			name:    "resource without href",
+			topic:   "plgd/" + deviceID + "/res",
+			wantErr: true,
+		},
+		{
+			name:    "resource with empty level",
+			topic:   "plgd/" + deviceID + "/res/light//1",
+			wantErr: true,
+		},
+		{
+			name:    "invalid status",
+			topic:   "plgd/" + deviceID + "/resp/c1/done",
+			wantErr: true,
+		},
+		{
+			name:    "command is published only by gateway",
+			topic:   topic.Command(deviceID, topic.MethodUpdate, "c1", "/light/1"),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := topic.Parse(tt.topic)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestMatch(t *testing.T) {
+	cmd := topic.Command(deviceID, topic.MethodRetrieve, "c1", "/light/1")
+	require.Equal(t, "plgd/"+deviceID+"/cmd/retrieve/c1/light/1", cmd)
+	require.True(t, topic.Match("plgd/"+deviceID+"/cmd/#", cmd))
+	require.True(t, topic.Match("plgd/+/cmd/+/+/light/1", cmd))
+	require.True(t, topic.Match("#", cmd))
+	require.False(t, topic.Match("plgd/"+deviceID+"/cmd/update/#", cmd))
+	require.False(t, topic.Match("plgd/"+deviceID+"/cmd/+", cmd))
+	require.False(t, topic.Match(cmd+"/x", cmd))
+}
+
+func TestValidateFilter(t *testing.T) {
+	require.NoError(t, topic.ValidateFilter("plgd/+/cmd/#"))
+	require.Error(t, topic.ValidateFilter(""))
+	require.Error(t, topic.ValidateFilter("plgd/#/cmd"))
+	require.Error(t, topic.ValidateFilter("plgd/dev+/cmd"))
+}
+
+func TestIsCommandFilter(t *testing.T) {
+	require.True(t, topic.IsCommandFilter(deviceID, "plgd/"+deviceID+"/cmd/#"))
+	require.True(t, topic.IsCommandFilter(deviceID, "plgd/"+deviceID+"/cmd/update/+/light/1"))
+	require.False(t, topic.IsCommandFilter(deviceID, "plgd/+/cmd/#"))
+	require.False(t, topic.IsCommandFilter(deviceID, "plgd/"+deviceID+"/#"))
+	require.False(t, topic.IsCommandFilter(deviceID, "plgd/9a8d6c2e-4c1b-4f0e-a3d5-2b7f1e6c8a90/cmd/#"))
+	require.False(t, topic.IsCommandFilter(deviceID, "plgd/"+deviceID+"/cmd/update#"))
+}
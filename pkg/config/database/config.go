@@ -14,8 +14,10 @@ func (u DBUse) ToLower() DBUse {
 }
 
 const (
-	MongoDB DBUse = "mongoDB"
-	CqlDB   DBUse = "cqlDB"
+	MongoDB    DBUse = "mongoDB"
+	CqlDB      DBUse = "cqlDB"
+	PostgreSQL DBUse = "postgreSQL"
+	Memory     DBUse = "memory"
 )
 
 type DBConfig interface {
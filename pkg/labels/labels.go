@@ -0,0 +1,93 @@
+package labels
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	maxKeyPrefixLength = 253
+	maxNameLength      = 63
+)
+
+var (
+	ErrInvalidKey   = errors.New("invalid label key")
+	ErrInvalidValue = errors.New("invalid label value")
+
+	nameRegexp   = regexp.MustCompile(`^[A-Za-z0-9]([-A-Za-z0-9_.]*[A-Za-z0-9])?$`)
+	prefixRegexp = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`)
+)
+
+// ValidateKey checks the label key. The key consists of an optional DNS subdomain prefix followed by a slash and
+// a name with at most 63 alphanumeric characters, '-', '_' or '.', starting and ending with an alphanumeric character.
+// Eg.: "site", "example.com/rack".
+func ValidateKey(key string) error {
+	name := key
+	if i := strings.LastIndexByte(key, '/'); i >= 0 {
+		prefix := key[:i]
+		name = key[i+1:]
+		if len(prefix) == 0 || len(prefix) > maxKeyPrefixLength || !prefixRegexp.MatchString(prefix) {
+			return fmt.Errorf("%w('%v'): invalid prefix", ErrInvalidKey, key)
+		}
+	}
+	if len(name) == 0 || len(name) > maxNameLength || !nameRegexp.MatchString(name) {
+		return fmt.Errorf("%w('%v'): invalid name", ErrInvalidKey, key)
+	}
+	return nil
+}
+
+// ValidateValue checks the label value. The value is empty or it has at most 63 alphanumeric characters, '-', '_' or '.',
+// starting and ending with an alphanumeric character.
+func ValidateValue(value string) error {
+	if value == "" {
+		return nil
+	}
+	if len(value) > maxNameLength || !nameRegexp.MatchString(value) {
+		return fmt.Errorf("%w('%v')", ErrInvalidValue, value)
+	}
+	return nil
+}
+
+// Validate checks all keys and values of the labels.
+func Validate(labels map[string]string) error {
+	for k, v := range labels {
+		if err := ValidateKey(k); err != nil {
+			return err
+		}
+		if err := ValidateValue(v); err != nil {
+			return fmt.Errorf("label('%v'): %w", k, err)
+		}
+	}
+	return nil
+}
+
+// Update returns a copy of the labels with the set labels added or overwritten and the remove keys removed.
+// Keys present in both set and remove are removed.
+func Update(labels map[string]string, set map[string]string, remove []string) map[string]string {
+	updated := make(map[string]string, len(labels)+len(set))
+	for k, v := range labels {
+		updated[k] = v
+	}
+	for k, v := range set {
+		updated[k] = v
+	}
+	for _, k := range remove {
+		delete(updated, k)
+	}
+	return updated
+}
+
+// Equal checks if two label sets are equal. Nil and empty labels are equal.
+func Equal(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if w, ok := b[k]; !ok || v != w {
+			return false
+		}
+	}
+	return true
+}
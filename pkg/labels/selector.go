@@ -0,0 +1,224 @@
+package labels
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+type Operator int
+
+const (
+	Equals Operator = iota
+	NotEquals
+	In
+	NotIn
+	Exists
+	DoesNotExist
+)
+
+func (o Operator) String() string {
+	switch o {
+	case Equals:
+		return "="
+	case NotEquals:
+		return "!="
+	case In:
+		return "in"
+	case NotIn:
+		return "notin"
+	case Exists:
+		return "exists"
+	case DoesNotExist:
+		return "!"
+	}
+	return fmt.Sprintf("Operator(%d)", int(o))
+}
+
+// Positive returns true when the requirement can be matched only by the labels containing the key.
+func (o Operator) Positive() bool {
+	return o == Equals || o == In || o == Exists
+}
+
+// Requirement is a single condition of the selector.
+type Requirement struct {
+	Key      string
+	Operator Operator
+	// Values are set for Equals, NotEquals (single value), In and NotIn (one or more values) operators.
+	Values []string
+}
+
+func (r Requirement) Matches(labels map[string]string) bool {
+	v, ok := labels[r.Key]
+	switch r.Operator {
+	case Equals, In:
+		return ok && slices.Contains(r.Values, v)
+	case NotEquals, NotIn:
+		return !ok || !slices.Contains(r.Values, v)
+	case Exists:
+		return ok
+	case DoesNotExist:
+		return !ok
+	}
+	return false
+}
+
+func (r Requirement) String() string {
+	switch r.Operator {
+	case Equals, NotEquals:
+		return r.Key + r.Operator.String() + r.Values[0]
+	case In, NotIn:
+		return r.Key + " " + r.Operator.String() + " (" + strings.Join(r.Values, ",") + ")"
+	case Exists:
+		return r.Key
+	case DoesNotExist:
+		return "!" + r.Key
+	}
+	return ""
+}
+
+// Selector selects labels which match all requirements. An empty selector matches all labels.
+type Selector []Requirement
+
+func (s Selector) Matches(labels map[string]string) bool {
+	for _, r := range s {
+		if !r.Matches(labels) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s Selector) Empty() bool {
+	return len(s) == 0
+}
+
+func (s Selector) String() string {
+	reqs := make([]string, 0, len(s))
+	for _, r := range s {
+		reqs = append(reqs, r.String())
+	}
+	return strings.Join(reqs, ",")
+}
+
+var (
+	ErrInvalidSelector = errors.New("invalid label selector")
+
+	setBasedRequirementRegexp = regexp.MustCompile(`^(\S+)\s+(in|notin)\s*\((.*)\)$`)
+)
+
+// splitRequirements splits the selector by commas outside of the parentheses.
+func splitRequirements(selector string) ([]string, error) {
+	var reqs []string
+	depth := 0
+	start := 0
+	for i, c := range selector {
+		switch c {
+		case '(':
+			depth++
+			if depth > 1 {
+				return nil, fmt.Errorf("%w('%v'): nested parentheses", ErrInvalidSelector, selector)
+			}
+		case ')':
+			depth--
+			if depth < 0 {
+				return nil, fmt.Errorf("%w('%v'): unexpected ')'", ErrInvalidSelector, selector)
+			}
+		case ',':
+			if depth == 0 {
+				reqs = append(reqs, selector[start:i])
+				start = i + 1
+			}
+		}
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("%w('%v'): missing ')'", ErrInvalidSelector, selector)
+	}
+	return append(reqs, selector[start:]), nil
+}
+
+func parseValues(values string) ([]string, error) {
+	var res []string
+	for _, v := range strings.Split(values, ",") {
+		v = strings.TrimSpace(v)
+		if err := ValidateValue(v); err != nil {
+			return nil, err
+		}
+		if !slices.Contains(res, v) {
+			res = append(res, v)
+		}
+	}
+	return res, nil
+}
+
+func parseRequirement(req string) (Requirement, error) {
+	if m := setBasedRequirementRegexp.FindStringSubmatch(req); m != nil {
+		values, err := parseValues(m[3])
+		if err != nil {
+			return Requirement{}, err
+		}
+		op := In
+		if m[2] == "notin" {
+			op = NotIn
+		}
+		return Requirement{Key: m[1], Operator: op, Values: values}, ValidateKey(m[1])
+	}
+	for _, o := range []struct {
+		sep string
+		op  Operator
+	}{{sep: "!=", op: NotEquals}, {sep: "==", op: Equals}, {sep: "=", op: Equals}} {
+		key, value, ok := strings.Cut(req, o.sep)
+		if !ok {
+			continue
+		}
+		key = strings.TrimSpace(key)
+		value = strings.TrimSpace(value)
+		if err := ValidateKey(key); err != nil {
+			return Requirement{}, err
+		}
+		if err := ValidateValue(value); err != nil {
+			return Requirement{}, err
+		}
+		return Requirement{Key: key, Operator: o.op, Values: []string{value}}, nil
+	}
+	if key, ok := strings.CutPrefix(req, "!"); ok {
+		key = strings.TrimSpace(key)
+		return Requirement{Key: key, Operator: DoesNotExist}, ValidateKey(key)
+	}
+	return Requirement{Key: req, Operator: Exists}, ValidateKey(req)
+}
+
+// Parse parses the selector. The selector is a comma separated list of requirements, which all must be satisfied:
+//   - "key=value", "key==value" - label key exists and it has the value
+//   - "key!=value" - label key doesn't exist or it has a different value
+//   - "key in (value1,value2)" - label key exists and it has one of the values
+//   - "key notin (value1,value2)" - label key doesn't exist or it has none of the values
+//   - "key" - label key exists
+//   - "!key" - label key doesn't exist
+//
+// Eg.: "site=brno,rack in (r1,r2),!decommissioned". An empty string is parsed to an empty selector.
+func Parse(selector string) (Selector, error) {
+	selector = strings.TrimSpace(selector)
+	if selector == "" {
+		return nil, nil
+	}
+	parts, err := splitRequirements(selector)
+	if err != nil {
+		return nil, err
+	}
+	s := make(Selector, 0, len(parts))
+	for _, p := range parts {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			return nil, fmt.Errorf("%w('%v'): empty requirement", ErrInvalidSelector, selector)
+		}
+		r, err := parseRequirement(p)
+		if err != nil {
+			return nil, fmt.Errorf("%w('%v'): %w", ErrInvalidSelector, selector, err)
+		}
+		s = append(s, r)
+	}
+	return s, nil
+}
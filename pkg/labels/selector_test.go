@@ -0,0 +1,123 @@
+package labels_test
+
+import (
+	"testing"
+
+	"github.com/plgd-dev/hub/v2/pkg/labels"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name     string
+		selector string
+		want     labels.Selector
+		wantErr  bool
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name:     "equality",
+			selector: "site=brno, customer == acme,rack!=r1",
+			want: labels.Selector{
+				{Key: "site", Operator: labels.Equals, Values: []string{"brno"}},
+				{Key: "customer", Operator: labels.Equals, Values: []string{"acme"}},
+				{Key: "rack", Operator: labels.NotEquals, Values: []string{"r1"}},
+			},
+		},
+		{
+			name:     "set-based",
+			selector: "rack in (r1, r2,r1),example.com/site notin (brno),deployed,!decommissioned",
+			want: labels.Selector{
+				{Key: "rack", Operator: labels.In, Values: []string{"r1", "r2"}},
+				{Key: "example.com/site", Operator: labels.NotIn, Values: []string{"brno"}},
+				{Key: "deployed", Operator: labels.Exists},
+				{Key: "decommissioned", Operator: labels.DoesNotExist},
+			},
+		},
+		{
+			name:     "empty requirement",
+			selector: "site=brno,",
+			wantErr:  true,
+		},
+		{
+			name:     "missing parenthesis",
+			selector: "rack in (r1,r2",
+			wantErr:  true,
+		},
+		{
+			name:     "invalid key",
+			selector: "-site=brno",
+			wantErr:  true,
+		},
+		{
+			name:     "invalid value",
+			selector: "site=br no",
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := labels.Parse(tt.selector)
+			if tt.wantErr {
+				require.ErrorIs(t, err, labels.ErrInvalidSelector)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSelectorMatches(t *testing.T) {
+	deviceLabels := map[string]string{
+		"site":     "brno",
+		"rack":     "r1",
+		"deployed": "",
+	}
+	tests := []struct {
+		selector string
+		want     bool
+	}{
+		{selector: "", want: true},
+		{selector: "site=brno", want: true},
+		{selector: "site=praha", want: false},
+		{selector: "site!=praha", want: true},
+		{selector: "customer!=acme", want: true},
+		{selector: "rack in (r1,r2)", want: true},
+		{selector: "rack notin (r1,r2)", want: false},
+		{selector: "customer notin (acme)", want: true},
+		{selector: "customer in (acme)", want: false},
+		{selector: "deployed,!decommissioned", want: true},
+		{selector: "!deployed", want: false},
+		{selector: "site=brno,rack=r2", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.selector, func(t *testing.T) {
+			s, err := labels.Parse(tt.selector)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, s.Matches(deviceLabels))
+			// the canonical form is parsed to the same selector
+			s2, err := labels.Parse(s.String())
+			require.NoError(t, err)
+			require.Equal(t, s, s2)
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	current := map[string]string{"site": "brno", "rack": "r1"}
+	got := labels.Update(current, map[string]string{"rack": "r2", "customer": "acme"}, []string{"site", "unknown"})
+	require.Equal(t, map[string]string{"rack": "r2", "customer": "acme"}, got)
+	require.Equal(t, map[string]string{"site": "brno", "rack": "r1"}, current)
+	require.True(t, labels.Equal(nil, map[string]string{}))
+	require.False(t, labels.Equal(current, got))
+}
+
+func TestValidate(t *testing.T) {
+	require.NoError(t, labels.Validate(map[string]string{"site": "brno", "example.com/rack": "r-1.a_b", "empty": ""}))
+	require.ErrorIs(t, labels.Validate(map[string]string{"Example.com/rack": "r1"}), labels.ErrInvalidKey)
+	require.ErrorIs(t, labels.Validate(map[string]string{"/rack": "r1"}), labels.ErrInvalidKey)
+	require.ErrorIs(t, labels.Validate(map[string]string{"rack": "r1/"}), labels.ErrInvalidValue)
+}
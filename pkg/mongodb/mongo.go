@@ -10,5 +10,6 @@ const (
 	In     Operator = "$in"
 	Set    Operator = "$set"
 	Unset  Operator = "$unset"
+	Inc    Operator = "$inc"
 	Match  Operator = "$match"
 )
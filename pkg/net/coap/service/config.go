@@ -15,6 +15,8 @@ type Protocol string
 const (
 	TCP Protocol = "tcp"
 	UDP Protocol = "udp"
+	// WS is CoAP over WebSockets (RFC 8323), it is served on the webSocket.address.
+	WS Protocol = "ws"
 )
 
 // Config represents configuration of coap service
@@ -28,6 +30,7 @@ type Config struct {
 	TLS               TLSConfig               `yaml:"tls" json:"tls"`
 	InactivityMonitor *InactivityMonitor      `yaml:"inactivityMonitor,omitempty" json:"inactivityMonitor,omitempty"`
 	KeepAlive         *KeepAlive              `yaml:"keepAlive,omitempty" json:"keepAlive,omitempty"`
+	WebSocket         *WebSocketConfig        `yaml:"webSocket,omitempty" json:"webSocket,omitempty"`
 }
 
 func (c *Config) GetTimeout() time.Duration {
@@ -67,6 +70,13 @@ func (c *Config) Validate() error {
 	for i := range len(c.Protocols) {
 		switch c.Protocols[i] {
 		case TCP, UDP:
+		case WS:
+			if c.WebSocket == nil {
+				return fmt.Errorf("webSocket('%v') - must be set for protocol %v", c.WebSocket, WS)
+			}
+			if err := c.WebSocket.Validate(); err != nil {
+				return fmt.Errorf("webSocket.%w", err)
+			}
 		default:
 			return fmt.Errorf("protocols[%v]('%v')", i, c.Protocols[i])
 		}
@@ -91,6 +101,20 @@ func (c TLSConfig) IsEnabled() bool {
 	return c.Enabled == nil || *c.Enabled
 }
 
+type WebSocketConfig struct {
+	Addr string `yaml:"address" json:"address"`
+	// AllowedOrigins are the origins of the browsers which can connect, "*" allows all origins. When it is empty,
+	// only the requests without the Origin header (e.g. from the devices) or from the same host are accepted.
+	AllowedOrigins []string `yaml:"allowedOrigins,omitempty" json:"allowedOrigins,omitempty"`
+}
+
+func (c *WebSocketConfig) Validate() error {
+	if c.Addr == "" {
+		return fmt.Errorf("address('%v')", c.Addr)
+	}
+	return nil
+}
+
 type KeepAlive struct {
 	Timeout time.Duration `yaml:"timeout" json:"timeout"`
 }
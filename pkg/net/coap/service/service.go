@@ -76,6 +76,12 @@ func newService(protocol Protocol, config Config, serviceOpts Options, fileWatch
 			return nil, fmt.Errorf("cannot create udp server: %w", err)
 		}
 		return coapServer, nil
+	case WS:
+		coapServer, err := newWSServer(config, serviceOpts, fileWatcher, logger, tracerProvider, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("cannot create websocket server: %w", err)
+		}
+		return coapServer, nil
 	}
 	return nil, nil
 }
@@ -0,0 +1,166 @@
+package service
+
+import (
+	"crypto/tls"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/plgd-dev/go-coap/v3/message"
+	coapTcpCoder "github.com/plgd-dev/go-coap/v3/tcp/coder"
+)
+
+// WebSocketConn adapts the CoAP over WebSockets connection (RFC 8323 section 4) to the byte stream of CoAP over TCP,
+// so the connection can be served by the CoAP over TCP server. Each WebSocket message contains exactly one CoAP message
+// which is framed like CoAP over TCP message with the Len nibble set to zero and without the Extended Length field.
+type WebSocketConn struct {
+	conn     *websocket.Conn
+	tlsState *tls.ConnectionState
+
+	readMutex sync.Mutex
+	readBuf   []byte
+
+	writeMutex sync.Mutex
+	writeBuf   []byte
+}
+
+func newWebSocketConn(conn *websocket.Conn, tlsState *tls.ConnectionState) *WebSocketConn {
+	return &WebSocketConn{
+		conn:     conn,
+		tlsState: tlsState,
+	}
+}
+
+// TLSConnectionState returns the state of the TLS connection or nil for the connection without TLS.
+func (c *WebSocketConn) TLSConnectionState() *tls.ConnectionState {
+	return c.tlsState
+}
+
+// IsTLS returns true for the CoAP over secure WebSockets connection (coap+wss).
+func (c *WebSocketConn) IsTLS() bool {
+	return c.tlsState != nil
+}
+
+// wsToTCPFrame converts the CoAP over WebSockets message to the CoAP over TCP message.
+func wsToTCPFrame(msg []byte) ([]byte, error) {
+	if len(msg) == 0 {
+		return nil, message.ErrShortRead
+	}
+	if msg[0]>>4 != 0 {
+		return nil, fmt.Errorf("invalid length nibble(%v): must be zero", msg[0]>>4)
+	}
+	tkl := int(msg[0] & 0x0f)
+	// the length of the options and the payload
+	opLen := len(msg) - 2 - tkl
+	if opLen < 0 {
+		return nil, message.ErrShortRead
+	}
+	frame := make([]byte, 0, len(msg)+4)
+	switch {
+	case opLen < coapTcpCoder.MessageLength13Base:
+		frame = append(frame, byte(opLen<<4)|msg[0])
+	case opLen < coapTcpCoder.MessageLength14Base:
+		frame = append(frame, 13<<4|msg[0], byte(opLen-coapTcpCoder.MessageLength13Base))
+	case opLen < coapTcpCoder.MessageLength15Base:
+		frame = append(frame, 14<<4|msg[0])
+		frame = binary.BigEndian.AppendUint16(frame, uint16(opLen-coapTcpCoder.MessageLength14Base)) //nolint:gosec
+	default:
+		frame = append(frame, 15<<4|msg[0])
+		frame = binary.BigEndian.AppendUint32(frame, uint32(opLen-coapTcpCoder.MessageLength15Base)) //nolint:gosec
+	}
+	return append(frame, msg[1:]...), nil
+}
+
+// tcpToWSFrame converts the first CoAP over TCP message of the buffer to the CoAP over WebSockets message. It returns
+// the number of the consumed bytes, zero is returned when the buffer doesn't contain the whole message.
+func tcpToWSFrame(buf []byte) ([]byte, int, error) {
+	var h coapTcpCoder.MessageHeader
+	_, err := coapTcpCoder.DefaultCoder.DecodeHeader(buf, &h)
+	if errors.Is(err, message.ErrShortRead) {
+		return nil, 0, nil
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(buf) < int(h.MessageLength) {
+		return nil, 0, nil
+	}
+	tkl := int(buf[0] & 0x0f)
+	extLen := int(h.Length) - 2 - tkl
+	msg := make([]byte, 0, int(h.MessageLength)-extLen)
+	msg = append(msg, buf[0]&0x0f)
+	msg = append(msg, buf[1+extLen:h.MessageLength]...)
+	return msg, int(h.MessageLength), nil
+}
+
+func (c *WebSocketConn) Read(b []byte) (int, error) {
+	c.readMutex.Lock()
+	defer c.readMutex.Unlock()
+	for len(c.readBuf) == 0 {
+		msgType, msg, err := c.conn.ReadMessage()
+		if err != nil {
+			return 0, err
+		}
+		if msgType != websocket.BinaryMessage {
+			return 0, fmt.Errorf("unsupported websocket message type(%v)", msgType)
+		}
+		c.readBuf, err = wsToTCPFrame(msg)
+		if err != nil {
+			return 0, fmt.Errorf("invalid coap message: %w", err)
+		}
+	}
+	n := copy(b, c.readBuf)
+	c.readBuf = c.readBuf[n:]
+	return n, nil
+}
+
+func (c *WebSocketConn) Write(b []byte) (int, error) {
+	c.writeMutex.Lock()
+	defer c.writeMutex.Unlock()
+	c.writeBuf = append(c.writeBuf, b...)
+	for {
+		msg, n, err := tcpToWSFrame(c.writeBuf)
+		if err != nil {
+			c.writeBuf = nil
+			return 0, fmt.Errorf("invalid coap message: %w", err)
+		}
+		if n == 0 {
+			return len(b), nil
+		}
+		c.writeBuf = c.writeBuf[n:]
+		if err = c.conn.WriteMessage(websocket.BinaryMessage, msg); err != nil {
+			return 0, err
+		}
+	}
+}
+
+func (c *WebSocketConn) Close() error {
+	return c.conn.Close()
+}
+
+func (c *WebSocketConn) LocalAddr() net.Addr {
+	return c.conn.LocalAddr()
+}
+
+func (c *WebSocketConn) RemoteAddr() net.Addr {
+	return c.conn.RemoteAddr()
+}
+
+func (c *WebSocketConn) SetDeadline(t time.Time) error {
+	if err := c.conn.SetReadDeadline(t); err != nil {
+		return err
+	}
+	return c.conn.SetWriteDeadline(t)
+}
+
+func (c *WebSocketConn) SetReadDeadline(t time.Time) error {
+	return c.conn.SetReadDeadline(t)
+}
+
+func (c *WebSocketConn) SetWriteDeadline(t time.Time) error {
+	return c.conn.SetWriteDeadline(t)
+}
@@ -0,0 +1,207 @@
+package service
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"slices"
+	"sync"
+
+	"github.com/gorilla/websocket"
+	coapDtlsServer "github.com/plgd-dev/go-coap/v3/dtls/server"
+	coapNet "github.com/plgd-dev/go-coap/v3/net"
+	"github.com/plgd-dev/go-coap/v3/options"
+	coapTcpClient "github.com/plgd-dev/go-coap/v3/tcp/client"
+	coapTcpServer "github.com/plgd-dev/go-coap/v3/tcp/server"
+	coapUdpServer "github.com/plgd-dev/go-coap/v3/udp/server"
+	"github.com/plgd-dev/hub/v2/pkg/fn"
+	"github.com/plgd-dev/hub/v2/pkg/fsnotify"
+	"github.com/plgd-dev/hub/v2/pkg/log"
+	certManagerServer "github.com/plgd-dev/hub/v2/pkg/security/certManager/server"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// WebSocketPath is the path of the CoAP over WebSockets endpoint (RFC 8323 section 4.4).
+	WebSocketPath = "/.well-known/coap"
+	// WebSocketSubprotocol is the WebSocket subprotocol of CoAP (RFC 8323 section 4.1).
+	WebSocketSubprotocol = "coap"
+)
+
+// newCheckOrigin returns the check of the Origin header, which is sent by the browsers. The devices don't send
+// the header and they are authenticated by the certificate or the access token.
+func newCheckOrigin(allowedOrigins []string) func(r *http.Request) bool {
+	if len(allowedOrigins) == 0 {
+		// the upgrader accepts the requests without the Origin header or from the same host
+		return nil
+	}
+	return func(r *http.Request) bool {
+		origin := r.Header.Get("Origin")
+		return origin == "" || slices.Contains(allowedOrigins, "*") || slices.Contains(allowedOrigins, origin)
+	}
+}
+
+// wsListener accepts the CoAP over WebSockets connections upgraded by the http server.
+type wsListener struct {
+	maxMessageSize uint32
+	checkOrigin    func(r *http.Request) bool
+	listener       net.Listener
+	httpServer     *http.Server
+	conns          chan net.Conn
+	done           chan struct{}
+	closeOnce      sync.Once
+	close          fn.FuncList
+}
+
+func (l *wsListener) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	upgrader := websocket.Upgrader{
+		Subprotocols: []string{WebSocketSubprotocol},
+		CheckOrigin:  l.checkOrigin,
+	}
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// the upgrader replies with the error to the client
+		return
+	}
+	if conn.Subprotocol() != WebSocketSubprotocol {
+		_ = conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseProtocolError, "coap subprotocol is required"))
+		_ = conn.Close()
+		return
+	}
+	conn.SetReadLimit(int64(l.maxMessageSize))
+	select {
+	case l.conns <- newWebSocketConn(conn, r.TLS):
+	case <-l.done:
+		_ = conn.Close()
+	case <-r.Context().Done():
+		_ = conn.Close()
+	}
+}
+
+func (l *wsListener) AcceptWithContext(ctx context.Context) (net.Conn, error) {
+	select {
+	case conn := <-l.conns:
+		return conn, nil
+	case <-l.done:
+		return nil, coapNet.ErrListenerIsClosed
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (l *wsListener) serve() error {
+	err := l.httpServer.Serve(l.listener)
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+	return err
+}
+
+func (l *wsListener) Close() error {
+	l.closeOnce.Do(func() {
+		close(l.done)
+		l.close.Execute()
+	})
+	return nil
+}
+
+type wsServer struct {
+	coapServer *coapTcpServer.Server
+	listener   *wsListener
+}
+
+func (s *wsServer) Serve() error {
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- s.listener.serve()
+	}()
+	err := s.coapServer.Serve(s.listener)
+	_ = s.listener.Close()
+	return errors.Join(err, <-errCh)
+}
+
+func (s *wsServer) Close() error {
+	s.coapServer.Stop()
+	return s.listener.Close()
+}
+
+func newWSListener(config Config, serviceOpts Options, fileWatcher *fsnotify.Watcher, logger log.Logger, tracerProvider trace.TracerProvider) (*wsListener, error) {
+	var closeListener fn.FuncList
+	listener, err := net.Listen("tcp", config.WebSocket.Addr)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create websocket listener: %w", err)
+	}
+	if config.TLS.IsEnabled() {
+		coapsTLS, err := certManagerServer.New(config.TLS.Embedded, fileWatcher, logger, tracerProvider)
+		if err != nil {
+			_ = listener.Close()
+			return nil, fmt.Errorf("cannot create tls cert manager: %w", err)
+		}
+		closeListener.AddFunc(coapsTLS.Close)
+		tlsCfg := coapsTLS.GetTLSConfig()
+		if serviceOpts.OverrideTLSConfig != nil {
+			tlsCfg = serviceOpts.OverrideTLSConfig(tlsCfg, coapsTLS.VerifyByCRL)
+		}
+		listener = tls.NewListener(listener, tlsCfg)
+	}
+	l := &wsListener{
+		maxMessageSize: config.MaxMessageSize,
+		checkOrigin:    newCheckOrigin(config.WebSocket.AllowedOrigins),
+		listener:       listener,
+		conns:          make(chan net.Conn),
+		done:           make(chan struct{}),
+	}
+	mux := http.NewServeMux()
+	mux.Handle(WebSocketPath, l)
+	l.httpServer = &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: config.GetTimeout(),
+	}
+	closeListener.AddFunc(func() {
+		if err := l.httpServer.Close(); err != nil {
+			logger.Errorf("failed to close websocket listener: %w", err)
+		}
+		// the listener is not closed by the http server when it was not served
+		_ = listener.Close()
+	})
+	l.close = closeListener
+	return l, nil
+}
+
+func newWSServer(config Config, serviceOpts Options, fileWatcher *fsnotify.Watcher, logger log.Logger, tracerProvider trace.TracerProvider, opts ...interface {
+	coapTcpServer.Option
+	coapDtlsServer.Option
+	coapUdpServer.Option
+},
+) (*wsServer, error) {
+	listener, err := newWSListener(config, serviceOpts, fileWatcher, logger, tracerProvider)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create listener: %w", err)
+	}
+	wsOpts := make([]coapTcpServer.Option, 0, 3)
+	if serviceOpts.OnNewConnection != nil {
+		wsOpts = append(wsOpts, options.WithOnNewConn(func(cc *coapTcpClient.Conn) {
+			serviceOpts.OnNewConnection(cc)
+		}))
+	}
+	if config.InactivityMonitor != nil {
+		wsOpts = append(wsOpts, options.WithInactivityMonitor(config.InactivityMonitor.Timeout, func(cc *coapTcpClient.Conn) {
+			serviceOpts.OnInactivityConnection(cc)
+		}))
+	}
+	if config.KeepAlive != nil {
+		wsOpts = append(wsOpts, options.WithKeepAlive(1, config.KeepAlive.Timeout, func(cc *coapTcpClient.Conn) {
+			serviceOpts.OnInactivityConnection(cc)
+		}))
+	}
+	for _, o := range opts {
+		wsOpts = append(wsOpts, o)
+	}
+	return &wsServer{
+		coapServer: coapTcpServer.New(wsOpts...),
+		listener:   listener,
+	}, nil
+}
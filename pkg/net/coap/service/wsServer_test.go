@@ -0,0 +1,172 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/plgd-dev/go-coap/v3/message"
+	"github.com/plgd-dev/go-coap/v3/message/codes"
+	"github.com/plgd-dev/go-coap/v3/message/pool"
+	"github.com/plgd-dev/go-coap/v3/mux"
+	coapTcpCoder "github.com/plgd-dev/go-coap/v3/tcp/coder"
+	"github.com/plgd-dev/hub/v2/pkg/fsnotify"
+	"github.com/plgd-dev/hub/v2/pkg/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+func TestWebSocketFrame(t *testing.T) {
+	for _, size := range []int{0, 12, 13, 268, 269, 65804, 65805, 70000} {
+		req := pool.NewMessage(context.Background())
+		req.SetCode(codes.POST)
+		req.SetToken(message.Token("abcd"))
+		req.SetBody(bytes.NewReader(bytes.Repeat([]byte("a"), size)))
+		tcpFrame, err := req.MarshalWithEncoder(coapTcpCoder.DefaultCoder)
+		require.NoError(t, err)
+
+		wsFrame, n, err := tcpToWSFrame(append(tcpFrame, 0x01))
+		require.NoError(t, err)
+		require.Equal(t, len(tcpFrame), n)
+		require.Equal(t, byte(len("abcd")), wsFrame[0])
+
+		_, n, err = tcpToWSFrame(tcpFrame[:len(tcpFrame)-1])
+		require.NoError(t, err)
+		require.Equal(t, 0, n)
+
+		got, err := wsToTCPFrame(wsFrame)
+		require.NoError(t, err)
+		require.Equal(t, tcpFrame, got)
+	}
+
+	_, err := wsToTCPFrame([]byte{0x14, byte(codes.GET), 0x01, 0x02, 0x03, 0x04})
+	require.Error(t, err)
+	_, err = wsToTCPFrame([]byte{0x04, byte(codes.GET), 0x01})
+	require.Error(t, err)
+}
+
+func TestWebSocket(t *testing.T) {
+	router := mux.NewRouter()
+	err := router.Handle("/a", mux.HandlerFunc(func(w mux.ResponseWriter, _ *mux.Message) {
+		errW := w.SetResponse(codes.Content, message.TextPlain, bytes.NewReader([]byte("hello")))
+		assert.NoError(t, errW)
+	}))
+	require.NoError(t, err)
+	logger := log.NewLogger(log.MakeDefaultConfig())
+	fileWatcher, err := fsnotify.NewWatcher(logger)
+	require.NoError(t, err)
+	defer func() {
+		_ = fileWatcher.Close()
+	}()
+	cfg := Config{
+		Addr:            "127.0.0.1:23456",
+		Protocols:       []Protocol{WS},
+		MaxMessageSize:  1024,
+		MessagePoolSize: 1024,
+		KeepAlive: &KeepAlive{
+			Timeout: time.Second * 20,
+		},
+		TLS: TLSConfig{
+			Enabled: new(bool), // TLS is disabled
+		},
+		WebSocket: &WebSocketConfig{
+			Addr: "127.0.0.1:23457",
+		},
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+	defer cancel()
+
+	connected := make(chan mux.Conn, 1)
+	got, err := New(ctx, cfg, router, fileWatcher, logger, noop.NewTracerProvider(), WithOnNewConnection(func(conn mux.Conn) {
+		connected <- conn
+	}))
+	require.NoError(t, err)
+	go func() {
+		errS := got.Serve()
+		assert.NoError(t, errS)
+	}()
+	defer func() {
+		errC := got.Close()
+		require.NoError(t, errC)
+	}()
+
+	dialer := websocket.Dialer{
+		Subprotocols: []string{WebSocketSubprotocol},
+	}
+	var c *websocket.Conn
+	require.Eventually(t, func() bool {
+		var errD error
+		c, _, errD = dialer.DialContext(ctx, "ws://"+cfg.WebSocket.Addr+WebSocketPath, nil) //nolint:bodyclose
+		return errD == nil
+	}, time.Second*5, time.Millisecond*100)
+	defer func() {
+		_ = c.Close()
+	}()
+	require.Equal(t, WebSocketSubprotocol, c.Subprotocol())
+
+	select {
+	case conn := <-connected:
+		wsConn, ok := conn.NetConn().(*WebSocketConn)
+		require.True(t, ok)
+		require.False(t, wsConn.IsTLS())
+	case <-ctx.Done():
+		require.NoError(t, ctx.Err())
+	}
+
+	// the server sends CSM (RFC 8323 section 5.3) and the response of the request
+	req := pool.NewMessage(ctx)
+	req.SetCode(codes.GET)
+	req.SetToken(message.Token("abcd"))
+	err = req.SetPath("/a")
+	require.NoError(t, err)
+	tcpFrame, err := req.MarshalWithEncoder(coapTcpCoder.DefaultCoder)
+	require.NoError(t, err)
+	wsFrame, _, err := tcpToWSFrame(tcpFrame)
+	require.NoError(t, err)
+	err = c.WriteMessage(websocket.BinaryMessage, wsFrame)
+	require.NoError(t, err)
+
+	for {
+		msgType, data, errR := c.ReadMessage()
+		require.NoError(t, errR)
+		require.Equal(t, websocket.BinaryMessage, msgType)
+		tcpFrame, errR = wsToTCPFrame(data)
+		require.NoError(t, errR)
+		resp := pool.NewMessage(ctx)
+		_, errR = resp.UnmarshalWithDecoder(coapTcpCoder.DefaultCoder, tcpFrame)
+		require.NoError(t, errR)
+		if resp.Code() == codes.CSM {
+			continue
+		}
+		require.Equal(t, codes.Content, resp.Code())
+		require.Equal(t, message.Token("abcd"), resp.Token())
+		body, errR := resp.ReadBody()
+		require.NoError(t, errR)
+		require.Equal(t, []byte("hello"), body)
+		return
+	}
+}
+
+func TestWebSocketCheckOrigin(t *testing.T) {
+	require.Nil(t, newCheckOrigin(nil))
+
+	newRequest := func(origin string) *http.Request {
+		r := httptest.NewRequest(http.MethodGet, WebSocketPath, nil)
+		if origin != "" {
+			r.Header.Set("Origin", origin)
+		}
+		return r
+	}
+	checkOrigin := newCheckOrigin([]string{"https://portal.example.com"})
+	require.True(t, checkOrigin(newRequest("")))
+	require.True(t, checkOrigin(newRequest("https://portal.example.com")))
+	require.False(t, checkOrigin(newRequest("https://evil.example.com")))
+
+	checkOrigin = newCheckOrigin([]string{"*"})
+	require.True(t, checkOrigin(newRequest("https://evil.example.com")))
+}
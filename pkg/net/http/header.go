@@ -2,15 +2,18 @@ package http
 
 const (
 	ApplicationProtoJsonContentType = "application/protojson"
+	TextEventStreamContentType      = "text/event-stream"
 
 	AcceptHeaderKey             = "Accept"
 	AuthorizationHeaderKey      = "Authorization"
+	CacheControlHeaderKey       = "Cache-Control"
 	ConnectionHeaderKey         = "Connection"
 	ContentLengthHeaderKey      = "Content-Length"
 	ContentTypeHeaderKey        = "Content-Type"
 	ContentTypeOptionsHeaderKey = "X-Content-Type-Options"
 	CorrelationIDHeaderKey      = "Correlation-Id"
 	ETagHeaderKey               = "ETag"
+	LastEventIDHeaderKey        = "Last-Event-ID"
 
 	AuthorizationBearerPrefix = "Bearer "
 )
@@ -63,6 +63,11 @@ func (w *statusWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
 	return writer.Hijack()
 }
 
+// Unwrap returns the underlying writer, so http.ResponseController can access it.
+func (w *statusWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
 func (w *statusWriter) Flush() {
 	f, ok := w.ResponseWriter.(interface{ Flush() })
 	if ok {
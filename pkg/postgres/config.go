@@ -0,0 +1,35 @@
+package postgres
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/plgd-dev/hub/v2/pkg/security/certManager/client"
+)
+
+type Config struct {
+	URI             string        `yaml:"uri" json:"uri"`
+	MaxConns        int32         `yaml:"maxConnections" json:"maxConnections"`
+	MaxConnIdleTime time.Duration `yaml:"maxConnIdleTime" json:"maxConnIdleTime"`
+	ConnectTimeout  time.Duration `yaml:"connectTimeout" json:"connectTimeout"`
+	TLS             client.Config `yaml:"tls" json:"tls"`
+}
+
+func (c *Config) Validate() error {
+	if c.URI == "" {
+		return fmt.Errorf("uri('%v')", c.URI)
+	}
+	if c.MaxConns < 0 {
+		return fmt.Errorf("maxConnections('%v')", c.MaxConns)
+	}
+	if c.MaxConnIdleTime < 0 {
+		return fmt.Errorf("maxConnIdleTime('%v')", c.MaxConnIdleTime)
+	}
+	if c.ConnectTimeout < 0 {
+		return fmt.Errorf("connectTimeout('%v')", c.ConnectTimeout)
+	}
+	if err := c.TLS.Validate(); err != nil {
+		return fmt.Errorf("tls.%w", err)
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+package postgres
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/plgd-dev/hub/v2/pkg/fn"
+	"github.com/plgd-dev/hub/v2/pkg/log"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// UniqueViolationCode is the SQLSTATE code returned when a unique constraint is violated.
+const UniqueViolationCode = "23505"
+
+type OnClearFn = func(context.Context) error
+
+// Client implements a Client for PostgreSQL.
+type Client struct {
+	pool      *pgxpool.Pool
+	logger    log.Logger
+	closeFunc fn.FuncList
+
+	onClear OnClearFn
+}
+
+// New creates a new Client.
+func New(ctx context.Context, cfg Config, tls *tls.Config, logger log.Logger, _ trace.TracerProvider) (*Client, error) {
+	logger = logger.With("database", "postgreSQL")
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+	poolCfg, err := pgxpool.ParseConfig(cfg.URI)
+	if err != nil {
+		return nil, fmt.Errorf("invalid uri: %w", err)
+	}
+	if cfg.MaxConns > 0 {
+		poolCfg.MaxConns = cfg.MaxConns
+	}
+	if cfg.MaxConnIdleTime > 0 {
+		poolCfg.MaxConnIdleTime = cfg.MaxConnIdleTime
+	}
+	if cfg.ConnectTimeout > 0 {
+		poolCfg.ConnConfig.ConnectTimeout = cfg.ConnectTimeout
+	}
+	if tls != nil && poolCfg.ConnConfig.TLSConfig != nil {
+		// sslmode from the uri enables TLS, use certificates managed by the hub
+		tls.ServerName = poolCfg.ConnConfig.TLSConfig.ServerName
+		poolCfg.ConnConfig.TLSConfig = tls
+		for _, fallback := range poolCfg.ConnConfig.Fallbacks {
+			if fallback.TLSConfig != nil {
+				fallbackTLS := tls.Clone()
+				fallbackTLS.ServerName = fallback.TLSConfig.ServerName
+				fallback.TLSConfig = fallbackTLS
+			}
+		}
+	}
+
+	pool, err := pgxpool.NewWithConfig(ctx, poolCfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial database: %w", err)
+	}
+	if err = pool.Ping(ctx); err != nil {
+		pool.Close()
+		return nil, fmt.Errorf("failed to ping database: %w", err)
+	}
+
+	return &Client{
+		pool:   pool,
+		logger: logger,
+	}, nil
+}
+
+// Pool returns the connection pool.
+func (c *Client) Pool() *pgxpool.Pool {
+	return c.pool
+}
+
+// Set the function called on Clear
+func (c *Client) SetOnClear(onClear OnClearFn) {
+	c.onClear = onClear
+}
+
+// Clear clears the database.
+func (c *Client) Clear(ctx context.Context) error {
+	if c.onClear != nil {
+		return c.onClear(ctx)
+	}
+	return nil
+}
+
+// Drops selected table from database
+func (c *Client) DropTable(ctx context.Context, table string) error {
+	_, err := c.pool.Exec(ctx, "drop table if exists "+pgx.Identifier{table}.Sanitize())
+	return err
+}
+
+// InTx executes f in a transaction. The transaction is committed when f returns nil, otherwise it is rolled back.
+func (c *Client) InTx(ctx context.Context, f func(tx pgx.Tx) error) error {
+	tx, err := c.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot begin transaction: %w", err)
+	}
+	if err = f(tx); err != nil {
+		if errR := tx.Rollback(ctx); errR != nil && !errors.Is(errR, pgx.ErrTxClosed) {
+			c.logger.Warnf("cannot rollback transaction: %v", errR)
+		}
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// Close closes the connection pool.
+func (c *Client) Close() {
+	c.pool.Close()
+	c.closeFunc.Execute()
+}
+
+func (c *Client) AddCloseFunc(f func()) {
+	c.closeFunc.AddFunc(f)
+}
+
+// IsUniqueViolation checks if the error was caused by violation of a unique constraint.
+func IsUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.Code == UniqueViolationCode
+	}
+	return false
+}
@@ -0,0 +1,44 @@
+package oscore
+
+import "encoding/binary"
+
+// Minimal deterministic CBOR encoder (RFC 8949) for the HKDF info and the AAD structures.
+
+const (
+	cborMajorUint  = 0 << 5
+	cborMajorBytes = 2 << 5
+	cborMajorText  = 3 << 5
+	cborMajorArray = 4 << 5
+	cborNull       = 0xf6
+)
+
+func cborAppendHead(buf []byte, major byte, v uint64) []byte {
+	switch {
+	case v < 24:
+		return append(buf, major|byte(v))
+	case v <= 0xff:
+		return append(buf, major|24, byte(v))
+	case v <= 0xffff:
+		return binary.BigEndian.AppendUint16(append(buf, major|25), uint16(v))
+	case v <= 0xffffffff:
+		return binary.BigEndian.AppendUint32(append(buf, major|26), uint32(v))
+	default:
+		return binary.BigEndian.AppendUint64(append(buf, major|27), v)
+	}
+}
+
+func cborAppendUint(buf []byte, v uint64) []byte {
+	return cborAppendHead(buf, cborMajorUint, v)
+}
+
+func cborAppendBytes(buf []byte, v []byte) []byte {
+	return append(cborAppendHead(buf, cborMajorBytes, uint64(len(v))), v...)
+}
+
+func cborAppendText(buf []byte, v string) []byte {
+	return append(cborAppendHead(buf, cborMajorText, uint64(len(v))), v...)
+}
+
+func cborAppendArray(buf []byte, n int) []byte {
+	return cborAppendHead(buf, cborMajorArray, uint64(n))
+}
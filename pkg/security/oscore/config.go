@@ -0,0 +1,110 @@
+package oscore
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+	"io"
+
+	"github.com/google/uuid"
+	"github.com/plgd-dev/hub/v2/pkg/config/property/urischeme"
+	"golang.org/x/crypto/hkdf"
+)
+
+const (
+	// MinMasterKeyLength is the minimal length of the master key of the hub.
+	MinMasterKeyLength = 16
+	// MasterSecretLength is the length of the Master Secrets of the devices.
+	MasterSecretLength = 16
+	// IDContextNonceLength is the length of the random part of the ID Context.
+	IDContextNonceLength = 8
+)
+
+var (
+	// HubSenderID is the Sender ID of the hub, it is the Recipient ID of the device.
+	HubSenderID = []byte{0x00}
+	// DeviceSenderID is the Sender ID of the device, it is the Recipient ID of the hub.
+	DeviceSenderID = []byte{0x01}
+)
+
+// Config is the configuration of the OSCORE security contexts of the devices. The Master Secret of each device is
+// derived from the master key and the ID Context, so the device-provisioning-service and the coap-gateway must be
+// configured with the same master key.
+type Config struct {
+	Enabled       bool                `yaml:"enabled" json:"enabled"`
+	MasterKeyFile urischeme.URIScheme `yaml:"masterKeyFile" json:"masterKeyFile"`
+}
+
+func (c *Config) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.MasterKeyFile == "" {
+		return fmt.Errorf("masterKeyFile('%v')", c.MasterKeyFile)
+	}
+	return nil
+}
+
+// MasterKey reads the master key from the file.
+func (c *Config) MasterKey() ([]byte, error) {
+	key, err := c.MasterKeyFile.Read()
+	if err != nil {
+		return nil, fmt.Errorf("cannot read master key: %w", err)
+	}
+	if len(key) < MinMasterKeyLength {
+		return nil, fmt.Errorf("invalid master key length(%v): minimal length is %v", len(key), MinMasterKeyLength)
+	}
+	return key, nil
+}
+
+// NewDeviceIDContext generates the ID Context of the provisioned device. It is the binary form of the device ID followed
+// by the random nonce, so each provisioning of the device establishes the new security context and the sender
+// sequence numbers starting from 0 never reuse the AEAD nonce with the same key (RFC 8613 appendix B.2).
+func NewDeviceIDContext(deviceID string) ([]byte, error) {
+	id, err := uuid.Parse(deviceID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid device ID(%v): %w", deviceID, err)
+	}
+	idContext := make([]byte, 0, len(id)+IDContextNonceLength)
+	idContext = append(idContext, id[:]...)
+	nonce := make([]byte, IDContextNonceLength)
+	if _, err = rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("cannot generate ID context nonce: %w", err)
+	}
+	return append(idContext, nonce...), nil
+}
+
+// DeviceIDFromIDContext returns the device ID encoded in the ID Context.
+func DeviceIDFromIDContext(idContext []byte) (string, error) {
+	if len(idContext) != len(uuid.UUID{})+IDContextNonceLength {
+		return "", fmt.Errorf("invalid ID context(%x): invalid length(%v)", idContext, len(idContext))
+	}
+	id, err := uuid.FromBytes(idContext[:len(uuid.UUID{})])
+	if err != nil {
+		return "", fmt.Errorf("invalid ID context(%x): %w", idContext, err)
+	}
+	return id.String(), nil
+}
+
+// DeviceMasterSecret derives the Master Secret of the device from the master key of the hub and the ID Context issued
+// by the provisioning, so the Master Secret is changed by each provisioning.
+func DeviceMasterSecret(masterKey, idContext []byte) ([]byte, error) {
+	if _, err := DeviceIDFromIDContext(idContext); err != nil {
+		return nil, err
+	}
+	info := append([]byte("plgd oscore master secret "), idContext...)
+	secret := make([]byte, MasterSecretLength)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, masterKey, nil, info), secret); err != nil {
+		return nil, fmt.Errorf("cannot derive master secret: %w", err)
+	}
+	return secret, nil
+}
+
+// NewHubContext creates the security context of the hub for the ID Context of the device.
+func NewHubContext(masterKey, idContext []byte, opts ...Option) (*Context, error) {
+	masterSecret, err := DeviceMasterSecret(masterKey, idContext)
+	if err != nil {
+		return nil, err
+	}
+	return NewContext(masterSecret, HubSenderID, DeviceSenderID, idContext, opts...)
+}
@@ -0,0 +1,271 @@
+package oscore
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/pion/dtls/v3/pkg/crypto/ccm"
+	"golang.org/x/crypto/hkdf"
+)
+
+const (
+	// AlgAESCCM16_64_128 is the COSE identifier (RFC 8152 section 10.2) of the AEAD algorithm AES-CCM-16-64-128,
+	// which is the default algorithm of OSCORE.
+	AlgAESCCM16_64_128 = 10
+
+	keyLength   = 16
+	nonceLength = 13
+	tagLength   = 8
+	// maxIDLength is the maximal length of the Sender ID and the Recipient ID for the nonce length of the algorithm.
+	maxIDLength = nonceLength - 6
+	// maxSequenceNumber is the maximal Sender Sequence Number (RFC 8613 section 7.2.1).
+	maxSequenceNumber = 1<<40 - 1
+	// DefaultPersistInterval is the default count of the sequence numbers reserved by one write to the store.
+	DefaultPersistInterval = 256
+
+	echoLength = 8
+)
+
+var (
+	ErrReplayedMessage           = errors.New("replayed message")
+	ErrDecryptionFailed          = errors.New("decryption failed")
+	ErrEchoRequired              = errors.New("echo required to verify freshness of the request")
+	ErrSequenceNumberExhausted   = errors.New("sender sequence number exhausted")
+	ErrSecurityContextNotMatched = errors.New("security context not matched")
+)
+
+type config struct {
+	masterSalt      []byte
+	store           SequenceNumberStore
+	persistInterval uint64
+}
+
+type Option interface {
+	apply(*config)
+}
+
+type optionFunc func(*config)
+
+func (o optionFunc) apply(c *config) {
+	o(c)
+}
+
+// WithMasterSalt sets the Master Salt of the security context, the empty salt is used by default.
+func WithMasterSalt(salt []byte) Option {
+	return optionFunc(func(c *config) {
+		c.masterSalt = salt
+	})
+}
+
+// WithSequenceNumberStore reserves the Sender Sequence Numbers in the store. The store is updated once per interval of
+// the sequence numbers, so after the restart up to interval numbers are skipped but never reused.
+func WithSequenceNumberStore(store SequenceNumberStore, interval uint64) Option {
+	return optionFunc(func(c *config) {
+		c.store = store
+		c.persistInterval = interval
+	})
+}
+
+// Context is the OSCORE security context (RFC 8613 section 3) of an endpoint. The sender part protects the outgoing
+// messages and the recipient part verifies the incoming messages.
+type Context struct {
+	senderID    []byte
+	recipientID []byte
+	idContext   []byte
+	commonIV    []byte
+	sender      cipher.AEAD
+	recipient   cipher.AEAD
+	storeID     string
+
+	store           SequenceNumberStore
+	persistInterval uint64
+
+	mutex          sync.Mutex
+	sequenceNumber uint64
+	reservedUntil  uint64
+	replayWindow   replayWindow
+	echo           []byte
+}
+
+func deriveParameter(masterSecret, masterSalt, id, idContext []byte, paramType string, length int) ([]byte, error) {
+	info := cborAppendArray(make([]byte, 0, 32+len(id)+len(idContext)), 5)
+	info = cborAppendBytes(info, id)
+	if idContext == nil {
+		info = append(info, cborNull)
+	} else {
+		info = cborAppendBytes(info, idContext)
+	}
+	info = cborAppendUint(info, AlgAESCCM16_64_128)
+	info = cborAppendText(info, paramType)
+	info = cborAppendUint(info, uint64(length))
+	out := make([]byte, length)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, masterSecret, masterSalt, info), out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return ccm.NewCCM(block, tagLength, nonceLength)
+}
+
+// NewContext derives the security context from the Master Secret (RFC 8613 section 3.2). The idContext can be nil
+// when the ID Context is not used.
+func NewContext(masterSecret, senderID, recipientID, idContext []byte, opts ...Option) (*Context, error) {
+	cfg := config{
+		persistInterval: DefaultPersistInterval,
+	}
+	for _, o := range opts {
+		o.apply(&cfg)
+	}
+	if len(masterSecret) == 0 {
+		return nil, errors.New("empty master secret")
+	}
+	if len(senderID) > maxIDLength {
+		return nil, fmt.Errorf("invalid sender ID length(%v): maximal length is %v", len(senderID), maxIDLength)
+	}
+	if len(recipientID) > maxIDLength {
+		return nil, fmt.Errorf("invalid recipient ID length(%v): maximal length is %v", len(recipientID), maxIDLength)
+	}
+	if len(idContext) > 0xff {
+		return nil, fmt.Errorf("invalid ID context length(%v)", len(idContext))
+	}
+	if cfg.store != nil && cfg.persistInterval == 0 {
+		return nil, errors.New("invalid persist interval(0)")
+	}
+	senderKey, err := deriveParameter(masterSecret, cfg.masterSalt, senderID, idContext, "Key", keyLength)
+	if err != nil {
+		return nil, fmt.Errorf("cannot derive sender key: %w", err)
+	}
+	recipientKey, err := deriveParameter(masterSecret, cfg.masterSalt, recipientID, idContext, "Key", keyLength)
+	if err != nil {
+		return nil, fmt.Errorf("cannot derive recipient key: %w", err)
+	}
+	commonIV, err := deriveParameter(masterSecret, cfg.masterSalt, nil, idContext, "IV", nonceLength)
+	if err != nil {
+		return nil, fmt.Errorf("cannot derive common IV: %w", err)
+	}
+	sender, err := newAEAD(senderKey)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create sender cipher: %w", err)
+	}
+	recipient, err := newAEAD(recipientKey)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create recipient cipher: %w", err)
+	}
+	c := &Context{
+		senderID:        senderID,
+		recipientID:     recipientID,
+		idContext:       idContext,
+		commonIV:        commonIV,
+		sender:          sender,
+		recipient:       recipient,
+		storeID:         hex.EncodeToString(idContext) + "-" + hex.EncodeToString(senderID),
+		store:           cfg.store,
+		persistInterval: cfg.persistInterval,
+	}
+	return c, nil
+}
+
+// SenderID returns the Sender ID of the context.
+func (c *Context) SenderID() []byte {
+	return c.senderID
+}
+
+// RecipientID returns the Recipient ID of the context.
+func (c *Context) RecipientID() []byte {
+	return c.recipientID
+}
+
+// IDContext returns the ID Context of the context.
+func (c *Context) IDContext() []byte {
+	return c.idContext
+}
+
+// nextSequenceNumber returns the Partial IV for the next protected message. When the context uses the store, the
+// sequence numbers are used only from the range reserved by the store.
+func (c *Context) nextSequenceNumber(ctx context.Context) ([]byte, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.store != nil && c.sequenceNumber >= c.reservedUntil {
+		seq, err := c.store.Reserve(ctx, c.storeID, c.persistInterval)
+		if err != nil {
+			return nil, fmt.Errorf("cannot reserve sender sequence numbers: %w", err)
+		}
+		c.sequenceNumber = seq
+		c.reservedUntil = seq + c.persistInterval
+	}
+	if c.sequenceNumber > maxSequenceNumber {
+		return nil, ErrSequenceNumberExhausted
+	}
+	seq := c.sequenceNumber
+	c.sequenceNumber++
+	return encodePIV(seq), nil
+}
+
+// verifyRequestFreshness checks the replay window for the sequence number of the request. The replay window isn't
+// known after the context is created, so the first request must contain the Echo option sent by the server
+// (RFC 8613 appendix B.1.2).
+func (c *Context) verifyRequestFreshness(seq uint64, echo []byte) ([]byte, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.replayWindow.initialized {
+		if !c.replayWindow.check(seq) {
+			return nil, ErrReplayedMessage
+		}
+		c.replayWindow.update(seq)
+		return nil, nil
+	}
+	if c.echo != nil && string(c.echo) == string(echo) {
+		c.echo = nil
+		c.replayWindow.update(seq)
+		return nil, nil
+	}
+	if c.echo == nil {
+		c.echo = make([]byte, echoLength)
+		if _, err := rand.Read(c.echo); err != nil {
+			c.echo = nil
+			return nil, fmt.Errorf("cannot generate echo: %w", err)
+		}
+	}
+	return c.echo, ErrEchoRequired
+}
+
+func (c *Context) nonce(id, piv []byte) []byte {
+	nonce := make([]byte, nonceLength)
+	nonce[0] = byte(len(id))
+	copy(nonce[nonceLength-5-len(id):nonceLength-5], id)
+	copy(nonce[nonceLength-len(piv):], piv)
+	for i := range nonce {
+		nonce[i] ^= c.commonIV[i]
+	}
+	return nonce
+}
+
+// additionalData creates the AAD (RFC 8613 section 5.4) for the request identified by the kid and the piv.
+func additionalData(requestKID, requestPIV []byte) []byte {
+	externalAAD := cborAppendArray(make([]byte, 0, 16+len(requestKID)+len(requestPIV)), 5)
+	externalAAD = cborAppendUint(externalAAD, 1)
+	externalAAD = cborAppendArray(externalAAD, 1)
+	externalAAD = cborAppendUint(externalAAD, AlgAESCCM16_64_128)
+	externalAAD = cborAppendBytes(externalAAD, requestKID)
+	externalAAD = cborAppendBytes(externalAAD, requestPIV)
+	externalAAD = cborAppendBytes(externalAAD, nil)
+
+	aad := cborAppendArray(make([]byte, 0, 16+len(externalAAD)), 3)
+	aad = cborAppendText(aad, "Encrypt0")
+	aad = cborAppendBytes(aad, nil)
+	return cborAppendBytes(aad, externalAAD)
+}
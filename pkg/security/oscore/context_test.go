@@ -0,0 +1,271 @@
+package oscore_test
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/plgd-dev/go-coap/v3/message"
+	"github.com/plgd-dev/go-coap/v3/message/codes"
+	"github.com/plgd-dev/go-coap/v3/message/pool"
+	"github.com/plgd-dev/hub/v2/pkg/security/oscore"
+	"github.com/stretchr/testify/require"
+)
+
+var testMasterKey = []byte("0123456789abcdef0123456789abcdef")
+
+func newIDContext(t *testing.T, deviceID string) []byte {
+	idContext, err := oscore.NewDeviceIDContext(deviceID)
+	require.NoError(t, err)
+	return idContext
+}
+
+func newDeviceContext(t *testing.T, idContext []byte) *oscore.Context {
+	secret, err := oscore.DeviceMasterSecret(testMasterKey, idContext)
+	require.NoError(t, err)
+	device, err := oscore.NewContext(secret, oscore.DeviceSenderID, oscore.HubSenderID, idContext)
+	require.NoError(t, err)
+	return device
+}
+
+func newRequest(path string, payload []byte) *pool.Message {
+	req := pool.NewMessage(context.Background())
+	req.SetCode(codes.POST)
+	req.SetToken(message.Token("token"))
+	_ = req.SetPath(path)
+	req.SetContentFormat(message.AppOcfCbor)
+	if payload != nil {
+		req.SetBody(bytes.NewReader(payload))
+	}
+	return req
+}
+
+func readBody(t *testing.T, msg *pool.Message) []byte {
+	body, err := msg.ReadBody()
+	require.NoError(t, err)
+	return body
+}
+
+func TestRequestResponse(t *testing.T) {
+	deviceID := uuid.NewString()
+	idContext := newIDContext(t, deviceID)
+	device := newDeviceContext(t, idContext)
+	hub, err := oscore.NewHubContext(testMasterKey, idContext)
+	require.NoError(t, err)
+
+	// the first request is answered by the echo
+	req := newRequest("/oic/sec/session", []byte("payload"))
+	devReq, err := device.ProtectRequest(req, true)
+	require.NoError(t, err)
+	require.True(t, oscore.IsProtected(req))
+	require.Equal(t, codes.POST, req.Code())
+	_, err = req.Path()
+	require.Error(t, err)
+	kidContext, err := oscore.GetKIDContext(req)
+	require.NoError(t, err)
+	gotDeviceID, err := oscore.DeviceIDFromIDContext(kidContext)
+	require.NoError(t, err)
+	require.Equal(t, deviceID, gotDeviceID)
+
+	hubReq, err := hub.UnprotectRequest(req)
+	require.ErrorIs(t, err, oscore.ErrEchoRequired)
+	require.NotEmpty(t, hubReq.Echo)
+	resp := pool.NewMessage(context.Background())
+	resp.SetCode(codes.Unauthorized)
+	resp.SetToken(req.Token())
+	err = hub.ProtectResponse(resp, hubReq)
+	require.NoError(t, err)
+	err = device.UnprotectResponse(resp, devReq)
+	require.NoError(t, err)
+	require.Equal(t, codes.Unauthorized, resp.Code())
+	echo, err := resp.GetOptionBytes(oscore.EchoOptionID)
+	require.NoError(t, err)
+
+	// the request with the echo is accepted
+	req = newRequest("/oic/sec/session", []byte("payload"))
+	req.SetOptionBytes(oscore.EchoOptionID, echo)
+	devReq, err = device.ProtectRequest(req, false)
+	require.NoError(t, err)
+	replayed := pool.NewMessage(context.Background())
+	err = req.Clone(replayed)
+	require.NoError(t, err)
+	hubReq, err = hub.UnprotectRequest(req)
+	require.NoError(t, err)
+	require.Equal(t, codes.POST, req.Code())
+	path, err := req.Path()
+	require.NoError(t, err)
+	require.Equal(t, "/oic/sec/session", path)
+	cf, err := req.ContentFormat()
+	require.NoError(t, err)
+	require.Equal(t, message.AppOcfCbor, cf)
+	require.Equal(t, []byte("payload"), readBody(t, req))
+	_, err = req.GetOptionBytes(oscore.EchoOptionID)
+	require.Error(t, err)
+
+	// the replayed request is rejected
+	_, err = hub.UnprotectRequest(replayed)
+	require.ErrorIs(t, err, oscore.ErrReplayedMessage)
+
+	resp = pool.NewMessage(context.Background())
+	resp.SetCode(codes.Changed)
+	resp.SetToken(req.Token())
+	resp.SetBody(bytes.NewReader([]byte("response")))
+	err = hub.ProtectResponse(resp, hubReq)
+	require.NoError(t, err)
+	require.Equal(t, codes.Changed, resp.Code())
+	err = device.UnprotectResponse(resp, devReq)
+	require.NoError(t, err)
+	require.Equal(t, codes.Changed, resp.Code())
+	require.Equal(t, []byte("response"), readBody(t, resp))
+
+	// tampered message is rejected
+	req = newRequest("/oic/sec/session", []byte("payload"))
+	_, err = device.ProtectRequest(req, false)
+	require.NoError(t, err)
+	body := readBody(t, req)
+	body[0] ^= 0xff
+	req.SetBody(bytes.NewReader(body))
+	_, err = hub.UnprotectRequest(req)
+	require.ErrorIs(t, err, oscore.ErrDecryptionFailed)
+
+	// context of the different device is rejected
+	req = newRequest("/oic/sec/session", nil)
+	_, err = newDeviceContext(t, newIDContext(t, uuid.NewString())).ProtectRequest(req, true)
+	require.NoError(t, err)
+	_, err = hub.UnprotectRequest(req)
+	require.ErrorIs(t, err, oscore.ErrSecurityContextNotMatched)
+
+	// context of the previous provisioning of the device is rejected
+	req = newRequest("/oic/sec/session", nil)
+	_, err = newDeviceContext(t, newIDContext(t, deviceID)).ProtectRequest(req, true)
+	require.NoError(t, err)
+	_, err = hub.UnprotectRequest(req)
+	require.ErrorIs(t, err, oscore.ErrSecurityContextNotMatched)
+}
+
+func TestDeviceIDContext(t *testing.T) {
+	deviceID := uuid.NewString()
+	idContext1 := newIDContext(t, deviceID)
+	idContext2 := newIDContext(t, deviceID)
+	require.NotEqual(t, idContext1, idContext2)
+	for _, idContext := range [][]byte{idContext1, idContext2} {
+		gotDeviceID, err := oscore.DeviceIDFromIDContext(idContext)
+		require.NoError(t, err)
+		require.Equal(t, deviceID, gotDeviceID)
+	}
+	// each provisioning derives the different master secret
+	secret1, err := oscore.DeviceMasterSecret(testMasterKey, idContext1)
+	require.NoError(t, err)
+	secret2, err := oscore.DeviceMasterSecret(testMasterKey, idContext2)
+	require.NoError(t, err)
+	require.NotEqual(t, secret1, secret2)
+
+	_, err = oscore.NewDeviceIDContext("invalid")
+	require.Error(t, err)
+	_, err = oscore.DeviceIDFromIDContext(idContext1[:16])
+	require.Error(t, err)
+	_, err = oscore.NewHubContext(testMasterKey, idContext1[:16])
+	require.Error(t, err)
+}
+
+func TestObserve(t *testing.T) {
+	deviceID := uuid.NewString()
+	idContext := newIDContext(t, deviceID)
+	device := newDeviceContext(t, idContext)
+	hub, err := oscore.NewHubContext(testMasterKey, idContext)
+	require.NoError(t, err)
+
+	// the hub observes the resource of the device
+	req := pool.NewMessage(context.Background())
+	req.SetCode(codes.GET)
+	req.SetToken(message.Token("observe"))
+	req.SetObserve(0)
+	_ = req.SetPath("/light/1")
+	hubReq, err := hub.ProtectRequest(req, false)
+	require.NoError(t, err)
+	obs, err := req.Observe()
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), obs)
+
+	devReq, err := device.UnprotectRequest(req)
+	require.ErrorIs(t, err, oscore.ErrEchoRequired)
+	require.NotNil(t, devReq)
+
+	notifications := make([]*pool.Message, 0, 3)
+	for i := range 3 {
+		n := pool.NewMessage(context.Background())
+		n.SetCode(codes.Content)
+		n.SetToken(req.Token())
+		n.SetObserve(uint32(i + 2))
+		n.SetBody(bytes.NewReader([]byte{byte(i)}))
+		err = device.ProtectResponse(n, devReq)
+		require.NoError(t, err)
+		require.Equal(t, codes.Content, n.Code())
+		notifications = append(notifications, n)
+	}
+	err = hub.UnprotectResponse(notifications[1], hubReq)
+	require.NoError(t, err)
+	require.Equal(t, []byte{1}, readBody(t, notifications[1]))
+	obs, err = notifications[1].Observe()
+	require.NoError(t, err)
+	require.Equal(t, uint32(3), obs)
+	// older notification is rejected
+	err = hub.UnprotectResponse(notifications[0], hubReq)
+	require.ErrorIs(t, err, oscore.ErrReplayedMessage)
+	err = hub.UnprotectResponse(notifications[2], hubReq)
+	require.NoError(t, err)
+	require.Equal(t, []byte{2}, readBody(t, notifications[2]))
+}
+
+type memoryStore struct {
+	mutex sync.Mutex
+	next  map[string]uint64
+}
+
+func (s *memoryStore) Reserve(_ context.Context, id string, count uint64) (uint64, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	seq := s.next[id]
+	s.next[id] = seq + count
+	return seq, nil
+}
+
+func TestSequenceNumberReservation(t *testing.T) {
+	store := &memoryStore{next: make(map[string]uint64)}
+	idContext := newIDContext(t, uuid.NewString())
+	device := newDeviceContext(t, idContext)
+
+	hub, err := oscore.NewHubContext(testMasterKey, idContext, oscore.WithSequenceNumberStore(store, 4))
+	require.NoError(t, err)
+	var lastReq *oscore.Request
+	for range 6 {
+		lastReq, err = hub.ProtectRequest(newRequest("/a", nil), false)
+		require.NoError(t, err)
+	}
+	require.Equal(t, []byte{5}, lastReq.PIV)
+
+	// the restarted hub continues after the reserved sequence numbers
+	hub, err = oscore.NewHubContext(testMasterKey, idContext, oscore.WithSequenceNumberStore(store, 4))
+	require.NoError(t, err)
+	req := newRequest("/a", nil)
+	hubReq, err := hub.ProtectRequest(req, false)
+	require.NoError(t, err)
+	require.Equal(t, []byte{8}, hubReq.PIV)
+	_, err = device.UnprotectRequest(req)
+	require.ErrorIs(t, err, oscore.ErrEchoRequired)
+
+	// the instances sharing the store never use the same sequence number
+	hub2, err := oscore.NewHubContext(testMasterKey, idContext, oscore.WithSequenceNumberStore(store, 4))
+	require.NoError(t, err)
+	used := make(map[string]struct{})
+	for range 10 {
+		for _, h := range []*oscore.Context{hub, hub2} {
+			r, errP := h.ProtectRequest(newRequest("/a", nil), false)
+			require.NoError(t, errP)
+			require.NotContains(t, used, string(r.PIV))
+			used[string(r.PIV)] = struct{}{}
+		}
+	}
+}
@@ -0,0 +1,311 @@
+package oscore
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/plgd-dev/go-coap/v3/message"
+	"github.com/plgd-dev/go-coap/v3/message/codes"
+	"github.com/plgd-dev/go-coap/v3/message/pool"
+)
+
+// codeFETCH is the code of the FETCH method (RFC 8132), it is used for the protected Observe requests.
+const codeFETCH codes.Code = 5
+
+// Request binds the responses to the protected request (RFC 8613 section 5.4).
+type Request struct {
+	KID []byte
+	PIV []byte
+	// Echo is set when ErrEchoRequired is returned by UnprotectRequest, it must be sent in the Echo option of
+	// the 4.01 (Unauthorized) response protected by ProtectResponse.
+	Echo []byte
+
+	mutex               sync.Mutex
+	hasNotification     bool
+	lastNotificationPIV uint64
+}
+
+// outerOptions are the options of the Class U (RFC 8613 section 4.1), they are not protected by OSCORE. The Observe
+// option is both the Inner and the Outer option.
+var outerOptions = map[message.OptionID]bool{
+	message.URIHost:     true,
+	message.URIPort:     true,
+	message.ProxyURI:    true,
+	message.ProxyScheme: true,
+	message.Block1:      true,
+	message.Block2:      true,
+	message.Size1:       true,
+	message.Size2:       true,
+	OptionID:            true,
+}
+
+func splitOptions(opts message.Options) (outer, inner message.Options) {
+	outer = make(message.Options, 0, len(opts)+1)
+	inner = make(message.Options, 0, len(opts))
+	for _, o := range opts {
+		switch {
+		case o.ID == message.Observe:
+			outer = append(outer, o)
+			inner = append(inner, o)
+		case outerOptions[o.ID]:
+			outer = append(outer, o)
+		default:
+			inner = append(inner, o)
+		}
+	}
+	return outer, inner
+}
+
+func marshalPlaintext(code codes.Code, opts message.Options, payload []byte) ([]byte, error) {
+	optsLen, err := opts.Marshal(nil)
+	if err != nil && !errors.Is(err, message.ErrTooSmall) {
+		return nil, fmt.Errorf("cannot marshal options: %w", err)
+	}
+	plaintext := make([]byte, 1+optsLen, 2+optsLen+len(payload))
+	plaintext[0] = byte(code)
+	if _, err = opts.Marshal(plaintext[1:]); err != nil {
+		return nil, fmt.Errorf("cannot marshal options: %w", err)
+	}
+	if len(payload) > 0 {
+		plaintext = append(plaintext, 0xff)
+		plaintext = append(plaintext, payload...)
+	}
+	return plaintext, nil
+}
+
+func unmarshalPlaintext(plaintext []byte) (codes.Code, message.Options, []byte, error) {
+	if len(plaintext) == 0 {
+		return 0, nil, nil, fmt.Errorf("%w: empty plaintext", ErrDecryptionFailed)
+	}
+	data := plaintext[1:]
+	// each option has at least one byte
+	opts := make(message.Options, 0, len(data)+1)
+	n, err := opts.Unmarshal(data, message.CoapOptionDefs)
+	if err != nil {
+		return 0, nil, nil, fmt.Errorf("cannot unmarshal options: %w", err)
+	}
+	var payload []byte
+	if n < len(data) {
+		payload = data[n:]
+	}
+	return codes.Code(plaintext[0]), opts, payload, nil
+}
+
+func readMessage(msg *pool.Message) (message.Options, []byte, error) {
+	payload, err := msg.ReadBody()
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot read body: %w", err)
+	}
+	// the values of the options are copied because they reference the buffer of the message
+	opts := make(message.Options, 0, len(msg.Options()))
+	for _, o := range msg.Options() {
+		opts = append(opts, message.Option{ID: o.ID, Value: append([]byte(nil), o.Value...)})
+	}
+	return opts, payload, nil
+}
+
+func writeMessage(msg *pool.Message, code codes.Code, opts message.Options, payload []byte) {
+	sort.SliceStable(opts, func(i, j int) bool {
+		return opts[i].ID < opts[j].ID
+	})
+	msg.SetCode(code)
+	msg.ResetOptionsTo(opts)
+	if len(payload) == 0 {
+		msg.SetBody(nil)
+		return
+	}
+	msg.SetBody(bytes.NewReader(payload))
+}
+
+func getOptionValue(opts message.Options, id message.OptionID) ([]byte, bool) {
+	for _, o := range opts {
+		if o.ID == id {
+			return o.Value, true
+		}
+	}
+	return nil, false
+}
+
+func (c *Context) protect(msg *pool.Message, outerCode codes.Code, opt optionValue, nonce, aad []byte) error {
+	opts, payload, err := readMessage(msg)
+	if err != nil {
+		return err
+	}
+	outer, inner := splitOptions(opts)
+	plaintext, err := marshalPlaintext(msg.Code(), inner, payload)
+	if err != nil {
+		return err
+	}
+	ciphertext := c.sender.Seal(nil, nonce, plaintext, aad)
+	outer = append(outer, message.Option{ID: OptionID, Value: opt.marshal()})
+	writeMessage(msg, outerCode, outer, ciphertext)
+	return nil
+}
+
+func (c *Context) unprotect(msg *pool.Message, nonce, aad []byte) error {
+	opts, ciphertext, err := readMessage(msg)
+	if err != nil {
+		return err
+	}
+	plaintext, err := c.recipient.Open(nil, nonce, ciphertext, aad)
+	if err != nil {
+		return ErrDecryptionFailed
+	}
+	code, inner, payload, err := unmarshalPlaintext(plaintext)
+	if err != nil {
+		return err
+	}
+	_, innerObserve := getOptionValue(inner, message.Observe)
+	outer := make(message.Options, 0, len(opts)+len(inner))
+	for _, o := range opts {
+		// the Class E options sent as the Outer options are ignored (RFC 8613 section 4.1)
+		switch {
+		case o.ID == OptionID:
+		case o.ID == message.Observe:
+			if !innerObserve {
+				outer = append(outer, o)
+			}
+		case outerOptions[o.ID]:
+			outer = append(outer, o)
+		}
+	}
+	writeMessage(msg, code, append(outer, inner...), payload)
+	return nil
+}
+
+func getOSCOREOption(msg *pool.Message) (optionValue, error) {
+	value, ok := getOptionValue(msg.Options(), OptionID)
+	if !ok {
+		return optionValue{}, fmt.Errorf("%w: option not found", errInvalidOption)
+	}
+	return parseOptionValue(value)
+}
+
+// IsProtected returns true when the message contains the OSCORE option.
+func IsProtected(msg *pool.Message) bool {
+	_, ok := getOptionValue(msg.Options(), OptionID)
+	return ok
+}
+
+// GetKIDContext returns the kid context of the protected request or nil when the request doesn't contain it.
+func GetKIDContext(msg *pool.Message) ([]byte, error) {
+	opt, err := getOSCOREOption(msg)
+	if err != nil {
+		return nil, err
+	}
+	return opt.kidContext, nil
+}
+
+// ProtectRequest replaces the request by the protected request (RFC 8613 section 8.1). The returned Request is used
+// to verify the responses of the request.
+func (c *Context) ProtectRequest(msg *pool.Message, includeIDContext bool) (*Request, error) {
+	piv, err := c.nextSequenceNumber(msg.Context())
+	if err != nil {
+		return nil, err
+	}
+	opt := optionValue{
+		piv:    piv,
+		kid:    c.senderID,
+		hasKID: true,
+	}
+	if includeIDContext && c.idContext != nil {
+		opt.kidContext = c.idContext
+	}
+	outerCode := codes.POST
+	if _, err := msg.Observe(); err == nil {
+		outerCode = codeFETCH
+	}
+	if err = c.protect(msg, outerCode, opt, c.nonce(c.senderID, piv), additionalData(c.senderID, piv)); err != nil {
+		return nil, err
+	}
+	return &Request{
+		KID: c.senderID,
+		PIV: piv,
+	}, nil
+}
+
+// UnprotectRequest replaces the protected request by the original request (RFC 8613 section 8.2). The returned Request
+// is used to protect the responses of the request. When ErrEchoRequired is returned, the request must be answered by
+// the protected 4.01 (Unauthorized) response with the Echo option.
+func (c *Context) UnprotectRequest(msg *pool.Message) (*Request, error) {
+	opt, err := getOSCOREOption(msg)
+	if err != nil {
+		return nil, err
+	}
+	if len(opt.piv) == 0 || !opt.hasKID {
+		return nil, fmt.Errorf("%w: partial IV and kid are required in request", errInvalidOption)
+	}
+	if !bytes.Equal(opt.kid, c.recipientID) {
+		return nil, fmt.Errorf("%w: unknown kid(%x)", ErrSecurityContextNotMatched, opt.kid)
+	}
+	if opt.kidContext != nil && !bytes.Equal(opt.kidContext, c.idContext) {
+		return nil, fmt.Errorf("%w: unknown kid context(%x)", ErrSecurityContextNotMatched, opt.kidContext)
+	}
+	kid := append([]byte(nil), opt.kid...)
+	piv := append([]byte(nil), opt.piv...)
+	if err = c.unprotect(msg, c.nonce(kid, piv), additionalData(kid, piv)); err != nil {
+		return nil, err
+	}
+	echo, _ := getOptionValue(msg.Options(), EchoOptionID)
+	req := &Request{
+		KID: kid,
+		PIV: piv,
+	}
+	req.Echo, err = c.verifyRequestFreshness(decodePIV(piv), echo)
+	if err != nil {
+		return req, err
+	}
+	if echo != nil {
+		msg.Remove(EchoOptionID)
+	}
+	return req, nil
+}
+
+// ProtectResponse replaces the response by the protected response (RFC 8613 section 8.3). The response always
+// contains own Partial IV, so the same request can be answered by several responses, eg. the notifications.
+func (c *Context) ProtectResponse(msg *pool.Message, req *Request) error {
+	if req.Echo != nil {
+		msg.SetOptionBytes(EchoOptionID, req.Echo)
+	}
+	piv, err := c.nextSequenceNumber(msg.Context())
+	if err != nil {
+		return err
+	}
+	outerCode := codes.Changed
+	if _, err := msg.Observe(); err == nil {
+		outerCode = codes.Content
+	}
+	return c.protect(msg, outerCode, optionValue{piv: piv}, c.nonce(c.senderID, piv), additionalData(req.KID, req.PIV))
+}
+
+// UnprotectResponse replaces the protected response of the request by the original response (RFC 8613 section 8.4).
+// The notifications older than the last received notification of the request are rejected by ErrReplayedMessage.
+func (c *Context) UnprotectResponse(msg *pool.Message, req *Request) error {
+	opt, err := getOSCOREOption(msg)
+	if err != nil {
+		return err
+	}
+	if len(opt.piv) == 0 {
+		return c.unprotect(msg, c.nonce(req.KID, req.PIV), additionalData(req.KID, req.PIV))
+	}
+	piv := append([]byte(nil), opt.piv...)
+	if err = c.unprotect(msg, c.nonce(c.recipientID, piv), additionalData(req.KID, req.PIV)); err != nil {
+		return err
+	}
+	if _, err = msg.Observe(); err != nil {
+		return nil
+	}
+	// notification number (RFC 8613 section 7.4.1)
+	seq := decodePIV(piv)
+	req.mutex.Lock()
+	defer req.mutex.Unlock()
+	if req.hasNotification && seq <= req.lastNotificationPIV {
+		return ErrReplayedMessage
+	}
+	req.hasNotification = true
+	req.lastNotificationPIV = seq
+	return nil
+}
@@ -0,0 +1,13 @@
+package mongodb
+
+import (
+	pkgMongo "github.com/plgd-dev/hub/v2/pkg/mongodb"
+)
+
+type Config struct {
+	Mongo pkgMongo.Config `yaml:",inline" json:",inline"`
+}
+
+func (c *Config) Validate() error {
+	return c.Mongo.Validate()
+}
@@ -0,0 +1,65 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/plgd-dev/hub/v2/pkg/fsnotify"
+	"github.com/plgd-dev/hub/v2/pkg/log"
+	pkgMongo "github.com/plgd-dev/hub/v2/pkg/mongodb"
+	"github.com/plgd-dev/hub/v2/pkg/security/certManager/client"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	sequenceNumbersCol = "oscoreSequenceNumbers"
+	idKey              = "_id"
+	nextKey            = "next"
+)
+
+// Store reserves the OSCORE sender sequence numbers by the atomic increment of the document of the security context,
+// so the instances of the service sharing the database never reserve the overlapping ranges.
+type Store struct {
+	*pkgMongo.Store
+}
+
+func New(ctx context.Context, cfg *Config, fileWatcher *fsnotify.Watcher, logger log.Logger, tracerProvider trace.TracerProvider) (*Store, error) {
+	certManager, err := client.New(cfg.Mongo.TLS, fileWatcher, logger, tracerProvider)
+	if err != nil {
+		return nil, fmt.Errorf("could not create cert manager: %w", err)
+	}
+	m, err := pkgMongo.NewStoreWithCollection(ctx, &cfg.Mongo, certManager.GetTLSConfig(), tracerProvider, sequenceNumbersCol)
+	if err != nil {
+		certManager.Close()
+		return nil, err
+	}
+	s := Store{Store: m}
+	s.SetOnClear(func(c context.Context) error {
+		return s.Collection(sequenceNumbersCol).Drop(c)
+	})
+	s.AddCloseFunc(certManager.Close)
+	return &s, nil
+}
+
+// Reserve reserves count sequence numbers of the security context and returns the first reserved number.
+func (s *Store) Reserve(ctx context.Context, id string, count uint64) (uint64, error) {
+	if id == "" || count == 0 || count > math.MaxInt64 {
+		return 0, errors.New("invalid argument")
+	}
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+	res := s.Collection(sequenceNumbersCol).FindOneAndUpdate(ctx,
+		bson.M{idKey: id},
+		bson.M{pkgMongo.Inc: bson.M{nextKey: int64(count)}},
+		opts)
+	var doc struct {
+		Next int64 `bson:"next"`
+	}
+	if err := res.Decode(&doc); err != nil {
+		return 0, fmt.Errorf("cannot reserve sequence numbers of %v: %w", id, err)
+	}
+	return uint64(doc.Next) - count, nil
+}
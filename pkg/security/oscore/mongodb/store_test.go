@@ -0,0 +1,80 @@
+package mongodb_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/plgd-dev/hub/v2/pkg/fsnotify"
+	"github.com/plgd-dev/hub/v2/pkg/log"
+	pkgMongo "github.com/plgd-dev/hub/v2/pkg/mongodb"
+	"github.com/plgd-dev/hub/v2/pkg/security/oscore/mongodb"
+	"github.com/plgd-dev/hub/v2/test/config"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+func newStore(t *testing.T) (*mongodb.Store, func()) {
+	logger := log.NewLogger(log.MakeDefaultConfig())
+	fileWatcher, err := fsnotify.NewWatcher(logger)
+	require.NoError(t, err)
+	cfg := mongodb.Config{
+		Mongo: pkgMongo.Config{
+			MaxPoolSize:     16,
+			MaxConnIdleTime: time.Minute * 4,
+			URI:             config.MONGODB_URI,
+			Database:        "coapGateway",
+			TLS:             config.MakeTLSClientConfig(),
+		},
+	}
+	ctx := context.Background()
+	s, err := mongodb.New(ctx, &cfg, fileWatcher, logger, noop.NewTracerProvider())
+	require.NoError(t, err)
+	return s, func() {
+		err := s.Clear(ctx)
+		require.NoError(t, err)
+		_ = s.Close(ctx)
+		err = fileWatcher.Close()
+		require.NoError(t, err)
+	}
+}
+
+func TestStoreReserve(t *testing.T) {
+	s, cleanUp := newStore(t)
+	defer cleanUp()
+	ctx := context.Background()
+
+	seq, err := s.Reserve(ctx, "a", 4)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), seq)
+	seq, err = s.Reserve(ctx, "a", 4)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), seq)
+	seq, err = s.Reserve(ctx, "b", 4)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), seq)
+	_, err = s.Reserve(ctx, "", 4)
+	require.Error(t, err)
+	_, err = s.Reserve(ctx, "a", 0)
+	require.Error(t, err)
+
+	// concurrent reservations never overlap
+	var mutex sync.Mutex
+	reserved := make(map[uint64]struct{})
+	var wg sync.WaitGroup
+	for range 16 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			seq, errR := s.Reserve(ctx, "c", 8)
+			require.NoError(t, errR)
+			mutex.Lock()
+			defer mutex.Unlock()
+			require.NotContains(t, reserved, seq)
+			reserved[seq] = struct{}{}
+		}()
+	}
+	wg.Wait()
+	require.Len(t, reserved, 16)
+}
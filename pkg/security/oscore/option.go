@@ -0,0 +1,110 @@
+package oscore
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/plgd-dev/go-coap/v3/message"
+)
+
+const (
+	// OptionID is the number of the OSCORE option (RFC 8613 section 2).
+	OptionID message.OptionID = 9
+	// EchoOptionID is the number of the Echo option (RFC 9175 section 2.2).
+	EchoOptionID message.OptionID = 252
+)
+
+const (
+	flagKIDContext = 0x10
+	flagKID        = 0x08
+	maskPIVLength  = 0x07
+	maxPIVLength   = 5
+)
+
+var errInvalidOption = errors.New("invalid OSCORE option")
+
+// optionValue is the decoded value of the OSCORE option (RFC 8613 section 6.1).
+type optionValue struct {
+	piv        []byte
+	kidContext []byte
+	kid        []byte
+	hasKID     bool
+}
+
+func (v optionValue) marshal() []byte {
+	if len(v.piv) == 0 && v.kidContext == nil && !v.hasKID {
+		return []byte{}
+	}
+	buf := make([]byte, 0, 2+len(v.piv)+len(v.kidContext)+len(v.kid))
+	flags := byte(len(v.piv))
+	if v.kidContext != nil {
+		flags |= flagKIDContext
+	}
+	if v.hasKID {
+		flags |= flagKID
+	}
+	buf = append(buf, flags)
+	buf = append(buf, v.piv...)
+	if v.kidContext != nil {
+		buf = append(buf, byte(len(v.kidContext)))
+		buf = append(buf, v.kidContext...)
+	}
+	if v.hasKID {
+		buf = append(buf, v.kid...)
+	}
+	return buf
+}
+
+func parseOptionValue(data []byte) (optionValue, error) {
+	var v optionValue
+	if len(data) == 0 {
+		return v, nil
+	}
+	flags := data[0]
+	if flags&0xe0 != 0 {
+		return v, fmt.Errorf("%w: unsupported flags(%#x)", errInvalidOption, flags)
+	}
+	data = data[1:]
+	pivLen := int(flags & maskPIVLength)
+	if pivLen > maxPIVLength || len(data) < pivLen {
+		return v, fmt.Errorf("%w: invalid partial IV length(%v)", errInvalidOption, pivLen)
+	}
+	v.piv = data[:pivLen]
+	data = data[pivLen:]
+	if flags&flagKIDContext != 0 {
+		if len(data) == 0 || len(data) < 1+int(data[0]) {
+			return v, fmt.Errorf("%w: invalid kid context", errInvalidOption)
+		}
+		v.kidContext = data[1 : 1+int(data[0])]
+		data = data[1+int(data[0]):]
+	}
+	if flags&flagKID != 0 {
+		v.kid = data
+		v.hasKID = true
+	} else if len(data) > 0 {
+		return v, fmt.Errorf("%w: unexpected trailing data", errInvalidOption)
+	}
+	return v, nil
+}
+
+// encodePIV encodes the sequence number as the Partial IV, it is the shortest big-endian representation.
+func encodePIV(seq uint64) []byte {
+	piv := make([]byte, 0, maxPIVLength)
+	started := false
+	for i := maxPIVLength - 1; i >= 0; i-- {
+		b := byte(seq >> (8 * uint(i)))
+		if b != 0 || started || i == 0 {
+			piv = append(piv, b)
+			started = true
+		}
+	}
+	return piv
+}
+
+func decodePIV(piv []byte) uint64 {
+	var seq uint64
+	for _, b := range piv {
+		seq = seq<<8 | uint64(b)
+	}
+	return seq
+}
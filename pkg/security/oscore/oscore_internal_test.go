@@ -0,0 +1,130 @@
+package oscore
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+
+	"github.com/plgd-dev/go-coap/v3/message"
+	"github.com/plgd-dev/go-coap/v3/message/codes"
+	"github.com/plgd-dev/go-coap/v3/message/pool"
+	udpCoder "github.com/plgd-dev/go-coap/v3/udp/coder"
+	"github.com/stretchr/testify/require"
+)
+
+func fromHex(t *testing.T, s string) []byte {
+	b, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	return b
+}
+
+// test vectors of RFC 8613 appendix C.1.1, C.4 and C.7
+var (
+	testMasterSecret = "0102030405060708090a0b0c0d0e0f10"
+	testMasterSalt   = "9e7ca92223786340"
+)
+
+func TestDeriveParameter(t *testing.T) {
+	secret := fromHex(t, testMasterSecret)
+	salt := fromHex(t, testMasterSalt)
+	senderKey, err := deriveParameter(secret, salt, []byte{}, nil, "Key", keyLength)
+	require.NoError(t, err)
+	require.Equal(t, "f0910ed7295e6ad4b54fc793154302ff", hex.EncodeToString(senderKey))
+	recipientKey, err := deriveParameter(secret, salt, []byte{0x01}, nil, "Key", keyLength)
+	require.NoError(t, err)
+	require.Equal(t, "ffb14e093c94c9cac9471648b4f98710", hex.EncodeToString(recipientKey))
+	commonIV, err := deriveParameter(secret, salt, nil, nil, "IV", nonceLength)
+	require.NoError(t, err)
+	require.Equal(t, "4622d4dd6d944168eefb54987c", hex.EncodeToString(commonIV))
+}
+
+func TestProtectRequestVector(t *testing.T) {
+	client, err := NewContext(fromHex(t, testMasterSecret), []byte{}, []byte{0x01}, nil, WithMasterSalt(fromHex(t, testMasterSalt)))
+	require.NoError(t, err)
+	client.sequenceNumber = 20
+	require.Equal(t, "4622d4dd6d944168eefb549868", hex.EncodeToString(client.nonce(client.senderID, encodePIV(20))))
+	require.Equal(t, "8368456e63727970743040488501810a40411440", hex.EncodeToString(additionalData([]byte{}, encodePIV(20))))
+
+	req := pool.NewMessage(context.Background())
+	req.SetCode(codes.GET)
+	req.SetType(message.Confirmable)
+	req.SetMessageID(0x5d1f)
+	req.SetToken(fromHex(t, "00003974"))
+	req.SetOptionString(message.URIHost, "localhost")
+	req.SetOptionString(message.URIPath, "tv1")
+	r, err := client.ProtectRequest(req, false)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x14}, r.PIV)
+	data, err := req.MarshalWithEncoder(udpCoder.DefaultCoder)
+	require.NoError(t, err)
+	require.Equal(t, "44025d1f00003974396c6f63616c686f7374620914ff612f1092f1776f1c1668b3825e", hex.EncodeToString(data))
+
+	resp := pool.NewMessage(context.Background())
+	_, err = resp.UnmarshalWithDecoder(udpCoder.DefaultCoder, fromHex(t, "64445d1f0000397490ffdbaad1e9a7e7b2a813d3c31524378303cdafae119106"))
+	require.NoError(t, err)
+	err = client.UnprotectResponse(resp, r)
+	require.NoError(t, err)
+	require.Equal(t, codes.Content, resp.Code())
+	body, err := resp.ReadBody()
+	require.NoError(t, err)
+	require.Equal(t, "Hello World!", string(body))
+}
+
+func TestOptionValue(t *testing.T) {
+	tests := []struct {
+		name  string
+		value optionValue
+		data  string
+	}{
+		{name: "empty", value: optionValue{}, data: ""},
+		{name: "piv", value: optionValue{piv: []byte{0x14}}, data: "0114"},
+		{name: "kid", value: optionValue{piv: []byte{0x14}, kid: []byte{0x01}, hasKID: true}, data: "091401"},
+		{name: "empty kid", value: optionValue{piv: []byte{0x14}, kid: []byte{}, hasKID: true}, data: "0914"},
+		{name: "kid context", value: optionValue{piv: []byte{0x01, 0x02}, kidContext: []byte{0xaa, 0xbb}, kid: []byte{0x01}, hasKID: true}, data: "1a010202aabb01"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := tt.value.marshal()
+			require.Equal(t, tt.data, hex.EncodeToString(data))
+			got, err := parseOptionValue(data)
+			require.NoError(t, err)
+			require.Equal(t, string(tt.value.piv), string(got.piv))
+			require.Equal(t, tt.value.hasKID, got.hasKID)
+			require.Equal(t, string(tt.value.kid), string(got.kid))
+			require.Equal(t, string(tt.value.kidContext), string(got.kidContext))
+		})
+	}
+	_, err := parseOptionValue([]byte{0x06, 0x01})
+	require.Error(t, err)
+	_, err = parseOptionValue([]byte{0x11, 0x01, 0x05, 0x01})
+	require.Error(t, err)
+	_, err = parseOptionValue([]byte{0x01, 0x01, 0x02})
+	require.Error(t, err)
+}
+
+func TestReplayWindow(t *testing.T) {
+	var w replayWindow
+	require.True(t, w.check(5))
+	w.update(5)
+	require.False(t, w.check(5))
+	require.True(t, w.check(4))
+	w.update(4)
+	require.False(t, w.check(4))
+	w.update(40)
+	require.False(t, w.check(5))
+	require.True(t, w.check(39))
+	require.True(t, w.check(9))
+	require.False(t, w.check(8))
+	w.update(9)
+	require.False(t, w.check(9))
+	require.False(t, w.check(40))
+	require.True(t, w.check(41))
+}
+
+func TestPIV(t *testing.T) {
+	for _, seq := range []uint64{0, 1, 0xff, 0x100, 0xffffff, maxSequenceNumber} {
+		require.Equal(t, seq, decodePIV(encodePIV(seq)))
+	}
+	require.Equal(t, []byte{0x00}, encodePIV(0))
+	require.Equal(t, []byte{0x01, 0x00}, encodePIV(0x100))
+}
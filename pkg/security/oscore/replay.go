@@ -0,0 +1,46 @@
+package oscore
+
+// replayWindowSize is the default size of the replay window (RFC 8613 section 7.4).
+const replayWindowSize = 32
+
+// replayWindow is the sliding window of the received sequence numbers of the recipient context.
+type replayWindow struct {
+	initialized bool
+	highest     uint64
+	// bitmap of the received sequence numbers, bit i is set when highest-i was received
+	bitmap uint32
+}
+
+// check returns true when the sequence number was not received yet and it is not too old.
+func (w *replayWindow) check(seq uint64) bool {
+	if !w.initialized || seq > w.highest {
+		return true
+	}
+	diff := w.highest - seq
+	if diff >= replayWindowSize {
+		return false
+	}
+	return w.bitmap&(1<<diff) == 0
+}
+
+// update marks the sequence number as received, it must be called only for the verified messages.
+func (w *replayWindow) update(seq uint64) {
+	if !w.initialized {
+		w.initialized = true
+		w.highest = seq
+		w.bitmap = 1
+		return
+	}
+	if seq > w.highest {
+		shift := seq - w.highest
+		if shift >= replayWindowSize {
+			w.bitmap = 0
+		} else {
+			w.bitmap <<= shift
+		}
+		w.highest = seq
+		w.bitmap |= 1
+		return
+	}
+	w.bitmap |= 1 << (w.highest - seq)
+}
@@ -0,0 +1,11 @@
+package oscore
+
+import "context"
+
+// SequenceNumberStore reserves the ranges of the Sender Sequence Numbers of the security contexts, so the numbers are
+// never reused after the restart of the endpoint (RFC 8613 appendix B.1.1) or by the other instance of the endpoint
+// using the same security context. The reservation must be atomic for all instances sharing the store.
+type SequenceNumberStore interface {
+	// Reserve reserves count sequence numbers of the context and returns the first reserved number.
+	Reserve(ctx context.Context, id string, count uint64) (uint64, error)
+}
@@ -0,0 +1,111 @@
+package senml
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/plgd-dev/kit/v2/codec/cbor"
+)
+
+// MarshalJSON encodes the pack as application/senml+json.
+func (p Pack) MarshalJSON() ([]byte, error) {
+	records := make([]jsonRecord, 0, len(p))
+	for _, r := range p {
+		records = append(records, r.toJSON())
+	}
+	return json.Marshal(records)
+}
+
+// UnmarshalJSON decodes the pack from application/senml+json.
+func (p *Pack) UnmarshalJSON(data []byte) error {
+	var records []jsonRecord
+	if err := json.Unmarshal(data, &records); err != nil {
+		return err
+	}
+	pack := make(Pack, 0, len(records))
+	for _, r := range records {
+		v, err := r.toRecord()
+		if err != nil {
+			return err
+		}
+		pack = append(pack, v)
+	}
+	*p = pack
+	return nil
+}
+
+// MarshalCBOR encodes the pack as application/senml+cbor.
+func (p Pack) MarshalCBOR() ([]byte, error) {
+	records := make([]cborRecord, 0, len(p))
+	for _, r := range p {
+		records = append(records, r.toCBOR())
+	}
+	return cbor.Encode(records)
+}
+
+// UnmarshalCBOR decodes the pack from application/senml+cbor.
+func (p *Pack) UnmarshalCBOR(data []byte) error {
+	var records []cborRecord
+	if err := cbor.Decode(data, &records); err != nil {
+		return err
+	}
+	pack := make(Pack, 0, len(records))
+	for _, r := range records {
+		pack = append(pack, r.toRecord())
+	}
+	*p = pack
+	return nil
+}
+
+// EncodeJSON converts the representation to the records and encodes them as application/senml+json.
+func EncodeJSON(v interface{}) ([]byte, error) {
+	p, err := FromRepresentation(v)
+	if err != nil {
+		return nil, err
+	}
+	return p.MarshalJSON()
+}
+
+// EncodeCBOR converts the representation to the records and encodes them as application/senml+cbor.
+func EncodeCBOR(v interface{}) ([]byte, error) {
+	p, err := FromRepresentation(v)
+	if err != nil {
+		return nil, err
+	}
+	return p.MarshalCBOR()
+}
+
+func decode(p Pack, v interface{}) error {
+	r, err := p.ToRepresentation()
+	if err != nil {
+		return err
+	}
+	if out, ok := v.(*interface{}); ok {
+		*out = r
+		return nil
+	}
+	// the representation is stored to the typed value in the same way as the CBOR content
+	data, err := cbor.Encode(r)
+	if err != nil {
+		return fmt.Errorf("cannot encode representation: %w", err)
+	}
+	return cbor.Decode(data, v)
+}
+
+// DecodeJSON decodes the application/senml+json records and stores the representation built from them in v.
+func DecodeJSON(data []byte, v interface{}) error {
+	var p Pack
+	if err := p.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	return decode(p, v)
+}
+
+// DecodeCBOR decodes the application/senml+cbor records and stores the representation built from them in v.
+func DecodeCBOR(data []byte, v interface{}) error {
+	var p Pack
+	if err := p.UnmarshalCBOR(data); err != nil {
+		return err
+	}
+	return decode(p, v)
+}
@@ -0,0 +1,338 @@
+// Package senml converts the resource representations to and from the Sensor Measurement Lists (SenML, RFC 8428)
+// encoded as application/senml+json or application/senml+cbor.
+package senml
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// NameSeparator joins the keys of the nested maps and the indexes of the arrays into the name of the record.
+const NameSeparator = "/"
+
+// Record is the SenML record. The base fields are applied to the record and to all following records
+// until they are redefined.
+type Record struct {
+	BaseName    string
+	BaseTime    float64
+	BaseUnit    string
+	BaseValue   *float64
+	BaseSum     *float64
+	BaseVersion int
+	Name        string
+	Unit        string
+	Value       *float64
+	StringValue *string
+	BoolValue   *bool
+	DataValue   []byte
+	Sum         *float64
+	Time        float64
+	UpdateTime  float64
+}
+
+// Pack is the list of the SenML records.
+type Pack []Record
+
+type jsonRecord struct {
+	BaseName    string   `json:"bn,omitempty"`
+	BaseTime    float64  `json:"bt,omitempty"`
+	BaseUnit    string   `json:"bu,omitempty"`
+	BaseValue   *float64 `json:"bv,omitempty"`
+	BaseSum     *float64 `json:"bs,omitempty"`
+	BaseVersion int      `json:"bver,omitempty"`
+	Name        string   `json:"n,omitempty"`
+	Unit        string   `json:"u,omitempty"`
+	Value       *float64 `json:"v,omitempty"`
+	StringValue *string  `json:"vs,omitempty"`
+	BoolValue   *bool    `json:"vb,omitempty"`
+	DataValue   string   `json:"vd,omitempty"`
+	Sum         *float64 `json:"s,omitempty"`
+	Time        float64  `json:"t,omitempty"`
+	UpdateTime  float64  `json:"ut,omitempty"`
+}
+
+type cborRecord struct {
+	BaseName    string   `cbor:"-2,keyasint,omitempty"`
+	BaseTime    float64  `cbor:"-3,keyasint,omitempty"`
+	BaseUnit    string   `cbor:"-4,keyasint,omitempty"`
+	BaseValue   *float64 `cbor:"-5,keyasint,omitempty"`
+	BaseSum     *float64 `cbor:"-6,keyasint,omitempty"`
+	BaseVersion int      `cbor:"-1,keyasint,omitempty"`
+	Name        string   `cbor:"0,keyasint,omitempty"`
+	Unit        string   `cbor:"1,keyasint,omitempty"`
+	Value       *float64 `cbor:"2,keyasint,omitempty"`
+	StringValue *string  `cbor:"3,keyasint,omitempty"`
+	BoolValue   *bool    `cbor:"4,keyasint,omitempty"`
+	DataValue   []byte   `cbor:"8,keyasint,omitempty"`
+	Sum         *float64 `cbor:"5,keyasint,omitempty"`
+	Time        float64  `cbor:"6,keyasint,omitempty"`
+	UpdateTime  float64  `cbor:"7,keyasint,omitempty"`
+}
+
+func (r Record) toJSON() jsonRecord {
+	v := jsonRecord{
+		BaseName:    r.BaseName,
+		BaseTime:    r.BaseTime,
+		BaseUnit:    r.BaseUnit,
+		BaseValue:   r.BaseValue,
+		BaseSum:     r.BaseSum,
+		BaseVersion: r.BaseVersion,
+		Name:        r.Name,
+		Unit:        r.Unit,
+		Value:       r.Value,
+		StringValue: r.StringValue,
+		BoolValue:   r.BoolValue,
+		Sum:         r.Sum,
+		Time:        r.Time,
+		UpdateTime:  r.UpdateTime,
+	}
+	if r.DataValue != nil {
+		v.DataValue = base64.RawURLEncoding.EncodeToString(r.DataValue)
+	}
+	return v
+}
+
+func (r jsonRecord) toRecord() (Record, error) {
+	v := Record{
+		BaseName:    r.BaseName,
+		BaseTime:    r.BaseTime,
+		BaseUnit:    r.BaseUnit,
+		BaseValue:   r.BaseValue,
+		BaseSum:     r.BaseSum,
+		BaseVersion: r.BaseVersion,
+		Name:        r.Name,
+		Unit:        r.Unit,
+		Value:       r.Value,
+		StringValue: r.StringValue,
+		BoolValue:   r.BoolValue,
+		Sum:         r.Sum,
+		Time:        r.Time,
+		UpdateTime:  r.UpdateTime,
+	}
+	if r.DataValue != "" {
+		data, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(r.DataValue, "="))
+		if err != nil {
+			return Record{}, fmt.Errorf("invalid data value of record('%v'): %w", r.Name, err)
+		}
+		v.DataValue = data
+	}
+	return v, nil
+}
+
+func (r Record) toCBOR() cborRecord {
+	return cborRecord(r)
+}
+
+func (r cborRecord) toRecord() Record {
+	return Record(r)
+}
+
+func joinName(name, key string) string {
+	if name == "" {
+		return key
+	}
+	return name + NameSeparator + key
+}
+
+func toFloat(v reflect.Value) (float64, bool) {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	default:
+		return 0, false
+	}
+}
+
+func flattenMap(name string, v reflect.Value, p *Pack) error {
+	keys := make([]string, 0, v.Len())
+	values := make(map[string]reflect.Value, v.Len())
+	iter := v.MapRange()
+	for iter.Next() {
+		k := iter.Key()
+		if k.Kind() == reflect.Interface {
+			k = k.Elem()
+		}
+		if k.Kind() != reflect.String {
+			return fmt.Errorf("unsupported key type(%v) of map('%v')", k.Kind(), name)
+		}
+		keys = append(keys, k.String())
+		values[k.String()] = iter.Value()
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if err := flatten(joinName(name, k), values[k].Interface(), p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func flatten(name string, v interface{}, p *Pack) error {
+	switch val := v.(type) {
+	case nil:
+		// SenML cannot express null values
+		return nil
+	case string:
+		*p = append(*p, Record{Name: name, StringValue: &val})
+		return nil
+	case bool:
+		*p = append(*p, Record{Name: name, BoolValue: &val})
+		return nil
+	case []byte:
+		*p = append(*p, Record{Name: name, DataValue: val})
+		return nil
+	}
+	rv := reflect.ValueOf(v)
+	if f, ok := toFloat(rv); ok {
+		*p = append(*p, Record{Name: name, Value: &f})
+		return nil
+	}
+	switch rv.Kind() {
+	case reflect.Map:
+		return flattenMap(name, rv, p)
+	case reflect.Slice, reflect.Array:
+		for i := range rv.Len() {
+			if err := flatten(joinName(name, strconv.Itoa(i)), rv.Index(i).Interface(), p); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported type(%T) of value('%v')", v, name)
+	}
+}
+
+// FromRepresentation converts the representation of the resource to the records. The nested maps and arrays
+// are flattened to the names joined by NameSeparator, eg. {"a":{"b":1},"c":[true]} is converted to the records
+// a/b=1 and c/0=true. Null values and empty maps and arrays cannot be expressed by SenML, so they are omitted.
+func FromRepresentation(v interface{}) (Pack, error) {
+	p := make(Pack, 0, 8)
+	if err := flatten("", v, &p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func addFloat(a, b *float64) *float64 {
+	if b == nil {
+		return a
+	}
+	v := *a + *b
+	return &v
+}
+
+// toValue returns the value of the record. The integral numbers are returned as int64, so the devices which expect
+// the integers in the representation accept them.
+func toValue(r Record, baseValue, baseSum *float64) (interface{}, bool) {
+	var f *float64
+	switch {
+	case r.Value != nil:
+		f = addFloat(r.Value, baseValue)
+	case r.StringValue != nil:
+		return *r.StringValue, true
+	case r.BoolValue != nil:
+		return *r.BoolValue, true
+	case r.DataValue != nil:
+		return r.DataValue, true
+	case r.Sum != nil:
+		f = addFloat(r.Sum, baseSum)
+	default:
+		return nil, false
+	}
+	if *f == math.Trunc(*f) && math.Abs(*f) < math.MaxInt64 {
+		return int64(*f), true
+	}
+	return *f, true
+}
+
+func setValue(root map[string]interface{}, name string, value interface{}) error {
+	keys := strings.Split(name, NameSeparator)
+	m := root
+	for i, k := range keys[:len(keys)-1] {
+		next, ok := m[k]
+		if !ok {
+			nm := make(map[string]interface{})
+			m[k] = nm
+			m = nm
+			continue
+		}
+		nm, ok := next.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("name('%v') conflicts with the value of the record('%v')", name, strings.Join(keys[:i+1], NameSeparator))
+		}
+		m = nm
+	}
+	last := keys[len(keys)-1]
+	if _, ok := m[last].(map[string]interface{}); ok {
+		return fmt.Errorf("name('%v') conflicts with the names of the other records", name)
+	}
+	m[last] = value
+	return nil
+}
+
+// toArrays converts the maps with the keys 0..n-1 to the arrays.
+func toArrays(v interface{}) interface{} {
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return v
+	}
+	for k, val := range m {
+		m[k] = toArrays(val)
+	}
+	if len(m) == 0 {
+		return m
+	}
+	arr := make([]interface{}, len(m))
+	for k, val := range m {
+		i, err := strconv.Atoi(k)
+		if err != nil || i < 0 || i >= len(m) || strconv.Itoa(i) != k {
+			return m
+		}
+		arr[i] = val
+	}
+	return arr
+}
+
+// ToRepresentation resolves the records and builds the representation of the resource from their names.
+// It is the inverse of FromRepresentation, when the pack contains more records with the same name the last one wins.
+func (p Pack) ToRepresentation() (interface{}, error) {
+	var baseName string
+	var baseValue, baseSum *float64
+	root := make(map[string]interface{})
+	for _, r := range p {
+		if r.BaseName != "" {
+			baseName = r.BaseName
+		}
+		if r.BaseValue != nil {
+			baseValue = r.BaseValue
+		}
+		if r.BaseSum != nil {
+			baseSum = r.BaseSum
+		}
+		value, ok := toValue(r, baseValue, baseSum)
+		if !ok {
+			continue
+		}
+		name := baseName + r.Name
+		if name == "" {
+			if len(p) != 1 {
+				return nil, errors.New("record without name must be the only record of the pack")
+			}
+			return value, nil
+		}
+		if err := setValue(root, name, value); err != nil {
+			return nil, err
+		}
+	}
+	return toArrays(root), nil
+}
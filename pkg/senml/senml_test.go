@@ -0,0 +1,121 @@
+package senml_test
+
+import (
+	"testing"
+
+	"github.com/plgd-dev/hub/v2/pkg/senml"
+	"github.com/plgd-dev/kit/v2/codec/cbor"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromRepresentation(t *testing.T) {
+	p, err := senml.FromRepresentation(map[interface{}]interface{}{
+		"power": uint64(1),
+		"name":  "light",
+		"state": true,
+		"range": []interface{}{int64(0), 100.5},
+		"nested": map[string]interface{}{
+			"data": []byte{1, 2},
+			"null": nil,
+		},
+	})
+	require.NoError(t, err)
+	data, err := p.MarshalJSON()
+	require.NoError(t, err)
+	require.JSONEq(t, `[
+		{"n":"name","vs":"light"},
+		{"n":"nested/data","vd":"AQI"},
+		{"n":"power","v":1},
+		{"n":"range/0","v":0},
+		{"n":"range/1","v":100.5},
+		{"n":"state","vb":true}
+	]`, string(data))
+
+	_, err = senml.FromRepresentation(map[interface{}]interface{}{1: "invalid key"})
+	require.Error(t, err)
+}
+
+func TestToRepresentation(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			name: "base fields",
+			data: `[{"bn":"light/","bv":10,"n":"power","v":1},{"n":"range/0","v":0.5},{"n":"range/1","v":100},{"n":"name","vs":"x"},{"n":"data","vd":"AQI"}]`,
+			want: map[string]interface{}{
+				"light": map[string]interface{}{
+					"power": int64(11),
+					"range": []interface{}{10.5, int64(110)},
+					"name":  "x",
+					"data":  []byte{1, 2},
+				},
+			},
+		},
+		{
+			name: "last record wins",
+			data: `[{"n":"power","v":1},{"n":"power","v":2}]`,
+			want: map[string]interface{}{"power": int64(2)},
+		},
+		{
+			name: "single value",
+			data: `[{"vb":true}]`,
+			want: true,
+		},
+		{
+			name:    "conflicting names",
+			data:    `[{"n":"a","v":1},{"n":"a/b","v":2}]`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid data value",
+			data:    `[{"n":"a","vd":"*"}]`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got interface{}
+			err := senml.DecodeJSON([]byte(tt.data), &got)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCBORRoundTrip(t *testing.T) {
+	representation := map[string]interface{}{
+		"power": int64(1),
+		"name":  "light",
+		"range": []interface{}{int64(0), 100.5},
+	}
+	data, err := senml.EncodeCBOR(representation)
+	require.NoError(t, err)
+
+	// the records use the integer keys of the SenML CBOR representation
+	var records []map[int]interface{}
+	err = cbor.Decode(data, &records)
+	require.NoError(t, err)
+	require.Equal(t, "name", records[0][0])
+	require.Equal(t, "light", records[0][3])
+
+	var got interface{}
+	err = senml.DecodeCBOR(data, &got)
+	require.NoError(t, err)
+	require.Equal(t, representation, got)
+
+	var typed struct {
+		Power int    `json:"power"`
+		Name  string `json:"name"`
+	}
+	err = senml.DecodeCBOR(data, &typed)
+	require.NoError(t, err)
+	require.Equal(t, 1, typed.Power)
+	require.Equal(t, "light", typed.Name)
+}
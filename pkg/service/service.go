@@ -13,11 +13,12 @@ import (
 )
 
 type Service struct {
-	services []APIService
-	done     chan struct{}
-	sigs     chan os.Signal
-	closeFn  fn.FuncList
-	serving  atomic.Bool
+	services      []APIService
+	done          chan struct{}
+	sigs          chan os.Signal
+	beforeCloseFn fn.FuncList
+	closeFn       fn.FuncList
+	serving       atomic.Bool
 }
 
 type APIService interface {
@@ -60,6 +61,7 @@ func (s *Service) Serve() error {
 		syscall.SIGTERM,
 		syscall.SIGQUIT)
 	<-s.sigs
+	s.beforeCloseFn.Execute()
 	for _, apiService := range s.services {
 		err := apiService.Close()
 		errCh <- err
@@ -101,6 +103,12 @@ func (s *Service) Close() error {
 	return nil
 }
 
+// AddBeforeCloseFunc adds a function to be called when the signal is received, before the API services are closed.
+// This needs to be called before Serve.
+func (s *Service) AddBeforeCloseFunc(f func()) {
+	s.beforeCloseFn.AddFunc(f)
+}
+
 // AddCloseFunc adds a function to be called when the server is closed
 func (s *Service) AddCloseFunc(f func()) {
 	s.closeFn.AddFunc(f)
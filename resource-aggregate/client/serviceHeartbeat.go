@@ -1,4 +1,4 @@
-package service
+package client
 
 import (
 	"context"
@@ -9,7 +9,6 @@ import (
 	"github.com/plgd-dev/hub/v2/pkg/log"
 	"github.com/plgd-dev/hub/v2/pkg/service"
 	pkgTime "github.com/plgd-dev/hub/v2/pkg/time"
-	raClient "github.com/plgd-dev/hub/v2/resource-aggregate/client"
 	"github.com/plgd-dev/hub/v2/resource-aggregate/commands"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -17,9 +16,9 @@ import (
 
 const numberUpdatesInTimeToLive = 3
 
-type serviceHeartbeat struct {
+type ServiceHeartbeat struct {
 	timeToLive          time.Duration
-	raClient            *raClient.Client
+	raClient            *Client
 	done                chan struct{}
 	instanceID          uuid.UUID
 	logger              log.Logger
@@ -27,12 +26,12 @@ type serviceHeartbeat struct {
 	service             *service.Service
 }
 
-// NewServiceHeartbeat creates new serviceHeartbeat instance. It will update service metadata in two times in timeToLive.
+// NewServiceHeartbeat creates new ServiceHeartbeat instance. It will update service metadata in two times in timeToLive.
 // If it fails to update service metadata in two times in row, it will kill the service. Because resource aggregate
 // can't be sure if the service is still alive. If any other services updates service metadata, it can consider this
 // service as dead. And all devices connected to this service will be marked as offline.
-func newServiceHeartbeat(instanceID uuid.UUID, timeToLive time.Duration, raClient *raClient.Client, logger log.Logger, service *service.Service) (*serviceHeartbeat, error) {
-	s := &serviceHeartbeat{
+func NewServiceHeartbeat(instanceID uuid.UUID, timeToLive time.Duration, raClient *Client, logger log.Logger, service *service.Service) (*ServiceHeartbeat, error) {
+	s := &ServiceHeartbeat{
 		instanceID: instanceID,
 		timeToLive: timeToLive,
 		raClient:   raClient,
@@ -49,7 +48,7 @@ func newServiceHeartbeat(instanceID uuid.UUID, timeToLive time.Duration, raClien
 }
 
 // updateServiceMetadata updates service metadata in resource aggregate.
-func (s *serviceHeartbeat) updateServiceMetadata(register bool) (time.Time, error) {
+func (s *ServiceHeartbeat) updateServiceMetadata(register bool) (time.Time, error) {
 	// set deadline to prevent blocking the service
 	deadline := s.heartbeatValidUntil.Add(s.timeToLive)
 	if s.heartbeatValidUntil.IsZero() {
@@ -83,7 +82,7 @@ func needToShutdownService(err error) bool {
 	return false
 }
 
-func (s *serviceHeartbeat) tryUpdateServiceMetadata(now time.Time) error {
+func (s *ServiceHeartbeat) tryUpdateServiceMetadata(now time.Time) error {
 	var err error
 	var isOffline bool
 	switch {
@@ -112,8 +111,8 @@ func (s *serviceHeartbeat) tryUpdateServiceMetadata(now time.Time) error {
 	return nil
 }
 
-// Serve starts serviceHeartbeat. It will update service metadata in two times in timeToLive.
-func (s *serviceHeartbeat) Serve() error {
+// Serve starts ServiceHeartbeat. It will update service metadata in two times in timeToLive.
+func (s *ServiceHeartbeat) Serve() error {
 	now := time.Now()
 	timer := time.NewTimer(0)
 	if !timer.Stop() {
@@ -139,8 +138,8 @@ func (s *serviceHeartbeat) Serve() error {
 	}
 }
 
-// Close stops serviceHeartbeat.
-func (s *serviceHeartbeat) Close() error {
+// Close stops ServiceHeartbeat.
+func (s *ServiceHeartbeat) Close() error {
 	select {
 	case s.done <- struct{}{}:
 	default:
@@ -75,6 +75,9 @@ const (
 	Connection_COAP_TCP  Connection_Protocol = 3
 	Connection_COAPS_TCP Connection_Protocol = 4
 	Connection_C2C       Connection_Protocol = 5
+	Connection_MQTT      Connection_Protocol = 6
+	Connection_COAP_WS   Connection_Protocol = 7
+	Connection_COAPS_WS  Connection_Protocol = 8
 )
 
 // Enum value maps for Connection_Protocol.
@@ -86,6 +89,9 @@ var (
 		3: "COAP_TCP",
 		4: "COAPS_TCP",
 		5: "C2C",
+		6: "MQTT",
+		7: "COAP_WS",
+		8: "COAPS_WS",
 	}
 	Connection_Protocol_value = map[string]int32{
 		"UNKNOWN":   0,
@@ -94,6 +100,9 @@ var (
 		"COAP_TCP":  3,
 		"COAPS_TCP": 4,
 		"C2C":       5,
+		"MQTT":      6,
+		"COAP_WS":   7,
+		"COAPS_WS":  8,
 	}
 )
 
@@ -1976,6 +1985,77 @@ func (x *TwinSynchronization) GetForceSynchronizationAt() int64 {
 	return 0
 }
 
+type LabelsUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Set               map[string]string `protobuf:"bytes,1,rep,name=set,proto3" json:"set,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // labels to add or overwrite.
+	Remove            []string          `protobuf:"bytes,2,rep,name=remove,proto3" json:"remove,omitempty"`                                                                                   // keys of labels to remove. Keys present in set and remove are removed.
+	SetDisplayName    string            `protobuf:"bytes,3,opt,name=set_display_name,json=setDisplayName,proto3" json:"set_display_name,omitempty"`                                           // user-defined name of the device to set or overwrite. Empty value keeps the current name.
+	RemoveDisplayName bool              `protobuf:"varint,4,opt,name=remove_display_name,json=removeDisplayName,proto3" json:"remove_display_name,omitempty"`                                 // remove the user-defined name of the device. It takes precedence over set_display_name.
+}
+
+func (x *LabelsUpdate) Reset() {
+	*x = LabelsUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_resource_aggregate_pb_commands_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LabelsUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LabelsUpdate) ProtoMessage() {}
+
+func (x *LabelsUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_aggregate_pb_commands_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LabelsUpdate.ProtoReflect.Descriptor instead.
+func (*LabelsUpdate) Descriptor() ([]byte, []int) {
+	return file_resource_aggregate_pb_commands_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *LabelsUpdate) GetSet() map[string]string {
+	if x != nil {
+		return x.Set
+	}
+	return nil
+}
+
+func (x *LabelsUpdate) GetRemove() []string {
+	if x != nil {
+		return x.Remove
+	}
+	return nil
+}
+
+func (x *LabelsUpdate) GetSetDisplayName() string {
+	if x != nil {
+		return x.SetDisplayName
+	}
+	return ""
+}
+
+func (x *LabelsUpdate) GetRemoveDisplayName() bool {
+	if x != nil {
+		return x.RemoveDisplayName
+	}
+	return false
+}
+
 type UpdateDeviceMetadataRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1989,6 +2069,7 @@ type UpdateDeviceMetadataRequest struct {
 	//	*UpdateDeviceMetadataRequest_TwinSynchronization
 	//	*UpdateDeviceMetadataRequest_TwinEnabled
 	//	*UpdateDeviceMetadataRequest_TwinForceSynchronization
+	//	*UpdateDeviceMetadataRequest_Labels
 	Update          isUpdateDeviceMetadataRequest_Update `protobuf_oneof:"update"`
 	TimeToLive      int64                                `protobuf:"varint,5,opt,name=time_to_live,json=timeToLive,proto3" json:"time_to_live,omitempty"` // command validity in nanoseconds. 0 means forever and minimal value is 100000000 (100ms).
 	CommandMetadata *CommandMetadata                     `protobuf:"bytes,6,opt,name=command_metadata,json=commandMetadata,proto3" json:"command_metadata,omitempty"`
@@ -1997,7 +2078,7 @@ type UpdateDeviceMetadataRequest struct {
 func (x *UpdateDeviceMetadataRequest) Reset() {
 	*x = UpdateDeviceMetadataRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_resource_aggregate_pb_commands_proto_msgTypes[27]
+		mi := &file_resource_aggregate_pb_commands_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2010,7 +2091,7 @@ func (x *UpdateDeviceMetadataRequest) String() string {
 func (*UpdateDeviceMetadataRequest) ProtoMessage() {}
 
 func (x *UpdateDeviceMetadataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_aggregate_pb_commands_proto_msgTypes[27]
+	mi := &file_resource_aggregate_pb_commands_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2023,7 +2104,7 @@ func (x *UpdateDeviceMetadataRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateDeviceMetadataRequest.ProtoReflect.Descriptor instead.
 func (*UpdateDeviceMetadataRequest) Descriptor() ([]byte, []int) {
-	return file_resource_aggregate_pb_commands_proto_rawDescGZIP(), []int{27}
+	return file_resource_aggregate_pb_commands_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *UpdateDeviceMetadataRequest) GetDeviceId() string {
@@ -2075,6 +2156,13 @@ func (x *UpdateDeviceMetadataRequest) GetTwinForceSynchronization() bool {
 	return false
 }
 
+func (x *UpdateDeviceMetadataRequest) GetLabels() *LabelsUpdate {
+	if x, ok := x.GetUpdate().(*UpdateDeviceMetadataRequest_Labels); ok {
+		return x.Labels
+	}
+	return nil
+}
+
 func (x *UpdateDeviceMetadataRequest) GetTimeToLive() int64 {
 	if x != nil {
 		return x.TimeToLive
@@ -2109,6 +2197,10 @@ type UpdateDeviceMetadataRequest_TwinForceSynchronization struct {
 	TwinForceSynchronization bool `protobuf:"varint,9,opt,name=twin_force_synchronization,json=twinForceSynchronization,proto3,oneof"` // Force synchronization IoT hub with the device resources and set twin_enabled to true. Use to address potential synchronization issues and prevent operational discrepancies.
 }
 
+type UpdateDeviceMetadataRequest_Labels struct {
+	Labels *LabelsUpdate `protobuf:"bytes,10,opt,name=labels,proto3,oneof"` // Update user-defined labels and display name of the device. The labels are applied immediately, no confirmation from the device is needed.
+}
+
 func (*UpdateDeviceMetadataRequest_Connection) isUpdateDeviceMetadataRequest_Update() {}
 
 func (*UpdateDeviceMetadataRequest_TwinSynchronization) isUpdateDeviceMetadataRequest_Update() {}
@@ -2117,20 +2209,24 @@ func (*UpdateDeviceMetadataRequest_TwinEnabled) isUpdateDeviceMetadataRequest_Up
 
 func (*UpdateDeviceMetadataRequest_TwinForceSynchronization) isUpdateDeviceMetadataRequest_Update() {}
 
+func (*UpdateDeviceMetadataRequest_Labels) isUpdateDeviceMetadataRequest_Update() {}
+
 type UpdateDeviceMetadataResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ValidUntil   int64         `protobuf:"varint,1,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"` // unix timestamp in nanoseconds (https://golang.org/pkg/time/#Time.UnixNano) when command is considered as expired. 0 means forever.
-	AuditContext *AuditContext `protobuf:"bytes,2,opt,name=audit_context,json=auditContext,proto3" json:"audit_context,omitempty"`
-	TwinEnabled  bool          `protobuf:"varint,3,opt,name=twin_enabled,json=twinEnabled,proto3" json:"twin_enabled,omitempty"`
+	ValidUntil   int64             `protobuf:"varint,1,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"` // unix timestamp in nanoseconds (https://golang.org/pkg/time/#Time.UnixNano) when command is considered as expired. 0 means forever.
+	AuditContext *AuditContext     `protobuf:"bytes,2,opt,name=audit_context,json=auditContext,proto3" json:"audit_context,omitempty"`
+	TwinEnabled  bool              `protobuf:"varint,3,opt,name=twin_enabled,json=twinEnabled,proto3" json:"twin_enabled,omitempty"`
+	Labels       map[string]string `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // labels of the device after the update.
+	DisplayName  string            `protobuf:"bytes,5,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`                                                            // user-defined name of the device after the update.
 }
 
 func (x *UpdateDeviceMetadataResponse) Reset() {
 	*x = UpdateDeviceMetadataResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_resource_aggregate_pb_commands_proto_msgTypes[28]
+		mi := &file_resource_aggregate_pb_commands_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2143,7 +2239,7 @@ func (x *UpdateDeviceMetadataResponse) String() string {
 func (*UpdateDeviceMetadataResponse) ProtoMessage() {}
 
 func (x *UpdateDeviceMetadataResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_aggregate_pb_commands_proto_msgTypes[28]
+	mi := &file_resource_aggregate_pb_commands_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2156,7 +2252,7 @@ func (x *UpdateDeviceMetadataResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateDeviceMetadataResponse.ProtoReflect.Descriptor instead.
 func (*UpdateDeviceMetadataResponse) Descriptor() ([]byte, []int) {
-	return file_resource_aggregate_pb_commands_proto_rawDescGZIP(), []int{28}
+	return file_resource_aggregate_pb_commands_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *UpdateDeviceMetadataResponse) GetValidUntil() int64 {
@@ -2180,6 +2276,20 @@ func (x *UpdateDeviceMetadataResponse) GetTwinEnabled() bool {
 	return false
 }
 
+func (x *UpdateDeviceMetadataResponse) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *UpdateDeviceMetadataResponse) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
 type ConfirmDeviceMetadataUpdateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2199,7 +2309,7 @@ type ConfirmDeviceMetadataUpdateRequest struct {
 func (x *ConfirmDeviceMetadataUpdateRequest) Reset() {
 	*x = ConfirmDeviceMetadataUpdateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_resource_aggregate_pb_commands_proto_msgTypes[29]
+		mi := &file_resource_aggregate_pb_commands_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2212,7 +2322,7 @@ func (x *ConfirmDeviceMetadataUpdateRequest) String() string {
 func (*ConfirmDeviceMetadataUpdateRequest) ProtoMessage() {}
 
 func (x *ConfirmDeviceMetadataUpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_aggregate_pb_commands_proto_msgTypes[29]
+	mi := &file_resource_aggregate_pb_commands_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2225,7 +2335,7 @@ func (x *ConfirmDeviceMetadataUpdateRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ConfirmDeviceMetadataUpdateRequest.ProtoReflect.Descriptor instead.
 func (*ConfirmDeviceMetadataUpdateRequest) Descriptor() ([]byte, []int) {
-	return file_resource_aggregate_pb_commands_proto_rawDescGZIP(), []int{29}
+	return file_resource_aggregate_pb_commands_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *ConfirmDeviceMetadataUpdateRequest) GetDeviceId() string {
@@ -2306,7 +2416,7 @@ type ConfirmDeviceMetadataUpdateResponse struct {
 func (x *ConfirmDeviceMetadataUpdateResponse) Reset() {
 	*x = ConfirmDeviceMetadataUpdateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_resource_aggregate_pb_commands_proto_msgTypes[30]
+		mi := &file_resource_aggregate_pb_commands_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2319,7 +2429,7 @@ func (x *ConfirmDeviceMetadataUpdateResponse) String() string {
 func (*ConfirmDeviceMetadataUpdateResponse) ProtoMessage() {}
 
 func (x *ConfirmDeviceMetadataUpdateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_aggregate_pb_commands_proto_msgTypes[30]
+	mi := &file_resource_aggregate_pb_commands_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2332,7 +2442,7 @@ func (x *ConfirmDeviceMetadataUpdateResponse) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use ConfirmDeviceMetadataUpdateResponse.ProtoReflect.Descriptor instead.
 func (*ConfirmDeviceMetadataUpdateResponse) Descriptor() ([]byte, []int) {
-	return file_resource_aggregate_pb_commands_proto_rawDescGZIP(), []int{30}
+	return file_resource_aggregate_pb_commands_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *ConfirmDeviceMetadataUpdateResponse) GetAuditContext() *AuditContext {
@@ -2355,7 +2465,7 @@ type CancelPendingCommandsRequest struct {
 func (x *CancelPendingCommandsRequest) Reset() {
 	*x = CancelPendingCommandsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_resource_aggregate_pb_commands_proto_msgTypes[31]
+		mi := &file_resource_aggregate_pb_commands_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2368,7 +2478,7 @@ func (x *CancelPendingCommandsRequest) String() string {
 func (*CancelPendingCommandsRequest) ProtoMessage() {}
 
 func (x *CancelPendingCommandsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_aggregate_pb_commands_proto_msgTypes[31]
+	mi := &file_resource_aggregate_pb_commands_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2381,7 +2491,7 @@ func (x *CancelPendingCommandsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelPendingCommandsRequest.ProtoReflect.Descriptor instead.
 func (*CancelPendingCommandsRequest) Descriptor() ([]byte, []int) {
-	return file_resource_aggregate_pb_commands_proto_rawDescGZIP(), []int{31}
+	return file_resource_aggregate_pb_commands_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *CancelPendingCommandsRequest) GetResourceId() *ResourceId {
@@ -2417,7 +2527,7 @@ type CancelPendingCommandsResponse struct {
 func (x *CancelPendingCommandsResponse) Reset() {
 	*x = CancelPendingCommandsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_resource_aggregate_pb_commands_proto_msgTypes[32]
+		mi := &file_resource_aggregate_pb_commands_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2430,7 +2540,7 @@ func (x *CancelPendingCommandsResponse) String() string {
 func (*CancelPendingCommandsResponse) ProtoMessage() {}
 
 func (x *CancelPendingCommandsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_aggregate_pb_commands_proto_msgTypes[32]
+	mi := &file_resource_aggregate_pb_commands_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2443,7 +2553,7 @@ func (x *CancelPendingCommandsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelPendingCommandsResponse.ProtoReflect.Descriptor instead.
 func (*CancelPendingCommandsResponse) Descriptor() ([]byte, []int) {
-	return file_resource_aggregate_pb_commands_proto_rawDescGZIP(), []int{32}
+	return file_resource_aggregate_pb_commands_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *CancelPendingCommandsResponse) GetCorrelationIds() []string {
@@ -2473,7 +2583,7 @@ type CancelPendingMetadataUpdatesRequest struct {
 func (x *CancelPendingMetadataUpdatesRequest) Reset() {
 	*x = CancelPendingMetadataUpdatesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_resource_aggregate_pb_commands_proto_msgTypes[33]
+		mi := &file_resource_aggregate_pb_commands_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2486,7 +2596,7 @@ func (x *CancelPendingMetadataUpdatesRequest) String() string {
 func (*CancelPendingMetadataUpdatesRequest) ProtoMessage() {}
 
 func (x *CancelPendingMetadataUpdatesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_aggregate_pb_commands_proto_msgTypes[33]
+	mi := &file_resource_aggregate_pb_commands_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2499,7 +2609,7 @@ func (x *CancelPendingMetadataUpdatesRequest) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use CancelPendingMetadataUpdatesRequest.ProtoReflect.Descriptor instead.
 func (*CancelPendingMetadataUpdatesRequest) Descriptor() ([]byte, []int) {
-	return file_resource_aggregate_pb_commands_proto_rawDescGZIP(), []int{33}
+	return file_resource_aggregate_pb_commands_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *CancelPendingMetadataUpdatesRequest) GetDeviceId() string {
@@ -2535,7 +2645,7 @@ type CancelPendingMetadataUpdatesResponse struct {
 func (x *CancelPendingMetadataUpdatesResponse) Reset() {
 	*x = CancelPendingMetadataUpdatesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_resource_aggregate_pb_commands_proto_msgTypes[34]
+		mi := &file_resource_aggregate_pb_commands_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2548,7 +2658,7 @@ func (x *CancelPendingMetadataUpdatesResponse) String() string {
 func (*CancelPendingMetadataUpdatesResponse) ProtoMessage() {}
 
 func (x *CancelPendingMetadataUpdatesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_aggregate_pb_commands_proto_msgTypes[34]
+	mi := &file_resource_aggregate_pb_commands_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2561,7 +2671,7 @@ func (x *CancelPendingMetadataUpdatesResponse) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use CancelPendingMetadataUpdatesResponse.ProtoReflect.Descriptor instead.
 func (*CancelPendingMetadataUpdatesResponse) Descriptor() ([]byte, []int) {
-	return file_resource_aggregate_pb_commands_proto_rawDescGZIP(), []int{34}
+	return file_resource_aggregate_pb_commands_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *CancelPendingMetadataUpdatesResponse) GetCorrelationIds() []string {
@@ -2589,7 +2699,7 @@ type DeleteDevicesRequest struct {
 func (x *DeleteDevicesRequest) Reset() {
 	*x = DeleteDevicesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_resource_aggregate_pb_commands_proto_msgTypes[35]
+		mi := &file_resource_aggregate_pb_commands_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2602,7 +2712,7 @@ func (x *DeleteDevicesRequest) String() string {
 func (*DeleteDevicesRequest) ProtoMessage() {}
 
 func (x *DeleteDevicesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_aggregate_pb_commands_proto_msgTypes[35]
+	mi := &file_resource_aggregate_pb_commands_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2615,7 +2725,7 @@ func (x *DeleteDevicesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteDevicesRequest.ProtoReflect.Descriptor instead.
 func (*DeleteDevicesRequest) Descriptor() ([]byte, []int) {
-	return file_resource_aggregate_pb_commands_proto_rawDescGZIP(), []int{35}
+	return file_resource_aggregate_pb_commands_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *DeleteDevicesRequest) GetDeviceIds() []string {
@@ -2637,7 +2747,7 @@ type DeleteDevicesResponse struct {
 func (x *DeleteDevicesResponse) Reset() {
 	*x = DeleteDevicesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_resource_aggregate_pb_commands_proto_msgTypes[36]
+		mi := &file_resource_aggregate_pb_commands_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2650,7 +2760,7 @@ func (x *DeleteDevicesResponse) String() string {
 func (*DeleteDevicesResponse) ProtoMessage() {}
 
 func (x *DeleteDevicesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_aggregate_pb_commands_proto_msgTypes[36]
+	mi := &file_resource_aggregate_pb_commands_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2663,7 +2773,7 @@ func (x *DeleteDevicesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteDevicesResponse.ProtoReflect.Descriptor instead.
 func (*DeleteDevicesResponse) Descriptor() ([]byte, []int) {
-	return file_resource_aggregate_pb_commands_proto_rawDescGZIP(), []int{36}
+	return file_resource_aggregate_pb_commands_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *DeleteDevicesResponse) GetDeviceIds() []string {
@@ -2694,7 +2804,7 @@ type ServiceHeartbeat struct {
 func (x *ServiceHeartbeat) Reset() {
 	*x = ServiceHeartbeat{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_resource_aggregate_pb_commands_proto_msgTypes[37]
+		mi := &file_resource_aggregate_pb_commands_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2707,7 +2817,7 @@ func (x *ServiceHeartbeat) String() string {
 func (*ServiceHeartbeat) ProtoMessage() {}
 
 func (x *ServiceHeartbeat) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_aggregate_pb_commands_proto_msgTypes[37]
+	mi := &file_resource_aggregate_pb_commands_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2720,7 +2830,7 @@ func (x *ServiceHeartbeat) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServiceHeartbeat.ProtoReflect.Descriptor instead.
 func (*ServiceHeartbeat) Descriptor() ([]byte, []int) {
-	return file_resource_aggregate_pb_commands_proto_rawDescGZIP(), []int{37}
+	return file_resource_aggregate_pb_commands_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *ServiceHeartbeat) GetServiceId() string {
@@ -2765,7 +2875,7 @@ type UpdateServiceMetadataRequest struct {
 func (x *UpdateServiceMetadataRequest) Reset() {
 	*x = UpdateServiceMetadataRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_resource_aggregate_pb_commands_proto_msgTypes[38]
+		mi := &file_resource_aggregate_pb_commands_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2778,7 +2888,7 @@ func (x *UpdateServiceMetadataRequest) String() string {
 func (*UpdateServiceMetadataRequest) ProtoMessage() {}
 
 func (x *UpdateServiceMetadataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_aggregate_pb_commands_proto_msgTypes[38]
+	mi := &file_resource_aggregate_pb_commands_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2791,7 +2901,7 @@ func (x *UpdateServiceMetadataRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateServiceMetadataRequest.ProtoReflect.Descriptor instead.
 func (*UpdateServiceMetadataRequest) Descriptor() ([]byte, []int) {
-	return file_resource_aggregate_pb_commands_proto_rawDescGZIP(), []int{38}
+	return file_resource_aggregate_pb_commands_proto_rawDescGZIP(), []int{39}
 }
 
 func (m *UpdateServiceMetadataRequest) GetUpdate() isUpdateServiceMetadataRequest_Update {
@@ -2830,7 +2940,7 @@ type UpdateServiceMetadataResponse struct {
 func (x *UpdateServiceMetadataResponse) Reset() {
 	*x = UpdateServiceMetadataResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_resource_aggregate_pb_commands_proto_msgTypes[39]
+		mi := &file_resource_aggregate_pb_commands_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2843,7 +2953,7 @@ func (x *UpdateServiceMetadataResponse) String() string {
 func (*UpdateServiceMetadataResponse) ProtoMessage() {}
 
 func (x *UpdateServiceMetadataResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_aggregate_pb_commands_proto_msgTypes[39]
+	mi := &file_resource_aggregate_pb_commands_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2856,7 +2966,7 @@ func (x *UpdateServiceMetadataResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateServiceMetadataResponse.ProtoReflect.Descriptor instead.
 func (*UpdateServiceMetadataResponse) Descriptor() ([]byte, []int) {
-	return file_resource_aggregate_pb_commands_proto_rawDescGZIP(), []int{39}
+	return file_resource_aggregate_pb_commands_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *UpdateServiceMetadataResponse) GetHeartbeatValidUntil() int64 {
@@ -3201,7 +3311,7 @@ var file_resource_aggregate_pb_commands_proto_rawDesc = []byte{
 	0x22, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67,
 	0x61, 0x74, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x74,
 	0x65, 0x78, 0x74, 0x52, 0x0c, 0x61, 0x75, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78,
-	0x74, 0x22, 0xa0, 0x03, 0x0a, 0x0a, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x74, 0x22, 0xc5, 0x03, 0x0a, 0x0a, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
 	0x12, 0x3f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
 	0x32, 0x27, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x61, 0x67, 0x67, 0x72, 0x65,
 	0x67, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
@@ -3220,192 +3330,225 @@ var file_resource_aggregate_pb_commands_proto_rawDesc = []byte{
 	0x03, 0x28, 0x09, 0x52, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69,
 	0x6e, 0x74, 0x73, 0x22, 0x21, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a,
 	0x07, 0x4f, 0x46, 0x46, 0x4c, 0x49, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4f, 0x4e,
-	0x4c, 0x49, 0x4e, 0x45, 0x10, 0x01, 0x22, 0x52, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x4c, 0x49, 0x4e, 0x45, 0x10, 0x01, 0x22, 0x77, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63,
 	0x6f, 0x6c, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12,
 	0x08, 0x0a, 0x04, 0x43, 0x4f, 0x41, 0x50, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x43, 0x4f, 0x41,
 	0x50, 0x53, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x4f, 0x41, 0x50, 0x5f, 0x54, 0x43, 0x50,
 	0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x41, 0x50, 0x53, 0x5f, 0x54, 0x43, 0x50, 0x10,
-	0x04, 0x12, 0x07, 0x0a, 0x03, 0x43, 0x32, 0x43, 0x10, 0x05, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03,
-	0x52, 0x12, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x75,
-	0x6e, 0x74, 0x69, 0x6c, 0x22, 0xe7, 0x02, 0x0a, 0x13, 0x54, 0x77, 0x69, 0x6e, 0x53, 0x79, 0x6e,
-	0x63, 0x68, 0x72, 0x6f, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x45, 0x0a, 0x05,
-	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2f, 0x2e, 0x72, 0x65,
+	0x04, 0x12, 0x07, 0x0a, 0x03, 0x43, 0x32, 0x43, 0x10, 0x05, 0x12, 0x08, 0x0a, 0x04, 0x4d, 0x51,
+	0x54, 0x54, 0x10, 0x06, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x4f, 0x41, 0x50, 0x5f, 0x57, 0x53, 0x10,
+	0x07, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x4f, 0x41, 0x50, 0x53, 0x5f, 0x57, 0x53, 0x10, 0x08, 0x4a,
+	0x04, 0x08, 0x02, 0x10, 0x03, 0x52, 0x12, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x22, 0xe7, 0x02, 0x0a, 0x13, 0x54, 0x77,
+	0x69, 0x6e, 0x53, 0x79, 0x6e, 0x63, 0x68, 0x72, 0x6f, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x45, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x2f, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x61, 0x67, 0x67, 0x72, 0x65,
+	0x67, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x77, 0x69, 0x6e, 0x53, 0x79, 0x6e, 0x63,
+	0x68, 0x72, 0x6f, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x79, 0x6e, 0x63,
+	0x69, 0x6e, 0x67, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x79,
+	0x6e, 0x63, 0x69, 0x6e, 0x67, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x0a, 0x69, 0x6e, 0x5f, 0x73, 0x79,
+	0x6e, 0x63, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x69, 0x6e, 0x53,
+	0x79, 0x6e, 0x63, 0x41, 0x74, 0x12, 0x50, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x38, 0x0a, 0x18, 0x66, 0x6f, 0x72, 0x63, 0x65,
+	0x5f, 0x73, 0x79, 0x6e, 0x63, 0x68, 0x72, 0x6f, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x66, 0x6f, 0x72, 0x63, 0x65,
+	0x53, 0x79, 0x6e, 0x63, 0x68, 0x72, 0x6f, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41,
+	0x74, 0x22, 0x40, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x4f, 0x55,
+	0x54, 0x5f, 0x4f, 0x46, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x44,
+	0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x59, 0x4e,
+	0x43, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x49, 0x4e, 0x5f, 0x53, 0x59, 0x4e,
+	0x43, 0x10, 0x03, 0x22, 0xf7, 0x01, 0x0a, 0x0c, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x12, 0x3d, 0x0a, 0x03, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2b, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x61, 0x67, 0x67, 0x72,
+	0x65, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x03,
+	0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x73,
+	0x65, 0x74, 0x5f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x65, 0x74, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61,
+	0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x5f,
+	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x11, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61,
+	0x79, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x36, 0x0a, 0x08, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xac, 0x04,
+	0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f,
+	0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x12, 0x42, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5e, 0x0a, 0x14, 0x74, 0x77, 0x69, 0x6e, 0x5f, 0x73, 0x79,
+	0x6e, 0x63, 0x68, 0x72, 0x6f, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x61, 0x67,
+	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x77, 0x69, 0x6e, 0x53,
+	0x79, 0x6e, 0x63, 0x68, 0x72, 0x6f, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00,
+	0x52, 0x13, 0x74, 0x77, 0x69, 0x6e, 0x53, 0x79, 0x6e, 0x63, 0x68, 0x72, 0x6f, 0x6e, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0c, 0x74, 0x77, 0x69, 0x6e, 0x5f, 0x65, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x0b, 0x74,
+	0x77, 0x69, 0x6e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x3e, 0x0a, 0x1a, 0x74, 0x77,
+	0x69, 0x6e, 0x5f, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x68, 0x72, 0x6f,
+	0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00,
+	0x52, 0x18, 0x74, 0x77, 0x69, 0x6e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x68,
+	0x72, 0x6f, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x06, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x70,
+	0x62, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x00,
+	0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x74, 0x6f, 0x5f, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x74, 0x69, 0x6d, 0x65, 0x54, 0x6f, 0x4c, 0x69, 0x76, 0x65, 0x12, 0x50, 0x0a, 0x10, 0x63, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x61,
+	0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x0f, 0x63, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x08, 0x0a, 0x06,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x22, 0xe1, 0x02, 0x0a,
+	0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x47,
+	0x0a, 0x0d, 0x61, 0x75, 0x64, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x75, 0x64,
+	0x69, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x0c, 0x61, 0x75, 0x64, 0x69, 0x74,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x77, 0x69, 0x6e, 0x5f,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x74,
+	0x77, 0x69, 0x6e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x56, 0x0a, 0x06, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x70,
+	0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
+	0x79, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0xe6, 0x02, 0x0a, 0x22, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x44, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f,
+	0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x72, 0x65,
 	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x2e,
-	0x70, 0x62, 0x2e, 0x54, 0x77, 0x69, 0x6e, 0x53, 0x79, 0x6e, 0x63, 0x68, 0x72, 0x6f, 0x6e, 0x69,
-	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74,
-	0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x79, 0x6e, 0x63, 0x69, 0x6e, 0x67, 0x5f, 0x61,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x79, 0x6e, 0x63, 0x69, 0x6e, 0x67,
-	0x41, 0x74, 0x12, 0x1c, 0x0a, 0x0a, 0x69, 0x6e, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x61, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x69, 0x6e, 0x53, 0x79, 0x6e, 0x63, 0x41, 0x74,
+	0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x23, 0x0a, 0x0c, 0x74, 0x77, 0x69, 0x6e, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x77, 0x69, 0x6e, 0x45,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x3e, 0x0a, 0x1a, 0x74, 0x77, 0x69, 0x6e, 0x5f, 0x66,
+	0x6f, 0x72, 0x63, 0x65, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x68, 0x72, 0x6f, 0x6e, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x18, 0x74, 0x77,
+	0x69, 0x6e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x68, 0x72, 0x6f, 0x6e, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x50, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x61, 0x67, 0x67, 0x72, 0x65,
+	0x67, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x09, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x72, 0x6d, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x22, 0x6e, 0x0a, 0x23, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x72, 0x6d, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x47, 0x0a, 0x0d, 0x61, 0x75, 0x64, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x41,
+	0x75, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x0c, 0x61, 0x75, 0x64,
+	0x69, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0xe7, 0x01, 0x0a, 0x1c, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x0b, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49,
+	0x64, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x32, 0x0a,
+	0x15, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x5f,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x63, 0x6f,
+	0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x12, 0x50, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x2e,
+	0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x22, 0x91, 0x01, 0x0a, 0x1d, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e,
+	0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x47,
+	0x0a, 0x0d, 0x61, 0x75, 0x64, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18,
+	0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x75, 0x64,
+	0x69, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x0c, 0x61, 0x75, 0x64, 0x69, 0x74,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0xc8, 0x01, 0x0a, 0x23, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x15,
+	0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x5f, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x63, 0x6f, 0x72,
+	0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
 	0x12, 0x50, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x6d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x72, 0x65, 0x73,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x72, 0x65, 0x73,
 	0x6f, 0x75, 0x72, 0x63, 0x65, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x70,
 	0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
 	0x61, 0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x12, 0x38, 0x0a, 0x18, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x5f, 0x73, 0x79, 0x6e, 0x63,
-	0x68, 0x72, 0x6f, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x74, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x68,
-	0x72, 0x6f, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x22, 0x40, 0x0a, 0x05,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x4f, 0x55, 0x54, 0x5f, 0x4f, 0x46, 0x5f,
-	0x53, 0x59, 0x4e, 0x43, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c,
-	0x45, 0x44, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x59, 0x4e, 0x43, 0x49, 0x4e, 0x47, 0x10,
-	0x02, 0x12, 0x0b, 0x0a, 0x07, 0x49, 0x4e, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0x03, 0x22, 0xee,
-	0x03, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
-	0x0a, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63,
-	0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x49, 0x64, 0x12, 0x42, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f,
-	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x6e,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5e, 0x0a, 0x14, 0x74, 0x77, 0x69, 0x6e, 0x5f, 0x73,
-	0x79, 0x6e, 0x63, 0x68, 0x72, 0x6f, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x61,
-	0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x77, 0x69, 0x6e,
-	0x53, 0x79, 0x6e, 0x63, 0x68, 0x72, 0x6f, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48,
-	0x00, 0x52, 0x13, 0x74, 0x77, 0x69, 0x6e, 0x53, 0x79, 0x6e, 0x63, 0x68, 0x72, 0x6f, 0x6e, 0x69,
-	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0c, 0x74, 0x77, 0x69, 0x6e, 0x5f, 0x65,
-	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x0b,
-	0x74, 0x77, 0x69, 0x6e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x3e, 0x0a, 0x1a, 0x74,
-	0x77, 0x69, 0x6e, 0x5f, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x68, 0x72,
-	0x6f, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x48,
-	0x00, 0x52, 0x18, 0x74, 0x77, 0x69, 0x6e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x53, 0x79, 0x6e, 0x63,
-	0x68, 0x72, 0x6f, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0c, 0x74,
-	0x69, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x54, 0x6f, 0x4c, 0x69, 0x76, 0x65, 0x12, 0x50, 0x0a,
-	0x10, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x43,
-	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x0f,
-	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42,
-	0x08, 0x0a, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x22,
-	0xab, 0x01, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x55, 0x6e, 0x74, 0x69,
-	0x6c, 0x12, 0x47, 0x0a, 0x0d, 0x61, 0x75, 0x64, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65,
-	0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x62, 0x2e,
-	0x41, 0x75, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x0c, 0x61, 0x75,
-	0x64, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x77,
-	0x69, 0x6e, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0b, 0x74, 0x77, 0x69, 0x6e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0xe6, 0x02,
-	0x0a, 0x22, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49,
-	0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65,
-	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x62, 0x2e,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23,
-	0x0a, 0x0c, 0x74, 0x77, 0x69, 0x6e, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x77, 0x69, 0x6e, 0x45, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x64, 0x12, 0x3e, 0x0a, 0x1a, 0x74, 0x77, 0x69, 0x6e, 0x5f, 0x66, 0x6f, 0x72, 0x63,
-	0x65, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x68, 0x72, 0x6f, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x18, 0x74, 0x77, 0x69, 0x6e, 0x46,
-	0x6f, 0x72, 0x63, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x68, 0x72, 0x6f, 0x6e, 0x69, 0x7a, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x50, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x6d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e,
-	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
-	0x65, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x09, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
-	0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x22, 0x6e, 0x0a, 0x23, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72,
-	0x6d, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a,
-	0x0d, 0x61, 0x75, 0x64, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x61,
-	0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x75, 0x64, 0x69,
-	0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x0c, 0x61, 0x75, 0x64, 0x69, 0x74, 0x43,
-	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0xe7, 0x01, 0x0a, 0x1c, 0x43, 0x61, 0x6e, 0x63, 0x65,
-	0x6c, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x72,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65,
-	0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x52, 0x0a,
-	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x15, 0x63, 0x6f,
-	0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x5f, 0x66, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x63, 0x6f, 0x72, 0x72, 0x65,
-	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x50,
-	0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x62, 0x2e,
-	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52,
-	0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x22, 0x91, 0x01, 0x0a, 0x1d, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x65, 0x6e, 0x64, 0x69,
-	0x6e, 0x67, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x72,
-	0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x47, 0x0a, 0x0d, 0x61,
-	0x75, 0x64, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x64, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x61, 0x67, 0x67,
-	0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x43,
-	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x0c, 0x61, 0x75, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6e,
-	0x74, 0x65, 0x78, 0x74, 0x22, 0xc8, 0x01, 0x0a, 0x23, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50,
-	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
-	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x15, 0x63, 0x6f, 0x72,
-	0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x5f, 0x66, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x50, 0x0a,
-	0x10, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x43,
-	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x0f,
-	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22,
-	0x98, 0x01, 0x0a, 0x24, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e,
-	0x67, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x72, 0x72,
-	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x0e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
-	0x73, 0x12, 0x47, 0x0a, 0x0d, 0x61, 0x75, 0x64, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65,
-	0x78, 0x74, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x74, 0x61, 0x22, 0x98, 0x01, 0x0a, 0x24, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x63,
+	0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x73, 0x12, 0x47, 0x0a, 0x0d, 0x61, 0x75, 0x64, 0x69, 0x74, 0x5f, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x2e,
+	0x70, 0x62, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52,
+	0x0c, 0x61, 0x75, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0x35, 0x0a,
+	0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f,
+	0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x49, 0x64, 0x73, 0x22, 0x7f, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x73, 0x12, 0x47, 0x0a, 0x0d,
+	0x61, 0x75, 0x64, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x64, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x61, 0x67,
+	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x0c, 0x61, 0x75, 0x64, 0x69, 0x74, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0x8d, 0x01, 0x0a, 0x10, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x74, 0x69, 0x6d,
+	0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x74, 0x69, 0x6d, 0x65, 0x54, 0x6f, 0x4c, 0x69, 0x76, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x22, 0x70, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x09, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65,
+	0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75,
 	0x72, 0x63, 0x65, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x62, 0x2e,
-	0x41, 0x75, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x0c, 0x61, 0x75,
-	0x64, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0x35, 0x0a, 0x14, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64,
-	0x73, 0x22, 0x7f, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63,
-	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65,
-	0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09,
-	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x73, 0x12, 0x47, 0x0a, 0x0d, 0x61, 0x75, 0x64,
-	0x69, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x22, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x61, 0x67, 0x67, 0x72, 0x65,
-	0x67, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6e,
-	0x74, 0x65, 0x78, 0x74, 0x52, 0x0c, 0x61, 0x75, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65,
-	0x78, 0x74, 0x22, 0x8d, 0x01, 0x0a, 0x10, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x48, 0x65,
-	0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x74,
-	0x6f, 0x5f, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x69,
-	0x6d, 0x65, 0x54, 0x6f, 0x4c, 0x69, 0x76, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x65, 0x72, 0x22, 0x70, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x46, 0x0a, 0x09, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x48, 0x00, 0x52,
-	0x09, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x42, 0x08, 0x0a, 0x06, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x22, 0x53, 0x0a, 0x1d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65,
-	0x61, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x56,
-	0x61, 0x6c, 0x69, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x42, 0x41, 0x5a, 0x3f, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x6c, 0x67, 0x64, 0x2d, 0x64, 0x65, 0x76,
-	0x2f, 0x68, 0x75, 0x62, 0x2f, 0x76, 0x32, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x2d, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
-	0x6e, 0x64, 0x73, 0x3b, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74,
+	0x48, 0x00, 0x52, 0x09, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x42, 0x08, 0x0a,
+	0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0x53, 0x0a, 0x1d, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x68, 0x65, 0x61, 0x72,
+	0x74, 0x62, 0x65, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x75, 0x6e, 0x74, 0x69,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65,
+	0x61, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x42, 0x41, 0x5a, 0x3f,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x6c, 0x67, 0x64, 0x2d,
+	0x64, 0x65, 0x76, 0x2f, 0x68, 0x75, 0x62, 0x2f, 0x76, 0x32, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x2d, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x2f, 0x63, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x3b, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3421,7 +3564,7 @@ func file_resource_aggregate_pb_commands_proto_rawDescGZIP() []byte {
 }
 
 var file_resource_aggregate_pb_commands_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_resource_aggregate_pb_commands_proto_msgTypes = make([]protoimpl.MessageInfo, 40)
+var file_resource_aggregate_pb_commands_proto_msgTypes = make([]protoimpl.MessageInfo, 43)
 var file_resource_aggregate_pb_commands_proto_goTypes = []any{
 	(Connection_Status)(0),                       // 0: resourceaggregate.pb.Connection.Status
 	(Connection_Protocol)(0),                     // 1: resourceaggregate.pb.Connection.Protocol
@@ -3453,96 +3596,102 @@ var file_resource_aggregate_pb_commands_proto_goTypes = []any{
 	(*ConfirmResourceCreateResponse)(nil),        // 27: resourceaggregate.pb.ConfirmResourceCreateResponse
 	(*Connection)(nil),                           // 28: resourceaggregate.pb.Connection
 	(*TwinSynchronization)(nil),                  // 29: resourceaggregate.pb.TwinSynchronization
-	(*UpdateDeviceMetadataRequest)(nil),          // 30: resourceaggregate.pb.UpdateDeviceMetadataRequest
-	(*UpdateDeviceMetadataResponse)(nil),         // 31: resourceaggregate.pb.UpdateDeviceMetadataResponse
-	(*ConfirmDeviceMetadataUpdateRequest)(nil),   // 32: resourceaggregate.pb.ConfirmDeviceMetadataUpdateRequest
-	(*ConfirmDeviceMetadataUpdateResponse)(nil),  // 33: resourceaggregate.pb.ConfirmDeviceMetadataUpdateResponse
-	(*CancelPendingCommandsRequest)(nil),         // 34: resourceaggregate.pb.CancelPendingCommandsRequest
-	(*CancelPendingCommandsResponse)(nil),        // 35: resourceaggregate.pb.CancelPendingCommandsResponse
-	(*CancelPendingMetadataUpdatesRequest)(nil),  // 36: resourceaggregate.pb.CancelPendingMetadataUpdatesRequest
-	(*CancelPendingMetadataUpdatesResponse)(nil), // 37: resourceaggregate.pb.CancelPendingMetadataUpdatesResponse
-	(*DeleteDevicesRequest)(nil),                 // 38: resourceaggregate.pb.DeleteDevicesRequest
-	(*DeleteDevicesResponse)(nil),                // 39: resourceaggregate.pb.DeleteDevicesResponse
-	(*ServiceHeartbeat)(nil),                     // 40: resourceaggregate.pb.ServiceHeartbeat
-	(*UpdateServiceMetadataRequest)(nil),         // 41: resourceaggregate.pb.UpdateServiceMetadataRequest
-	(*UpdateServiceMetadataResponse)(nil),        // 42: resourceaggregate.pb.UpdateServiceMetadataResponse
-	(*Resource)(nil),                             // 43: resourceaggregate.pb.Resource
-	(*AuditContext)(nil),                         // 44: resourceaggregate.pb.AuditContext
-	(*ResourceId)(nil),                           // 45: resourceaggregate.pb.ResourceId
-	(*Content)(nil),                              // 46: resourceaggregate.pb.Content
-	(Status)(0),                                  // 47: resourceaggregate.pb.Status
+	(*LabelsUpdate)(nil),                         // 30: resourceaggregate.pb.LabelsUpdate
+	(*UpdateDeviceMetadataRequest)(nil),          // 31: resourceaggregate.pb.UpdateDeviceMetadataRequest
+	(*UpdateDeviceMetadataResponse)(nil),         // 32: resourceaggregate.pb.UpdateDeviceMetadataResponse
+	(*ConfirmDeviceMetadataUpdateRequest)(nil),   // 33: resourceaggregate.pb.ConfirmDeviceMetadataUpdateRequest
+	(*ConfirmDeviceMetadataUpdateResponse)(nil),  // 34: resourceaggregate.pb.ConfirmDeviceMetadataUpdateResponse
+	(*CancelPendingCommandsRequest)(nil),         // 35: resourceaggregate.pb.CancelPendingCommandsRequest
+	(*CancelPendingCommandsResponse)(nil),        // 36: resourceaggregate.pb.CancelPendingCommandsResponse
+	(*CancelPendingMetadataUpdatesRequest)(nil),  // 37: resourceaggregate.pb.CancelPendingMetadataUpdatesRequest
+	(*CancelPendingMetadataUpdatesResponse)(nil), // 38: resourceaggregate.pb.CancelPendingMetadataUpdatesResponse
+	(*DeleteDevicesRequest)(nil),                 // 39: resourceaggregate.pb.DeleteDevicesRequest
+	(*DeleteDevicesResponse)(nil),                // 40: resourceaggregate.pb.DeleteDevicesResponse
+	(*ServiceHeartbeat)(nil),                     // 41: resourceaggregate.pb.ServiceHeartbeat
+	(*UpdateServiceMetadataRequest)(nil),         // 42: resourceaggregate.pb.UpdateServiceMetadataRequest
+	(*UpdateServiceMetadataResponse)(nil),        // 43: resourceaggregate.pb.UpdateServiceMetadataResponse
+	nil,                                          // 44: resourceaggregate.pb.LabelsUpdate.SetEntry
+	nil,                                          // 45: resourceaggregate.pb.UpdateDeviceMetadataResponse.LabelsEntry
+	(*Resource)(nil),                             // 46: resourceaggregate.pb.Resource
+	(*AuditContext)(nil),                         // 47: resourceaggregate.pb.AuditContext
+	(*ResourceId)(nil),                           // 48: resourceaggregate.pb.ResourceId
+	(*Content)(nil),                              // 49: resourceaggregate.pb.Content
+	(Status)(0),                                  // 50: resourceaggregate.pb.Status
 }
 var file_resource_aggregate_pb_commands_proto_depIdxs = []int32{
-	43, // 0: resourceaggregate.pb.PublishResourceLinksRequest.resources:type_name -> resourceaggregate.pb.Resource
+	46, // 0: resourceaggregate.pb.PublishResourceLinksRequest.resources:type_name -> resourceaggregate.pb.Resource
 	3,  // 1: resourceaggregate.pb.PublishResourceLinksRequest.command_metadata:type_name -> resourceaggregate.pb.CommandMetadata
-	43, // 2: resourceaggregate.pb.PublishResourceLinksResponse.published_resources:type_name -> resourceaggregate.pb.Resource
-	44, // 3: resourceaggregate.pb.PublishResourceLinksResponse.audit_context:type_name -> resourceaggregate.pb.AuditContext
+	46, // 2: resourceaggregate.pb.PublishResourceLinksResponse.published_resources:type_name -> resourceaggregate.pb.Resource
+	47, // 3: resourceaggregate.pb.PublishResourceLinksResponse.audit_context:type_name -> resourceaggregate.pb.AuditContext
 	3,  // 4: resourceaggregate.pb.UnpublishResourceLinksRequest.command_metadata:type_name -> resourceaggregate.pb.CommandMetadata
-	44, // 5: resourceaggregate.pb.UnpublishResourceLinksResponse.audit_context:type_name -> resourceaggregate.pb.AuditContext
-	45, // 6: resourceaggregate.pb.NotifyResourceChangedRequest.resource_id:type_name -> resourceaggregate.pb.ResourceId
-	46, // 7: resourceaggregate.pb.NotifyResourceChangedRequest.content:type_name -> resourceaggregate.pb.Content
+	47, // 5: resourceaggregate.pb.UnpublishResourceLinksResponse.audit_context:type_name -> resourceaggregate.pb.AuditContext
+	48, // 6: resourceaggregate.pb.NotifyResourceChangedRequest.resource_id:type_name -> resourceaggregate.pb.ResourceId
+	49, // 7: resourceaggregate.pb.NotifyResourceChangedRequest.content:type_name -> resourceaggregate.pb.Content
 	3,  // 8: resourceaggregate.pb.NotifyResourceChangedRequest.command_metadata:type_name -> resourceaggregate.pb.CommandMetadata
-	47, // 9: resourceaggregate.pb.NotifyResourceChangedRequest.status:type_name -> resourceaggregate.pb.Status
-	44, // 10: resourceaggregate.pb.NotifyResourceChangedResponse.audit_context:type_name -> resourceaggregate.pb.AuditContext
+	50, // 9: resourceaggregate.pb.NotifyResourceChangedRequest.status:type_name -> resourceaggregate.pb.Status
+	47, // 10: resourceaggregate.pb.NotifyResourceChangedResponse.audit_context:type_name -> resourceaggregate.pb.AuditContext
 	8,  // 11: resourceaggregate.pb.BatchNotifyResourceChangedRequest.batch:type_name -> resourceaggregate.pb.NotifyResourceChangedRequest
-	44, // 12: resourceaggregate.pb.BatchNotifyResourceChangedResponse.audit_context:type_name -> resourceaggregate.pb.AuditContext
-	45, // 13: resourceaggregate.pb.UpdateResourceRequest.resource_id:type_name -> resourceaggregate.pb.ResourceId
-	46, // 14: resourceaggregate.pb.UpdateResourceRequest.content:type_name -> resourceaggregate.pb.Content
+	47, // 12: resourceaggregate.pb.BatchNotifyResourceChangedResponse.audit_context:type_name -> resourceaggregate.pb.AuditContext
+	48, // 13: resourceaggregate.pb.UpdateResourceRequest.resource_id:type_name -> resourceaggregate.pb.ResourceId
+	49, // 14: resourceaggregate.pb.UpdateResourceRequest.content:type_name -> resourceaggregate.pb.Content
 	3,  // 15: resourceaggregate.pb.UpdateResourceRequest.command_metadata:type_name -> resourceaggregate.pb.CommandMetadata
-	44, // 16: resourceaggregate.pb.UpdateResourceResponse.audit_context:type_name -> resourceaggregate.pb.AuditContext
-	45, // 17: resourceaggregate.pb.ConfirmResourceUpdateRequest.resource_id:type_name -> resourceaggregate.pb.ResourceId
-	47, // 18: resourceaggregate.pb.ConfirmResourceUpdateRequest.status:type_name -> resourceaggregate.pb.Status
-	46, // 19: resourceaggregate.pb.ConfirmResourceUpdateRequest.content:type_name -> resourceaggregate.pb.Content
+	47, // 16: resourceaggregate.pb.UpdateResourceResponse.audit_context:type_name -> resourceaggregate.pb.AuditContext
+	48, // 17: resourceaggregate.pb.ConfirmResourceUpdateRequest.resource_id:type_name -> resourceaggregate.pb.ResourceId
+	50, // 18: resourceaggregate.pb.ConfirmResourceUpdateRequest.status:type_name -> resourceaggregate.pb.Status
+	49, // 19: resourceaggregate.pb.ConfirmResourceUpdateRequest.content:type_name -> resourceaggregate.pb.Content
 	3,  // 20: resourceaggregate.pb.ConfirmResourceUpdateRequest.command_metadata:type_name -> resourceaggregate.pb.CommandMetadata
-	44, // 21: resourceaggregate.pb.ConfirmResourceUpdateResponse.audit_context:type_name -> resourceaggregate.pb.AuditContext
-	45, // 22: resourceaggregate.pb.RetrieveResourceRequest.resource_id:type_name -> resourceaggregate.pb.ResourceId
+	47, // 21: resourceaggregate.pb.ConfirmResourceUpdateResponse.audit_context:type_name -> resourceaggregate.pb.AuditContext
+	48, // 22: resourceaggregate.pb.RetrieveResourceRequest.resource_id:type_name -> resourceaggregate.pb.ResourceId
 	3,  // 23: resourceaggregate.pb.RetrieveResourceRequest.command_metadata:type_name -> resourceaggregate.pb.CommandMetadata
-	44, // 24: resourceaggregate.pb.RetrieveResourceResponse.audit_context:type_name -> resourceaggregate.pb.AuditContext
-	45, // 25: resourceaggregate.pb.ConfirmResourceRetrieveRequest.resource_id:type_name -> resourceaggregate.pb.ResourceId
-	47, // 26: resourceaggregate.pb.ConfirmResourceRetrieveRequest.status:type_name -> resourceaggregate.pb.Status
-	46, // 27: resourceaggregate.pb.ConfirmResourceRetrieveRequest.content:type_name -> resourceaggregate.pb.Content
+	47, // 24: resourceaggregate.pb.RetrieveResourceResponse.audit_context:type_name -> resourceaggregate.pb.AuditContext
+	48, // 25: resourceaggregate.pb.ConfirmResourceRetrieveRequest.resource_id:type_name -> resourceaggregate.pb.ResourceId
+	50, // 26: resourceaggregate.pb.ConfirmResourceRetrieveRequest.status:type_name -> resourceaggregate.pb.Status
+	49, // 27: resourceaggregate.pb.ConfirmResourceRetrieveRequest.content:type_name -> resourceaggregate.pb.Content
 	3,  // 28: resourceaggregate.pb.ConfirmResourceRetrieveRequest.command_metadata:type_name -> resourceaggregate.pb.CommandMetadata
-	44, // 29: resourceaggregate.pb.ConfirmResourceRetrieveResponse.audit_context:type_name -> resourceaggregate.pb.AuditContext
-	45, // 30: resourceaggregate.pb.DeleteResourceRequest.resource_id:type_name -> resourceaggregate.pb.ResourceId
+	47, // 29: resourceaggregate.pb.ConfirmResourceRetrieveResponse.audit_context:type_name -> resourceaggregate.pb.AuditContext
+	48, // 30: resourceaggregate.pb.DeleteResourceRequest.resource_id:type_name -> resourceaggregate.pb.ResourceId
 	3,  // 31: resourceaggregate.pb.DeleteResourceRequest.command_metadata:type_name -> resourceaggregate.pb.CommandMetadata
-	44, // 32: resourceaggregate.pb.DeleteResourceResponse.audit_context:type_name -> resourceaggregate.pb.AuditContext
-	45, // 33: resourceaggregate.pb.ConfirmResourceDeleteRequest.resource_id:type_name -> resourceaggregate.pb.ResourceId
-	47, // 34: resourceaggregate.pb.ConfirmResourceDeleteRequest.status:type_name -> resourceaggregate.pb.Status
-	46, // 35: resourceaggregate.pb.ConfirmResourceDeleteRequest.content:type_name -> resourceaggregate.pb.Content
+	47, // 32: resourceaggregate.pb.DeleteResourceResponse.audit_context:type_name -> resourceaggregate.pb.AuditContext
+	48, // 33: resourceaggregate.pb.ConfirmResourceDeleteRequest.resource_id:type_name -> resourceaggregate.pb.ResourceId
+	50, // 34: resourceaggregate.pb.ConfirmResourceDeleteRequest.status:type_name -> resourceaggregate.pb.Status
+	49, // 35: resourceaggregate.pb.ConfirmResourceDeleteRequest.content:type_name -> resourceaggregate.pb.Content
 	3,  // 36: resourceaggregate.pb.ConfirmResourceDeleteRequest.command_metadata:type_name -> resourceaggregate.pb.CommandMetadata
-	44, // 37: resourceaggregate.pb.ConfirmResourceDeleteResponse.audit_context:type_name -> resourceaggregate.pb.AuditContext
-	45, // 38: resourceaggregate.pb.CreateResourceRequest.resource_id:type_name -> resourceaggregate.pb.ResourceId
-	46, // 39: resourceaggregate.pb.CreateResourceRequest.content:type_name -> resourceaggregate.pb.Content
+	47, // 37: resourceaggregate.pb.ConfirmResourceDeleteResponse.audit_context:type_name -> resourceaggregate.pb.AuditContext
+	48, // 38: resourceaggregate.pb.CreateResourceRequest.resource_id:type_name -> resourceaggregate.pb.ResourceId
+	49, // 39: resourceaggregate.pb.CreateResourceRequest.content:type_name -> resourceaggregate.pb.Content
 	3,  // 40: resourceaggregate.pb.CreateResourceRequest.command_metadata:type_name -> resourceaggregate.pb.CommandMetadata
-	44, // 41: resourceaggregate.pb.CreateResourceResponse.audit_context:type_name -> resourceaggregate.pb.AuditContext
-	45, // 42: resourceaggregate.pb.ConfirmResourceCreateRequest.resource_id:type_name -> resourceaggregate.pb.ResourceId
-	47, // 43: resourceaggregate.pb.ConfirmResourceCreateRequest.status:type_name -> resourceaggregate.pb.Status
-	46, // 44: resourceaggregate.pb.ConfirmResourceCreateRequest.content:type_name -> resourceaggregate.pb.Content
+	47, // 41: resourceaggregate.pb.CreateResourceResponse.audit_context:type_name -> resourceaggregate.pb.AuditContext
+	48, // 42: resourceaggregate.pb.ConfirmResourceCreateRequest.resource_id:type_name -> resourceaggregate.pb.ResourceId
+	50, // 43: resourceaggregate.pb.ConfirmResourceCreateRequest.status:type_name -> resourceaggregate.pb.Status
+	49, // 44: resourceaggregate.pb.ConfirmResourceCreateRequest.content:type_name -> resourceaggregate.pb.Content
 	3,  // 45: resourceaggregate.pb.ConfirmResourceCreateRequest.command_metadata:type_name -> resourceaggregate.pb.CommandMetadata
-	44, // 46: resourceaggregate.pb.ConfirmResourceCreateResponse.audit_context:type_name -> resourceaggregate.pb.AuditContext
+	47, // 46: resourceaggregate.pb.ConfirmResourceCreateResponse.audit_context:type_name -> resourceaggregate.pb.AuditContext
 	0,  // 47: resourceaggregate.pb.Connection.status:type_name -> resourceaggregate.pb.Connection.Status
 	1,  // 48: resourceaggregate.pb.Connection.protocol:type_name -> resourceaggregate.pb.Connection.Protocol
 	2,  // 49: resourceaggregate.pb.TwinSynchronization.state:type_name -> resourceaggregate.pb.TwinSynchronization.State
 	3,  // 50: resourceaggregate.pb.TwinSynchronization.command_metadata:type_name -> resourceaggregate.pb.CommandMetadata
-	28, // 51: resourceaggregate.pb.UpdateDeviceMetadataRequest.connection:type_name -> resourceaggregate.pb.Connection
-	29, // 52: resourceaggregate.pb.UpdateDeviceMetadataRequest.twin_synchronization:type_name -> resourceaggregate.pb.TwinSynchronization
-	3,  // 53: resourceaggregate.pb.UpdateDeviceMetadataRequest.command_metadata:type_name -> resourceaggregate.pb.CommandMetadata
-	44, // 54: resourceaggregate.pb.UpdateDeviceMetadataResponse.audit_context:type_name -> resourceaggregate.pb.AuditContext
-	47, // 55: resourceaggregate.pb.ConfirmDeviceMetadataUpdateRequest.status:type_name -> resourceaggregate.pb.Status
-	3,  // 56: resourceaggregate.pb.ConfirmDeviceMetadataUpdateRequest.command_metadata:type_name -> resourceaggregate.pb.CommandMetadata
-	44, // 57: resourceaggregate.pb.ConfirmDeviceMetadataUpdateResponse.audit_context:type_name -> resourceaggregate.pb.AuditContext
-	45, // 58: resourceaggregate.pb.CancelPendingCommandsRequest.resource_id:type_name -> resourceaggregate.pb.ResourceId
-	3,  // 59: resourceaggregate.pb.CancelPendingCommandsRequest.command_metadata:type_name -> resourceaggregate.pb.CommandMetadata
-	44, // 60: resourceaggregate.pb.CancelPendingCommandsResponse.audit_context:type_name -> resourceaggregate.pb.AuditContext
-	3,  // 61: resourceaggregate.pb.CancelPendingMetadataUpdatesRequest.command_metadata:type_name -> resourceaggregate.pb.CommandMetadata
-	44, // 62: resourceaggregate.pb.CancelPendingMetadataUpdatesResponse.audit_context:type_name -> resourceaggregate.pb.AuditContext
-	44, // 63: resourceaggregate.pb.DeleteDevicesResponse.audit_context:type_name -> resourceaggregate.pb.AuditContext
-	40, // 64: resourceaggregate.pb.UpdateServiceMetadataRequest.heartbeat:type_name -> resourceaggregate.pb.ServiceHeartbeat
-	65, // [65:65] is the sub-list for method output_type
-	65, // [65:65] is the sub-list for method input_type
-	65, // [65:65] is the sub-list for extension type_name
-	65, // [65:65] is the sub-list for extension extendee
-	0,  // [0:65] is the sub-list for field type_name
+	44, // 51: resourceaggregate.pb.LabelsUpdate.set:type_name -> resourceaggregate.pb.LabelsUpdate.SetEntry
+	28, // 52: resourceaggregate.pb.UpdateDeviceMetadataRequest.connection:type_name -> resourceaggregate.pb.Connection
+	29, // 53: resourceaggregate.pb.UpdateDeviceMetadataRequest.twin_synchronization:type_name -> resourceaggregate.pb.TwinSynchronization
+	30, // 54: resourceaggregate.pb.UpdateDeviceMetadataRequest.labels:type_name -> resourceaggregate.pb.LabelsUpdate
+	3,  // 55: resourceaggregate.pb.UpdateDeviceMetadataRequest.command_metadata:type_name -> resourceaggregate.pb.CommandMetadata
+	47, // 56: resourceaggregate.pb.UpdateDeviceMetadataResponse.audit_context:type_name -> resourceaggregate.pb.AuditContext
+	45, // 57: resourceaggregate.pb.UpdateDeviceMetadataResponse.labels:type_name -> resourceaggregate.pb.UpdateDeviceMetadataResponse.LabelsEntry
+	50, // 58: resourceaggregate.pb.ConfirmDeviceMetadataUpdateRequest.status:type_name -> resourceaggregate.pb.Status
+	3,  // 59: resourceaggregate.pb.ConfirmDeviceMetadataUpdateRequest.command_metadata:type_name -> resourceaggregate.pb.CommandMetadata
+	47, // 60: resourceaggregate.pb.ConfirmDeviceMetadataUpdateResponse.audit_context:type_name -> resourceaggregate.pb.AuditContext
+	48, // 61: resourceaggregate.pb.CancelPendingCommandsRequest.resource_id:type_name -> resourceaggregate.pb.ResourceId
+	3,  // 62: resourceaggregate.pb.CancelPendingCommandsRequest.command_metadata:type_name -> resourceaggregate.pb.CommandMetadata
+	47, // 63: resourceaggregate.pb.CancelPendingCommandsResponse.audit_context:type_name -> resourceaggregate.pb.AuditContext
+	3,  // 64: resourceaggregate.pb.CancelPendingMetadataUpdatesRequest.command_metadata:type_name -> resourceaggregate.pb.CommandMetadata
+	47, // 65: resourceaggregate.pb.CancelPendingMetadataUpdatesResponse.audit_context:type_name -> resourceaggregate.pb.AuditContext
+	47, // 66: resourceaggregate.pb.DeleteDevicesResponse.audit_context:type_name -> resourceaggregate.pb.AuditContext
+	41, // 67: resourceaggregate.pb.UpdateServiceMetadataRequest.heartbeat:type_name -> resourceaggregate.pb.ServiceHeartbeat
+	68, // [68:68] is the sub-list for method output_type
+	68, // [68:68] is the sub-list for method input_type
+	68, // [68:68] is the sub-list for extension type_name
+	68, // [68:68] is the sub-list for extension extendee
+	0,  // [0:68] is the sub-list for field type_name
 }
 
 func init() { file_resource_aggregate_pb_commands_proto_init() }
@@ -3877,7 +4026,7 @@ func file_resource_aggregate_pb_commands_proto_init() {
 			}
 		}
 		file_resource_aggregate_pb_commands_proto_msgTypes[27].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateDeviceMetadataRequest); i {
+			switch v := v.(*LabelsUpdate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3889,7 +4038,7 @@ func file_resource_aggregate_pb_commands_proto_init() {
 			}
 		}
 		file_resource_aggregate_pb_commands_proto_msgTypes[28].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateDeviceMetadataResponse); i {
+			switch v := v.(*UpdateDeviceMetadataRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3901,7 +4050,7 @@ func file_resource_aggregate_pb_commands_proto_init() {
 			}
 		}
 		file_resource_aggregate_pb_commands_proto_msgTypes[29].Exporter = func(v any, i int) any {
-			switch v := v.(*ConfirmDeviceMetadataUpdateRequest); i {
+			switch v := v.(*UpdateDeviceMetadataResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3913,7 +4062,7 @@ func file_resource_aggregate_pb_commands_proto_init() {
 			}
 		}
 		file_resource_aggregate_pb_commands_proto_msgTypes[30].Exporter = func(v any, i int) any {
-			switch v := v.(*ConfirmDeviceMetadataUpdateResponse); i {
+			switch v := v.(*ConfirmDeviceMetadataUpdateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3925,7 +4074,7 @@ func file_resource_aggregate_pb_commands_proto_init() {
 			}
 		}
 		file_resource_aggregate_pb_commands_proto_msgTypes[31].Exporter = func(v any, i int) any {
-			switch v := v.(*CancelPendingCommandsRequest); i {
+			switch v := v.(*ConfirmDeviceMetadataUpdateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3937,7 +4086,7 @@ func file_resource_aggregate_pb_commands_proto_init() {
 			}
 		}
 		file_resource_aggregate_pb_commands_proto_msgTypes[32].Exporter = func(v any, i int) any {
-			switch v := v.(*CancelPendingCommandsResponse); i {
+			switch v := v.(*CancelPendingCommandsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3949,7 +4098,7 @@ func file_resource_aggregate_pb_commands_proto_init() {
 			}
 		}
 		file_resource_aggregate_pb_commands_proto_msgTypes[33].Exporter = func(v any, i int) any {
-			switch v := v.(*CancelPendingMetadataUpdatesRequest); i {
+			switch v := v.(*CancelPendingCommandsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3961,7 +4110,7 @@ func file_resource_aggregate_pb_commands_proto_init() {
 			}
 		}
 		file_resource_aggregate_pb_commands_proto_msgTypes[34].Exporter = func(v any, i int) any {
-			switch v := v.(*CancelPendingMetadataUpdatesResponse); i {
+			switch v := v.(*CancelPendingMetadataUpdatesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3973,7 +4122,7 @@ func file_resource_aggregate_pb_commands_proto_init() {
 			}
 		}
 		file_resource_aggregate_pb_commands_proto_msgTypes[35].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteDevicesRequest); i {
+			switch v := v.(*CancelPendingMetadataUpdatesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3985,7 +4134,7 @@ func file_resource_aggregate_pb_commands_proto_init() {
 			}
 		}
 		file_resource_aggregate_pb_commands_proto_msgTypes[36].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteDevicesResponse); i {
+			switch v := v.(*DeleteDevicesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3997,7 +4146,7 @@ func file_resource_aggregate_pb_commands_proto_init() {
 			}
 		}
 		file_resource_aggregate_pb_commands_proto_msgTypes[37].Exporter = func(v any, i int) any {
-			switch v := v.(*ServiceHeartbeat); i {
+			switch v := v.(*DeleteDevicesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4009,7 +4158,7 @@ func file_resource_aggregate_pb_commands_proto_init() {
 			}
 		}
 		file_resource_aggregate_pb_commands_proto_msgTypes[38].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateServiceMetadataRequest); i {
+			switch v := v.(*ServiceHeartbeat); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4021,6 +4170,18 @@ func file_resource_aggregate_pb_commands_proto_init() {
 			}
 		}
 		file_resource_aggregate_pb_commands_proto_msgTypes[39].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateServiceMetadataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_resource_aggregate_pb_commands_proto_msgTypes[40].Exporter = func(v any, i int) any {
 			switch v := v.(*UpdateServiceMetadataResponse); i {
 			case 0:
 				return &v.state
@@ -4033,17 +4194,18 @@ func file_resource_aggregate_pb_commands_proto_init() {
 			}
 		}
 	}
-	file_resource_aggregate_pb_commands_proto_msgTypes[27].OneofWrappers = []any{
+	file_resource_aggregate_pb_commands_proto_msgTypes[28].OneofWrappers = []any{
 		(*UpdateDeviceMetadataRequest_Connection)(nil),
 		(*UpdateDeviceMetadataRequest_TwinSynchronization)(nil),
 		(*UpdateDeviceMetadataRequest_TwinEnabled)(nil),
 		(*UpdateDeviceMetadataRequest_TwinForceSynchronization)(nil),
+		(*UpdateDeviceMetadataRequest_Labels)(nil),
 	}
-	file_resource_aggregate_pb_commands_proto_msgTypes[29].OneofWrappers = []any{
+	file_resource_aggregate_pb_commands_proto_msgTypes[30].OneofWrappers = []any{
 		(*ConfirmDeviceMetadataUpdateRequest_TwinEnabled)(nil),
 		(*ConfirmDeviceMetadataUpdateRequest_TwinForceSynchronization)(nil),
 	}
-	file_resource_aggregate_pb_commands_proto_msgTypes[38].OneofWrappers = []any{
+	file_resource_aggregate_pb_commands_proto_msgTypes[39].OneofWrappers = []any{
 		(*UpdateServiceMetadataRequest_Heartbeat)(nil),
 	}
 	type x struct{}
@@ -4052,7 +4214,7 @@ func file_resource_aggregate_pb_commands_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_resource_aggregate_pb_commands_proto_rawDesc,
 			NumEnums:      3,
-			NumMessages:   40,
+			NumMessages:   43,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -10,6 +10,7 @@ import (
 	"github.com/plgd-dev/device/v2/schema"
 	"github.com/plgd-dev/go-coap/v3/message"
 	extCodes "github.com/plgd-dev/hub/v2/grpc-gateway/pb/codes"
+	"github.com/plgd-dev/hub/v2/pkg/senml"
 	"github.com/plgd-dev/kit/v2/codec/cbor"
 	"github.com/plgd-dev/kit/v2/codec/json"
 	"google.golang.org/grpc/codes"
@@ -190,6 +191,10 @@ func DecodeContent(content *Content, v interface{}) error {
 		decode = cbor.Decode
 	case message.AppJSON.String():
 		decode = json.Decode
+	case message.AppSenmlJSON.String():
+		decode = senml.DecodeJSON
+	case message.AppSenmlCbor.String():
+		decode = senml.DecodeCBOR
 	case message.TextPlain.String():
 		switch out := v.(type) {
 		case *string:
@@ -31,9 +31,12 @@ func testNewEventstore(ctx context.Context, t *testing.T) (eventstore.EventStore
 	require.NoError(t, err)
 
 	config := eventstoreConfig.Config{
-		Use:     config.ACTIVE_DATABASE(),
-		MongoDB: config.MakeEventsStoreMongoDBConfig(),
-		CqlDB:   config.MakeEventsStoreCqlDBConfig(),
+		Config: database.Config[*mongodb.Config, *cqldb.Config]{
+			Use:     config.ACTIVE_DATABASE(),
+			MongoDB: config.MakeEventsStoreMongoDBConfig(),
+			CqlDB:   config.MakeEventsStoreCqlDBConfig(),
+		},
+		PostgreSQL: config.MakeEventsStorePostgreSQLConfig(),
 	}
 	switch config.Use {
 	case database.MongoDB:
@@ -0,0 +1,94 @@
+package config
+
+import (
+	"fmt"
+
+	kafkaClient "github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/kafka/client"
+	natsClient "github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/nats/client"
+)
+
+// Use selects the implementation of the eventbus.
+type Use string
+
+const (
+	NATS  Use = "nats"
+	Kafka Use = "kafka"
+	// InProcess selects the eventbus shared by the services running in one process.
+	InProcess Use = "inProcess"
+)
+
+func (u Use) Validate() error {
+	switch u {
+	case "", NATS, Kafka, InProcess:
+		return nil
+	}
+	return fmt.Errorf("use('%v')", u)
+}
+
+// IsKafka returns true when the Kafka is selected. The NATS is used by default.
+func (u Use) IsKafka() bool {
+	return u == Kafka
+}
+
+// IsInProcess returns true when the in-process eventbus is selected.
+func (u Use) IsInProcess() bool {
+	return u == InProcess
+}
+
+type ConfigPublisher struct {
+	Use   Use                         `yaml:"use" json:"use"`
+	NATS  natsClient.ConfigPublisher  `yaml:"nats" json:"nats"`
+	Kafka kafkaClient.ConfigPublisher `yaml:"kafka" json:"kafka"`
+}
+
+func (c *ConfigPublisher) Validate() error {
+	if err := c.Use.Validate(); err != nil {
+		return err
+	}
+	if c.Use.IsInProcess() {
+		return nil
+	}
+	if c.Use.IsKafka() {
+		if err := c.Kafka.Validate(); err != nil {
+			return fmt.Errorf("kafka.%w", err)
+		}
+		return nil
+	}
+	if err := c.NATS.Validate(); err != nil {
+		return fmt.Errorf("nats.%w", err)
+	}
+	return nil
+}
+
+type ConfigSubscriber struct {
+	Use   Use                          `yaml:"use" json:"use"`
+	NATS  natsClient.ConfigSubscriber  `yaml:"nats" json:"nats"`
+	Kafka kafkaClient.ConfigSubscriber `yaml:"kafka" json:"kafka"`
+}
+
+func (c *ConfigSubscriber) Validate() error {
+	if err := c.Use.Validate(); err != nil {
+		return err
+	}
+	if c.Use.IsInProcess() {
+		return nil
+	}
+	if c.Use.IsKafka() {
+		if err := c.Kafka.Validate(); err != nil {
+			return fmt.Errorf("kafka.%w", err)
+		}
+		return nil
+	}
+	if err := c.NATS.Validate(); err != nil {
+		return fmt.Errorf("nats.%w", err)
+	}
+	return nil
+}
+
+// LeadResourceTypeEnabled returns true when the subjects of the resource events contain the lead resource type.
+func (c *ConfigSubscriber) LeadResourceTypeEnabled() bool {
+	if c.Use.IsKafka() {
+		return c.Kafka.LeadResourceType.IsEnabled()
+	}
+	return c.NATS.LeadResourceType.IsEnabled()
+}
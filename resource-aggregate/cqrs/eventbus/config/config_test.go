@@ -0,0 +1,87 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/config"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestConfigSubscriber(t *testing.T) {
+	tests := []struct {
+		name                    string
+		data                    string
+		leadResourceTypeEnabled bool
+		wantErr                 bool
+	}{
+		{
+			name: "nats by default",
+			data: `
+nats:
+  url: "test"
+  pendingLimits:
+    msgLimit: 1
+    bytesLimit: 1
+  tls:
+    certFile: "test"
+    keyFile: "test"
+    caPool: "test"
+  leadResourceType:
+    enabled: true
+kafka:
+  brokers: []
+`,
+			leadResourceTypeEnabled: true,
+		},
+		{
+			name: "kafka",
+			data: `
+use: kafka
+kafka:
+  brokers: ["localhost:9092"]
+  topicPrefix: plgd
+  dialTimeout: 1s
+`,
+		},
+		{
+			name: "invalid - kafka without brokers",
+			data: `
+use: kafka
+nats:
+  url: "test"
+kafka:
+  topicPrefix: plgd
+  dialTimeout: 1s
+`,
+			wantErr: true,
+		},
+		{
+			name: "in-process",
+			data: `
+use: inProcess
+`,
+		},
+		{
+			name: "invalid - unknown use",
+			data: `
+use: amqp
+`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var cfg config.ConfigSubscriber
+			err := yaml.Unmarshal([]byte(tt.data), &cfg)
+			require.NoError(t, err)
+			err = cfg.Validate()
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.leadResourceTypeEnabled, cfg.LeadResourceTypeEnabled())
+		})
+	}
+}
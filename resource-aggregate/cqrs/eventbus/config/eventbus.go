@@ -0,0 +1,203 @@
+package config
+
+import (
+	"context"
+
+	"github.com/plgd-dev/hub/v2/pkg/fsnotify"
+	"github.com/plgd-dev/hub/v2/pkg/log"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/inprocess"
+	kafkaClient "github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/kafka/client"
+	kafkaPublisher "github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/kafka/publisher"
+	kafkaSubscriber "github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/kafka/subscriber"
+	natsClient "github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/nats/client"
+	natsPublisher "github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/nats/publisher"
+	natsSubscriber "github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/nats/subscriber"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/utils"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Publisher is implemented by the NATS, the Kafka and the in-process publishers.
+type Publisher interface {
+	eventbus.Publisher
+	PublishData(subj string, data []byte) error
+	Flush(ctx context.Context) error
+	AddCloseFunc(f func())
+	Close()
+}
+
+// Subscriber is implemented by the NATS, the Kafka and the in-process subscribers.
+type Subscriber interface {
+	eventbus.Subscriber
+	eventbus.RawSubscriber
+	AddReconnectFunc(f func()) uint64
+	RemoveReconnectFunc(id uint64)
+	AddCloseFunc(f func())
+	Close()
+}
+
+// NewPublisher creates the publisher selected by the configuration. The connection is closed by Close of the publisher.
+func NewPublisher(config ConfigPublisher, fileWatcher *fsnotify.Watcher, logger log.Logger, tracerProvider trace.TracerProvider) (Publisher, error) {
+	if config.Use.IsInProcess() {
+		opts := []inprocess.PublisherOption{inprocess.WithMarshaler(utils.Marshal)}
+		if lrt := config.NATS.LeadResourceType; lrt.IsEnabled() {
+			opts = append(opts, inprocess.WithLeadResourceType(lrt.GetCompiledRegexFilter(), lrt.Filter, lrt.UseUUID))
+		}
+		p, err := inprocess.NewPublisher(inprocess.Default(), opts...)
+		if err != nil {
+			return nil, err
+		}
+		return p, nil
+	}
+	if config.Use.IsKafka() {
+		c, err := kafkaClient.New(config.Kafka.Config, fileWatcher, logger, tracerProvider)
+		if err != nil {
+			return nil, err
+		}
+		opts := []kafkaPublisher.Option{kafkaPublisher.WithMarshaler(utils.Marshal)}
+		if lrt := config.Kafka.LeadResourceType; lrt.IsEnabled() {
+			opts = append(opts, kafkaPublisher.WithLeadResourceType(lrt.GetCompiledRegexFilter(), lrt.Filter, lrt.UseUUID))
+		}
+		p, err := kafkaPublisher.New(c, opts...)
+		if err != nil {
+			c.Close()
+			return nil, err
+		}
+		p.AddCloseFunc(c.Close)
+		return p, nil
+	}
+
+	c, err := natsClient.New(config.NATS.Config, fileWatcher, logger, tracerProvider)
+	if err != nil {
+		return nil, err
+	}
+	opts := []natsPublisher.Option{natsPublisher.WithMarshaler(utils.Marshal), natsPublisher.WithFlusherTimeout(config.NATS.FlusherTimeout)}
+	if lrt := config.NATS.LeadResourceType; lrt.IsEnabled() {
+		opts = append(opts, natsPublisher.WithLeadResourceType(lrt.GetCompiledRegexFilter(), lrt.Filter, lrt.UseUUID))
+	}
+	p, err := natsPublisher.New(c.GetConn(), config.NATS.JetStream, opts...)
+	if err != nil {
+		c.Close()
+		return nil, err
+	}
+	p.AddCloseFunc(c.Close)
+	return p, nil
+}
+
+type subscriberOptions struct {
+	goroutinePoolGo eventbus.GoroutinePoolGoFunc
+	durableIDs      []string
+}
+
+type SubscriberOption interface {
+	apply(o *subscriberOptions)
+}
+
+type GoroutinePoolGoOpt struct {
+	goroutinePoolGo eventbus.GoroutinePoolGoFunc
+}
+
+func (o GoroutinePoolGoOpt) apply(opts *subscriberOptions) {
+	opts.goroutinePoolGo = o.goroutinePoolGo
+}
+
+func WithGoPool(goroutinePoolGo eventbus.GoroutinePoolGoFunc) GoroutinePoolGoOpt {
+	return GoroutinePoolGoOpt{
+		goroutinePoolGo: goroutinePoolGo,
+	}
+}
+
+type DurableSubscriptionsOpt struct {
+	subscriptionIDs []string
+}
+
+func (o DurableSubscriptionsOpt) apply(opts *subscriberOptions) {
+	opts.durableIDs = append(opts.durableIDs, o.subscriptionIDs...)
+}
+
+// WithDurableSubscriptions sets the subscriptionIDs which are balanced among the instances of the service and which
+// continue from the last processed event after the restart. The Kafka subscriber uses the consumer groups and the NATS
+// subscriber uses the durable JetStream consumers when the jetstream is enabled, otherwise the queue groups are used.
+func WithDurableSubscriptions(subscriptionIDs ...string) DurableSubscriptionsOpt {
+	return DurableSubscriptionsOpt{
+		subscriptionIDs: subscriptionIDs,
+	}
+}
+
+// NewSubscriber creates the subscriber selected by the configuration. The connection is closed by Close of the subscriber.
+func NewSubscriber(config ConfigSubscriber, fileWatcher *fsnotify.Watcher, logger log.Logger, tracerProvider trace.TracerProvider, opts ...SubscriberOption) (Subscriber, error) {
+	var cfg subscriberOptions
+	for _, o := range opts {
+		o.apply(&cfg)
+	}
+	if config.Use.IsInProcess() {
+		// the subscriptionIDs are always the queue groups of the in-process eventbus
+		s, err := inprocess.NewSubscriber(inprocess.Default(), config.LeadResourceTypeEnabled(), logger,
+			inprocess.WithUnmarshaler(utils.Unmarshal),
+			inprocess.WithGoPool(cfg.goroutinePoolGo),
+		)
+		if err != nil {
+			return nil, err
+		}
+		return s, nil
+	}
+	if config.Use.IsKafka() {
+		c, err := kafkaClient.New(config.Kafka.Config, fileWatcher, logger, tracerProvider)
+		if err != nil {
+			return nil, err
+		}
+		s, err := kafkaSubscriber.New(c, config.LeadResourceTypeEnabled(), logger,
+			kafkaSubscriber.WithUnmarshaler(utils.Unmarshal),
+			kafkaSubscriber.WithGoPool(cfg.goroutinePoolGo),
+			kafkaSubscriber.WithConsumerGroups(cfg.durableIDs...),
+		)
+		if err != nil {
+			c.Close()
+			return nil, err
+		}
+		s.AddCloseFunc(c.Close)
+		return s, nil
+	}
+
+	c, err := natsClient.New(config.NATS.Config, fileWatcher, logger, tracerProvider)
+	if err != nil {
+		return nil, err
+	}
+	s, err := natsSubscriber.New(c.GetConn(), config.NATS.PendingLimits, config.LeadResourceTypeEnabled(), logger,
+		natsSubscriber.WithUnmarshaler(utils.Unmarshal),
+		natsSubscriber.WithGoPool(cfg.goroutinePoolGo),
+		natsSubscriber.WithJetStream(config.NATS.JetStream, cfg.durableIDs...),
+	)
+	if err != nil {
+		c.Close()
+		return nil, err
+	}
+	s.AddCloseFunc(c.Close)
+	return s, nil
+}
+
+// NewRawSubscriber creates the subscriber of the raw data for the services which publish the events.
+func NewRawSubscriber(config ConfigPublisher, fileWatcher *fsnotify.Watcher, logger log.Logger, tracerProvider trace.TracerProvider) (eventbus.RawSubscriber, func(), error) {
+	if config.Use.IsInProcess() {
+		return inprocess.Default(), func() {
+			// the in-process eventbus is shared by the process
+		}, nil
+	}
+	if config.Use.IsKafka() {
+		s, err := NewSubscriber(ConfigSubscriber{
+			Use: Kafka,
+			Kafka: kafkaClient.ConfigSubscriber{
+				Config: config.Kafka.Config,
+			},
+		}, fileWatcher, logger, tracerProvider)
+		if err != nil {
+			return nil, nil, err
+		}
+		return s, s.Close, nil
+	}
+	c, err := natsClient.New(config.NATS.Config, fileWatcher, logger, tracerProvider)
+	if err != nil {
+		return nil, nil, err
+	}
+	return c, c.Close, nil
+}
@@ -0,0 +1,93 @@
+package config_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/plgd-dev/hub/v2/pkg/log"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/commands"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/config"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/events"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+type handlerFunc func(ctx context.Context, iter eventbus.Iter) error
+
+func (f handlerFunc) Handle(ctx context.Context, iter eventbus.Iter) error {
+	return f(ctx, iter)
+}
+
+func TestInProcess(t *testing.T) {
+	ctx := context.Background()
+	logger := log.NewLogger(log.MakeDefaultConfig())
+	// the publisher and the subscribers of the services share the eventbus of the process
+	p, err := config.NewPublisher(config.ConfigPublisher{Use: config.InProcess}, nil, logger, noop.NewTracerProvider())
+	require.NoError(t, err)
+	defer p.Close()
+	s, err := config.NewSubscriber(config.ConfigSubscriber{Use: config.InProcess}, nil, logger, noop.NewTracerProvider())
+	require.NoError(t, err)
+	defer s.Close()
+	raw, closeRaw, err := config.NewRawSubscriber(config.ConfigPublisher{Use: config.InProcess}, nil, logger, noop.NewTracerProvider())
+	require.NoError(t, err)
+	defer closeRaw()
+
+	received := make(chan string, 1)
+	resourceID := commands.NewResourceID("deviceID", "/light/1")
+	topics := s.GetResourceEventSubjects("owner", resourceID, "*")
+	observer, err := s.Subscribe(ctx, "subscriptionID", topics, handlerFunc(func(ctx context.Context, iter eventbus.Iter) error {
+		for {
+			e, ok := iter.Next(ctx)
+			if !ok {
+				return iter.Err()
+			}
+			var ev events.ResourceChanged
+			if err := e.Unmarshal(&ev); err != nil {
+				return err
+			}
+			received <- ev.GetResourceId().GetHref()
+		}
+	}))
+	require.NoError(t, err)
+	defer func() {
+		_ = observer.Close()
+	}()
+
+	rawReceived := make(chan string, 1)
+	unsubscribe, err := raw.SubscribeRaw("plgd.owners.owner.registrations.>", func(subject string, _ []byte) {
+		rawReceived <- subject
+	})
+	require.NoError(t, err)
+	defer func() {
+		_ = unsubscribe()
+	}()
+
+	ev := events.ResourceChanged{
+		ResourceId: resourceID,
+		AuditContext: &commands.AuditContext{
+			Owner: "owner",
+		},
+		EventMetadata: &events.EventMetadata{
+			Version: 1,
+		},
+	}
+	err = p.Publish(ctx, p.GetPublishSubject("owner", &ev), ev.GetResourceId().GetDeviceId(), ev.AggregateID(), &ev)
+	require.NoError(t, err)
+	select {
+	case href := <-received:
+		require.Equal(t, resourceID.GetHref(), href)
+	case <-time.After(time.Second * 5):
+		require.FailNow(t, "timeout")
+	}
+
+	err = p.PublishData("plgd.owners.owner.registrations.devicesregistered", []byte("data"))
+	require.NoError(t, err)
+	select {
+	case subject := <-rawReceived:
+		require.Equal(t, "plgd.owners.owner.registrations.devicesregistered", subject)
+	case <-time.After(time.Second * 5):
+		require.FailNow(t, "timeout")
+	}
+}
@@ -2,6 +2,7 @@ package eventbus
 
 import (
 	"context"
+	"strconv"
 	"time"
 
 	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore"
@@ -20,6 +21,12 @@ type Event = interface {
 	Types() []string
 }
 
+// IdempotencyKey identifies the event of the aggregate. The event can be delivered more times,
+// so the subscribers can deduplicate the events by the key.
+func IdempotencyKey(aggregateID string, version uint64) string {
+	return aggregateID + "." + strconv.FormatUint(version, 10)
+}
+
 // EventUnmarshaler provides event.
 type EventUnmarshaler = interface {
 	Version() uint64
@@ -23,3 +23,13 @@ type Observer interface {
 	Close() error
 	SetTopics(ctx context.Context, topics []string) error
 }
+
+// RawHandlerFunc handles the data published to the subject.
+type RawHandlerFunc = func(subject string, data []byte)
+
+// RawSubscriber delivers the published data without decoding. Each subscription receives all data
+// published to the subject, so it is used by the caches of the services.
+type RawSubscriber interface {
+	// SubscribeRaw subscribes the handler to the subject, the returned function removes the subscription.
+	SubscribeRaw(subject string, handler RawHandlerFunc) (unsubscribe func() error, err error)
+}
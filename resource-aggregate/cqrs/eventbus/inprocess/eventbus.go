@@ -0,0 +1,223 @@
+package inprocess
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"sync"
+
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+type subscription struct {
+	tokens   []string
+	queue    string
+	observer *Observer
+	raw      *rawObserver
+}
+
+// EventBus routes events from the publishers to the subscribers within one process.
+// Subjects, wildcards and queue groups follow the NATS semantics.
+type EventBus struct {
+	lock   sync.RWMutex
+	subs   map[uint64]*subscription
+	nextID uint64
+}
+
+// New creates an in-process eventbus.
+func New() *EventBus {
+	return &EventBus{
+		subs: make(map[uint64]*subscription),
+	}
+}
+
+var defaultBus = New()
+
+// Default returns the eventbus shared by all publishers and subscribers of the process, which are created
+// by the eventbus configuration. So the services running in one process exchange the events by it.
+func Default() *EventBus {
+	return defaultBus
+}
+
+func (b *EventBus) add(subject string, s *subscription) (uint64, error) {
+	tokens, err := eventbus.ParseSubject(subject, true)
+	if err != nil {
+		return 0, err
+	}
+	s.tokens = tokens
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.nextID++
+	b.subs[b.nextID] = s
+	return b.nextID, nil
+}
+
+func (b *EventBus) subscribe(subject, queue string, o *Observer) (uint64, error) {
+	return b.add(subject, &subscription{
+		queue:    queue,
+		observer: o,
+	})
+}
+
+// SubscribeRaw implements the eventbus.RawSubscriber interface. The published events are delivered
+// encoded by the protobuf, same as by the NATS.
+func (b *EventBus) SubscribeRaw(subject string, handler eventbus.RawHandlerFunc) (func() error, error) {
+	o := newRawObserver(handler)
+	id, err := b.add(subject, &subscription{
+		raw: o,
+	})
+	if err != nil {
+		o.close()
+		return nil, err
+	}
+	return func() error {
+		b.unsubscribe(id)
+		o.close()
+		return nil
+	}, nil
+}
+
+func (b *EventBus) unsubscribe(id uint64) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	delete(b.subs, id)
+}
+
+// route returns observers which receive the event published to the subject. Each queue group
+// receives the event only once by a randomly chosen member.
+func (b *EventBus) route(tokens []string) ([]*Observer, []*rawObserver) {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+	observers := make([]*Observer, 0, 4)
+	var raws []*rawObserver
+	queues := make(map[string][]*Observer)
+	for _, s := range b.subs {
+		if !eventbus.MatchSubject(s.tokens, tokens) {
+			continue
+		}
+		switch {
+		case s.raw != nil:
+			raws = append(raws, s.raw)
+		case s.queue == "":
+			observers = append(observers, s.observer)
+		default:
+			queues[s.queue] = append(queues[s.queue], s.observer)
+		}
+	}
+	for _, members := range queues {
+		observers = append(observers, members[rand.IntN(len(members))]) //nolint:gosec
+	}
+	return observers, raws
+}
+
+// PublishEvent routes the event to the observers subscribed to the subject.
+func (b *EventBus) PublishEvent(subject string, e *pb.Event) error {
+	tokens, err := eventbus.ParseSubject(subject, false)
+	if err != nil {
+		return err
+	}
+	observers, raws := b.route(tokens)
+	for _, o := range observers {
+		o.push(e)
+	}
+	if len(raws) == 0 {
+		return nil
+	}
+	data, err := proto.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("cannot marshal event: %w", err)
+	}
+	for _, o := range raws {
+		o.push(subject, data)
+	}
+	return nil
+}
+
+// PublishData routes the data to the raw subscriptions of the subject. The observers receive
+// the data only when it is an encoded event.
+func (b *EventBus) PublishData(subject string, data []byte) error {
+	tokens, err := eventbus.ParseSubject(subject, false)
+	if err != nil {
+		return err
+	}
+	observers, raws := b.route(tokens)
+	for _, o := range raws {
+		o.push(subject, data)
+	}
+	if len(observers) == 0 {
+		return nil
+	}
+	var e pb.Event
+	if err = proto.Unmarshal(data, &e); err != nil {
+		// the data isn't an event, so it is only for the raw subscriptions
+		return nil //nolint:nilerr
+	}
+	for _, o := range observers {
+		o.push(&e)
+	}
+	return nil
+}
+
+type rawMessage struct {
+	subject string
+	data    []byte
+}
+
+// rawObserver calls the handler of the raw subscription by its goroutine in the order of publishing.
+type rawObserver struct {
+	handler eventbus.RawHandlerFunc
+
+	lock      sync.Mutex
+	pending   []rawMessage
+	signal    chan struct{}
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+func newRawObserver(handler eventbus.RawHandlerFunc) *rawObserver {
+	o := &rawObserver{
+		handler: handler,
+		signal:  make(chan struct{}, 1),
+		done:    make(chan struct{}),
+	}
+	go o.run()
+	return o
+}
+
+func (o *rawObserver) push(subject string, data []byte) {
+	o.lock.Lock()
+	o.pending = append(o.pending, rawMessage{subject: subject, data: data})
+	o.lock.Unlock()
+	select {
+	case o.signal <- struct{}{}:
+	default:
+	}
+}
+
+func (o *rawObserver) popAll() []rawMessage {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	pending := o.pending
+	o.pending = nil
+	return pending
+}
+
+func (o *rawObserver) run() {
+	for {
+		select {
+		case <-o.done:
+			return
+		case <-o.signal:
+			for _, m := range o.popAll() {
+				o.handler(m.subject, m.data)
+			}
+		}
+	}
+}
+
+func (o *rawObserver) close() {
+	o.closeOnce.Do(func() {
+		close(o.done)
+	})
+}
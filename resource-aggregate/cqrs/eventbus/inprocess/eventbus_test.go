@@ -0,0 +1,188 @@
+package inprocess_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/plgd-dev/hub/v2/pkg/log"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/commands"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/inprocess"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/events"
+	"github.com/stretchr/testify/require"
+)
+
+const timeout = time.Second * 5
+
+type mockEvent struct {
+	VersionI     uint64
+	EventTypeI   string
+	AggregateIDI string
+	Data         string
+}
+
+func (e mockEvent) Version() uint64 {
+	return e.VersionI
+}
+
+func (e mockEvent) EventType() string {
+	return e.EventTypeI
+}
+
+func (e mockEvent) AggregateID() string {
+	return e.AggregateIDI
+}
+
+func (e mockEvent) GroupID() string {
+	return ""
+}
+
+func (e mockEvent) IsSnapshot() bool {
+	return false
+}
+
+func (e mockEvent) ETag() *eventstore.ETagData {
+	return nil
+}
+
+func (e mockEvent) Timestamp() time.Time {
+	return time.Unix(0, 0)
+}
+
+func (e mockEvent) ServiceID() (string, bool) {
+	return "", false
+}
+
+func (e mockEvent) Types() []string {
+	return nil
+}
+
+type mockEventHandler struct {
+	newEvent chan mockEvent
+}
+
+func newMockEventHandler() *mockEventHandler {
+	return &mockEventHandler{newEvent: make(chan mockEvent, 10)}
+}
+
+func (eh *mockEventHandler) Handle(ctx context.Context, iter eventbus.Iter) error {
+	for {
+		eu, ok := iter.Next(ctx)
+		if !ok {
+			break
+		}
+		var e mockEvent
+		if err := eu.Unmarshal(&e); err != nil {
+			return err
+		}
+		eh.newEvent <- e
+	}
+	return iter.Err()
+}
+
+func (eh *mockEventHandler) waitForEvent(timeout time.Duration) (mockEvent, error) {
+	select {
+	case e := <-eh.newEvent:
+		return e, nil
+	case <-time.After(timeout):
+		return mockEvent{}, errors.New("timeout")
+	}
+}
+
+func newPublisherAndSubscriber(t *testing.T) (*inprocess.Publisher, *inprocess.Subscriber) {
+	bus := inprocess.New()
+	p, err := inprocess.NewPublisher(bus, inprocess.WithMarshaler(json.Marshal))
+	require.NoError(t, err)
+	s, err := inprocess.NewSubscriber(bus, false, log.NewLogger(log.MakeDefaultConfig()), inprocess.WithUnmarshaler(json.Unmarshal))
+	require.NoError(t, err)
+	return p, s
+}
+
+func subscribe(ctx context.Context, t *testing.T, s eventbus.Subscriber, subscriptionID string, topics []string) (*mockEventHandler, eventbus.Observer) {
+	m := newMockEventHandler()
+	ob, err := s.Subscribe(ctx, subscriptionID, topics, m)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		errC := ob.Close()
+		require.NoError(t, errC)
+	})
+	return m, ob
+}
+
+func TestPublishSubscribe(t *testing.T) {
+	ctx := context.Background()
+	p, s := newPublisherAndSubscriber(t)
+
+	m0, _ := subscribe(ctx, t, s, "sub-0", []string{"test.topic0.>"})
+	m1, ob1 := subscribe(ctx, t, s, "sub-1", []string{"test.*.a"})
+	m2, _ := subscribe(ctx, t, s, "sub-shared", []string{"test.>"})
+	m3, _ := subscribe(ctx, t, s, "sub-shared", []string{"test.>"})
+
+	ev := mockEvent{VersionI: 1, EventTypeI: "test1", AggregateIDI: "a1", Data: "data1"}
+	err := p.Publish(ctx, []string{"test.topic0.a"}, "g1", "a1", ev)
+	require.NoError(t, err)
+
+	e, err := m0.waitForEvent(timeout)
+	require.NoError(t, err)
+	require.Equal(t, ev, e)
+	e, err = m1.waitForEvent(timeout)
+	require.NoError(t, err)
+	require.Equal(t, ev, e)
+
+	// queue group receives the event once
+	select {
+	case e = <-m2.newEvent:
+	case e = <-m3.newEvent:
+	case <-time.After(timeout):
+		require.Fail(t, "timeout")
+	}
+	require.Equal(t, ev, e)
+	select {
+	case <-m2.newEvent:
+		require.Fail(t, "event delivered twice to the queue group")
+	case <-m3.newEvent:
+		require.Fail(t, "event delivered twice to the queue group")
+	case <-time.After(time.Millisecond * 100):
+	}
+
+	// change topics
+	err = ob1.SetTopics(ctx, []string{"test.topic1.b"})
+	require.NoError(t, err)
+	ev = mockEvent{VersionI: 2, EventTypeI: "test2", AggregateIDI: "a1", Data: "data2"}
+	err = p.Publish(ctx, []string{"test.topic0.a", "test.topic1.b"}, "g1", "a1", ev)
+	require.NoError(t, err)
+	e, err = m1.waitForEvent(timeout)
+	require.NoError(t, err)
+	require.Equal(t, ev, e)
+	_, err = m1.waitForEvent(time.Millisecond * 100)
+	require.Error(t, err)
+
+	// wildcards are not allowed for publishing
+	err = p.Publish(ctx, []string{"test.*.a"}, "g1", "a1", ev)
+	require.Error(t, err)
+}
+
+func TestSubjects(t *testing.T) {
+	ctx := context.Background()
+	p, s := newPublisherAndSubscriber(t)
+
+	owner := "owner"
+	resourceID := commands.NewResourceID("4f2b2b10-7f1c-4c5a-9c5e-3a0d0e2d4b1f", "/light/1")
+	subjects := s.GetResourceEventSubjects(owner, resourceID, (&events.ResourceChanged{}).EventType())
+	m, _ := subscribe(ctx, t, s, "sub", subjects)
+
+	ev := &events.ResourceChanged{
+		ResourceId: resourceID,
+		EventMetadata: &events.EventMetadata{
+			Version: 3,
+		},
+	}
+	err := p.Publish(ctx, p.GetPublishSubject(owner, ev), resourceID.GetDeviceId(), resourceID.ToUUID().String(), ev)
+	require.NoError(t, err)
+	_, err = m.waitForEvent(timeout)
+	require.NoError(t, err)
+}
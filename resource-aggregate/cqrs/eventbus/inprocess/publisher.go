@@ -0,0 +1,129 @@
+package inprocess
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"regexp"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/plgd-dev/hub/v2/pkg/fn"
+	pkgTime "github.com/plgd-dev/hub/v2/pkg/time"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/nats/client"
+	natsPublisher "github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/nats/publisher"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/pb"
+)
+
+// MarshalerFunc marshal struct to bytes.
+type MarshalerFunc = func(v interface{}) ([]byte, error)
+
+// Publisher implements a eventbus.Publisher interface. The subjects are same as for the NATS publisher.
+type Publisher struct {
+	*natsPublisher.Subjects
+	bus           *EventBus
+	dataMarshaler MarshalerFunc
+	closeFunc     fn.FuncList
+}
+
+type publisherOptions struct {
+	dataMarshaler MarshalerFunc
+	subjectsOpts  []natsPublisher.Option
+}
+
+type PublisherOption interface {
+	applyPublisher(o *publisherOptions)
+}
+
+type MarshalerOpt struct {
+	dataMarshaler MarshalerFunc
+}
+
+func (o MarshalerOpt) applyPublisher(opts *publisherOptions) {
+	opts.dataMarshaler = o.dataMarshaler
+}
+
+func WithMarshaler(dataMarshaler MarshalerFunc) MarshalerOpt {
+	return MarshalerOpt{
+		dataMarshaler: dataMarshaler,
+	}
+}
+
+type LeadResourceTypeOpt struct {
+	opt natsPublisher.LeadResourceTypeOpt
+}
+
+func (o LeadResourceTypeOpt) applyPublisher(opts *publisherOptions) {
+	opts.subjectsOpts = append(opts.subjectsOpts, o.opt)
+}
+
+func WithLeadResourceType(regexFilter []*regexp.Regexp, filter client.LeadResourceTypeFilter, useUUID bool) LeadResourceTypeOpt {
+	return LeadResourceTypeOpt{
+		opt: natsPublisher.WithLeadResourceType(regexFilter, filter, useUUID),
+	}
+}
+
+// NewPublisher creates publisher for the in-process eventbus.
+func NewPublisher(bus *EventBus, opts ...PublisherOption) (*Publisher, error) {
+	cfg := publisherOptions{
+		dataMarshaler: json.Marshal,
+	}
+	for _, o := range opts {
+		o.applyPublisher(&cfg)
+	}
+	if bus == nil {
+		return nil, errors.New("invalid eventbus")
+	}
+	if cfg.dataMarshaler == nil {
+		return nil, errors.New("invalid dataMarshaler")
+	}
+	return &Publisher{
+		Subjects:      natsPublisher.NewSubjects(cfg.subjectsOpts...),
+		bus:           bus,
+		dataMarshaler: cfg.dataMarshaler,
+	}, nil
+}
+
+// Publish publishes an event to topics.
+func (p *Publisher) Publish(_ context.Context, topics []string, groupID, aggregateID string, event eventbus.Event) error {
+	data, err := p.dataMarshaler(event)
+	if err != nil {
+		return errors.New("could not marshal data for event: " + err.Error())
+	}
+
+	e := &pb.Event{
+		EventType:   event.EventType(),
+		Data:        data,
+		Version:     event.Version(),
+		GroupId:     groupID,
+		AggregateId: aggregateID,
+		IsSnapshot:  event.IsSnapshot(),
+		Timestamp:   pkgTime.UnixNano(event.Timestamp()),
+	}
+
+	var errors *multierror.Error
+	for _, t := range topics {
+		if err = p.bus.PublishEvent(t, e); err != nil {
+			errors = multierror.Append(errors, err)
+		}
+	}
+	return errors.ErrorOrNil()
+}
+
+// PublishData publishes the raw data to the subject.
+func (p *Publisher) PublishData(subj string, data []byte) error {
+	return p.bus.PublishData(subj, data)
+}
+
+// Flush is a no-op, the events are routed to the subscribers by the Publish.
+func (p *Publisher) Flush(context.Context) error {
+	return nil
+}
+
+func (p *Publisher) AddCloseFunc(f func()) {
+	p.closeFunc.AddFunc(f)
+}
+
+func (p *Publisher) Close() {
+	p.closeFunc.Execute()
+}
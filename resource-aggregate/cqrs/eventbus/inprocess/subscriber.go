@@ -0,0 +1,312 @@
+package inprocess
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/plgd-dev/hub/v2/pkg/fn"
+	"github.com/plgd-dev/hub/v2/pkg/log"
+	pkgTime "github.com/plgd-dev/hub/v2/pkg/time"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/commands"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/pb"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/utils"
+)
+
+// UnmarshalerFunc unmarshal bytes to pointer of struct.
+type UnmarshalerFunc = func(s []byte, v interface{}) error
+
+// Subscriber implements a eventbus.Subscriber interface.
+type Subscriber struct {
+	bus                     *EventBus
+	dataUnmarshaler         UnmarshalerFunc
+	logger                  log.Logger
+	goroutinePoolGo         eventbus.GoroutinePoolGoFunc
+	closeFunc               fn.FuncList
+	leadResourceTypeEnabled bool
+}
+
+type subscriberOptions struct {
+	dataUnmarshaler UnmarshalerFunc
+	goroutinePoolGo eventbus.GoroutinePoolGoFunc
+}
+
+type SubscriberOption interface {
+	applySubscriber(o *subscriberOptions)
+}
+
+type UnmarshalerOpt struct {
+	dataUnmarshaler UnmarshalerFunc
+}
+
+func (o UnmarshalerOpt) applySubscriber(opts *subscriberOptions) {
+	opts.dataUnmarshaler = o.dataUnmarshaler
+}
+
+func WithUnmarshaler(dataUnmarshaler UnmarshalerFunc) UnmarshalerOpt {
+	return UnmarshalerOpt{
+		dataUnmarshaler: dataUnmarshaler,
+	}
+}
+
+type GoroutinePoolGoOpt struct {
+	goroutinePoolGo eventbus.GoroutinePoolGoFunc
+}
+
+func (o GoroutinePoolGoOpt) applySubscriber(opts *subscriberOptions) {
+	opts.goroutinePoolGo = o.goroutinePoolGo
+}
+
+func WithGoPool(goroutinePoolGo eventbus.GoroutinePoolGoFunc) GoroutinePoolGoOpt {
+	return GoroutinePoolGoOpt{
+		goroutinePoolGo: goroutinePoolGo,
+	}
+}
+
+// NewSubscriber creates subscriber for the in-process eventbus.
+func NewSubscriber(bus *EventBus, leadResourceTypeEnabled bool, logger log.Logger, opts ...SubscriberOption) (*Subscriber, error) {
+	cfg := subscriberOptions{
+		dataUnmarshaler: json.Unmarshal,
+	}
+	for _, o := range opts {
+		o.applySubscriber(&cfg)
+	}
+	if bus == nil {
+		return nil, errors.New("invalid eventbus")
+	}
+	if cfg.dataUnmarshaler == nil {
+		return nil, errors.New("invalid eventUnmarshaler")
+	}
+	return &Subscriber{
+		bus:                     bus,
+		dataUnmarshaler:         cfg.dataUnmarshaler,
+		logger:                  logger,
+		goroutinePoolGo:         cfg.goroutinePoolGo,
+		leadResourceTypeEnabled: leadResourceTypeEnabled,
+	}, nil
+}
+
+// SubscribeRaw implements the eventbus.RawSubscriber interface.
+func (s *Subscriber) SubscribeRaw(subject string, handler eventbus.RawHandlerFunc) (func() error, error) {
+	return s.bus.SubscribeRaw(subject, handler)
+}
+
+// AddReconnectFunc is a no-op, the in-process eventbus is never disconnected.
+func (s *Subscriber) AddReconnectFunc(func()) uint64 {
+	return 0
+}
+
+// RemoveReconnectFunc is a no-op, see AddReconnectFunc.
+func (s *Subscriber) RemoveReconnectFunc(uint64) {
+	// reconnect functions are not stored
+}
+
+func (s *Subscriber) AddCloseFunc(f func()) {
+	s.closeFunc.AddFunc(f)
+}
+
+func (s *Subscriber) GetResourceEventSubjects(owner string, resourceID *commands.ResourceId, eventType string) []string {
+	return utils.GetResourceEventSubjects(owner, resourceID, eventType, s.leadResourceTypeEnabled)
+}
+
+// Subscribe creates a observer that listen on events from topics.
+func (s *Subscriber) Subscribe(ctx context.Context, subscriptionID string, topics []string, eh eventbus.Handler) (eventbus.Observer, error) {
+	observer := newObserver(s.bus, subscriptionID, s.dataUnmarshaler, eventbus.NewGoroutinePoolHandler(s.goroutinePoolGo, eh, func(err error) { s.logger.Error(err) }), s.logger)
+
+	err := observer.SetTopics(ctx, topics)
+	if err != nil {
+		_ = observer.Close()
+		return nil, fmt.Errorf("cannot subscribe: %w", err)
+	}
+
+	return observer, nil
+}
+
+func (s *Subscriber) Close() {
+	s.closeFunc.Execute()
+}
+
+// Observer handles events from the in-process eventbus. Events are delivered in the order of publishing
+// by the goroutine of the observer, so the publisher is never blocked by the handler.
+type Observer struct {
+	bus             *EventBus
+	subscriptionID  string
+	dataUnmarshaler UnmarshalerFunc
+	eventHandler    eventbus.Handler
+	logger          log.Logger
+
+	lock sync.Mutex
+	subs map[string]uint64
+
+	pendingLock sync.Mutex
+	pending     []*pb.Event
+	signal      chan struct{}
+	done        chan struct{}
+	closeOnce   sync.Once
+}
+
+func newObserver(bus *EventBus, subscriptionID string, dataUnmarshaler UnmarshalerFunc, eh eventbus.Handler, logger log.Logger) *Observer {
+	o := &Observer{
+		bus:             bus,
+		subscriptionID:  subscriptionID,
+		dataUnmarshaler: dataUnmarshaler,
+		eventHandler:    eh,
+		logger:          logger,
+		subs:            make(map[string]uint64),
+		signal:          make(chan struct{}, 1),
+		done:            make(chan struct{}),
+	}
+	go o.run()
+	return o
+}
+
+// SetTopics set new topics to observe.
+func (o *Observer) SetTopics(_ context.Context, topics []string) error {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	mapTopics := make(map[string]bool)
+	for _, topic := range topics {
+		mapTopics[topic] = true
+	}
+	for topic, id := range o.subs {
+		if !mapTopics[topic] {
+			o.bus.unsubscribe(id)
+			delete(o.subs, topic)
+		}
+	}
+
+	var errors *multierror.Error
+	for topic := range mapTopics {
+		if _, ok := o.subs[topic]; ok {
+			continue
+		}
+		id, err := o.bus.subscribe(topic, o.subscriptionID, o)
+		if err != nil {
+			errors = multierror.Append(errors, err)
+			continue
+		}
+		o.subs[topic] = id
+	}
+	if errors.ErrorOrNil() != nil {
+		return fmt.Errorf("cannot subscribe to topics: %w", errors)
+	}
+	return nil
+}
+
+// Close cancel observation. Pending events are dropped. It can be called from the handler.
+func (o *Observer) Close() error {
+	o.lock.Lock()
+	for topic, id := range o.subs {
+		o.bus.unsubscribe(id)
+		delete(o.subs, topic)
+	}
+	o.lock.Unlock()
+	o.closeOnce.Do(func() {
+		close(o.done)
+	})
+	return nil
+}
+
+func (o *Observer) push(e *pb.Event) {
+	o.pendingLock.Lock()
+	o.pending = append(o.pending, e)
+	o.pendingLock.Unlock()
+	select {
+	case o.signal <- struct{}{}:
+	default:
+	}
+}
+
+func (o *Observer) popAll() []*pb.Event {
+	o.pendingLock.Lock()
+	defer o.pendingLock.Unlock()
+	pending := o.pending
+	o.pending = nil
+	return pending
+}
+
+func (o *Observer) run() {
+	for {
+		select {
+		case <-o.done:
+			return
+		case <-o.signal:
+			for _, e := range o.popAll() {
+				o.handleEvent(e)
+			}
+		}
+	}
+}
+
+func (o *Observer) handleEvent(e *pb.Event) {
+	i := iter{
+		hasNext: true,
+		e:       e,
+		dataUnmarshaler: func(v interface{}) error {
+			return o.dataUnmarshaler(e.GetData(), v)
+		},
+	}
+	if err := o.eventHandler.Handle(context.Background(), &i); err != nil {
+		o.logger.Errorf("cannot handle event: %v", err)
+	}
+}
+
+type eventUnmarshaler struct {
+	pb              *pb.Event
+	dataUnmarshaler func(v interface{}) error
+}
+
+func (e *eventUnmarshaler) Version() uint64 {
+	return e.pb.GetVersion()
+}
+
+func (e *eventUnmarshaler) EventType() string {
+	return e.pb.GetEventType()
+}
+
+func (e *eventUnmarshaler) AggregateID() string {
+	return e.pb.GetAggregateId()
+}
+
+func (e *eventUnmarshaler) GroupID() string {
+	return e.pb.GetGroupId()
+}
+
+func (e *eventUnmarshaler) IsSnapshot() bool {
+	return e.pb.GetIsSnapshot()
+}
+
+func (e *eventUnmarshaler) Timestamp() time.Time {
+	return pkgTime.Unix(0, e.pb.GetTimestamp())
+}
+
+func (e *eventUnmarshaler) Unmarshal(v interface{}) error {
+	return e.dataUnmarshaler(v)
+}
+
+type iter struct {
+	e               *pb.Event
+	dataUnmarshaler func(v interface{}) error
+	hasNext         bool
+}
+
+func (i *iter) Next(context.Context) (eventbus.EventUnmarshaler, bool) {
+	if i.hasNext {
+		i.hasNext = false
+		return &eventUnmarshaler{
+			pb:              i.e,
+			dataUnmarshaler: i.dataUnmarshaler,
+		}, true
+	}
+	return nil, false
+}
+
+func (i *iter) Err() error {
+	return nil
+}
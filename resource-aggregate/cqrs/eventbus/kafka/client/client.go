@@ -0,0 +1,58 @@
+package client
+
+import (
+	"fmt"
+
+	"github.com/plgd-dev/hub/v2/pkg/fn"
+	"github.com/plgd-dev/hub/v2/pkg/fsnotify"
+	"github.com/plgd-dev/hub/v2/pkg/log"
+	"github.com/plgd-dev/hub/v2/pkg/security/certManager/client"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Client creates the connections to the Kafka brokers. The publisher and each consumer of the subscriber
+// use own connection.
+type Client struct {
+	config    Config
+	opts      []kgo.Opt
+	closeFunc fn.FuncList
+}
+
+func New(config Config, fileWatcher *fsnotify.Watcher, logger log.Logger, tracerProvider trace.TracerProvider) (*Client, error) {
+	c := &Client{
+		config: config,
+	}
+	c.opts = append(c.opts, kgo.SeedBrokers(config.Brokers...), kgo.DialTimeout(config.DialTimeout))
+	if config.TLS.Enabled {
+		certManager, err := client.New(config.TLS.Config, fileWatcher, logger, tracerProvider)
+		if err != nil {
+			return nil, fmt.Errorf("cannot create cert manager: %w", err)
+		}
+		c.opts = append(c.opts, kgo.DialTLSConfig(certManager.GetTLSConfig()))
+		c.AddCloseFunc(certManager.Close)
+	}
+	c.opts = append(c.opts, config.Options...)
+	return c, nil
+}
+
+// NewConn creates the connection with the options of the producer or the consumer.
+func (c *Client) NewConn(opts ...kgo.Opt) (*kgo.Client, error) {
+	conn, err := kgo.NewClient(append(append([]kgo.Opt{}, c.opts...), opts...)...)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create kafka client: %w", err)
+	}
+	return conn, nil
+}
+
+func (c *Client) GetConfig() Config {
+	return c.config
+}
+
+func (c *Client) AddCloseFunc(f func()) {
+	c.closeFunc.AddFunc(f)
+}
+
+func (c *Client) Close() {
+	c.closeFunc.Execute()
+}
@@ -0,0 +1,69 @@
+package client
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/plgd-dev/hub/v2/pkg/security/certManager/client"
+	natsClient "github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/nats/client"
+	"github.com/twmb/franz-go/pkg/kgo"
+)
+
+type TLSConfig struct {
+	Enabled       bool `yaml:"enabled" json:"enabled"`
+	client.Config `yaml:",inline" json:",inline"`
+}
+
+func (c *TLSConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	return c.Config.Validate()
+}
+
+type Config struct {
+	Brokers []string `yaml:"brokers" json:"brokers"`
+	// TopicPrefix is prepended to the names of the Kafka topics, see GetTopic.
+	TopicPrefix string        `yaml:"topicPrefix" json:"topicPrefix"`
+	DialTimeout time.Duration `yaml:"dialTimeout" json:"dialTimeout"`
+	TLS         TLSConfig     `yaml:"tls" json:"tls"`
+	Options     []kgo.Opt     `yaml:"-" json:"-"`
+}
+
+func (c *Config) Validate() error {
+	if len(c.Brokers) == 0 {
+		return fmt.Errorf("brokers('%v')", c.Brokers)
+	}
+	if c.TopicPrefix == "" {
+		return fmt.Errorf("topicPrefix('%v')", c.TopicPrefix)
+	}
+	if c.DialTimeout <= 0 {
+		return fmt.Errorf("dialTimeout('%v')", c.DialTimeout)
+	}
+	if err := c.TLS.Validate(); err != nil {
+		return fmt.Errorf("tls.%w", err)
+	}
+	return nil
+}
+
+type ConfigPublisher struct {
+	Config           `yaml:",inline" json:",inline"`
+	LeadResourceType *natsClient.LeadResourceTypePublisherConfig `yaml:"leadResourceType,omitempty" json:"leadResourceType,omitempty"`
+}
+
+func (c *ConfigPublisher) Validate() error {
+	if err := c.Config.Validate(); err != nil {
+		return err
+	}
+	if c.LeadResourceType != nil {
+		if err := c.LeadResourceType.Validate(); err != nil {
+			return fmt.Errorf("leadResourceType.%w", err)
+		}
+	}
+	return nil
+}
+
+type ConfigSubscriber struct {
+	Config           `yaml:",inline" json:",inline"`
+	LeadResourceType *natsClient.LeadResourceTypeSubscriberConfig `yaml:"leadResourceType,omitempty" json:"leadResourceType,omitempty"`
+}
@@ -0,0 +1,86 @@
+package client
+
+import (
+	"fmt"
+	"strings"
+
+	isEvents "github.com/plgd-dev/hub/v2/identity-store/events"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/utils"
+)
+
+// The subjects have the form plgd.owners.{owner}.{category}[.{deviceID}...], the positions of the tokens:
+const (
+	ownerToken = iota + 2
+	categoryToken
+	deviceToken
+)
+
+// SubjectHeader is the header of the Kafka record with the subject of the event.
+const SubjectHeader = "subject"
+
+// EventTypeHeader is the header of the Kafka record with the type of the event. It is not set for the raw data.
+const EventTypeHeader = "eventType"
+
+// categories of the subjects, each category is published to own topic
+var categories = []string{utils.Devices, isEvents.Registrations}
+
+func splitSubject(subject string) ([]string, error) {
+	tokens := strings.Split(subject, ".")
+	if len(tokens) <= categoryToken || tokens[0] != isEvents.Plgd || tokens[0]+"."+tokens[1] != isEvents.PlgdOwners {
+		return nil, fmt.Errorf("invalid subject('%v')", subject)
+	}
+	return tokens, nil
+}
+
+// GetTopic returns the Kafka topic of the subject. The subjects plgd.owners.{owner}.{category}.* are
+// published to the topic {topicPrefix}.{category}.
+func (c *Config) GetTopic(subject string) (string, error) {
+	tokens, err := splitSubject(subject)
+	if err != nil {
+		return "", err
+	}
+	return c.TopicPrefix + "." + tokens[categoryToken], nil
+}
+
+func isWildcard(token string) bool {
+	return token == "*" || token == ">"
+}
+
+// GetSubscriptionTopics returns the Kafka topics with the events which match the subject of the subscription.
+// The subject can contain wildcards, so all topics are returned when the category is not set.
+func (c *Config) GetSubscriptionTopics(subject string) ([]string, error) {
+	tokens := strings.Split(subject, ".")
+	if len(tokens) < 2 || tokens[0] != isEvents.Plgd {
+		return nil, fmt.Errorf("invalid subject('%v')", subject)
+	}
+	if len(tokens) <= categoryToken || isWildcard(tokens[1]) || isWildcard(tokens[categoryToken]) {
+		return c.GetTopics(), nil
+	}
+	topic, err := c.GetTopic(subject)
+	if err != nil {
+		return nil, err
+	}
+	return []string{topic}, nil
+}
+
+// GetTopics returns all Kafka topics of the eventbus.
+func (c *Config) GetTopics() []string {
+	topics := make([]string, 0, len(categories))
+	for _, category := range categories {
+		topics = append(topics, c.TopicPrefix+"."+category)
+	}
+	return topics
+}
+
+// GetKey returns the key of the Kafka record. The events of the device have the same key,
+// so they are stored to the same partition and consumed in the order of publishing.
+func GetKey(subject string) (string, error) {
+	tokens, err := splitSubject(subject)
+	if err != nil {
+		return "", err
+	}
+	if tokens[categoryToken] == utils.Devices && len(tokens) > deviceToken {
+		return tokens[ownerToken] + "." + tokens[deviceToken], nil
+	}
+	return tokens[ownerToken], nil
+}
@@ -0,0 +1,110 @@
+package client_test
+
+import (
+	"testing"
+
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/kafka/client"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigGetTopic(t *testing.T) {
+	cfg := client.Config{TopicPrefix: "plgd"}
+	tests := []struct {
+		name    string
+		subject string
+		topic   string
+		key     string
+		wantErr bool
+	}{
+		{
+			name:    "resource event",
+			subject: "plgd.owners.owner.devices.deviceID.resources.resourceID.resourcechanged",
+			topic:   "plgd.devices",
+			key:     "owner.deviceID",
+		},
+		{
+			name:    "devices metadata",
+			subject: "plgd.owners.owner.devices.deviceID.metadata.devicemetadataupdated",
+			topic:   "plgd.devices",
+			key:     "owner.deviceID",
+		},
+		{
+			name:    "registrations",
+			subject: "plgd.owners.owner.registrations.devicesregistered",
+			topic:   "plgd.registrations",
+			key:     "owner",
+		},
+		{
+			name:    "invalid prefix",
+			subject: "test.owners.owner.devices.deviceID",
+			wantErr: true,
+		},
+		{
+			name:    "too short",
+			subject: "plgd.owners.owner",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			topic, err := cfg.GetTopic(tt.subject)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.topic, topic)
+			key, err := client.GetKey(tt.subject)
+			require.NoError(t, err)
+			require.Equal(t, tt.key, key)
+		})
+	}
+	require.ElementsMatch(t, []string{"plgd.devices", "plgd.registrations"}, cfg.GetTopics())
+}
+
+func TestConfigGetSubscriptionTopics(t *testing.T) {
+	cfg := client.Config{TopicPrefix: "plgd"}
+	tests := []struct {
+		name    string
+		subject string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:    "device events",
+			subject: "plgd.owners.*.devices.deviceID.>",
+			want:    []string{"plgd.devices"},
+		},
+		{
+			name:    "registrations",
+			subject: "plgd.owners.owner.registrations.>",
+			want:    []string{"plgd.registrations"},
+		},
+		{
+			name:    "all categories of owner",
+			subject: "plgd.owners.owner.>",
+			want:    []string{"plgd.devices", "plgd.registrations"},
+		},
+		{
+			name:    "wildcard category",
+			subject: "plgd.owners.owner.*.deviceID.>",
+			want:    []string{"plgd.devices", "plgd.registrations"},
+		},
+		{
+			name:    "invalid prefix",
+			subject: "test.owners.owner.devices.>",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			topics, err := cfg.GetSubscriptionTopics(tt.subject)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.ElementsMatch(t, tt.want, topics)
+		})
+	}
+}
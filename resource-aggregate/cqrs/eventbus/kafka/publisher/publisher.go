@@ -0,0 +1,185 @@
+package publisher
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/plgd-dev/hub/v2/pkg/fn"
+	pkgTime "github.com/plgd-dev/hub/v2/pkg/time"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus"
+	kafkaClient "github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/kafka/client"
+	natsClient "github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/nats/client"
+	natsPublisher "github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/nats/publisher"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/pb"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"google.golang.org/protobuf/proto"
+)
+
+// MarshalerFunc marshal struct to bytes.
+type MarshalerFunc = func(v interface{}) ([]byte, error)
+
+// Publisher implements a eventbus.Publisher interface. The subjects are same as for the NATS publisher,
+// they are mapped to the Kafka topics and keys by the kafkaClient.Config.
+type Publisher struct {
+	*natsPublisher.Subjects
+	config        kafkaClient.Config
+	conn          *kgo.Client
+	dataMarshaler MarshalerFunc
+	closeFunc     fn.FuncList
+}
+
+type options struct {
+	dataMarshaler MarshalerFunc
+	subjectsOpts  []natsPublisher.Option
+}
+
+type Option interface {
+	apply(o *options)
+}
+
+type MarshalerOpt struct {
+	dataMarshaler MarshalerFunc
+}
+
+func (o MarshalerOpt) apply(opts *options) {
+	opts.dataMarshaler = o.dataMarshaler
+}
+
+func WithMarshaler(dataMarshaler MarshalerFunc) MarshalerOpt {
+	return MarshalerOpt{
+		dataMarshaler: dataMarshaler,
+	}
+}
+
+type LeadResourceTypeOpt struct {
+	opt natsPublisher.LeadResourceTypeOpt
+}
+
+func (o LeadResourceTypeOpt) apply(opts *options) {
+	opts.subjectsOpts = append(opts.subjectsOpts, o.opt)
+}
+
+func WithLeadResourceType(regexFilter []*regexp.Regexp, filter natsClient.LeadResourceTypeFilter, useUUID bool) LeadResourceTypeOpt {
+	return LeadResourceTypeOpt{
+		opt: natsPublisher.WithLeadResourceType(regexFilter, filter, useUUID),
+	}
+}
+
+// New creates publisher with own connection to the Kafka brokers of the client.
+func New(client *kafkaClient.Client, opts ...Option) (*Publisher, error) {
+	cfg := options{
+		dataMarshaler: json.Marshal,
+	}
+	for _, o := range opts {
+		o.apply(&cfg)
+	}
+	if cfg.dataMarshaler == nil {
+		return nil, errors.New("invalid dataMarshaler")
+	}
+	conn, err := client.NewConn(kgo.AllowAutoTopicCreation())
+	if err != nil {
+		return nil, err
+	}
+	return &Publisher{
+		Subjects:      natsPublisher.NewSubjects(cfg.subjectsOpts...),
+		config:        client.GetConfig(),
+		conn:          conn,
+		dataMarshaler: cfg.dataMarshaler,
+	}, nil
+}
+
+func (p *Publisher) newRecord(subject string, data []byte) (*kgo.Record, error) {
+	topic, err := p.config.GetTopic(subject)
+	if err != nil {
+		return nil, err
+	}
+	key, err := kafkaClient.GetKey(subject)
+	if err != nil {
+		return nil, err
+	}
+	return &kgo.Record{
+		Topic: topic,
+		Key:   []byte(key),
+		Value: data,
+		Headers: []kgo.RecordHeader{
+			{Key: kafkaClient.SubjectHeader, Value: []byte(subject)},
+		},
+	}, nil
+}
+
+func (p *Publisher) produce(ctx context.Context, records []*kgo.Record) error {
+	var errors *multierror.Error
+	for _, r := range p.conn.ProduceSync(ctx, records...) {
+		if r.Err != nil {
+			errors = multierror.Append(errors, fmt.Errorf("cannot publish to topic('%v'): %w", r.Record.Topic, r.Err))
+		}
+	}
+	return errors.ErrorOrNil()
+}
+
+// Publish publishes an event to topics. The event is stored by the brokers when the function returns.
+func (p *Publisher) Publish(ctx context.Context, topics []string, groupID, aggregateID string, event eventbus.Event) error {
+	data, err := p.dataMarshaler(event)
+	if err != nil {
+		return errors.New("could not marshal data for event: " + err.Error())
+	}
+
+	e := pb.Event{
+		EventType:   event.EventType(),
+		Data:        data,
+		Version:     event.Version(),
+		GroupId:     groupID,
+		AggregateId: aggregateID,
+		IsSnapshot:  event.IsSnapshot(),
+		Timestamp:   pkgTime.UnixNano(event.Timestamp()),
+	}
+	eData, err := proto.Marshal(&e)
+	if err != nil {
+		return errors.New("could not marshal event: " + err.Error())
+	}
+
+	var errors *multierror.Error
+	records := make([]*kgo.Record, 0, len(topics))
+	for _, t := range topics {
+		r, err := p.newRecord(t, eData)
+		if err != nil {
+			errors = multierror.Append(errors, err)
+			continue
+		}
+		r.Headers = append(r.Headers, kgo.RecordHeader{Key: kafkaClient.EventTypeHeader, Value: []byte(event.EventType())})
+		records = append(records, r)
+	}
+	if len(records) > 0 {
+		if err = p.produce(ctx, records); err != nil {
+			errors = multierror.Append(errors, err)
+		}
+	}
+	return errors.ErrorOrNil()
+}
+
+// PublishData publishes the raw data to the subject.
+func (p *Publisher) PublishData(subj string, data []byte) error {
+	r, err := p.newRecord(subj, data)
+	if err != nil {
+		return err
+	}
+	return p.produce(context.Background(), []*kgo.Record{r})
+}
+
+// Flush waits until all buffered records are published.
+func (p *Publisher) Flush(ctx context.Context) error {
+	return p.conn.Flush(ctx)
+}
+
+func (p *Publisher) AddCloseFunc(f func()) {
+	p.closeFunc.AddFunc(f)
+}
+
+func (p *Publisher) Close() {
+	p.conn.Close()
+	p.closeFunc.Execute()
+}
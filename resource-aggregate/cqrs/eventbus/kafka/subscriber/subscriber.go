@@ -0,0 +1,509 @@
+package subscriber
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/plgd-dev/hub/v2/pkg/fn"
+	"github.com/plgd-dev/hub/v2/pkg/log"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/commands"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/inprocess"
+	kafkaClient "github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/kafka/client"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/pb"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/utils"
+	"github.com/plgd-dev/kit/v2/strings"
+	"github.com/twmb/franz-go/pkg/kerr"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"google.golang.org/protobuf/proto"
+)
+
+// UnmarshalerFunc unmarshal bytes to pointer of struct.
+type UnmarshalerFunc = func(s []byte, v interface{}) error
+
+type rawSubscription struct {
+	tokens  []string
+	handler eventbus.RawHandlerFunc
+}
+
+// consumer reads the records of all topics by own connection and routes them to the observers by the subjects.
+type consumer struct {
+	conn       *kgo.Client
+	bus        *inprocess.EventBus
+	subscriber *inprocess.Subscriber
+	done       chan struct{}
+}
+
+// topicConsumer reads the records of one topic for the shared observers and raw subscriptions.
+type topicConsumer struct {
+	conn *kgo.Client
+	done chan struct{}
+	refs int
+}
+
+// Subscriber implements a eventbus.Subscriber and eventbus.RawSubscriber interfaces.
+//
+// The observers are served by the shared consumers without the consumer group, so each instance of the
+// service receives all events of the subscribed topics, as for the NATS subscriptions with unique
+// subscriptionID. Joining a consumer group takes seconds, which is too slow for the short living
+// subscriptions (e.g. waiting for the result of a command). To bound the fan-out, the shared consumers
+// are created per topic only while a subscription needs the topic, e.g. the registrations are not read
+// by the service observing only the devices. The shared consumer reads the records published after it
+// was created. The subscriptionIDs set by WithConsumerGroups are consumed by the Kafka consumer groups
+// with the same name from all topics, so the events are balanced among the instances. The consumer
+// groups read the records published after the subscriber was created.
+type Subscriber struct {
+	client                  *kafkaClient.Client
+	dataUnmarshaler         UnmarshalerFunc
+	logger                  log.Logger
+	goroutinePoolGo         eventbus.GoroutinePoolGoFunc
+	consumerGroups          strings.Set
+	leadResourceTypeEnabled bool
+	startOffset             kgo.Offset
+	closeFunc               fn.FuncList
+
+	shared           *inprocess.EventBus
+	sharedSubscriber *inprocess.Subscriber
+
+	lock      sync.Mutex
+	topics    map[string]*topicConsumer
+	groups    map[string]*consumer
+	raw       map[uint64]rawSubscription
+	rawNextID uint64
+	closed    bool
+}
+
+type options struct {
+	dataUnmarshaler UnmarshalerFunc
+	goroutinePoolGo eventbus.GoroutinePoolGoFunc
+	consumerGroups  []string
+}
+
+type Option interface {
+	apply(o *options)
+}
+
+type UnmarshalerOpt struct {
+	dataUnmarshaler UnmarshalerFunc
+}
+
+func (o UnmarshalerOpt) apply(opts *options) {
+	opts.dataUnmarshaler = o.dataUnmarshaler
+}
+
+func WithUnmarshaler(dataUnmarshaler UnmarshalerFunc) UnmarshalerOpt {
+	return UnmarshalerOpt{
+		dataUnmarshaler: dataUnmarshaler,
+	}
+}
+
+type GoroutinePoolGoOpt struct {
+	goroutinePoolGo eventbus.GoroutinePoolGoFunc
+}
+
+func (o GoroutinePoolGoOpt) apply(opts *options) {
+	opts.goroutinePoolGo = o.goroutinePoolGo
+}
+
+func WithGoPool(goroutinePoolGo eventbus.GoroutinePoolGoFunc) GoroutinePoolGoOpt {
+	return GoroutinePoolGoOpt{
+		goroutinePoolGo: goroutinePoolGo,
+	}
+}
+
+type ConsumerGroupsOpt struct {
+	subscriptionIDs []string
+}
+
+func (o ConsumerGroupsOpt) apply(opts *options) {
+	opts.consumerGroups = append(opts.consumerGroups, o.subscriptionIDs...)
+}
+
+// WithConsumerGroups sets the subscriptionIDs which are balanced among the instances by the Kafka consumer groups.
+func WithConsumerGroups(subscriptionIDs ...string) ConsumerGroupsOpt {
+	return ConsumerGroupsOpt{
+		subscriptionIDs: subscriptionIDs,
+	}
+}
+
+// New creates subscriber with own connections to the Kafka brokers of the client.
+func New(client *kafkaClient.Client, leadResourceTypeEnabled bool, logger log.Logger, opts ...Option) (*Subscriber, error) {
+	cfg := options{
+		dataUnmarshaler: json.Unmarshal,
+	}
+	for _, o := range opts {
+		o.apply(&cfg)
+	}
+	if cfg.dataUnmarshaler == nil {
+		return nil, errors.New("invalid eventUnmarshaler")
+	}
+
+	s := &Subscriber{
+		client:                  client,
+		dataUnmarshaler:         cfg.dataUnmarshaler,
+		logger:                  logger,
+		goroutinePoolGo:         cfg.goroutinePoolGo,
+		consumerGroups:          strings.MakeSet(cfg.consumerGroups...),
+		leadResourceTypeEnabled: leadResourceTypeEnabled,
+		startOffset:             kgo.NewOffset().AfterMilli(time.Now().UnixMilli()),
+		topics:                  make(map[string]*topicConsumer),
+		groups:                  make(map[string]*consumer),
+		raw:                     make(map[uint64]rawSubscription),
+	}
+	s.shared = inprocess.New()
+	sharedSubscriber, err := s.newInprocessSubscriber(s.shared)
+	if err != nil {
+		return nil, err
+	}
+	s.sharedSubscriber = sharedSubscriber
+	return s, nil
+}
+
+func (s *Subscriber) newInprocessSubscriber(bus *inprocess.EventBus) (*inprocess.Subscriber, error) {
+	return inprocess.NewSubscriber(bus, s.leadResourceTypeEnabled, s.logger, inprocess.WithUnmarshaler(s.dataUnmarshaler), inprocess.WithGoPool(s.goroutinePoolGo))
+}
+
+func (s *Subscriber) newConsumer(opts ...kgo.Opt) (*consumer, error) {
+	cfg := s.client.GetConfig()
+	conn, err := s.client.NewConn(append([]kgo.Opt{
+		kgo.ConsumeTopics(cfg.GetTopics()...),
+		kgo.ConsumeResetOffset(s.startOffset),
+	}, opts...)...)
+	if err != nil {
+		return nil, err
+	}
+	bus := inprocess.New()
+	subscriber, err := s.newInprocessSubscriber(bus)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	c := &consumer{
+		conn:       conn,
+		bus:        bus,
+		subscriber: subscriber,
+		done:       make(chan struct{}),
+	}
+	go func() {
+		defer close(c.done)
+		s.consume(c.conn, c.bus, false)
+	}()
+	return c, nil
+}
+
+func (s *Subscriber) newTopicConsumer(topic string) (*topicConsumer, error) {
+	conn, err := s.client.NewConn(
+		kgo.ConsumeTopics(topic),
+		kgo.ConsumeResetOffset(kgo.NewOffset().AfterMilli(time.Now().UnixMilli())),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create consumer of topic('%v'): %w", topic, err)
+	}
+	c := &topicConsumer{
+		conn: conn,
+		done: make(chan struct{}),
+	}
+	go func() {
+		defer close(c.done)
+		s.consume(c.conn, s.shared, true)
+	}()
+	return c, nil
+}
+
+// releaseTopicsLocked decrements the references of the topics and returns the consumers which are not used
+// anymore. The consumers must be closed without the lock, because the consuming of records takes the lock.
+func (s *Subscriber) releaseTopicsLocked(topics []string) []*topicConsumer {
+	unused := make([]*topicConsumer, 0, len(topics))
+	for _, topic := range topics {
+		c, ok := s.topics[topic]
+		if !ok {
+			continue
+		}
+		c.refs--
+		if c.refs <= 0 {
+			delete(s.topics, topic)
+			unused = append(unused, c)
+		}
+	}
+	return unused
+}
+
+func closeTopicConsumers(consumers []*topicConsumer) {
+	for _, c := range consumers {
+		c.close()
+	}
+}
+
+func (s *Subscriber) releaseTopics(topics []string) {
+	s.lock.Lock()
+	unused := s.releaseTopicsLocked(topics)
+	s.lock.Unlock()
+	closeTopicConsumers(unused)
+}
+
+// acquireTopics returns the topics of the subjects and starts the consumers of the topics which are not consumed yet.
+// The returned topics must be released by releaseTopics.
+func (s *Subscriber) acquireTopics(subjects []string) ([]string, error) {
+	cfg := s.client.GetConfig()
+	topics := make([]string, 0, len(subjects))
+	for _, subject := range subjects {
+		t, err := cfg.GetSubscriptionTopics(subject)
+		if err != nil {
+			return nil, err
+		}
+		topics = append(topics, t...)
+	}
+	topics = strings.MakeSet(topics...).ToSlice()
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.closed {
+		return nil, errors.New("subscriber is closed")
+	}
+	for idx, topic := range topics {
+		c, ok := s.topics[topic]
+		if !ok {
+			var err error
+			c, err = s.newTopicConsumer(topic)
+			if err != nil {
+				unused := s.releaseTopicsLocked(topics[:idx])
+				go closeTopicConsumers(unused)
+				return nil, err
+			}
+			s.topics[topic] = c
+		}
+		c.refs++
+	}
+	return topics, nil
+}
+
+func (s *Subscriber) consume(conn *kgo.Client, bus *inprocess.EventBus, shared bool) {
+	for {
+		fetches := conn.PollFetches(context.Background())
+		if fetches.IsClientClosed() {
+			return
+		}
+		fetches.EachError(func(topic string, partition int32, err error) {
+			if errors.Is(err, kerr.UnknownTopicOrPartition) {
+				// the topic is created by the first published event
+				return
+			}
+			s.logger.Errorf("cannot fetch records of topic('%v') partition(%v): %v", topic, partition, err)
+		})
+		fetches.EachRecord(func(r *kgo.Record) {
+			s.handleRecord(bus, r, shared)
+		})
+	}
+}
+
+func getHeader(r *kgo.Record, key string) string {
+	for _, h := range r.Headers {
+		if h.Key == key {
+			return string(h.Value)
+		}
+	}
+	return ""
+}
+
+func (s *Subscriber) copyRawHandlers(subject []string) []eventbus.RawHandlerFunc {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	handlers := make([]eventbus.RawHandlerFunc, 0, 4)
+	for _, sub := range s.raw {
+		if eventbus.MatchSubject(sub.tokens, subject) {
+			handlers = append(handlers, sub.handler)
+		}
+	}
+	return handlers
+}
+
+func (s *Subscriber) handleRecord(bus *inprocess.EventBus, r *kgo.Record, shared bool) {
+	subject := getHeader(r, kafkaClient.SubjectHeader)
+	tokens, err := eventbus.ParseSubject(subject, false)
+	if err != nil {
+		s.logger.Errorf("cannot handle record of topic('%v'): %v", r.Topic, err)
+		return
+	}
+	if shared {
+		for _, h := range s.copyRawHandlers(tokens) {
+			h(subject, r.Value)
+		}
+	}
+	if getHeader(r, kafkaClient.EventTypeHeader) == "" {
+		// raw data are not routed to the observers
+		return
+	}
+	var e pb.Event
+	if err = proto.Unmarshal(r.Value, &e); err != nil {
+		s.logger.Errorf("cannot unmarshal event: %v", err)
+		return
+	}
+	if err = bus.PublishEvent(subject, &e); err != nil {
+		s.logger.Errorf("cannot route event: %v", err)
+	}
+}
+
+func (s *Subscriber) getConsumer(subscriptionID string) (*consumer, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.closed {
+		return nil, errors.New("subscriber is closed")
+	}
+	c, ok := s.groups[subscriptionID]
+	if ok {
+		return c, nil
+	}
+	c, err := s.newConsumer(kgo.ConsumerGroup(subscriptionID))
+	if err != nil {
+		return nil, fmt.Errorf("cannot create consumer group('%v'): %w", subscriptionID, err)
+	}
+	s.groups[subscriptionID] = c
+	return c, nil
+}
+
+func (s *Subscriber) GetResourceEventSubjects(owner string, resourceID *commands.ResourceId, eventType string) []string {
+	return utils.GetResourceEventSubjects(owner, resourceID, eventType, s.leadResourceTypeEnabled)
+}
+
+// Subscribe creates a observer that listen on events from topics.
+func (s *Subscriber) Subscribe(ctx context.Context, subscriptionID string, topics []string, eh eventbus.Handler) (eventbus.Observer, error) {
+	if s.consumerGroups.HasOneOf(subscriptionID) {
+		c, err := s.getConsumer(subscriptionID)
+		if err != nil {
+			return nil, fmt.Errorf("cannot subscribe: %w", err)
+		}
+		return c.subscriber.Subscribe(ctx, subscriptionID, topics, eh)
+	}
+	kafkaTopics, err := s.acquireTopics(topics)
+	if err != nil {
+		return nil, fmt.Errorf("cannot subscribe: %w", err)
+	}
+	ob, err := s.sharedSubscriber.Subscribe(ctx, subscriptionID, topics, eh)
+	if err != nil {
+		s.releaseTopics(kafkaTopics)
+		return nil, err
+	}
+	return &observer{
+		Observer: ob,
+		s:        s,
+		topics:   kafkaTopics,
+	}, nil
+}
+
+// observer holds the consumers of the topics with the subscribed subjects.
+type observer struct {
+	eventbus.Observer
+	s *Subscriber
+
+	lock   sync.Mutex
+	topics []string
+}
+
+// SetTopics sets the subjects of the observer and starts consuming of the new topics.
+func (o *observer) SetTopics(ctx context.Context, subjects []string) error {
+	topics, err := o.s.acquireTopics(subjects)
+	if err != nil {
+		return err
+	}
+	if err = o.Observer.SetTopics(ctx, subjects); err != nil {
+		o.s.releaseTopics(topics)
+		return err
+	}
+	o.lock.Lock()
+	old := o.topics
+	o.topics = topics
+	o.lock.Unlock()
+	o.s.releaseTopics(old)
+	return nil
+}
+
+// Close closes the observer and the consumers of the topics which are not used by other subscriptions.
+func (o *observer) Close() error {
+	err := o.Observer.Close()
+	o.lock.Lock()
+	old := o.topics
+	o.topics = nil
+	o.lock.Unlock()
+	o.s.releaseTopics(old)
+	return err
+}
+
+// SubscribeRaw implements the eventbus.RawSubscriber interface.
+func (s *Subscriber) SubscribeRaw(subject string, handler eventbus.RawHandlerFunc) (func() error, error) {
+	tokens, err := eventbus.ParseSubject(subject, true)
+	if err != nil {
+		return nil, err
+	}
+	topics, err := s.acquireTopics([]string{subject})
+	if err != nil {
+		return nil, err
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.rawNextID++
+	id := s.rawNextID
+	s.raw[id] = rawSubscription{
+		tokens:  tokens,
+		handler: handler,
+	}
+	return func() error {
+		s.lock.Lock()
+		_, ok := s.raw[id]
+		delete(s.raw, id)
+		var unused []*topicConsumer
+		if ok {
+			unused = s.releaseTopicsLocked(topics)
+		}
+		s.lock.Unlock()
+		closeTopicConsumers(unused)
+		return nil
+	}, nil
+}
+
+// AddReconnectFunc is a no-op. The consumers continue from the last fetched offsets after the reconnection
+// to the brokers, so no event is lost and the subscriptions don't need to be refreshed.
+func (s *Subscriber) AddReconnectFunc(func()) uint64 {
+	return 0
+}
+
+// RemoveReconnectFunc is a no-op, see AddReconnectFunc.
+func (s *Subscriber) RemoveReconnectFunc(uint64) {
+	// reconnect functions are not stored
+}
+
+func (s *Subscriber) AddCloseFunc(f func()) {
+	s.closeFunc.AddFunc(f)
+}
+
+func (c *topicConsumer) close() {
+	c.conn.Close()
+	<-c.done
+}
+
+func (c *consumer) close() {
+	c.conn.Close()
+	<-c.done
+	c.subscriber.Close()
+}
+
+// Close closes the connections of the consumers. The observers must be closed by the owners.
+func (s *Subscriber) Close() {
+	s.lock.Lock()
+	s.closed = true
+	groups := s.groups
+	s.groups = make(map[string]*consumer)
+	topics := s.topics
+	s.topics = make(map[string]*topicConsumer)
+	s.lock.Unlock()
+	for _, c := range groups {
+		c.close()
+	}
+	for _, c := range topics {
+		c.close()
+	}
+	s.sharedSubscriber.Close()
+	s.closeFunc.Execute()
+}
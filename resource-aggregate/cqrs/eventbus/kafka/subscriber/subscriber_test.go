@@ -0,0 +1,289 @@
+package subscriber_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/plgd-dev/hub/v2/pkg/log"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/kafka/client"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/kafka/publisher"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/kafka/subscriber"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore"
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/kfake"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+const timeout = time.Second * 30
+
+type mockEvent struct {
+	VersionI     uint64
+	EventTypeI   string
+	AggregateIDI string
+	Data         string
+}
+
+func (e mockEvent) Version() uint64 {
+	return e.VersionI
+}
+
+func (e mockEvent) EventType() string {
+	return e.EventTypeI
+}
+
+func (e mockEvent) AggregateID() string {
+	return e.AggregateIDI
+}
+
+func (e mockEvent) GroupID() string {
+	return ""
+}
+
+func (e mockEvent) IsSnapshot() bool {
+	return false
+}
+
+func (e mockEvent) ETag() *eventstore.ETagData {
+	return nil
+}
+
+func (e mockEvent) Timestamp() time.Time {
+	return time.Unix(0, 0)
+}
+
+func (e mockEvent) ServiceID() (string, bool) {
+	return "", false
+}
+
+func (e mockEvent) Types() []string {
+	return nil
+}
+
+type mockEventHandler struct {
+	newEvent chan mockEvent
+}
+
+func newMockEventHandler() *mockEventHandler {
+	return &mockEventHandler{newEvent: make(chan mockEvent, 100)}
+}
+
+func (eh *mockEventHandler) Handle(ctx context.Context, iter eventbus.Iter) error {
+	for {
+		eu, ok := iter.Next(ctx)
+		if !ok {
+			break
+		}
+		var e mockEvent
+		if err := eu.Unmarshal(&e); err != nil {
+			return err
+		}
+		eh.newEvent <- e
+	}
+	return nil
+}
+
+func newCluster(t *testing.T) client.Config {
+	c, err := kfake.NewCluster(kfake.NumBrokers(1), kfake.SeedTopics(3, "plgd.devices", "plgd.registrations"))
+	require.NoError(t, err)
+	t.Cleanup(c.Close)
+	return client.Config{
+		Brokers:     c.ListenAddrs(),
+		TopicPrefix: "plgd",
+		DialTimeout: time.Second * 10,
+		Options:     []kgo.Opt{kgo.FetchMaxWait(time.Millisecond * 100)},
+	}
+}
+
+func newPublisher(t *testing.T, cfg client.Config, logger log.Logger) *publisher.Publisher {
+	c, err := client.New(cfg, nil, logger, noop.NewTracerProvider())
+	require.NoError(t, err)
+	p, err := publisher.New(c, publisher.WithMarshaler(json.Marshal))
+	require.NoError(t, err)
+	p.AddCloseFunc(c.Close)
+	t.Cleanup(p.Close)
+	return p
+}
+
+func newSubscriber(t *testing.T, cfg client.Config, logger log.Logger, opts ...subscriber.Option) *subscriber.Subscriber {
+	c, err := client.New(cfg, nil, logger, noop.NewTracerProvider())
+	require.NoError(t, err)
+	opts = append(opts, subscriber.WithUnmarshaler(json.Unmarshal), subscriber.WithGoPool(func(f func()) error { go f(); return nil }))
+	s, err := subscriber.New(c, false, logger, opts...)
+	require.NoError(t, err)
+	s.AddCloseFunc(c.Close)
+	t.Cleanup(s.Close)
+	return s
+}
+
+func waitForEvent(t *testing.T, eh *mockEventHandler) mockEvent {
+	select {
+	case e := <-eh.newEvent:
+		return e
+	case <-time.After(timeout):
+		require.FailNow(t, "timeout")
+	}
+	return mockEvent{}
+}
+
+func TestSubscriber(t *testing.T) {
+	logger := log.NewLogger(log.MakeDefaultConfig())
+	cfg := newCluster(t)
+	p := newPublisher(t, cfg, logger)
+	s := newSubscriber(t, cfg, logger)
+	ctx := context.Background()
+
+	eh := newMockEventHandler()
+	ob, err := s.Subscribe(ctx, uuid.NewString(), []string{"plgd.owners.owner.devices.deviceID.>"}, eh)
+	require.NoError(t, err)
+	defer func() {
+		errC := ob.Close()
+		require.NoError(t, errC)
+	}()
+	other := newMockEventHandler()
+	otherOb, err := s.Subscribe(ctx, uuid.NewString(), []string{"plgd.owners.*.devices.otherDeviceID.>"}, other)
+	require.NoError(t, err)
+	defer func() {
+		errC := otherOb.Close()
+		require.NoError(t, errC)
+	}()
+
+	events := []mockEvent{
+		{VersionI: 0, EventTypeI: "resourcechanged", AggregateIDI: "a", Data: "0"},
+		{VersionI: 1, EventTypeI: "resourcechanged", AggregateIDI: "a", Data: "1"},
+		{VersionI: 2, EventTypeI: "resourcechanged", AggregateIDI: "a", Data: "2"},
+	}
+	for _, e := range events {
+		err = p.Publish(ctx, []string{"plgd.owners.owner.devices.deviceID.resources.a." + e.EventTypeI}, "deviceID", e.AggregateID(), e)
+		require.NoError(t, err)
+	}
+	for _, e := range events {
+		require.Equal(t, e, waitForEvent(t, eh))
+	}
+
+	e := mockEvent{VersionI: 0, EventTypeI: "resourcechanged", AggregateIDI: "b", Data: "other"}
+	err = p.Publish(ctx, []string{"plgd.owners.owner.devices.otherDeviceID.resources.b." + e.EventTypeI}, "otherDeviceID", e.AggregateID(), e)
+	require.NoError(t, err)
+	require.Equal(t, e, waitForEvent(t, other))
+	select {
+	case got := <-eh.newEvent:
+		require.FailNowf(t, "unexpected event", "%+v", got)
+	case <-time.After(time.Millisecond * 500):
+	}
+}
+
+func TestSubscriberSubscribeRaw(t *testing.T) {
+	logger := log.NewLogger(log.MakeDefaultConfig())
+	cfg := newCluster(t)
+	p := newPublisher(t, cfg, logger)
+	s := newSubscriber(t, cfg, logger)
+
+	type msg struct {
+		subject string
+		data    string
+	}
+	msgs := make(chan msg, 10)
+	unsubscribe, err := s.SubscribeRaw("plgd.owners.*.registrations.>", func(subject string, data []byte) {
+		msgs <- msg{subject: subject, data: string(data)}
+	})
+	require.NoError(t, err)
+
+	err = p.PublishData("plgd.owners.owner.registrations.devicesregistered", []byte("data"))
+	require.NoError(t, err)
+	select {
+	case m := <-msgs:
+		require.Equal(t, msg{subject: "plgd.owners.owner.registrations.devicesregistered", data: "data"}, m)
+	case <-time.After(timeout):
+		require.FailNow(t, "timeout")
+	}
+
+	err = unsubscribe()
+	require.NoError(t, err)
+	err = p.PublishData("plgd.owners.owner.registrations.devicesunregistered", []byte("data"))
+	require.NoError(t, err)
+	select {
+	case m := <-msgs:
+		require.FailNowf(t, "unexpected message", "%+v", m)
+	case <-time.After(time.Millisecond * 500):
+	}
+}
+
+func TestSubscriberConsumerGroups(t *testing.T) {
+	logger := log.NewLogger(log.MakeDefaultConfig())
+	cfg := newCluster(t)
+	p := newPublisher(t, cfg, logger)
+	const subscriptionID = "group"
+	ctx := context.Background()
+
+	eh := newMockEventHandler()
+	for i := 0; i < 2; i++ {
+		s := newSubscriber(t, cfg, logger, subscriber.WithConsumerGroups(subscriptionID))
+		ob, err := s.Subscribe(ctx, subscriptionID, []string{"plgd.owners.owner.devices.>"}, eh)
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			errC := ob.Close()
+			require.NoError(t, errC)
+		})
+	}
+
+	const numEvents = 20
+	for i := 0; i < numEvents; i++ {
+		deviceID := uuid.NewString()
+		e := mockEvent{VersionI: 0, EventTypeI: "resourcechanged", AggregateIDI: deviceID, Data: deviceID}
+		err := p.Publish(ctx, []string{"plgd.owners.owner.devices." + deviceID + ".resources.a." + e.EventTypeI}, deviceID, e.AggregateID(), e)
+		require.NoError(t, err)
+	}
+
+	// each event is handled by one member of the consumer group
+	received := make(map[string]int)
+	for i := 0; i < numEvents; i++ {
+		e := waitForEvent(t, eh)
+		received[e.Data]++
+	}
+	require.Len(t, received, numEvents)
+	select {
+	case e := <-eh.newEvent:
+		require.FailNowf(t, "duplicate event", "%+v", e)
+	case <-time.After(time.Second):
+	}
+}
+
+func TestSubscriberResubscribe(t *testing.T) {
+	logger := log.NewLogger(log.MakeDefaultConfig())
+	cfg := newCluster(t)
+	p := newPublisher(t, cfg, logger)
+	s := newSubscriber(t, cfg, logger)
+	ctx := context.Background()
+	const subject = "plgd.owners.owner.devices.deviceID.resources.a.resourcechanged"
+
+	eh := newMockEventHandler()
+	ob, err := s.Subscribe(ctx, uuid.NewString(), []string{"plgd.owners.owner.devices.>"}, eh)
+	require.NoError(t, err)
+	e := mockEvent{VersionI: 0, EventTypeI: "resourcechanged", AggregateIDI: "a", Data: "0"}
+	err = p.Publish(ctx, []string{subject}, "deviceID", e.AggregateID(), e)
+	require.NoError(t, err)
+	require.Equal(t, e, waitForEvent(t, eh))
+	// the consumer of the topic is closed with the last observer
+	err = ob.Close()
+	require.NoError(t, err)
+
+	err = p.Publish(ctx, []string{subject}, "deviceID", e.AggregateID(), mockEvent{VersionI: 1, EventTypeI: "resourcechanged", AggregateIDI: "a", Data: "1"})
+	require.NoError(t, err)
+	time.Sleep(time.Millisecond * 100)
+
+	// the new consumer reads only the events published after the subscription
+	ob, err = s.Subscribe(ctx, uuid.NewString(), []string{"plgd.owners.owner.devices.>"}, eh)
+	require.NoError(t, err)
+	defer func() {
+		errC := ob.Close()
+		require.NoError(t, errC)
+	}()
+	e = mockEvent{VersionI: 2, EventTypeI: "resourcechanged", AggregateIDI: "a", Data: "2"}
+	err = p.Publish(ctx, []string{subject}, "deviceID", e.AggregateID(), e)
+	require.NoError(t, err)
+	require.Equal(t, e, waitForEvent(t, eh))
+}
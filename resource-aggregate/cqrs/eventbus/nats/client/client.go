@@ -45,3 +45,19 @@ func (c *Client) Close() {
 	c.conn.Close()
 	c.closeFunc.Execute()
 }
+
+// SubscribeRaw implements the eventbus.RawSubscriber interface.
+func (c *Client) SubscribeRaw(subject string, handler func(subject string, data []byte)) (func() error, error) {
+	return SubscribeRaw(c.conn, subject, handler)
+}
+
+// SubscribeRaw subscribes the handler to the subject of the NATS connection.
+func SubscribeRaw(conn *nats.Conn, subject string, handler func(subject string, data []byte)) (func() error, error) {
+	sub, err := conn.Subscribe(subject, func(msg *nats.Msg) {
+		handler(msg.Subject, msg.Data)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return sub.Unsubscribe, nil
+}
@@ -129,7 +129,50 @@ func (c *LeadResourceTypeSubscriberConfig) IsEnabled() bool {
 	return c != nil && c.Enabled
 }
 
+// JetStreamConsumerConfig configures the durable JetStream consumers of the subscriber.
+type JetStreamConsumerConfig struct {
+	Enabled bool `yaml:"enabled" json:"enabled"`
+	// Stream is the name of the stream which stores the events.
+	Stream string `yaml:"stream" json:"stream"`
+	// AckWait is the time after which the not acknowledged event is redelivered.
+	AckWait time.Duration `yaml:"ackWait" json:"ackWait"`
+	// MaxDeliver limits the number of the deliveries of an event, -1 means unlimited.
+	MaxDeliver int `yaml:"maxDeliver" json:"maxDeliver"`
+	// InactiveThreshold removes the consumer which has no subscription for the duration, 0 means never.
+	InactiveThreshold time.Duration `yaml:"inactiveThreshold" json:"inactiveThreshold"`
+}
+
+func (c *JetStreamConsumerConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.Stream == "" {
+		return fmt.Errorf("stream('%v')", c.Stream)
+	}
+	if c.AckWait <= 0 {
+		return fmt.Errorf("ackWait('%v')", c.AckWait)
+	}
+	if c.MaxDeliver == 0 || c.MaxDeliver < -1 {
+		return fmt.Errorf("maxDeliver('%v')", c.MaxDeliver)
+	}
+	if c.InactiveThreshold < 0 {
+		return fmt.Errorf("inactiveThreshold('%v')", c.InactiveThreshold)
+	}
+	return nil
+}
+
 type ConfigSubscriber struct {
 	Config           `yaml:",inline" json:",inline"`
+	JetStream        JetStreamConsumerConfig           `yaml:"jetstream" json:"jetstream"`
 	LeadResourceType *LeadResourceTypeSubscriberConfig `yaml:"leadResourceType,omitempty" json:"leadResourceType,omitempty"`
 }
+
+func (c *ConfigSubscriber) Validate() error {
+	if err := c.Config.Validate(); err != nil {
+		return err
+	}
+	if err := c.JetStream.Validate(); err != nil {
+		return fmt.Errorf("jetstream.%w", err)
+	}
+	return nil
+}
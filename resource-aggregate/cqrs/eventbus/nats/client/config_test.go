@@ -100,3 +100,78 @@ leadResourceType:
 		})
 	}
 }
+
+func TestConfigSubscriber(t *testing.T) {
+	basicConfigYAML := `
+url: "test"
+pendingLimits:
+  msgLimit: 1
+  bytesLimit: 1
+tls:
+  certFile: "test"
+  keyFile: "test"
+  caPool: "test"
+`
+	tests := []struct {
+		name    string
+		data    string
+		wantErr bool
+	}{
+		{
+			name: "valid - jetstream disabled",
+			data: basicConfigYAML + `
+jetstream:
+  enabled: false`,
+		},
+		{
+			name: "valid - jetstream",
+			data: basicConfigYAML + `
+jetstream:
+  enabled: true
+  stream: EVENTS
+  ackWait: 30s
+  maxDeliver: -1
+  inactiveThreshold: 24h`,
+		},
+		{
+			name: "invalid - jetstream without stream",
+			data: basicConfigYAML + `
+jetstream:
+  enabled: true
+  ackWait: 30s
+  maxDeliver: -1`,
+			wantErr: true,
+		},
+		{
+			name: "invalid - jetstream with zero ackWait",
+			data: basicConfigYAML + `
+jetstream:
+  enabled: true
+  stream: EVENTS
+  maxDeliver: -1`,
+			wantErr: true,
+		},
+		{
+			name: "invalid - jetstream with zero maxDeliver",
+			data: basicConfigYAML + `
+jetstream:
+  enabled: true
+  stream: EVENTS
+  ackWait: 30s`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got client.ConfigSubscriber
+			err := yaml.Unmarshal([]byte(tt.data), &got)
+			require.NoError(t, err)
+			err = got.Validate()
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
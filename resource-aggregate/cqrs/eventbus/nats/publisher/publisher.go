@@ -32,14 +32,30 @@ type leadResourceType struct {
 	useUUID     bool
 }
 
+// Subjects resolves subjects of the published events.
+type Subjects struct {
+	leadResourceType *leadResourceType
+}
+
+// NewSubjects creates subjects resolver. Only the lead resource type option is used.
+func NewSubjects(opts ...Option) *Subjects {
+	var cfg options
+	for _, o := range opts {
+		o.apply(&cfg)
+	}
+	return &Subjects{
+		leadResourceType: cfg.leadResourceType,
+	}
+}
+
 // Publisher implements a eventbus.Publisher interface.
 type Publisher struct {
-	dataMarshaler    MarshalerFunc
-	conn             *nats.Conn
-	closeFunc        fn.FuncList
-	publish          func(subj string, data []byte) error
-	flusherTimeout   time.Duration
-	leadResourceType *leadResourceType
+	*Subjects
+	dataMarshaler  MarshalerFunc
+	conn           *nats.Conn
+	closeFunc      fn.FuncList
+	publish        func(subj string, data []byte, msgID string) error
+	flusherTimeout time.Duration
 }
 
 func (p *Publisher) AddCloseFunc(f func()) {
@@ -118,24 +134,33 @@ func New(conn *nats.Conn, jetstream bool, opts ...Option) (*Publisher, error) {
 		o.apply(&cfg)
 	}
 
-	publish := conn.Publish
+	publish := func(subj string, data []byte, _ string) error {
+		return conn.Publish(subj, data)
+	}
 	if jetstream {
 		js, err := conn.JetStream()
 		if err != nil {
 			return nil, fmt.Errorf("cannot get jetstream context: %w", err)
 		}
-		publish = func(subj string, data []byte) error {
-			_, err := js.Publish(subj, data)
+		publish = func(subj string, data []byte, msgID string) error {
+			opts := make([]nats.PubOpt, 0, 1)
+			if msgID != "" {
+				// the stream drops the duplicates within its duplicate window
+				opts = append(opts, nats.MsgId(msgID))
+			}
+			_, err := js.Publish(subj, data, opts...)
 			return err
 		}
 	}
 
 	return &Publisher{
-		dataMarshaler:    cfg.dataMarshaler,
-		conn:             conn,
-		publish:          publish,
-		flusherTimeout:   cfg.flusherTimeout,
-		leadResourceType: cfg.leadResourceType,
+		Subjects: &Subjects{
+			leadResourceType: cfg.leadResourceType,
+		},
+		dataMarshaler:  cfg.dataMarshaler,
+		conn:           conn,
+		publish:        publish,
+		flusherTimeout: cfg.flusherTimeout,
 	}, nil
 }
 
@@ -148,17 +173,17 @@ func matchType(t string, filter []*regexp.Regexp) bool {
 	return false
 }
 
-func (p *Publisher) getLeadResourceTypeByFilter(event eventbus.Event) string {
+func (s *Subjects) getLeadResourceTypeByFilter(event eventbus.Event) string {
 	types := event.Types()
-	if p.leadResourceType.regexFilter != nil {
+	if s.leadResourceType.regexFilter != nil {
 		for _, t := range types {
-			if matchType(t, p.leadResourceType.regexFilter) {
+			if matchType(t, s.leadResourceType.regexFilter) {
 				return t
 			}
 		}
 	}
 
-	switch p.leadResourceType.filter {
+	switch s.leadResourceType.filter {
 	case client.LeadResourceTypeFilter_First:
 		return types[0]
 	case client.LeadResourceTypeFilter_Last:
@@ -176,29 +201,29 @@ func ResourceTypeToUUID(resourceType string) string {
 	return uuid.NewSHA1(uuid.NameSpaceURL, []byte(resourceType)).String()
 }
 
-func (p *Publisher) GetLeadResourceType(event eventbus.Event) string {
-	if p.leadResourceType == nil || len(event.Types()) == 0 {
+func (s *Subjects) GetLeadResourceType(event eventbus.Event) string {
+	if s.leadResourceType == nil || len(event.Types()) == 0 {
 		return ""
 	}
 
-	leadResourceType := replaceSpecialCharacters(p.getLeadResourceTypeByFilter(event))
-	if p.leadResourceType.useUUID && leadResourceType != "" {
+	leadResourceType := replaceSpecialCharacters(s.getLeadResourceTypeByFilter(event))
+	if s.leadResourceType.useUUID && leadResourceType != "" {
 		return ResourceTypeToUUID(leadResourceType)
 	}
 	return leadResourceType
 }
 
-func (p *Publisher) getPublishResourceEventSubject(owner string, resourceID *commands.ResourceId, event eventbus.Event) string {
+func (s *Subjects) getPublishResourceEventSubject(owner string, resourceID *commands.ResourceId, event eventbus.Event) string {
 	template := utils.PlgdOwnersOwnerDevicesDeviceResourcesResourceEvent
 	opts := []func(values map[string]string){
 		isEvents.WithOwner(owner), utils.WithDeviceID(event.GroupID()),
 		utils.WithHrefId(utils.HrefToID(resourceID.GetHref()).String()), isEvents.WithEventType(event.EventType()),
 	}
-	if p.leadResourceType == nil {
+	if s.leadResourceType == nil {
 		return isEvents.ToSubject(template, opts...)
 	}
 	// if leadResourceType is set, then the feature is enabled
-	lrt := p.GetLeadResourceType(event)
+	lrt := s.GetLeadResourceType(event)
 	if lrt != "" {
 		template = utils.PlgdOwnersOwnerDevicesDeviceResourcesResourceEventLeadResourceType
 		opts = append(opts, utils.WithLeadResourceType(lrt))
@@ -210,15 +235,15 @@ func (p *Publisher) getPublishResourceEventSubject(owner string, resourceID *com
 	return isEvents.ToSubject(template, opts...)
 }
 
-func (p *Publisher) GetPublishSubject(owner string, event eventbus.Event) []string {
+func (s *Subjects) GetPublishSubject(owner string, event eventbus.Event) []string {
 	switch event.EventType() {
 	case (&events.ResourceLinksPublished{}).EventType(), (&events.ResourceLinksUnpublished{}).EventType(), (&events.ResourceLinksSnapshotTaken{}).EventType():
 		return []string{isEvents.ToSubject(utils.PlgdOwnersOwnerDevicesDeviceResourceLinksEvent, isEvents.WithOwner(owner), utils.WithDeviceID(event.GroupID()), isEvents.WithEventType(event.EventType()))}
-	case (&events.DeviceMetadataUpdatePending{}).EventType(), (&events.DeviceMetadataUpdated{}).EventType(), (&events.DeviceMetadataSnapshotTaken{}).EventType():
+	case (&events.DeviceMetadataUpdatePending{}).EventType(), (&events.DeviceMetadataUpdated{}).EventType(), (&events.DeviceMetadataSnapshotTaken{}).EventType(), (&events.DeviceLabelsUpdated{}).EventType():
 		return []string{isEvents.ToSubject(utils.PlgdOwnersOwnerDevicesDeviceMetadataEvent, isEvents.WithOwner(owner), utils.WithDeviceID(event.GroupID()), isEvents.WithEventType(event.EventType()))}
 	}
 	if ev, ok := event.(interface{ GetResourceId() *commands.ResourceId }); ok {
-		return []string{p.getPublishResourceEventSubject(owner, ev.GetResourceId(), event)}
+		return []string{s.getPublishResourceEventSubject(owner, ev.GetResourceId(), event)}
 	}
 	return nil
 }
@@ -244,8 +269,9 @@ func (p *Publisher) Publish(ctx context.Context, topics []string, groupID, aggre
 	}
 
 	var errors *multierror.Error
+	key := eventbus.IdempotencyKey(aggregateID, event.Version())
 	for _, t := range topics {
-		err = p.PublishData(t, eData)
+		err = p.publish(t, eData, t+"/"+key)
 		if err != nil {
 			errors = multierror.Append(errors, err)
 		}
@@ -260,7 +286,7 @@ func (p *Publisher) Publish(ctx context.Context, topics []string, groupID, aggre
 }
 
 func (p *Publisher) PublishData(subj string, data []byte) error {
-	return p.publish(subj, data)
+	return p.publish(subj, data, "")
 }
 
 func (p *Publisher) Flush(ctx context.Context) error {
@@ -0,0 +1,150 @@
+package subscriber
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	nats "github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/plgd-dev/hub/v2/pkg/log"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus"
+	natsClient "github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/nats/client"
+)
+
+type jetStream struct {
+	js         jetstream.JetStream
+	config     natsClient.JetStreamConsumerConfig
+	durableIDs map[string]struct{}
+}
+
+func newJetStream(conn *nats.Conn, config natsClient.JetStreamConsumerConfig, durableIDs []string) (*jetStream, error) {
+	js, err := jetstream.New(conn)
+	if err != nil {
+		return nil, err
+	}
+	ids := make(map[string]struct{}, len(durableIDs))
+	for _, id := range durableIDs {
+		ids[id] = struct{}{}
+	}
+	return &jetStream{
+		js:         js,
+		config:     config,
+		durableIDs: ids,
+	}, nil
+}
+
+func (j *jetStream) isDurable(subscriptionID string) bool {
+	if j == nil {
+		return false
+	}
+	_, ok := j.durableIDs[subscriptionID]
+	return ok
+}
+
+// JetStreamObserver handles events from the durable JetStream consumer. The consumer is named by the subscriptionID,
+// so the instances of the service share it and a restarted service continues from the last acknowledged event.
+type JetStreamObserver struct {
+	lock            sync.Mutex
+	js              jetstream.JetStream
+	config          natsClient.JetStreamConsumerConfig
+	dataUnmarshaler UnmarshalerFunc
+	eventHandler    eventbus.Handler
+	logger          log.Logger
+	subscriptionID  string
+	topics          []string
+	consumeCtx      jetstream.ConsumeContext
+}
+
+func (s *Subscriber) newJetStreamObservation(subscriptionID string, eh eventbus.Handler) *JetStreamObserver {
+	return &JetStreamObserver{
+		js:              s.jetStream.js,
+		config:          s.jetStream.config,
+		dataUnmarshaler: s.dataUnmarshaler,
+		eventHandler:    eh,
+		logger:          s.logger,
+		subscriptionID:  subscriptionID,
+	}
+}
+
+func (o *JetStreamObserver) stop() {
+	if o.consumeCtx != nil {
+		o.consumeCtx.Stop()
+		o.consumeCtx = nil
+	}
+	o.topics = nil
+}
+
+// SetTopics updates the filter subjects of the consumer. The empty topics stop the consumption, the durable consumer
+// is kept, so the events published meanwhile are delivered after the topics are set again.
+func (o *JetStreamObserver) SetTopics(ctx context.Context, topics []string) error {
+	topics = slices.Compact(slices.Sorted(slices.Values(topics)))
+
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	if len(topics) == 0 {
+		// the consumer without filter subjects would receive all events of the stream
+		o.stop()
+		return nil
+	}
+	if slices.Equal(o.topics, topics) {
+		return nil
+	}
+
+	consumer, err := o.js.CreateOrUpdateConsumer(ctx, o.config.Stream, jetstream.ConsumerConfig{
+		Durable:           o.subscriptionID,
+		FilterSubjects:    topics,
+		AckPolicy:         jetstream.AckExplicitPolicy,
+		AckWait:           o.config.AckWait,
+		MaxDeliver:        o.config.MaxDeliver,
+		DeliverPolicy:     jetstream.DeliverNewPolicy,
+		InactiveThreshold: o.config.InactiveThreshold,
+	})
+	if err != nil {
+		return fmt.Errorf("cannot set consumer('%v') of stream('%v'): %w", o.subscriptionID, o.config.Stream, err)
+	}
+	o.topics = topics
+	if o.consumeCtx != nil {
+		return nil
+	}
+	consumeCtx, err := consumer.Consume(o.handleMsg)
+	if err != nil {
+		o.topics = nil
+		return fmt.Errorf("cannot consume events of consumer('%v'): %w", o.subscriptionID, err)
+	}
+	o.consumeCtx = consumeCtx
+	return nil
+}
+
+// Close stops the consumption of the events. The durable consumer is kept, so the events published meanwhile are
+// delivered after the next subscription.
+func (o *JetStreamObserver) Close() error {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	o.stop()
+	return nil
+}
+
+func (o *JetStreamObserver) handleMsg(msg jetstream.Msg) {
+	i, err := newIter(msg.Data(), o.dataUnmarshaler)
+	if err != nil {
+		o.logger.Errorf("cannot unmarshal event: %v", err)
+		// the event cannot be processed by any redelivery
+		if errT := msg.Term(); errT != nil {
+			o.logger.Errorf("cannot terminate event: %v", errT)
+		}
+		return
+	}
+
+	if err := o.eventHandler.Handle(context.Background(), i); err != nil {
+		o.logger.Errorf("cannot handle event: %v", err)
+		if errN := msg.Nak(); errN != nil {
+			o.logger.Errorf("cannot negatively acknowledge event: %v", errN)
+		}
+		return
+	}
+	if err := msg.Ack(); err != nil {
+		o.logger.Errorf("cannot acknowledge event: %v", err)
+	}
+}
@@ -0,0 +1,95 @@
+package subscriber_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/plgd-dev/hub/v2/pkg/fsnotify"
+	"github.com/plgd-dev/hub/v2/pkg/log"
+	natsClient "github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/nats/client"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/nats/publisher"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/nats/subscriber"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/nats/test"
+	"github.com/plgd-dev/hub/v2/test/config"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+func TestSubscriberJetStreamReplay(t *testing.T) {
+	// subjects of the EVENTS stream are plgd.>
+	topic := "plgd.test.jetstream." + uuid.NewString()
+	subscriptionID := "test-jetstream-" + uuid.NewString()
+	timeout := time.Second * 30
+
+	logger := log.NewLogger(log.MakeDefaultConfig())
+	fileWatcher, err := fsnotify.NewWatcher(logger)
+	require.NoError(t, err)
+	defer func() {
+		errC := fileWatcher.Close()
+		require.NoError(t, errC)
+	}()
+
+	pubConfig := config.MakePublisherConfig(t)
+	pubConfig.JetStream = true
+	naPubClient, pub, err := test.NewClientAndPublisher(pubConfig, fileWatcher, logger, noop.NewTracerProvider(), publisher.WithMarshaler(json.Marshal))
+	require.NoError(t, err)
+	defer func() {
+		pub.Close()
+		naPubClient.Close()
+	}()
+
+	naSubClient, sub, err := test.NewClientAndSubscriber(config.MakeSubscriberConfig(), fileWatcher, logger, noop.NewTracerProvider(),
+		subscriber.WithUnmarshaler(json.Unmarshal),
+		subscriber.WithJetStream(natsClient.JetStreamConsumerConfig{
+			Enabled:           true,
+			Stream:            "EVENTS",
+			AckWait:           time.Second * 10,
+			MaxDeliver:        -1,
+			InactiveThreshold: time.Minute,
+		}, subscriptionID),
+	)
+	require.NoError(t, err)
+	defer func() {
+		sub.Close()
+		naSubClient.Close()
+	}()
+
+	ctx := context.Background()
+	publish := func(version uint64) mockEvent {
+		ev := mockEvent{
+			VersionI:     version,
+			EventTypeI:   "test",
+			AggregateIDI: "aggregateID",
+		}
+		err := pub.Publish(ctx, []string{topic}, "deviceId", ev.AggregateIDI, ev)
+		require.NoError(t, err)
+		return ev
+	}
+
+	eh, ob := testNewSubscription(ctx, t, sub, subscriptionID, []string{topic})
+	ev := publish(0)
+	got, err := eh.waitForEvent(timeout)
+	require.NoError(t, err)
+	require.Equal(t, ev, got)
+	err = ob.Close()
+	require.NoError(t, err)
+
+	// the event published while no observer exists is delivered after the subscription is renewed
+	ev = publish(1)
+	eh, ob = testNewSubscription(ctx, t, sub, subscriptionID, []string{topic})
+	got, err = eh.waitForEvent(timeout)
+	require.NoError(t, err)
+	require.Equal(t, ev, got)
+
+	// updated filter subjects stop the delivery of the previous topic
+	err = ob.SetTopics(ctx, []string{topic + ".other"})
+	require.NoError(t, err)
+	publish(2)
+	_, err = eh.waitForEvent(time.Second)
+	require.Error(t, err)
+	err = ob.Close()
+	require.NoError(t, err)
+}
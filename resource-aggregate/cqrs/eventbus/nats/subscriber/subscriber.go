@@ -41,6 +41,7 @@ type Subscriber struct {
 	closeFunc               fn.FuncList
 	pendingLimits           natsClient.PendingLimitsConfig
 	leadResourceTypeEnabled bool
+	jetStream               *jetStream
 
 	lock        sync.Mutex
 	reconnectID uint64
@@ -105,6 +106,8 @@ type Observer struct {
 type options struct {
 	dataUnmarshaler UnmarshalerFunc
 	goroutinePoolGo eventbus.GoroutinePoolGoFunc
+	jetStream       natsClient.JetStreamConsumerConfig
+	durableIDs      []string
 }
 
 type Option interface {
@@ -139,6 +142,25 @@ func WithGoPool(goroutinePoolGo eventbus.GoroutinePoolGoFunc) GoroutinePoolGoOpt
 	}
 }
 
+type JetStreamOpt struct {
+	config          natsClient.JetStreamConsumerConfig
+	subscriptionIDs []string
+}
+
+func (o JetStreamOpt) apply(opts *options) {
+	opts.jetStream = o.config
+	opts.durableIDs = o.subscriptionIDs
+}
+
+// WithJetStream subscribes the subscriptionIDs by the durable JetStream consumers when the JetStream is enabled
+// by the config. The other subscriptionIDs are subscribed by the core NATS queue groups.
+func WithJetStream(config natsClient.JetStreamConsumerConfig, subscriptionIDs ...string) JetStreamOpt {
+	return JetStreamOpt{
+		config:          config,
+		subscriptionIDs: subscriptionIDs,
+	}
+}
+
 // Create subscriber with existing NATS connection and proto marshaller
 func New(conn *nats.Conn, pendingLimits natsClient.PendingLimitsConfig, leadResourceTypeEnabled bool, logger log.Logger, opts ...Option) (*Subscriber, error) {
 	cfg := options{
@@ -162,6 +184,13 @@ func New(conn *nats.Conn, pendingLimits natsClient.PendingLimitsConfig, leadReso
 		leadResourceTypeEnabled: leadResourceTypeEnabled,
 		reconnect:               make([]reconnect, 0, 8),
 	}
+	if cfg.jetStream.Enabled && len(cfg.durableIDs) > 0 {
+		js, err := newJetStream(conn, cfg.jetStream, cfg.durableIDs)
+		if err != nil {
+			return nil, fmt.Errorf("cannot create jetstream context: %w", err)
+		}
+		s.jetStream = js
+	}
 	conn.SetReconnectHandler(s.reconnectedHandler)
 
 	return s, nil
@@ -173,6 +202,14 @@ func (s *Subscriber) GetResourceEventSubjects(owner string, resourceID *commands
 
 // Subscribe creates a observer that listen on events from topics.
 func (s *Subscriber) Subscribe(ctx context.Context, subscriptionID string, topics []string, eh eventbus.Handler) (eventbus.Observer, error) {
+	if s.jetStream.isDurable(subscriptionID) {
+		// the handler is called synchronously, so the event is acknowledged after it is processed
+		observer := s.newJetStreamObservation(subscriptionID, eh)
+		if err := observer.SetTopics(ctx, topics); err != nil {
+			return nil, fmt.Errorf("cannot subscribe: %w", err)
+		}
+		return observer, nil
+	}
 	observer := s.newObservation(subscriptionID, eventbus.NewGoroutinePoolHandler(s.goroutinePoolGo, eh, func(err error) { s.logger.Error(err) }))
 
 	err := observer.SetTopics(ctx, topics)
@@ -289,24 +326,28 @@ func (o *Observer) Close() error {
 	return nil
 }
 
-func (o *Observer) handleMsg(msg *nats.Msg) {
+func newIter(data []byte, dataUnmarshaler UnmarshalerFunc) (*iter, error) {
 	var e pb.Event
-
-	err := proto.Unmarshal(msg.Data, &e)
-	if err != nil {
-		o.logger.Errorf("cannot unmarshal event: %v", err)
-		return
+	if err := proto.Unmarshal(data, &e); err != nil {
+		return nil, err
 	}
-
-	i := iter{
+	return &iter{
 		hasNext: true,
 		e:       &e,
 		dataUnmarshaler: func(v interface{}) error {
-			return o.dataUnmarshaler(e.GetData(), v)
+			return dataUnmarshaler(e.GetData(), v)
 		},
+	}, nil
+}
+
+func (o *Observer) handleMsg(msg *nats.Msg) {
+	i, err := newIter(msg.Data, o.dataUnmarshaler)
+	if err != nil {
+		o.logger.Errorf("cannot unmarshal event: %v", err)
+		return
 	}
 
-	if err := o.eventHandler.Handle(context.Background(), &i); err != nil {
+	if err := o.eventHandler.Handle(context.Background(), i); err != nil {
 		o.logger.Errorf("cannot unmarshal event: %v", err)
 	}
 }
@@ -368,3 +409,8 @@ func (i *iter) Err() error {
 func (s *Subscriber) Conn() *nats.Conn {
 	return s.conn
 }
+
+// SubscribeRaw implements the eventbus.RawSubscriber interface.
+func (s *Subscriber) SubscribeRaw(subject string, handler eventbus.RawHandlerFunc) (func() error, error) {
+	return natsClient.SubscribeRaw(s.conn, subject, handler)
+}
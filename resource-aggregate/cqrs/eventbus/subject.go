@@ -0,0 +1,48 @@
+package eventbus
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	subjectTokenSeparator = "."
+	subjectWildcardToken  = "*"
+	subjectFullWildcard   = ">"
+)
+
+// ParseSubject splits the subject to tokens. Wildcards are allowed only for subscriptions.
+func ParseSubject(subject string, allowWildcards bool) ([]string, error) {
+	if subject == "" {
+		return nil, fmt.Errorf("invalid subject('%v')", subject)
+	}
+	tokens := strings.Split(subject, subjectTokenSeparator)
+	for idx, t := range tokens {
+		switch {
+		case t == "":
+			return nil, fmt.Errorf("invalid subject('%v'): empty token", subject)
+		case (t == subjectWildcardToken || t == subjectFullWildcard) && !allowWildcards:
+			return nil, fmt.Errorf("invalid subject('%v'): wildcards are not allowed", subject)
+		case t == subjectFullWildcard && idx != len(tokens)-1:
+			return nil, fmt.Errorf("invalid subject('%v'): '%v' must be the last token", subject, subjectFullWildcard)
+		}
+	}
+	return tokens, nil
+}
+
+// MatchSubject checks whether the tokens of the subject match the tokens of the pattern.
+// Subjects and wildcards follow the NATS semantics.
+func MatchSubject(pattern, subject []string) bool {
+	for idx, p := range pattern {
+		if p == subjectFullWildcard {
+			return len(subject) > idx
+		}
+		if idx >= len(subject) {
+			return false
+		}
+		if p != subjectWildcardToken && p != subject[idx] {
+			return false
+		}
+	}
+	return len(pattern) == len(subject)
+}
@@ -0,0 +1,94 @@
+// Package backup exports events from an eventstore to a portable archive and restores them to an eventstore.
+//
+// The archive is a gzip stream which starts with the archiveMagic followed by the events encoded as
+// varint length-prefixed protobuf messages (eventbus pb.Event). The data of the events are stored as they
+// were marshaled by the eventstore, so the archive can be restored to any eventstore backend.
+package backup
+
+import (
+	"bufio"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/pb"
+	"google.golang.org/protobuf/encoding/protodelim"
+)
+
+const archiveMagic = "PLGDEVB1"
+
+// Writer writes events to the archive.
+type Writer struct {
+	zw *gzip.Writer
+}
+
+// NewWriter creates the archive writer. Close must be called to flush the archive, the underlying writer is not closed.
+func NewWriter(w io.Writer) (*Writer, error) {
+	zw := gzip.NewWriter(w)
+	if _, err := zw.Write([]byte(archiveMagic)); err != nil {
+		return nil, fmt.Errorf("cannot write archive header: %w", err)
+	}
+	return &Writer{
+		zw: zw,
+	}, nil
+}
+
+// Write appends the event to the archive.
+func (w *Writer) Write(e *pb.Event) error {
+	if _, err := protodelim.MarshalTo(w.zw, e); err != nil {
+		return fmt.Errorf("cannot write event('%v', %v): %w", e.GetAggregateId(), e.GetVersion(), err)
+	}
+	return nil
+}
+
+// Close flushes the archive.
+func (w *Writer) Close() error {
+	return w.zw.Close()
+}
+
+// Reader reads events from the archive.
+type Reader struct {
+	zr *gzip.Reader
+	br *bufio.Reader
+}
+
+// NewReader creates the archive reader and checks the header of the archive.
+func NewReader(r io.Reader) (*Reader, error) {
+	zr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("cannot open archive: %w", err)
+	}
+	br := bufio.NewReader(zr)
+	magic := make([]byte, len(archiveMagic))
+	if _, err = io.ReadFull(br, magic); err != nil {
+		_ = zr.Close()
+		return nil, fmt.Errorf("cannot read archive header: %w", err)
+	}
+	if string(magic) != archiveMagic {
+		_ = zr.Close()
+		return nil, errors.New("invalid archive header")
+	}
+	return &Reader{
+		zr: zr,
+		br: br,
+	}, nil
+}
+
+// Read returns the next event from the archive. io.EOF is returned at the end of the archive.
+func (r *Reader) Read() (*pb.Event, error) {
+	var e pb.Event
+	err := protodelim.UnmarshalOptions{MaxSize: -1}.UnmarshalFrom(r.br, &e)
+	if errors.Is(err, io.EOF) {
+		return nil, io.EOF
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot read event: %w", err)
+	}
+	return &e, nil
+}
+
+// Close closes the archive, the underlying reader is not closed.
+func (r *Reader) Close() error {
+	return r.zr.Close()
+}
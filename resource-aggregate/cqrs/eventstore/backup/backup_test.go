@@ -0,0 +1,177 @@
+package backup_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/plgd-dev/hub/v2/pkg/log"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/pb"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore/backup"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore/memory"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore/test"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const (
+	deviceID1    = "2f1d4c4e-7a1b-4b2f-9e39-0a9d6c3f5b11"
+	deviceID2    = "9a0c3f1e-52d4-4a8b-b7e6-3c1f0d2e8a47"
+	aggregateID1 = "7b8a6f5e-0f58-4c5d-9c4e-62d9b1f7a3a1"
+	aggregateID2 = "e4d2c1b0-8f7a-4e6d-9c5b-1a2b3c4d5e6f"
+)
+
+func newEvent(groupID, aggregateID string, version uint64, isSnapshot bool) test.MockEvent {
+	return test.MockEvent{
+		VersionI:     version,
+		EventTypeI:   "test",
+		IsSnapshotI:  isSnapshot,
+		AggregateIDI: aggregateID,
+		GroupIDI:     groupID,
+		DataI:        []byte("data"),
+		TimestampI:   int64(version + 1),
+	}
+}
+
+func newStore(ctx context.Context, t *testing.T, opts ...memory.Option) *memory.EventStore {
+	// New doesn't share the storage, so the source and the target eventstores are independent
+	store, err := memory.New(ctx, &memory.Config{}, log.NewLogger(log.MakeDefaultConfig()), opts...)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		errC := store.Close(ctx)
+		require.NoError(t, errC)
+	})
+	return store
+}
+
+type loadedEvent struct {
+	aggregateID string
+	version     uint64
+	isSnapshot  bool
+	data        string
+}
+
+type handler struct {
+	events []loadedEvent
+}
+
+func (h *handler) Handle(ctx context.Context, iter eventstore.Iter) error {
+	for {
+		eu, ok := iter.Next(ctx)
+		if !ok {
+			break
+		}
+		var e test.MockEvent
+		if err := eu.Unmarshal(&e); err != nil {
+			return err
+		}
+		h.events = append(h.events, loadedEvent{
+			aggregateID: eu.AggregateID(),
+			version:     eu.Version(),
+			isSnapshot:  eu.IsSnapshot(),
+			data:        string(e.DataI),
+		})
+	}
+	return iter.Err()
+}
+
+func export(ctx context.Context, t *testing.T, store eventstore.EventStore, filter backup.Filter, opts ...backup.ExportOption) ([]byte, int) {
+	var buf bytes.Buffer
+	w, err := backup.NewWriter(&buf)
+	require.NoError(t, err)
+	n, err := backup.Export(ctx, store, filter, w, opts...)
+	require.NoError(t, err)
+	err = w.Close()
+	require.NoError(t, err)
+	return buf.Bytes(), n
+}
+
+func restore(ctx context.Context, t *testing.T, store eventstore.EventStore, archive []byte) (backup.Stats, error) {
+	r, err := backup.NewReader(bytes.NewReader(archive))
+	require.NoError(t, err)
+	defer func() {
+		errC := r.Close()
+		require.NoError(t, errC)
+	}()
+	return backup.Restore(ctx, store, r)
+}
+
+func TestExportRestore(t *testing.T) {
+	ctx := context.Background()
+	source := newStore(ctx, t, memory.WithMarshaler(bson.Marshal), memory.WithUnmarshaler(backup.UnmarshalRaw))
+	_, err := source.Save(ctx, newEvent(deviceID1, aggregateID1, 0, true), newEvent(deviceID1, aggregateID1, 1, false), newEvent(deviceID1, aggregateID1, 2, false))
+	require.NoError(t, err)
+	_, err = source.Save(ctx, newEvent(deviceID1, aggregateID1, 3, true), newEvent(deviceID1, aggregateID1, 4, false))
+	require.NoError(t, err)
+	_, err = source.Save(ctx, newEvent(deviceID2, aggregateID2, 0, true), newEvent(deviceID2, aggregateID2, 1, false))
+	require.NoError(t, err)
+
+	// only the latest snapshot and following events are exported
+	archive, n := export(ctx, t, source, backup.Filter{})
+	require.Equal(t, 4, n)
+	_, n = export(ctx, t, source, backup.Filter{GroupIDs: []string{deviceID2}})
+	require.Equal(t, 2, n)
+
+	target := newStore(ctx, t, memory.WithMarshaler(backup.MarshalRaw), memory.WithUnmarshaler(bson.Unmarshal))
+	stats, err := restore(ctx, t, target, archive)
+	require.NoError(t, err)
+	require.Equal(t, backup.Stats{Aggregates: 2, Events: 4}, stats)
+
+	var h handler
+	err = target.GetEvents(ctx, []eventstore.GetEventsQuery{{}}, 0, &h)
+	require.NoError(t, err)
+	// the aggregate which starts by the snapshot is restored from version 0
+	require.Equal(t, []loadedEvent{
+		{aggregateID: aggregateID1, version: 0, isSnapshot: true, data: "data"},
+		{aggregateID: aggregateID1, version: 1, data: "data"},
+		{aggregateID: aggregateID2, version: 0, isSnapshot: true, data: "data"},
+		{aggregateID: aggregateID2, version: 1, data: "data"},
+	}, h.events)
+
+	// aggregates already exist
+	stats, err = restore(ctx, t, target, archive)
+	require.Error(t, err)
+	require.Equal(t, backup.Stats{Aggregates: 2, FailedAggregates: 2}, stats)
+}
+
+func TestExportTransform(t *testing.T) {
+	ctx := context.Background()
+	source := newStore(ctx, t, memory.WithMarshaler(bson.Marshal), memory.WithUnmarshaler(backup.UnmarshalRaw))
+	_, err := source.Save(ctx, newEvent(deviceID1, aggregateID1, 0, true), newEvent(deviceID1, aggregateID1, 1, false))
+	require.NoError(t, err)
+
+	// the stored data are replaced before they are archived
+	archive, n := export(ctx, t, source, backup.Filter{}, backup.WithTransform(func(e *pb.Event) error {
+		ev := newEvent(e.GetGroupId(), e.GetAggregateId(), e.GetVersion(), e.GetIsSnapshot())
+		ev.DataI = []byte("plain")
+		data, errM := bson.Marshal(ev)
+		e.Data = data
+		return errM
+	}))
+	require.Equal(t, 2, n)
+	r, err := backup.NewReader(bytes.NewReader(archive))
+	require.NoError(t, err)
+	defer func() {
+		errC := r.Close()
+		require.NoError(t, errC)
+	}()
+	for range n {
+		e, errR := r.Read()
+		require.NoError(t, errR)
+		var ev test.MockEvent
+		require.NoError(t, bson.Unmarshal(e.GetData(), &ev))
+		require.Equal(t, []byte("plain"), ev.DataI)
+	}
+
+	_, err = backup.Export(ctx, source, backup.Filter{}, nil, backup.WithTransform(func(*pb.Event) error {
+		return errors.New("cannot transform")
+	}))
+	require.Error(t, err)
+}
+
+func TestInvalidArchive(t *testing.T) {
+	_, err := backup.NewReader(bytes.NewReader([]byte("invalid")))
+	require.Error(t, err)
+}
@@ -0,0 +1,121 @@
+package backup
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pkgTime "github.com/plgd-dev/hub/v2/pkg/time"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/pb"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore"
+)
+
+// UnmarshalerFunc unmarshal bytes to pointer of struct.
+type UnmarshalerFunc = func(b []byte, v interface{}) error
+
+// UnmarshalRaw returns the stored data of the event. The eventstore used by Export must be created with it.
+func UnmarshalRaw(b []byte, v interface{}) error {
+	data, ok := v.(*[]byte)
+	if !ok {
+		return fmt.Errorf("invalid type %T, expected *[]byte", v)
+	}
+	*data = append((*data)[:0], b...)
+	return nil
+}
+
+// Filter selects the exported events.
+type Filter struct {
+	// GroupIDs limits the export to the groups. Empty means all groups.
+	GroupIDs []string
+	// Since limits the export to events with timestamp after since. Zero value means the latest snapshot of the aggregate.
+	Since time.Time
+	// Until limits the export to events with timestamp before or equal to until. Zero value means no limit.
+	Until time.Time
+}
+
+// Queries returns queries of GetEvents for the filter.
+func (f Filter) Queries() []eventstore.GetEventsQuery {
+	if len(f.GroupIDs) == 0 {
+		return []eventstore.GetEventsQuery{{}}
+	}
+	queries := make([]eventstore.GetEventsQuery, 0, len(f.GroupIDs))
+	for _, groupID := range f.GroupIDs {
+		queries = append(queries, eventstore.GetEventsQuery{GroupID: groupID})
+	}
+	return queries
+}
+
+// TransformFunc modifies the exported event before it is written to the archive.
+type TransformFunc = func(e *pb.Event) error
+
+type exportOptions struct {
+	transform TransformFunc
+}
+
+type ExportOption func(*exportOptions)
+
+// WithTransform sets the transformation of the exported events, eg. the decryption of the stored content.
+func WithTransform(transform TransformFunc) ExportOption {
+	return func(o *exportOptions) {
+		o.transform = transform
+	}
+}
+
+type exportHandler struct {
+	w     *Writer
+	opts  exportOptions
+	until int64
+	count int
+}
+
+func (h *exportHandler) Handle(ctx context.Context, iter eventstore.Iter) error {
+	for {
+		eu, ok := iter.Next(ctx)
+		if !ok {
+			break
+		}
+		timestamp := pkgTime.UnixNano(eu.Timestamp())
+		if h.until > 0 && timestamp > h.until {
+			continue
+		}
+		var data []byte
+		if err := eu.Unmarshal(&data); err != nil {
+			return fmt.Errorf("cannot get data of event('%v', %v): %w", eu.AggregateID(), eu.Version(), err)
+		}
+		e := &pb.Event{
+			Version:     eu.Version(),
+			EventType:   eu.EventType(),
+			GroupId:     eu.GroupID(),
+			AggregateId: eu.AggregateID(),
+			Data:        data,
+			IsSnapshot:  eu.IsSnapshot(),
+			Timestamp:   timestamp,
+		}
+		if h.opts.transform != nil {
+			if err := h.opts.transform(e); err != nil {
+				return fmt.Errorf("cannot transform event('%v', %v): %w", eu.AggregateID(), eu.Version(), err)
+			}
+		}
+		if err := h.w.Write(e); err != nil {
+			return err
+		}
+		h.count++
+	}
+	return iter.Err()
+}
+
+// Export writes events loaded by GetEvents to the archive and returns number of exported events. For each aggregate
+// the latest snapshot and the following events are exported.
+func Export(ctx context.Context, store eventstore.EventStore, filter Filter, w *Writer, opts ...ExportOption) (int, error) {
+	h := exportHandler{
+		w:     w,
+		until: pkgTime.UnixNano(filter.Until),
+	}
+	for _, o := range opts {
+		o(&h.opts)
+	}
+	if err := store.GetEvents(ctx, filter.Queries(), pkgTime.UnixNano(filter.Since), &h); err != nil {
+		return h.count, fmt.Errorf("cannot export events: %w", err)
+	}
+	return h.count, nil
+}
@@ -0,0 +1,237 @@
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	pkgTime "github.com/plgd-dev/hub/v2/pkg/time"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/pb"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore"
+)
+
+const defaultBatchSize = 128
+
+// MarshalerFunc marshal struct to bytes.
+type MarshalerFunc = func(v interface{}) ([]byte, error)
+
+// DecodeFunc creates the event which is saved to the eventstore. The version of the created event must be set to the version argument.
+type DecodeFunc = func(e *pb.Event, version uint64) (eventstore.Event, error)
+
+// RawEvent is the event restored with the stored data. It doesn't provide ServiceID, ETag and Types of the event.
+type RawEvent struct {
+	event   *pb.Event
+	version uint64
+}
+
+// DecodeRaw creates RawEvent. The eventstore used by Restore must be created with MarshalRaw.
+func DecodeRaw(e *pb.Event, version uint64) (eventstore.Event, error) {
+	return &RawEvent{
+		event:   e,
+		version: version,
+	}, nil
+}
+
+// MarshalRaw returns the stored data of RawEvent.
+func MarshalRaw(v interface{}) ([]byte, error) {
+	e, ok := v.(*RawEvent)
+	if !ok {
+		return nil, fmt.Errorf("invalid type %T, expected %T", v, (*RawEvent)(nil))
+	}
+	return e.event.GetData(), nil
+}
+
+func (e *RawEvent) Version() uint64 {
+	return e.version
+}
+
+func (e *RawEvent) EventType() string {
+	return e.event.GetEventType()
+}
+
+func (e *RawEvent) AggregateID() string {
+	return e.event.GetAggregateId()
+}
+
+func (e *RawEvent) GroupID() string {
+	return e.event.GetGroupId()
+}
+
+func (e *RawEvent) IsSnapshot() bool {
+	return e.event.GetIsSnapshot()
+}
+
+func (e *RawEvent) Timestamp() time.Time {
+	return pkgTime.Unix(0, e.event.GetTimestamp())
+}
+
+func (e *RawEvent) ETag() *eventstore.ETagData {
+	return nil
+}
+
+func (e *RawEvent) ServiceID() (string, bool) {
+	return "", false
+}
+
+func (e *RawEvent) Types() []string {
+	return nil
+}
+
+type restoreOptions struct {
+	decode       DecodeFunc
+	keepVersions bool
+	batchSize    int
+}
+
+type RestoreOption func(*restoreOptions)
+
+// WithDecoder sets the decoder of the archived events. By default DecodeRaw is used.
+func WithDecoder(decode DecodeFunc) RestoreOption {
+	return func(o *restoreOptions) {
+		o.decode = decode
+	}
+}
+
+// WithKeepVersions keeps versions of the archived events. Use it to append an archive to the aggregates
+// which are already stored in the eventstore.
+func WithKeepVersions() RestoreOption {
+	return func(o *restoreOptions) {
+		o.keepVersions = true
+	}
+}
+
+// WithBatchSize sets the maximal number of events saved at once.
+func WithBatchSize(batchSize int) RestoreOption {
+	return func(o *restoreOptions) {
+		if batchSize > 0 {
+			o.batchSize = batchSize
+		}
+	}
+}
+
+// Stats contains counters of the restore.
+type Stats struct {
+	Aggregates       int
+	Events           int
+	FailedAggregates int
+}
+
+type aggregateState struct {
+	// offset is subtracted from the archived versions
+	offset uint64
+	failed bool
+}
+
+type restorer struct {
+	store      eventstore.EventStore
+	opts       restoreOptions
+	aggregates map[string]*aggregateState
+	batch      []*pb.Event
+	stats      Stats
+	errors     *multierror.Error
+}
+
+func (r *restorer) getState(first *pb.Event) *aggregateState {
+	state, ok := r.aggregates[first.GetAggregateId()]
+	if ok {
+		return state
+	}
+	state = &aggregateState{}
+	// the aggregate starts by the snapshot, so it is restored from version 0
+	if !r.opts.keepVersions && first.GetIsSnapshot() {
+		state.offset = first.GetVersion()
+	}
+	r.aggregates[first.GetAggregateId()] = state
+	r.stats.Aggregates++
+	return state
+}
+
+func (r *restorer) fail(state *aggregateState, err error) {
+	state.failed = true
+	r.stats.FailedAggregates++
+	r.errors = multierror.Append(r.errors, err)
+}
+
+func (r *restorer) save(ctx context.Context, state *aggregateState, batch []*pb.Event) {
+	events := make([]eventstore.Event, 0, len(batch))
+	for _, e := range batch {
+		if e.GetVersion() < state.offset {
+			r.fail(state, fmt.Errorf("cannot restore event('%v', %v): version is lower than version of the first snapshot %v", e.GetAggregateId(), e.GetVersion(), state.offset))
+			return
+		}
+		ev, err := r.opts.decode(e, e.GetVersion()-state.offset)
+		if err != nil {
+			r.fail(state, fmt.Errorf("cannot decode event('%v', %v): %w", e.GetAggregateId(), e.GetVersion(), err))
+			return
+		}
+		events = append(events, ev)
+	}
+	status, err := r.store.Save(ctx, events...)
+	if err != nil {
+		r.fail(state, fmt.Errorf("cannot save events of aggregate('%v'): %w", batch[0].GetAggregateId(), err))
+		return
+	}
+	if status != eventstore.Ok {
+		r.fail(state, fmt.Errorf("cannot save events of aggregate('%v'): unexpected status %v, the aggregate already exists or the archive is not complete", batch[0].GetAggregateId(), status))
+		return
+	}
+	r.stats.Events += len(events)
+}
+
+func (r *restorer) flush(ctx context.Context) {
+	if len(r.batch) == 0 {
+		return
+	}
+	batch := r.batch
+	r.batch = nil
+	state := r.getState(batch[0])
+	if state.failed {
+		return
+	}
+	r.save(ctx, state, batch)
+}
+
+func (r *restorer) push(ctx context.Context, e *pb.Event) {
+	if len(r.batch) > 0 && (r.batch[0].GetAggregateId() != e.GetAggregateId() || len(r.batch) >= r.opts.batchSize) {
+		r.flush(ctx)
+	}
+	r.batch = append(r.batch, e)
+}
+
+// Restore saves events from the archive to the eventstore. Events of the aggregate are saved in the order of the archive,
+// the aggregate which cannot be restored is skipped and the restore continues with other aggregates.
+//
+// By default an aggregate which starts by a snapshot is restored from version 0, so it can be saved to the eventstore
+// which doesn't contain the aggregate. The decoder must set the version of the event to the provided one.
+func Restore(ctx context.Context, store eventstore.EventStore, reader *Reader, opts ...RestoreOption) (Stats, error) {
+	r := restorer{
+		store: store,
+		opts: restoreOptions{
+			decode:    DecodeRaw,
+			batchSize: defaultBatchSize,
+		},
+		aggregates: make(map[string]*aggregateState),
+	}
+	for _, o := range opts {
+		o(&r.opts)
+	}
+	for {
+		if err := ctx.Err(); err != nil {
+			return r.stats, err
+		}
+		e, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			r.errors = multierror.Append(r.errors, err)
+			break
+		}
+		r.push(ctx, e)
+	}
+	r.flush(ctx)
+	return r.stats, r.errors.ErrorOrNil()
+}
@@ -1,9 +1,49 @@
 package config
 
 import (
+	"errors"
+	"fmt"
+
 	"github.com/plgd-dev/hub/v2/pkg/config/database"
 	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore/cqldb"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore/encryption"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore/memory"
 	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore/mongodb"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore/postgres"
 )
 
-type Config = database.Config[*mongodb.Config, *cqldb.Config]
+type Config struct {
+	database.Config[*mongodb.Config, *cqldb.Config] `yaml:",inline" json:",inline"`
+	PostgreSQL                                      *postgres.Config  `yaml:"postgreSQL" json:"postgreSql"`
+	Memory                                          *memory.Config    `yaml:"memory" json:"memory"`
+	Encryption                                      encryption.Config `yaml:"encryption" json:"encryption"`
+}
+
+func (c *Config) Validate() error {
+	if err := c.Encryption.Validate(); err != nil {
+		return fmt.Errorf("encryption.%w", err)
+	}
+	switch c.Use.ToLower() {
+	case database.PostgreSQL.ToLower():
+		if c.PostgreSQL == nil {
+			return errors.New("postgreSQL - is empty")
+		}
+		if err := c.PostgreSQL.Validate(); err != nil {
+			return fmt.Errorf("postgreSQL.%w", err)
+		}
+		c.Use = database.PostgreSQL
+		return nil
+	case database.Memory.ToLower():
+		if c.Memory == nil {
+			c.Memory = &memory.Config{}
+		}
+		if err := c.Memory.Validate(); err != nil {
+			return fmt.Errorf("memory.%w", err)
+		}
+		c.Use = database.Memory
+		return nil
+	case database.MongoDB.ToLower(), database.CqlDB.ToLower():
+		return c.Config.Validate()
+	}
+	return fmt.Errorf("use('%v' - only %v, %v, %v or %v are supported)", c.Use, database.MongoDB, database.CqlDB, database.PostgreSQL, database.Memory)
+}
@@ -41,7 +41,7 @@ func TestEventStore(t *testing.T) {
 	t.Log("clearing collections")
 	err = store.ClearTable(ctx)
 	require.NoError(t, err)
-	test.GetEventsTest(ctx, t, store)
+	test.GetEventsOfLatestSnapshotTest(ctx, t, store)
 }
 
 func NewTestEventStore(ctx context.Context, fileWatcher *fsnotify.Watcher, logger log.Logger) (*cqldb.EventStore, error) {
@@ -239,3 +239,8 @@ func (s *EventStore) LoadFromSnapshot(ctx context.Context, queries []eventstore.
 	}
 	return s.loadFromSnapshot(ctx, normalizedQueries, 0, eventHandler)
 }
+
+// LoadUpToTimestamp is not supported, because only the latest snapshots of the aggregates are stored.
+func (s *EventStore) LoadUpToTimestamp(_ context.Context, _ []eventstore.GetEventsQuery, _ int64, _ eventstore.Handler) error {
+	return eventstore.ErrNotSupported
+}
@@ -0,0 +1,79 @@
+package cqldb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gocql/gocql"
+	"github.com/plgd-dev/hub/v2/pkg/cqldb"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore/upcast"
+)
+
+type rewriteSnapshot struct {
+	deviceID  gocql.UUID
+	id        gocql.UUID
+	version   int64
+	eventType string
+	data      []byte
+}
+
+// reloadRewriteSnapshot loads the current snapshot of the aggregate, false is returned when the snapshot was removed.
+func (s *EventStore) reloadRewriteSnapshot(ctx context.Context, r *rewriteSnapshot) (bool, error) {
+	q := cqldb.SelectCommand + " " + versionKey + "," + eventTypeKey + "," + snapshotKey + " " + cqldb.FromClause + " " + s.Table() + " " +
+		cqldb.WhereClause + " " + deviceIDKey + "=? and " + idKey + "=?;"
+	err := s.Session().Query(q, r.deviceID, r.id).WithContext(ctx).Scan(&r.version, &r.eventType, &r.data)
+	if errors.Is(err, gocql.ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("cannot load event('%v'): %w", r.id, err)
+	}
+	return true, nil
+}
+
+// rewriteSnapshot replaces the data of the snapshot when its version is not changed. When the snapshot was replaced
+// in the meantime, the current snapshot is rewritten again. It returns true when the data were replaced.
+func (s *EventStore) rewriteSnapshot(ctx context.Context, r *rewriteSnapshot, rewrite upcast.RewriteFunc) (bool, error) {
+	upd := "update " + s.Table() + " set " + snapshotKey + "=? " + cqldb.WhereClause + " " + deviceIDKey + "=? and " + idKey + "=? if " + versionKey + "=?;"
+	for {
+		newData, changed, err := rewrite(r.eventType, r.data)
+		if err != nil {
+			return false, fmt.Errorf("cannot rewrite event('%v', %v): %w", r.id, r.version, err)
+		}
+		if !changed {
+			return false, nil
+		}
+		applied, err := s.Session().Query(upd, newData, r.deviceID, r.id, r.version).WithContext(ctx).MapScanCAS(make(map[string]interface{}))
+		if err != nil {
+			return false, fmt.Errorf("cannot update event('%v', %v): %w", r.id, r.version, err)
+		}
+		if applied {
+			return true, nil
+		}
+		ok, err := s.reloadRewriteSnapshot(ctx, r)
+		if err != nil || !ok {
+			return false, err
+		}
+	}
+}
+
+// RewriteEvents calls the rewrite function for each stored snapshot and replaces data of the changed snapshots.
+// The snapshot replaced in the meantime is rewritten again.
+func (s *EventStore) RewriteEvents(ctx context.Context, rewrite upcast.RewriteFunc) (int, error) {
+	q := cqldb.SelectCommand + " " + deviceIDKey + "," + idKey + "," + versionKey + "," + eventTypeKey + "," + snapshotKey + " " + cqldb.FromClause + " " + s.Table() + ";"
+	iter := s.Session().Query(q).WithContext(ctx).Iter()
+	var count int
+	var r rewriteSnapshot
+	for iter.Scan(&r.deviceID, &r.id, &r.version, &r.eventType, &r.data) {
+		rewritten, err := s.rewriteSnapshot(ctx, &r, rewrite)
+		if err != nil {
+			_ = iter.Close()
+			return count, err
+		}
+		if rewritten {
+			count++
+		}
+	}
+	return count, iter.Close()
+}
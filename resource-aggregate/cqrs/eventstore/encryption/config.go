@@ -0,0 +1,75 @@
+package encryption
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/plgd-dev/hub/v2/pkg/config/property/urischeme"
+	"github.com/plgd-dev/hub/v2/pkg/fsnotify"
+	"github.com/plgd-dev/hub/v2/pkg/log"
+	pkgMongo "github.com/plgd-dev/hub/v2/pkg/mongodb"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type Config struct {
+	Enabled bool `yaml:"enabled" json:"enabled"`
+	// MasterKeyFiles contains the files with the master keys. The first one wraps the new data keys,
+	// the others are the retired master keys used to unwrap the data keys until they are rewrapped.
+	MasterKeyFiles     []urischeme.URIScheme `yaml:"masterKeyFiles" json:"masterKeyFiles"`
+	KeyCacheExpiration time.Duration         `yaml:"keyCacheExpiration" json:"keyCacheExpiration"`
+	// KeyStoreTimeout limits the loading of the data keys during the encryption and the decryption of the events.
+	KeyStoreTimeout time.Duration `yaml:"keyStoreTimeout" json:"keyStoreTimeout"`
+	// ShreddedOwnersSyncInterval is the interval in which the cached data keys of the owners shredded by other
+	// instances or by the eventstore-keys tool are dropped. 0 disables the synchronization.
+	ShreddedOwnersSyncInterval time.Duration   `yaml:"shreddedOwnersSyncInterval" json:"shreddedOwnersSyncInterval"`
+	MongoDB                    pkgMongo.Config `yaml:"mongoDB" json:"mongoDb"`
+}
+
+func (c *Config) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if len(c.MasterKeyFiles) == 0 {
+		return fmt.Errorf("masterKeyFiles('%v')", c.MasterKeyFiles)
+	}
+	if c.KeyCacheExpiration <= 0 {
+		return fmt.Errorf("keyCacheExpiration('%v')", c.KeyCacheExpiration)
+	}
+	if c.KeyStoreTimeout <= 0 {
+		return fmt.Errorf("keyStoreTimeout('%v')", c.KeyStoreTimeout)
+	}
+	if c.ShreddedOwnersSyncInterval < 0 {
+		return fmt.Errorf("shreddedOwnersSyncInterval('%v')", c.ShreddedOwnersSyncInterval)
+	}
+	if err := c.MongoDB.Validate(); err != nil {
+		return fmt.Errorf("mongoDB.%w", err)
+	}
+	return nil
+}
+
+// New creates the encryptor with the data keys stored in the MongoDB.
+func New(ctx context.Context, cfg Config, fileWatcher *fsnotify.Watcher, logger log.Logger, tracerProvider trace.TracerProvider) (*Encryptor, error) {
+	masterKeys := make([]MasterKey, 0, len(cfg.MasterKeyFiles))
+	for _, f := range cfg.MasterKeyFiles {
+		k, err := LoadAESMasterKey(f)
+		if err != nil {
+			return nil, err
+		}
+		masterKeys = append(masterKeys, k)
+	}
+	store, err := NewMongoKeyStore(ctx, &cfg.MongoDB, fileWatcher, logger, tracerProvider)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create data key store: %w", err)
+	}
+	e, err := NewEncryptor(store, cfg.KeyCacheExpiration, masterKeys...)
+	if err != nil {
+		_ = store.Close(ctx)
+		return nil, err
+	}
+	e.keyStoreTimeout = cfg.KeyStoreTimeout
+	if cfg.ShreddedOwnersSyncInterval > 0 {
+		e.runSyncShreddedOwners(cfg.ShreddedOwnersSyncInterval, logger)
+	}
+	return e, nil
+}
@@ -0,0 +1,505 @@
+// Package encryption encrypts the resource content stored in the eventstore by the data keys of the owners.
+//
+// Each owner gets a random data key which is stored wrapped by the master key. The data of each commands.Content
+// of an event is replaced by an envelope with the id of the data key, so older versions are still readable
+// after the key rotation. The encrypted content is marked by the content type, because the data of the devices
+// can start with any bytes. Deleting the data keys of the owner makes the stored content unreadable (crypto-shredding),
+// the shredded content is loaded as empty.
+package encryption
+
+import (
+	"bytes"
+	"context"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/plgd-dev/hub/v2/pkg/log"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/commands"
+	"golang.org/x/sync/singleflight"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// UnmarshalerFunc unmarshal bytes to pointer of struct.
+type UnmarshalerFunc = func(b []byte, v interface{}) error
+
+// MarshalerFunc marshal struct to bytes.
+type MarshalerFunc = func(v interface{}) ([]byte, error)
+
+const dataKeySize = 32
+
+// envelopeMagic starts the encrypted data.
+var envelopeMagic = []byte{0x00, 'p', 'e', 'n', 'c', 0x01}
+
+// EncryptedContentTypePrefix is prepended to the content type of the encrypted content. Only the content marked
+// by it is decrypted, so the plaintext stored before the encryption was enabled is loaded as it is.
+const EncryptedContentTypePrefix = "application/vnd.plgd.encrypted;"
+
+const keyIDSize = 8
+
+// DefaultKeyStoreTimeout limits the requests to the key store done by the marshaler and the unmarshaler.
+const DefaultKeyStoreTimeout = time.Second * 10
+
+// shreddedOwnersClockSkew is the tolerated difference of the clocks of the instances synchronizing the shredded owners.
+const shreddedOwnersClockSkew = time.Minute
+
+type ownerKeys struct {
+	keys      map[string]cipher.AEAD
+	current   string
+	expiresAt time.Time
+}
+
+// Encryptor encrypts the content of the events by the data keys of the owners.
+type Encryptor struct {
+	store      KeyStore
+	masterKeys []MasterKey
+	expiration time.Duration
+	// keyStoreTimeout bounds the loading of the keys by the marshaler and the unmarshaler
+	keyStoreTimeout time.Duration
+
+	lock  sync.Mutex
+	cache map[string]*ownerKeys
+	// generation is incremented by each invalidation of the cache, so the keys loaded before it are not cached
+	generation uint64
+	// shreddedSince is the unix timestamp in nanoseconds from which the shredded owners are synchronized
+	shreddedSince int64
+	// loading deduplicates the concurrent loads of the keys of the owner
+	loading singleflight.Group
+
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewEncryptor creates the encryptor. The first master key wraps the new data keys, the others are used to unwrap
+// the data keys which have not been rewrapped yet. The unwrapped data keys are cached for the expiration.
+func NewEncryptor(store KeyStore, expiration time.Duration, masterKeys ...MasterKey) (*Encryptor, error) {
+	if len(masterKeys) == 0 {
+		return nil, errors.New("master key is not set")
+	}
+	return &Encryptor{
+		store:           store,
+		masterKeys:      masterKeys,
+		expiration:      expiration,
+		keyStoreTimeout: DefaultKeyStoreTimeout,
+		cache:           make(map[string]*ownerKeys),
+		shreddedSince:   time.Now().UnixNano(),
+		done:            make(chan struct{}),
+	}, nil
+}
+
+func (e *Encryptor) unwrap(key DataKey) ([]byte, error) {
+	for _, m := range e.masterKeys {
+		if m.ID() == key.MasterKeyID {
+			return m.Unwrap(key.WrappedKey)
+		}
+	}
+	return nil, fmt.Errorf("master key('%v') of data key of owner('%v') version('%v') not found", key.MasterKeyID, key.Owner, key.Version)
+}
+
+func (e *Encryptor) loadKeys(ctx context.Context, owner string) (*ownerKeys, error) {
+	keys, err := e.store.GetKeys(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+	ok := &ownerKeys{
+		keys:      make(map[string]cipher.AEAD, len(keys)),
+		expiresAt: time.Now().Add(e.expiration),
+	}
+	// keys are sorted by the versions, so the last one is the current key
+	for _, k := range keys {
+		dataKey, err := e.unwrap(k)
+		if err != nil {
+			return nil, err
+		}
+		aead, err := newAEAD(dataKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid data key of owner('%v') version('%v'): %w", owner, k.Version, err)
+		}
+		ok.keys[k.ID] = aead
+		ok.current = k.ID
+	}
+	return ok, nil
+}
+
+// getKeys returns the cached keys of the owner. On the cache miss the keys are loaded without holding the lock,
+// so the encryption and the decryption for the other owners are not blocked by the keystore.
+func (e *Encryptor) getKeys(ctx context.Context, owner string) (*ownerKeys, error) {
+	e.lock.Lock()
+	keys, ok := e.cache[owner]
+	generation := e.generation
+	e.lock.Unlock()
+	if ok && time.Now().Before(keys.expiresAt) {
+		return keys, nil
+	}
+	// the callers after the invalidation don't wait for the keys loaded before it
+	v, err, _ := e.loading.Do(owner+"/"+strconv.FormatUint(generation, 10), func() (interface{}, error) {
+		keys, err := e.loadKeys(ctx, owner)
+		if err != nil {
+			return nil, err
+		}
+		e.lock.Lock()
+		defer e.lock.Unlock()
+		if e.generation == generation {
+			e.cache[owner] = keys
+		}
+		return keys, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(*ownerKeys), nil
+}
+
+// invalidateLocked drops the cached keys of the owner. Lock must be held by the caller.
+func (e *Encryptor) invalidateLocked(owner string) {
+	delete(e.cache, owner)
+	e.generation++
+}
+
+func (e *Encryptor) invalidate(owner string) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.invalidateLocked(owner)
+}
+
+// addKey generates and stores the next version of the owner's data key.
+func (e *Encryptor) addKey(ctx context.Context, owner string, version uint32) error {
+	dataKey := make([]byte, dataKeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return fmt.Errorf("cannot generate data key: %w", err)
+	}
+	keyID := make([]byte, keyIDSize)
+	if _, err := rand.Read(keyID); err != nil {
+		return fmt.Errorf("cannot generate data key id: %w", err)
+	}
+	wrappedKey, err := e.masterKeys[0].Wrap(dataKey)
+	if err != nil {
+		return err
+	}
+	err = e.store.AddKey(ctx, DataKey{
+		ID:          hex.EncodeToString(keyID),
+		Owner:       owner,
+		Version:     version,
+		MasterKeyID: e.masterKeys[0].ID(),
+		WrappedKey:  wrappedKey,
+		CreatedAt:   time.Now().UnixNano(),
+	})
+	e.invalidate(owner)
+	return err
+}
+
+func (e *Encryptor) getCurrentKey(ctx context.Context, owner string) (string, cipher.AEAD, error) {
+	keys, err := e.getKeys(ctx, owner)
+	if err != nil {
+		return "", nil, err
+	}
+	if len(keys.keys) == 0 {
+		// the key can be created concurrently by another instance
+		if err = e.addKey(ctx, owner, 1); err != nil && !errors.Is(err, ErrKeyExists) {
+			return "", nil, fmt.Errorf("cannot create data key of owner('%v'): %w", owner, err)
+		}
+		if keys, err = e.getKeys(ctx, owner); err != nil {
+			return "", nil, err
+		}
+	}
+	aead, ok := keys.keys[keys.current]
+	if !ok {
+		return "", nil, fmt.Errorf("data key of owner('%v') not found", owner)
+	}
+	return keys.current, aead, nil
+}
+
+// RotateKey creates a new version of the owner's data key, which is used to encrypt new content. The content
+// encrypted by the previous versions stays readable.
+func (e *Encryptor) RotateKey(ctx context.Context, owner string) (uint32, error) {
+	keys, err := e.store.GetKeys(ctx, owner)
+	if err != nil {
+		return 0, err
+	}
+	var version uint32 = 1
+	if len(keys) > 0 {
+		version = keys[len(keys)-1].Version + 1
+	}
+	if err = e.addKey(ctx, owner, version); err != nil {
+		return 0, fmt.Errorf("cannot rotate data key of owner('%v'): %w", owner, err)
+	}
+	return version, nil
+}
+
+// RewrapKeys wraps all data keys by the current master key and returns the number of rewrapped keys. It is used
+// after the master key rotation, when the previous master key is configured after the new one.
+func (e *Encryptor) RewrapKeys(ctx context.Context) (int, error) {
+	var count int
+	err := e.store.IterateKeys(ctx, func(key DataKey) error {
+		if key.MasterKeyID == e.masterKeys[0].ID() {
+			return nil
+		}
+		dataKey, err := e.unwrap(key)
+		if err != nil {
+			return err
+		}
+		if key.WrappedKey, err = e.masterKeys[0].Wrap(dataKey); err != nil {
+			return err
+		}
+		key.MasterKeyID = e.masterKeys[0].ID()
+		if err = e.store.UpdateKey(ctx, key); err != nil {
+			return err
+		}
+		count++
+		return nil
+	})
+	return count, err
+}
+
+// ShredKeys deletes all data keys of the owner, so the stored content of the owner becomes unreadable. The other
+// instances drop the cached data keys of the owner by SyncShreddedOwners.
+func (e *Encryptor) ShredKeys(ctx context.Context, owner string) error {
+	err := e.store.DeleteKeys(ctx, owner)
+	e.invalidate(owner)
+	return err
+}
+
+// SyncShreddedOwners drops the cached data keys of the owners shredded since the previous synchronization, eg. by
+// another instance of the service or by the eventstore-keys tool.
+func (e *Encryptor) SyncShreddedOwners(ctx context.Context) error {
+	e.lock.Lock()
+	since := e.shreddedSince
+	e.lock.Unlock()
+	// the clocks of the instances are not synchronized exactly, so the interval overlaps the previous one
+	now := time.Now()
+	owners, err := e.store.GetShreddedOwners(ctx, since-shreddedOwnersClockSkew.Nanoseconds())
+	if err != nil {
+		return fmt.Errorf("cannot get shredded owners: %w", err)
+	}
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	for _, o := range owners {
+		e.invalidateLocked(o.Owner)
+	}
+	e.shreddedSince = now.UnixNano()
+	return nil
+}
+
+// runSyncShreddedOwners calls SyncShreddedOwners periodically until the encryptor is closed.
+func (e *Encryptor) runSyncShreddedOwners(interval time.Duration, logger log.Logger) {
+	e.wg.Add(1)
+	go func() {
+		defer e.wg.Done()
+		t := time.NewTicker(interval)
+		defer t.Stop()
+		for {
+			select {
+			case <-e.done:
+				return
+			case <-t.C:
+				ctx, cancel := context.WithTimeout(context.Background(), interval)
+				if err := e.SyncShreddedOwners(ctx); err != nil {
+					logger.Errorf("cannot synchronize shredded owners: %w", err)
+				}
+				cancel()
+			}
+		}
+	}()
+}
+
+func (e *Encryptor) Close(ctx context.Context) error {
+	close(e.done)
+	e.wg.Wait()
+	return e.store.Close(ctx)
+}
+
+// IsEncrypted returns true when the content is encrypted by the encryptor.
+func IsEncrypted(c *commands.Content) bool {
+	return strings.HasPrefix(c.GetContentType(), EncryptedContentTypePrefix)
+}
+
+func (e *Encryptor) encrypt(ctx context.Context, owner string, data []byte) ([]byte, error) {
+	keyID, aead, err := e.getCurrentKey(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+	rawKeyID, err := hex.DecodeString(keyID)
+	if err != nil || len(rawKeyID) != keyIDSize {
+		return nil, fmt.Errorf("invalid id('%v') of data key of owner('%v')", keyID, owner)
+	}
+	header := make([]byte, len(envelopeMagic)+keyIDSize+aead.NonceSize())
+	copy(header, envelopeMagic)
+	copy(header[len(envelopeMagic):], rawKeyID)
+	nonce := header[len(envelopeMagic)+keyIDSize:]
+	if _, err = rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("cannot generate nonce: %w", err)
+	}
+	return aead.Seal(header, nonce, data, []byte(owner)), nil
+}
+
+// getKey returns the data key of the owner by the id. The cached keys are reloaded when the key is missing, because
+// the key can be created by another instance after the keys were cached. The nil key without an error is returned
+// when the data keys of the owner were shredded.
+func (e *Encryptor) getKey(ctx context.Context, owner, keyID string) (cipher.AEAD, error) {
+	keys, err := e.getKeys(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+	if aead, ok := keys.keys[keyID]; ok {
+		return aead, nil
+	}
+	e.invalidate(owner)
+	if keys, err = e.getKeys(ctx, owner); err != nil {
+		return nil, err
+	}
+	if aead, ok := keys.keys[keyID]; ok {
+		return aead, nil
+	}
+	shredded, err := e.store.IsShredded(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+	if !shredded {
+		return nil, fmt.Errorf("data key of owner('%v') not found", owner)
+	}
+	return nil, nil
+}
+
+// decrypt returns nil data without an error when the data key of the owner was shredded.
+func (e *Encryptor) decrypt(ctx context.Context, owner string, data []byte) ([]byte, error) {
+	if len(data) < len(envelopeMagic)+keyIDSize || !bytes.HasPrefix(data, envelopeMagic) {
+		return nil, errors.New("invalid envelope")
+	}
+	keyID := hex.EncodeToString(data[len(envelopeMagic) : len(envelopeMagic)+keyIDSize])
+	aead, err := e.getKey(ctx, owner, keyID)
+	if err != nil {
+		return nil, err
+	}
+	if aead == nil {
+		return nil, nil
+	}
+	data = data[len(envelopeMagic)+keyIDSize:]
+	if len(data) < aead.NonceSize() {
+		return nil, errors.New("invalid envelope")
+	}
+	plain, err := aead.Open(nil, data[:aead.NonceSize()], data[aead.NonceSize():], []byte(owner))
+	if err != nil {
+		return nil, fmt.Errorf("cannot decrypt content of owner('%v'): %w", owner, err)
+	}
+	return plain, nil
+}
+
+// visitContents calls fn for each commands.Content of the message.
+func visitContents(m protoreflect.Message, fn func(*commands.Content) error) error {
+	if c, ok := m.Interface().(*commands.Content); ok {
+		return fn(c)
+	}
+	var err error
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsList():
+			if fd.Kind() != protoreflect.MessageKind {
+				return true
+			}
+			l := v.List()
+			for i := 0; i < l.Len() && err == nil; i++ {
+				err = visitContents(l.Get(i).Message(), fn)
+			}
+		case fd.IsMap():
+			if fd.MapValue().Kind() != protoreflect.MessageKind {
+				return true
+			}
+			v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+				err = visitContents(mv.Message(), fn)
+				return err == nil
+			})
+		case fd.Kind() == protoreflect.MessageKind:
+			err = visitContents(v.Message(), fn)
+		}
+		return err == nil
+	})
+	return err
+}
+
+// getOwner returns the owner of the event from the audit context.
+func getOwner(v interface{}) (proto.Message, string) {
+	m, ok := v.(proto.Message)
+	if !ok {
+		return nil, ""
+	}
+	a, ok := v.(interface{ GetAuditContext() *commands.AuditContext })
+	if !ok {
+		return nil, ""
+	}
+	return m, a.GetAuditContext().GetOwner()
+}
+
+// Decrypt decrypts the content of the message encrypted by the owner's data keys. The shredded content is cleared.
+func (e *Encryptor) Decrypt(ctx context.Context, owner string, m proto.Message) error {
+	return visitContents(m.ProtoReflect(), func(c *commands.Content) error {
+		if !IsEncrypted(c) {
+			return nil
+		}
+		data, err := e.decrypt(ctx, owner, c.GetData())
+		if err != nil {
+			return err
+		}
+		if data == nil {
+			c.Data = nil
+			c.ContentType = ""
+			c.CoapContentFormat = -1
+			return nil
+		}
+		c.Data = data
+		c.ContentType = strings.TrimPrefix(c.GetContentType(), EncryptedContentTypePrefix)
+		return nil
+	})
+}
+
+// Marshaler wraps the marshaler, so the content of the events with the owner is encrypted. The event is not modified.
+func (e *Encryptor) Marshaler(marshal MarshalerFunc) MarshalerFunc {
+	return func(v interface{}) ([]byte, error) {
+		m, owner := getOwner(v)
+		if owner == "" {
+			return marshal(v)
+		}
+		m = proto.Clone(m)
+		ctx, cancel := context.WithTimeout(context.Background(), e.keyStoreTimeout)
+		defer cancel()
+		err := visitContents(m.ProtoReflect(), func(c *commands.Content) error {
+			if len(c.GetData()) == 0 {
+				return nil
+			}
+			data, err := e.encrypt(ctx, owner, c.GetData())
+			if err != nil {
+				return fmt.Errorf("cannot encrypt content: %w", err)
+			}
+			c.Data = data
+			c.ContentType = EncryptedContentTypePrefix + c.GetContentType()
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return marshal(m)
+	}
+}
+
+// Unmarshaler wraps the unmarshaler, so the encrypted content of the decoded event is decrypted. The shredded content
+// is cleared.
+func (e *Encryptor) Unmarshaler(unmarshal UnmarshalerFunc) UnmarshalerFunc {
+	return func(b []byte, v interface{}) error {
+		if err := unmarshal(b, v); err != nil {
+			return err
+		}
+		m, owner := getOwner(v)
+		if m == nil {
+			return nil
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), e.keyStoreTimeout)
+		defer cancel()
+		return e.Decrypt(ctx, owner, m)
+	}
+}
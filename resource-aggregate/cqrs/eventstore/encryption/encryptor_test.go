@@ -0,0 +1,299 @@
+package encryption_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"sync"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/plgd-dev/hub/v2/resource-aggregate/commands"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore/encryption"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/utils"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/events"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+const testOwner = "owner"
+
+var testData = []byte(`{"power":42}`)
+
+func newMasterKey(t *testing.T) *encryption.AESMasterKey {
+	key := make([]byte, 32)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+	m, err := encryption.NewAESMasterKey(key)
+	require.NoError(t, err)
+	return m
+}
+
+func newResourceChanged(owner string, data []byte) *events.ResourceChanged {
+	return &events.ResourceChanged{
+		ResourceId: commands.NewResourceID("deviceID", "/light/1"),
+		Content: &commands.Content{
+			Data:              data,
+			ContentType:       "application/json",
+			CoapContentFormat: 50,
+		},
+		Status:       commands.Status_OK,
+		AuditContext: commands.NewAuditContext(owner, "", owner),
+	}
+}
+
+func marshal(t *testing.T, e *encryption.Encryptor, v interface{}) []byte {
+	data, err := e.Marshaler(utils.Marshal)(v)
+	require.NoError(t, err)
+	return data
+}
+
+func unmarshalResourceChanged(t *testing.T, e *encryption.Encryptor, data []byte) *events.ResourceChanged {
+	var ev events.ResourceChanged
+	err := e.Unmarshaler(utils.Unmarshal)(data, &ev)
+	require.NoError(t, err)
+	return &ev
+}
+
+func TestEncryptorRoundTrip(t *testing.T) {
+	e, err := encryption.NewEncryptor(encryption.NewMemoryKeyStore(), time.Minute, newMasterKey(t))
+	require.NoError(t, err)
+
+	ev := newResourceChanged(testOwner, testData)
+	data := marshal(t, e, ev)
+	require.False(t, bytes.Contains(data, testData))
+	// the event is not modified
+	require.Equal(t, testData, ev.GetContent().GetData())
+
+	got := unmarshalResourceChanged(t, e, data)
+	require.True(t, proto.Equal(ev, got))
+
+	// the event without the owner is not encrypted
+	ev = newResourceChanged("", testData)
+	data = marshal(t, e, ev)
+	require.True(t, bytes.Contains(data, testData))
+	got = unmarshalResourceChanged(t, e, data)
+	require.True(t, proto.Equal(ev, got))
+}
+
+func TestEncryptorContentWithEnvelopePrefix(t *testing.T) {
+	e, err := encryption.NewEncryptor(encryption.NewMemoryKeyStore(), time.Minute, newMasterKey(t))
+	require.NoError(t, err)
+
+	// the octet stream of the device starts with the same bytes as the envelope
+	content := []byte{0x00, 'p', 'e', 'n', 'c', 0x01, 0x02, 0x03}
+	ev := newResourceChanged(testOwner, content)
+	ev.Content.ContentType = "application/octet-stream"
+	data := marshal(t, e, ev)
+	require.False(t, bytes.Contains(data, content))
+	require.True(t, proto.Equal(ev, unmarshalResourceChanged(t, e, data)))
+
+	// the plaintext stored before the encryption was enabled is not decrypted
+	plain, err := utils.Marshal(ev)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(ev, unmarshalResourceChanged(t, e, plain)))
+}
+
+func TestEncryptorSnapshot(t *testing.T) {
+	e, err := encryption.NewEncryptor(encryption.NewMemoryKeyStore(), time.Minute, newMasterKey(t))
+	require.NoError(t, err)
+
+	s := &events.ResourceStateSnapshotTaken{
+		ResourceId:           commands.NewResourceID("deviceID", "/light/1"),
+		LatestResourceChange: newResourceChanged(testOwner, testData),
+		ResourceUpdatePendings: []*events.ResourceUpdatePending{
+			{
+				Content:      &commands.Content{Data: []byte(`{"power":43}`), ContentType: "application/json"},
+				AuditContext: commands.NewAuditContext(testOwner, "", testOwner),
+			},
+		},
+		AuditContext: commands.NewAuditContext(testOwner, "", testOwner),
+	}
+	data, err := e.Marshaler(utils.Marshal)(s)
+	require.NoError(t, err)
+	require.False(t, bytes.Contains(data, testData))
+	require.False(t, bytes.Contains(data, []byte(`{"power":43}`)))
+
+	var got events.ResourceStateSnapshotTaken
+	err = e.Unmarshaler(utils.Unmarshal)(data, &got)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(s, &got))
+}
+
+func TestEncryptorRotateKey(t *testing.T) {
+	store := encryption.NewMemoryKeyStore()
+	e, err := encryption.NewEncryptor(store, time.Minute, newMasterKey(t))
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	ev := newResourceChanged(testOwner, testData)
+	v1 := marshal(t, e, ev)
+	version, err := e.RotateKey(ctx, testOwner)
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), version)
+	v2 := marshal(t, e, ev)
+
+	keys, err := store.GetKeys(ctx, testOwner)
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+	for _, k := range keys {
+		// the id of the data key is stored as the valid string
+		require.True(t, utf8.ValidString(k.ID))
+	}
+	keyID, err := hex.DecodeString(keys[1].ID)
+	require.NoError(t, err)
+	require.True(t, bytes.Contains(v2, keyID))
+
+	require.True(t, proto.Equal(ev, unmarshalResourceChanged(t, e, v1)))
+	require.True(t, proto.Equal(ev, unmarshalResourceChanged(t, e, v2)))
+}
+
+func TestEncryptorRewrapKeys(t *testing.T) {
+	store := encryption.NewMemoryKeyStore()
+	oldMasterKey := newMasterKey(t)
+	e, err := encryption.NewEncryptor(store, time.Minute, oldMasterKey)
+	require.NoError(t, err)
+	ev := newResourceChanged(testOwner, testData)
+	data := marshal(t, e, ev)
+
+	newMasterKey := newMasterKey(t)
+	e, err = encryption.NewEncryptor(store, time.Minute, newMasterKey, oldMasterKey)
+	require.NoError(t, err)
+	count, err := e.RewrapKeys(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+	count, err = e.RewrapKeys(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 0, count)
+
+	// the old master key is not needed anymore
+	e, err = encryption.NewEncryptor(store, time.Minute, newMasterKey)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(ev, unmarshalResourceChanged(t, e, data)))
+}
+
+func TestEncryptorShredKeys(t *testing.T) {
+	e, err := encryption.NewEncryptor(encryption.NewMemoryKeyStore(), time.Minute, newMasterKey(t))
+	require.NoError(t, err)
+	ev := newResourceChanged(testOwner, testData)
+	data := marshal(t, e, ev)
+
+	err = e.ShredKeys(context.Background(), testOwner)
+	require.NoError(t, err)
+	got := unmarshalResourceChanged(t, e, data)
+	require.Empty(t, got.GetContent().GetData())
+	require.Equal(t, int32(-1), got.GetContent().GetCoapContentFormat())
+	require.True(t, proto.Equal(ev.GetResourceId(), got.GetResourceId()))
+
+	// the new key of the owner cannot decrypt the shredded content
+	_ = marshal(t, e, ev)
+	got = unmarshalResourceChanged(t, e, data)
+	require.Empty(t, got.GetContent().GetData())
+}
+
+func TestEncryptorKeyRotatedByAnotherInstance(t *testing.T) {
+	store := encryption.NewMemoryKeyStore()
+	masterKey := newMasterKey(t)
+	e1, err := encryption.NewEncryptor(store, time.Minute, masterKey)
+	require.NoError(t, err)
+	e2, err := encryption.NewEncryptor(store, time.Minute, masterKey)
+	require.NoError(t, err)
+	ev := newResourceChanged(testOwner, testData)
+	// the keys of the owner are cached by the second instance
+	require.True(t, proto.Equal(ev, unmarshalResourceChanged(t, e2, marshal(t, e1, ev))))
+
+	_, err = e1.RotateKey(context.Background(), testOwner)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(ev, unmarshalResourceChanged(t, e2, marshal(t, e1, ev))))
+}
+
+func TestEncryptorMissingKeyIsNotShredded(t *testing.T) {
+	masterKey := newMasterKey(t)
+	e1, err := encryption.NewEncryptor(encryption.NewMemoryKeyStore(), time.Minute, masterKey)
+	require.NoError(t, err)
+	e2, err := encryption.NewEncryptor(encryption.NewMemoryKeyStore(), time.Minute, masterKey)
+	require.NoError(t, err)
+	data := marshal(t, e1, newResourceChanged(testOwner, testData))
+
+	var ev events.ResourceChanged
+	err = e2.Unmarshaler(utils.Unmarshal)(data, &ev)
+	require.Error(t, err)
+}
+
+func TestEncryptorShreddedByAnotherInstance(t *testing.T) {
+	store := encryption.NewMemoryKeyStore()
+	masterKey := newMasterKey(t)
+	e1, err := encryption.NewEncryptor(store, time.Minute, masterKey)
+	require.NoError(t, err)
+	e2, err := encryption.NewEncryptor(store, time.Minute, masterKey)
+	require.NoError(t, err)
+	ev := newResourceChanged(testOwner, testData)
+	data := marshal(t, e1, ev)
+	require.True(t, proto.Equal(ev, unmarshalResourceChanged(t, e2, data)))
+
+	err = e1.ShredKeys(context.Background(), testOwner)
+	require.NoError(t, err)
+	err = e2.SyncShreddedOwners(context.Background())
+	require.NoError(t, err)
+	require.Empty(t, unmarshalResourceChanged(t, e2, data).GetContent().GetData())
+}
+
+type blockingKeyStore struct {
+	*encryption.MemoryKeyStore
+	owner   string
+	once    sync.Once
+	loading chan struct{}
+	release chan struct{}
+}
+
+// GetKeys blocks the first load of the keys of the owner until the release channel is closed.
+func (s *blockingKeyStore) GetKeys(ctx context.Context, owner string) ([]encryption.DataKey, error) {
+	if owner == s.owner {
+		s.once.Do(func() {
+			close(s.loading)
+			<-s.release
+		})
+	}
+	return s.MemoryKeyStore.GetKeys(ctx, owner)
+}
+
+func TestEncryptorLoadKeysDoesNotBlockOtherOwners(t *testing.T) {
+	store := &blockingKeyStore{
+		MemoryKeyStore: encryption.NewMemoryKeyStore(),
+		owner:          "blocked",
+		loading:        make(chan struct{}),
+		release:        make(chan struct{}),
+	}
+	e, err := encryption.NewEncryptor(store, time.Minute, newMasterKey(t))
+	require.NoError(t, err)
+	data := marshal(t, e, newResourceChanged(testOwner, testData))
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_, errM := e.Marshaler(utils.Marshal)(newResourceChanged(store.owner, testData))
+		assert.NoError(t, errM)
+	}()
+	<-store.loading
+
+	// the keys of the other owner are available while the keys of the blocked owner are loaded
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		got := unmarshalResourceChanged(t, e, data)
+		assert.Equal(t, testData, got.GetContent().GetData())
+		marshal(t, e, newResourceChanged(testOwner, testData))
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second * 5):
+		require.FailNow(t, "encryption of the other owner is blocked by the load of the keys")
+	}
+
+	close(store.release)
+	wg.Wait()
+}
@@ -0,0 +1,147 @@
+package encryption
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+)
+
+// ErrKeyExists is returned by KeyStore.AddKey when the version of the owner's data key is already stored.
+var ErrKeyExists = errors.New("data key already exists")
+
+// DataKey is the wrapped data key of the owner.
+type DataKey struct {
+	// ID is stored with the encrypted content. It is unique, so the content encrypted by a shredded key cannot
+	// be decrypted by a later key of the owner.
+	ID          string `bson:"id"`
+	Owner       string `bson:"owner"`
+	Version     uint32 `bson:"version"`
+	MasterKeyID string `bson:"masterKeyId"`
+	WrappedKey  []byte `bson:"wrappedKey"`
+	// Unix timestamp in nanoseconds
+	CreatedAt int64 `bson:"createdAt"`
+}
+
+// ShreddedOwner records that the data keys of the owner were deleted.
+type ShreddedOwner struct {
+	Owner string `bson:"_id"`
+	// Unix timestamp in nanoseconds
+	ShreddedAt int64 `bson:"shreddedAt"`
+}
+
+// KeyStore persists the wrapped data keys.
+type KeyStore interface {
+	// GetKeys returns the data keys of the owner in the ascending order of their versions.
+	GetKeys(ctx context.Context, owner string) ([]DataKey, error)
+	// AddKey stores the new version of the owner's data key. ErrKeyExists is returned when the version exists.
+	AddKey(ctx context.Context, key DataKey) error
+	// UpdateKey replaces the wrapped key of the stored version.
+	UpdateKey(ctx context.Context, key DataKey) error
+	// DeleteKeys removes all data keys of the owner and records the owner as shredded.
+	DeleteKeys(ctx context.Context, owner string) error
+	// IsShredded returns true when the data keys of the owner were deleted.
+	IsShredded(ctx context.Context, owner string) (bool, error)
+	// GetShreddedOwners returns the owners shredded at or after the unix timestamp in nanoseconds.
+	GetShreddedOwners(ctx context.Context, since int64) ([]ShreddedOwner, error)
+	// IterateKeys calls onKey for all stored data keys.
+	IterateKeys(ctx context.Context, onKey func(DataKey) error) error
+	Close(ctx context.Context) error
+}
+
+// MemoryKeyStore keeps the data keys in memory. It is intended for tests.
+type MemoryKeyStore struct {
+	lock     sync.Mutex
+	keys     map[string][]DataKey
+	shredded map[string]int64
+}
+
+func NewMemoryKeyStore() *MemoryKeyStore {
+	return &MemoryKeyStore{
+		keys:     make(map[string][]DataKey),
+		shredded: make(map[string]int64),
+	}
+}
+
+func (s *MemoryKeyStore) GetKeys(_ context.Context, owner string) ([]DataKey, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return append([]DataKey(nil), s.keys[owner]...), nil
+}
+
+func (s *MemoryKeyStore) AddKey(_ context.Context, key DataKey) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	keys := s.keys[key.Owner]
+	for _, k := range keys {
+		if k.Version == key.Version {
+			return ErrKeyExists
+		}
+	}
+	keys = append(keys, key)
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].Version < keys[j].Version
+	})
+	s.keys[key.Owner] = keys
+	return nil
+}
+
+func (s *MemoryKeyStore) UpdateKey(_ context.Context, key DataKey) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	keys := s.keys[key.Owner]
+	for i := range keys {
+		if keys[i].Version == key.Version {
+			keys[i] = key
+			return nil
+		}
+	}
+	return errors.New("data key not found")
+}
+
+func (s *MemoryKeyStore) DeleteKeys(_ context.Context, owner string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	delete(s.keys, owner)
+	s.shredded[owner] = time.Now().UnixNano()
+	return nil
+}
+
+func (s *MemoryKeyStore) IsShredded(_ context.Context, owner string) (bool, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	_, ok := s.shredded[owner]
+	return ok, nil
+}
+
+func (s *MemoryKeyStore) GetShreddedOwners(_ context.Context, since int64) ([]ShreddedOwner, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	var owners []ShreddedOwner
+	for owner, shreddedAt := range s.shredded {
+		if shreddedAt >= since {
+			owners = append(owners, ShreddedOwner{Owner: owner, ShreddedAt: shreddedAt})
+		}
+	}
+	return owners, nil
+}
+
+func (s *MemoryKeyStore) IterateKeys(_ context.Context, onKey func(DataKey) error) error {
+	s.lock.Lock()
+	keys := make([]DataKey, 0, len(s.keys))
+	for _, k := range s.keys {
+		keys = append(keys, k...)
+	}
+	s.lock.Unlock()
+	for _, k := range keys {
+		if err := onKey(k); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *MemoryKeyStore) Close(context.Context) error {
+	return nil
+}
@@ -0,0 +1,90 @@
+package encryption
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/plgd-dev/hub/v2/pkg/config/property/urischeme"
+)
+
+// MasterKey wraps the data keys of the owners. It can be implemented by a client of a key management service.
+type MasterKey interface {
+	// ID identifies the master key which wrapped the data key.
+	ID() string
+	Wrap(dataKey []byte) ([]byte, error)
+	Unwrap(wrappedKey []byte) ([]byte, error)
+}
+
+// AESMasterKey wraps the data keys by AES-256-GCM.
+type AESMasterKey struct {
+	id   string
+	aead cipher.AEAD
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// NewAESMasterKey creates the master key from 32 bytes.
+func NewAESMasterKey(key []byte) (*AESMasterKey, error) {
+	if len(key) != dataKeySize {
+		return nil, fmt.Errorf("invalid master key size %v, expected %v", len(key), dataKeySize)
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create master key: %w", err)
+	}
+	sum := sha256.Sum256(key)
+	return &AESMasterKey{
+		id:   hex.EncodeToString(sum[:8]),
+		aead: aead,
+	}, nil
+}
+
+// LoadAESMasterKey loads the master key from the file. The file contains 32 bytes encoded in base64.
+func LoadAESMasterKey(path urischeme.URIScheme) (*AESMasterKey, error) {
+	data, err := path.Read()
+	if err != nil {
+		return nil, fmt.Errorf("cannot read master key %v: %w", path, err)
+	}
+	key, err := base64.StdEncoding.DecodeString(string(bytes.TrimSpace(data)))
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode master key %v: %w", path, err)
+	}
+	return NewAESMasterKey(key)
+}
+
+func (k *AESMasterKey) ID() string {
+	return k.id
+}
+
+func (k *AESMasterKey) Wrap(dataKey []byte) ([]byte, error) {
+	nonce := make([]byte, k.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("cannot generate nonce: %w", err)
+	}
+	return k.aead.Seal(nonce, nonce, dataKey, []byte(k.id)), nil
+}
+
+func (k *AESMasterKey) Unwrap(wrappedKey []byte) ([]byte, error) {
+	if len(wrappedKey) < k.aead.NonceSize() {
+		return nil, errors.New("invalid wrapped key")
+	}
+	nonce := wrappedKey[:k.aead.NonceSize()]
+	dataKey, err := k.aead.Open(nil, nonce, wrappedKey[k.aead.NonceSize():], []byte(k.id))
+	if err != nil {
+		return nil, fmt.Errorf("cannot unwrap data key: %w", err)
+	}
+	return dataKey, nil
+}
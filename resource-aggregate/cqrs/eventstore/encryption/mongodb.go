@@ -0,0 +1,165 @@
+package encryption
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/plgd-dev/hub/v2/pkg/fsnotify"
+	"github.com/plgd-dev/hub/v2/pkg/log"
+	pkgMongo "github.com/plgd-dev/hub/v2/pkg/mongodb"
+	"github.com/plgd-dev/hub/v2/pkg/security/certManager/client"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	dataKeysCol       = "dataKeys"
+	shreddedOwnersCol = "shreddedOwners"
+
+	idKey         = "_id"
+	ownerKey      = "owner"
+	versionKey    = "version"
+	shreddedAtKey = "shreddedAt"
+)
+
+var ownerVersionUniqueIndex = mongo.IndexModel{
+	Keys: bson.D{
+		{Key: ownerKey, Value: 1},
+		{Key: versionKey, Value: 1},
+	},
+	Options: options.Index().SetUnique(true),
+}
+
+var shreddedAtIndex = mongo.IndexModel{
+	Keys: bson.D{
+		{Key: shreddedAtKey, Value: 1},
+	},
+}
+
+// MongoKeyStore persists the wrapped data keys in the MongoDB.
+type MongoKeyStore struct {
+	*pkgMongo.Store
+}
+
+func NewMongoKeyStore(ctx context.Context, cfg *pkgMongo.Config, fileWatcher *fsnotify.Watcher, logger log.Logger, tracerProvider trace.TracerProvider) (*MongoKeyStore, error) {
+	certManager, err := client.New(cfg.TLS, fileWatcher, logger, tracerProvider)
+	if err != nil {
+		return nil, fmt.Errorf("could not create cert manager: %w", err)
+	}
+	m, err := pkgMongo.NewStoreWithCollections(ctx, cfg, certManager.GetTLSConfig(), tracerProvider, map[string][]mongo.IndexModel{
+		dataKeysCol:       {ownerVersionUniqueIndex},
+		shreddedOwnersCol: {shreddedAtIndex},
+	})
+	if err != nil {
+		certManager.Close()
+		return nil, err
+	}
+	s := MongoKeyStore{Store: m}
+	s.SetOnClear(s.clearDatabases)
+	s.AddCloseFunc(certManager.Close)
+	return &s, nil
+}
+
+func (s *MongoKeyStore) clearDatabases(ctx context.Context) error {
+	var errors *multierror.Error
+	errors = multierror.Append(errors, s.Collection(dataKeysCol).Drop(ctx))
+	errors = multierror.Append(errors, s.Collection(shreddedOwnersCol).Drop(ctx))
+	return errors.ErrorOrNil()
+}
+
+func (s *MongoKeyStore) find(ctx context.Context, filter bson.D, onKey func(DataKey) error) error {
+	cur, err := s.Collection(dataKeysCol).Find(ctx, filter, options.Find().SetSort(bson.D{{Key: ownerKey, Value: 1}, {Key: versionKey, Value: 1}}))
+	if err != nil {
+		return fmt.Errorf("cannot load data keys: %w", err)
+	}
+	var errors *multierror.Error
+	for cur.Next(ctx) {
+		var k DataKey
+		if err = cur.Decode(&k); err != nil {
+			errors = multierror.Append(errors, fmt.Errorf("cannot decode data key: %w", err))
+			break
+		}
+		if err = onKey(k); err != nil {
+			errors = multierror.Append(errors, err)
+			break
+		}
+	}
+	errors = multierror.Append(errors, cur.Err())
+	errors = multierror.Append(errors, cur.Close(ctx))
+	return errors.ErrorOrNil()
+}
+
+func (s *MongoKeyStore) GetKeys(ctx context.Context, owner string) ([]DataKey, error) {
+	var keys []DataKey
+	err := s.find(ctx, bson.D{{Key: ownerKey, Value: owner}}, func(k DataKey) error {
+		keys = append(keys, k)
+		return nil
+	})
+	return keys, err
+}
+
+func (s *MongoKeyStore) AddKey(ctx context.Context, key DataKey) error {
+	_, err := s.Collection(dataKeysCol).InsertOne(ctx, key)
+	if mongo.IsDuplicateKeyError(err) {
+		return ErrKeyExists
+	}
+	if err != nil {
+		return fmt.Errorf("cannot add data key: %w", err)
+	}
+	return nil
+}
+
+func (s *MongoKeyStore) UpdateKey(ctx context.Context, key DataKey) error {
+	res, err := s.Collection(dataKeysCol).ReplaceOne(ctx, bson.D{{Key: ownerKey, Value: key.Owner}, {Key: versionKey, Value: key.Version}}, key)
+	if err != nil {
+		return fmt.Errorf("cannot update data key: %w", err)
+	}
+	if res.MatchedCount == 0 {
+		return errors.New("data key not found")
+	}
+	return nil
+}
+
+func (s *MongoKeyStore) DeleteKeys(ctx context.Context, owner string) error {
+	if _, err := s.Collection(dataKeysCol).DeleteMany(ctx, bson.D{{Key: ownerKey, Value: owner}}); err != nil {
+		return fmt.Errorf("cannot delete data keys: %w", err)
+	}
+	_, err := s.Collection(shreddedOwnersCol).UpdateOne(ctx, bson.D{{Key: idKey, Value: owner}},
+		bson.D{{Key: "$set", Value: bson.D{{Key: shreddedAtKey, Value: time.Now().UnixNano()}}}}, options.Update().SetUpsert(true))
+	if err != nil {
+		return fmt.Errorf("cannot record shredded owner: %w", err)
+	}
+	return nil
+}
+
+func (s *MongoKeyStore) IsShredded(ctx context.Context, owner string) (bool, error) {
+	err := s.Collection(shreddedOwnersCol).FindOne(ctx, bson.D{{Key: idKey, Value: owner}}).Err()
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("cannot get shredded owner: %w", err)
+	}
+	return true, nil
+}
+
+func (s *MongoKeyStore) GetShreddedOwners(ctx context.Context, since int64) ([]ShreddedOwner, error) {
+	cur, err := s.Collection(shreddedOwnersCol).Find(ctx, bson.D{{Key: shreddedAtKey, Value: bson.M{"$gte": since}}})
+	if err != nil {
+		return nil, fmt.Errorf("cannot load shredded owners: %w", err)
+	}
+	var owners []ShreddedOwner
+	if err = cur.All(ctx, &owners); err != nil {
+		return nil, fmt.Errorf("cannot decode shredded owners: %w", err)
+	}
+	return owners, nil
+}
+
+func (s *MongoKeyStore) IterateKeys(ctx context.Context, onKey func(DataKey) error) error {
+	return s.find(ctx, bson.D{}, onKey)
+}
@@ -49,7 +49,8 @@ type DeviceDocumentMetadata struct {
 // EventStore provides interface over eventstore. More aggregates can be grouped by groupID,
 // but aggregateID of aggregates must be unique against whole DB.
 type EventStore interface {
-	// Get events from the eventstore with timestamp larger than given value
+	// Get events from the eventstore with timestamp larger than given value, including the events stored before
+	// the latest snapshot of the aggregate.
 	// If timestamp is <=0 then the argument is ignored.
 	GetEvents(ctx context.Context, queries []GetEventsQuery, timestamp int64, eventHandler Handler) error
 	// Save save events to eventstore.
@@ -59,6 +60,12 @@ type EventStore interface {
 	// Only first event can be a snapshot.
 	Save(ctx context.Context, events ...Event) (status SaveStatus, err error)
 	LoadUpToVersion(ctx context.Context, queries []VersionQuery, eventHandler Handler) error
+	// LoadUpToTimestamp loads the events of the aggregates stored up to the timestamp. The events of each aggregate
+	// start from the nearest snapshot stored up to the timestamp, so they can be folded to the state of the aggregate
+	// at that time. When no event of the aggregate is stored up to the timestamp, only the oldest stored event of the
+	// aggregate is loaded, so the caller can detect that the older events were removed. The events of each aggregate
+	// are passed together ordered by the version. The eventstores which don't keep the history return ErrNotSupported.
+	LoadUpToTimestamp(ctx context.Context, queries []GetEventsQuery, timestamp int64, eventHandler Handler) error
 	LoadFromVersion(ctx context.Context, queries []VersionQuery, eventHandler Handler) error
 	LoadFromSnapshot(ctx context.Context, queries []SnapshotQuery, eventHandler Handler) error
 	RemoveUpToVersion(ctx context.Context, queries []VersionQuery) error
@@ -0,0 +1,43 @@
+package memory
+
+import (
+	"context"
+	"errors"
+
+	"github.com/plgd-dev/hub/v2/pkg/strings"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore"
+)
+
+func getDeviceIDFilter(queries []eventstore.DeleteQuery) []string {
+	deviceIDs := make([]string, 0, len(queries))
+	for _, q := range queries {
+		if q.GroupID != "" {
+			deviceIDs = append(deviceIDs, q.GroupID)
+		}
+	}
+	return strings.Unique(deviceIDs)
+}
+
+// applyDelete removes aggregates of the groups. Lock must be held by the caller.
+func (s *EventStore) applyDelete(groupIDs []string) {
+	groups := make(map[string]struct{}, len(groupIDs))
+	for _, groupID := range groupIDs {
+		groups[groupID] = struct{}{}
+	}
+	for aggregateID, a := range s.aggregates {
+		if _, ok := groups[a.groupID]; ok {
+			delete(s.aggregates, aggregateID)
+		}
+	}
+}
+
+// Delete documents with given group ids
+func (s *EventStore) Delete(_ context.Context, queries []eventstore.DeleteQuery) error {
+	deviceIDFilter := getDeviceIDFilter(queries)
+	if len(deviceIDFilter) == 0 {
+		return errors.New("failed to delete documents: invalid query")
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.write(logRecord{Delete: deviceIDFilter})
+}
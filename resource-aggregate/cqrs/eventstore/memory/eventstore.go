@@ -0,0 +1,262 @@
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/plgd-dev/hub/v2/pkg/fn"
+	"github.com/plgd-dev/hub/v2/pkg/log"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore/maintenance"
+)
+
+const defaultMaxEventsWithoutSnapshot = 1024
+
+type storedEvent struct {
+	Version    uint64 `json:"version"`
+	EventType  string `json:"eventType"`
+	IsSnapshot bool   `json:"isSnapshot"`
+	Timestamp  int64  `json:"timestamp"`
+	Data       []byte `json:"data"`
+}
+
+type aggregate struct {
+	groupID               string
+	aggregateID           string
+	latestVersion         uint64
+	latestSnapshotVersion uint64
+	serviceID             string
+	etag                  *eventstore.ETagData
+	types                 []string
+	// outboxVersion is version of the first unpublished event, nil when all events are published
+	outboxVersion *uint64
+	// outboxTimestamp is timestamp of the first unpublished event
+	outboxTimestamp int64
+	// events are ordered by version
+	events []storedEvent
+}
+
+func (a *aggregate) hasTypes(types []string) bool {
+	for _, t := range types {
+		found := false
+		for _, at := range a.types {
+			if at == t {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// storage keeps the aggregates and the maintenance tasks. It is shared by the eventstores opened by Open.
+type storage struct {
+	lock                     sync.RWMutex
+	aggregates               map[string]*aggregate
+	tasks                    map[string]maintenance.Task
+	log                      *appendLog
+	maxEventsWithoutSnapshot uint64
+}
+
+// close closes the append-only log.
+func (s *storage) close() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.log == nil {
+		return nil
+	}
+	err := s.log.close()
+	s.log = nil
+	return err
+}
+
+// EventStore implements an EventStore which keeps events in memory. Optionally all changes are written
+// to the append-only log, which is replayed when the eventstore is created.
+type EventStore struct {
+	*storage
+	closeFunc       fn.FuncList
+	dataMarshaler   MarshalerFunc
+	dataUnmarshaler UnmarshalerFunc
+	logger          log.Logger
+	outbox          bool
+	// release is called by Close, it closes the storage when no other eventstore uses it
+	release func() error
+}
+
+func newEventStore(config *Config, logger log.Logger, opts ...Option) (*EventStore, error) {
+	config.marshalerFunc = json.Marshal
+	config.unmarshalerFunc = json.Unmarshal
+	for _, o := range opts {
+		o.apply(config)
+	}
+	if config.marshalerFunc == nil {
+		return nil, errors.New("no event marshaler")
+	}
+	if config.unmarshalerFunc == nil {
+		return nil, errors.New("no event unmarshaler")
+	}
+	if config.MaxEventsWithoutSnapshot == 0 {
+		config.MaxEventsWithoutSnapshot = defaultMaxEventsWithoutSnapshot
+	}
+	return &EventStore{
+		dataMarshaler:   config.marshalerFunc,
+		dataUnmarshaler: config.unmarshalerFunc,
+		logger:          logger,
+		outbox:          config.outbox,
+	}, nil
+}
+
+// New creates the in-memory eventstore. When config.FilePath is set, the stored state is restored from the file.
+func New(_ context.Context, config *Config, logger log.Logger, opts ...Option) (*EventStore, error) {
+	s, err := newEventStore(config, logger, opts...)
+	if err != nil {
+		return nil, err
+	}
+	s.storage = &storage{
+		aggregates:               make(map[string]*aggregate),
+		tasks:                    make(map[string]maintenance.Task),
+		maxEventsWithoutSnapshot: uint64(config.MaxEventsWithoutSnapshot),
+	}
+	s.release = s.storage.close
+	if config.FilePath == "" {
+		return s, nil
+	}
+	l, err := openAppendLog(config.FilePath, s.apply)
+	if err != nil {
+		return nil, fmt.Errorf("cannot open append-only log %v: %w", config.FilePath, err)
+	}
+	s.log = l
+	return s, nil
+}
+
+type sharedStorage struct {
+	storage *storage
+	refs    int
+}
+
+var shared = struct {
+	lock     sync.Mutex
+	storages map[string]*sharedStorage
+}{
+	storages: make(map[string]*sharedStorage),
+}
+
+// Open opens the eventstore over the storage shared by all eventstores of the process opened with the same
+// config.FilePath, so the services running in one process see the same events. The empty config.FilePath
+// is shared too, so the independent eventstores must be created by New. Each eventstore uses its own
+// marshaler, unmarshaler and outbox options, the config.MaxEventsWithoutSnapshot must match the opened storage.
+// The storage is closed when the last eventstore is closed.
+func Open(ctx context.Context, config *Config, logger log.Logger, opts ...Option) (*EventStore, error) {
+	s, err := newEventStore(config, logger, opts...)
+	if err != nil {
+		return nil, err
+	}
+	shared.lock.Lock()
+	defer shared.lock.Unlock()
+	ss, ok := shared.storages[config.FilePath]
+	if ok && ss.storage.maxEventsWithoutSnapshot != uint64(config.MaxEventsWithoutSnapshot) {
+		return nil, fmt.Errorf("eventstore of filePath('%v') is already opened with maxEventsWithoutSnapshot(%v)", config.FilePath, ss.storage.maxEventsWithoutSnapshot)
+	}
+	if !ok {
+		n, errN := New(ctx, config, logger, opts...)
+		if errN != nil {
+			return nil, errN
+		}
+		ss = &sharedStorage{storage: n.storage}
+		shared.storages[config.FilePath] = ss
+	}
+	ss.refs++
+	s.storage = ss.storage
+	s.release = func() error {
+		shared.lock.Lock()
+		defer shared.lock.Unlock()
+		ss.refs--
+		if ss.refs > 0 {
+			return nil
+		}
+		delete(shared.storages, config.FilePath)
+		return ss.storage.close()
+	}
+	return s, nil
+}
+
+// apply applies the record to the state of the eventstore. Lock must be held by the caller.
+func (s *EventStore) apply(r logRecord) error {
+	switch {
+	case r.Save != nil:
+		s.applySave(r.Save)
+	case len(r.RemoveUpToVersion) > 0:
+		s.applyRemoveUpToVersion(r.RemoveUpToVersion)
+	case len(r.Delete) > 0:
+		s.applyDelete(r.Delete)
+	case r.InsertTask != nil:
+		s.tasks[r.InsertTask.AggregateID] = *r.InsertTask
+	case r.RemoveTask != nil:
+		delete(s.tasks, r.RemoveTask.AggregateID)
+	case r.Rewrite != nil:
+		s.applyRewrite(r.Rewrite)
+	case r.MarkPublished != nil:
+		s.applyMarkPublished(r.MarkPublished)
+	default:
+		return errors.New("unknown record")
+	}
+	return nil
+}
+
+// write stores the record to the append-only log and applies it. Lock must be held by the caller.
+func (s *EventStore) write(r logRecord) error {
+	if s.log != nil {
+		if err := s.log.append(r); err != nil {
+			return fmt.Errorf("cannot write to append-only log: %w", err)
+		}
+	}
+	return s.apply(r)
+}
+
+// getAggregates returns aggregates accepted by the filter ordered by groupID and aggregateID. Lock must be held by the caller.
+func (s *EventStore) getAggregates(filter func(a *aggregate) bool) []*aggregate {
+	aggregates := make([]*aggregate, 0, 16)
+	for _, a := range s.aggregates {
+		if filter(a) {
+			aggregates = append(aggregates, a)
+		}
+	}
+	sort.Slice(aggregates, func(i, j int) bool {
+		if aggregates[i].groupID != aggregates[j].groupID {
+			return aggregates[i].groupID < aggregates[j].groupID
+		}
+		return aggregates[i].aggregateID < aggregates[j].aggregateID
+	})
+	return aggregates
+}
+
+func (s *EventStore) AddCloseFunc(f func()) {
+	s.closeFunc.AddFunc(f)
+}
+
+// Clear clears the event storage.
+func (s *EventStore) Clear(context.Context) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.aggregates = make(map[string]*aggregate)
+	s.tasks = make(map[string]maintenance.Task)
+	if s.log != nil {
+		if err := s.log.truncate(); err != nil {
+			return fmt.Errorf("cannot clear: %w", err)
+		}
+	}
+	return nil
+}
+
+// Close closes the append-only log when the storage isn't used by another eventstore.
+func (s *EventStore) Close(context.Context) error {
+	defer s.closeFunc.Execute()
+	return s.release()
+}
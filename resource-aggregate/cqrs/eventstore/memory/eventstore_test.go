@@ -0,0 +1,226 @@
+package memory_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/plgd-dev/hub/v2/pkg/log"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore/memory"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore/test"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func newTestEventStore(ctx context.Context, t *testing.T, filePath string) *memory.EventStore {
+	store, err := memory.New(
+		ctx,
+		&memory.Config{
+			FilePath: filePath,
+		},
+		log.NewLogger(log.MakeDefaultConfig()),
+		memory.WithMarshaler(bson.Marshal),
+		memory.WithUnmarshaler(bson.Unmarshal),
+	)
+	require.NoError(t, err)
+	return store
+}
+
+func TestEventStore(t *testing.T) {
+	ctx := context.Background()
+	store := newTestEventStore(ctx, t, "")
+	defer func() {
+		errC := store.Close(ctx)
+		require.NoError(t, errC)
+	}()
+
+	test.AcceptanceTest(ctx, t, store)
+
+	err := store.Clear(ctx)
+	require.NoError(t, err)
+	test.GetEventsTest(ctx, t, store)
+}
+
+func TestEventStoreWithFile(t *testing.T) {
+	ctx := context.Background()
+	filePath := filepath.Join(t.TempDir(), "events.log")
+	store := newTestEventStore(ctx, t, filePath)
+	test.AcceptanceTest(ctx, t, store)
+
+	err := store.Clear(ctx)
+	require.NoError(t, err)
+	test.GetEventsTest(ctx, t, store)
+	err = store.Close(ctx)
+	require.NoError(t, err)
+}
+
+func TestEventStoreRestoreFromFile(t *testing.T) {
+	ctx := context.Background()
+	filePath := filepath.Join(t.TempDir(), "events.log")
+	store := newTestEventStore(ctx, t, filePath)
+
+	deviceID := "2f1d4c4e-7a1b-4b2f-9e39-0a9d6c3f5b11"
+	aggregateID := "7b8a6f5e-0f58-4c5d-9c4e-62d9b1f7a3a1"
+	serviceID := "c3a7e9d2-5b14-4f6e-8a2d-1e0b9f4c7d63"
+	events := []eventstore.Event{
+		test.MockEvent{
+			VersionI:     0,
+			EventTypeI:   "test0",
+			IsSnapshotI:  true,
+			AggregateIDI: aggregateID,
+			GroupIDI:     deviceID,
+			TimestampI:   1,
+			ServiceIDI:   serviceID,
+		},
+		test.MockEvent{
+			VersionI:     1,
+			EventTypeI:   "test1",
+			AggregateIDI: aggregateID,
+			GroupIDI:     deviceID,
+			TimestampI:   2,
+			ServiceIDI:   serviceID,
+		},
+	}
+	status, err := store.Save(ctx, events...)
+	require.NoError(t, err)
+	require.Equal(t, eventstore.Ok, status)
+	err = store.Close(ctx)
+	require.NoError(t, err)
+
+	store = newTestEventStore(ctx, t, filePath)
+	defer func() {
+		errC := store.Close(ctx)
+		require.NoError(t, errC)
+	}()
+	eh := test.NewMockEventHandler()
+	err = store.LoadFromVersion(ctx, []eventstore.VersionQuery{{GroupID: deviceID, AggregateID: aggregateID}}, eh)
+	require.NoError(t, err)
+	require.True(t, eh.Equals(events))
+
+	metadata, err := store.LoadDeviceMetadataByServiceIDs(ctx, []string{serviceID}, 0)
+	require.NoError(t, err)
+	require.Equal(t, []eventstore.DeviceDocumentMetadata{{DeviceID: deviceID, ServiceID: serviceID}}, metadata)
+
+	// version 1 is already stored
+	status, err = store.Save(ctx, test.MockEvent{
+		VersionI:     1,
+		EventTypeI:   "test1",
+		AggregateIDI: aggregateID,
+		GroupIDI:     deviceID,
+		TimestampI:   3,
+		ServiceIDI:   serviceID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, eventstore.ConcurrencyException, status)
+}
+
+func TestEventStoreOutbox(t *testing.T) {
+	ctx := context.Background()
+	store, err := memory.New(
+		ctx,
+		&memory.Config{
+			FilePath: filepath.Join(t.TempDir(), "events.log"),
+		},
+		log.NewLogger(log.MakeDefaultConfig()),
+		memory.WithMarshaler(bson.Marshal),
+		memory.WithUnmarshaler(bson.Unmarshal),
+		memory.WithOutbox(true),
+	)
+	require.NoError(t, err)
+	defer func() {
+		errC := store.Close(ctx)
+		require.NoError(t, errC)
+	}()
+
+	test.OutboxTest(ctx, t, store)
+}
+
+func TestEventStoreGetEventsPage(t *testing.T) {
+	ctx := context.Background()
+	store, err := memory.New(
+		ctx,
+		&memory.Config{
+			FilePath: filepath.Join(t.TempDir(), "events.log"),
+		},
+		log.NewLogger(log.MakeDefaultConfig()),
+		memory.WithMarshaler(bson.Marshal),
+		memory.WithUnmarshaler(bson.Unmarshal),
+	)
+	require.NoError(t, err)
+	defer func() {
+		errC := store.Close(ctx)
+		require.NoError(t, errC)
+	}()
+
+	test.GetEventsPageTest(ctx, t, store)
+	test.GetEventsFromTimestampTest(ctx, t, store)
+	test.LoadUpToTimestampTest(ctx, t, store)
+}
+
+func TestEventStoreOpenShared(t *testing.T) {
+	ctx := context.Background()
+	filePath := filepath.Join(t.TempDir(), "events.log")
+	open := func(opts ...memory.Option) *memory.EventStore {
+		store, err := memory.Open(ctx, &memory.Config{FilePath: filePath}, log.NewLogger(log.MakeDefaultConfig()),
+			append([]memory.Option{memory.WithMarshaler(bson.Marshal), memory.WithUnmarshaler(bson.Unmarshal)}, opts...)...)
+		require.NoError(t, err)
+		return store
+	}
+	writer := open(memory.WithOutbox(true))
+	reader := open()
+
+	// the storage is already opened with the default maxEventsWithoutSnapshot
+	_, err := memory.Open(ctx, &memory.Config{FilePath: filePath, MaxEventsWithoutSnapshot: 1}, log.NewLogger(log.MakeDefaultConfig()),
+		memory.WithMarshaler(bson.Marshal), memory.WithUnmarshaler(bson.Unmarshal))
+	require.Error(t, err)
+
+	deviceID := "2f1d4c4e-7a1b-4b2f-9e39-0a9d6c3f5b11"
+	aggregateID := "7b8a6f5e-0f58-4c5d-9c4e-62d9b1f7a3a1"
+	events := []eventstore.Event{
+		test.MockEvent{
+			VersionI:     0,
+			EventTypeI:   "test0",
+			IsSnapshotI:  true,
+			AggregateIDI: aggregateID,
+			GroupIDI:     deviceID,
+			TimestampI:   1,
+		},
+	}
+	status, err := writer.Save(ctx, events...)
+	require.NoError(t, err)
+	require.Equal(t, eventstore.Ok, status)
+
+	// the events saved by the writer are loaded by the reader
+	eh := test.NewMockEventHandler()
+	err = reader.LoadFromVersion(ctx, []eventstore.VersionQuery{{GroupID: deviceID, AggregateID: aggregateID}}, eh)
+	require.NoError(t, err)
+	require.True(t, eh.Equals(events))
+
+	// the outbox is enabled only for the writer
+	unpublished, err := writer.GetUnpublished(ctx, 2, 0)
+	require.NoError(t, err)
+	require.Len(t, unpublished, 1)
+	_, err = reader.GetUnpublished(ctx, 2, 0)
+	require.ErrorIs(t, err, eventstore.ErrNotSupported)
+
+	// the storage stays open until the last eventstore is closed
+	err = writer.Close(ctx)
+	require.NoError(t, err)
+	eh = test.NewMockEventHandler()
+	err = reader.LoadFromVersion(ctx, []eventstore.VersionQuery{{GroupID: deviceID, AggregateID: aggregateID}}, eh)
+	require.NoError(t, err)
+	require.True(t, eh.Equals(events))
+	err = reader.Close(ctx)
+	require.NoError(t, err)
+
+	// the storage is restored from the file with the unpublished events
+	writer = open(memory.WithOutbox(true))
+	defer func() {
+		errC := writer.Close(ctx)
+		require.NoError(t, errC)
+	}()
+	unpublished, err = writer.GetUnpublished(ctx, 2, 0)
+	require.NoError(t, err)
+	require.Len(t, unpublished, 1)
+}
@@ -0,0 +1,30 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"sort"
+)
+
+// Get latest ETags for device resources from event store for batch observing
+func (s *EventStore) GetLatestDeviceETags(_ context.Context, deviceID string, limit uint32) ([][]byte, error) {
+	if deviceID == "" {
+		return nil, errors.New("deviceID is invalid")
+	}
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	aggregates := s.getAggregates(func(a *aggregate) bool {
+		return a.groupID == deviceID && a.etag != nil && len(a.etag.ETag) > 0
+	})
+	sort.SliceStable(aggregates, func(i, j int) bool {
+		return aggregates[i].etag.Timestamp > aggregates[j].etag.Timestamp
+	})
+	etags := make([][]byte, 0, len(aggregates))
+	for _, a := range aggregates {
+		if limit > 0 && len(etags) >= int(limit) {
+			break
+		}
+		etags = append(etags, a.etag.ETag)
+	}
+	return etags, nil
+}
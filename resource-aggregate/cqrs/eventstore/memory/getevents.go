@@ -0,0 +1,133 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func matchGetEventsQuery(a *aggregate, query eventstore.GetEventsQuery) bool {
+	if query.GroupID != "" && a.groupID != query.GroupID {
+		return false
+	}
+	if query.AggregateID != "" && a.aggregateID != query.AggregateID {
+		return false
+	}
+	return a.hasTypes(query.Types)
+}
+
+// matchGetEventsTimestamp matches the events after the timestamp, also the ones before the latest snapshot. Without
+// the timestamp, the events from the latest snapshot are matched.
+func matchGetEventsTimestamp(a *aggregate, e storedEvent, timestamp int64) bool {
+	if timestamp > 0 {
+		return e.Timestamp > timestamp
+	}
+	return e.Version >= a.latestSnapshotVersion
+}
+
+// Get events from the eventstore.
+func (s *EventStore) GetEvents(ctx context.Context, queries []eventstore.GetEventsQuery, timestamp int64, eventHandler eventstore.Handler) error {
+	if len(queries) == 0 {
+		return status.Errorf(codes.InvalidArgument, "invalid queries")
+	}
+	s.lock.RLock()
+	aggregates := s.getAggregates(func(a *aggregate) bool {
+		for _, query := range queries {
+			if matchGetEventsQuery(a, query) {
+				return true
+			}
+		}
+		return false
+	})
+	var events []loadedEvent
+	for _, a := range aggregates {
+		events = collectEvents(events, a, func(e storedEvent) bool {
+			return matchGetEventsTimestamp(a, e, timestamp)
+		})
+	}
+	s.lock.RUnlock()
+	return s.handle(ctx, eventHandler, events)
+}
+
+// GetEventsPage gets the events ordered by the aggregateID and the version.
+func (s *EventStore) GetEventsPage(ctx context.Context, queries []eventstore.GetEventsQuery, timestamp int64, page eventstore.EventsPage, eventHandler eventstore.Handler) error {
+	if len(queries) == 0 {
+		return status.Errorf(codes.InvalidArgument, "invalid queries")
+	}
+	s.lock.RLock()
+	aggregates := s.getAggregates(func(a *aggregate) bool {
+		if page.After != nil && a.aggregateID < page.After.AggregateID {
+			return false
+		}
+		for _, query := range queries {
+			if matchGetEventsQuery(a, query) {
+				return true
+			}
+		}
+		return false
+	})
+	var events []loadedEvent
+	for _, a := range aggregates {
+		events = collectEvents(events, a, func(e storedEvent) bool {
+			if page.After != nil && !page.After.Less(eventstore.EventPosition{AggregateID: a.aggregateID, Version: e.Version}) {
+				return false
+			}
+			return matchGetEventsTimestamp(a, e, timestamp)
+		})
+	}
+	s.lock.RUnlock()
+	sort.Slice(events, func(i, j int) bool {
+		return eventstore.EventPosition{AggregateID: events[i].aggregateID, Version: events[i].event.Version}.Less(
+			eventstore.EventPosition{AggregateID: events[j].aggregateID, Version: events[j].event.Version})
+	})
+	if page.Limit > 0 && int64(len(events)) > page.Limit {
+		events = events[:page.Limit]
+	}
+	return s.handle(ctx, eventHandler, events)
+}
+
+// upToTimestampStartVersion returns the version of the nearest snapshot stored up to the timestamp. Without such
+// snapshot, the version of the oldest stored event is returned.
+func upToTimestampStartVersion(a *aggregate, timestamp int64) uint64 {
+	if len(a.events) == 0 {
+		return 0
+	}
+	start := a.events[0].Version
+	for _, e := range a.events {
+		if e.Timestamp > timestamp {
+			break
+		}
+		if e.IsSnapshot {
+			start = e.Version
+		}
+	}
+	return start
+}
+
+// LoadUpToTimestamp loads the events of the aggregates stored up to the timestamp from the nearest snapshot.
+func (s *EventStore) LoadUpToTimestamp(ctx context.Context, queries []eventstore.GetEventsQuery, timestamp int64, eventHandler eventstore.Handler) error {
+	if len(queries) == 0 {
+		return status.Errorf(codes.InvalidArgument, "invalid queries")
+	}
+	s.lock.RLock()
+	aggregates := s.getAggregates(func(a *aggregate) bool {
+		for _, query := range queries {
+			if matchGetEventsQuery(a, query) {
+				return true
+			}
+		}
+		return false
+	})
+	var events []loadedEvent
+	for _, a := range aggregates {
+		start := upToTimestampStartVersion(a, timestamp)
+		events = collectEvents(events, a, func(e storedEvent) bool {
+			return e.Version == start || (e.Version > start && e.Timestamp <= timestamp)
+		})
+	}
+	s.lock.RUnlock()
+	return s.handle(ctx, eventHandler, events)
+}
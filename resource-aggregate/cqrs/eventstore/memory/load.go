@@ -0,0 +1,176 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/go-multierror"
+	pkgTime "github.com/plgd-dev/hub/v2/pkg/time"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore"
+)
+
+type loadedEvent struct {
+	groupID     string
+	aggregateID string
+	event       storedEvent
+}
+
+type iterator struct {
+	events          []loadedEvent
+	dataUnmarshaler UnmarshalerFunc
+}
+
+func (i *iterator) Next(context.Context) (eventstore.EventUnmarshaler, bool) {
+	if len(i.events) == 0 {
+		return nil, false
+	}
+	e := i.events[0]
+	i.events = i.events[1:]
+	return eventstore.NewLoadedEvent(
+		e.event.Version,
+		e.event.EventType,
+		e.aggregateID,
+		e.groupID,
+		e.event.IsSnapshot,
+		pkgTime.Unix(0, e.event.Timestamp),
+		func(v interface{}) error {
+			return i.dataUnmarshaler(e.event.Data, v)
+		}), true
+}
+
+func (i *iterator) Err() error {
+	return nil
+}
+
+// collectEvents returns events of the aggregate accepted by the filter. Lock must be held by the caller.
+func collectEvents(events []loadedEvent, a *aggregate, filter func(e storedEvent) bool) []loadedEvent {
+	for _, e := range a.events {
+		if filter(e) {
+			events = append(events, loadedEvent{
+				groupID:     a.groupID,
+				aggregateID: a.aggregateID,
+				event:       e,
+			})
+		}
+	}
+	return events
+}
+
+// handle calls the handler without the lock, so the handler can use the eventstore.
+func (s *EventStore) handle(ctx context.Context, eh eventstore.Handler, events []loadedEvent) error {
+	return eh.Handle(ctx, &iterator{
+		events:          events,
+		dataUnmarshaler: s.dataUnmarshaler,
+	})
+}
+
+func validateVersionQuery(query eventstore.VersionQuery) error {
+	if query.GroupID == "" {
+		return fmt.Errorf("invalid GroupID('%v')", query.GroupID)
+	}
+	if query.AggregateID == "" || query.AggregateID == uuid.Nil.String() {
+		return fmt.Errorf("invalid AggregateID('%v')", query.AggregateID)
+	}
+	return nil
+}
+
+func (s *EventStore) loadEvents(ctx context.Context, queries []eventstore.VersionQuery, eh eventstore.Handler, minVersion func(a *aggregate, version uint64) uint64, maxVersion func(a *aggregate, version uint64) uint64) error {
+	var errors *multierror.Error
+	s.lock.RLock()
+	matched := make(map[string]eventstore.VersionQuery, len(queries))
+	for _, query := range queries {
+		if err := validateVersionQuery(query); err != nil {
+			errors = multierror.Append(errors, fmt.Errorf("cannot load events version for query('%+v'): %w", query, err))
+			continue
+		}
+		matched[query.AggregateID] = query
+	}
+	aggregates := s.getAggregates(func(a *aggregate) bool {
+		q, ok := matched[a.aggregateID]
+		return ok && q.GroupID == a.groupID
+	})
+	var events []loadedEvent
+	for _, a := range aggregates {
+		version := matched[a.aggregateID].Version
+		minV := minVersion(a, version)
+		maxV := maxVersion(a, version)
+		events = collectEvents(events, a, func(e storedEvent) bool {
+			return e.Version >= minV && e.Version < maxV
+		})
+	}
+	s.lock.RUnlock()
+	if len(matched) > 0 {
+		if err := s.handle(ctx, eh, events); err != nil {
+			errors = multierror.Append(errors, err)
+		}
+	}
+	return errors.ErrorOrNil()
+}
+
+// LoadUpToVersion loads aggregates events up to a specific version.
+func (s *EventStore) LoadUpToVersion(ctx context.Context, queries []eventstore.VersionQuery, eh eventstore.Handler) error {
+	return s.loadEvents(ctx, queries, eh, func(*aggregate, uint64) uint64 {
+		return 0
+	}, func(_ *aggregate, version uint64) uint64 {
+		return version
+	})
+}
+
+// LoadFromVersion loads aggregates events from version. Events older than the latest snapshot are
+// skipped, because the snapshot already contains them.
+func (s *EventStore) LoadFromVersion(ctx context.Context, queries []eventstore.VersionQuery, eh eventstore.Handler) error {
+	return s.loadEvents(ctx, queries, eh, func(a *aggregate, version uint64) uint64 {
+		return max(version, a.latestSnapshotVersion)
+	}, func(*aggregate, uint64) uint64 {
+		return ^uint64(0)
+	})
+}
+
+func matchSnapshotQuery(a *aggregate, query eventstore.SnapshotQuery) bool {
+	if a.groupID != query.GroupID {
+		return false
+	}
+	if query.AggregateID != "" && query.AggregateID != uuid.Nil.String() {
+		return a.aggregateID == query.AggregateID
+	}
+	return a.hasTypes(query.Types)
+}
+
+func fromLatestSnapshot(a *aggregate) func(e storedEvent) bool {
+	return func(e storedEvent) bool {
+		return e.Version >= a.latestSnapshotVersion
+	}
+}
+
+// LoadFromSnapshot loads events from the last snapshot eventstore.
+func (s *EventStore) LoadFromSnapshot(ctx context.Context, queries []eventstore.SnapshotQuery, eventHandler eventstore.Handler) error {
+	if len(queries) == 0 {
+		return errors.New("not supported")
+	}
+	validQueries := make([]eventstore.SnapshotQuery, 0, len(queries))
+	for _, query := range queries {
+		if query.GroupID != "" {
+			validQueries = append(validQueries, query)
+		}
+	}
+	if len(validQueries) == 0 {
+		return nil
+	}
+	s.lock.RLock()
+	aggregates := s.getAggregates(func(a *aggregate) bool {
+		for _, query := range validQueries {
+			if matchSnapshotQuery(a, query) {
+				return true
+			}
+		}
+		return false
+	})
+	var events []loadedEvent
+	for _, a := range aggregates {
+		events = collectEvents(events, a, fromLatestSnapshot(a))
+	}
+	s.lock.RUnlock()
+	return s.handle(ctx, eventHandler, events)
+}
@@ -0,0 +1,37 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/plgd-dev/hub/v2/pkg/strings"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *EventStore) LoadDeviceMetadataByServiceIDs(_ context.Context, serviceIDs []string, limit int64) ([]eventstore.DeviceDocumentMetadata, error) {
+	if len(serviceIDs) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid serviceIDs")
+	}
+	services := make(map[string]struct{}, len(serviceIDs))
+	for _, serviceID := range strings.Unique(serviceIDs) {
+		services[serviceID] = struct{}{}
+	}
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	aggregates := s.getAggregates(func(a *aggregate) bool {
+		_, ok := services[a.serviceID]
+		return ok && a.serviceID != ""
+	})
+	ret := make([]eventstore.DeviceDocumentMetadata, 0, len(aggregates))
+	for _, a := range aggregates {
+		if limit > 0 && int64(len(ret)) >= limit {
+			break
+		}
+		ret = append(ret, eventstore.DeviceDocumentMetadata{
+			DeviceID:  a.groupID,
+			ServiceID: a.serviceID,
+		})
+	}
+	return ret, nil
+}
@@ -0,0 +1,106 @@
+package memory
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore/maintenance"
+)
+
+// logRecord is one line of the append-only log. Exactly one field is set.
+type logRecord struct {
+	Save              *saveRecord               `json:"save,omitempty"`
+	RemoveUpToVersion []eventstore.VersionQuery `json:"removeUpToVersion,omitempty"`
+	Delete            []string                  `json:"delete,omitempty"`
+	InsertTask        *maintenance.Task         `json:"insertTask,omitempty"`
+	RemoveTask        *maintenance.Task         `json:"removeTask,omitempty"`
+	Rewrite           *rewriteRecord            `json:"rewrite,omitempty"`
+	MarkPublished     *markPublishedRecord      `json:"markPublished,omitempty"`
+}
+
+// appendLog stores records as JSON lines to the file.
+type appendLog struct {
+	file *os.File
+}
+
+// openAppendLog opens the file and calls apply for each stored record. An incomplete
+// last record, caused by an interrupted write, is dropped.
+func openAppendLog(path string, apply func(r logRecord) error) (*appendLog, error) {
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	offset, err := replay(file, apply)
+	if err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+	if err = file.Truncate(offset); err != nil {
+		_ = file.Close()
+		return nil, fmt.Errorf("cannot drop incomplete record: %w", err)
+	}
+	if _, err = file.Seek(offset, io.SeekStart); err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+	return &appendLog{
+		file: file,
+	}, nil
+}
+
+// replay returns offset of the end of the last complete record.
+func replay(file *os.File, apply func(r logRecord) error) (int64, error) {
+	reader := bufio.NewReader(file)
+	var offset int64
+	for {
+		line, err := reader.ReadBytes('\n')
+		if errors.Is(err, io.EOF) {
+			return offset, nil
+		}
+		if err != nil {
+			return 0, err
+		}
+		var r logRecord
+		if err = json.Unmarshal(line, &r); err != nil {
+			return 0, fmt.Errorf("cannot decode record at offset %v: %w", offset, err)
+		}
+		if err = apply(r); err != nil {
+			return 0, fmt.Errorf("cannot apply record at offset %v: %w", offset, err)
+		}
+		offset += int64(len(line))
+	}
+}
+
+func (l *appendLog) append(r logRecord) error {
+	data, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	_, err = l.file.Write(append(data, '\n'))
+	return err
+}
+
+func (l *appendLog) truncate() error {
+	if err := l.file.Truncate(0); err != nil {
+		return err
+	}
+	_, err := l.file.Seek(0, io.SeekStart)
+	return err
+}
+
+func (l *appendLog) close() error {
+	var errors *multierror.Error
+	if err := l.file.Sync(); err != nil {
+		errors = multierror.Append(errors, err)
+	}
+	if err := l.file.Close(); err != nil {
+		errors = multierror.Append(errors, err)
+	}
+	return errors.ErrorOrNil()
+}
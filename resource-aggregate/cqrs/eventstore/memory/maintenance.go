@@ -0,0 +1,71 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore/maintenance"
+)
+
+// Insert stores (or updates) the information about the latest snapshot version per aggregate into the DB
+func (s *EventStore) Insert(_ context.Context, task maintenance.Task) error {
+	if task.GroupID == "" {
+		return errors.New("could not insert record - group ID cannot be empty")
+	}
+	if task.AggregateID == "" {
+		return errors.New("could not insert record - aggregate ID cannot be empty")
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if t, ok := s.tasks[task.AggregateID]; ok && t.Version >= task.Version {
+		return fmt.Errorf("could not insert record with aggregate ID %v, version %d - version is outdated", task.AggregateID, task.Version)
+	}
+	if err := s.write(logRecord{InsertTask: &task}); err != nil {
+		return fmt.Errorf("could not insert record with aggregate ID %v, version %d - %w", task.AggregateID, task.Version, err)
+	}
+	return nil
+}
+
+type taskIterator struct {
+	tasks []maintenance.Task
+}
+
+func (i *taskIterator) Next(_ context.Context, task *maintenance.Task) bool {
+	if len(i.tasks) == 0 {
+		return false
+	}
+	*task = i.tasks[0]
+	i.tasks = i.tasks[1:]
+	return true
+}
+
+func (i *taskIterator) Err() error {
+	return nil
+}
+
+// Query retrieves the latest snapshot version per aggregate for thw number of aggregates specified by 'limit'
+func (s *EventStore) Query(ctx context.Context, limit int, taskHandler maintenance.TaskHandler) error {
+	s.lock.RLock()
+	tasks := make([]maintenance.Task, 0, len(s.tasks))
+	for _, task := range s.tasks {
+		if limit > 0 && len(tasks) >= limit {
+			break
+		}
+		tasks = append(tasks, task)
+	}
+	s.lock.RUnlock()
+	return taskHandler.Handle(ctx, &taskIterator{
+		tasks: tasks,
+	})
+}
+
+// Remove deletes (the latest snapshot version) database record for a given aggregate ID
+func (s *EventStore) Remove(_ context.Context, task maintenance.Task) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if t, ok := s.tasks[task.AggregateID]; !ok || t != task {
+		return fmt.Errorf("could not remove record with aggregate ID %s and/or version %d", task.AggregateID, task.Version)
+	}
+	return s.write(logRecord{RemoveTask: &task})
+}
@@ -0,0 +1,72 @@
+package memory
+
+import (
+	"context"
+	"errors"
+
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore"
+)
+
+type markPublishedRecord struct {
+	AggregateID string `json:"aggregateId"`
+	FromVersion uint64 `json:"fromVersion"`
+	ToVersion   uint64 `json:"toVersion"`
+}
+
+// GetUnpublished returns up to limit aggregates with unpublished events stored before the timestamp.
+func (s *EventStore) GetUnpublished(_ context.Context, savedBefore int64, limit int64) ([]eventstore.OutboxEntry, error) {
+	if !s.outbox {
+		return nil, eventstore.ErrNotSupported
+	}
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	aggregates := s.getAggregates(func(a *aggregate) bool {
+		return a.outboxVersion != nil && a.outboxTimestamp < savedBefore
+	})
+	if limit > 0 && int64(len(aggregates)) > limit {
+		aggregates = aggregates[:limit]
+	}
+	entries := make([]eventstore.OutboxEntry, 0, len(aggregates))
+	for _, a := range aggregates {
+		entries = append(entries, eventstore.OutboxEntry{
+			GroupID:     a.groupID,
+			AggregateID: a.aggregateID,
+			Version:     *a.outboxVersion,
+		})
+	}
+	return entries, nil
+}
+
+// applyMarkPublished moves the first unpublished event of the aggregate. Lock must be held by the caller.
+func (s *EventStore) applyMarkPublished(r *markPublishedRecord) {
+	a, ok := s.aggregates[r.AggregateID]
+	if !ok || a.outboxVersion == nil || *a.outboxVersion < r.FromVersion || *a.outboxVersion > r.ToVersion {
+		return
+	}
+	if a.latestVersion <= r.ToVersion {
+		a.outboxVersion = nil
+		a.outboxTimestamp = 0
+		return
+	}
+	v := r.ToVersion + 1
+	a.outboxVersion = &v
+	// the remaining events are returned by GetUnpublished after the latest stored event
+	a.outboxTimestamp = a.events[len(a.events)-1].Timestamp
+}
+
+// MarkPublished marks the events of the aggregate from fromVersion to toVersion as published.
+func (s *EventStore) MarkPublished(_ context.Context, aggregateID string, fromVersion, toVersion uint64) error {
+	if !s.outbox {
+		return eventstore.ErrNotSupported
+	}
+	if fromVersion > toVersion {
+		return errors.New("invalid interval of versions")
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.write(logRecord{MarkPublished: &markPublishedRecord{
+		AggregateID: aggregateID,
+		FromVersion: fromVersion,
+		ToVersion:   toVersion,
+	}})
+}
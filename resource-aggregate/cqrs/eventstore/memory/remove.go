@@ -0,0 +1,48 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore"
+)
+
+// applyRemoveUpToVersion removes events older than the version. Lock must be held by the caller.
+func (s *EventStore) applyRemoveUpToVersion(queries []eventstore.VersionQuery) {
+	for _, query := range queries {
+		a, ok := s.aggregates[query.AggregateID]
+		if !ok || a.groupID != query.GroupID {
+			continue
+		}
+		events := make([]storedEvent, 0, len(a.events))
+		for _, e := range a.events {
+			if e.Version >= query.Version {
+				events = append(events, e)
+			}
+		}
+		a.events = events
+	}
+}
+
+// RemoveUpToVersion deletes the aggregated events up to a specific version.
+func (s *EventStore) RemoveUpToVersion(_ context.Context, queries []eventstore.VersionQuery) error {
+	var errors *multierror.Error
+	validQueries := make([]eventstore.VersionQuery, 0, len(queries))
+	for _, query := range queries {
+		if err := validateVersionQuery(query); err != nil {
+			errors = multierror.Append(errors, fmt.Errorf("cannot remove events version for query('%+v'): %w", query, err))
+			continue
+		}
+		validQueries = append(validQueries, query)
+	}
+	if len(validQueries) == 0 {
+		return errors.ErrorOrNil()
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if err := s.write(logRecord{RemoveUpToVersion: validQueries}); err != nil {
+		errors = multierror.Append(errors, err)
+	}
+	return errors.ErrorOrNil()
+}
@@ -0,0 +1,59 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore/upcast"
+)
+
+type rewriteRecord struct {
+	AggregateID string `json:"aggregateId"`
+	Version     uint64 `json:"version"`
+	Data        []byte `json:"data"`
+}
+
+// applyRewrite replaces data of the stored event. Lock must be held by the caller.
+func (s *EventStore) applyRewrite(r *rewriteRecord) {
+	a, ok := s.aggregates[r.AggregateID]
+	if !ok {
+		return
+	}
+	for idx := range a.events {
+		if a.events[idx].Version == r.Version {
+			a.events[idx].Data = r.Data
+			return
+		}
+	}
+}
+
+// RewriteEvents calls the rewrite function for each stored event and replaces data of the changed events.
+func (s *EventStore) RewriteEvents(ctx context.Context, rewrite upcast.RewriteFunc) (int, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	aggregates := s.getAggregates(func(*aggregate) bool { return true })
+	var count int
+	for _, a := range aggregates {
+		if err := ctx.Err(); err != nil {
+			return count, err
+		}
+		for _, e := range a.events {
+			data, changed, err := rewrite(e.EventType, e.Data)
+			if err != nil {
+				return count, fmt.Errorf("cannot rewrite event('%v', %v): %w", a.aggregateID, e.Version, err)
+			}
+			if !changed {
+				continue
+			}
+			if err = s.write(logRecord{Rewrite: &rewriteRecord{
+				AggregateID: a.aggregateID,
+				Version:     e.Version,
+				Data:        data,
+			}}); err != nil {
+				return count, err
+			}
+			count++
+		}
+	}
+	return count, nil
+}
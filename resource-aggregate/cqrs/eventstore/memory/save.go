@@ -0,0 +1,148 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+
+	pkgTime "github.com/plgd-dev/hub/v2/pkg/time"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore"
+)
+
+type saveRecord struct {
+	GroupID     string        `json:"groupId"`
+	AggregateID string        `json:"aggregateId"`
+	Events      []storedEvent `json:"events"`
+	// ServiceID is nil when the serviceID is not changed, empty string unsets the serviceID
+	ServiceID *string              `json:"serviceId,omitempty"`
+	ETag      *eventstore.ETagData `json:"etag,omitempty"`
+	Types     []string             `json:"types,omitempty"`
+	// Unpublished marks the events for the outbox relay
+	Unpublished bool `json:"unpublished,omitempty"`
+}
+
+func (r *saveRecord) firstVersion() uint64 {
+	return r.Events[0].Version
+}
+
+func (r *saveRecord) latestVersion() uint64 {
+	return r.Events[len(r.Events)-1].Version
+}
+
+// latestSnapshotVersion returns version of the latest snapshot. The first event of the aggregate is considered as the snapshot.
+func (r *saveRecord) latestSnapshotVersion() (uint64, bool) {
+	for i := len(r.Events) - 1; i >= 0; i-- {
+		if r.Events[i].IsSnapshot {
+			return r.Events[i].Version, true
+		}
+	}
+	if r.firstVersion() == 0 {
+		return 0, true
+	}
+	return 0, false
+}
+
+func makeSaveRecord(events []eventstore.Event, marshaler MarshalerFunc) (*saveRecord, error) {
+	r := saveRecord{
+		GroupID:     events[0].GroupID(),
+		AggregateID: events[0].AggregateID(),
+		Events:      make([]storedEvent, 0, len(events)),
+	}
+	for idx, event := range events {
+		raw, err := marshaler(event)
+		if err != nil {
+			return nil, fmt.Errorf("cannot marshal event[%v]: %w", idx, err)
+		}
+		r.Events = append(r.Events, storedEvent{
+			Version:    event.Version(),
+			EventType:  event.EventType(),
+			IsSnapshot: event.IsSnapshot(),
+			Timestamp:  pkgTime.UnixNano(event.Timestamp()),
+			Data:       raw,
+		})
+		if etag := event.ETag(); etag != nil {
+			r.ETag = etag
+		}
+		if len(event.Types()) > 0 {
+			r.Types = event.Types()
+		}
+		if serviceID, ok := event.ServiceID(); ok {
+			r.ServiceID = &serviceID
+		}
+	}
+	return &r, nil
+}
+
+// checkSave verifies that the record can be stored. Lock must be held by the caller.
+func (s *EventStore) checkSave(r *saveRecord) eventstore.SaveStatus {
+	a, ok := s.aggregates[r.AggregateID]
+	if r.firstVersion() == 0 {
+		if ok {
+			return eventstore.ConcurrencyException
+		}
+		return eventstore.Ok
+	}
+	// latestVersion shall be lower by 1 as new event otherwise other event was stored (occ).
+	if !ok || a.groupID != r.GroupID || a.latestVersion != r.firstVersion()-1 {
+		return eventstore.ConcurrencyException
+	}
+	if _, ok := r.latestSnapshotVersion(); !ok && r.latestVersion()-a.latestSnapshotVersion > s.maxEventsWithoutSnapshot {
+		return eventstore.SnapshotRequired
+	}
+	return eventstore.Ok
+}
+
+// applySave stores the checked record. Lock must be held by the caller.
+func (s *EventStore) applySave(r *saveRecord) {
+	a, ok := s.aggregates[r.AggregateID]
+	if !ok {
+		a = &aggregate{
+			groupID:     r.GroupID,
+			aggregateID: r.AggregateID,
+		}
+		s.aggregates[r.AggregateID] = a
+	}
+	if r.Unpublished && a.outboxVersion == nil {
+		v := r.firstVersion()
+		a.outboxVersion = &v
+		a.outboxTimestamp = r.Events[0].Timestamp
+	}
+	a.events = append(a.events, r.Events...)
+	a.latestVersion = r.latestVersion()
+	if v, ok := r.latestSnapshotVersion(); ok {
+		a.latestSnapshotVersion = v
+	}
+	if r.ServiceID != nil {
+		a.serviceID = *r.ServiceID
+	}
+	if r.ETag != nil {
+		a.etag = r.ETag
+	}
+	if len(r.Types) > 0 {
+		a.types = r.Types
+	}
+}
+
+// Save save events to eventstore.
+// AggregateID, GroupID and EventType are required.
+// All events within one Save operation shall have the same AggregateID and GroupID.
+// Versions shall be unique and ascend continually.
+// Only first event can be a snapshot.
+func (s *EventStore) Save(_ context.Context, events ...eventstore.Event) (eventstore.SaveStatus, error) {
+	if err := eventstore.ValidateEventsBeforeSave(events); err != nil {
+		return eventstore.Fail, err
+	}
+	r, err := makeSaveRecord(events, s.dataMarshaler)
+	if err != nil {
+		return eventstore.Fail, err
+	}
+	r.Unpublished = s.outbox
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if status := s.checkSave(r); status != eventstore.Ok {
+		return status, nil
+	}
+	if err = s.write(logRecord{Save: r}); err != nil {
+		return eventstore.Fail, fmt.Errorf("cannot save events('%v'): %w", events, err)
+	}
+	return eventstore.Ok, nil
+}
@@ -10,6 +10,7 @@ type Config struct {
 
 	marshalerFunc   MarshalerFunc   `yaml:"-"`
 	unmarshalerFunc UnmarshalerFunc `yaml:"-"`
+	outbox          bool            `yaml:"-"`
 }
 
 func (c *Config) Validate() error {
@@ -50,3 +51,18 @@ func WithUnmarshaler(f UnmarshalerFunc) UnmarshalerOpt {
 		f: f,
 	}
 }
+
+type OutboxOpt struct {
+	enabled bool
+}
+
+func (o OutboxOpt) apply(cfg *Config) {
+	cfg.outbox = o.enabled
+}
+
+// WithOutbox marks the saved events as unpublished, so they can be published by the outbox relay
+func WithOutbox(enabled bool) OutboxOpt {
+	return OutboxOpt{
+		enabled: enabled,
+	}
+}
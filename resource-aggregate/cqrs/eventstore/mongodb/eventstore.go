@@ -52,6 +52,8 @@ const (
 	typesKey                  = "types"
 	latestETagKeyTimestampKey = latestETagKey + "." + timestampKey
 	serviceIDKey              = "serviceid"
+	outboxVersionKey          = "outboxversion"
+	outboxTimestampKey        = "outboxtimestamp" // timestamp of the first unpublished event
 )
 
 var aggregateIDLastVersionQueryIndex = bson.D{
@@ -101,6 +103,11 @@ var groupIDTypesQueryIndex = bson.D{
 	{Key: isActiveKey, Value: 1},
 }
 
+var outboxVersionTimestampQueryIndex = bson.D{
+	{Key: outboxVersionKey, Value: 1},
+	{Key: outboxTimestampKey, Value: 1},
+}
+
 type signOperator string
 
 const (
@@ -125,6 +132,7 @@ type EventStore struct {
 	dataMarshaler   MarshalerFunc
 	dataUnmarshaler UnmarshalerFunc
 	ensuredIndexes  *cache.Cache[string, bool]
+	outbox          bool
 }
 
 func (s *EventStore) AddCloseFunc(f func()) {
@@ -145,7 +153,7 @@ func New(ctx context.Context, config *Config, fileWatcher *fsnotify.Watcher, log
 	if err != nil {
 		return nil, err
 	}
-	store, err := newEventStoreWithClient(ctx, mgoStore, config.Embedded.Database, "events", config.marshalerFunc, config.unmarshalerFunc, nil)
+	store, err := newEventStoreWithClient(ctx, mgoStore, config.Embedded.Database, "events", config.marshalerFunc, config.unmarshalerFunc, nil, config.outbox)
 	if err != nil {
 		return nil, err
 	}
@@ -154,7 +162,7 @@ func New(ctx context.Context, config *Config, fileWatcher *fsnotify.Watcher, log
 }
 
 // NewEventStoreWithClient creates a new EventStore with a session.
-func newEventStoreWithClient(ctx context.Context, store *pkgMongo.Store, dbPrefix string, colPrefix string, eventMarshaler MarshalerFunc, eventUnmarshaler UnmarshalerFunc, logDebugfFunc LogDebugfFunc) (*EventStore, error) {
+func newEventStoreWithClient(ctx context.Context, store *pkgMongo.Store, dbPrefix string, colPrefix string, eventMarshaler MarshalerFunc, eventUnmarshaler UnmarshalerFunc, logDebugfFunc LogDebugfFunc, outbox bool) (*EventStore, error) {
 	if store == nil {
 		return nil, errors.New("invalid client")
 	}
@@ -194,6 +202,7 @@ func newEventStoreWithClient(ctx context.Context, store *pkgMongo.Store, dbPrefi
 		dataUnmarshaler: eventUnmarshaler,
 		LogDebugfFunc:   logDebugfFunc,
 		ensuredIndexes:  ensuredIndexes,
+		outbox:          outbox,
 	}
 
 	colAv := s.client().Database(s.DBName()).Collection(maintenanceCName)
@@ -203,8 +212,7 @@ func newEventStoreWithClient(ctx context.Context, store *pkgMongo.Store, dbPrefi
 	}
 
 	col := s.client().Database(s.DBName()).Collection(getEventCollectionName())
-	err = s.ensureIndex(ctx,
-		col,
+	indexes := []bson.D{
 		aggregateIDLastVersionQueryIndex,
 		aggregateIDFirstVersionQueryIndex,
 		groupIDQueryIndex,
@@ -214,7 +222,11 @@ func newEventStoreWithClient(ctx context.Context, store *pkgMongo.Store, dbPrefi
 		groupIDETagLatestTimestampQueryIndex,
 		serviceIDQueryIndex,
 		groupIDTypesQueryIndex,
-	)
+	}
+	if outbox {
+		indexes = append(indexes, outboxVersionTimestampQueryIndex)
+	}
+	err = s.ensureIndex(ctx, col, indexes...)
 	if err != nil {
 		return nil, fmt.Errorf("cannot save events: %w", err)
 	}
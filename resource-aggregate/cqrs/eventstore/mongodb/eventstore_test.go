@@ -8,6 +8,7 @@ import (
 	"github.com/plgd-dev/hub/v2/pkg/log"
 	pkgMongo "github.com/plgd-dev/hub/v2/pkg/mongodb"
 	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore/mongodb"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore/test"
 	"github.com/plgd-dev/hub/v2/test/config"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -15,7 +16,7 @@ import (
 	"go.opentelemetry.io/otel/trace/noop"
 )
 
-func NewTestEventStore(ctx context.Context, fileWatcher *fsnotify.Watcher, logger log.Logger) (*mongodb.EventStore, error) {
+func NewTestEventStore(ctx context.Context, fileWatcher *fsnotify.Watcher, logger log.Logger, opts ...mongodb.Option) (*mongodb.EventStore, error) {
 	store, err := mongodb.New(
 		ctx,
 		&mongodb.Config{
@@ -27,8 +28,10 @@ func NewTestEventStore(ctx context.Context, fileWatcher *fsnotify.Watcher, logge
 		fileWatcher,
 		logger,
 		noop.NewTracerProvider(),
-		mongodb.WithMarshaler(bson.Marshal),
-		mongodb.WithUnmarshaler(bson.Unmarshal),
+		append([]mongodb.Option{
+			mongodb.WithMarshaler(bson.Marshal),
+			mongodb.WithUnmarshaler(bson.Unmarshal),
+		}, opts...)...,
 	)
 	return store, err
 }
@@ -61,3 +64,47 @@ func TestEventStore(t *testing.T) {
 	require.NoError(t, err)
 	GetEventsTest(ctx, t, store)
 }
+
+func TestEventStoreOutbox(t *testing.T) {
+	logger := log.NewLogger(log.MakeDefaultConfig())
+	fileWatcher, err := fsnotify.NewWatcher(logger)
+	require.NoError(t, err)
+	defer func() {
+		errC := fileWatcher.Close()
+		require.NoError(t, errC)
+	}()
+
+	ctx := context.Background()
+	store, err := NewTestEventStore(ctx, fileWatcher, logger, mongodb.WithOutbox(true))
+	require.NoError(t, err)
+	defer func() {
+		errC := store.Clear(ctx)
+		require.NoError(t, errC)
+		_ = store.Close(ctx)
+	}()
+
+	test.OutboxTest(ctx, t, store)
+}
+
+func TestEventStoreGetEventsPage(t *testing.T) {
+	logger := log.NewLogger(log.MakeDefaultConfig())
+	fileWatcher, err := fsnotify.NewWatcher(logger)
+	require.NoError(t, err)
+	defer func() {
+		errC := fileWatcher.Close()
+		require.NoError(t, errC)
+	}()
+
+	ctx := context.Background()
+	store, err := NewTestEventStore(ctx, fileWatcher, logger)
+	require.NoError(t, err)
+	defer func() {
+		errC := store.Clear(ctx)
+		require.NoError(t, errC)
+		_ = store.Close(ctx)
+	}()
+
+	test.GetEventsPageTest(ctx, t, store)
+	test.GetEventsFromTimestampTest(ctx, t, store)
+	test.LoadUpToTimestampTest(ctx, t, store)
+}
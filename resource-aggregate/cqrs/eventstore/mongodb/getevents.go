@@ -2,7 +2,6 @@ package mongodb
 
 import (
 	"context"
-	"errors"
 	"time"
 
 	"github.com/hashicorp/go-multierror"
@@ -10,6 +9,8 @@ import (
 	"github.com/plgd-dev/kit/v2/strings"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // Get array of unique aggregateID values
@@ -163,7 +164,7 @@ func (s *EventStore) GetEvents(ctx context.Context, queries []eventstore.GetEven
 		s.LogDebugfFunc("mongodb.Evenstore.GetEvents takes %v", time.Since(t))
 	}()
 	if len(queries) == 0 {
-		return errors.New("not supported")
+		return status.Errorf(codes.InvalidArgument, "invalid queries")
 	}
 
 	eventFilter := GetNormalizedGetEventsFilter(queries)
@@ -182,3 +183,101 @@ func (s *EventStore) GetEvents(ctx context.Context, queries []eventstore.GetEven
 	}
 	return errors.ErrorOrNil()
 }
+
+func getEventsPageFilter(queries []eventstore.GetEventsQuery, timestamp int64, after *eventstore.EventPosition) bson.D {
+	var filter bson.D
+	eventFilter := GetNormalizedGetEventsFilter(queries)
+	if eventFilter.All {
+		filter = getEventsFilter("", nil, timestamp)
+	} else {
+		groupFilters := make(bson.A, 0, len(eventFilter.DeviceIds))
+		for groupID := range eventFilter.DeviceIds {
+			groupFilters = append(groupFilters, getEventsFilter(groupID, queries, timestamp))
+		}
+		filter = bson.D{{Key: "$or", Value: groupFilters}}
+	}
+	if after == nil {
+		return filter
+	}
+	// documents of the aggregate from the previous page must contain some newer event, otherwise the projection is empty
+	return bson.D{{Key: "$and", Value: bson.A{filter, bson.M{"$or": bson.A{
+		bson.M{aggregateIDKey: bson.M{"$gt": after.AggregateID}},
+		bson.M{aggregateIDKey: after.AggregateID, latestVersionKey: bson.M{"$gt": after.Version}},
+	}}}}}
+}
+
+func getEventsPageProjection(timestamp int64, after *eventstore.EventPosition) bson.M {
+	conds := make(bson.A, 0, 2)
+	if timestamp > 0 {
+		conds = append(conds, bson.M{"$gt": bson.A{"$$event." + timestampKey, timestamp}})
+	}
+	if after != nil {
+		conds = append(conds, bson.M{"$or": bson.A{
+			bson.M{"$ne": bson.A{"$" + aggregateIDKey, after.AggregateID}},
+			bson.M{"$gt": bson.A{"$$event." + versionKey, after.Version}},
+		}})
+	}
+	projection := bson.M{
+		"_id":          0,
+		groupIDKey:     1,
+		aggregateIDKey: 1,
+		eventsKey:      1,
+	}
+	if len(conds) > 0 {
+		projection[eventsKey] = bson.M{
+			"$filter": bson.M{
+				"input": "$" + eventsKey,
+				"as":    "event",
+				"cond":  bson.M{"$and": conds},
+			},
+		}
+	}
+	return projection
+}
+
+// GetEventsPage gets the events ordered by the aggregateID and the version.
+func (s *EventStore) GetEventsPage(ctx context.Context, queries []eventstore.GetEventsQuery, timestamp int64, page eventstore.EventsPage, eventHandler eventstore.Handler) error {
+	if len(queries) == 0 {
+		return status.Errorf(codes.InvalidArgument, "invalid queries")
+	}
+	opts := options.Find()
+	opts.SetAllowDiskUse(true)
+	opts.SetProjection(getEventsPageProjection(timestamp, page.After))
+	opts.SetSort(aggregateIDFirstVersionQueryIndex)
+	if page.Limit > 0 {
+		// each selected document contains at least one event of the page, the events over the limit are
+		// skipped by the handler
+		opts.SetLimit(page.Limit)
+	}
+	filter := getEventsPageFilter(queries, timestamp, page.After)
+	return s.loadEventsQuery(ctx, eventstore.NewLimitHandler(eventHandler, page.Limit), nil, []mongoQuery{{filter: filter, options: opts}})
+}
+
+// LoadUpToTimestamp loads the events of the aggregates stored up to the timestamp from the nearest snapshot.
+func (s *EventStore) LoadUpToTimestamp(ctx context.Context, queries []eventstore.GetEventsQuery, timestamp int64, eventHandler eventstore.Handler) error {
+	if len(queries) == 0 {
+		return status.Errorf(codes.InvalidArgument, "invalid queries")
+	}
+	opts := options.Find()
+	opts.SetAllowDiskUse(true)
+	// the first event of each document is kept to detect the aggregates without events stored up to the timestamp,
+	// the events before the nearest snapshot are skipped by the handler
+	opts.SetProjection(bson.M{
+		"_id":          0,
+		groupIDKey:     1,
+		aggregateIDKey: 1,
+		eventsKey: bson.M{
+			"$filter": bson.M{
+				"input": "$" + eventsKey,
+				"as":    "event",
+				"cond": bson.M{"$or": bson.A{
+					bson.M{"$lte": bson.A{"$$event." + timestampKey, timestamp}},
+					bson.M{"$eq": bson.A{"$$event." + versionKey, "$" + firstVersionKey}},
+				}},
+			},
+		},
+	})
+	opts.SetSort(aggregateIDFirstVersionQueryIndex)
+	filter := getEventsPageFilter(queries, 0, nil)
+	return s.loadEventsQuery(ctx, eventstore.NewUpToTimestampHandler(eventHandler, timestamp), nil, []mongoQuery{{filter: filter, options: opts}})
+}
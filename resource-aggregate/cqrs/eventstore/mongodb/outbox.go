@@ -0,0 +1,92 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// GetUnpublished returns up to limit aggregates with unpublished events stored before the timestamp.
+func (s *EventStore) GetUnpublished(ctx context.Context, savedBefore int64, limit int64) ([]eventstore.OutboxEntry, error) {
+	if !s.outbox {
+		return nil, eventstore.ErrNotSupported
+	}
+	col := s.client().Database(s.DBName()).Collection(getEventCollectionName())
+	opts := options.Find().SetHint(outboxVersionTimestampQueryIndex).SetProjection(bson.M{
+		groupIDKey:       1,
+		aggregateIDKey:   1,
+		outboxVersionKey: 1,
+	})
+	if limit > 0 {
+		opts.SetLimit(limit)
+	}
+	iter, err := col.Find(ctx, bson.M{
+		outboxVersionKey:   bson.M{"$exists": true},
+		outboxTimestampKey: bson.M{"$lt": savedBefore},
+	}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get unpublished events: %w", err)
+	}
+	defer func() {
+		_ = iter.Close(ctx)
+	}()
+	entries := make([]eventstore.OutboxEntry, 0, 32)
+	for iter.Next(ctx) {
+		var doc struct {
+			GroupID       string `bson:"groupid"`
+			AggregateID   string `bson:"aggregateid"`
+			OutboxVersion uint64 `bson:"outboxversion"`
+		}
+		if err = iter.Decode(&doc); err != nil {
+			return nil, fmt.Errorf("cannot decode unpublished events: %w", err)
+		}
+		entries = append(entries, eventstore.OutboxEntry{
+			GroupID:     doc.GroupID,
+			AggregateID: doc.AggregateID,
+			Version:     doc.OutboxVersion,
+		})
+	}
+	if err = iter.Err(); err != nil {
+		return nil, fmt.Errorf("cannot get unpublished events: %w", err)
+	}
+	return entries, nil
+}
+
+// MarkPublished marks the events of the aggregate from fromVersion to toVersion as published.
+func (s *EventStore) MarkPublished(ctx context.Context, aggregateID string, fromVersion, toVersion uint64) error {
+	if !s.outbox {
+		return eventstore.ErrNotSupported
+	}
+	if fromVersion > toVersion {
+		return errors.New("invalid interval of versions")
+	}
+	col := s.client().Database(s.DBName()).Collection(getEventCollectionName())
+	filter := bson.M{
+		aggregateIDKey:   aggregateID,
+		outboxVersionKey: bson.M{"$gte": fromVersion, "$lte": toVersion},
+	}
+	// events stored after toVersion stay unpublished, they are returned by GetUnpublished after the latest stored event
+	allPublished := bson.M{"$lte": bson.A{"$" + latestVersionKey, toVersion}}
+	update := bson.A{
+		bson.M{"$set": bson.M{
+			outboxVersionKey: bson.M{"$cond": bson.A{
+				allPublished,
+				"$$REMOVE",
+				toVersion + 1,
+			}},
+			outboxTimestampKey: bson.M{"$cond": bson.A{
+				allPublished,
+				"$$REMOVE",
+				"$" + latestTimestampKey,
+			}},
+		}},
+	}
+	if _, err := col.UpdateMany(ctx, filter, update); err != nil {
+		return fmt.Errorf("cannot mark events of aggregate('%v') as published: %w", aggregateID, err)
+	}
+	return nil
+}
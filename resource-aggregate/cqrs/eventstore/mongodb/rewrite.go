@@ -0,0 +1,78 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore/upcast"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type rewriteEvent struct {
+	Version   uint64 `bson:"version"`
+	EventType string `bson:"eventtype"`
+	Data      []byte `bson:"data"`
+}
+
+type rewriteDocument struct {
+	ID     string         `bson:"_id"`
+	Events []rewriteEvent `bson:"events"`
+}
+
+func (s *EventStore) rewriteDocument(ctx context.Context, doc rewriteDocument, rewrite upcast.RewriteFunc) (int, error) {
+	col := s.client().Database(s.DBName()).Collection(getEventCollectionName())
+	var count int
+	for _, e := range doc.Events {
+		data, changed, err := rewrite(e.EventType, e.Data)
+		if err != nil {
+			return count, fmt.Errorf("cannot rewrite event('%v', %v): %w", doc.ID, e.Version, err)
+		}
+		if !changed {
+			continue
+		}
+		// the positional operator updates the event matched by the filter
+		res, err := col.UpdateOne(ctx, bson.M{
+			idKey:                        doc.ID,
+			eventsKey + "." + versionKey: e.Version,
+		}, bson.M{
+			"$set": bson.M{
+				eventsKey + ".$." + dataKey: data,
+			},
+		})
+		if err != nil {
+			return count, fmt.Errorf("cannot update event('%v', %v): %w", doc.ID, e.Version, err)
+		}
+		// the event removed in the meantime is not counted
+		count += int(res.MatchedCount)
+	}
+	return count, nil
+}
+
+// RewriteEvents calls the rewrite function for each stored event and replaces data of the changed events.
+func (s *EventStore) RewriteEvents(ctx context.Context, rewrite upcast.RewriteFunc) (int, error) {
+	opts := options.Find().SetProjection(bson.M{
+		idKey:     1,
+		eventsKey: 1,
+	})
+	iter, err := s.client().Database(s.DBName()).Collection(getEventCollectionName()).Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return 0, fmt.Errorf("cannot load documents: %w", err)
+	}
+	defer func() {
+		_ = iter.Close(ctx)
+	}()
+	var count int
+	for iter.Next(ctx) {
+		var doc rewriteDocument
+		if err = iter.Decode(&doc); err != nil {
+			return count, fmt.Errorf("cannot decode document: %w", err)
+		}
+		n, err := s.rewriteDocument(ctx, doc, rewrite)
+		count += n
+		if err != nil {
+			return count, err
+		}
+	}
+	return count, iter.Err()
+}
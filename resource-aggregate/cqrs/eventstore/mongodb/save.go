@@ -75,6 +75,13 @@ func (s *EventStore) saveEvent(ctx context.Context, col *mongo.Collection, event
 			},
 		},
 	}
+	if s.outbox {
+		// the events are marked as unpublished in the same write as they are stored
+		update["$min"] = bson.M{
+			outboxVersionKey:   events[0].Version(),
+			outboxTimestampKey: events[0].Timestamp().UnixNano(),
+		}
+	}
 
 	res, err := col.UpdateOne(ctx, filter, update, opts)
 	switch {
@@ -116,7 +123,7 @@ func (s *EventStore) Save(ctx context.Context, events ...eventstore.Event) (even
 
 	col := s.client().Database(s.DBName()).Collection(getEventCollectionName())
 	if events[0].Version() == 0 {
-		doc, err := makeDBDoc(events, s.dataMarshaler)
+		doc, err := s.makeDBDoc(events)
 		if err != nil {
 			return eventstore.Fail, fmt.Errorf("cannot insert first events('%v'): %w", events, err)
 		}
@@ -141,7 +148,7 @@ func (s *EventStore) Save(ctx context.Context, events ...eventstore.Event) (even
 }
 
 func (s *EventStore) saveSnapshot(ctx context.Context, events []eventstore.Event) (status eventstore.SaveStatus, err error) {
-	doc, err := makeDBDoc(events, s.dataMarshaler)
+	doc, err := s.makeDBDoc(events)
 	if err != nil {
 		return eventstore.Fail, err
 	}
@@ -197,3 +204,15 @@ func (s *EventStore) saveSnapshot(ctx context.Context, events []eventstore.Event
 	}
 	return eventstore.Ok, nil
 }
+
+func (s *EventStore) makeDBDoc(events []eventstore.Event) (bson.M, error) {
+	doc, err := makeDBDoc(events, s.dataMarshaler)
+	if err != nil {
+		return nil, err
+	}
+	if s.outbox {
+		doc[outboxVersionKey] = events[0].Version()
+		doc[outboxTimestampKey] = events[0].Timestamp().UnixNano()
+	}
+	return doc, nil
+}
@@ -0,0 +1,23 @@
+package eventstore
+
+import "context"
+
+// OutboxEntry describes saved but not yet published events of an aggregate.
+type OutboxEntry struct {
+	GroupID     string
+	AggregateID string
+	Version     uint64 // version of the first unpublished event
+}
+
+// Outbox is implemented by the eventstores which mark saved events as unpublished
+// in the same write as the events are stored.
+type Outbox interface {
+	// GetUnpublished returns up to limit aggregates with unpublished events stored before the timestamp (unix nanoseconds).
+	// The timestamp of the first unpublished event is compared, so the aggregates with the events stored continuously
+	// are returned too.
+	GetUnpublished(ctx context.Context, savedBefore int64, limit int64) ([]OutboxEntry, error)
+	// MarkPublished marks the events of the aggregate from fromVersion to toVersion (including) as published.
+	// The mark is ignored when the first unpublished event of the aggregate is not within the interval, so the
+	// events published out of order are published again by the relay.
+	MarkPublished(ctx context.Context, aggregateID string, fromVersion, toVersion uint64) error
+}
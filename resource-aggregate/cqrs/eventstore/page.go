@@ -0,0 +1,70 @@
+package eventstore
+
+import "context"
+
+// EventPosition identifies the event in the order of GetEventsPage.
+type EventPosition struct {
+	AggregateID string
+	Version     uint64
+}
+
+// Less returns true when the position p is before the position v.
+func (p EventPosition) Less(v EventPosition) bool {
+	if p.AggregateID != v.AggregateID {
+		return p.AggregateID < v.AggregateID
+	}
+	return p.Version < v.Version
+}
+
+// EventsPage selects the page of events.
+type EventsPage struct {
+	After *EventPosition // position of the last event of the previous page, nil for the first page
+	Limit int64          // maximal number of events in the page, <=0 means no limit
+}
+
+// PagedEventStore is implemented by the eventstores which can return the events page by page.
+type PagedEventStore interface {
+	// GetEventsPage gets the events as GetEvents does, but the events are ordered by the aggregateID
+	// and the version and only the events after page.After are returned, up to page.Limit.
+	GetEventsPage(ctx context.Context, queries []GetEventsQuery, timestamp int64, page EventsPage, eventHandler Handler) error
+}
+
+type limitIter struct {
+	Iter
+	remaining int64
+}
+
+func (i *limitIter) Next(ctx context.Context) (EventUnmarshaler, bool) {
+	if i.remaining <= 0 {
+		return nil, false
+	}
+	eu, ok := i.Iter.Next(ctx)
+	if ok {
+		i.remaining--
+	}
+	return eu, ok
+}
+
+type limitHandler struct {
+	Handler
+	remaining int64
+}
+
+func (h *limitHandler) Handle(ctx context.Context, iter Iter) error {
+	if h.remaining <= 0 {
+		return nil
+	}
+	i := &limitIter{Iter: iter, remaining: h.remaining}
+	err := h.Handler.Handle(ctx, i)
+	h.remaining = i.remaining
+	return err
+}
+
+// NewLimitHandler creates the handler which passes at most limit events to the handler over all iterators.
+// When the limit is <=0 the handler is returned.
+func NewLimitHandler(handler Handler, limit int64) Handler {
+	if limit <= 0 {
+		return handler
+	}
+	return &limitHandler{Handler: handler, remaining: limit}
+}
@@ -0,0 +1,83 @@
+package postgres
+
+import (
+	"fmt"
+
+	pkgPostgres "github.com/plgd-dev/hub/v2/pkg/postgres"
+)
+
+// MarshalerFunc marshal struct to bytes.
+type MarshalerFunc = func(v interface{}) ([]byte, error)
+
+// UnmarshalerFunc unmarshal bytes to pointer of struct.
+type UnmarshalerFunc = func(b []byte, v interface{}) error
+
+// Config provides PostgreSQL configuration options
+type Config struct {
+	Embedded pkgPostgres.Config `yaml:",inline" json:",inline"`
+	Table    string             `yaml:"table" json:"table"`
+	// MaxEventsWithoutSnapshot limits number of events stored after the latest snapshot of an aggregate.
+	// When the limit is reached, Save returns SnapshotRequired for events without a snapshot.
+	MaxEventsWithoutSnapshot int `yaml:"maxEventsWithoutSnapshot" json:"maxEventsWithoutSnapshot"`
+
+	marshalerFunc   MarshalerFunc   `yaml:"-"`
+	unmarshalerFunc UnmarshalerFunc `yaml:"-"`
+	outbox          bool            `yaml:"-"`
+}
+
+func (c *Config) Validate() error {
+	if c.MaxEventsWithoutSnapshot < 0 {
+		return fmt.Errorf("maxEventsWithoutSnapshot('%v')", c.MaxEventsWithoutSnapshot)
+	}
+	return c.Embedded.Validate()
+}
+
+// Option provides the means to use function call chaining
+type Option interface {
+	apply(cfg *Config)
+}
+
+type MarshalerOpt struct {
+	f MarshalerFunc
+}
+
+func (o MarshalerOpt) apply(cfg *Config) {
+	cfg.marshalerFunc = o.f
+}
+
+// WithMarshaler provides the possibility to set an marshaling function for the config
+func WithMarshaler(f MarshalerFunc) MarshalerOpt {
+	return MarshalerOpt{
+		f: f,
+	}
+}
+
+type UnmarshalerOpt struct {
+	f UnmarshalerFunc
+}
+
+func (o UnmarshalerOpt) apply(cfg *Config) {
+	cfg.unmarshalerFunc = o.f
+}
+
+// WithUnmarshaler provides the possibility to set an unmarshaling function for the config
+func WithUnmarshaler(f UnmarshalerFunc) UnmarshalerOpt {
+	return UnmarshalerOpt{
+		f: f,
+	}
+}
+
+type OutboxOpt struct {
+	enabled bool
+}
+
+func (o OutboxOpt) apply(cfg *Config) {
+	cfg.outbox = o.enabled
+}
+
+// WithOutbox marks the saved events as unpublished, so they can be published by the outbox relay
+func WithOutbox(enabled bool) OutboxOpt {
+	return OutboxOpt{
+		enabled: enabled,
+	}
+}
@@ -0,0 +1,36 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/plgd-dev/hub/v2/pkg/strings"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore"
+)
+
+func getDeviceIDFilter(queries []eventstore.DeleteQuery) []string {
+	deviceIDs := make([]string, 0, len(queries))
+	for _, q := range queries {
+		if q.GroupID != "" {
+			deviceIDs = append(deviceIDs, q.GroupID)
+		}
+	}
+	return strings.Unique(deviceIDs)
+}
+
+// Delete documents with given group ids
+func (s *EventStore) Delete(ctx context.Context, queries []eventstore.DeleteQuery) error {
+	deviceIDFilter := getDeviceIDFilter(queries)
+	if len(deviceIDFilter) == 0 {
+		return errors.New("failed to delete documents: invalid query")
+	}
+
+	return s.client.InTx(ctx, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, "delete from "+s.eventsTable()+" where "+groupIDKey+"=any($1)", deviceIDFilter); err != nil {
+			return err
+		}
+		_, err := tx.Exec(ctx, "delete from "+s.aggregatesTable()+" where "+groupIDKey+"=any($1)", deviceIDFilter)
+		return err
+	})
+}
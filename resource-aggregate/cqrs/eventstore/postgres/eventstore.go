@@ -0,0 +1,236 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/jackc/pgx/v5"
+	"github.com/plgd-dev/hub/v2/pkg/fsnotify"
+	"github.com/plgd-dev/hub/v2/pkg/log"
+	pkgPostgres "github.com/plgd-dev/hub/v2/pkg/postgres"
+	"github.com/plgd-dev/hub/v2/pkg/security/certManager/client"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Event columns
+const (
+	groupIDKey     = "groupid"
+	aggregateIDKey = "aggregateid"
+	versionKey     = "version"
+	eventTypeKey   = "eventtype"
+	isSnapshotKey  = "issnapshot"
+	timestampKey   = "timestamp"
+	dataKey        = "data"
+)
+
+// Aggregate columns
+const (
+	latestVersionKey         = "latestversion"
+	latestSnapshotVersionKey = "latestsnapshotversion"
+	latestTimestampKey       = "latesttimestamp"
+	serviceIDKey             = "serviceid"
+	latestETagKey            = "latestetag"
+	latestETagTimestampKey   = "latestetagtimestamp"
+	typesKey                 = "types"
+	outboxVersionKey         = "outboxversion"
+	// outboxTimestampKey is the timestamp of the first unpublished event
+	outboxTimestampKey = "outboxtimestamp"
+)
+
+const (
+	defaultTable                    = "events"
+	defaultMaxEventsWithoutSnapshot = 1024
+
+	aggregatesTableSuffix  = "_aggregates"
+	maintenanceTableSuffix = "_maintenance"
+)
+
+// eventColumns are the columns of the events table in the order used by the iterator.
+var eventColumns = []string{groupIDKey, aggregateIDKey, versionKey, eventTypeKey, isSnapshotKey, timestampKey, dataKey}
+
+// EventStore implements an EventStore for PostgreSQL.
+type EventStore struct {
+	client                   *pkgPostgres.Client
+	table                    string
+	maxEventsWithoutSnapshot int
+	dataMarshaler            MarshalerFunc
+	dataUnmarshaler          UnmarshalerFunc
+	outbox                   bool
+}
+
+func New(ctx context.Context, config *Config, fileWatcher *fsnotify.Watcher, logger log.Logger, tracerProvider trace.TracerProvider, opts ...Option) (*EventStore, error) {
+	config.marshalerFunc = json.Marshal
+	config.unmarshalerFunc = json.Unmarshal
+	for _, o := range opts {
+		o.apply(config)
+	}
+	certManager, err := client.New(config.Embedded.TLS, fileWatcher, logger, tracerProvider)
+	if err != nil {
+		return nil, fmt.Errorf("could not create cert manager: %w", err)
+	}
+	pgClient, err := pkgPostgres.New(ctx, config.Embedded, certManager.GetTLSConfig(), logger, tracerProvider)
+	if err != nil {
+		certManager.Close()
+		return nil, err
+	}
+	store, err := newEventStoreWithClient(ctx, pgClient, config)
+	if err != nil {
+		pgClient.Close()
+		certManager.Close()
+		return nil, err
+	}
+	store.AddCloseFunc(certManager.Close)
+	return store, nil
+}
+
+// NewEventStoreWithClient creates a new EventStore with a client.
+func newEventStoreWithClient(ctx context.Context, client *pkgPostgres.Client, config *Config) (*EventStore, error) {
+	if client == nil {
+		return nil, errors.New("invalid client")
+	}
+	if config.marshalerFunc == nil {
+		return nil, errors.New("no event marshaler")
+	}
+	if config.unmarshalerFunc == nil {
+		return nil, errors.New("no event unmarshaler")
+	}
+	if config.Table == "" {
+		config.Table = defaultTable
+	}
+	if config.MaxEventsWithoutSnapshot == 0 {
+		config.MaxEventsWithoutSnapshot = defaultMaxEventsWithoutSnapshot
+	}
+
+	s := &EventStore{
+		client:                   client,
+		table:                    config.Table,
+		maxEventsWithoutSnapshot: config.MaxEventsWithoutSnapshot,
+		dataMarshaler:            config.marshalerFunc,
+		dataUnmarshaler:          config.unmarshalerFunc,
+		outbox:                   config.outbox,
+	}
+	if err := s.createTables(ctx); err != nil {
+		return nil, err
+	}
+	client.SetOnClear(s.dropTables)
+	return s, nil
+}
+
+func (s *EventStore) eventsTable() string {
+	return pgx.Identifier{s.table}.Sanitize()
+}
+
+func (s *EventStore) aggregatesTable() string {
+	return pgx.Identifier{s.table + aggregatesTableSuffix}.Sanitize()
+}
+
+func (s *EventStore) maintenanceTable() string {
+	return pgx.Identifier{s.table + maintenanceTableSuffix}.Sanitize()
+}
+
+func (s *EventStore) indexName(table string, columns ...string) string {
+	return pgx.Identifier{table + "_" + strings.Join(columns, "_") + "_idx"}.Sanitize()
+}
+
+func (s *EventStore) createTables(ctx context.Context) error {
+	aggregatesTable := s.table + aggregatesTableSuffix
+	queries := []string{
+		"create table if not exists " + s.aggregatesTable() + " (" +
+			aggregateIDKey + " text primary key," +
+			groupIDKey + " text not null," +
+			latestVersionKey + " bigint not null," +
+			latestSnapshotVersionKey + " bigint not null," +
+			latestTimestampKey + " bigint not null," +
+			serviceIDKey + " text," +
+			latestETagKey + " bytea," +
+			latestETagTimestampKey + " bigint," +
+			typesKey + " text[]," +
+			outboxVersionKey + " bigint," +
+			outboxTimestampKey + " bigint" +
+			")",
+		"create index if not exists " + s.indexName(aggregatesTable, outboxTimestampKey) + " on " + s.aggregatesTable() + " (" + outboxTimestampKey + ") where " + outboxVersionKey + " is not null",
+		"create index if not exists " + s.indexName(aggregatesTable, groupIDKey) + " on " + s.aggregatesTable() + " (" + groupIDKey + ")",
+		"create index if not exists " + s.indexName(aggregatesTable, serviceIDKey) + " on " + s.aggregatesTable() + " (" + serviceIDKey + ")",
+		"create index if not exists " + s.indexName(aggregatesTable, groupIDKey, latestETagTimestampKey) + " on " + s.aggregatesTable() + " (" + groupIDKey + "," + latestETagTimestampKey + " desc)",
+		"create index if not exists " + s.indexName(aggregatesTable, typesKey) + " on " + s.aggregatesTable() + " using gin (" + typesKey + ")",
+		"create table if not exists " + s.eventsTable() + " (" +
+			aggregateIDKey + " text not null," +
+			versionKey + " bigint not null," +
+			groupIDKey + " text not null," +
+			eventTypeKey + " text not null," +
+			isSnapshotKey + " boolean not null," +
+			timestampKey + " bigint not null," +
+			dataKey + " bytea not null," +
+			"primary key (" + aggregateIDKey + "," + versionKey + ")" +
+			")",
+		"create index if not exists " + s.indexName(s.table, groupIDKey, timestampKey) + " on " + s.eventsTable() + " (" + groupIDKey + "," + timestampKey + ")",
+		"create table if not exists " + s.maintenanceTable() + " (" +
+			aggregateIDKey + " text primary key," +
+			groupIDKey + " text not null," +
+			versionKey + " bigint not null" +
+			")",
+	}
+	for _, q := range queries {
+		if _, err := s.client.Pool().Exec(ctx, q); err != nil {
+			return fmt.Errorf("cannot create tables for eventstore: %w", err)
+		}
+	}
+	return nil
+}
+
+func (s *EventStore) dropTables(ctx context.Context) error {
+	var errors *multierror.Error
+	for _, table := range []string{s.table, s.table + aggregatesTableSuffix, s.table + maintenanceTableSuffix} {
+		if err := s.client.DropTable(ctx, table); err != nil {
+			errors = multierror.Append(errors, fmt.Errorf("cannot drop table %v: %w", table, err))
+		}
+	}
+	return errors.ErrorOrNil()
+}
+
+func (s *EventStore) AddCloseFunc(f func()) {
+	s.client.AddCloseFunc(f)
+}
+
+// Clear clears the event storage.
+func (s *EventStore) Clear(ctx context.Context) error {
+	if err := s.client.Clear(ctx); err != nil {
+		return fmt.Errorf("cannot clear: %w", err)
+	}
+	return nil
+}
+
+// Clear rows in tables, but don't drop the tables
+func (s *EventStore) ClearTables(ctx context.Context) error {
+	_, err := s.client.Pool().Exec(ctx, "truncate "+s.eventsTable()+","+s.aggregatesTable()+","+s.maintenanceTable())
+	return err
+}
+
+// Close closes the database session.
+func (s *EventStore) Close(_ context.Context) error {
+	s.client.Close()
+	return nil
+}
+
+// sqlQuery collects positional arguments of a query.
+type sqlQuery struct {
+	args []interface{}
+}
+
+// arg adds argument to the query and returns its placeholder.
+func (q *sqlQuery) arg(v interface{}) string {
+	q.args = append(q.args, v)
+	return "$" + strconv.Itoa(len(q.args))
+}
+
+func joinConditions(conditions []string, operator string) string {
+	if len(conditions) == 1 {
+		return conditions[0]
+	}
+	return "(" + strings.Join(conditions, " "+operator+" ") + ")"
+}
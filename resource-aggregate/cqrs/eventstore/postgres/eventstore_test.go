@@ -0,0 +1,106 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/plgd-dev/hub/v2/pkg/fsnotify"
+	"github.com/plgd-dev/hub/v2/pkg/log"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore/postgres"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore/test"
+	"github.com/plgd-dev/hub/v2/test/config"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+func TestEventStore(t *testing.T) {
+	logger := log.NewLogger(log.MakeDefaultConfig())
+	fileWatcher, err := fsnotify.NewWatcher(logger)
+	require.NoError(t, err)
+	defer func() {
+		errC := fileWatcher.Close()
+		require.NoError(t, errC)
+	}()
+
+	ctx := context.Background()
+	store, err := NewTestEventStore(ctx, fileWatcher, logger)
+	require.NoError(t, err)
+	require.NotNil(t, store)
+	defer func() {
+		t.Log("clearing db")
+		errC := store.Clear(ctx)
+		require.NoError(t, errC)
+		_ = store.Close(ctx)
+	}()
+
+	t.Log("event store with default table")
+	test.AcceptanceTest(ctx, t, store)
+
+	t.Log("clearing tables")
+	err = store.ClearTables(ctx)
+	require.NoError(t, err)
+	test.GetEventsTest(ctx, t, store)
+}
+
+func NewTestEventStore(ctx context.Context, fileWatcher *fsnotify.Watcher, logger log.Logger, opts ...postgres.Option) (*postgres.EventStore, error) {
+	store, err := postgres.New(
+		ctx,
+		&postgres.Config{
+			Embedded: config.MakePostgreSQLConfig(),
+			Table:    "test",
+		},
+		fileWatcher,
+		logger,
+		noop.NewTracerProvider(),
+		append([]postgres.Option{
+			postgres.WithMarshaler(bson.Marshal),
+			postgres.WithUnmarshaler(bson.Unmarshal),
+		}, opts...)...,
+	)
+	return store, err
+}
+
+func TestEventStoreOutbox(t *testing.T) {
+	logger := log.NewLogger(log.MakeDefaultConfig())
+	fileWatcher, err := fsnotify.NewWatcher(logger)
+	require.NoError(t, err)
+	defer func() {
+		errC := fileWatcher.Close()
+		require.NoError(t, errC)
+	}()
+
+	ctx := context.Background()
+	store, err := NewTestEventStore(ctx, fileWatcher, logger, postgres.WithOutbox(true))
+	require.NoError(t, err)
+	defer func() {
+		errC := store.Clear(ctx)
+		require.NoError(t, errC)
+		_ = store.Close(ctx)
+	}()
+
+	test.OutboxTest(ctx, t, store)
+}
+
+func TestEventStoreGetEventsPage(t *testing.T) {
+	logger := log.NewLogger(log.MakeDefaultConfig())
+	fileWatcher, err := fsnotify.NewWatcher(logger)
+	require.NoError(t, err)
+	defer func() {
+		errC := fileWatcher.Close()
+		require.NoError(t, errC)
+	}()
+
+	ctx := context.Background()
+	store, err := NewTestEventStore(ctx, fileWatcher, logger)
+	require.NoError(t, err)
+	defer func() {
+		errC := store.Clear(ctx)
+		require.NoError(t, errC)
+		_ = store.Close(ctx)
+	}()
+
+	test.GetEventsPageTest(ctx, t, store)
+	test.GetEventsFromTimestampTest(ctx, t, store)
+	test.LoadUpToTimestampTest(ctx, t, store)
+}
@@ -0,0 +1,39 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// Get latest ETags for device resources from event store for batch observing
+func (s *EventStore) GetLatestDeviceETags(ctx context.Context, deviceID string, limit uint32) ([][]byte, error) {
+	if deviceID == "" {
+		return nil, errors.New("deviceID is invalid")
+	}
+	q := "select " + latestETagKey + " from " + s.aggregatesTable() +
+		" where " + groupIDKey + "=$1 and " + latestETagKey + " is not null and length(" + latestETagKey + ")>0" +
+		" order by " + latestETagTimestampKey + " desc"
+	args := []interface{}{deviceID}
+	if limit > 0 {
+		q += " limit $2"
+		args = append(args, int64(limit))
+	}
+	rows, err := s.client.Pool().Query(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	etags := make([][]byte, 0, limit)
+	for rows.Next() {
+		var etag []byte
+		if err = rows.Scan(&etag); err != nil {
+			return nil, fmt.Errorf("cannot scan etag: %w", err)
+		}
+		etags = append(etags, etag)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return etags, nil
+}
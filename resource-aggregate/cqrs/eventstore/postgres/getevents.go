@@ -0,0 +1,115 @@
+package postgres
+
+import (
+	"context"
+	"slices"
+
+	"github.com/plgd-dev/hub/v2/internal/math"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func getEventsQueryToCondition(q *sqlQuery, query eventstore.GetEventsQuery) string {
+	conditions := make([]string, 0, 3)
+	if query.GroupID != "" {
+		conditions = append(conditions, "a."+groupIDKey+"="+q.arg(query.GroupID))
+	}
+	if query.AggregateID != "" {
+		conditions = append(conditions, "a."+aggregateIDKey+"="+q.arg(query.AggregateID))
+	}
+	if len(query.Types) > 0 {
+		conditions = append(conditions, "a."+typesKey+"@>"+q.arg(query.Types))
+	}
+	if len(conditions) == 0 {
+		return ""
+	}
+	return joinConditions(conditions, "and")
+}
+
+func getEventsWhere(q *sqlQuery, queries []eventstore.GetEventsQuery, timestamp int64) string {
+	conditions := make([]string, 0, len(queries))
+	for _, query := range queries {
+		condition := getEventsQueryToCondition(q, query)
+		if condition == "" {
+			// query all events
+			*q = sqlQuery{}
+			conditions = nil
+			break
+		}
+		conditions = append(conditions, condition)
+	}
+	// the events after the timestamp are returned even when they are stored before the latest snapshot
+	where := "e." + versionKey + ">=a." + latestSnapshotVersionKey
+	if timestamp > 0 {
+		where = "e." + timestampKey + ">" + q.arg(timestamp)
+	}
+	if len(conditions) > 0 {
+		where = joinConditions(conditions, "or") + " and " + where
+	}
+	return where
+}
+
+// Get events from the eventstore.
+func (s *EventStore) GetEvents(ctx context.Context, queries []eventstore.GetEventsQuery, timestamp int64, eventHandler eventstore.Handler) error {
+	if len(queries) == 0 {
+		return status.Errorf(codes.InvalidArgument, "invalid queries")
+	}
+	var q sqlQuery
+	where := getEventsWhere(&q, queries, timestamp)
+	return s.loadEventsQuery(ctx, eventHandler, s.selectEvents(where), q.args)
+}
+
+// GetEventsPage gets the events ordered by the aggregateID and the version.
+func (s *EventStore) GetEventsPage(ctx context.Context, queries []eventstore.GetEventsQuery, timestamp int64, page eventstore.EventsPage, eventHandler eventstore.Handler) error {
+	if len(queries) == 0 {
+		return status.Errorf(codes.InvalidArgument, "invalid queries")
+	}
+	var q sqlQuery
+	where := "(" + getEventsWhere(&q, queries, timestamp) + ")"
+	// the binary collation keeps the same order of aggregateIDs as the other eventstores
+	aggregateID := "e." + aggregateIDKey + ` collate "C"`
+	if page.After != nil {
+		after := q.arg(page.After.AggregateID)
+		where += " and (" + aggregateID + ">" + after + " or (e." + aggregateIDKey + "=" + after +
+			" and e." + versionKey + ">" + q.arg(math.CastTo[int64](page.After.Version)) + "))"
+	}
+	sql := "select " + selectEventsColumns() + " from " + s.eventsTable() + " e join " + s.aggregatesTable() + " a on a." + aggregateIDKey + "=e." + aggregateIDKey +
+		" where " + where +
+		" order by " + aggregateID + ",e." + versionKey
+	if page.Limit > 0 {
+		sql += " limit " + q.arg(page.Limit)
+	}
+	return s.loadEventsQuery(ctx, eventHandler, sql, q.args)
+}
+
+func isGetAllEventsQuery(query eventstore.GetEventsQuery) bool {
+	return query.GroupID == "" && query.AggregateID == "" && len(query.Types) == 0
+}
+
+// LoadUpToTimestamp loads the events of the aggregates stored up to the timestamp from the nearest snapshot.
+func (s *EventStore) LoadUpToTimestamp(ctx context.Context, queries []eventstore.GetEventsQuery, timestamp int64, eventHandler eventstore.Handler) error {
+	if len(queries) == 0 {
+		return status.Errorf(codes.InvalidArgument, "invalid queries")
+	}
+	var q sqlQuery
+	ts := q.arg(timestamp)
+	// the version of the nearest snapshot stored up to the timestamp, otherwise the version of the oldest stored event
+	startVersion := "coalesce(" +
+		"(select max(s." + versionKey + ") from " + s.eventsTable() + " s where s." + aggregateIDKey + "=a." + aggregateIDKey + " and s." + isSnapshotKey + " and s." + timestampKey + "<=" + ts + ")," +
+		"(select min(s." + versionKey + ") from " + s.eventsTable() + " s where s." + aggregateIDKey + "=a." + aggregateIDKey + "))"
+	where := "(e." + versionKey + "=st.startversion or (e." + versionKey + ">st.startversion and e." + timestampKey + "<=" + ts + "))"
+	if !slices.ContainsFunc(queries, isGetAllEventsQuery) {
+		conditions := make([]string, 0, len(queries))
+		for _, query := range queries {
+			conditions = append(conditions, getEventsQueryToCondition(&q, query))
+		}
+		where = joinConditions(conditions, "or") + " and " + where
+	}
+	sql := "select " + selectEventsColumns() + " from " + s.aggregatesTable() + " a" +
+		" cross join lateral (select " + startVersion + " as startversion) st" +
+		" join " + s.eventsTable() + " e on e." + aggregateIDKey + "=a." + aggregateIDKey +
+		" where " + where +
+		" order by e." + groupIDKey + ",e." + aggregateIDKey + ",e." + versionKey
+	return s.loadEventsQuery(ctx, eventHandler, sql, q.args)
+}
@@ -0,0 +1,176 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/go-multierror"
+	"github.com/jackc/pgx/v5"
+	"github.com/plgd-dev/hub/v2/internal/math"
+	pkgTime "github.com/plgd-dev/hub/v2/pkg/time"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore"
+)
+
+type iterator struct {
+	rows            pgx.Rows
+	dataUnmarshaler UnmarshalerFunc
+	err             error
+}
+
+func newIterator(rows pgx.Rows, dataUnmarshaler UnmarshalerFunc) *iterator {
+	return &iterator{
+		rows:            rows,
+		dataUnmarshaler: dataUnmarshaler,
+	}
+}
+
+func (i *iterator) Next(context.Context) (eventstore.EventUnmarshaler, bool) {
+	if i.err != nil || !i.rows.Next() {
+		return nil, false
+	}
+	var groupID, aggregateID, eventType string
+	var version, timestamp int64
+	var isSnapshot bool
+	var data []byte
+	if err := i.rows.Scan(&groupID, &aggregateID, &version, &eventType, &isSnapshot, &timestamp, &data); err != nil {
+		i.err = fmt.Errorf("cannot scan event: %w", err)
+		return nil, false
+	}
+	return eventstore.NewLoadedEvent(
+		math.CastTo[uint64](version),
+		eventType,
+		aggregateID,
+		groupID,
+		isSnapshot,
+		pkgTime.Unix(0, timestamp),
+		func(v interface{}) error {
+			return i.dataUnmarshaler(data, v)
+		}), true
+}
+
+func (i *iterator) Err() error {
+	if i.err != nil {
+		return i.err
+	}
+	return i.rows.Err()
+}
+
+func selectEventsColumns() string {
+	cols := make([]string, 0, len(eventColumns))
+	for _, c := range eventColumns {
+		cols = append(cols, "e."+c)
+	}
+	return strings.Join(cols, ",")
+}
+
+// selectEvents creates the select of events joined with their aggregates filtered by the where clause.
+func (s *EventStore) selectEvents(where string) string {
+	return "select " + selectEventsColumns() + " from " + s.eventsTable() + " e join " + s.aggregatesTable() + " a on a." + aggregateIDKey + "=e." + aggregateIDKey +
+		" where " + where +
+		" order by e." + groupIDKey + ",e." + aggregateIDKey + ",e." + versionKey
+}
+
+// Create query to load events
+func (s *EventStore) loadEventsQuery(ctx context.Context, eh eventstore.Handler, sql string, args []interface{}) error {
+	rows, err := s.client.Pool().Query(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("cannot load events: %w", err)
+	}
+	i := newIterator(rows, s.dataUnmarshaler)
+	err = eh.Handle(ctx, i)
+	rows.Close()
+	if err == nil {
+		return rows.Err()
+	}
+	return err
+}
+
+func validateVersionQuery(query eventstore.VersionQuery) error {
+	if query.GroupID == "" {
+		return fmt.Errorf("invalid GroupID('%v')", query.GroupID)
+	}
+	if query.AggregateID == "" || query.AggregateID == uuid.Nil.String() {
+		return fmt.Errorf("invalid AggregateID('%v')", query.AggregateID)
+	}
+	return nil
+}
+
+// versionQueriesToConditions converts the queries to conditions. The cmpVersion function creates the condition for the version.
+func versionQueriesToConditions(q *sqlQuery, queries []eventstore.VersionQuery, cmpVersion func(version string) string) ([]string, error) {
+	var errors *multierror.Error
+	conditions := make([]string, 0, len(queries))
+	for _, query := range queries {
+		if err := validateVersionQuery(query); err != nil {
+			errors = multierror.Append(errors, fmt.Errorf("cannot load events version for query('%+v'): %w", query, err))
+			continue
+		}
+		conditions = append(conditions, "(e."+groupIDKey+"="+q.arg(query.GroupID)+
+			" and e."+aggregateIDKey+"="+q.arg(query.AggregateID)+
+			" and "+cmpVersion(q.arg(math.CastTo[int64](query.Version)))+")")
+	}
+	return conditions, errors.ErrorOrNil()
+}
+
+func (s *EventStore) loadEvents(ctx context.Context, queries []eventstore.VersionQuery, eh eventstore.Handler, cmpVersion func(version string) string) error {
+	var q sqlQuery
+	var errors *multierror.Error
+	conditions, err := versionQueriesToConditions(&q, queries, cmpVersion)
+	if err != nil {
+		errors = multierror.Append(errors, err)
+	}
+	if len(conditions) > 0 {
+		if err = s.loadEventsQuery(ctx, eh, s.selectEvents(joinConditions(conditions, "or")), q.args); err != nil {
+			errors = multierror.Append(errors, err)
+		}
+	}
+	return errors.ErrorOrNil()
+}
+
+// LoadUpToVersion loads aggregates events up to a specific version.
+func (s *EventStore) LoadUpToVersion(ctx context.Context, queries []eventstore.VersionQuery, eh eventstore.Handler) error {
+	return s.loadEvents(ctx, queries, eh, func(version string) string {
+		return "e." + versionKey + "<" + version
+	})
+}
+
+// LoadFromVersion loads aggregates events from version. Events older than the latest snapshot are
+// skipped, because the snapshot already contains them.
+func (s *EventStore) LoadFromVersion(ctx context.Context, queries []eventstore.VersionQuery, eh eventstore.Handler) error {
+	return s.loadEvents(ctx, queries, eh, func(version string) string {
+		return "e." + versionKey + ">=greatest(" + version + ",a." + latestSnapshotVersionKey + ")"
+	})
+}
+
+func snapshotQueryToCondition(q *sqlQuery, query eventstore.SnapshotQuery) string {
+	groupCondition := "a." + groupIDKey + "=" + q.arg(query.GroupID)
+	if query.AggregateID != "" && query.AggregateID != uuid.Nil.String() {
+		return "(" + groupCondition + " and a." + aggregateIDKey + "=" + q.arg(query.AggregateID) + ")"
+	}
+	if len(query.Types) > 0 {
+		return "(" + groupCondition + " and a." + typesKey + "@>" + q.arg(query.Types) + ")"
+	}
+	return groupCondition
+}
+
+// LoadFromSnapshot loads events from the last snapshot eventstore.
+func (s *EventStore) LoadFromSnapshot(ctx context.Context, queries []eventstore.SnapshotQuery, eventHandler eventstore.Handler) error {
+	if len(queries) == 0 {
+		return errors.New("not supported")
+	}
+	var q sqlQuery
+	conditions := make([]string, 0, len(queries))
+	for _, query := range queries {
+		if query.GroupID == "" {
+			continue
+		}
+		conditions = append(conditions, snapshotQueryToCondition(&q, query))
+	}
+	if len(conditions) == 0 {
+		return nil
+	}
+	where := joinConditions(conditions, "or") + " and e." + versionKey + ">=a." + latestSnapshotVersionKey
+	return s.loadEventsQuery(ctx, eventHandler, s.selectEvents(where), q.args)
+}
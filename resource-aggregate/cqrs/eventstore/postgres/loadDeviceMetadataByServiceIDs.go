@@ -0,0 +1,41 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/plgd-dev/hub/v2/pkg/strings"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *EventStore) LoadDeviceMetadataByServiceIDs(ctx context.Context, serviceIDs []string, limit int64) ([]eventstore.DeviceDocumentMetadata, error) {
+	if len(serviceIDs) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid serviceIDs")
+	}
+	serviceIDs = strings.Unique(serviceIDs)
+	q := "select " + groupIDKey + "," + serviceIDKey + " from " + s.aggregatesTable() + " where " + serviceIDKey + "=any($1)"
+	args := []interface{}{serviceIDs}
+	if limit > 0 {
+		q += " limit $2"
+		args = append(args, limit)
+	}
+	rows, err := s.client.Pool().Query(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	ret := make([]eventstore.DeviceDocumentMetadata, 0, 32)
+	for rows.Next() {
+		var v eventstore.DeviceDocumentMetadata
+		if err = rows.Scan(&v.DeviceID, &v.ServiceID); err != nil {
+			return nil, fmt.Errorf("cannot scan device metadata: %w", err)
+		}
+		ret = append(ret, v)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
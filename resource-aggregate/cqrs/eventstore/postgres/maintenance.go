@@ -0,0 +1,88 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/plgd-dev/hub/v2/internal/math"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore/maintenance"
+)
+
+// Insert stores (or updates) the information about the latest snapshot version per aggregate into the DB
+func (s *EventStore) Insert(ctx context.Context, task maintenance.Task) error {
+	if task.GroupID == "" {
+		return errors.New("could not insert record - group ID cannot be empty")
+	}
+	if task.AggregateID == "" {
+		return errors.New("could not insert record - aggregate ID cannot be empty")
+	}
+
+	res, err := s.client.Pool().Exec(ctx, "insert into "+s.maintenanceTable()+" ("+aggregateIDKey+","+groupIDKey+","+versionKey+") values ($1,$2,$3)"+
+		" on conflict ("+aggregateIDKey+") do update set "+groupIDKey+"=excluded."+groupIDKey+","+versionKey+"=excluded."+versionKey+
+		" where "+s.maintenanceTable()+"."+versionKey+"<excluded."+versionKey,
+		task.AggregateID, task.GroupID, math.CastTo[int64](task.Version))
+	if err != nil {
+		return fmt.Errorf("could not insert record with aggregate ID %v, version %d - %w", task.AggregateID, task.Version, err)
+	}
+	if res.RowsAffected() != 1 {
+		return fmt.Errorf("could not insert record with aggregate ID %v, version %d - version is outdated", task.AggregateID, task.Version)
+	}
+	return nil
+}
+
+type taskIterator struct {
+	rows pgx.Rows
+	err  error
+}
+
+func (i *taskIterator) Next(_ context.Context, task *maintenance.Task) bool {
+	if i.err != nil || !i.rows.Next() {
+		return false
+	}
+	var version int64
+	if err := i.rows.Scan(&task.GroupID, &task.AggregateID, &version); err != nil {
+		i.err = err
+		return false
+	}
+	task.Version = math.CastTo[uint64](version)
+	return true
+}
+
+func (i *taskIterator) Err() error {
+	if i.err != nil {
+		return i.err
+	}
+	return i.rows.Err()
+}
+
+// Query retrieves the latest snapshot version per aggregate for thw number of aggregates specified by 'limit'
+func (s *EventStore) Query(ctx context.Context, limit int, taskHandler maintenance.TaskHandler) error {
+	rows, err := s.client.Pool().Query(ctx, "select "+groupIDKey+","+aggregateIDKey+","+versionKey+" from "+s.maintenanceTable()+" limit $1", limit)
+	if err != nil {
+		return err
+	}
+	i := taskIterator{
+		rows: rows,
+	}
+	err = taskHandler.Handle(ctx, &i)
+	rows.Close()
+	if err == nil {
+		return rows.Err()
+	}
+	return err
+}
+
+// Remove deletes (the latest snapshot version) database record for a given aggregate ID
+func (s *EventStore) Remove(ctx context.Context, task maintenance.Task) error {
+	res, err := s.client.Pool().Exec(ctx, "delete from "+s.maintenanceTable()+" where "+aggregateIDKey+"=$1 and "+groupIDKey+"=$2 and "+versionKey+"=$3",
+		task.AggregateID, task.GroupID, math.CastTo[int64](task.Version))
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() != 1 {
+		return fmt.Errorf("could not remove record with aggregate ID %s and/or version %d", task.AggregateID, task.Version)
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/plgd-dev/hub/v2/internal/math"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore"
+)
+
+// GetUnpublished returns up to limit aggregates with unpublished events stored before the timestamp.
+func (s *EventStore) GetUnpublished(ctx context.Context, savedBefore int64, limit int64) ([]eventstore.OutboxEntry, error) {
+	if !s.outbox {
+		return nil, eventstore.ErrNotSupported
+	}
+	var q sqlQuery
+	sql := "select " + groupIDKey + "," + aggregateIDKey + "," + outboxVersionKey + " from " + s.aggregatesTable() +
+		" where " + outboxVersionKey + " is not null and " + outboxTimestampKey + "<" + q.arg(savedBefore)
+	if limit > 0 {
+		sql += " limit " + q.arg(limit)
+	}
+	rows, err := s.client.Pool().Query(ctx, sql, q.args...)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get unpublished events: %w", err)
+	}
+	defer rows.Close()
+	entries := make([]eventstore.OutboxEntry, 0, 32)
+	for rows.Next() {
+		var e eventstore.OutboxEntry
+		var version int64
+		if err = rows.Scan(&e.GroupID, &e.AggregateID, &version); err != nil {
+			return nil, fmt.Errorf("cannot get unpublished events: %w", err)
+		}
+		e.Version = math.CastTo[uint64](version)
+		entries = append(entries, e)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("cannot get unpublished events: %w", err)
+	}
+	return entries, nil
+}
+
+// MarkPublished marks the events of the aggregate from fromVersion to toVersion as published.
+func (s *EventStore) MarkPublished(ctx context.Context, aggregateID string, fromVersion, toVersion uint64) error {
+	if !s.outbox {
+		return eventstore.ErrNotSupported
+	}
+	if fromVersion > toVersion {
+		return errors.New("invalid interval of versions")
+	}
+	var q sqlQuery
+	to := q.arg(math.CastTo[int64](toVersion))
+	// events stored after toVersion stay unpublished, they are returned by GetUnpublished after the latest stored event
+	sql := "update " + s.aggregatesTable() + " set " + outboxVersionKey + "=case when " + latestVersionKey + "<=" + to +
+		" then null else " + to + "+1 end," + outboxTimestampKey + "=case when " + latestVersionKey + "<=" + to +
+		" then null else " + latestTimestampKey + " end where " + aggregateIDKey + "=" + q.arg(aggregateID) +
+		" and " + outboxVersionKey + " between " + q.arg(math.CastTo[int64](fromVersion)) + " and " + to
+	if _, err := s.client.Pool().Exec(ctx, sql, q.args...); err != nil {
+		return fmt.Errorf("cannot mark events of aggregate('%v') as published: %w", aggregateID, err)
+	}
+	return nil
+}
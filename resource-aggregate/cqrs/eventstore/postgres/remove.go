@@ -0,0 +1,33 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/plgd-dev/hub/v2/internal/math"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore"
+)
+
+// RemoveUpToVersion deletes the aggregated events up to a specific version.
+func (s *EventStore) RemoveUpToVersion(ctx context.Context, queries []eventstore.VersionQuery) error {
+	var q sqlQuery
+	var errors *multierror.Error
+	conditions := make([]string, 0, len(queries))
+	for _, query := range queries {
+		if err := validateVersionQuery(query); err != nil {
+			errors = multierror.Append(errors, fmt.Errorf("cannot remove events version for query('%+v'): %w", query, err))
+			continue
+		}
+		conditions = append(conditions, "("+groupIDKey+"="+q.arg(query.GroupID)+
+			" and "+aggregateIDKey+"="+q.arg(query.AggregateID)+
+			" and "+versionKey+"<"+q.arg(math.CastTo[int64](query.Version))+")")
+	}
+	if len(conditions) == 0 {
+		return errors.ErrorOrNil()
+	}
+	if _, err := s.client.Pool().Exec(ctx, "delete from "+s.eventsTable()+" where "+joinConditions(conditions, "or"), q.args...); err != nil {
+		errors = multierror.Append(errors, err)
+	}
+	return errors.ErrorOrNil()
+}
@@ -0,0 +1,69 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore/upcast"
+)
+
+const rewriteBatchSize = 128
+
+type rewriteRow struct {
+	aggregateID string
+	version     int64
+	eventType   string
+	data        []byte
+}
+
+func (s *EventStore) loadRewriteBatch(ctx context.Context, aggregateID string, version int64) ([]rewriteRow, error) {
+	rows, err := s.client.Pool().Query(ctx, "select "+aggregateIDKey+","+versionKey+","+eventTypeKey+","+dataKey+" from "+s.eventsTable()+
+		" where ("+aggregateIDKey+","+versionKey+")>($1,$2) order by "+aggregateIDKey+","+versionKey+" limit $3", aggregateID, version, rewriteBatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	batch := make([]rewriteRow, 0, rewriteBatchSize)
+	for rows.Next() {
+		var r rewriteRow
+		if err = rows.Scan(&r.aggregateID, &r.version, &r.eventType, &r.data); err != nil {
+			return nil, err
+		}
+		batch = append(batch, r)
+	}
+	return batch, rows.Err()
+}
+
+// RewriteEvents calls the rewrite function for each stored event and replaces data of the changed events.
+func (s *EventStore) RewriteEvents(ctx context.Context, rewrite upcast.RewriteFunc) (int, error) {
+	aggregateID := ""
+	version := int64(-1)
+	var count int
+	for {
+		batch, err := s.loadRewriteBatch(ctx, aggregateID, version)
+		if err != nil {
+			return count, fmt.Errorf("cannot load events: %w", err)
+		}
+		for _, r := range batch {
+			data, changed, err := rewrite(r.eventType, r.data)
+			if err != nil {
+				return count, fmt.Errorf("cannot rewrite event('%v', %v): %w", r.aggregateID, r.version, err)
+			}
+			if !changed {
+				continue
+			}
+			tag, err := s.client.Pool().Exec(ctx, "update "+s.eventsTable()+" set "+dataKey+"=$1 where "+aggregateIDKey+"=$2 and "+versionKey+"=$3",
+				data, r.aggregateID, r.version)
+			if err != nil {
+				return count, fmt.Errorf("cannot update event('%v', %v): %w", r.aggregateID, r.version, err)
+			}
+			// the event removed in the meantime is not counted
+			count += int(tag.RowsAffected())
+		}
+		if len(batch) < rewriteBatchSize {
+			return count, nil
+		}
+		aggregateID = batch[len(batch)-1].aggregateID
+		version = batch[len(batch)-1].version
+	}
+}
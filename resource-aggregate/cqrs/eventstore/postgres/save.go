@@ -0,0 +1,260 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/plgd-dev/hub/v2/internal/math"
+	pkgPostgres "github.com/plgd-dev/hub/v2/pkg/postgres"
+	pkgTime "github.com/plgd-dev/hub/v2/pkg/time"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore"
+)
+
+// errSaveRejected is used to rollback the transaction when events cannot be stored.
+var errSaveRejected = errors.New("save rejected")
+
+type dbEvent struct {
+	version    int64
+	eventType  string
+	isSnapshot bool
+	timestamp  int64
+	data       []byte
+}
+
+type dbAggregate struct {
+	groupID               string
+	aggregateID           string
+	firstVersion          uint64
+	firstTimestamp        int64
+	latestVersion         uint64
+	latestSnapshotVersion *uint64
+	latestTimestamp       int64
+	serviceID             *string
+	setServiceID          bool
+	etag                  *eventstore.ETagData
+	types                 []string
+	events                []dbEvent
+}
+
+func getLatestSnapshotVersion(events []eventstore.Event) *uint64 {
+	var latestSnapshotVersion *uint64
+	for _, e := range events {
+		if e.IsSnapshot() {
+			v := e.Version()
+			latestSnapshotVersion = &v
+		}
+	}
+	if latestSnapshotVersion == nil && events[0].Version() == 0 {
+		v := uint64(0)
+		latestSnapshotVersion = &v
+	}
+	return latestSnapshotVersion
+}
+
+func makeDBAggregate(events []eventstore.Event, marshaler MarshalerFunc) (dbAggregate, error) {
+	a := dbAggregate{
+		groupID:               events[0].GroupID(),
+		aggregateID:           events[0].AggregateID(),
+		firstVersion:          events[0].Version(),
+		firstTimestamp:        pkgTime.UnixNano(events[0].Timestamp()),
+		latestVersion:         events[len(events)-1].Version(),
+		latestSnapshotVersion: getLatestSnapshotVersion(events),
+		latestTimestamp:       pkgTime.UnixNano(events[len(events)-1].Timestamp()),
+		events:                make([]dbEvent, 0, len(events)),
+	}
+	for idx, event := range events {
+		raw, err := marshaler(event)
+		if err != nil {
+			return dbAggregate{}, fmt.Errorf("cannot create db event from event[%v]: %w", idx, err)
+		}
+		a.events = append(a.events, dbEvent{
+			version:    math.CastTo[int64](event.Version()),
+			eventType:  event.EventType(),
+			isSnapshot: event.IsSnapshot(),
+			timestamp:  pkgTime.UnixNano(event.Timestamp()),
+			data:       raw,
+		})
+		if etag := event.ETag(); etag != nil {
+			a.etag = etag
+		}
+		if len(event.Types()) > 0 {
+			a.types = event.Types()
+		}
+		if serviceID, ok := event.ServiceID(); ok {
+			a.setServiceID = true
+			a.serviceID = nil
+			if serviceID != "" {
+				a.serviceID = &serviceID
+			}
+		}
+	}
+	return a, nil
+}
+
+func (a dbAggregate) etagValues() ([]byte, *int64) {
+	if a.etag == nil {
+		return nil, nil
+	}
+	return a.etag.ETag, &a.etag.Timestamp
+}
+
+// outboxVersion returns version and timestamp of the first unpublished event of the new aggregate.
+func (s *EventStore) outboxVersion(a dbAggregate) (*int64, *int64) {
+	if !s.outbox {
+		return nil, nil
+	}
+	v := math.CastTo[int64](a.firstVersion)
+	return &v, &a.firstTimestamp
+}
+
+func (s *EventStore) insertAggregate(ctx context.Context, tx pgx.Tx, a dbAggregate) (eventstore.SaveStatus, error) {
+	var q sqlQuery
+	etag, etagTimestamp := a.etagValues()
+	outboxVersion, outboxTimestamp := s.outboxVersion(a)
+	values := []string{
+		q.arg(a.aggregateID),
+		q.arg(a.groupID),
+		q.arg(math.CastTo[int64](a.latestVersion)),
+		q.arg(math.CastTo[int64](*a.latestSnapshotVersion)),
+		q.arg(a.latestTimestamp),
+		q.arg(a.serviceID),
+		q.arg(etag),
+		q.arg(etagTimestamp),
+		q.arg(a.types),
+		q.arg(outboxVersion),
+		q.arg(outboxTimestamp),
+	}
+	sql := "insert into " + s.aggregatesTable() + " (" +
+		strings.Join([]string{aggregateIDKey, groupIDKey, latestVersionKey, latestSnapshotVersionKey, latestTimestampKey, serviceIDKey, latestETagKey, latestETagTimestampKey, typesKey, outboxVersionKey, outboxTimestampKey}, ",") +
+		") values (" + strings.Join(values, ",") + ") on conflict (" + aggregateIDKey + ") do nothing"
+	res, err := tx.Exec(ctx, sql, q.args...)
+	if err != nil {
+		return eventstore.Fail, fmt.Errorf("cannot insert aggregate: %w", err)
+	}
+	if res.RowsAffected() == 0 {
+		return eventstore.ConcurrencyException, nil
+	}
+	return eventstore.Ok, nil
+}
+
+func (s *EventStore) updateAggregate(ctx context.Context, tx pgx.Tx, a dbAggregate) (eventstore.SaveStatus, error) {
+	var latestVersion, latestSnapshotVersion int64
+	err := tx.QueryRow(ctx, "select "+latestVersionKey+","+latestSnapshotVersionKey+" from "+s.aggregatesTable()+" where "+aggregateIDKey+"=$1 for update", a.aggregateID).Scan(&latestVersion, &latestSnapshotVersion)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return eventstore.ConcurrencyException, nil
+	}
+	if err != nil {
+		return eventstore.Fail, fmt.Errorf("cannot lock aggregate: %w", err)
+	}
+	// latestVersion shall be lower by 1 as new event otherwise other event was stored (occ).
+	if math.CastTo[uint64](latestVersion) != a.firstVersion-1 {
+		return eventstore.ConcurrencyException, nil
+	}
+	if a.latestSnapshotVersion == nil && a.latestVersion-math.CastTo[uint64](latestSnapshotVersion) > math.CastTo[uint64](s.maxEventsWithoutSnapshot) {
+		return eventstore.SnapshotRequired, nil
+	}
+
+	var q sqlQuery
+	setters := []string{
+		latestVersionKey + "=" + q.arg(math.CastTo[int64](a.latestVersion)),
+		latestTimestampKey + "=" + q.arg(a.latestTimestamp),
+	}
+	if a.latestSnapshotVersion != nil {
+		setters = append(setters, latestSnapshotVersionKey+"="+q.arg(math.CastTo[int64](*a.latestSnapshotVersion)))
+	}
+	if a.setServiceID {
+		setters = append(setters, serviceIDKey+"="+q.arg(a.serviceID))
+	}
+	if a.etag != nil {
+		etag, etagTimestamp := a.etagValues()
+		setters = append(setters, latestETagKey+"="+q.arg(etag), latestETagTimestampKey+"="+q.arg(etagTimestamp))
+	}
+	if len(a.types) > 0 {
+		setters = append(setters, typesKey+"="+q.arg(a.types))
+	}
+	if s.outbox {
+		// the events are marked as unpublished in the same transaction as they are stored
+		setters = append(setters, outboxVersionKey+"=coalesce("+outboxVersionKey+","+q.arg(math.CastTo[int64](a.firstVersion))+")",
+			outboxTimestampKey+"=coalesce("+outboxTimestampKey+","+q.arg(a.firstTimestamp)+")")
+	}
+	sql := "update " + s.aggregatesTable() + " set " + strings.Join(setters, ",") + " where " + aggregateIDKey + "=" + q.arg(a.aggregateID)
+	if _, err = tx.Exec(ctx, sql, q.args...); err != nil {
+		return eventstore.Fail, fmt.Errorf("cannot update aggregate: %w", err)
+	}
+	return eventstore.Ok, nil
+}
+
+func (s *EventStore) insertEvents(ctx context.Context, tx pgx.Tx, a dbAggregate) (eventstore.SaveStatus, error) {
+	var q sqlQuery
+	rows := make([]string, 0, len(a.events))
+	for _, e := range a.events {
+		rows = append(rows, "("+strings.Join([]string{
+			q.arg(a.groupID),
+			q.arg(a.aggregateID),
+			q.arg(e.version),
+			q.arg(e.eventType),
+			q.arg(e.isSnapshot),
+			q.arg(e.timestamp),
+			q.arg(e.data),
+		}, ",")+")")
+	}
+	sql := "insert into " + s.eventsTable() + " (" + strings.Join(eventColumns, ",") + ") values " + strings.Join(rows, ",")
+	if _, err := tx.Exec(ctx, sql, q.args...); err != nil {
+		if pkgPostgres.IsUniqueViolation(err) {
+			return eventstore.ConcurrencyException, nil
+		}
+		return eventstore.Fail, fmt.Errorf("cannot insert events: %w", err)
+	}
+	return eventstore.Ok, nil
+}
+
+// Save save events to eventstore.
+// AggregateID, GroupID and EventType are required.
+// All events within one Save operation shall have the same AggregateID and GroupID.
+// Versions shall be unique and ascend continually.
+// Only first event can be a snapshot.
+func (s *EventStore) Save(ctx context.Context, events ...eventstore.Event) (eventstore.SaveStatus, error) {
+	if err := eventstore.ValidateEventsBeforeSave(events); err != nil {
+		return eventstore.Fail, err
+	}
+	a, err := makeDBAggregate(events, s.dataMarshaler)
+	if err != nil {
+		return eventstore.Fail, err
+	}
+	status := eventstore.Ok
+	err = s.client.InTx(ctx, func(tx pgx.Tx) error {
+		var errS error
+		if a.firstVersion == 0 {
+			status, errS = s.insertAggregate(ctx, tx, a)
+		} else {
+			status, errS = s.updateAggregate(ctx, tx, a)
+		}
+		if errS != nil {
+			return errS
+		}
+		if status != eventstore.Ok {
+			return errSaveRejected
+		}
+		status, errS = s.insertEvents(ctx, tx, a)
+		if errS != nil {
+			return errS
+		}
+		if status != eventstore.Ok {
+			return errSaveRejected
+		}
+		return nil
+	})
+	if errors.Is(err, errSaveRejected) {
+		return status, nil
+	}
+	if err != nil {
+		if pkgPostgres.IsUniqueViolation(err) {
+			return eventstore.ConcurrencyException, nil
+		}
+		return eventstore.Fail, fmt.Errorf("cannot save events('%v'): %w", events, err)
+	}
+	return eventstore.Ok, nil
+}
@@ -2,6 +2,7 @@ package test
 
 import (
 	"context"
+	"slices"
 	"testing"
 	"time"
 
@@ -70,7 +71,19 @@ var (
 	groupID3 = "00000000-0000-0000-0000-000000000003"
 )
 
+// GetEventsTest is the test of GetEvents for the event stores which keep the events before the latest snapshot,
+// so the events after the timestamp are returned even when a newer snapshot is stored.
 func GetEventsTest(ctx context.Context, t *testing.T, store eventstore.EventStore) {
+	getEventsTest(ctx, t, store, false)
+}
+
+// GetEventsOfLatestSnapshotTest is the test of GetEvents for the event stores which keep only the latest snapshot of
+// the aggregate.
+func GetEventsOfLatestSnapshotTest(ctx context.Context, t *testing.T, store eventstore.EventStore) {
+	getEventsTest(ctx, t, store, true)
+}
+
+func getEventsTest(ctx context.Context, t *testing.T, store eventstore.EventStore, latestSnapshotOnly bool) {
 	t.Log("testing GetEvents")
 
 	const timestamp1 = int64(0)
@@ -99,6 +112,17 @@ func GetEventsTest(ctx context.Context, t *testing.T, store eventstore.EventStor
 	require.NoError(t, err)
 	require.Equal(t, eventstore.Ok, saveStatus)
 
+	// all events are snapshots, so only the latest ones are returned without the timestamp
+	storedEvents := func(events []eventstore.Event) []eventstore.Event {
+		if latestSnapshotOnly {
+			return events[len(events)-1:]
+		}
+		return slices.Clone(events)
+	}
+	groupID2AggID3StoredEvents := storedEvents(groupID2AggID3Events)
+	groupID3StoredEvents := storedEvents(groupID3Events)
+	groupID2StoredEvents := append(storedEvents(groupID2AggID2Events), groupID2AggID3StoredEvents...)
+
 	groupID3Events = groupID3Events[len(groupID3Events)-1:]
 	groupID2Events := groupID2AggID2Events[len(groupID2AggID2Events)-1:]
 
@@ -140,14 +164,14 @@ func GetEventsTest(ctx context.Context, t *testing.T, store eventstore.EventStor
 	saveEh = NewMockEventHandler()
 	err = store.GetEvents(ctx, []eventstore.GetEventsQuery{{}}, timestamp, saveEh)
 	require.NoError(t, err)
-	require.True(t, saveEh.Equals(groupID3Events))
+	require.True(t, saveEh.Equals(groupID3StoredEvents))
 
 	timestamp = timestamp3 + 2
 	t.Logf("get groupid (%v, %v) events with timestamp > %v", groupID2, groupID3, timestamp)
 	saveEh = NewMockEventHandler()
 	err = store.GetEvents(ctx, []eventstore.GetEventsQuery{{GroupID: groupID2}, {GroupID: groupID3}}, timestamp, saveEh)
 	require.NoError(t, err)
-	events = filterEvents(append(groupID2Events, groupID3Events...), func(e eventstore.Event) bool {
+	events = filterEvents(append(groupID2StoredEvents, groupID3StoredEvents...), func(e eventstore.Event) bool {
 		return e.Timestamp().UnixNano() > timestamp
 	})
 	require.True(t, saveEh.Equals(events))
@@ -157,8 +181,8 @@ func GetEventsTest(ctx context.Context, t *testing.T, store eventstore.EventStor
 	saveEh = NewMockEventHandler()
 	err = store.GetEvents(ctx, []eventstore.GetEventsQuery{{AggregateID: aggregateID3}, {AggregateID: aggregateID4}}, timestamp, saveEh)
 	require.NoError(t, err)
-	events = groupID2AggID3Events[len(groupID2AggID3Events)-1:]
-	events = append(events, groupID3Events...)
+	events = groupID2AggID3StoredEvents
+	events = append(events, groupID3StoredEvents...)
 	require.True(t, saveEh.Equals(events))
 }
 
@@ -404,3 +428,75 @@ func AcceptanceTest(ctx context.Context, t *testing.T, store eventstore.EventSto
 	require.NoError(t, err)
 	require.Equal(t, getEvents(5, 1, aggregateID1Path.GroupID, aggregateID1Path.AggregateID, timestamp+5), eh10.events[aggregateID1Path.GroupID][aggregateID1Path.AggregateID])
 }
+
+// GetEventsFromTimestampTest checks that the events after the timestamp are returned also when a newer snapshot
+// of the aggregate is stored.
+func GetEventsFromTimestampTest(ctx context.Context, t *testing.T, store eventstore.EventStore) {
+	groupID := uuid.NewString()
+	aggregateID := uuid.NewString()
+	timestamp := time.Now().UnixNano()
+	events := getEvents(0, 4, groupID, aggregateID, timestamp)
+	for i := range events {
+		e := events[i].(MockEvent)
+		e.IsSnapshotI = i == 0 || i == 2
+		events[i] = e
+	}
+	status, err := store.Save(ctx, events[:2]...)
+	require.NoError(t, err)
+	require.Equal(t, eventstore.Ok, status)
+	status, err = store.Save(ctx, events[2:]...)
+	require.NoError(t, err)
+	require.Equal(t, eventstore.Ok, status)
+
+	var h positionHandler
+	err = store.GetEvents(ctx, []eventstore.GetEventsQuery{{GroupID: groupID}}, timestamp, &h)
+	require.NoError(t, err)
+	require.Equal(t, []eventstore.EventPosition{
+		{AggregateID: aggregateID, Version: 1},
+		{AggregateID: aggregateID, Version: 2},
+		{AggregateID: aggregateID, Version: 3},
+	}, h.positions)
+}
+
+// LoadUpToTimestampTest checks that the events are loaded from the nearest snapshot stored up to the timestamp.
+func LoadUpToTimestampTest(ctx context.Context, t *testing.T, store eventstore.EventStore) {
+	groupID := uuid.NewString()
+	aggregateID := uuid.NewString()
+	timestamp := time.Now().UnixNano()
+	events := getEvents(0, 6, groupID, aggregateID, timestamp)
+	for i := range events {
+		e := events[i].(MockEvent)
+		e.IsSnapshotI = i == 0 || i == 3
+		events[i] = e
+	}
+	status, err := store.Save(ctx, events[:3]...)
+	require.NoError(t, err)
+	require.Equal(t, eventstore.Ok, status)
+	status, err = store.Save(ctx, events[3:]...)
+	require.NoError(t, err)
+	require.Equal(t, eventstore.Ok, status)
+
+	load := func(timestamp int64) []eventstore.EventPosition {
+		var h positionHandler
+		errL := store.LoadUpToTimestamp(ctx, []eventstore.GetEventsQuery{{GroupID: groupID}}, timestamp, &h)
+		require.NoError(t, errL)
+		return h.positions
+	}
+	positions := func(versions ...uint64) []eventstore.EventPosition {
+		p := make([]eventstore.EventPosition, 0, len(versions))
+		for _, v := range versions {
+			p = append(p, eventstore.EventPosition{AggregateID: aggregateID, Version: v})
+		}
+		return p
+	}
+	require.Equal(t, positions(3, 4), load(timestamp+4))
+	// the events before the latest snapshot
+	require.Equal(t, positions(0, 1, 2), load(timestamp+2))
+	// only the oldest event is loaded when no event is stored up to the timestamp
+	require.Equal(t, positions(0), load(timestamp-1))
+
+	err = store.RemoveUpToVersion(ctx, []eventstore.VersionQuery{{GroupID: groupID, AggregateID: aggregateID, Version: 3}})
+	require.NoError(t, err)
+	require.Equal(t, positions(3), load(timestamp+2))
+	require.Equal(t, positions(3, 4, 5), load(timestamp+5))
+}
@@ -49,6 +49,11 @@ func (s *MockEventStore) LoadUpToVersion(context.Context, []eventstore.VersionQu
 	return errNotSupported
 }
 
+// LoadUpToTimestamp loads aggregate events up to a specific timestamp.
+func (s *MockEventStore) LoadUpToTimestamp(context.Context, []eventstore.GetEventsQuery, int64, eventstore.Handler) error {
+	return errNotSupported
+}
+
 func makeModelId(groupID, aggregateID string) string {
 	return groupID + "." + aggregateID
 }
@@ -0,0 +1,99 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore"
+	"github.com/stretchr/testify/require"
+)
+
+type OutboxEventStore interface {
+	eventstore.EventStore
+	eventstore.Outbox
+}
+
+// OutboxTest is the test that all implementations of Outbox should pass. The store
+// must be created with the outbox enabled.
+func OutboxTest(ctx context.Context, t *testing.T, store OutboxEventStore) {
+	groupID := uuid.NewString()
+	aggregateID := uuid.NewString()
+	timestamp := time.Now().UnixNano()
+	events := getEvents(0, 6, groupID, aggregateID, timestamp)
+	// only the first event is a snapshot, so the events are appended to the aggregate
+	for i := 1; i < len(events); i++ {
+		e := events[i].(MockEvent)
+		e.IsSnapshotI = false
+		events[i] = e
+	}
+	savedBefore := timestamp + int64(len(events))
+
+	getUnpublished := func() []eventstore.OutboxEntry {
+		entries, err := store.GetUnpublished(ctx, savedBefore, 0)
+		require.NoError(t, err)
+		return entries
+	}
+
+	status, err := store.Save(ctx, events[0:2]...)
+	require.NoError(t, err)
+	require.Equal(t, eventstore.Ok, status)
+	require.Equal(t, []eventstore.OutboxEntry{{GroupID: groupID, AggregateID: aggregateID, Version: 0}}, getUnpublished())
+
+	entries, err := store.GetUnpublished(ctx, timestamp, 0)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+
+	// the mark out of order is ignored
+	err = store.MarkPublished(ctx, aggregateID, 1, 1)
+	require.NoError(t, err)
+	require.Equal(t, []eventstore.OutboxEntry{{GroupID: groupID, AggregateID: aggregateID, Version: 0}}, getUnpublished())
+
+	err = store.MarkPublished(ctx, aggregateID, 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, []eventstore.OutboxEntry{{GroupID: groupID, AggregateID: aggregateID, Version: 1}}, getUnpublished())
+
+	err = store.MarkPublished(ctx, aggregateID, 1, 1)
+	require.NoError(t, err)
+	require.Empty(t, getUnpublished())
+
+	status, err = store.Save(ctx, events[2:4]...)
+	require.NoError(t, err)
+	require.Equal(t, eventstore.Ok, status)
+	require.Equal(t, []eventstore.OutboxEntry{{GroupID: groupID, AggregateID: aggregateID, Version: 2}}, getUnpublished())
+
+	// events saved after the relay loaded them stay unpublished
+	status, err = store.Save(ctx, events[4:6]...)
+	require.NoError(t, err)
+	require.Equal(t, eventstore.Ok, status)
+	err = store.MarkPublished(ctx, aggregateID, 2, 3)
+	require.NoError(t, err)
+	require.Equal(t, []eventstore.OutboxEntry{{GroupID: groupID, AggregateID: aggregateID, Version: 4}}, getUnpublished())
+
+	err = store.MarkPublished(ctx, aggregateID, 4, 5)
+	require.NoError(t, err)
+	require.Empty(t, getUnpublished())
+
+	// the aggregate with the events stored continuously is returned by the first unpublished event
+	events = getEvents(6, 2, groupID, aggregateID, savedBefore-1)
+	e := events[1].(MockEvent)
+	e.IsSnapshotI = false
+	e.TimestampI = savedBefore + int64(time.Hour)
+	events[1] = e
+	status, err = store.Save(ctx, events[0])
+	require.NoError(t, err)
+	require.Equal(t, eventstore.Ok, status)
+	status, err = store.Save(ctx, events[1])
+	require.NoError(t, err)
+	require.Equal(t, eventstore.Ok, status)
+	require.Equal(t, []eventstore.OutboxEntry{{GroupID: groupID, AggregateID: aggregateID, Version: 6}}, getUnpublished())
+
+	// the remaining events are returned after the latest stored event
+	err = store.MarkPublished(ctx, aggregateID, 6, 6)
+	require.NoError(t, err)
+	require.Empty(t, getUnpublished())
+	entries, err = store.GetUnpublished(ctx, e.TimestampI+1, 0)
+	require.NoError(t, err)
+	require.Equal(t, []eventstore.OutboxEntry{{GroupID: groupID, AggregateID: aggregateID, Version: 7}}, entries)
+}
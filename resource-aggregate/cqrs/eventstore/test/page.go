@@ -0,0 +1,97 @@
+package test
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore"
+	"github.com/stretchr/testify/require"
+)
+
+type PagedEventStore interface {
+	eventstore.EventStore
+	eventstore.PagedEventStore
+}
+
+type positionHandler struct {
+	lock      sync.Mutex
+	positions []eventstore.EventPosition
+}
+
+func (h *positionHandler) Handle(ctx context.Context, iter eventstore.Iter) error {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	for {
+		eu, ok := iter.Next(ctx)
+		if !ok {
+			break
+		}
+		h.positions = append(h.positions, eventstore.EventPosition{AggregateID: eu.AggregateID(), Version: eu.Version()})
+	}
+	return iter.Err()
+}
+
+// GetEventsPageTest is the test that all implementations of PagedEventStore should pass.
+func GetEventsPageTest(ctx context.Context, t *testing.T, store PagedEventStore) {
+	groupIDs := []string{uuid.NewString(), uuid.NewString()}
+	timestamp := time.Now().UnixNano()
+	expected := make([]eventstore.EventPosition, 0, 9)
+	for i := range 3 {
+		groupID := groupIDs[i%len(groupIDs)]
+		aggregateID := uuid.NewString()
+		events := getEvents(0, 3, groupID, aggregateID, timestamp)
+		// only the first event is a snapshot, so all events are returned
+		for j := 1; j < len(events); j++ {
+			e := events[j].(MockEvent)
+			e.IsSnapshotI = false
+			events[j] = e
+		}
+		status, err := store.Save(ctx, events...)
+		require.NoError(t, err)
+		require.Equal(t, eventstore.Ok, status)
+		for _, e := range events {
+			expected = append(expected, eventstore.EventPosition{AggregateID: aggregateID, Version: e.Version()})
+		}
+	}
+	slices.SortFunc(expected, func(a, b eventstore.EventPosition) int {
+		if a.Less(b) {
+			return -1
+		}
+		if b.Less(a) {
+			return 1
+		}
+		return 0
+	})
+	queries := []eventstore.GetEventsQuery{{GroupID: groupIDs[0]}, {GroupID: groupIDs[1]}}
+
+	var got []eventstore.EventPosition
+	page := eventstore.EventsPage{Limit: 4}
+	for range len(expected) {
+		var h positionHandler
+		err := store.GetEventsPage(ctx, queries, 0, page, &h)
+		require.NoError(t, err)
+		require.LessOrEqual(t, int64(len(h.positions)), page.Limit)
+		if len(h.positions) == 0 {
+			break
+		}
+		got = append(got, h.positions...)
+		page.After = &h.positions[len(h.positions)-1]
+	}
+	require.Equal(t, expected, got)
+
+	// the timestamp filter is applied together with the page
+	var h positionHandler
+	err := store.GetEventsPage(ctx, queries, timestamp+1, eventstore.EventsPage{After: &expected[0]}, &h)
+	require.NoError(t, err)
+	expectedAfterTimestamp := make([]eventstore.EventPosition, 0, len(expected))
+	for _, p := range expected[1:] {
+		if p.Version == 2 {
+			expectedAfterTimestamp = append(expectedAfterTimestamp, p)
+		}
+	}
+	require.Equal(t, expectedAfterTimestamp, h.positions)
+}
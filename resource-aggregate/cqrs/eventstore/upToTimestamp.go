@@ -0,0 +1,72 @@
+package eventstore
+
+import "context"
+
+// upToTimestampIter passes the events of each aggregate from the nearest snapshot stored up to the timestamp. The events
+// must be ordered by the aggregateID and the version.
+type upToTimestampIter struct {
+	Iter
+	timestamp int64
+
+	next     EventUnmarshaler // the first event of the next aggregate
+	buffered []EventUnmarshaler
+}
+
+// bufferAggregate reads the events of the aggregate. When no event of the aggregate is stored up to the timestamp,
+// only the oldest event is kept.
+func (i *upToTimestampIter) bufferAggregate(ctx context.Context, first EventUnmarshaler) {
+	i.buffered = append(i.buffered[:0], first)
+	upToTimestamp := first.Timestamp().UnixNano() <= i.timestamp
+	for {
+		eu, ok := i.Iter.Next(ctx)
+		if !ok {
+			return
+		}
+		if eu.AggregateID() != first.AggregateID() {
+			i.next = eu
+			return
+		}
+		if eu.Timestamp().UnixNano() > i.timestamp {
+			continue
+		}
+		if !upToTimestamp || eu.IsSnapshot() {
+			i.buffered = i.buffered[:0]
+			upToTimestamp = true
+		}
+		i.buffered = append(i.buffered, eu)
+	}
+}
+
+func (i *upToTimestampIter) Next(ctx context.Context) (EventUnmarshaler, bool) {
+	if len(i.buffered) == 0 {
+		first := i.next
+		i.next = nil
+		if first == nil {
+			var ok bool
+			first, ok = i.Iter.Next(ctx)
+			if !ok {
+				return nil, false
+			}
+		}
+		i.bufferAggregate(ctx, first)
+	}
+	eu := i.buffered[0]
+	i.buffered = i.buffered[1:]
+	return eu, true
+}
+
+type upToTimestampHandler struct {
+	Handler
+	timestamp int64
+}
+
+func (h *upToTimestampHandler) Handle(ctx context.Context, iter Iter) error {
+	return h.Handler.Handle(ctx, &upToTimestampIter{Iter: iter, timestamp: h.timestamp})
+}
+
+// NewUpToTimestampHandler creates the handler which passes the events of each aggregate as LoadUpToTimestamp returns them.
+// The events of each aggregate must be iterated together ordered by the version and they must contain the events from the nearest
+// snapshot stored up to the timestamp or the oldest event of the aggregate.
+func NewUpToTimestampHandler(handler Handler, timestamp int64) Handler {
+	return &upToTimestampHandler{Handler: handler, timestamp: timestamp}
+}
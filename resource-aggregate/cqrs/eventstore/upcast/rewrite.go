@@ -0,0 +1,29 @@
+package upcast
+
+import (
+	"context"
+	"errors"
+)
+
+// RewriteFunc returns the new data of the stored event. The stored event is updated only when the
+// returned flag is set. The function can be called more times for the same event, eg. when the event was
+// replaced concurrently.
+type RewriteFunc = func(eventType string, data []byte) ([]byte, bool, error)
+
+// Rewriter is implemented by the eventstores which support in place rewriting of stored events.
+type Rewriter interface {
+	// RewriteEvents calls the rewrite function for each stored event and returns the number of updated events.
+	RewriteEvents(ctx context.Context, rewrite RewriteFunc) (int, error)
+}
+
+// RewriteEvents rewrites stored events to the current shape and returns number of rewritten events.
+// The rewrite is idempotent, so it can be interrupted and started again.
+func RewriteEvents(ctx context.Context, store interface{}, registry *Registry, unmarshal UnmarshalerFunc, marshal MarshalerFunc) (int, error) {
+	rewriter, ok := store.(Rewriter)
+	if !ok {
+		return 0, errors.New("eventstore doesn't support rewriting of events")
+	}
+	return rewriter.RewriteEvents(ctx, func(eventType string, data []byte) ([]byte, bool, error) {
+		return registry.Rewrite(eventType, data, unmarshal, marshal)
+	})
+}
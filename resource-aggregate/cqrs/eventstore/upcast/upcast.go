@@ -0,0 +1,151 @@
+// Package upcast migrates stored events to the current shape of the event.
+//
+// Upcasters are registered per event type and they are applied in the ascending order of
+// their versions when an event is decoded by the eventstore. The version of the shape is not
+// stored with the event, so each upcaster must be idempotent and it must change the event only
+// when the event is in the old shape.
+package upcast
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// UnmarshalerFunc unmarshal bytes to pointer of struct.
+type UnmarshalerFunc = func(b []byte, v interface{}) error
+
+// MarshalerFunc marshal struct to bytes.
+type MarshalerFunc = func(v interface{}) ([]byte, error)
+
+// Upcaster migrates the decoded event of EventType to the newer shape.
+type Upcaster struct {
+	EventType string
+	// Version orders upcasters of the same event type.
+	Version uint32
+	// NewEvent creates an empty event of EventType. It is used to decode the stored data by Rewrite.
+	NewEvent func() interface{}
+	// Upcast migrates the event in place and reports whether the event was changed.
+	Upcast func(event interface{}) (bool, error)
+}
+
+// NewUpcaster creates upcaster for events of type T.
+func NewUpcaster[T any](eventType string, version uint32, upcast func(event *T) (bool, error)) Upcaster {
+	return Upcaster{
+		EventType: eventType,
+		Version:   version,
+		NewEvent: func() interface{} {
+			return new(T)
+		},
+		Upcast: func(event interface{}) (bool, error) {
+			e, ok := event.(*T)
+			if !ok {
+				return false, fmt.Errorf("invalid event type %T, expected %T", event, (*T)(nil))
+			}
+			return upcast(e)
+		},
+	}
+}
+
+// Registry holds the registered upcasters.
+type Registry struct {
+	lock      sync.RWMutex
+	upcasters map[string][]Upcaster
+}
+
+// NewRegistry creates an empty registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		upcasters: make(map[string][]Upcaster),
+	}
+}
+
+// Register adds the upcaster to the registry. The version must be unique for the event type.
+func (r *Registry) Register(u Upcaster) error {
+	if u.EventType == "" {
+		return fmt.Errorf("invalid eventType('%v')", u.EventType)
+	}
+	if u.NewEvent == nil || u.Upcast == nil {
+		return fmt.Errorf("invalid upcaster for eventType('%v') version('%v')", u.EventType, u.Version)
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	upcasters := r.upcasters[u.EventType]
+	for _, v := range upcasters {
+		if v.Version == u.Version {
+			return fmt.Errorf("upcaster for eventType('%v') version('%v') is already registered", u.EventType, u.Version)
+		}
+	}
+	upcasters = append(upcasters, u)
+	sort.Slice(upcasters, func(i, j int) bool {
+		return upcasters[i].Version < upcasters[j].Version
+	})
+	r.upcasters[u.EventType] = upcasters
+	return nil
+}
+
+func (r *Registry) get(eventType string) []Upcaster {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	return r.upcasters[eventType]
+}
+
+// LatestVersion returns the version of the latest upcaster for the event type. 0 means no upcaster is registered.
+func (r *Registry) LatestVersion(eventType string) uint32 {
+	upcasters := r.get(eventType)
+	if len(upcasters) == 0 {
+		return 0
+	}
+	return upcasters[len(upcasters)-1].Version
+}
+
+// Upcast applies all upcasters of the event type to the decoded event.
+func (r *Registry) Upcast(eventType string, event interface{}) (bool, error) {
+	var changed bool
+	for _, u := range r.get(eventType) {
+		c, err := u.Upcast(event)
+		if err != nil {
+			return false, fmt.Errorf("cannot upcast eventType('%v') to version('%v'): %w", eventType, u.Version, err)
+		}
+		changed = changed || c
+	}
+	return changed, nil
+}
+
+// Unmarshaler wraps the unmarshaler, so the upcasters are applied to each decoded event. The event type
+// is provided by the EventType method of the decoded value.
+func (r *Registry) Unmarshaler(unmarshal UnmarshalerFunc) UnmarshalerFunc {
+	return func(b []byte, v interface{}) error {
+		if err := unmarshal(b, v); err != nil {
+			return err
+		}
+		e, ok := v.(interface{ EventType() string })
+		if !ok {
+			return nil
+		}
+		_, err := r.Upcast(e.EventType(), v)
+		return err
+	}
+}
+
+// Rewrite decodes the stored data of the event type, applies the upcasters and returns encoded data
+// when the event was changed.
+func (r *Registry) Rewrite(eventType string, data []byte, unmarshal UnmarshalerFunc, marshal MarshalerFunc) ([]byte, bool, error) {
+	upcasters := r.get(eventType)
+	if len(upcasters) == 0 {
+		return nil, false, nil
+	}
+	event := upcasters[0].NewEvent()
+	if err := unmarshal(data, event); err != nil {
+		return nil, false, fmt.Errorf("cannot unmarshal eventType('%v'): %w", eventType, err)
+	}
+	changed, err := r.Upcast(eventType, event)
+	if err != nil || !changed {
+		return nil, false, err
+	}
+	newData, err := marshal(event)
+	if err != nil {
+		return nil, false, fmt.Errorf("cannot marshal eventType('%v'): %w", eventType, err)
+	}
+	return newData, true, nil
+}
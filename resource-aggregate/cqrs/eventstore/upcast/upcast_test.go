@@ -0,0 +1,127 @@
+package upcast_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/plgd-dev/hub/v2/pkg/log"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore/memory"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore/test"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore/upcast"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const testEventType = "test"
+
+// replaceData returns idempotent upcaster which replaces data of the event.
+func replaceData(version uint32, from, to string) upcast.Upcaster {
+	return upcast.NewUpcaster(testEventType, version, func(e *test.MockEvent) (bool, error) {
+		if string(e.DataI) != from {
+			return false, nil
+		}
+		e.DataI = []byte(to)
+		return true, nil
+	})
+}
+
+func newTestRegistry(t *testing.T) *upcast.Registry {
+	r := upcast.NewRegistry()
+	// upcasters are applied by the version, not by the order of the registration
+	err := r.Register(replaceData(2, "v1", "v2"))
+	require.NoError(t, err)
+	err = r.Register(replaceData(1, "v0", "v1"))
+	require.NoError(t, err)
+	return r
+}
+
+func TestRegistryRegister(t *testing.T) {
+	r := newTestRegistry(t)
+	require.Equal(t, uint32(2), r.LatestVersion(testEventType))
+	require.Equal(t, uint32(0), r.LatestVersion("unknown"))
+
+	err := r.Register(replaceData(1, "a", "b"))
+	require.Error(t, err)
+	err = r.Register(upcast.Upcaster{EventType: testEventType, Version: 3})
+	require.Error(t, err)
+	u := replaceData(3, "a", "b")
+	u.EventType = ""
+	err = r.Register(u)
+	require.Error(t, err)
+}
+
+func TestRegistryUnmarshaler(t *testing.T) {
+	r := newTestRegistry(t)
+	unmarshal := r.Unmarshaler(bson.Unmarshal)
+
+	data, err := bson.Marshal(test.MockEvent{EventTypeI: testEventType, DataI: []byte("v0")})
+	require.NoError(t, err)
+	var e test.MockEvent
+	err = unmarshal(data, &e)
+	require.NoError(t, err)
+	require.Equal(t, "v2", string(e.DataI))
+
+	// other event types are not changed
+	data, err = bson.Marshal(test.MockEvent{EventTypeI: "other", DataI: []byte("v0")})
+	require.NoError(t, err)
+	e = test.MockEvent{}
+	err = unmarshal(data, &e)
+	require.NoError(t, err)
+	require.Equal(t, "v0", string(e.DataI))
+}
+
+func newTestEvents(data string) []eventstore.Event {
+	const (
+		deviceID    = "2f1d4c4e-7a1b-4b2f-9e39-0a9d6c3f5b11"
+		aggregateID = "7b8a6f5e-0f58-4c5d-9c4e-62d9b1f7a3a1"
+		serviceID   = "c3a7e9d2-5b14-4f6e-8a2d-1e0b9f4c7d63"
+	)
+	events := make([]eventstore.Event, 0, 3)
+	for i := 0; i < 3; i++ {
+		events = append(events, test.MockEvent{
+			VersionI:     uint64(i),
+			EventTypeI:   testEventType,
+			IsSnapshotI:  i == 0,
+			AggregateIDI: aggregateID,
+			GroupIDI:     deviceID,
+			DataI:        []byte(data),
+			TimestampI:   int64(i + 1),
+			ServiceIDI:   serviceID,
+		})
+	}
+	return events
+}
+
+func TestRewriteEvents(t *testing.T) {
+	ctx := context.Background()
+	r := newTestRegistry(t)
+	store, err := memory.New(ctx, &memory.Config{}, log.NewLogger(log.MakeDefaultConfig()),
+		memory.WithMarshaler(bson.Marshal), memory.WithUnmarshaler(bson.Unmarshal))
+	require.NoError(t, err)
+	defer func() {
+		errC := store.Close(ctx)
+		require.NoError(t, errC)
+	}()
+
+	events := newTestEvents("v0")
+	_, err = store.Save(ctx, events...)
+	require.NoError(t, err)
+
+	count, err := upcast.RewriteEvents(ctx, store, r, bson.Unmarshal, bson.Marshal)
+	require.NoError(t, err)
+	require.Equal(t, len(events), count)
+
+	eh := test.NewMockEventHandler()
+	err = store.LoadFromVersion(ctx, []eventstore.VersionQuery{{GroupID: events[0].GroupID(), AggregateID: events[0].AggregateID()}}, eh)
+	require.NoError(t, err)
+	require.True(t, eh.Equals(newTestEvents("v2")))
+
+	// stored events are already in the current shape
+	count, err = upcast.RewriteEvents(ctx, store, r, bson.Unmarshal, bson.Marshal)
+	require.NoError(t, err)
+	require.Equal(t, 0, count)
+
+	_, err = upcast.RewriteEvents(ctx, struct{}{}, r, bson.Unmarshal, bson.Marshal)
+	require.Error(t, err)
+}
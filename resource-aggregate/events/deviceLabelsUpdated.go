@@ -0,0 +1,77 @@
+package events
+
+import (
+	"time"
+
+	"github.com/plgd-dev/hub/v2/pkg/labels"
+	pkgTime "github.com/plgd-dev/hub/v2/pkg/time"
+	commands "github.com/plgd-dev/hub/v2/resource-aggregate/commands"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore"
+	"google.golang.org/protobuf/proto"
+)
+
+const eventTypeDeviceLabelsUpdated = "devicelabelsupdated"
+
+func (d *DeviceLabelsUpdated) Version() uint64 {
+	return d.GetEventMetadata().GetVersion()
+}
+
+func (d *DeviceLabelsUpdated) Marshal() ([]byte, error) {
+	return proto.Marshal(d)
+}
+
+func (d *DeviceLabelsUpdated) Unmarshal(b []byte) error {
+	return proto.Unmarshal(b, d)
+}
+
+func (d *DeviceLabelsUpdated) EventType() string {
+	return eventTypeDeviceLabelsUpdated
+}
+
+func (d *DeviceLabelsUpdated) AggregateID() string {
+	return commands.MakeStatusResourceUUID(d.GetDeviceId()).String()
+}
+
+func (d *DeviceLabelsUpdated) GroupID() string {
+	return d.GetDeviceId()
+}
+
+func (d *DeviceLabelsUpdated) IsSnapshot() bool {
+	return false
+}
+
+func (d *DeviceLabelsUpdated) ETag() *eventstore.ETagData {
+	return nil
+}
+
+func (d *DeviceLabelsUpdated) Timestamp() time.Time {
+	return pkgTime.Unix(0, d.GetEventMetadata().GetTimestamp())
+}
+
+func (d *DeviceLabelsUpdated) ServiceID() (string, bool) {
+	return "", false
+}
+
+func (d *DeviceLabelsUpdated) Types() []string {
+	return nil
+}
+
+func (d *DeviceLabelsUpdated) CopyData(event *DeviceLabelsUpdated) {
+	d.DeviceId = event.GetDeviceId()
+	d.Labels = event.GetLabels()
+	d.DisplayName = event.GetDisplayName()
+	d.AuditContext = event.GetAuditContext()
+	d.EventMetadata = event.GetEventMetadata()
+	d.OpenTelemetryCarrier = event.GetOpenTelemetryCarrier()
+}
+
+func (d *DeviceLabelsUpdated) CheckInitialized() bool {
+	return d.GetDeviceId() != "" &&
+		d.GetAuditContext() != nil &&
+		d.GetEventMetadata() != nil
+}
+
+// Equal checks if the labels and the display names of two DeviceLabelsUpdated events are equal.
+func (d *DeviceLabelsUpdated) Equal(upd *DeviceLabelsUpdated) bool {
+	return labels.Equal(d.GetLabels(), upd.GetLabels()) && d.GetDisplayName() == upd.GetDisplayName()
+}
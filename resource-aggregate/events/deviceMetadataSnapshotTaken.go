@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 	"time"
+	"unicode/utf8"
 
+	"github.com/plgd-dev/hub/v2/pkg/labels"
 	"github.com/plgd-dev/hub/v2/pkg/opentelemetry/propagation"
 	pkgTime "github.com/plgd-dev/hub/v2/pkg/time"
 	commands "github.com/plgd-dev/hub/v2/resource-aggregate/commands"
@@ -16,7 +18,11 @@ import (
 	"google.golang.org/protobuf/proto"
 )
 
-const eventTypeDeviceMetadataSnapshotTaken = "devicemetadatasnapshottaken"
+const (
+	eventTypeDeviceMetadataSnapshotTaken = "devicemetadatasnapshottaken"
+	// maxDisplayNameLength limits the user-defined name of the device in bytes
+	maxDisplayNameLength = 256
+)
 
 func (d *DeviceMetadataSnapshotTaken) Version() uint64 {
 	return d.GetEventMetadata().GetVersion()
@@ -67,13 +73,15 @@ func (d *DeviceMetadataSnapshotTaken) CopyData(event *DeviceMetadataSnapshotTake
 	d.DeviceMetadataUpdated = event.GetDeviceMetadataUpdated()
 	d.UpdatePendings = event.GetUpdatePendings()
 	d.EventMetadata = event.GetEventMetadata()
+	d.DeviceLabelsUpdated = event.GetDeviceLabelsUpdated()
 }
 
 func (d *DeviceMetadataSnapshotTaken) CheckInitialized() bool {
 	return d.GetDeviceId() != "" &&
 		d.GetEventMetadata() != nil &&
 		(d.GetDeviceMetadataUpdated() != nil ||
-			d.GetUpdatePendings() != nil)
+			d.GetUpdatePendings() != nil ||
+			d.GetDeviceLabelsUpdated() != nil)
 }
 
 func (d *DeviceMetadataSnapshotTaken) HandleDeviceMetadataUpdated(_ context.Context, upd *DeviceMetadataUpdated, confirm bool) (bool, error) {
@@ -102,6 +110,16 @@ func (d *DeviceMetadataSnapshotTaken) HandleDeviceMetadataUpdated(_ context.Cont
 	return true, nil
 }
 
+func (d *DeviceMetadataSnapshotTaken) HandleDeviceLabelsUpdated(_ context.Context, upd *DeviceLabelsUpdated) bool {
+	if d.GetDeviceLabelsUpdated().Equal(upd) {
+		return false
+	}
+	d.DeviceId = upd.GetDeviceId()
+	d.DeviceLabelsUpdated = upd
+	d.EventMetadata = upd.GetEventMetadata()
+	return true
+}
+
 func (d *DeviceMetadataSnapshotTaken) HandleDeviceMetadataSnapshotTaken(_ context.Context, s *DeviceMetadataSnapshotTaken) {
 	d.CopyData(s)
 }
@@ -148,6 +166,12 @@ func (d *DeviceMetadataSnapshotTaken) handleByEvent(ctx context.Context, eu even
 			return status.Errorf(codes.Internal, "%v", err)
 		}
 		_ = d.HandleDeviceMetadataUpdatePending(ctx, &s)
+	case (&DeviceLabelsUpdated{}).EventType():
+		var s DeviceLabelsUpdated
+		if err := eu.Unmarshal(&s); err != nil {
+			return status.Errorf(codes.Internal, "%v", err)
+		}
+		_ = d.HandleDeviceLabelsUpdated(ctx, &s)
 	}
 	return nil
 }
@@ -532,6 +556,51 @@ func (d *DeviceMetadataSnapshotTakenForCommand) updateDeviceTwinForceSynchroniza
 	return []eventstore.Event{&ev}, nil
 }
 
+// updateDisplayName returns the display name of the device after the update. The removal takes precedence over the set.
+func updateDisplayName(displayName string, upd *commands.LabelsUpdate) (string, error) {
+	if upd.GetRemoveDisplayName() {
+		return "", nil
+	}
+	if upd.GetSetDisplayName() == "" {
+		return displayName, nil
+	}
+	if len(upd.GetSetDisplayName()) > maxDisplayNameLength || !utf8.ValidString(upd.GetSetDisplayName()) {
+		return "", status.Errorf(codes.InvalidArgument, "cannot update display name: invalid display name('%v')", upd.GetSetDisplayName())
+	}
+	return upd.GetSetDisplayName(), nil
+}
+
+func (d *DeviceMetadataSnapshotTaken) updateDeviceLabels(ctx context.Context, req *commands.UpdateDeviceMetadataRequest, em *EventMetadata, ac *commands.AuditContext) ([]eventstore.Event, error) {
+	if em.GetVersion() == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot update labels for not existing device %v", req.GetDeviceId())
+	}
+	if err := labels.Validate(req.GetLabels().GetSet()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot update labels: %v", err)
+	}
+	for _, key := range req.GetLabels().GetRemove() {
+		if err := labels.ValidateKey(key); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "cannot remove labels: %v", err)
+		}
+	}
+	displayName, err := updateDisplayName(d.GetDeviceLabelsUpdated().GetDisplayName(), req.GetLabels())
+	if err != nil {
+		return nil, err
+	}
+	// the event contains all labels of the device, so the projections don't need to replay the previous updates
+	ev := DeviceLabelsUpdated{
+		DeviceId:             req.GetDeviceId(),
+		Labels:               labels.Update(d.GetDeviceLabelsUpdated().GetLabels(), req.GetLabels().GetSet(), req.GetLabels().GetRemove()),
+		DisplayName:          displayName,
+		AuditContext:         ac,
+		EventMetadata:        em,
+		OpenTelemetryCarrier: propagation.TraceFromCtx(ctx),
+	}
+	if !d.HandleDeviceLabelsUpdated(ctx, &ev) {
+		return nil, nil
+	}
+	return []eventstore.Event{&ev}, nil
+}
+
 func (d *DeviceMetadataSnapshotTakenForCommand) updateDeviceMetadataRequest(ctx context.Context, req *commands.UpdateDeviceMetadataRequest, newVersion uint64) ([]eventstore.Event, error) {
 	if req.GetCommandMetadata() == nil {
 		return nil, status.Errorf(codes.InvalidArgument, errInvalidCommandMetadata)
@@ -551,6 +620,8 @@ func (d *DeviceMetadataSnapshotTakenForCommand) updateDeviceMetadataRequest(ctx
 		return d.updateDeviceTwinEnabled(ctx, req, em, ac)
 	case is_process_twin_force_synchronization:
 		return d.updateDeviceTwinForceSynchronization(ctx, req, em, ac)
+	case req.GetLabels() != nil:
+		return d.updateDeviceLabels(ctx, req, em, ac)
 	default:
 		return nil, status.Errorf(codes.InvalidArgument, "unknown update type(%T)", req.GetUpdate())
 	}
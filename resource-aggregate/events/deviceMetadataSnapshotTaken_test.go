@@ -2,6 +2,7 @@ package events_test
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
@@ -708,6 +709,202 @@ func TestDeviceMetadataSnapshotTakenHandleCommand(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "labels-not-existing-device",
+			cmds: []cmd{
+				{
+					cmd: &commands.UpdateDeviceMetadataRequest{
+						DeviceId:        deviceID,
+						CommandMetadata: &commands.CommandMetadata{ConnectionId: connectionID},
+						CorrelationId:   correlationID,
+						Update: &commands.UpdateDeviceMetadataRequest_Labels{
+							Labels: &commands.LabelsUpdate{Set: map[string]string{"site": "brno"}},
+						},
+					},
+					newVersion: 0,
+					wantErr:    true,
+				},
+			},
+		},
+		{
+			name: "online,set-labels,set-same-labels,remove-labels,invalid-labels",
+			cmds: []cmd{
+				{
+					cmd: &commands.UpdateDeviceMetadataRequest{
+						DeviceId:        deviceID,
+						CommandMetadata: &commands.CommandMetadata{ConnectionId: connectionID},
+						CorrelationId:   correlationID,
+						Update: &commands.UpdateDeviceMetadataRequest_Connection{
+							Connection: &commands.Connection{
+								Status: commands.Connection_ONLINE,
+							},
+						},
+					},
+					newVersion: 0,
+					want: []*grpcgwPb.Event{
+						pb.ToEvent(&events.DeviceMetadataUpdated{
+							DeviceId: deviceID,
+							Connection: &commands.Connection{
+								Status: commands.Connection_ONLINE,
+							},
+							TwinEnabled:          true,
+							TwinSynchronization:  &commands.TwinSynchronization{},
+							AuditContext:         commands.NewAuditContext(userID, correlationID, userID),
+							OpenTelemetryCarrier: map[string]string{},
+						}),
+					},
+				},
+				{
+					cmd: &commands.UpdateDeviceMetadataRequest{
+						DeviceId:        deviceID,
+						CommandMetadata: &commands.CommandMetadata{ConnectionId: connectionID},
+						CorrelationId:   correlationID,
+						Update: &commands.UpdateDeviceMetadataRequest_Labels{
+							Labels: &commands.LabelsUpdate{Set: map[string]string{"site": "brno", "rack": "r1"}},
+						},
+					},
+					newVersion: 1,
+					want: []*grpcgwPb.Event{
+						pb.ToEvent(&events.DeviceLabelsUpdated{
+							DeviceId:     deviceID,
+							Labels:       map[string]string{"site": "brno", "rack": "r1"},
+							AuditContext: commands.NewAuditContext(userID, correlationID, userID),
+						}),
+					},
+				},
+				{
+					cmd: &commands.UpdateDeviceMetadataRequest{
+						DeviceId:        deviceID,
+						CommandMetadata: &commands.CommandMetadata{ConnectionId: connectionID},
+						CorrelationId:   correlationID,
+						Update: &commands.UpdateDeviceMetadataRequest_Labels{
+							Labels: &commands.LabelsUpdate{Set: map[string]string{"rack": "r1"}},
+						},
+					},
+					newVersion: 2,
+				},
+				{
+					cmd: &commands.UpdateDeviceMetadataRequest{
+						DeviceId:        deviceID,
+						CommandMetadata: &commands.CommandMetadata{ConnectionId: connectionID},
+						CorrelationId:   correlationID,
+						Update: &commands.UpdateDeviceMetadataRequest_Labels{
+							Labels: &commands.LabelsUpdate{Remove: []string{"site"}},
+						},
+					},
+					newVersion: 2,
+					want: []*grpcgwPb.Event{
+						pb.ToEvent(&events.DeviceLabelsUpdated{
+							DeviceId:     deviceID,
+							Labels:       map[string]string{"rack": "r1"},
+							AuditContext: commands.NewAuditContext(userID, correlationID, userID),
+						}),
+					},
+				},
+				{
+					cmd: &commands.UpdateDeviceMetadataRequest{
+						DeviceId:        deviceID,
+						CommandMetadata: &commands.CommandMetadata{ConnectionId: connectionID},
+						CorrelationId:   correlationID,
+						Update: &commands.UpdateDeviceMetadataRequest_Labels{
+							Labels: &commands.LabelsUpdate{Set: map[string]string{"-rack": "r1"}},
+						},
+					},
+					newVersion: 3,
+					wantErr:    true,
+				},
+			},
+		},
+		{
+			name: "online,set-display-name,set-same-display-name,remove-display-name,invalid-display-name",
+			cmds: []cmd{
+				{
+					cmd: &commands.UpdateDeviceMetadataRequest{
+						DeviceId:        deviceID,
+						CommandMetadata: &commands.CommandMetadata{ConnectionId: connectionID},
+						CorrelationId:   correlationID,
+						Update: &commands.UpdateDeviceMetadataRequest_Connection{
+							Connection: &commands.Connection{
+								Status: commands.Connection_ONLINE,
+							},
+						},
+					},
+					newVersion: 0,
+					want: []*grpcgwPb.Event{
+						pb.ToEvent(&events.DeviceMetadataUpdated{
+							DeviceId: deviceID,
+							Connection: &commands.Connection{
+								Status: commands.Connection_ONLINE,
+							},
+							TwinEnabled:          true,
+							TwinSynchronization:  &commands.TwinSynchronization{},
+							AuditContext:         commands.NewAuditContext(userID, correlationID, userID),
+							OpenTelemetryCarrier: map[string]string{},
+						}),
+					},
+				},
+				{
+					cmd: &commands.UpdateDeviceMetadataRequest{
+						DeviceId:        deviceID,
+						CommandMetadata: &commands.CommandMetadata{ConnectionId: connectionID},
+						CorrelationId:   correlationID,
+						Update: &commands.UpdateDeviceMetadataRequest_Labels{
+							Labels: &commands.LabelsUpdate{Set: map[string]string{"site": "brno"}, SetDisplayName: "lobby light"},
+						},
+					},
+					newVersion: 1,
+					want: []*grpcgwPb.Event{
+						pb.ToEvent(&events.DeviceLabelsUpdated{
+							DeviceId:     deviceID,
+							Labels:       map[string]string{"site": "brno"},
+							DisplayName:  "lobby light",
+							AuditContext: commands.NewAuditContext(userID, correlationID, userID),
+						}),
+					},
+				},
+				{
+					cmd: &commands.UpdateDeviceMetadataRequest{
+						DeviceId:        deviceID,
+						CommandMetadata: &commands.CommandMetadata{ConnectionId: connectionID},
+						CorrelationId:   correlationID,
+						Update: &commands.UpdateDeviceMetadataRequest_Labels{
+							Labels: &commands.LabelsUpdate{SetDisplayName: "lobby light"},
+						},
+					},
+					newVersion: 2,
+				},
+				{
+					cmd: &commands.UpdateDeviceMetadataRequest{
+						DeviceId:        deviceID,
+						CommandMetadata: &commands.CommandMetadata{ConnectionId: connectionID},
+						CorrelationId:   correlationID,
+						Update: &commands.UpdateDeviceMetadataRequest_Labels{
+							Labels: &commands.LabelsUpdate{SetDisplayName: "hall light", RemoveDisplayName: true},
+						},
+					},
+					newVersion: 2,
+					want: []*grpcgwPb.Event{
+						pb.ToEvent(&events.DeviceLabelsUpdated{
+							DeviceId:     deviceID,
+							Labels:       map[string]string{"site": "brno"},
+							AuditContext: commands.NewAuditContext(userID, correlationID, userID),
+						}),
+					},
+				},
+				{
+					cmd: &commands.UpdateDeviceMetadataRequest{
+						DeviceId:        deviceID,
+						CommandMetadata: &commands.CommandMetadata{ConnectionId: connectionID},
+						CorrelationId:   correlationID,
+						Update: &commands.UpdateDeviceMetadataRequest_Labels{
+							Labels: &commands.LabelsUpdate{SetDisplayName: strings.Repeat("a", 257)},
+						},
+					},
+					newVersion: 3,
+					wantErr:    true,
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
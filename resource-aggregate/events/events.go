@@ -1,5 +1,7 @@
 package events
 
+import "maps"
+
 func (e *EventMetadata) Clone() *EventMetadata {
 	if e == nil {
 		return nil
@@ -43,6 +45,19 @@ func (d *DeviceMetadataUpdatePending) Clone() *DeviceMetadataUpdatePending {
 	}
 }
 
+func (d *DeviceLabelsUpdated) Clone() *DeviceLabelsUpdated {
+	if d == nil {
+		return nil
+	}
+	return &DeviceLabelsUpdated{
+		DeviceId:      d.GetDeviceId(),
+		Labels:        maps.Clone(d.GetLabels()),
+		DisplayName:   d.GetDisplayName(),
+		AuditContext:  d.GetAuditContext().Clone(),
+		EventMetadata: d.GetEventMetadata().Clone(),
+	}
+}
+
 func (d *DeviceMetadataSnapshotTaken) Clone() *DeviceMetadataSnapshotTaken {
 	if d == nil {
 		return nil
@@ -52,5 +67,6 @@ func (d *DeviceMetadataSnapshotTaken) Clone() *DeviceMetadataSnapshotTaken {
 		DeviceMetadataUpdated: d.GetDeviceMetadataUpdated().Clone(),
 		UpdatePendings:        append([]*DeviceMetadataUpdatePending(nil), d.GetUpdatePendings()...),
 		EventMetadata:         d.GetEventMetadata().Clone(),
+		DeviceLabelsUpdated:   d.GetDeviceLabelsUpdated().Clone(),
 	}
 }
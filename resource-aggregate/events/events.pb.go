@@ -1567,6 +1567,95 @@ func (*DeviceMetadataUpdatePending_TwinEnabled) isDeviceMetadataUpdatePending_Up
 func (*DeviceMetadataUpdatePending_TwinForceSynchronization) isDeviceMetadataUpdatePending_UpdatePending() {
 }
 
+// DeviceLabelsUpdated is stored when the user-defined labels or display name of the device are changed. It contains all labels and the display name of the device.
+type DeviceLabelsUpdated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeviceId      string                 `protobuf:"bytes,1,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	Labels        map[string]string      `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	AuditContext  *commands.AuditContext `protobuf:"bytes,3,opt,name=audit_context,json=auditContext,proto3" json:"audit_context,omitempty"`
+	EventMetadata *EventMetadata         `protobuf:"bytes,4,opt,name=event_metadata,json=eventMetadata,proto3" json:"event_metadata,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,5,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"` // user-defined name of the device.
+	// Open telemetry data propagated to asynchronous events
+	OpenTelemetryCarrier map[string]string `protobuf:"bytes,100,rep,name=open_telemetry_carrier,json=openTelemetryCarrier,proto3" json:"open_telemetry_carrier,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *DeviceLabelsUpdated) Reset() {
+	*x = DeviceLabelsUpdated{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_resource_aggregate_pb_events_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeviceLabelsUpdated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceLabelsUpdated) ProtoMessage() {}
+
+func (x *DeviceLabelsUpdated) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_aggregate_pb_events_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeviceLabelsUpdated.ProtoReflect.Descriptor instead.
+func (*DeviceLabelsUpdated) Descriptor() ([]byte, []int) {
+	return file_resource_aggregate_pb_events_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DeviceLabelsUpdated) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *DeviceLabelsUpdated) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *DeviceLabelsUpdated) GetAuditContext() *commands.AuditContext {
+	if x != nil {
+		return x.AuditContext
+	}
+	return nil
+}
+
+func (x *DeviceLabelsUpdated) GetEventMetadata() *EventMetadata {
+	if x != nil {
+		return x.EventMetadata
+	}
+	return nil
+}
+
+func (x *DeviceLabelsUpdated) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *DeviceLabelsUpdated) GetOpenTelemetryCarrier() map[string]string {
+	if x != nil {
+		return x.OpenTelemetryCarrier
+	}
+	return nil
+}
+
 type DeviceMetadataSnapshotTaken struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1576,12 +1665,13 @@ type DeviceMetadataSnapshotTaken struct {
 	DeviceMetadataUpdated *DeviceMetadataUpdated         `protobuf:"bytes,2,opt,name=device_metadata_updated,json=deviceMetadataUpdated,proto3" json:"device_metadata_updated,omitempty"`
 	UpdatePendings        []*DeviceMetadataUpdatePending `protobuf:"bytes,3,rep,name=update_pendings,json=updatePendings,proto3" json:"update_pendings,omitempty"` // expired events will be removed by creating a new snapshot.
 	EventMetadata         *EventMetadata                 `protobuf:"bytes,4,opt,name=event_metadata,json=eventMetadata,proto3" json:"event_metadata,omitempty"`
+	DeviceLabelsUpdated   *DeviceLabelsUpdated           `protobuf:"bytes,5,opt,name=device_labels_updated,json=deviceLabelsUpdated,proto3" json:"device_labels_updated,omitempty"`
 }
 
 func (x *DeviceMetadataSnapshotTaken) Reset() {
 	*x = DeviceMetadataSnapshotTaken{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_resource_aggregate_pb_events_proto_msgTypes[16]
+		mi := &file_resource_aggregate_pb_events_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1594,7 +1684,7 @@ func (x *DeviceMetadataSnapshotTaken) String() string {
 func (*DeviceMetadataSnapshotTaken) ProtoMessage() {}
 
 func (x *DeviceMetadataSnapshotTaken) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_aggregate_pb_events_proto_msgTypes[16]
+	mi := &file_resource_aggregate_pb_events_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1607,7 +1697,7 @@ func (x *DeviceMetadataSnapshotTaken) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeviceMetadataSnapshotTaken.ProtoReflect.Descriptor instead.
 func (*DeviceMetadataSnapshotTaken) Descriptor() ([]byte, []int) {
-	return file_resource_aggregate_pb_events_proto_rawDescGZIP(), []int{16}
+	return file_resource_aggregate_pb_events_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *DeviceMetadataSnapshotTaken) GetDeviceId() string {
@@ -1638,6 +1728,13 @@ func (x *DeviceMetadataSnapshotTaken) GetEventMetadata() *EventMetadata {
 	return nil
 }
 
+func (x *DeviceMetadataSnapshotTaken) GetDeviceLabelsUpdated() *DeviceLabelsUpdated {
+	if x != nil {
+		return x.DeviceLabelsUpdated
+	}
+	return nil
+}
+
 type ServicesHeartbeat struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1650,7 +1747,7 @@ type ServicesHeartbeat struct {
 func (x *ServicesHeartbeat) Reset() {
 	*x = ServicesHeartbeat{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_resource_aggregate_pb_events_proto_msgTypes[17]
+		mi := &file_resource_aggregate_pb_events_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1663,7 +1760,7 @@ func (x *ServicesHeartbeat) String() string {
 func (*ServicesHeartbeat) ProtoMessage() {}
 
 func (x *ServicesHeartbeat) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_aggregate_pb_events_proto_msgTypes[17]
+	mi := &file_resource_aggregate_pb_events_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1676,7 +1773,7 @@ func (x *ServicesHeartbeat) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServicesHeartbeat.ProtoReflect.Descriptor instead.
 func (*ServicesHeartbeat) Descriptor() ([]byte, []int) {
-	return file_resource_aggregate_pb_events_proto_rawDescGZIP(), []int{17}
+	return file_resource_aggregate_pb_events_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ServicesHeartbeat) GetValid() []*ServicesHeartbeat_Heartbeat {
@@ -1708,7 +1805,7 @@ type ServiceMetadataUpdated struct {
 func (x *ServiceMetadataUpdated) Reset() {
 	*x = ServiceMetadataUpdated{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_resource_aggregate_pb_events_proto_msgTypes[18]
+		mi := &file_resource_aggregate_pb_events_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1721,7 +1818,7 @@ func (x *ServiceMetadataUpdated) String() string {
 func (*ServiceMetadataUpdated) ProtoMessage() {}
 
 func (x *ServiceMetadataUpdated) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_aggregate_pb_events_proto_msgTypes[18]
+	mi := &file_resource_aggregate_pb_events_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1734,7 +1831,7 @@ func (x *ServiceMetadataUpdated) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServiceMetadataUpdated.ProtoReflect.Descriptor instead.
 func (*ServiceMetadataUpdated) Descriptor() ([]byte, []int) {
-	return file_resource_aggregate_pb_events_proto_rawDescGZIP(), []int{18}
+	return file_resource_aggregate_pb_events_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ServiceMetadataUpdated) GetServicesHeartbeat() *ServicesHeartbeat {
@@ -1777,7 +1874,7 @@ type ServiceMetadataSnapshotTaken struct {
 func (x *ServiceMetadataSnapshotTaken) Reset() {
 	*x = ServiceMetadataSnapshotTaken{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_resource_aggregate_pb_events_proto_msgTypes[19]
+		mi := &file_resource_aggregate_pb_events_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1790,7 +1887,7 @@ func (x *ServiceMetadataSnapshotTaken) String() string {
 func (*ServiceMetadataSnapshotTaken) ProtoMessage() {}
 
 func (x *ServiceMetadataSnapshotTaken) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_aggregate_pb_events_proto_msgTypes[19]
+	mi := &file_resource_aggregate_pb_events_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1803,7 +1900,7 @@ func (x *ServiceMetadataSnapshotTaken) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServiceMetadataSnapshotTaken.ProtoReflect.Descriptor instead.
 func (*ServiceMetadataSnapshotTaken) Descriptor() ([]byte, []int) {
-	return file_resource_aggregate_pb_events_proto_rawDescGZIP(), []int{19}
+	return file_resource_aggregate_pb_events_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *ServiceMetadataSnapshotTaken) GetServiceMetadataUpdated() *ServiceMetadataUpdated {
@@ -1832,7 +1929,7 @@ type ServicesHeartbeat_Heartbeat struct {
 func (x *ServicesHeartbeat_Heartbeat) Reset() {
 	*x = ServicesHeartbeat_Heartbeat{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_resource_aggregate_pb_events_proto_msgTypes[34]
+		mi := &file_resource_aggregate_pb_events_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1845,7 +1942,7 @@ func (x *ServicesHeartbeat_Heartbeat) String() string {
 func (*ServicesHeartbeat_Heartbeat) ProtoMessage() {}
 
 func (x *ServicesHeartbeat_Heartbeat) ProtoReflect() protoreflect.Message {
-	mi := &file_resource_aggregate_pb_events_proto_msgTypes[34]
+	mi := &file_resource_aggregate_pb_events_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1858,7 +1955,7 @@ func (x *ServicesHeartbeat_Heartbeat) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServicesHeartbeat_Heartbeat.ProtoReflect.Descriptor instead.
 func (*ServicesHeartbeat_Heartbeat) Descriptor() ([]byte, []int) {
-	return file_resource_aggregate_pb_events_proto_rawDescGZIP(), []int{17, 0}
+	return file_resource_aggregate_pb_events_proto_rawDescGZIP(), []int{18, 0}
 }
 
 func (x *ServicesHeartbeat_Heartbeat) GetServiceId() string {
@@ -2431,90 +2528,131 @@ var file_resource_aggregate_pb_events_proto_rawDesc = []byte{
 	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x10, 0x0a,
 	0x0e, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4a,
-	0x04, 0x08, 0x02, 0x10, 0x03, 0x22, 0xc7, 0x02, 0x0a, 0x1b, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
-	0x54, 0x61, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
-	0x49, 0x64, 0x12, 0x63, 0x0a, 0x17, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x61, 0x67,
-	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63,
-	0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
-	0x52, 0x15, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x5a, 0x0a, 0x0f, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x5f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x31, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x61, 0x67, 0x67, 0x72, 0x65,
-	0x67, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x6e, 0x64,
-	0x69, 0x6e, 0x67, 0x52, 0x0e, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x6e, 0x64, 0x69,
-	0x6e, 0x67, 0x73, 0x12, 0x4a, 0x0a, 0x0e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x72, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x2e,
-	0x70, 0x62, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x52, 0x0d, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22,
-	0xf6, 0x01, 0x0a, 0x11, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x48, 0x65, 0x61, 0x72,
-	0x74, 0x62, 0x65, 0x61, 0x74, 0x12, 0x47, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x61,
-	0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x73, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x2e, 0x48, 0x65,
-	0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x4b,
-	0x0a, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x31, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67,
-	0x61, 0x74, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x48,
-	0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x2e, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65,
-	0x61, 0x74, 0x52, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x1a, 0x4b, 0x0a, 0x09, 0x48,
-	0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x22, 0xcc, 0x03, 0x0a, 0x16, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x64, 0x12, 0x56, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x5f,
-	0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x27, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67,
-	0x61, 0x74, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x48,
-	0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x11, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x73, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x12, 0x4a, 0x0a, 0x0e, 0x65,
-	0x76, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x61, 0x67,
-	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x0d, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x47, 0x0a, 0x0d, 0x61, 0x75, 0x64, 0x69, 0x74,
-	0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22,
-	0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61,
-	0x74, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65,
-	0x78, 0x74, 0x52, 0x0c, 0x61, 0x75, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
-	0x12, 0x7c, 0x0a, 0x16, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74,
-	0x72, 0x79, 0x5f, 0x63, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x18, 0x64, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x46, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x61, 0x67, 0x67, 0x72, 0x65,
-	0x67, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x2e, 0x4f,
-	0x70, 0x65, 0x6e, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x43, 0x61, 0x72, 0x72,
-	0x69, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x14, 0x6f, 0x70, 0x65, 0x6e, 0x54, 0x65,
-	0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x43, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x1a, 0x47,
-	0x0a, 0x19, 0x4f, 0x70, 0x65, 0x6e, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x43,
-	0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x04, 0x08, 0x02, 0x10, 0x03, 0x22, 0xb8, 0x04, 0x0a, 0x13, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x4d, 0x0a, 0x06, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x70,
+	0x62, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x47, 0x0a, 0x0d, 0x61, 0x75, 0x64,
+	0x69, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x61, 0x67, 0x67, 0x72, 0x65,
+	0x67, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x78, 0x74, 0x52, 0x0c, 0x61, 0x75, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x12, 0x4a, 0x0a, 0x0e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x70,
+	0x62, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52,
+	0x0d, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x21,
+	0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x79, 0x0a, 0x16, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65,
+	0x74, 0x72, 0x79, 0x5f, 0x63, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x18, 0x64, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x43, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x61, 0x67, 0x67, 0x72,
+	0x65, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x2e, 0x4f, 0x70, 0x65,
+	0x6e, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x43, 0x61, 0x72, 0x72, 0x69, 0x65,
+	0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x14, 0x6f, 0x70, 0x65, 0x6e, 0x54, 0x65, 0x6c, 0x65,
+	0x6d, 0x65, 0x74, 0x72, 0x79, 0x43, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x1a, 0x39, 0x0a, 0x0b,
+	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
 	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
 	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd2, 0x01, 0x0a, 0x1c, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x53, 0x6e, 0x61, 0x70, 0x73,
-	0x68, 0x6f, 0x74, 0x54, 0x61, 0x6b, 0x65, 0x6e, 0x12, 0x66, 0x0a, 0x18, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x72, 0x65, 0x73,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x47, 0x0a, 0x19, 0x4f, 0x70, 0x65, 0x6e, 0x54,
+	0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x43, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0xa6, 0x03, 0x0a, 0x1b, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x54, 0x61, 0x6b, 0x65, 0x6e,
+	0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x63, 0x0a,
+	0x17, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b,
+	0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61,
+	0x74, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x52, 0x15, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x12, 0x5a, 0x0a, 0x0f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x2e,
+	0x70, 0x62, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x0e,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x4a,
+	0x0a, 0x0e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x0d, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x5d, 0x0a, 0x15, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x5f, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x62,
+	0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x52, 0x13, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x61, 0x62, 0x65,
+	0x6c, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x22, 0xf6, 0x01, 0x0a, 0x11, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x12,
+	0x47, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31,
+	0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61,
+	0x74, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x48, 0x65,
+	0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x2e, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61,
+	0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x4b, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x62,
+	0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65,
+	0x61, 0x74, 0x2e, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x07, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x64, 0x1a, 0x4b, 0x0a, 0x09, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65,
+	0x61, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49,
+	0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x55, 0x6e, 0x74,
+	0x69, 0x6c, 0x22, 0xcc, 0x03, 0x0a, 0x16, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x56, 0x0a,
+	0x12, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x5f, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62,
+	0x65, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x62,
+	0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65,
+	0x61, 0x74, 0x52, 0x11, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x48, 0x65, 0x61, 0x72,
+	0x74, 0x62, 0x65, 0x61, 0x74, 0x12, 0x4a, 0x0a, 0x0e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x52, 0x0d, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x47, 0x0a, 0x0d, 0x61, 0x75, 0x64, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x62, 0x2e,
+	0x41, 0x75, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x0c, 0x61, 0x75,
+	0x64, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x7c, 0x0a, 0x16, 0x6f, 0x70,
+	0x65, 0x6e, 0x5f, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x61, 0x72,
+	0x72, 0x69, 0x65, 0x72, 0x18, 0x64, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x46, 0x2e, 0x72, 0x65, 0x73,
 	0x6f, 0x75, 0x72, 0x63, 0x65, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x70,
 	0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x52, 0x16, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
-	0x12, 0x4a, 0x0a, 0x0e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x62, 0x2e,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x0d, 0x65,
-	0x76, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x3d, 0x5a, 0x3b,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x6c, 0x67, 0x64, 0x2d,
-	0x64, 0x65, 0x76, 0x2f, 0x68, 0x75, 0x62, 0x2f, 0x76, 0x32, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x2d, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x2f, 0x65, 0x76,
-	0x65, 0x6e, 0x74, 0x73, 0x3b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x61, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x54, 0x65, 0x6c,
+	0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x43, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x14, 0x6f, 0x70, 0x65, 0x6e, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72,
+	0x79, 0x43, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x1a, 0x47, 0x0a, 0x19, 0x4f, 0x70, 0x65, 0x6e,
+	0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x43, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0xd2, 0x01, 0x0a, 0x1c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x54, 0x61, 0x6b,
+	0x65, 0x6e, 0x12, 0x66, 0x0a, 0x18, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x61,
+	0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x52, 0x16, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x4a, 0x0a, 0x0e, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x61, 0x67, 0x67,
+	0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x0d, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x3d, 0x5a, 0x3b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x6c, 0x67, 0x64, 0x2d, 0x64, 0x65, 0x76, 0x2f, 0x68, 0x75,
+	0x62, 0x2f, 0x76, 0x32, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2d, 0x61, 0x67,
+	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x3b, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2529,7 +2667,7 @@ func file_resource_aggregate_pb_events_proto_rawDescGZIP() []byte {
 	return file_resource_aggregate_pb_events_proto_rawDescData
 }
 
-var file_resource_aggregate_pb_events_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
+var file_resource_aggregate_pb_events_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
 var file_resource_aggregate_pb_events_proto_goTypes = []any{
 	(*EventMetadata)(nil),                // 0: resourceaggregate.pb.EventMetadata
 	(*ResourceLinksPublished)(nil),       // 1: resourceaggregate.pb.ResourceLinksPublished
@@ -2547,126 +2685,134 @@ var file_resource_aggregate_pb_events_proto_goTypes = []any{
 	(*ResourceStateSnapshotTaken)(nil),   // 13: resourceaggregate.pb.ResourceStateSnapshotTaken
 	(*DeviceMetadataUpdated)(nil),        // 14: resourceaggregate.pb.DeviceMetadataUpdated
 	(*DeviceMetadataUpdatePending)(nil),  // 15: resourceaggregate.pb.DeviceMetadataUpdatePending
-	(*DeviceMetadataSnapshotTaken)(nil),  // 16: resourceaggregate.pb.DeviceMetadataSnapshotTaken
-	(*ServicesHeartbeat)(nil),            // 17: resourceaggregate.pb.ServicesHeartbeat
-	(*ServiceMetadataUpdated)(nil),       // 18: resourceaggregate.pb.ServiceMetadataUpdated
-	(*ServiceMetadataSnapshotTaken)(nil), // 19: resourceaggregate.pb.ServiceMetadataSnapshotTaken
-	nil,                                  // 20: resourceaggregate.pb.ResourceLinksPublished.OpenTelemetryCarrierEntry
-	nil,                                  // 21: resourceaggregate.pb.ResourceLinksUnpublished.OpenTelemetryCarrierEntry
-	nil,                                  // 22: resourceaggregate.pb.ResourceLinksSnapshotTaken.ResourcesEntry
-	nil,                                  // 23: resourceaggregate.pb.ResourceChanged.OpenTelemetryCarrierEntry
-	nil,                                  // 24: resourceaggregate.pb.ResourceUpdatePending.OpenTelemetryCarrierEntry
-	nil,                                  // 25: resourceaggregate.pb.ResourceUpdated.OpenTelemetryCarrierEntry
-	nil,                                  // 26: resourceaggregate.pb.ResourceRetrievePending.OpenTelemetryCarrierEntry
-	nil,                                  // 27: resourceaggregate.pb.ResourceRetrieved.OpenTelemetryCarrierEntry
-	nil,                                  // 28: resourceaggregate.pb.ResourceDeletePending.OpenTelemetryCarrierEntry
-	nil,                                  // 29: resourceaggregate.pb.ResourceDeleted.OpenTelemetryCarrierEntry
-	nil,                                  // 30: resourceaggregate.pb.ResourceCreatePending.OpenTelemetryCarrierEntry
-	nil,                                  // 31: resourceaggregate.pb.ResourceCreated.OpenTelemetryCarrierEntry
-	nil,                                  // 32: resourceaggregate.pb.DeviceMetadataUpdated.OpenTelemetryCarrierEntry
-	nil,                                  // 33: resourceaggregate.pb.DeviceMetadataUpdatePending.OpenTelemetryCarrierEntry
-	(*ServicesHeartbeat_Heartbeat)(nil),  // 34: resourceaggregate.pb.ServicesHeartbeat.Heartbeat
-	nil,                                  // 35: resourceaggregate.pb.ServiceMetadataUpdated.OpenTelemetryCarrierEntry
-	(*commands.Resource)(nil),            // 36: resourceaggregate.pb.Resource
-	(*commands.AuditContext)(nil),        // 37: resourceaggregate.pb.AuditContext
-	(*commands.ResourceId)(nil),          // 38: resourceaggregate.pb.ResourceId
-	(*commands.Content)(nil),             // 39: resourceaggregate.pb.Content
-	(commands.Status)(0),                 // 40: resourceaggregate.pb.Status
-	(*commands.Connection)(nil),          // 41: resourceaggregate.pb.Connection
-	(*commands.TwinSynchronization)(nil), // 42: resourceaggregate.pb.TwinSynchronization
+	(*DeviceLabelsUpdated)(nil),          // 16: resourceaggregate.pb.DeviceLabelsUpdated
+	(*DeviceMetadataSnapshotTaken)(nil),  // 17: resourceaggregate.pb.DeviceMetadataSnapshotTaken
+	(*ServicesHeartbeat)(nil),            // 18: resourceaggregate.pb.ServicesHeartbeat
+	(*ServiceMetadataUpdated)(nil),       // 19: resourceaggregate.pb.ServiceMetadataUpdated
+	(*ServiceMetadataSnapshotTaken)(nil), // 20: resourceaggregate.pb.ServiceMetadataSnapshotTaken
+	nil,                                  // 21: resourceaggregate.pb.ResourceLinksPublished.OpenTelemetryCarrierEntry
+	nil,                                  // 22: resourceaggregate.pb.ResourceLinksUnpublished.OpenTelemetryCarrierEntry
+	nil,                                  // 23: resourceaggregate.pb.ResourceLinksSnapshotTaken.ResourcesEntry
+	nil,                                  // 24: resourceaggregate.pb.ResourceChanged.OpenTelemetryCarrierEntry
+	nil,                                  // 25: resourceaggregate.pb.ResourceUpdatePending.OpenTelemetryCarrierEntry
+	nil,                                  // 26: resourceaggregate.pb.ResourceUpdated.OpenTelemetryCarrierEntry
+	nil,                                  // 27: resourceaggregate.pb.ResourceRetrievePending.OpenTelemetryCarrierEntry
+	nil,                                  // 28: resourceaggregate.pb.ResourceRetrieved.OpenTelemetryCarrierEntry
+	nil,                                  // 29: resourceaggregate.pb.ResourceDeletePending.OpenTelemetryCarrierEntry
+	nil,                                  // 30: resourceaggregate.pb.ResourceDeleted.OpenTelemetryCarrierEntry
+	nil,                                  // 31: resourceaggregate.pb.ResourceCreatePending.OpenTelemetryCarrierEntry
+	nil,                                  // 32: resourceaggregate.pb.ResourceCreated.OpenTelemetryCarrierEntry
+	nil,                                  // 33: resourceaggregate.pb.DeviceMetadataUpdated.OpenTelemetryCarrierEntry
+	nil,                                  // 34: resourceaggregate.pb.DeviceMetadataUpdatePending.OpenTelemetryCarrierEntry
+	nil,                                  // 35: resourceaggregate.pb.DeviceLabelsUpdated.LabelsEntry
+	nil,                                  // 36: resourceaggregate.pb.DeviceLabelsUpdated.OpenTelemetryCarrierEntry
+	(*ServicesHeartbeat_Heartbeat)(nil),  // 37: resourceaggregate.pb.ServicesHeartbeat.Heartbeat
+	nil,                                  // 38: resourceaggregate.pb.ServiceMetadataUpdated.OpenTelemetryCarrierEntry
+	(*commands.Resource)(nil),            // 39: resourceaggregate.pb.Resource
+	(*commands.AuditContext)(nil),        // 40: resourceaggregate.pb.AuditContext
+	(*commands.ResourceId)(nil),          // 41: resourceaggregate.pb.ResourceId
+	(*commands.Content)(nil),             // 42: resourceaggregate.pb.Content
+	(commands.Status)(0),                 // 43: resourceaggregate.pb.Status
+	(*commands.Connection)(nil),          // 44: resourceaggregate.pb.Connection
+	(*commands.TwinSynchronization)(nil), // 45: resourceaggregate.pb.TwinSynchronization
 }
 var file_resource_aggregate_pb_events_proto_depIdxs = []int32{
-	36, // 0: resourceaggregate.pb.ResourceLinksPublished.resources:type_name -> resourceaggregate.pb.Resource
-	37, // 1: resourceaggregate.pb.ResourceLinksPublished.audit_context:type_name -> resourceaggregate.pb.AuditContext
+	39, // 0: resourceaggregate.pb.ResourceLinksPublished.resources:type_name -> resourceaggregate.pb.Resource
+	40, // 1: resourceaggregate.pb.ResourceLinksPublished.audit_context:type_name -> resourceaggregate.pb.AuditContext
 	0,  // 2: resourceaggregate.pb.ResourceLinksPublished.event_metadata:type_name -> resourceaggregate.pb.EventMetadata
-	20, // 3: resourceaggregate.pb.ResourceLinksPublished.open_telemetry_carrier:type_name -> resourceaggregate.pb.ResourceLinksPublished.OpenTelemetryCarrierEntry
-	37, // 4: resourceaggregate.pb.ResourceLinksUnpublished.audit_context:type_name -> resourceaggregate.pb.AuditContext
+	21, // 3: resourceaggregate.pb.ResourceLinksPublished.open_telemetry_carrier:type_name -> resourceaggregate.pb.ResourceLinksPublished.OpenTelemetryCarrierEntry
+	40, // 4: resourceaggregate.pb.ResourceLinksUnpublished.audit_context:type_name -> resourceaggregate.pb.AuditContext
 	0,  // 5: resourceaggregate.pb.ResourceLinksUnpublished.event_metadata:type_name -> resourceaggregate.pb.EventMetadata
-	21, // 6: resourceaggregate.pb.ResourceLinksUnpublished.open_telemetry_carrier:type_name -> resourceaggregate.pb.ResourceLinksUnpublished.OpenTelemetryCarrierEntry
-	22, // 7: resourceaggregate.pb.ResourceLinksSnapshotTaken.resources:type_name -> resourceaggregate.pb.ResourceLinksSnapshotTaken.ResourcesEntry
+	22, // 6: resourceaggregate.pb.ResourceLinksUnpublished.open_telemetry_carrier:type_name -> resourceaggregate.pb.ResourceLinksUnpublished.OpenTelemetryCarrierEntry
+	23, // 7: resourceaggregate.pb.ResourceLinksSnapshotTaken.resources:type_name -> resourceaggregate.pb.ResourceLinksSnapshotTaken.ResourcesEntry
 	0,  // 8: resourceaggregate.pb.ResourceLinksSnapshotTaken.event_metadata:type_name -> resourceaggregate.pb.EventMetadata
-	37, // 9: resourceaggregate.pb.ResourceLinksSnapshotTaken.audit_context:type_name -> resourceaggregate.pb.AuditContext
-	38, // 10: resourceaggregate.pb.ResourceChanged.resource_id:type_name -> resourceaggregate.pb.ResourceId
-	39, // 11: resourceaggregate.pb.ResourceChanged.content:type_name -> resourceaggregate.pb.Content
-	40, // 12: resourceaggregate.pb.ResourceChanged.status:type_name -> resourceaggregate.pb.Status
-	37, // 13: resourceaggregate.pb.ResourceChanged.audit_context:type_name -> resourceaggregate.pb.AuditContext
+	40, // 9: resourceaggregate.pb.ResourceLinksSnapshotTaken.audit_context:type_name -> resourceaggregate.pb.AuditContext
+	41, // 10: resourceaggregate.pb.ResourceChanged.resource_id:type_name -> resourceaggregate.pb.ResourceId
+	42, // 11: resourceaggregate.pb.ResourceChanged.content:type_name -> resourceaggregate.pb.Content
+	43, // 12: resourceaggregate.pb.ResourceChanged.status:type_name -> resourceaggregate.pb.Status
+	40, // 13: resourceaggregate.pb.ResourceChanged.audit_context:type_name -> resourceaggregate.pb.AuditContext
 	0,  // 14: resourceaggregate.pb.ResourceChanged.event_metadata:type_name -> resourceaggregate.pb.EventMetadata
-	23, // 15: resourceaggregate.pb.ResourceChanged.open_telemetry_carrier:type_name -> resourceaggregate.pb.ResourceChanged.OpenTelemetryCarrierEntry
-	38, // 16: resourceaggregate.pb.ResourceUpdatePending.resource_id:type_name -> resourceaggregate.pb.ResourceId
-	39, // 17: resourceaggregate.pb.ResourceUpdatePending.content:type_name -> resourceaggregate.pb.Content
-	37, // 18: resourceaggregate.pb.ResourceUpdatePending.audit_context:type_name -> resourceaggregate.pb.AuditContext
+	24, // 15: resourceaggregate.pb.ResourceChanged.open_telemetry_carrier:type_name -> resourceaggregate.pb.ResourceChanged.OpenTelemetryCarrierEntry
+	41, // 16: resourceaggregate.pb.ResourceUpdatePending.resource_id:type_name -> resourceaggregate.pb.ResourceId
+	42, // 17: resourceaggregate.pb.ResourceUpdatePending.content:type_name -> resourceaggregate.pb.Content
+	40, // 18: resourceaggregate.pb.ResourceUpdatePending.audit_context:type_name -> resourceaggregate.pb.AuditContext
 	0,  // 19: resourceaggregate.pb.ResourceUpdatePending.event_metadata:type_name -> resourceaggregate.pb.EventMetadata
-	24, // 20: resourceaggregate.pb.ResourceUpdatePending.open_telemetry_carrier:type_name -> resourceaggregate.pb.ResourceUpdatePending.OpenTelemetryCarrierEntry
-	38, // 21: resourceaggregate.pb.ResourceUpdated.resource_id:type_name -> resourceaggregate.pb.ResourceId
-	40, // 22: resourceaggregate.pb.ResourceUpdated.status:type_name -> resourceaggregate.pb.Status
-	39, // 23: resourceaggregate.pb.ResourceUpdated.content:type_name -> resourceaggregate.pb.Content
-	37, // 24: resourceaggregate.pb.ResourceUpdated.audit_context:type_name -> resourceaggregate.pb.AuditContext
+	25, // 20: resourceaggregate.pb.ResourceUpdatePending.open_telemetry_carrier:type_name -> resourceaggregate.pb.ResourceUpdatePending.OpenTelemetryCarrierEntry
+	41, // 21: resourceaggregate.pb.ResourceUpdated.resource_id:type_name -> resourceaggregate.pb.ResourceId
+	43, // 22: resourceaggregate.pb.ResourceUpdated.status:type_name -> resourceaggregate.pb.Status
+	42, // 23: resourceaggregate.pb.ResourceUpdated.content:type_name -> resourceaggregate.pb.Content
+	40, // 24: resourceaggregate.pb.ResourceUpdated.audit_context:type_name -> resourceaggregate.pb.AuditContext
 	0,  // 25: resourceaggregate.pb.ResourceUpdated.event_metadata:type_name -> resourceaggregate.pb.EventMetadata
-	25, // 26: resourceaggregate.pb.ResourceUpdated.open_telemetry_carrier:type_name -> resourceaggregate.pb.ResourceUpdated.OpenTelemetryCarrierEntry
-	38, // 27: resourceaggregate.pb.ResourceRetrievePending.resource_id:type_name -> resourceaggregate.pb.ResourceId
-	37, // 28: resourceaggregate.pb.ResourceRetrievePending.audit_context:type_name -> resourceaggregate.pb.AuditContext
+	26, // 26: resourceaggregate.pb.ResourceUpdated.open_telemetry_carrier:type_name -> resourceaggregate.pb.ResourceUpdated.OpenTelemetryCarrierEntry
+	41, // 27: resourceaggregate.pb.ResourceRetrievePending.resource_id:type_name -> resourceaggregate.pb.ResourceId
+	40, // 28: resourceaggregate.pb.ResourceRetrievePending.audit_context:type_name -> resourceaggregate.pb.AuditContext
 	0,  // 29: resourceaggregate.pb.ResourceRetrievePending.event_metadata:type_name -> resourceaggregate.pb.EventMetadata
-	26, // 30: resourceaggregate.pb.ResourceRetrievePending.open_telemetry_carrier:type_name -> resourceaggregate.pb.ResourceRetrievePending.OpenTelemetryCarrierEntry
-	38, // 31: resourceaggregate.pb.ResourceRetrieved.resource_id:type_name -> resourceaggregate.pb.ResourceId
-	40, // 32: resourceaggregate.pb.ResourceRetrieved.status:type_name -> resourceaggregate.pb.Status
-	39, // 33: resourceaggregate.pb.ResourceRetrieved.content:type_name -> resourceaggregate.pb.Content
-	37, // 34: resourceaggregate.pb.ResourceRetrieved.audit_context:type_name -> resourceaggregate.pb.AuditContext
+	27, // 30: resourceaggregate.pb.ResourceRetrievePending.open_telemetry_carrier:type_name -> resourceaggregate.pb.ResourceRetrievePending.OpenTelemetryCarrierEntry
+	41, // 31: resourceaggregate.pb.ResourceRetrieved.resource_id:type_name -> resourceaggregate.pb.ResourceId
+	43, // 32: resourceaggregate.pb.ResourceRetrieved.status:type_name -> resourceaggregate.pb.Status
+	42, // 33: resourceaggregate.pb.ResourceRetrieved.content:type_name -> resourceaggregate.pb.Content
+	40, // 34: resourceaggregate.pb.ResourceRetrieved.audit_context:type_name -> resourceaggregate.pb.AuditContext
 	0,  // 35: resourceaggregate.pb.ResourceRetrieved.event_metadata:type_name -> resourceaggregate.pb.EventMetadata
-	27, // 36: resourceaggregate.pb.ResourceRetrieved.open_telemetry_carrier:type_name -> resourceaggregate.pb.ResourceRetrieved.OpenTelemetryCarrierEntry
-	38, // 37: resourceaggregate.pb.ResourceDeletePending.resource_id:type_name -> resourceaggregate.pb.ResourceId
-	37, // 38: resourceaggregate.pb.ResourceDeletePending.audit_context:type_name -> resourceaggregate.pb.AuditContext
+	28, // 36: resourceaggregate.pb.ResourceRetrieved.open_telemetry_carrier:type_name -> resourceaggregate.pb.ResourceRetrieved.OpenTelemetryCarrierEntry
+	41, // 37: resourceaggregate.pb.ResourceDeletePending.resource_id:type_name -> resourceaggregate.pb.ResourceId
+	40, // 38: resourceaggregate.pb.ResourceDeletePending.audit_context:type_name -> resourceaggregate.pb.AuditContext
 	0,  // 39: resourceaggregate.pb.ResourceDeletePending.event_metadata:type_name -> resourceaggregate.pb.EventMetadata
-	28, // 40: resourceaggregate.pb.ResourceDeletePending.open_telemetry_carrier:type_name -> resourceaggregate.pb.ResourceDeletePending.OpenTelemetryCarrierEntry
-	38, // 41: resourceaggregate.pb.ResourceDeleted.resource_id:type_name -> resourceaggregate.pb.ResourceId
-	40, // 42: resourceaggregate.pb.ResourceDeleted.status:type_name -> resourceaggregate.pb.Status
-	39, // 43: resourceaggregate.pb.ResourceDeleted.content:type_name -> resourceaggregate.pb.Content
-	37, // 44: resourceaggregate.pb.ResourceDeleted.audit_context:type_name -> resourceaggregate.pb.AuditContext
+	29, // 40: resourceaggregate.pb.ResourceDeletePending.open_telemetry_carrier:type_name -> resourceaggregate.pb.ResourceDeletePending.OpenTelemetryCarrierEntry
+	41, // 41: resourceaggregate.pb.ResourceDeleted.resource_id:type_name -> resourceaggregate.pb.ResourceId
+	43, // 42: resourceaggregate.pb.ResourceDeleted.status:type_name -> resourceaggregate.pb.Status
+	42, // 43: resourceaggregate.pb.ResourceDeleted.content:type_name -> resourceaggregate.pb.Content
+	40, // 44: resourceaggregate.pb.ResourceDeleted.audit_context:type_name -> resourceaggregate.pb.AuditContext
 	0,  // 45: resourceaggregate.pb.ResourceDeleted.event_metadata:type_name -> resourceaggregate.pb.EventMetadata
-	29, // 46: resourceaggregate.pb.ResourceDeleted.open_telemetry_carrier:type_name -> resourceaggregate.pb.ResourceDeleted.OpenTelemetryCarrierEntry
-	38, // 47: resourceaggregate.pb.ResourceCreatePending.resource_id:type_name -> resourceaggregate.pb.ResourceId
-	39, // 48: resourceaggregate.pb.ResourceCreatePending.content:type_name -> resourceaggregate.pb.Content
-	37, // 49: resourceaggregate.pb.ResourceCreatePending.audit_context:type_name -> resourceaggregate.pb.AuditContext
+	30, // 46: resourceaggregate.pb.ResourceDeleted.open_telemetry_carrier:type_name -> resourceaggregate.pb.ResourceDeleted.OpenTelemetryCarrierEntry
+	41, // 47: resourceaggregate.pb.ResourceCreatePending.resource_id:type_name -> resourceaggregate.pb.ResourceId
+	42, // 48: resourceaggregate.pb.ResourceCreatePending.content:type_name -> resourceaggregate.pb.Content
+	40, // 49: resourceaggregate.pb.ResourceCreatePending.audit_context:type_name -> resourceaggregate.pb.AuditContext
 	0,  // 50: resourceaggregate.pb.ResourceCreatePending.event_metadata:type_name -> resourceaggregate.pb.EventMetadata
-	30, // 51: resourceaggregate.pb.ResourceCreatePending.open_telemetry_carrier:type_name -> resourceaggregate.pb.ResourceCreatePending.OpenTelemetryCarrierEntry
-	38, // 52: resourceaggregate.pb.ResourceCreated.resource_id:type_name -> resourceaggregate.pb.ResourceId
-	40, // 53: resourceaggregate.pb.ResourceCreated.status:type_name -> resourceaggregate.pb.Status
-	39, // 54: resourceaggregate.pb.ResourceCreated.content:type_name -> resourceaggregate.pb.Content
-	37, // 55: resourceaggregate.pb.ResourceCreated.audit_context:type_name -> resourceaggregate.pb.AuditContext
+	31, // 51: resourceaggregate.pb.ResourceCreatePending.open_telemetry_carrier:type_name -> resourceaggregate.pb.ResourceCreatePending.OpenTelemetryCarrierEntry
+	41, // 52: resourceaggregate.pb.ResourceCreated.resource_id:type_name -> resourceaggregate.pb.ResourceId
+	43, // 53: resourceaggregate.pb.ResourceCreated.status:type_name -> resourceaggregate.pb.Status
+	42, // 54: resourceaggregate.pb.ResourceCreated.content:type_name -> resourceaggregate.pb.Content
+	40, // 55: resourceaggregate.pb.ResourceCreated.audit_context:type_name -> resourceaggregate.pb.AuditContext
 	0,  // 56: resourceaggregate.pb.ResourceCreated.event_metadata:type_name -> resourceaggregate.pb.EventMetadata
-	31, // 57: resourceaggregate.pb.ResourceCreated.open_telemetry_carrier:type_name -> resourceaggregate.pb.ResourceCreated.OpenTelemetryCarrierEntry
-	38, // 58: resourceaggregate.pb.ResourceStateSnapshotTaken.resource_id:type_name -> resourceaggregate.pb.ResourceId
+	32, // 57: resourceaggregate.pb.ResourceCreated.open_telemetry_carrier:type_name -> resourceaggregate.pb.ResourceCreated.OpenTelemetryCarrierEntry
+	41, // 58: resourceaggregate.pb.ResourceStateSnapshotTaken.resource_id:type_name -> resourceaggregate.pb.ResourceId
 	4,  // 59: resourceaggregate.pb.ResourceStateSnapshotTaken.latest_resource_change:type_name -> resourceaggregate.pb.ResourceChanged
 	11, // 60: resourceaggregate.pb.ResourceStateSnapshotTaken.resource_create_pendings:type_name -> resourceaggregate.pb.ResourceCreatePending
 	7,  // 61: resourceaggregate.pb.ResourceStateSnapshotTaken.resource_retrieve_pendings:type_name -> resourceaggregate.pb.ResourceRetrievePending
 	5,  // 62: resourceaggregate.pb.ResourceStateSnapshotTaken.resource_update_pendings:type_name -> resourceaggregate.pb.ResourceUpdatePending
 	9,  // 63: resourceaggregate.pb.ResourceStateSnapshotTaken.resource_delete_pendings:type_name -> resourceaggregate.pb.ResourceDeletePending
-	37, // 64: resourceaggregate.pb.ResourceStateSnapshotTaken.audit_context:type_name -> resourceaggregate.pb.AuditContext
+	40, // 64: resourceaggregate.pb.ResourceStateSnapshotTaken.audit_context:type_name -> resourceaggregate.pb.AuditContext
 	0,  // 65: resourceaggregate.pb.ResourceStateSnapshotTaken.event_metadata:type_name -> resourceaggregate.pb.EventMetadata
-	41, // 66: resourceaggregate.pb.DeviceMetadataUpdated.connection:type_name -> resourceaggregate.pb.Connection
-	42, // 67: resourceaggregate.pb.DeviceMetadataUpdated.twin_synchronization:type_name -> resourceaggregate.pb.TwinSynchronization
-	37, // 68: resourceaggregate.pb.DeviceMetadataUpdated.audit_context:type_name -> resourceaggregate.pb.AuditContext
+	44, // 66: resourceaggregate.pb.DeviceMetadataUpdated.connection:type_name -> resourceaggregate.pb.Connection
+	45, // 67: resourceaggregate.pb.DeviceMetadataUpdated.twin_synchronization:type_name -> resourceaggregate.pb.TwinSynchronization
+	40, // 68: resourceaggregate.pb.DeviceMetadataUpdated.audit_context:type_name -> resourceaggregate.pb.AuditContext
 	0,  // 69: resourceaggregate.pb.DeviceMetadataUpdated.event_metadata:type_name -> resourceaggregate.pb.EventMetadata
-	32, // 70: resourceaggregate.pb.DeviceMetadataUpdated.open_telemetry_carrier:type_name -> resourceaggregate.pb.DeviceMetadataUpdated.OpenTelemetryCarrierEntry
-	37, // 71: resourceaggregate.pb.DeviceMetadataUpdatePending.audit_context:type_name -> resourceaggregate.pb.AuditContext
+	33, // 70: resourceaggregate.pb.DeviceMetadataUpdated.open_telemetry_carrier:type_name -> resourceaggregate.pb.DeviceMetadataUpdated.OpenTelemetryCarrierEntry
+	40, // 71: resourceaggregate.pb.DeviceMetadataUpdatePending.audit_context:type_name -> resourceaggregate.pb.AuditContext
 	0,  // 72: resourceaggregate.pb.DeviceMetadataUpdatePending.event_metadata:type_name -> resourceaggregate.pb.EventMetadata
-	33, // 73: resourceaggregate.pb.DeviceMetadataUpdatePending.open_telemetry_carrier:type_name -> resourceaggregate.pb.DeviceMetadataUpdatePending.OpenTelemetryCarrierEntry
-	14, // 74: resourceaggregate.pb.DeviceMetadataSnapshotTaken.device_metadata_updated:type_name -> resourceaggregate.pb.DeviceMetadataUpdated
-	15, // 75: resourceaggregate.pb.DeviceMetadataSnapshotTaken.update_pendings:type_name -> resourceaggregate.pb.DeviceMetadataUpdatePending
-	0,  // 76: resourceaggregate.pb.DeviceMetadataSnapshotTaken.event_metadata:type_name -> resourceaggregate.pb.EventMetadata
-	34, // 77: resourceaggregate.pb.ServicesHeartbeat.valid:type_name -> resourceaggregate.pb.ServicesHeartbeat.Heartbeat
-	34, // 78: resourceaggregate.pb.ServicesHeartbeat.expired:type_name -> resourceaggregate.pb.ServicesHeartbeat.Heartbeat
-	17, // 79: resourceaggregate.pb.ServiceMetadataUpdated.services_heartbeat:type_name -> resourceaggregate.pb.ServicesHeartbeat
-	0,  // 80: resourceaggregate.pb.ServiceMetadataUpdated.event_metadata:type_name -> resourceaggregate.pb.EventMetadata
-	37, // 81: resourceaggregate.pb.ServiceMetadataUpdated.audit_context:type_name -> resourceaggregate.pb.AuditContext
-	35, // 82: resourceaggregate.pb.ServiceMetadataUpdated.open_telemetry_carrier:type_name -> resourceaggregate.pb.ServiceMetadataUpdated.OpenTelemetryCarrierEntry
-	18, // 83: resourceaggregate.pb.ServiceMetadataSnapshotTaken.service_metadata_updated:type_name -> resourceaggregate.pb.ServiceMetadataUpdated
-	0,  // 84: resourceaggregate.pb.ServiceMetadataSnapshotTaken.event_metadata:type_name -> resourceaggregate.pb.EventMetadata
-	36, // 85: resourceaggregate.pb.ResourceLinksSnapshotTaken.ResourcesEntry.value:type_name -> resourceaggregate.pb.Resource
-	86, // [86:86] is the sub-list for method output_type
-	86, // [86:86] is the sub-list for method input_type
-	86, // [86:86] is the sub-list for extension type_name
-	86, // [86:86] is the sub-list for extension extendee
-	0,  // [0:86] is the sub-list for field type_name
+	34, // 73: resourceaggregate.pb.DeviceMetadataUpdatePending.open_telemetry_carrier:type_name -> resourceaggregate.pb.DeviceMetadataUpdatePending.OpenTelemetryCarrierEntry
+	35, // 74: resourceaggregate.pb.DeviceLabelsUpdated.labels:type_name -> resourceaggregate.pb.DeviceLabelsUpdated.LabelsEntry
+	40, // 75: resourceaggregate.pb.DeviceLabelsUpdated.audit_context:type_name -> resourceaggregate.pb.AuditContext
+	0,  // 76: resourceaggregate.pb.DeviceLabelsUpdated.event_metadata:type_name -> resourceaggregate.pb.EventMetadata
+	36, // 77: resourceaggregate.pb.DeviceLabelsUpdated.open_telemetry_carrier:type_name -> resourceaggregate.pb.DeviceLabelsUpdated.OpenTelemetryCarrierEntry
+	14, // 78: resourceaggregate.pb.DeviceMetadataSnapshotTaken.device_metadata_updated:type_name -> resourceaggregate.pb.DeviceMetadataUpdated
+	15, // 79: resourceaggregate.pb.DeviceMetadataSnapshotTaken.update_pendings:type_name -> resourceaggregate.pb.DeviceMetadataUpdatePending
+	0,  // 80: resourceaggregate.pb.DeviceMetadataSnapshotTaken.event_metadata:type_name -> resourceaggregate.pb.EventMetadata
+	16, // 81: resourceaggregate.pb.DeviceMetadataSnapshotTaken.device_labels_updated:type_name -> resourceaggregate.pb.DeviceLabelsUpdated
+	37, // 82: resourceaggregate.pb.ServicesHeartbeat.valid:type_name -> resourceaggregate.pb.ServicesHeartbeat.Heartbeat
+	37, // 83: resourceaggregate.pb.ServicesHeartbeat.expired:type_name -> resourceaggregate.pb.ServicesHeartbeat.Heartbeat
+	18, // 84: resourceaggregate.pb.ServiceMetadataUpdated.services_heartbeat:type_name -> resourceaggregate.pb.ServicesHeartbeat
+	0,  // 85: resourceaggregate.pb.ServiceMetadataUpdated.event_metadata:type_name -> resourceaggregate.pb.EventMetadata
+	40, // 86: resourceaggregate.pb.ServiceMetadataUpdated.audit_context:type_name -> resourceaggregate.pb.AuditContext
+	38, // 87: resourceaggregate.pb.ServiceMetadataUpdated.open_telemetry_carrier:type_name -> resourceaggregate.pb.ServiceMetadataUpdated.OpenTelemetryCarrierEntry
+	19, // 88: resourceaggregate.pb.ServiceMetadataSnapshotTaken.service_metadata_updated:type_name -> resourceaggregate.pb.ServiceMetadataUpdated
+	0,  // 89: resourceaggregate.pb.ServiceMetadataSnapshotTaken.event_metadata:type_name -> resourceaggregate.pb.EventMetadata
+	39, // 90: resourceaggregate.pb.ResourceLinksSnapshotTaken.ResourcesEntry.value:type_name -> resourceaggregate.pb.Resource
+	91, // [91:91] is the sub-list for method output_type
+	91, // [91:91] is the sub-list for method input_type
+	91, // [91:91] is the sub-list for extension type_name
+	91, // [91:91] is the sub-list for extension extendee
+	0,  // [0:91] is the sub-list for field type_name
 }
 
 func init() { file_resource_aggregate_pb_events_proto_init() }
@@ -2868,7 +3014,7 @@ func file_resource_aggregate_pb_events_proto_init() {
 			}
 		}
 		file_resource_aggregate_pb_events_proto_msgTypes[16].Exporter = func(v any, i int) any {
-			switch v := v.(*DeviceMetadataSnapshotTaken); i {
+			switch v := v.(*DeviceLabelsUpdated); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2880,7 +3026,7 @@ func file_resource_aggregate_pb_events_proto_init() {
 			}
 		}
 		file_resource_aggregate_pb_events_proto_msgTypes[17].Exporter = func(v any, i int) any {
-			switch v := v.(*ServicesHeartbeat); i {
+			switch v := v.(*DeviceMetadataSnapshotTaken); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2892,7 +3038,7 @@ func file_resource_aggregate_pb_events_proto_init() {
 			}
 		}
 		file_resource_aggregate_pb_events_proto_msgTypes[18].Exporter = func(v any, i int) any {
-			switch v := v.(*ServiceMetadataUpdated); i {
+			switch v := v.(*ServicesHeartbeat); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2904,6 +3050,18 @@ func file_resource_aggregate_pb_events_proto_init() {
 			}
 		}
 		file_resource_aggregate_pb_events_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*ServiceMetadataUpdated); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_resource_aggregate_pb_events_proto_msgTypes[20].Exporter = func(v any, i int) any {
 			switch v := v.(*ServiceMetadataSnapshotTaken); i {
 			case 0:
 				return &v.state
@@ -2915,7 +3073,7 @@ func file_resource_aggregate_pb_events_proto_init() {
 				return nil
 			}
 		}
-		file_resource_aggregate_pb_events_proto_msgTypes[34].Exporter = func(v any, i int) any {
+		file_resource_aggregate_pb_events_proto_msgTypes[37].Exporter = func(v any, i int) any {
 			switch v := v.(*ServicesHeartbeat_Heartbeat); i {
 			case 0:
 				return &v.state
@@ -2938,7 +3096,7 @@ func file_resource_aggregate_pb_events_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_resource_aggregate_pb_events_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   36,
+			NumMessages:   39,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,54 @@
+package events
+
+import (
+	"github.com/plgd-dev/hub/v2/resource-aggregate/commands"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore"
+)
+
+// Event is the event of the hub stored in the eventstore.
+type Event interface {
+	eventstore.Event
+	GetEventMetadata() *EventMetadata
+}
+
+var eventFactories = map[string]func() Event{
+	(&ResourceLinksPublished{}).EventType():       func() Event { return &ResourceLinksPublished{} },
+	(&ResourceLinksUnpublished{}).EventType():     func() Event { return &ResourceLinksUnpublished{} },
+	(&ResourceLinksSnapshotTaken{}).EventType():   func() Event { return &ResourceLinksSnapshotTaken{} },
+	(&ResourceChanged{}).EventType():              func() Event { return &ResourceChanged{} },
+	(&ResourceUpdatePending{}).EventType():        func() Event { return &ResourceUpdatePending{} },
+	(&ResourceUpdated{}).EventType():              func() Event { return &ResourceUpdated{} },
+	(&ResourceRetrievePending{}).EventType():      func() Event { return &ResourceRetrievePending{} },
+	(&ResourceRetrieved{}).EventType():            func() Event { return &ResourceRetrieved{} },
+	(&ResourceDeletePending{}).EventType():        func() Event { return &ResourceDeletePending{} },
+	(&ResourceDeleted{}).EventType():              func() Event { return &ResourceDeleted{} },
+	(&ResourceCreatePending{}).EventType():        func() Event { return &ResourceCreatePending{} },
+	(&ResourceCreated{}).EventType():              func() Event { return &ResourceCreated{} },
+	(&ResourceStateSnapshotTaken{}).EventType():   func() Event { return &ResourceStateSnapshotTaken{} },
+	(&DeviceMetadataUpdatePending{}).EventType():  func() Event { return &DeviceMetadataUpdatePending{} },
+	(&DeviceMetadataUpdated{}).EventType():        func() Event { return &DeviceMetadataUpdated{} },
+	(&DeviceMetadataSnapshotTaken{}).EventType():  func() Event { return &DeviceMetadataSnapshotTaken{} },
+	(&DeviceLabelsUpdated{}).EventType():          func() Event { return &DeviceLabelsUpdated{} },
+	(&ServiceMetadataUpdated{}).EventType():       func() Event { return &ServiceMetadataUpdated{} },
+	(&ServiceMetadataSnapshotTaken{}).EventType(): func() Event { return &ServiceMetadataSnapshotTaken{} },
+}
+
+// NewEvent creates the empty event of the event type. It returns false for the unknown event type.
+func NewEvent(eventType string) (Event, bool) {
+	newEvent, ok := eventFactories[eventType]
+	if !ok {
+		return nil, false
+	}
+	return newEvent(), true
+}
+
+// GetOwner returns owner of the device from the audit context of the event.
+func GetOwner(ev Event) string {
+	if s, ok := ev.(*DeviceMetadataSnapshotTaken); ok {
+		return s.GetDeviceMetadataUpdated().GetAuditContext().GetOwner()
+	}
+	if e, ok := ev.(interface{ GetAuditContext() *commands.AuditContext }); ok {
+		return e.GetAuditContext().GetOwner()
+	}
+	return ""
+}
@@ -0,0 +1,32 @@
+package events_test
+
+import (
+	"testing"
+
+	"github.com/plgd-dev/hub/v2/resource-aggregate/commands"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/events"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEvent(t *testing.T) {
+	for _, eventType := range []string{
+		(&events.ResourceChanged{}).EventType(),
+		(&events.DeviceMetadataSnapshotTaken{}).EventType(),
+		(&events.ServiceMetadataSnapshotTaken{}).EventType(),
+	} {
+		ev, ok := events.NewEvent(eventType)
+		require.True(t, ok)
+		require.Equal(t, eventType, ev.EventType())
+	}
+	_, ok := events.NewEvent("unknown")
+	require.False(t, ok)
+}
+
+func TestGetOwner(t *testing.T) {
+	auditContext := commands.NewAuditContext("owner", "", "owner")
+	require.Equal(t, "owner", events.GetOwner(&events.ResourceChanged{AuditContext: auditContext}))
+	require.Equal(t, "owner", events.GetOwner(&events.DeviceMetadataSnapshotTaken{
+		DeviceMetadataUpdated: &events.DeviceMetadataUpdated{AuditContext: auditContext},
+	}))
+	require.Empty(t, events.GetOwner(&events.ServiceMetadataSnapshotTaken{}))
+}
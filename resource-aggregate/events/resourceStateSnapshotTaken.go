@@ -7,6 +7,7 @@ import (
 
 	"github.com/plgd-dev/go-coap/v3/message"
 	"github.com/plgd-dev/hub/v2/pkg/opentelemetry/propagation"
+	"github.com/plgd-dev/hub/v2/pkg/senml"
 	pkgTime "github.com/plgd-dev/hub/v2/pkg/time"
 	"github.com/plgd-dev/hub/v2/resource-aggregate/commands"
 	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/aggregate"
@@ -439,6 +440,12 @@ func convertContent(content *commands.Content, supportedContentType string) (new
 	case message.AppJSON.String():
 		encode = json.Encode
 		coapContentFormat = int32(message.AppJSON)
+	case message.AppSenmlJSON.String():
+		encode = senml.EncodeJSON
+		coapContentFormat = int32(message.AppSenmlJSON)
+	case message.AppSenmlCbor.String():
+		encode = senml.EncodeCBOR
+		coapContentFormat = int32(message.AppSenmlCbor)
 	}
 
 	if encode == nil {
@@ -451,6 +458,10 @@ func convertContent(content *commands.Content, supportedContentType string) (new
 		decode = cbor.Decode
 	case message.AppJSON.String():
 		decode = json.Decode
+	case message.AppSenmlJSON.String():
+		decode = senml.DecodeJSON
+	case message.AppSenmlCbor.String():
+		decode = senml.DecodeCBOR
 	default:
 		return nil, status.Errorf(codes.InvalidArgument, "cannot convert content-type from %v: unsupported source", contentType)
 	}
@@ -3,8 +3,11 @@ package events
 import (
 	"testing"
 
+	"github.com/plgd-dev/go-coap/v3/message"
 	"github.com/plgd-dev/hub/v2/resource-aggregate/commands"
+	"github.com/plgd-dev/kit/v2/codec/cbor"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestResourceStateSnapshotTakenForCommand_ValidateCancelPendingCommandsForNotExistingResource(t *testing.T) {
@@ -47,3 +50,35 @@ func TestResourceStateSnapshotTakenForCommand_ValidateCancelPendingCommandsForNo
 		CorrelationIdFilter: []string{"5", "6"},
 	}))
 }
+
+func TestConvertContentSenML(t *testing.T) {
+	senmlJSON := &commands.Content{
+		ContentType:       message.AppSenmlJSON.String(),
+		CoapContentFormat: -1,
+		Data:              []byte(`[{"bn":"light/","n":"power","v":1},{"n":"state","vb":true}]`),
+	}
+
+	// SenML update of the device which supports OCF-CBOR
+	content, err := convertContent(senmlJSON, message.AppOcfCbor.String())
+	require.NoError(t, err)
+	assert.Equal(t, message.AppOcfCbor.String(), content.GetContentType())
+	assert.Equal(t, int32(message.AppOcfCbor), content.GetCoapContentFormat())
+	var v map[string]map[string]interface{}
+	err = cbor.Decode(content.GetData(), &v)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]map[string]interface{}{
+		"light": {"power": uint64(1), "state": true},
+	}, v)
+
+	// OCF-CBOR update of the device which supports SenML
+	content, err = convertContent(content, message.AppSenmlJSON.String())
+	require.NoError(t, err)
+	assert.Equal(t, message.AppSenmlJSON.String(), content.GetContentType())
+	assert.JSONEq(t, `[{"n":"light/power","v":1},{"n":"light/state","vb":true}]`, string(content.GetData()))
+
+	_, err = convertContent(&commands.Content{
+		ContentType: message.AppSenmlCbor.String(),
+		Data:        []byte("invalid"),
+	}, message.AppJSON.String())
+	require.Error(t, err)
+}
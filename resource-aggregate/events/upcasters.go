@@ -0,0 +1,121 @@
+package events
+
+import (
+	"fmt"
+
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore/upcast"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Removed fields of the stored events.
+const (
+	// ShadowSynchronization shadow_synchronization = 3 of DeviceMetadataUpdated
+	deviceMetadataUpdatedShadowSynchronizationField protowire.Number = 3
+	// ShadowSynchronization shadow_synchronization = 2 of DeviceMetadataUpdatePending
+	deviceMetadataUpdatePendingShadowSynchronizationField protowire.Number = 2
+	// ShadowSynchronization_DISABLED of the removed ShadowSynchronization enum
+	shadowSynchronizationDisabled = 2
+)
+
+// popUnknownVarintField removes the varint field from unknown fields of the message and returns its last value.
+func popUnknownVarintField(m protoreflect.Message, num protowire.Number) (uint64, bool, error) {
+	unknown := m.GetUnknown()
+	if len(unknown) == 0 {
+		return 0, false, nil
+	}
+	rest := make(protoreflect.RawFields, 0, len(unknown))
+	var value uint64
+	var found bool
+	for b := []byte(unknown); len(b) > 0; {
+		n, typ, tagLen := protowire.ConsumeTag(b)
+		if tagLen < 0 {
+			return 0, false, fmt.Errorf("cannot parse unknown fields: %w", protowire.ParseError(tagLen))
+		}
+		fieldLen := protowire.ConsumeFieldValue(n, typ, b[tagLen:])
+		if fieldLen < 0 {
+			return 0, false, fmt.Errorf("cannot parse unknown field %v: %w", n, protowire.ParseError(fieldLen))
+		}
+		if n == num && typ == protowire.VarintType {
+			v, l := protowire.ConsumeVarint(b[tagLen:])
+			if l < 0 {
+				return 0, false, fmt.Errorf("cannot parse unknown field %v: %w", n, protowire.ParseError(l))
+			}
+			value = v
+			found = true
+		} else {
+			rest = append(rest, b[:tagLen+fieldLen]...)
+		}
+		b = b[tagLen+fieldLen:]
+	}
+	if !found {
+		return 0, false, nil
+	}
+	m.SetUnknown(rest)
+	return value, true, nil
+}
+
+// upcastDeviceMetadataUpdated replaces the removed shadow_synchronization by twin_enabled.
+func upcastDeviceMetadataUpdated(d *DeviceMetadataUpdated) (bool, error) {
+	if d == nil {
+		return false, nil
+	}
+	v, ok, err := popUnknownVarintField(d.ProtoReflect(), deviceMetadataUpdatedShadowSynchronizationField)
+	if err != nil || !ok {
+		return false, err
+	}
+	d.TwinEnabled = v != shadowSynchronizationDisabled
+	return true, nil
+}
+
+// upcastDeviceMetadataUpdatePending replaces the removed shadow_synchronization by twin_enabled.
+func upcastDeviceMetadataUpdatePending(d *DeviceMetadataUpdatePending) (bool, error) {
+	if d == nil {
+		return false, nil
+	}
+	v, ok, err := popUnknownVarintField(d.ProtoReflect(), deviceMetadataUpdatePendingShadowSynchronizationField)
+	if err != nil || !ok {
+		return false, err
+	}
+	if d.GetUpdatePending() == nil {
+		d.UpdatePending = &DeviceMetadataUpdatePending_TwinEnabled{
+			TwinEnabled: v != shadowSynchronizationDisabled,
+		}
+	}
+	return true, nil
+}
+
+func upcastDeviceMetadataSnapshotTaken(d *DeviceMetadataSnapshotTaken) (bool, error) {
+	changed, err := upcastDeviceMetadataUpdated(d.GetDeviceMetadataUpdated())
+	if err != nil {
+		return false, err
+	}
+	for _, p := range d.GetUpdatePendings() {
+		c, err := upcastDeviceMetadataUpdatePending(p)
+		if err != nil {
+			return false, err
+		}
+		changed = changed || c
+	}
+	return changed, nil
+}
+
+// Upcasters returns upcasters of the events stored by the previous versions of the hub.
+func Upcasters() []upcast.Upcaster {
+	return []upcast.Upcaster{
+		upcast.NewUpcaster(eventTypeDeviceMetadataUpdated, 1, upcastDeviceMetadataUpdated),
+		upcast.NewUpcaster(eventTypeDeviceMetadataUpdatePending, 1, upcastDeviceMetadataUpdatePending),
+		upcast.NewUpcaster(eventTypeDeviceMetadataSnapshotTaken, 1, upcastDeviceMetadataSnapshotTaken),
+	}
+}
+
+// NewUpcastRegistry creates registry with upcasters of the events.
+func NewUpcastRegistry() (*upcast.Registry, error) {
+	r := upcast.NewRegistry()
+	for _, u := range Upcasters() {
+		if err := r.Register(u); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
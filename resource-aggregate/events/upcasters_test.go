@@ -0,0 +1,99 @@
+package events_test
+
+import (
+	"testing"
+
+	"github.com/golang/snappy"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/commands"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/utils"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/events"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	shadowSynchronizationEnabled  = 1
+	shadowSynchronizationDisabled = 2
+)
+
+// marshalWithShadowSynchronization encodes the message as it was stored by the previous versions of the hub.
+func marshalWithShadowSynchronization(t *testing.T, m proto.Message, field protowire.Number, value uint64) []byte {
+	data, err := proto.Marshal(m)
+	require.NoError(t, err)
+	data = protowire.AppendTag(data, field, protowire.VarintType)
+	data = protowire.AppendVarint(data, value)
+	return snappy.Encode(nil, data)
+}
+
+func TestUpcastDeviceMetadataUpdated(t *testing.T) {
+	registry, err := events.NewUpcastRegistry()
+	require.NoError(t, err)
+	unmarshal := registry.Unmarshaler(utils.Unmarshal)
+
+	ev := &events.DeviceMetadataUpdated{
+		DeviceId: dev1,
+		Connection: &commands.Connection{
+			Status: commands.Connection_ONLINE,
+		},
+	}
+	var got events.DeviceMetadataUpdated
+	err = unmarshal(marshalWithShadowSynchronization(t, ev, 3, shadowSynchronizationEnabled), &got)
+	require.NoError(t, err)
+	require.True(t, got.GetTwinEnabled())
+	require.Empty(t, got.ProtoReflect().GetUnknown())
+
+	got = events.DeviceMetadataUpdated{}
+	err = unmarshal(marshalWithShadowSynchronization(t, ev, 3, shadowSynchronizationDisabled), &got)
+	require.NoError(t, err)
+	require.False(t, got.GetTwinEnabled())
+
+	// current shape is not changed
+	ev.TwinEnabled = true
+	data, err := utils.Marshal(ev)
+	require.NoError(t, err)
+	_, changed, err := registry.Rewrite(ev.EventType(), data, utils.Unmarshal, utils.Marshal)
+	require.NoError(t, err)
+	require.False(t, changed)
+}
+
+func TestUpcastDeviceMetadataSnapshotTaken(t *testing.T) {
+	registry, err := events.NewUpcastRegistry()
+	require.NoError(t, err)
+
+	updated := &events.DeviceMetadataUpdated{
+		DeviceId: dev1,
+	}
+	updatedData, err := snappy.Decode(nil, marshalWithShadowSynchronization(t, updated, 3, shadowSynchronizationEnabled))
+	require.NoError(t, err)
+	pending := &events.DeviceMetadataUpdatePending{
+		DeviceId: dev1,
+	}
+	pendingData, err := snappy.Decode(nil, marshalWithShadowSynchronization(t, pending, 2, shadowSynchronizationDisabled))
+	require.NoError(t, err)
+
+	var data []byte
+	data = protowire.AppendTag(data, 1, protowire.BytesType)
+	data = protowire.AppendString(data, dev1)
+	data = protowire.AppendTag(data, 2, protowire.BytesType)
+	data = protowire.AppendBytes(data, updatedData)
+	data = protowire.AppendTag(data, 3, protowire.BytesType)
+	data = protowire.AppendBytes(data, pendingData)
+
+	s := events.NewDeviceMetadataSnapshotTaken()
+	newData, changed, err := registry.Rewrite(s.EventType(), snappy.Encode(nil, data), utils.Unmarshal, utils.Marshal)
+	require.NoError(t, err)
+	require.True(t, changed)
+
+	// stored data are in the current shape
+	err = utils.Unmarshal(newData, s)
+	require.NoError(t, err)
+	require.True(t, s.GetDeviceMetadataUpdated().GetTwinEnabled())
+	require.Len(t, s.GetUpdatePendings(), 1)
+	require.NotNil(t, s.GetUpdatePendings()[0].GetUpdatePending())
+	require.False(t, s.GetUpdatePendings()[0].GetTwinEnabled())
+
+	_, changed, err = registry.Rewrite(s.EventType(), newData, utils.Unmarshal, utils.Marshal)
+	require.NoError(t, err)
+	require.False(t, changed)
+}
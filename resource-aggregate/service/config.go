@@ -6,11 +6,12 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/plgd-dev/hub/v2/pkg/config"
+	"github.com/plgd-dev/hub/v2/pkg/config/database"
 	"github.com/plgd-dev/hub/v2/pkg/log"
 	"github.com/plgd-dev/hub/v2/pkg/net/grpc/client"
 	grpcServer "github.com/plgd-dev/hub/v2/pkg/net/grpc/server"
 	otelClient "github.com/plgd-dev/hub/v2/pkg/opentelemetry/collector/client"
-	natsClient "github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/nats/client"
+	eventbusConfig "github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/config"
 	eventstoreConfig "github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore/config"
 )
 
@@ -62,12 +63,36 @@ func (c *GRPCConfig) Validate() error {
 }
 
 type EventBusConfig struct {
-	NATS natsClient.ConfigPublisher `yaml:"nats" json:"nats"`
+	eventbusConfig.ConfigPublisher `yaml:",inline" json:",inline"`
 }
 
 func (c *EventBusConfig) Validate() error {
-	if err := c.NATS.Validate(); err != nil {
-		return fmt.Errorf("nats.%w", err)
+	return c.ConfigPublisher.Validate()
+}
+
+// OutboxConfig configures the publishing of the events which were stored to the eventstore but not published to the eventbus.
+type OutboxConfig struct {
+	Enabled bool `yaml:"enabled" json:"enabled"`
+	// Interval of the relay which publishes the unpublished events.
+	Interval time.Duration `yaml:"interval" json:"interval"`
+	// Delay is the time after the save of events, when the events are published by the relay. Until that the request handler is expected to publish them.
+	Delay time.Duration `yaml:"delay" json:"delay"`
+	// Limit of the aggregates processed by one run of the relay.
+	Limit int64 `yaml:"limit" json:"limit"`
+}
+
+func (c *OutboxConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.Interval <= 0 {
+		return fmt.Errorf("interval('%v')", c.Interval)
+	}
+	if c.Delay < 0 {
+		return fmt.Errorf("delay('%v')", c.Delay)
+	}
+	if c.Limit <= 0 {
+		return fmt.Errorf("limit('%v')", c.Limit)
 	}
 	return nil
 }
@@ -75,6 +100,7 @@ func (c *EventBusConfig) Validate() error {
 type EventStoreConfig struct {
 	ConcurrencyExceptionMaxRetry int                     `yaml:"occMaxRetry" json:"occMaxRetry"`
 	DefaultCommandTimeToLive     time.Duration           `yaml:"defaultCommandTimeToLive" json:"defaultCommandTimeToLive"`
+	Outbox                       OutboxConfig            `yaml:"outbox" json:"outbox"`
 	Connection                   eventstoreConfig.Config `yaml:",inline" json:",inline"`
 }
 
@@ -82,7 +108,16 @@ func (c *EventStoreConfig) Validate() error {
 	if c.ConcurrencyExceptionMaxRetry <= 0 {
 		return fmt.Errorf("occMaxRetry('%v')", c.ConcurrencyExceptionMaxRetry)
 	}
-	return c.Connection.Validate()
+	if err := c.Outbox.Validate(); err != nil {
+		return fmt.Errorf("outbox.%w", err)
+	}
+	if err := c.Connection.Validate(); err != nil {
+		return err
+	}
+	if c.Outbox.Enabled && c.Connection.Use.ToLower() == database.CqlDB.ToLower() {
+		return fmt.Errorf("outbox.enabled('%v') - not supported by %v", c.Outbox.Enabled, c.Connection.Use)
+	}
+	return nil
 }
 
 type IdentityStoreConfig struct {
@@ -0,0 +1,178 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/plgd-dev/hub/v2/pkg/log"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/nats/publisher"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/events"
+)
+
+// outboxPublisher marks the events as published when they are published by the request handler.
+type outboxPublisher struct {
+	eventbus.Publisher
+	outbox eventstore.Outbox
+	logger log.Logger
+}
+
+func newOutboxPublisher(pub eventbus.Publisher, outbox eventstore.Outbox, logger log.Logger) *outboxPublisher {
+	return &outboxPublisher{
+		Publisher: pub,
+		outbox:    outbox,
+		logger:    logger,
+	}
+}
+
+func (p *outboxPublisher) Publish(ctx context.Context, topics []string, groupID, aggregateID string, event eventbus.Event) error {
+	if err := p.Publisher.Publish(ctx, topics, groupID, aggregateID, event); err != nil {
+		return err
+	}
+	if err := p.outbox.MarkPublished(ctx, aggregateID, event.Version(), event.Version()); err != nil {
+		// the event will be published again by the relay
+		p.logger.Errorf("cannot mark event('%v', %v) as published: %w", aggregateID, event.Version(), err)
+	}
+	return nil
+}
+
+// OutboxRelay publishes the events which were stored but not published by the request handler,
+// for example because the service was stopped or the eventbus was not available.
+// The events are delivered at least once, subscribers can deduplicate them by the aggregateID and the version.
+type OutboxRelay struct {
+	eventstore eventstore.EventStore
+	outbox     eventstore.Outbox
+	publisher  eventbus.Publisher
+	config     OutboxConfig
+	logger     log.Logger
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewOutboxRelay starts the relay which runs in the interval set by the config.
+func NewOutboxRelay(store eventstore.EventStore, pub eventbus.Publisher, config OutboxConfig, logger log.Logger) (*OutboxRelay, error) {
+	outbox, ok := store.(eventstore.Outbox)
+	if !ok {
+		return nil, fmt.Errorf("eventstore %T doesn't support outbox", store)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	r := &OutboxRelay{
+		eventstore: store,
+		outbox:     outbox,
+		publisher:  pub,
+		config:     config,
+		logger:     logger,
+		cancel:     cancel,
+	}
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		r.run(ctx)
+	}()
+	return r, nil
+}
+
+func (r *OutboxRelay) run(ctx context.Context) {
+	ticker := time.NewTicker(r.config.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := r.Relay(ctx); err != nil && !errors.Is(err, context.Canceled) {
+				r.logger.Errorf("cannot relay unpublished events: %w", err)
+			}
+		}
+	}
+}
+
+// Relay publishes the unpublished events stored before the configured delay.
+func (r *OutboxRelay) Relay(ctx context.Context) error {
+	entries, err := r.outbox.GetUnpublished(ctx, time.Now().Add(-r.config.Delay).UnixNano(), r.config.Limit)
+	if err != nil {
+		return err
+	}
+	// an aggregate can be returned more times, the events are published from the lowest unpublished version
+	aggregates := make(map[string]eventstore.OutboxEntry, len(entries))
+	for _, e := range entries {
+		if a, ok := aggregates[e.AggregateID]; ok && a.Version <= e.Version {
+			continue
+		}
+		aggregates[e.AggregateID] = e
+	}
+	var errors *multierror.Error
+	for _, e := range aggregates {
+		if err = r.relayAggregate(ctx, e); err != nil {
+			errors = multierror.Append(errors, err)
+		}
+	}
+	return errors.ErrorOrNil()
+}
+
+type outboxRelayHandler struct {
+	publisher     eventbus.Publisher
+	logger        log.Logger
+	lastPublished *uint64
+}
+
+func (h *outboxRelayHandler) publish(eu eventstore.EventUnmarshaler) error {
+	ev, ok := events.NewEvent(eu.EventType())
+	if !ok {
+		return fmt.Errorf("unknown event type('%v')", eu.EventType())
+	}
+	if err := eu.Unmarshal(ev); err != nil {
+		return fmt.Errorf("cannot unmarshal event('%v', %v): %w", eu.AggregateID(), eu.Version(), err)
+	}
+	subjects := h.publisher.GetPublishSubject(events.GetOwner(ev), ev)
+	err := h.publisher.Publish(context.Background(), subjects, eu.GroupID(), eu.AggregateID(), ev)
+	publisher.LogPublish(h.logger, ev, subjects, err)
+	return err
+}
+
+func (h *outboxRelayHandler) Handle(ctx context.Context, iter eventstore.Iter) error {
+	for {
+		eu, ok := iter.Next(ctx)
+		if !ok {
+			break
+		}
+		// the events must be published in order, so the relay stops on the first error
+		if err := h.publish(eu); err != nil {
+			return err
+		}
+		v := eu.Version()
+		h.lastPublished = &v
+	}
+	return iter.Err()
+}
+
+func (r *OutboxRelay) relayAggregate(ctx context.Context, e eventstore.OutboxEntry) error {
+	h := outboxRelayHandler{
+		publisher: r.publisher,
+		logger:    r.logger,
+	}
+	var errors *multierror.Error
+	err := r.eventstore.LoadFromVersion(ctx, []eventstore.VersionQuery{{GroupID: e.GroupID, AggregateID: e.AggregateID, Version: e.Version}}, &h)
+	if err != nil {
+		errors = multierror.Append(errors, fmt.Errorf("cannot publish events of aggregate('%v'): %w", e.AggregateID, err))
+	}
+	// the events which were published before the error are marked too
+	if h.lastPublished != nil && *h.lastPublished >= e.Version {
+		if err = r.outbox.MarkPublished(ctx, e.AggregateID, e.Version, *h.lastPublished); err != nil {
+			errors = multierror.Append(errors, fmt.Errorf("cannot mark events of aggregate('%v') as published: %w", e.AggregateID, err))
+		}
+	}
+	return errors.ErrorOrNil()
+}
+
+// Close stops the relay.
+func (r *OutboxRelay) Close() {
+	r.cancel()
+	r.wg.Wait()
+}
@@ -0,0 +1,150 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/plgd-dev/hub/v2/pkg/log"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/commands"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/nats/publisher"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore/memory"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/utils"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/events"
+	"github.com/stretchr/testify/require"
+)
+
+type mockOutboxPublisher struct {
+	*publisher.Subjects
+	lock      sync.Mutex
+	published []string
+	fail      func(event eventbus.Event) bool
+}
+
+func (p *mockOutboxPublisher) Publish(_ context.Context, topics []string, _, aggregateID string, event eventbus.Event) error {
+	if p.fail != nil && p.fail(event) {
+		return errors.New("publish failed")
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	for _, t := range topics {
+		p.published = append(p.published, t+"@"+aggregateID+"."+strconv.FormatUint(event.Version(), 10))
+	}
+	return nil
+}
+
+func (p *mockOutboxPublisher) getPublished() []string {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return append([]string(nil), p.published...)
+}
+
+func newOutboxTestEventStore(ctx context.Context, t *testing.T) *memory.EventStore {
+	store, err := memory.New(ctx, &memory.Config{}, log.NewLogger(log.MakeDefaultConfig()),
+		memory.WithMarshaler(utils.Marshal),
+		memory.WithUnmarshaler(utils.Unmarshal),
+		memory.WithOutbox(true),
+	)
+	require.NoError(t, err)
+	return store
+}
+
+func makeOutboxTestEvents(resourceID *commands.ResourceId, owner string, count int) []eventstore.Event {
+	timestamp := time.Now().Add(-time.Minute).UnixNano()
+	evs := make([]eventstore.Event, 0, count)
+	for i := range count {
+		evs = append(evs, &events.ResourceChanged{
+			ResourceId:   resourceID,
+			Status:       commands.Status_OK,
+			AuditContext: commands.NewAuditContext(owner, "", owner),
+			EventMetadata: &events.EventMetadata{
+				Version:   uint64(i),
+				Timestamp: timestamp + int64(i),
+			},
+		})
+	}
+	return evs
+}
+
+func TestOutboxRelay(t *testing.T) {
+	ctx := context.Background()
+	store := newOutboxTestEventStore(ctx, t)
+	defer func() {
+		errC := store.Close(ctx)
+		require.NoError(t, errC)
+	}()
+
+	resourceID := commands.NewResourceID(uuid.NewString(), "/light/1")
+	evs := makeOutboxTestEvents(resourceID, "owner", 3)
+	status, err := store.Save(ctx, evs...)
+	require.NoError(t, err)
+	require.Equal(t, eventstore.Ok, status)
+
+	failVersion := uint64(1)
+	pub := &mockOutboxPublisher{
+		Subjects: publisher.NewSubjects(),
+		fail: func(event eventbus.Event) bool {
+			return event.Version() == failVersion
+		},
+	}
+	relay := &OutboxRelay{
+		eventstore: store,
+		outbox:     store,
+		publisher:  pub,
+		config: OutboxConfig{
+			Enabled: true,
+			Limit:   10,
+		},
+		logger: log.NewLogger(log.MakeDefaultConfig()),
+	}
+	subject := pub.GetPublishSubject("owner", evs[0])[0]
+	aggregateID := resourceID.ToUUID().String()
+
+	// the relay stops on the failed event and keeps it unpublished
+	err = relay.Relay(ctx)
+	require.Error(t, err)
+	require.Equal(t, []string{subject + "@" + aggregateID + ".0"}, pub.getPublished())
+	entries, err := store.GetUnpublished(ctx, time.Now().UnixNano(), 0)
+	require.NoError(t, err)
+	require.Equal(t, []eventstore.OutboxEntry{{GroupID: resourceID.GetDeviceId(), AggregateID: aggregateID, Version: 1}}, entries)
+
+	failVersion = 100
+	err = relay.Relay(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		subject + "@" + aggregateID + ".0",
+		subject + "@" + aggregateID + ".1",
+		subject + "@" + aggregateID + ".2",
+	}, pub.getPublished())
+	entries, err = store.GetUnpublished(ctx, time.Now().UnixNano(), 0)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+}
+
+func TestOutboxPublisher(t *testing.T) {
+	ctx := context.Background()
+	store := newOutboxTestEventStore(ctx, t)
+	defer func() {
+		errC := store.Close(ctx)
+		require.NoError(t, errC)
+	}()
+
+	resourceID := commands.NewResourceID(uuid.NewString(), "/light/1")
+	evs := makeOutboxTestEvents(resourceID, "owner", 2)
+	status, err := store.Save(ctx, evs...)
+	require.NoError(t, err)
+	require.Equal(t, eventstore.Ok, status)
+
+	pub := newOutboxPublisher(&mockOutboxPublisher{Subjects: publisher.NewSubjects()}, store, log.NewLogger(log.MakeDefaultConfig()))
+	PublishEvents(pub, "owner", resourceID.GetDeviceId(), resourceID.ToUUID().String(), evs, log.NewLogger(log.MakeDefaultConfig()))
+
+	entries, err := store.GetUnpublished(ctx, time.Now().UnixNano(), 0)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+}
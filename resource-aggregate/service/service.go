@@ -16,30 +16,46 @@ import (
 	"github.com/plgd-dev/hub/v2/pkg/security/jwt/validator"
 	"github.com/plgd-dev/hub/v2/pkg/service"
 	cqrsEventBus "github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus"
-	natsClient "github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/nats/client"
-	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/nats/publisher"
+	eventbusConfig "github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/config"
 	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore"
 	eventstoreConfig "github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore/config"
 	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore/cqldb"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore/encryption"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore/memory"
 	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore/mongodb"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore/postgres"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore/upcast"
 	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/utils"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/events"
 	"go.opentelemetry.io/otel/trace"
 )
 
-func createEvenstore(ctx context.Context, config eventstoreConfig.Config, fileWatcher *fsnotify.Watcher, logger log.Logger, tracerProvider trace.TracerProvider) (eventstore.EventStore, error) {
+func createEvenstore(ctx context.Context, config eventstoreConfig.Config, outbox bool, marshaler upcast.MarshalerFunc, unmarshaler upcast.UnmarshalerFunc, fileWatcher *fsnotify.Watcher, logger log.Logger, tracerProvider trace.TracerProvider) (eventstore.EventStore, error) {
 	switch config.Use {
 	case database.MongoDB:
-		s, err := mongodb.New(ctx, config.MongoDB, fileWatcher, logger, tracerProvider, mongodb.WithUnmarshaler(utils.Unmarshal), mongodb.WithMarshaler(utils.Marshal))
+		s, err := mongodb.New(ctx, config.MongoDB, fileWatcher, logger, tracerProvider, mongodb.WithUnmarshaler(unmarshaler), mongodb.WithMarshaler(marshaler), mongodb.WithOutbox(outbox))
 		if err != nil {
 			return nil, fmt.Errorf("mongodb: %w", err)
 		}
 		return s, nil
 	case database.CqlDB:
-		s, err := cqldb.New(ctx, config.CqlDB, fileWatcher, logger, tracerProvider, cqldb.WithUnmarshaler(utils.Unmarshal), cqldb.WithMarshaler(utils.Marshal))
+		s, err := cqldb.New(ctx, config.CqlDB, fileWatcher, logger, tracerProvider, cqldb.WithUnmarshaler(unmarshaler), cqldb.WithMarshaler(marshaler))
 		if err != nil {
 			return nil, fmt.Errorf("cqldb: %w", err)
 		}
 		return s, nil
+	case database.PostgreSQL:
+		s, err := postgres.New(ctx, config.PostgreSQL, fileWatcher, logger, tracerProvider, postgres.WithUnmarshaler(unmarshaler), postgres.WithMarshaler(marshaler), postgres.WithOutbox(outbox))
+		if err != nil {
+			return nil, fmt.Errorf("postgres: %w", err)
+		}
+		return s, nil
+	case database.Memory:
+		s, err := memory.Open(ctx, config.Memory, logger, memory.WithUnmarshaler(unmarshaler), memory.WithMarshaler(marshaler), memory.WithOutbox(outbox))
+		if err != nil {
+			return nil, fmt.Errorf("memory: %w", err)
+		}
+		return s, nil
 	}
 	return nil, fmt.Errorf("invalid eventstore use('%v')", config.Use)
 }
@@ -54,8 +70,31 @@ func New(ctx context.Context, config Config, fileWatcher *fsnotify.Watcher, logg
 	otelClient.AddCloseFunc(cancel)
 	tracerProvider := otelClient.GetTracerProvider()
 
-	eventstore, err := createEvenstore(ctx, config.Clients.Eventstore.Connection, fileWatcher, logger, tracerProvider)
+	upcastRegistry, err := events.NewUpcastRegistry()
+	if err != nil {
+		otelClient.Close()
+		return nil, fmt.Errorf("cannot create upcast registry: %w", err)
+	}
+	marshaler, unmarshaler := utils.Marshal, upcastRegistry.Unmarshaler(utils.Unmarshal)
+	closeEncryptor := func() {
+		// encryption is disabled
+	}
+	if config.Clients.Eventstore.Connection.Encryption.Enabled {
+		encryptor, errE := encryption.New(ctx, config.Clients.Eventstore.Connection.Encryption, fileWatcher, logger, tracerProvider)
+		if errE != nil {
+			otelClient.Close()
+			return nil, fmt.Errorf("cannot create eventstore encryptor: %w", errE)
+		}
+		marshaler, unmarshaler = encryptor.Marshaler(utils.Marshal), upcastRegistry.Unmarshaler(encryptor.Unmarshaler(utils.Unmarshal))
+		closeEncryptor = func() {
+			if errC := encryptor.Close(ctx); errC != nil {
+				logger.Errorf("error occurs during closing of eventstore encryptor: %w", errC)
+			}
+		}
+	}
+	eventstore, err := createEvenstore(ctx, config.Clients.Eventstore.Connection, config.Clients.Eventstore.Outbox.Enabled, marshaler, unmarshaler, fileWatcher, logger, tracerProvider)
 	if err != nil {
+		closeEncryptor()
 		otelClient.Close()
 		return nil, fmt.Errorf("cannot create eventstore %w", err)
 	}
@@ -64,36 +103,41 @@ func New(ctx context.Context, config Config, fileWatcher *fsnotify.Watcher, logg
 		if errC != nil {
 			logger.Errorf("error occurs during closing of connection to eventstore: %w", errC)
 		}
+		closeEncryptor()
 	}
-	naClient, err := natsClient.New(config.Clients.Eventbus.NATS.Config, fileWatcher, logger, tracerProvider)
+	publisher, err := eventbusConfig.NewPublisher(config.Clients.Eventbus.ConfigPublisher, fileWatcher, logger, tracerProvider)
 	if err != nil {
 		closeEventStore()
 		otelClient.Close()
-		return nil, fmt.Errorf("cannot create nats client %w", err)
+		return nil, fmt.Errorf("cannot create eventbus publisher %w", err)
 	}
-	opts := []publisher.Option{publisher.WithMarshaler(utils.Marshal), publisher.WithFlusherTimeout(config.Clients.Eventbus.NATS.FlusherTimeout)}
-	if config.Clients.Eventbus.NATS.LeadResourceType.IsEnabled() {
-		lrt := config.Clients.Eventbus.NATS.LeadResourceType
-		opts = append(opts, publisher.WithLeadResourceType(lrt.GetCompiledRegexFilter(), lrt.Filter, lrt.UseUUID))
+	publisher.AddCloseFunc(otelClient.Close)
+
+	var servicePublisher cqrsEventBus.Publisher = publisher
+	closeOutboxRelay := func() {
+		// outbox is disabled
 	}
-	publisher, err := publisher.New(naClient.GetConn(), config.Clients.Eventbus.NATS.JetStream, opts...)
-	if err != nil {
-		naClient.Close()
-		closeEventStore()
-		otelClient.Close()
-		return nil, fmt.Errorf("cannot create nats publisher %w", err)
+	if config.Clients.Eventstore.Outbox.Enabled {
+		relay, errR := NewOutboxRelay(eventstore, publisher, config.Clients.Eventstore.Outbox, logger)
+		if errR != nil {
+			publisher.Close()
+			closeEventStore()
+			return nil, fmt.Errorf("cannot create outbox relay: %w", errR)
+		}
+		closeOutboxRelay = relay.Close
+		servicePublisher = newOutboxPublisher(publisher, relay.outbox, logger)
 	}
-	naClient.AddCloseFunc(otelClient.Close)
-	naClient.AddCloseFunc(publisher.Close)
 
-	service, err := NewService(ctx, config, fileWatcher, logger, tracerProvider, eventstore, publisher)
+	service, err := NewService(ctx, config, fileWatcher, logger, tracerProvider, eventstore, servicePublisher)
 	if err != nil {
-		naClient.Close()
+		closeOutboxRelay()
+		publisher.Close()
 		closeEventStore()
-		return nil, fmt.Errorf("cannot create nats publisher %w", err)
+		return nil, fmt.Errorf("cannot create service %w", err)
 	}
 	service.AddCloseFunc(closeEventStore)
-	service.AddCloseFunc(naClient.Close)
+	service.AddCloseFunc(publisher.Close)
+	service.AddCloseFunc(closeOutboxRelay)
 
 	return service, nil
 }
@@ -155,13 +199,13 @@ func NewService(ctx context.Context, config Config, fileWatcher *fsnotify.Watche
 	}
 	grpcServer.AddCloseFunc(closeIsClient)
 
-	nats, err := natsClient.New(config.Clients.Eventbus.NATS.Config, fileWatcher, logger, tracerProvider)
+	rawSubscriber, closeRawSubscriber, err := eventbusConfig.NewRawSubscriber(config.Clients.Eventbus.ConfigPublisher, fileWatcher, logger, tracerProvider)
 	if err != nil {
-		return nil, closeGrpcServerOnError(fmt.Errorf("cannot create nats client: %w", err))
+		return nil, closeGrpcServerOnError(fmt.Errorf("cannot create eventbus subscriber: %w", err))
 	}
-	grpcServer.AddCloseFunc(nats.Close)
+	grpcServer.AddCloseFunc(closeRawSubscriber)
 
-	ownerCache := clientIS.NewOwnerCache(config.APIs.GRPC.Authorization.OwnerClaim, config.APIs.GRPC.OwnerCacheExpiration, nats.GetConn(), isClient, func(err error) {
+	ownerCache := clientIS.NewOwnerCache(config.APIs.GRPC.Authorization.OwnerClaim, config.APIs.GRPC.OwnerCacheExpiration, rawSubscriber, isClient, func(err error) {
 		log.Errorf("ownerCache error: %w", err)
 	})
 	grpcServer.AddCloseFunc(ownerCache.Close)
@@ -23,7 +23,7 @@ func validateUpdateDeviceMetadata(request *commands.UpdateDeviceMetadataRequest)
 		return status.Errorf(codes.InvalidArgument, "invalid DeviceId")
 	}
 	switch v := request.GetUpdate().(type) {
-	case *commands.UpdateDeviceMetadataRequest_Connection, *commands.UpdateDeviceMetadataRequest_TwinEnabled, *commands.UpdateDeviceMetadataRequest_TwinSynchronization, *commands.UpdateDeviceMetadataRequest_TwinForceSynchronization:
+	case *commands.UpdateDeviceMetadataRequest_Connection, *commands.UpdateDeviceMetadataRequest_TwinEnabled, *commands.UpdateDeviceMetadataRequest_TwinSynchronization, *commands.UpdateDeviceMetadataRequest_TwinForceSynchronization, *commands.UpdateDeviceMetadataRequest_Labels:
 	default:
 		return status.Errorf(codes.InvalidArgument, "update type (%T) invalid", v)
 	}
@@ -99,6 +99,8 @@ func (r RequestHandler) updateDeviceMetadata(ctx context.Context, request *comma
 		AuditContext: commands.NewAuditContext(userID, "", owner),
 		TwinEnabled:  latestSnapshot.GetDeviceMetadataUpdated().GetTwinEnabled(),
 		ValidUntil:   validUntil,
+		Labels:       latestSnapshot.GetDeviceLabelsUpdated().GetLabels(),
+		DisplayName:  latestSnapshot.GetDeviceLabelsUpdated().GetDisplayName(),
 	}, nil
 }
 
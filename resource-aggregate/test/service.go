@@ -28,6 +28,7 @@ func MakeConfig(t require.TestingT) service.Config {
 	cfg.Clients.Eventstore.Connection.Use = config.ACTIVE_DATABASE()
 	cfg.Clients.Eventstore.Connection.MongoDB = config.MakeEventsStoreMongoDBConfig()
 	cfg.Clients.Eventstore.Connection.CqlDB = config.MakeEventsStoreCqlDBConfig()
+	cfg.Clients.Eventstore.Connection.PostgreSQL = config.MakeEventsStorePostgreSQLConfig()
 
 	cfg.Clients.Eventstore.ConcurrencyExceptionMaxRetry = 8
 	cfg.Clients.OpenTelemetryCollector = config.MakeOpenTelemetryCollectorClient()
@@ -0,0 +1,54 @@
+package history
+
+import (
+	"context"
+
+	"github.com/plgd-dev/hub/v2/pkg/log"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/commands"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/events"
+)
+
+// SubscriptionID is the queue group of the archivers, so each event is archived by a single instance.
+const SubscriptionID = "resource-directory-history"
+
+// Archiver stores the ResourceChanged events received from the eventbus to the history store.
+type Archiver struct {
+	store     *Store
+	retention RetentionConfig
+	logger    log.Logger
+}
+
+func NewArchiver(store *Store, retention RetentionConfig, logger log.Logger) *Archiver {
+	return &Archiver{
+		store:     store,
+		retention: retention,
+		logger:    logger,
+	}
+}
+
+// Subjects returns the subjects of the ResourceChanged events of all owners.
+func Subjects(subscriber eventbus.Subscriber) []string {
+	return subscriber.GetResourceEventSubjects("*", commands.NewResourceID("*", "*"), (&events.ResourceChanged{}).EventType())
+}
+
+func (a *Archiver) Handle(ctx context.Context, iter eventbus.Iter) error {
+	for {
+		eu, ok := iter.Next(ctx)
+		if !ok {
+			break
+		}
+		if eu.EventType() != (&events.ResourceChanged{}).EventType() {
+			continue
+		}
+		var ev events.ResourceChanged
+		if err := eu.Unmarshal(&ev); err != nil {
+			a.logger.Errorf("cannot unmarshal resource value %v: %w", eu.AggregateID(), err)
+			continue
+		}
+		if err := a.store.Save(ctx, &ev, a.retention.Duration(ev.GetResourceTypes())); err != nil {
+			a.logger.Errorf("cannot archive resource value %v: %w", ev.GetResourceId().ToString(), err)
+		}
+	}
+	return iter.Err()
+}
@@ -0,0 +1,76 @@
+package history
+
+import (
+	"fmt"
+	"time"
+
+	pkgMongo "github.com/plgd-dev/hub/v2/pkg/mongodb"
+	"github.com/plgd-dev/kit/v2/strings"
+)
+
+type RetentionPolicy struct {
+	ResourceTypes []string `yaml:"resourceTypes" json:"resourceTypes"`
+	// 0s - means forever
+	Duration time.Duration `yaml:"duration" json:"duration"`
+}
+
+func (c *RetentionPolicy) Validate() error {
+	if len(c.ResourceTypes) == 0 {
+		return fmt.Errorf("resourceTypes('%v')", c.ResourceTypes)
+	}
+	if c.Duration < 0 {
+		return fmt.Errorf("duration('%v')", c.Duration)
+	}
+	return nil
+}
+
+type RetentionConfig struct {
+	// 0s - means forever
+	Default  time.Duration     `yaml:"default" json:"default"`
+	Policies []RetentionPolicy `yaml:"policies" json:"policies"`
+}
+
+func (c *RetentionConfig) Validate() error {
+	if c.Default < 0 {
+		return fmt.Errorf("default('%v')", c.Default)
+	}
+	for i := range c.Policies {
+		if err := c.Policies[i].Validate(); err != nil {
+			return fmt.Errorf("policies[%v].%w", i, err)
+		}
+	}
+	return nil
+}
+
+// Duration returns the retention of the resource with the resource types. The first matching policy is used,
+// otherwise the default retention is returned.
+func (c *RetentionConfig) Duration(resourceTypes []string) time.Duration {
+	if len(resourceTypes) == 0 {
+		return c.Default
+	}
+	for _, p := range c.Policies {
+		if strings.MakeSet(p.ResourceTypes...).HasOneOf(resourceTypes...) {
+			return p.Duration
+		}
+	}
+	return c.Default
+}
+
+type Config struct {
+	Enabled   bool            `yaml:"enabled" json:"enabled"`
+	MongoDB   pkgMongo.Config `yaml:"mongoDB" json:"mongoDB"`
+	Retention RetentionConfig `yaml:"retention" json:"retention"`
+}
+
+func (c *Config) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if err := c.MongoDB.Validate(); err != nil {
+		return fmt.Errorf("mongoDB.%w", err)
+	}
+	if err := c.Retention.Validate(); err != nil {
+		return fmt.Errorf("retention.%w", err)
+	}
+	return nil
+}
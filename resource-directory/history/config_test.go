@@ -0,0 +1,23 @@
+package history_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/plgd-dev/hub/v2/resource-directory/history"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetentionDuration(t *testing.T) {
+	cfg := history.RetentionConfig{
+		Default: time.Hour,
+		Policies: []history.RetentionPolicy{
+			{ResourceTypes: []string{"oic.r.temperature"}, Duration: 24 * time.Hour},
+			{ResourceTypes: []string{"oic.r.switch.binary", "oic.r.temperature"}},
+		},
+	}
+	require.Equal(t, 24*time.Hour, cfg.Duration([]string{"oic.r.temperature", "oic.r.switch.binary"}))
+	require.Equal(t, time.Duration(0), cfg.Duration([]string{"oic.r.switch.binary"}))
+	require.Equal(t, time.Hour, cfg.Duration([]string{"oic.r.light"}))
+	require.Equal(t, time.Hour, cfg.Duration(nil))
+}
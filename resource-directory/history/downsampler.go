@@ -0,0 +1,191 @@
+package history
+
+import (
+	"strings"
+
+	"github.com/plgd-dev/hub/v2/grpc-gateway/pb"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/commands"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/events"
+)
+
+type propertyStatistics struct {
+	min   float64
+	max   float64
+	sum   float64
+	count uint32
+}
+
+func (s *propertyStatistics) add(v float64) {
+	if s.count == 0 || v < s.min {
+		s.min = v
+	}
+	if s.count == 0 || v > s.max {
+		s.max = v
+	}
+	s.sum += v
+	s.count++
+}
+
+// Downsampler aggregates the numeric properties of the resource values to buckets of the same duration.
+// The buckets are aligned to the multiples of the duration and the values must be added in the ascending
+// order of their timestamps.
+type Downsampler struct {
+	bucketDuration int64
+	properties     []string
+
+	timeFrom   int64
+	count      uint32
+	statistics map[string]*propertyStatistics
+}
+
+// NewDownsampler creates a downsampler. When properties are empty, all numeric top-level properties are aggregated.
+func NewDownsampler(bucketDuration int64, properties []string) *Downsampler {
+	return &Downsampler{
+		bucketDuration: bucketDuration,
+		properties:     properties,
+	}
+}
+
+func toFloat(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int8:
+		return float64(n), true
+	case int16:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case uint:
+		return float64(n), true
+	case uint8:
+		return float64(n), true
+	case uint16:
+		return float64(n), true
+	case uint32:
+		return float64(n), true
+	case uint64:
+		return float64(n), true
+	}
+	return 0, false
+}
+
+// property returns the value of the property from the decoded JSON or CBOR object.
+func property(v interface{}, key string) (interface{}, bool) {
+	switch m := v.(type) {
+	case map[string]interface{}:
+		p, ok := m[key]
+		return p, ok
+	case map[interface{}]interface{}:
+		p, ok := m[key]
+		return p, ok
+	}
+	return nil, false
+}
+
+func (d *Downsampler) numericProperties(content *commands.Content) map[string]float64 {
+	var v interface{}
+	if err := commands.DecodeContent(content, &v); err != nil {
+		return nil
+	}
+	values := make(map[string]float64)
+	if len(d.properties) == 0 {
+		switch m := v.(type) {
+		case map[string]interface{}:
+			for key, p := range m {
+				if n, ok := toFloat(p); ok {
+					values[key] = n
+				}
+			}
+		case map[interface{}]interface{}:
+			for key, p := range m {
+				k, ok := key.(string)
+				if !ok {
+					continue
+				}
+				if n, ok := toFloat(p); ok {
+					values[k] = n
+				}
+			}
+		}
+		return values
+	}
+	for _, name := range d.properties {
+		p := v
+		ok := true
+		for _, key := range strings.Split(name, ".") {
+			if p, ok = property(p, key); !ok {
+				break
+			}
+		}
+		if !ok {
+			continue
+		}
+		if n, ok := toFloat(p); ok {
+			values[name] = n
+		}
+	}
+	return values
+}
+
+func (d *Downsampler) bucketStart(timestamp int64) int64 {
+	start := timestamp - timestamp%d.bucketDuration
+	if timestamp < 0 && start != timestamp {
+		start -= d.bucketDuration
+	}
+	return start
+}
+
+// Add adds the value to the current bucket. When the value belongs to the next bucket, the current bucket is
+// returned and the value starts a new bucket.
+func (d *Downsampler) Add(ev *events.ResourceChanged) *pb.ResourceHistoryBucket {
+	start := d.bucketStart(ev.GetEventMetadata().GetTimestamp())
+	var done *pb.ResourceHistoryBucket
+	if d.count > 0 && start != d.timeFrom {
+		done = d.Flush()
+	}
+	if d.count == 0 {
+		d.timeFrom = start
+		d.statistics = make(map[string]*propertyStatistics)
+	}
+	d.count++
+	for name, v := range d.numericProperties(ev.GetContent()) {
+		s, ok := d.statistics[name]
+		if !ok {
+			s = &propertyStatistics{}
+			d.statistics[name] = s
+		}
+		s.add(v)
+	}
+	return done
+}
+
+// Flush returns the current bucket or nil when the bucket is empty.
+func (d *Downsampler) Flush() *pb.ResourceHistoryBucket {
+	if d.count == 0 {
+		return nil
+	}
+	bucket := &pb.ResourceHistoryBucket{
+		TimeFrom:   d.timeFrom,
+		TimeTo:     d.timeFrom + d.bucketDuration,
+		Count:      d.count,
+		Properties: make(map[string]*pb.ResourceHistoryBucket_PropertyStatistics, len(d.statistics)),
+	}
+	for name, s := range d.statistics {
+		bucket.Properties[name] = &pb.ResourceHistoryBucket_PropertyStatistics{
+			Min:   s.min,
+			Max:   s.max,
+			Avg:   s.sum / float64(s.count),
+			Count: s.count,
+		}
+	}
+	d.count = 0
+	d.statistics = nil
+	return bucket
+}
@@ -0,0 +1,90 @@
+package history_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/plgd-dev/go-coap/v3/message"
+	"github.com/plgd-dev/hub/v2/grpc-gateway/pb"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/commands"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/events"
+	"github.com/plgd-dev/hub/v2/resource-directory/history"
+	"github.com/plgd-dev/kit/v2/codec/cbor"
+	"github.com/plgd-dev/kit/v2/codec/json"
+	"github.com/stretchr/testify/require"
+)
+
+func makeValue(t *testing.T, contentType message.MediaType, data interface{}, timestamp int64) *events.ResourceChanged {
+	var encoded []byte
+	var err error
+	if contentType == message.AppJSON {
+		encoded, err = json.Encode(data)
+	} else {
+		encoded, err = cbor.Encode(data)
+	}
+	require.NoError(t, err)
+	return &events.ResourceChanged{
+		ResourceId: commands.NewResourceID("4f2b2b10-7f1c-4c5a-9c5e-3a0d0e2d4b1f", "/temperature"),
+		Content: &commands.Content{
+			Data:        encoded,
+			ContentType: contentType.String(),
+		},
+		EventMetadata: &events.EventMetadata{Timestamp: timestamp},
+	}
+}
+
+func TestDownsampler(t *testing.T) {
+	minute := time.Minute.Nanoseconds()
+	values := []*events.ResourceChanged{
+		makeValue(t, message.AppJSON, map[string]interface{}{"temperature": 20, "units": "C", "status": map[string]interface{}{"battery": 90}}, 0),
+		makeValue(t, message.AppOcfCbor, map[string]interface{}{"temperature": 22.5, "units": "C", "status": map[string]interface{}{"battery": 80}}, minute/2),
+		makeValue(t, message.AppJSON, map[string]interface{}{"units": "C"}, minute),
+		makeValue(t, message.AppCBOR, map[string]interface{}{"temperature": -4, "status": map[string]interface{}{"battery": 70}}, 3*minute+1),
+	}
+
+	downsample := func(properties []string) []*pb.ResourceHistoryBucket {
+		d := history.NewDownsampler(minute, properties)
+		var buckets []*pb.ResourceHistoryBucket
+		for _, v := range values {
+			if b := d.Add(v); b != nil {
+				buckets = append(buckets, b)
+			}
+		}
+		if b := d.Flush(); b != nil {
+			buckets = append(buckets, b)
+		}
+		return buckets
+	}
+
+	require.Equal(t, []*pb.ResourceHistoryBucket{
+		{
+			TimeFrom: 0,
+			TimeTo:   minute,
+			Count:    2,
+			Properties: map[string]*pb.ResourceHistoryBucket_PropertyStatistics{
+				"temperature": {Min: 20, Max: 22.5, Avg: 21.25, Count: 2},
+			},
+		},
+		{
+			TimeFrom:   minute,
+			TimeTo:     2 * minute,
+			Count:      1,
+			Properties: map[string]*pb.ResourceHistoryBucket_PropertyStatistics{},
+		},
+		{
+			TimeFrom: 3 * minute,
+			TimeTo:   4 * minute,
+			Count:    1,
+			Properties: map[string]*pb.ResourceHistoryBucket_PropertyStatistics{
+				"temperature": {Min: -4, Max: -4, Avg: -4, Count: 1},
+			},
+		},
+	}, downsample(nil))
+
+	buckets := downsample([]string{"status.battery", "unknown"})
+	require.Len(t, buckets, 3)
+	require.Equal(t, map[string]*pb.ResourceHistoryBucket_PropertyStatistics{
+		"status.battery": {Min: 80, Max: 90, Avg: 85, Count: 2},
+	}, buckets[0].GetProperties())
+	require.Empty(t, buckets[1].GetProperties())
+}
@@ -0,0 +1,197 @@
+package history
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/plgd-dev/hub/v2/pkg/fsnotify"
+	"github.com/plgd-dev/hub/v2/pkg/log"
+	pkgMongo "github.com/plgd-dev/hub/v2/pkg/mongodb"
+	"github.com/plgd-dev/hub/v2/pkg/security/certManager/client"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/commands"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/utils"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/events"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const valuesCol = "values"
+
+const (
+	deviceIDKey  = "deviceId"
+	hrefKey      = "href"
+	versionKey   = "version"
+	timestampKey = "timestamp"
+	expiresAtKey = "expiresAt"
+	dataKey      = "data"
+)
+
+var deviceIDHrefTimestampVersionUniqueIndex = mongo.IndexModel{
+	Keys: bson.D{
+		{Key: deviceIDKey, Value: 1},
+		{Key: hrefKey, Value: 1},
+		{Key: timestampKey, Value: 1},
+		{Key: versionKey, Value: 1},
+	},
+	Options: options.Index().SetUnique(true),
+}
+
+// values without expiresAt are kept forever
+var expiresAtTTLIndex = mongo.IndexModel{
+	Keys: bson.D{
+		{Key: expiresAtKey, Value: 1},
+	},
+	Options: options.Index().SetExpireAfterSeconds(0),
+}
+
+type value struct {
+	DeviceID  string     `bson:"deviceId"`
+	Href      string     `bson:"href"`
+	Version   uint64     `bson:"version"`
+	Timestamp int64      `bson:"timestamp"`
+	ExpiresAt *time.Time `bson:"expiresAt,omitempty"`
+	Data      []byte     `bson:"data"`
+}
+
+// MarshalerFunc marshal struct to bytes.
+type MarshalerFunc = func(v interface{}) ([]byte, error)
+
+// UnmarshalerFunc unmarshal bytes to pointer of struct.
+type UnmarshalerFunc = func(b []byte, v interface{}) error
+
+// Option configures the Store.
+type Option func(s *Store)
+
+// WithMarshaler sets the marshaler of the archived events, eg. the marshaler which encrypts the content by the data
+// keys of the owners, so the archived content is shredded with the events in the eventstore.
+func WithMarshaler(f MarshalerFunc) Option {
+	return func(s *Store) {
+		s.marshal = f
+	}
+}
+
+// WithUnmarshaler sets the unmarshaler of the archived events.
+func WithUnmarshaler(f UnmarshalerFunc) Option {
+	return func(s *Store) {
+		s.unmarshal = f
+	}
+}
+
+// Store archives the resource values to the MongoDB. Expired values are removed by the TTL index.
+type Store struct {
+	*pkgMongo.Store
+	marshal   MarshalerFunc
+	unmarshal UnmarshalerFunc
+}
+
+func NewStore(ctx context.Context, cfg *pkgMongo.Config, fileWatcher *fsnotify.Watcher, logger log.Logger, tracerProvider trace.TracerProvider, opts ...Option) (*Store, error) {
+	certManager, err := client.New(cfg.TLS, fileWatcher, logger, tracerProvider)
+	if err != nil {
+		return nil, fmt.Errorf("could not create cert manager: %w", err)
+	}
+	m, err := pkgMongo.NewStoreWithCollection(ctx, cfg, certManager.GetTLSConfig(), tracerProvider, valuesCol, deviceIDHrefTimestampVersionUniqueIndex, expiresAtTTLIndex)
+	if err != nil {
+		certManager.Close()
+		return nil, err
+	}
+	s := Store{Store: m, marshal: utils.Marshal, unmarshal: utils.Unmarshal}
+	for _, o := range opts {
+		o(&s)
+	}
+	s.SetOnClear(s.clearDatabases)
+	s.AddCloseFunc(certManager.Close)
+	return &s, nil
+}
+
+func (s *Store) clearDatabases(ctx context.Context) error {
+	return s.Collection(valuesCol).Drop(ctx)
+}
+
+// Save archives the resource value. A value with the same device id, href, timestamp and version is stored only once,
+// so redelivered events are ignored. Retention 0 means that the value never expires.
+func (s *Store) Save(ctx context.Context, ev *events.ResourceChanged, retention time.Duration) error {
+	data, err := s.marshal(ev)
+	if err != nil {
+		return fmt.Errorf("cannot marshal resource value: %w", err)
+	}
+	v := value{
+		DeviceID:  ev.GetResourceId().GetDeviceId(),
+		Href:      ev.GetResourceId().GetHref(),
+		Version:   ev.GetEventMetadata().GetVersion(),
+		Timestamp: ev.GetEventMetadata().GetTimestamp(),
+		Data:      data,
+	}
+	if retention > 0 {
+		expiresAt := time.Unix(0, v.Timestamp).Add(retention)
+		v.ExpiresAt = &expiresAt
+	}
+	filter := bson.D{
+		{Key: deviceIDKey, Value: v.DeviceID},
+		{Key: hrefKey, Value: v.Href},
+		{Key: timestampKey, Value: v.Timestamp},
+		{Key: versionKey, Value: v.Version},
+	}
+	_, err = s.Collection(valuesCol).UpdateOne(ctx, filter, bson.M{"$setOnInsert": v}, options.Update().SetUpsert(true))
+	if err != nil && !mongo.IsDuplicateKeyError(err) {
+		return fmt.Errorf("cannot save resource value: %w", err)
+	}
+	return nil
+}
+
+type Query struct {
+	ResourceID *commands.ResourceId
+	// Unix timestamp in nanoseconds, inclusive
+	TimeFrom int64
+	// Unix timestamp in nanoseconds, exclusive. 0 means no upper bound.
+	TimeTo int64
+	// 0 means no limit
+	Limit int64
+}
+
+func toFilter(query Query) bson.D {
+	timestamp := bson.M{"$gte": query.TimeFrom}
+	if query.TimeTo > 0 {
+		timestamp["$lt"] = query.TimeTo
+	}
+	return bson.D{
+		{Key: deviceIDKey, Value: query.ResourceID.GetDeviceId()},
+		{Key: hrefKey, Value: query.ResourceID.GetHref()},
+		{Key: timestampKey, Value: timestamp},
+	}
+}
+
+// Load calls onValue for the archived values which match the query in the ascending order of the timestamps.
+func (s *Store) Load(ctx context.Context, query Query, onValue func(*events.ResourceChanged) error) error {
+	opts := options.Find().SetSort(bson.D{{Key: timestampKey, Value: 1}, {Key: versionKey, Value: 1}}).SetProjection(bson.M{dataKey: 1})
+	if query.Limit > 0 {
+		opts.SetLimit(query.Limit)
+	}
+	cur, err := s.Collection(valuesCol).Find(ctx, toFilter(query), opts)
+	if err != nil {
+		return fmt.Errorf("cannot load resource values: %w", err)
+	}
+	var errors *multierror.Error
+	for cur.Next(ctx) {
+		var v value
+		if err = cur.Decode(&v); err != nil {
+			errors = multierror.Append(errors, fmt.Errorf("cannot decode resource value: %w", err))
+			break
+		}
+		var ev events.ResourceChanged
+		if err = s.unmarshal(v.Data, &ev); err != nil {
+			errors = multierror.Append(errors, fmt.Errorf("cannot unmarshal resource value: %w", err))
+			break
+		}
+		if err = onValue(&ev); err != nil {
+			errors = multierror.Append(errors, err)
+			break
+		}
+	}
+	errors = multierror.Append(errors, cur.Err())
+	errors = multierror.Append(errors, cur.Close(ctx))
+	return errors.ErrorOrNil()
+}
@@ -0,0 +1,305 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/plgd-dev/device/v2/schema/device"
+	"github.com/plgd-dev/hub/v2/grpc-gateway/pb"
+	"github.com/plgd-dev/hub/v2/pkg/labels"
+	pkgTime "github.com/plgd-dev/hub/v2/pkg/time"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/commands"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/events"
+	"github.com/plgd-dev/kit/v2/strings"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type aggregateAsOf struct {
+	model       eventstore.Model
+	version     uint64
+	minVersion  uint64
+	initialized bool
+}
+
+// canApply returns true when the event continues the folded state of the aggregate. The eventstore
+// doesn't guarantee the order of the stored snapshots, so events of older snapshots are skipped.
+func (a *aggregateAsOf) canApply(eu eventstore.EventUnmarshaler) bool {
+	if a.initialized && eu.Version() <= a.version {
+		return false
+	}
+	if eu.IsSnapshot() {
+		return true
+	}
+	if !a.initialized {
+		return eu.Version() == 0
+	}
+	return eu.Version() == a.version+1
+}
+
+type eventIter struct {
+	eu eventstore.EventUnmarshaler
+}
+
+func (i *eventIter) Next(context.Context) (eventstore.EventUnmarshaler, bool) {
+	eu := i.eu
+	i.eu = nil
+	return eu, eu != nil
+}
+
+func (i *eventIter) Err() error {
+	return nil
+}
+
+// twinAsOf rebuilds models of the aggregates from events stored up to the asOf time.
+type twinAsOf struct {
+	asOf     int64
+	newModel eventstore.FactoryModelFunc
+
+	lock       sync.Mutex
+	aggregates map[string]*aggregateAsOf
+}
+
+func newTwinAsOf(asOf int64) *twinAsOf {
+	return &twinAsOf{
+		asOf:       asOf,
+		newModel:   NewEventStoreModelFactory(),
+		aggregates: make(map[string]*aggregateAsOf),
+	}
+}
+
+func (t *twinAsOf) handleEventLocked(ctx context.Context, eu eventstore.EventUnmarshaler) error {
+	a, ok := t.aggregates[eu.AggregateID()]
+	if !ok {
+		model, err := t.newModel(ctx, eu.GroupID(), eu.AggregateID())
+		if err != nil {
+			return err
+		}
+		a = &aggregateAsOf{model: model, minVersion: eu.Version()}
+		t.aggregates[eu.AggregateID()] = a
+	}
+	if eu.Version() < a.minVersion {
+		a.minVersion = eu.Version()
+	}
+	if pkgTime.UnixNano(eu.Timestamp()) > t.asOf || !a.canApply(eu) {
+		return nil
+	}
+	if err := a.model.Handle(ctx, &eventIter{eu: eu}); err != nil {
+		return err
+	}
+	a.version = eu.Version()
+	a.initialized = true
+	return nil
+}
+
+func (t *twinAsOf) Handle(ctx context.Context, iter eventstore.Iter) error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	for {
+		eu, ok := iter.Next(ctx)
+		if !ok {
+			break
+		}
+		if err := t.handleEventLocked(ctx, eu); err != nil {
+			return err
+		}
+	}
+	return iter.Err()
+}
+
+// loadTwinAsOf folds the events of the aggregates selected by queries up to the asOf time. When the eventstore
+// doesn't retain the events before asOf for some aggregate, codes.OutOfRange is returned.
+func loadTwinAsOf(ctx context.Context, store eventstore.EventStore, queries []eventstore.GetEventsQuery, asOf int64) (*twinAsOf, error) {
+	t := newTwinAsOf(asOf)
+	if err := store.LoadUpToTimestamp(ctx, queries, asOf, t); err != nil {
+		if errors.Is(err, eventstore.ErrNotSupported) {
+			return nil, status.Errorf(codes.Unimplemented, "cannot load events as of %v: eventstore doesn't keep the history", pkgTime.Unix(0, asOf))
+		}
+		return nil, status.Errorf(codes.Internal, "cannot load events: %v", err)
+	}
+	for aggregateID, a := range t.aggregates {
+		if !a.initialized && a.minVersion > 0 {
+			return nil, status.Errorf(codes.OutOfRange, "cannot rebuild aggregate %v as of %v: events are not retained", aggregateID, pkgTime.Unix(0, asOf))
+		}
+	}
+	return t, nil
+}
+
+// loadDevicesTwinAsOf folds the events of the aggregates of the devices up to the asOf time by one query
+// to the eventstore. When the aggregateIDs function is nil, all aggregates of the devices are loaded.
+func loadDevicesTwinAsOf(ctx context.Context, store eventstore.EventStore, deviceIDs strings.Set, aggregateIDs func(deviceID string) []string, asOf int64) (*twinAsOf, error) {
+	queries := make([]eventstore.GetEventsQuery, 0, len(deviceIDs))
+	for deviceID := range deviceIDs {
+		if aggregateIDs == nil {
+			queries = append(queries, eventstore.GetEventsQuery{GroupID: deviceID})
+			continue
+		}
+		for _, aggregateID := range aggregateIDs(deviceID) {
+			queries = append(queries, eventstore.GetEventsQuery{GroupID: deviceID, AggregateID: aggregateID})
+		}
+	}
+	return loadTwinAsOf(ctx, store, queries, asOf)
+}
+
+func (t *twinAsOf) getModel(aggregateID string) eventstore.Model {
+	a, ok := t.aggregates[aggregateID]
+	if !ok || !a.initialized {
+		return nil
+	}
+	return a.model
+}
+
+func (t *twinAsOf) resourceLinks(deviceID string) *resourceLinksProjection {
+	rl, ok := t.getModel(commands.MakeLinksResourceUUID(deviceID).String()).(*resourceLinksProjection)
+	if !ok || rl.LenResources() == 0 {
+		return nil
+	}
+	return rl
+}
+
+// deviceLabels returns the labels of the device as they were at that time.
+func (t *twinAsOf) deviceLabels(deviceID string) map[string]string {
+	dm := t.deviceMetadata(deviceID)
+	if dm == nil {
+		return nil
+	}
+	return dm.GetDeviceLabelsUpdated().GetLabels()
+}
+
+func (t *twinAsOf) deviceMetadata(deviceID string) *deviceMetadataProjection {
+	dm, ok := t.getModel(commands.MakeStatusResourceUUID(deviceID).String()).(*deviceMetadataProjection)
+	if !ok || !dm.IsInitialized() {
+		return nil
+	}
+	return dm
+}
+
+func (t *twinAsOf) resource(resourceID *commands.ResourceId) *resourceProjection {
+	rp, _ := t.getModel(resourceID.ToUUID().String()).(*resourceProjection)
+	return rp
+}
+
+// iterateResources iterates over the published resources of the device which match the filter.
+func (t *twinAsOf) iterateResources(deviceID string, rf resourceFilter, onResource func(*Resource) error) error {
+	rl := t.resourceLinks(deviceID)
+	if rl == nil {
+		return nil
+	}
+	var err error
+	rl.IterateOverResources(func(res *commands.Resource) (wantNext bool) {
+		if !rf.isMatchingResource(res.GetHref(), res.GetResourceTypes()) {
+			return true
+		}
+		rp := t.resource(commands.NewResourceID(deviceID, res.GetHref()))
+		if rp == nil {
+			return true
+		}
+		err = onResource(&Resource{
+			projection: rp,
+			Resource:   res,
+		})
+		return err == nil
+	})
+	return err
+}
+
+func (r *RequestHandler) getResourcesAsOf(req *pb.GetResourcesRequest, srv pb.GrpcGateway_GetResourcesServer, deviceIDs []string, selector labels.Selector) error {
+	// for backward compatibility and http api
+	req.ResourceIdFilter = append(req.ResourceIdFilter, req.ConvertHTTPResourceIDFilter()...)
+
+	resourceIDsFilter := NewResourceTwin(nil, deviceIDs).convertToResourceIDs(req.GetResourceIdFilter(), req.GetDeviceIdFilter())
+	typeFilter := make(strings.Set)
+	typeFilter.Add(req.GetTypeFilter()...)
+	resourceIDMapFilter := getResourceIDMapFilter(resourceIdFilterToSimple(resourceIDsFilter))
+	return sendPage(srv, req.GetPageSize(), req.GetPageToken(), resourceIDsFilterToDevices(resourceIDsFilter), func(deviceIDs strings.Set, add func(key pageToken, val *pb.Resource) error) error {
+		twin, err := loadDevicesTwinAsOf(srv.Context(), r.eventStore, deviceIDs, nil, req.GetAsOf())
+		if err != nil {
+			return err
+		}
+		for deviceID := range deviceIDs {
+			if !selector.Empty() && !selector.Matches(twin.deviceLabels(deviceID)) {
+				continue
+			}
+			err = twin.iterateResources(deviceID, resourceFilter{hrefFilter: resourceIDMapFilter[deviceID], typeFilter: typeFilter}, func(resource *Resource) error {
+				val := toResourceValue(resource)
+				updateContentIfETagMatched(resourceIDsFilter, val)
+				return add(resourceKey(val), val)
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}, newResourceSender(srv))
+}
+
+func (r *RequestHandler) getDevicesAsOf(req *pb.GetDevicesRequest, srv pb.GrpcGateway_GetDevicesServer, deviceIDs []string, selector labels.Selector) error {
+	typeFilter := make(strings.Set)
+	typeFilter.Add(req.GetTypeFilter()...)
+	rf := resourceFilter{hrefFilter: map[string]bool{device.ResourceURI: true}, typeFilter: typeFilter}
+	filteredDeviceIDs := filterDevices(strings.MakeSet(deviceIDs...), req.GetDeviceIdFilter())
+	return sendPage(srv, req.GetPageSize(), req.GetPageToken(), filteredDeviceIDs, func(deviceIDs strings.Set, add func(key pageToken, device *pb.Device) error) error {
+		twin, err := loadDevicesTwinAsOf(srv.Context(), r.eventStore, deviceIDs, func(deviceID string) []string {
+			return []string{
+				commands.MakeStatusResourceUUID(deviceID).String(),
+				commands.MakeLinksResourceUUID(deviceID).String(),
+				commands.NewResourceID(deviceID, device.ResourceURI).ToUUID().String(),
+			}
+		}, req.GetAsOf())
+		if err != nil {
+			return err
+		}
+		for deviceID := range deviceIDs {
+			dm := twin.deviceMetadata(deviceID)
+			if dm == nil {
+				continue
+			}
+			deviceMetadataUpdated := dm.GetDeviceMetadataUpdated()
+			if !hasMatchingStatus(deviceMetadataUpdated.GetConnection().IsOnline(), req.GetStatusFilter()) {
+				continue
+			}
+			// the labels are matched as they were at that time
+			deviceLabelsUpdated := dm.GetDeviceLabelsUpdated()
+			if !selector.Matches(deviceLabelsUpdated.GetLabels()) {
+				continue
+			}
+			err = twin.iterateResources(deviceID, rf, func(resource *Resource) error {
+				return sendDevice(add, deviceMetadataUpdated, deviceLabelsUpdated, resource)
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}, newDeviceSender(srv))
+}
+
+func (r *RequestHandler) getResourceLinksAsOf(req *pb.GetResourceLinksRequest, srv pb.GrpcGateway_GetResourceLinksServer, deviceIDs []string) error {
+	typeFilter := make(strings.Set)
+	typeFilter.Add(req.GetTypeFilter()...)
+	filteredDeviceIDs := filterDevices(strings.MakeSet(deviceIDs...), req.GetDeviceIdFilter())
+	return sendPage(srv, req.GetPageSize(), req.GetPageToken(), filteredDeviceIDs, func(deviceIDs strings.Set, add func(key pageToken, links *events.ResourceLinksPublished) error) error {
+		twin, err := loadDevicesTwinAsOf(srv.Context(), r.eventStore, deviceIDs, func(deviceID string) []string {
+			return []string{commands.MakeLinksResourceUUID(deviceID).String()}
+		}, req.GetAsOf())
+		if err != nil {
+			return err
+		}
+		for deviceID := range deviceIDs {
+			rl := twin.resourceLinks(deviceID)
+			if rl == nil {
+				continue
+			}
+			toSend := rl.ToResourceLinksPublished(typeFilter)
+			if toSend == nil {
+				continue
+			}
+			if err = add(pageToken{DeviceID: deviceID}, toSend); err != nil {
+				return err
+			}
+		}
+		return nil
+	}, newResourceLinksSender(srv))
+}
@@ -0,0 +1,156 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/plgd-dev/go-coap/v3/message"
+	"github.com/plgd-dev/hub/v2/pkg/log"
+	pkgTime "github.com/plgd-dev/hub/v2/pkg/time"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/commands"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore/memory"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/utils"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/events"
+	"github.com/plgd-dev/kit/v2/strings"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func makeResourceChanged(resourceID *commands.ResourceId, data string, version uint64, timestamp time.Time) *events.ResourceChanged {
+	return &events.ResourceChanged{
+		ResourceId: resourceID,
+		Content: &commands.Content{
+			Data:        []byte(data),
+			ContentType: message.TextPlain.String(),
+		},
+		Status:        commands.Status_OK,
+		EventMetadata: &events.EventMetadata{Version: version, Timestamp: pkgTime.UnixNano(timestamp)},
+	}
+}
+
+func TestLoadTwinAsOf(t *testing.T) {
+	ctx := context.Background()
+	store, err := memory.New(ctx, &memory.Config{}, log.NewLogger(log.MakeDefaultConfig()), memory.WithMarshaler(utils.Marshal), memory.WithUnmarshaler(utils.Unmarshal))
+	require.NoError(t, err)
+
+	deviceID := "4f2b2b10-7f1c-4c5a-9c5e-3a0d0e2d4b1f"
+	light := commands.NewResourceID(deviceID, "/light/1")
+	trimmed := commands.NewResourceID(deviceID, "/trimmed")
+	t0 := time.Unix(1000, 0)
+	t1 := t0.Add(time.Minute)
+	t2 := t1.Add(time.Minute)
+
+	save := func(evs ...eventstore.Event) {
+		s, errS := store.Save(ctx, evs...)
+		require.NoError(t, errS)
+		require.Equal(t, eventstore.Ok, s)
+	}
+	save(&events.ResourceLinksPublished{
+		DeviceId: deviceID,
+		Resources: []*commands.Resource{
+			{DeviceId: deviceID, Href: light.GetHref(), ResourceTypes: []string{"oic.r.light"}},
+			{DeviceId: deviceID, Href: trimmed.GetHref(), ResourceTypes: []string{"oic.r.trimmed"}},
+		},
+		EventMetadata: &events.EventMetadata{Version: 0, Timestamp: pkgTime.UnixNano(t0)},
+	})
+	save(makeResourceChanged(light, "off", 0, t0))
+	save(makeResourceChanged(light, "on", 1, t1))
+	save(makeResourceChanged(trimmed, "initial", 0, t0))
+	save(&events.ResourceStateSnapshotTaken{
+		ResourceId:           trimmed,
+		LatestResourceChange: makeResourceChanged(trimmed, "trimmed", 0, t0),
+		EventMetadata:        &events.EventMetadata{Version: 1, Timestamp: pkgTime.UnixNano(t2)},
+	})
+
+	getContent := func(twin *twinAsOf, hrefFilter map[string]bool) map[string]string {
+		contents := make(map[string]string)
+		err := twin.iterateResources(deviceID, resourceFilter{hrefFilter: hrefFilter}, func(r *Resource) error {
+			contents[r.Resource.GetHref()] = string(r.GetContent().GetData())
+			return nil
+		})
+		require.NoError(t, err)
+		return contents
+	}
+	lightQuery := []eventstore.GetEventsQuery{
+		{GroupID: deviceID, AggregateID: commands.MakeLinksResourceUUID(deviceID).String()},
+		{GroupID: deviceID, AggregateID: light.ToUUID().String()},
+	}
+
+	twin, err := loadTwinAsOf(ctx, store, lightQuery, pkgTime.UnixNano(t0)-1)
+	require.NoError(t, err)
+	require.Nil(t, twin.resourceLinks(deviceID))
+	require.Empty(t, getContent(twin, nil))
+
+	twin, err = loadTwinAsOf(ctx, store, lightQuery, pkgTime.UnixNano(t0))
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{light.GetHref(): "off"}, getContent(twin, nil))
+
+	twin, err = loadTwinAsOf(ctx, store, lightQuery, pkgTime.UnixNano(t1)+1)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{light.GetHref(): "on"}, getContent(twin, nil))
+
+	twin, err = loadTwinAsOf(ctx, store, []eventstore.GetEventsQuery{{GroupID: deviceID}}, pkgTime.UnixNano(t2))
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{light.GetHref(): "on", trimmed.GetHref(): "trimmed"}, getContent(twin, nil))
+	require.Equal(t, map[string]string{trimmed.GetHref(): "trimmed"}, getContent(twin, map[string]bool{trimmed.GetHref(): true}))
+
+	// the events stored before the latest snapshot are loaded
+	twin, err = loadTwinAsOf(ctx, store, []eventstore.GetEventsQuery{{GroupID: deviceID}}, pkgTime.UnixNano(t1))
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{light.GetHref(): "on", trimmed.GetHref(): "initial"}, getContent(twin, nil))
+
+	// events of the trimmed resource before the snapshot are not retained
+	err = store.RemoveUpToVersion(ctx, []eventstore.VersionQuery{{GroupID: deviceID, AggregateID: trimmed.ToUUID().String(), Version: 1}})
+	require.NoError(t, err)
+	_, err = loadTwinAsOf(ctx, store, []eventstore.GetEventsQuery{{GroupID: deviceID}}, pkgTime.UnixNano(t1))
+	require.Error(t, err)
+	require.Equal(t, codes.OutOfRange, status.Code(err))
+}
+
+func TestLoadDevicesTwinAsOf(t *testing.T) {
+	ctx := context.Background()
+	store, err := memory.New(ctx, &memory.Config{}, log.NewLogger(log.MakeDefaultConfig()), memory.WithMarshaler(utils.Marshal), memory.WithUnmarshaler(utils.Unmarshal))
+	require.NoError(t, err)
+
+	deviceIDs := []string{"4f2b2b10-7f1c-4c5a-9c5e-3a0d0e2d4b1f", "5a3c3c20-8f2d-4d6b-8d6f-4b1e1f3e5c2a"}
+	t0 := time.Unix(1000, 0)
+	for _, deviceID := range deviceIDs {
+		light := commands.NewResourceID(deviceID, "/light/1")
+		s, errS := store.Save(ctx, &events.ResourceLinksPublished{
+			DeviceId:      deviceID,
+			Resources:     []*commands.Resource{{DeviceId: deviceID, Href: light.GetHref()}},
+			EventMetadata: &events.EventMetadata{Version: 0, Timestamp: pkgTime.UnixNano(t0)},
+		})
+		require.NoError(t, errS)
+		require.Equal(t, eventstore.Ok, s)
+		s, errS = store.Save(ctx, makeResourceChanged(light, deviceID, 0, t0))
+		require.NoError(t, errS)
+		require.Equal(t, eventstore.Ok, s)
+	}
+
+	// the aggregates of all devices are loaded by one query
+	twin, err := loadDevicesTwinAsOf(ctx, store, strings.MakeSet(deviceIDs...), nil, pkgTime.UnixNano(t0))
+	require.NoError(t, err)
+	for _, deviceID := range deviceIDs {
+		contents := make(map[string]string)
+		err = twin.iterateResources(deviceID, resourceFilter{}, func(r *Resource) error {
+			contents[r.Resource.GetHref()] = string(r.GetContent().GetData())
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{"/light/1": deviceID}, contents)
+	}
+
+	// only the selected aggregates are loaded
+	twin, err = loadDevicesTwinAsOf(ctx, store, strings.MakeSet(deviceIDs...), func(deviceID string) []string {
+		return []string{commands.MakeLinksResourceUUID(deviceID).String()}
+	}, pkgTime.UnixNano(t0))
+	require.NoError(t, err)
+	for _, deviceID := range deviceIDs {
+		require.NotNil(t, twin.resourceLinks(deviceID))
+		require.Nil(t, twin.resource(commands.NewResourceID(deviceID, "/light/1")))
+	}
+}
@@ -14,8 +14,9 @@ import (
 	"github.com/plgd-dev/hub/v2/pkg/net/grpc/server"
 	otelClient "github.com/plgd-dev/hub/v2/pkg/opentelemetry/collector/client"
 	pkgTime "github.com/plgd-dev/hub/v2/pkg/time"
-	natsClient "github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/nats/client"
+	eventbusConfig "github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/config"
 	eventstoreConfig "github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore/config"
+	"github.com/plgd-dev/hub/v2/resource-directory/history"
 )
 
 type Config struct {
@@ -59,6 +60,7 @@ func (c *APIsConfig) Validate() error {
 
 type GRPCConfig struct {
 	OwnerCacheExpiration time.Duration `yaml:"ownerCacheExpiration" json:"ownerCacheExpiration"`
+	MaxPageSize          int64         `yaml:"maxPageSize" json:"maxPageSize"`
 	server.Config        `yaml:",inline" json:",inline"`
 }
 
@@ -66,6 +68,9 @@ func (c *GRPCConfig) Validate() error {
 	if c.OwnerCacheExpiration <= 0 {
 		return fmt.Errorf("ownerCacheExpiration('%v')", c.OwnerCacheExpiration)
 	}
+	if c.MaxPageSize <= 0 {
+		return fmt.Errorf("maxPageSize('%v')", c.MaxPageSize)
+	}
 	return c.Config.Validate()
 }
 
@@ -73,6 +78,7 @@ type ClientsConfig struct {
 	Eventbus               EventBusConfig      `yaml:"eventBus" json:"eventBus"`
 	Eventstore             EventStoreConfig    `yaml:"eventStore" json:"eventStore"`
 	IdentityStore          IdentityStoreConfig `yaml:"identityStore" json:"identityStore"`
+	History                history.Config      `yaml:"history" json:"history"`
 	OpenTelemetryCollector otelClient.Config   `yaml:"openTelemetryCollector" json:"openTelemetryCollector"`
 }
 
@@ -86,6 +92,9 @@ func (c *ClientsConfig) Validate() error {
 	if err := c.Eventstore.Validate(); err != nil {
 		return fmt.Errorf("eventstore.%w", err)
 	}
+	if err := c.History.Validate(); err != nil {
+		return fmt.Errorf("history.%w", err)
+	}
 	if err := c.OpenTelemetryCollector.Validate(); err != nil {
 		return fmt.Errorf("openTelemetryCollector.%w", err)
 	}
@@ -93,16 +102,21 @@ func (c *ClientsConfig) Validate() error {
 }
 
 type EventBusConfig struct {
-	GoPoolSize int                         `yaml:"goPoolSize" json:"goPoolSize"`
-	NATS       natsClient.ConfigSubscriber `yaml:"nats" json:"nats"`
+	eventbusConfig.ConfigSubscriber `yaml:",inline" json:",inline"`
+
+	GoPoolSize int `yaml:"goPoolSize" json:"goPoolSize"`
 }
 
 func (c *EventBusConfig) Validate() error {
 	if c.GoPoolSize <= 0 {
 		return fmt.Errorf("goPoolSize('%v')", c.GoPoolSize)
 	}
-	if err := c.NATS.Validate(); err != nil {
-		return fmt.Errorf("nats.%w", err)
+	if err := c.ConfigSubscriber.Validate(); err != nil {
+		return err
+	}
+	// the consumers of the projection are created for each start of the service, they must be removed when inactive
+	if !c.Use.IsKafka() && c.NATS.JetStream.Enabled && c.NATS.JetStream.InactiveThreshold <= 0 {
+		return fmt.Errorf("nats.jetstream.inactiveThreshold('%v')", c.NATS.JetStream.InactiveThreshold)
 	}
 	return nil
 }
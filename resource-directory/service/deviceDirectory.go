@@ -1,6 +1,7 @@
 package service
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
@@ -76,6 +77,8 @@ type Device struct {
 	ResourceChanged *events.ResourceChanged
 	Metadata        *pb.Device_Metadata
 	Endpoints       []*commands.EndpointInformation
+	Labels          map[string]string
+	DisplayName     string
 }
 
 func (d Device) ToProto() *pb.Device {
@@ -88,6 +91,8 @@ func (d Device) ToProto() *pb.Device {
 	r.Metadata = d.Metadata
 	r.Data = d.ResourceChanged
 	r.OwnershipStatus = pb.Device_OWNED
+	r.Labels = d.Labels
+	r.DisplayName = d.DisplayName
 	if len(d.Endpoints) == 0 {
 		return r
 	}
@@ -130,7 +135,34 @@ func filterDevices(deviceIds strings.Set, deviceIDsFilter []string) strings.Set
 	return result
 }
 
-func (dd *DeviceDirectory) sendDevices(deviceIDs strings.Set, req *pb.GetDevicesRequest, srv pb.GrpcGateway_GetDevicesServer, toReloadDevices strings.Set) (err error) {
+func sendDevice(add func(key pageToken, device *pb.Device) error, deviceMetadataUpdated *events.DeviceMetadataUpdated, deviceLabelsUpdated *events.DeviceLabelsUpdated, resource *Resource) error {
+	var device Device
+	err := updateDevice(&device, resource)
+	if err != nil {
+		// device is not valid
+		return nil //nolint:nilerr
+	}
+	device.Metadata = &pb.Device_Metadata{
+		Connection:          deviceMetadataUpdated.GetConnection(),
+		TwinSynchronization: deviceMetadataUpdated.GetTwinSynchronization(),
+		TwinEnabled:         deviceMetadataUpdated.GetTwinEnabled(),
+	}
+	device.Labels = deviceLabelsUpdated.GetLabels()
+	device.DisplayName = deviceLabelsUpdated.GetDisplayName()
+	return add(pageToken{DeviceID: resource.Resource.GetDeviceId()}, device.ToProto())
+}
+
+func newDeviceSender(srv pb.GrpcGateway_GetDevicesServer) func(device *pb.Device) error {
+	return func(device *pb.Device) error {
+		err := srv.Send(device)
+		if err != nil {
+			return status.Errorf(codes.Canceled, "cannot send device: %v", err)
+		}
+		return nil
+	}
+}
+
+func (dd *DeviceDirectory) sendDevices(ctx context.Context, deviceIDs strings.Set, req *pb.GetDevicesRequest, add func(key pageToken, device *pb.Device) error, toReloadDevices strings.Set) (err error) {
 	typeFilter := make(strings.Set)
 	typeFilter.Add(req.GetTypeFilter()...)
 	return dd.projection.LoadDevicesMetadata(deviceIDs, toReloadDevices, func(m *deviceMetadataProjection) error {
@@ -138,45 +170,37 @@ func (dd *DeviceDirectory) sendDevices(deviceIDs strings.Set, req *pb.GetDevices
 		if !hasMatchingStatus(deviceMetadataUpdated.GetConnection().IsOnline(), req.GetStatusFilter()) {
 			return nil
 		}
+		deviceLabelsUpdated := m.GetDeviceLabelsUpdated()
 		resourceIdFilter := []*commands.ResourceId{commands.NewResourceID(m.GetDeviceID(), device.ResourceURI)}
-		return dd.projection.LoadResources(srv.Context(), resourceIdFilter, typeFilter, false, toReloadDevices, func(resource *Resource) error {
-			var device Device
-			err = updateDevice(&device, resource)
-			if err != nil {
-				// device is not valid
-				return nil //nolint:nilerr
-			}
-			device.Metadata = &pb.Device_Metadata{
-				Connection:          deviceMetadataUpdated.GetConnection(),
-				TwinSynchronization: deviceMetadataUpdated.GetTwinSynchronization(),
-				TwinEnabled:         deviceMetadataUpdated.GetTwinEnabled(),
-			}
-			err := srv.Send(device.ToProto())
-			if err != nil {
-				return status.Errorf(codes.Canceled, "cannot send device: %v", err)
-			}
-			return nil
+		return dd.projection.LoadResources(ctx, resourceIdFilter, typeFilter, false, toReloadDevices, func(resource *Resource) error {
+			return sendDevice(add, deviceMetadataUpdated, deviceLabelsUpdated, resource)
 		})
 	})
 }
 
-func (dd *DeviceDirectory) GetDevices(req *pb.GetDevicesRequest, srv pb.GrpcGateway_GetDevicesServer) (err error) {
-	deviceIDs := filterDevices(dd.userDeviceIds, req.GetDeviceIdFilter())
-	if len(deviceIDs) == 0 {
-		log.Debug("DeviceDirectory.GetDevices.filterDevices returns empty deviceIDs")
-		return nil
-	}
-
+func (dd *DeviceDirectory) loadDevices(ctx context.Context, deviceIDs strings.Set, req *pb.GetDevicesRequest, add func(key pageToken, device *pb.Device) error) error {
 	toReloadDevices := make(strings.Set)
-	err = dd.sendDevices(deviceIDs, req, srv, toReloadDevices)
+	err := dd.sendDevices(ctx, deviceIDs, req, add, toReloadDevices)
 	if err != nil {
 		return err
 	}
 
 	if len(toReloadDevices) > 0 {
-		dd.projection.ReloadDevices(srv.Context(), toReloadDevices)
-		return dd.sendDevices(toReloadDevices, req, srv, nil)
+		dd.projection.ReloadDevices(ctx, toReloadDevices)
+		return dd.sendDevices(ctx, toReloadDevices, req, add, nil)
 	}
 
 	return nil
 }
+
+func (dd *DeviceDirectory) GetDevices(req *pb.GetDevicesRequest, srv pb.GrpcGateway_GetDevicesServer) (err error) {
+	deviceIDs := filterDevices(dd.userDeviceIds, req.GetDeviceIdFilter())
+	if len(deviceIDs) == 0 {
+		log.Debug("DeviceDirectory.GetDevices.filterDevices returns empty deviceIDs")
+		return nil
+	}
+
+	return sendPage(srv, req.GetPageSize(), req.GetPageToken(), deviceIDs, func(deviceIDs strings.Set, add func(key pageToken, device *pb.Device) error) error {
+		return dd.loadDevices(srv.Context(), deviceIDs, req, add)
+	}, newDeviceSender(srv))
+}
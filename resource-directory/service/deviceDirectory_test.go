@@ -149,6 +149,8 @@ func TestDeviceDirectoryGetDevices(t *testing.T) {
 	for _, tt := range tests {
 		fn := func(t *testing.T) {
 			var s testGrpcGateway_GetDevicesServer
+			// the page size is set by the RequestHandler
+			tt.args.request.PageSize = 1000
 			err := rd.GetDevices(tt.args.request, &s)
 			if tt.wantErr {
 				require.Error(t, err)
@@ -34,6 +34,12 @@ func (p *deviceMetadataProjection) GetDeviceMetadataUpdated() *events.DeviceMeta
 	return p.private.snapshot.GetDeviceMetadataUpdated()
 }
 
+func (p *deviceMetadataProjection) GetDeviceLabelsUpdated() *events.DeviceLabelsUpdated {
+	p.private.lock.RLock()
+	defer p.private.lock.RUnlock()
+	return p.private.snapshot.GetDeviceLabelsUpdated()
+}
+
 func (p *deviceMetadataProjection) GetDeviceUpdatePendings(now time.Time) []*events.DeviceMetadataUpdatePending {
 	updatePendings := make([]*events.DeviceMetadataUpdatePending, 0, 4)
 	p.private.lock.RLock()
@@ -93,6 +99,12 @@ func (p *deviceMetadataProjection) handleEventLocked(ctx context.Context, eu eve
 		if _, err := p.private.snapshot.HandleDeviceMetadataUpdated(ctx, &e, false); err != nil {
 			return nil //nolint:nilerr
 		}
+	case (&events.DeviceLabelsUpdated{}).EventType():
+		var e events.DeviceLabelsUpdated
+		if err := eu.Unmarshal(&e); err != nil {
+			return err
+		}
+		_ = p.private.snapshot.HandleDeviceLabelsUpdated(ctx, &e)
 	}
 	return nil
 }
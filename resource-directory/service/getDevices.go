@@ -2,6 +2,7 @@ package service
 
 import (
 	"github.com/plgd-dev/hub/v2/grpc-gateway/pb"
+	"github.com/plgd-dev/hub/v2/pkg/labels"
 	"github.com/plgd-dev/hub/v2/pkg/log"
 	kitNetGrpc "github.com/plgd-dev/hub/v2/pkg/net/grpc"
 	"google.golang.org/grpc/codes"
@@ -13,13 +14,25 @@ func (r *RequestHandler) GetDevices(req *pb.GetDevicesRequest, srv pb.GrpcGatewa
 	if err != nil {
 		return log.LogAndReturnError(status.Errorf(codes.Unauthenticated, "cannot get devices: %v", err))
 	}
+	if err = validatePageSize(req.GetPageSize(), r.maxPageSize); err != nil {
+		return log.LogAndReturnError(status.Errorf(codes.InvalidArgument, "cannot get devices: %v", err))
+	}
+	req.PageSize = r.pageSize(req.GetPageSize())
+	selector, err := labels.Parse(req.GetLabelSelector())
+	if err != nil {
+		return log.LogAndReturnError(status.Errorf(codes.InvalidArgument, "cannot get devices: %v", err))
+	}
 	deviceIDs, err := r.getOwnerDevices(srv.Context())
 	if err != nil {
 		return log.LogAndReturnError(status.Errorf(status.Convert(err).Code(), "cannot get devices contents: %v", err))
 	}
 
-	rd := NewDeviceDirectory(r.resourceProjection, deviceIDs)
-	err = rd.GetDevices(req, srv)
+	if req.GetAsOf() > 0 {
+		err = r.getDevicesAsOf(req, srv, deviceIDs, selector)
+	} else {
+		deviceIDs = r.resourceProjection.FilterDevicesByLabels(srv.Context(), deviceIDs, selector)
+		err = NewDeviceDirectory(r.resourceProjection, deviceIDs).GetDevices(req, srv)
+	}
 	if err != nil {
 		return log.LogAndReturnError(err)
 	}
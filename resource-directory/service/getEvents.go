@@ -14,10 +14,6 @@ import (
 	"google.golang.org/grpc/status"
 )
 
-type resourceEvent struct {
-	srv pb.GrpcGateway_GetEventsServer
-}
-
 type resourceEventHandler func(eventstore.EventUnmarshaler) *pb.GetEventsResponse
 
 func logErrUnmarshal(eu eventstore.EventUnmarshaler, err error) {
@@ -219,6 +215,19 @@ func handleDeviceMetadataUpdated(eu eventstore.EventUnmarshaler) *pb.GetEventsRe
 	}
 }
 
+func handleDeviceLabelsUpdated(eu eventstore.EventUnmarshaler) *pb.GetEventsResponse {
+	var e events.DeviceLabelsUpdated
+	if err := eu.Unmarshal(&e); err != nil {
+		logErrUnmarshal(eu, err)
+		return nil
+	}
+	return &pb.GetEventsResponse{
+		Type: &pb.GetEventsResponse_DeviceLabelsUpdated{
+			DeviceLabelsUpdated: &e,
+		},
+	}
+}
+
 func handleDeviceMetadataSnapshotTaken(eu eventstore.EventUnmarshaler) *pb.GetEventsResponse {
 	var e events.DeviceMetadataSnapshotTaken
 	if err := eu.Unmarshal(&e); err != nil {
@@ -249,6 +258,7 @@ var eventTypeToEventHandler = map[string]resourceEventHandler{
 	(&events.DeviceMetadataUpdatePending{}).EventType(): handleDeviceMetadataUpdatePending,
 	(&events.DeviceMetadataUpdated{}).EventType():       handleDeviceMetadataUpdated,
 	(&events.DeviceMetadataSnapshotTaken{}).EventType(): handleDeviceMetadataSnapshotTaken,
+	(&events.DeviceLabelsUpdated{}).EventType():         handleDeviceLabelsUpdated,
 }
 
 func handleEvent(eu eventstore.EventUnmarshaler) *pb.GetEventsResponse {
@@ -261,9 +271,14 @@ func handleEvent(eu eventstore.EventUnmarshaler) *pb.GetEventsResponse {
 	return handler(eu)
 }
 
-func (p *resourceEvent) Handle(ctx context.Context, iter eventstore.Iter) error {
-	log.Debug("resourceEvent.Handle")
+// pagedResourceEvent adds the events to the page.
+type pagedResourceEvent struct {
+	page *pager[*pb.GetEventsResponse]
+	last *eventstore.EventPosition
+	read int64
+}
 
+func (p *pagedResourceEvent) Handle(ctx context.Context, iter eventstore.Iter) error {
 	for {
 		eu, ok := iter.Next(ctx)
 		if !ok {
@@ -272,16 +287,48 @@ func (p *resourceEvent) Handle(ctx context.Context, iter eventstore.Iter) error
 		if eu.EventType() == "" {
 			return errors.New("cannot determine type of event")
 		}
+		p.read++
+		p.last = &eventstore.EventPosition{AggregateID: eu.AggregateID(), Version: eu.Version()}
 		resp := handleEvent(eu)
 		if resp == nil {
 			continue
 		}
-		if err := p.srv.Send(resp); err != nil {
+		p.page.add(pageToken{AggregateID: eu.AggregateID(), Version: eu.Version()}, resp)
+	}
+	return iter.Err()
+}
+
+func (r *RequestHandler) getEventsPage(req *pb.GetEventsRequest, srv pb.GrpcGateway_GetEventsServer, queries []eventstore.GetEventsQuery) error {
+	p, err := newPager[*pb.GetEventsResponse](req.GetPageSize(), req.GetPageToken())
+	if err != nil {
+		return err
+	}
+	pagedStore, ok := r.eventStore.(eventstore.PagedEventStore)
+	if !ok {
+		// the eventstore doesn't support the pages, so all events are sorted by the pager
+		if err = r.eventStore.GetEvents(srv.Context(), queries, req.GetTimestampFilter(), &pagedResourceEvent{page: p}); err != nil {
+			return err
+		}
+		return p.send(srv, srv.Send)
+	}
+	var after *eventstore.EventPosition
+	if p.after != nil {
+		after = &eventstore.EventPosition{AggregateID: p.after.AggregateID, Version: p.after.Version}
+	}
+	// some events are not sent, so the events are loaded until the page is full
+	for !p.full() {
+		h := pagedResourceEvent{page: p}
+		limit := p.missing()
+		err = pagedStore.GetEventsPage(srv.Context(), queries, req.GetTimestampFilter(), eventstore.EventsPage{After: after, Limit: limit}, &h)
+		if err != nil {
 			return err
 		}
+		if h.read < limit {
+			break
+		}
+		after = h.last
 	}
-
-	return iter.Err()
+	return p.send(srv, srv.Send)
 }
 
 func getDeviceQueries(deviceIDFilter []string, userDeviceIDs strings.Set) []eventstore.GetEventsQuery {
@@ -328,6 +375,10 @@ func (r *RequestHandler) GetEvents(req *pb.GetEventsRequest, srv pb.GrpcGateway_
 	if err != nil {
 		return log.LogAndReturnError(status.Errorf(codes.Unauthenticated, "cannot get owner: %v", err))
 	}
+	if err = validatePageSize(req.GetPageSize(), r.maxPageSize); err != nil {
+		return log.LogAndReturnError(status.Errorf(codes.InvalidArgument, "cannot get events: %v", err))
+	}
+	req.PageSize = r.pageSize(req.GetPageSize())
 	userDeviceIDs, err := r.getOwnerDevices(srv.Context())
 	if err != nil {
 		return log.LogAndReturnError(status.Errorf(status.Convert(err).Code(), "cannot get owned devices: %v", err))
@@ -356,8 +407,7 @@ func (r *RequestHandler) GetEvents(req *pb.GetEventsRequest, srv pb.GrpcGateway_
 		}
 	}
 
-	err = r.eventStore.GetEvents(srv.Context(), queries, req.GetTimestampFilter(), &resourceEvent{srv: srv})
-	if err != nil {
+	if err = r.getEventsPage(req, srv, queries); err != nil {
 		return log.LogAndReturnError(status.Errorf(status.Convert(err).Code(), "cannot get events: %v", err))
 	}
 	return nil
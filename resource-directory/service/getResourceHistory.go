@@ -0,0 +1,101 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/plgd-dev/hub/v2/grpc-gateway/pb"
+	"github.com/plgd-dev/hub/v2/pkg/log"
+	kitNetGrpc "github.com/plgd-dev/hub/v2/pkg/net/grpc"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/events"
+	"github.com/plgd-dev/hub/v2/resource-directory/history"
+	"github.com/plgd-dev/kit/v2/strings"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errStopLoading stops loading of the values when the limit of the buckets is reached.
+var errStopLoading = errors.New("limit reached")
+
+func validateGetResourceHistoryRequest(req *pb.GetResourceHistoryRequest) error {
+	if req.GetResourceId().GetDeviceId() == "" || req.GetResourceId().GetHref() == "" {
+		return fmt.Errorf("invalid resourceId('%v')", req.GetResourceId())
+	}
+	if req.GetTimeTo() > 0 && req.GetTimeTo() <= req.GetTimeFrom() {
+		return fmt.Errorf("invalid time range timeFrom('%v') timeTo('%v')", req.GetTimeFrom(), req.GetTimeTo())
+	}
+	if req.GetDownsampling() != nil && req.GetDownsampling().GetBucketDuration() <= 0 {
+		return fmt.Errorf("invalid downsampling.bucketDuration('%v')", req.GetDownsampling().GetBucketDuration())
+	}
+	return nil
+}
+
+func (r *RequestHandler) sendResourceHistoryBuckets(req *pb.GetResourceHistoryRequest, srv pb.GrpcGateway_GetResourceHistoryServer, query history.Query) error {
+	d := history.NewDownsampler(req.GetDownsampling().GetBucketDuration(), req.GetDownsampling().GetProperties())
+	var sent uint32
+	send := func(bucket *pb.ResourceHistoryBucket) error {
+		if bucket == nil || (req.GetLimit() > 0 && sent >= req.GetLimit()) {
+			return nil
+		}
+		sent++
+		if err := srv.Send(&pb.GetResourceHistoryResponse{Type: &pb.GetResourceHistoryResponse_Bucket{Bucket: bucket}}); err != nil {
+			return status.Errorf(codes.Canceled, "cannot send resource history bucket: %v", err)
+		}
+		return nil
+	}
+	err := r.historyStore.Load(srv.Context(), query, func(ev *events.ResourceChanged) error {
+		if req.GetLimit() > 0 && sent >= req.GetLimit() {
+			return errStopLoading
+		}
+		return send(d.Add(ev))
+	})
+	if err != nil && !errors.Is(err, errStopLoading) {
+		return err
+	}
+	return send(d.Flush())
+}
+
+func (r *RequestHandler) sendResourceHistoryValues(srv pb.GrpcGateway_GetResourceHistoryServer, query history.Query) error {
+	return r.historyStore.Load(srv.Context(), query, func(ev *events.ResourceChanged) error {
+		if err := srv.Send(&pb.GetResourceHistoryResponse{Type: &pb.GetResourceHistoryResponse_Value{Value: ev}}); err != nil {
+			return status.Errorf(codes.Canceled, "cannot send resource history value: %v", err)
+		}
+		return nil
+	})
+}
+
+func (r *RequestHandler) GetResourceHistory(req *pb.GetResourceHistoryRequest, srv pb.GrpcGateway_GetResourceHistoryServer) error {
+	if r.historyStore == nil {
+		return log.LogAndReturnError(status.Errorf(codes.Unimplemented, "cannot get resource history: history is disabled"))
+	}
+	_, err := kitNetGrpc.OwnerFromTokenMD(srv.Context(), r.ownerCache.OwnerClaim())
+	if err != nil {
+		return log.LogAndReturnError(kitNetGrpc.ForwardErrorf(codes.Unauthenticated, "cannot get resource history: %v", err))
+	}
+	if err = validateGetResourceHistoryRequest(req); err != nil {
+		return log.LogAndReturnError(status.Errorf(codes.InvalidArgument, "cannot get resource history: %v", err))
+	}
+	deviceIDs, err := r.getOwnerDevices(srv.Context())
+	if err != nil {
+		return log.LogAndReturnError(status.Errorf(status.Convert(err).Code(), "cannot get resource history: %v", err))
+	}
+	if len(filterDevices(strings.MakeSet(deviceIDs...), []string{req.GetResourceId().GetDeviceId()})) == 0 {
+		return log.LogAndReturnError(status.Errorf(codes.NotFound, "cannot get resource history: device %v not found", req.GetResourceId().GetDeviceId()))
+	}
+
+	query := history.Query{
+		ResourceID: req.GetResourceId(),
+		TimeFrom:   req.GetTimeFrom(),
+		TimeTo:     req.GetTimeTo(),
+	}
+	if req.GetDownsampling() != nil {
+		err = r.sendResourceHistoryBuckets(req, srv, query)
+	} else {
+		query.Limit = int64(req.GetLimit())
+		err = r.sendResourceHistoryValues(srv, query)
+	}
+	if err != nil {
+		return log.LogAndReturnError(kitNetGrpc.ForwardErrorf(codes.Internal, "cannot get resource history: %v", err))
+	}
+	return nil
+}
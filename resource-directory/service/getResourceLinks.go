@@ -13,13 +13,20 @@ func (r *RequestHandler) GetResourceLinks(req *pb.GetResourceLinksRequest, srv p
 	if err != nil {
 		return log.LogAndReturnError(kitNetGrpc.ForwardErrorf(codes.Unauthenticated, "cannot get resource links: %v", err))
 	}
+	if err = validatePageSize(req.GetPageSize(), r.maxPageSize); err != nil {
+		return log.LogAndReturnError(status.Errorf(codes.InvalidArgument, "cannot get resource links: %v", err))
+	}
+	req.PageSize = r.pageSize(req.GetPageSize())
 	deviceIDs, err := r.getOwnerDevices(srv.Context())
 	if err != nil {
 		return log.LogAndReturnError(status.Errorf(status.Convert(err).Code(), "cannot get resource links: %v", err))
 	}
 
-	rd := NewResourceDirectory(r.resourceProjection, deviceIDs)
-	err = rd.GetResourceLinks(req, srv)
+	if req.GetAsOf() > 0 {
+		err = r.getResourceLinksAsOf(req, srv, deviceIDs)
+	} else {
+		err = NewResourceDirectory(r.resourceProjection, deviceIDs).GetResourceLinks(req, srv)
+	}
 	if err != nil {
 		return log.LogAndReturnError(err)
 	}
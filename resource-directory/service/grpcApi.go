@@ -15,13 +15,18 @@ import (
 	"github.com/plgd-dev/hub/v2/pkg/net/grpc/client"
 	"github.com/plgd-dev/hub/v2/pkg/net/grpc/server"
 	"github.com/plgd-dev/hub/v2/resource-aggregate/commands"
-	naClient "github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/nats/client"
-	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/nats/subscriber"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus"
+	eventbusConfig "github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/config"
 	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore"
 	eventstoreConfig "github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore/config"
 	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore/cqldb"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore/encryption"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore/memory"
 	mongodb "github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore/mongodb"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore/postgres"
 	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/utils"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/events"
+	"github.com/plgd-dev/hub/v2/resource-directory/history"
 	pbRD "github.com/plgd-dev/hub/v2/resource-directory/pb"
 	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
@@ -34,10 +39,12 @@ type RequestHandler struct {
 
 	resourceProjection  *Projection
 	eventStore          eventstore.EventStore
+	historyStore        *history.Store
 	publicConfiguration PublicConfiguration
 	ownerCache          *clientIS.OwnerCache
 	closeFunc           fn.FuncList
 	hubID               string
+	maxPageSize         int64
 }
 
 func (r *RequestHandler) Close() {
@@ -78,24 +85,79 @@ func newIdentityStoreClient(config IdentityStoreConfig, fileWatcher *fsnotify.Wa
 	return isClient, closeIsClient.ToFunction(), nil
 }
 
-func createEventStore(ctx context.Context, config eventstoreConfig.Config, fileWatcher *fsnotify.Watcher, logger log.Logger, tracerProvider trace.TracerProvider) (eventstore.EventStore, error) {
+// createEventStore creates the eventstore, the encryptor is nil when the encryption is disabled.
+func createEventStore(ctx context.Context, config eventstoreConfig.Config, encryptor *encryption.Encryptor, fileWatcher *fsnotify.Watcher, logger log.Logger, tracerProvider trace.TracerProvider) (eventstore.EventStore, error) {
+	upcastRegistry, err := events.NewUpcastRegistry()
+	if err != nil {
+		return nil, fmt.Errorf("cannot create upcast registry: %w", err)
+	}
+	marshaler, unmarshaler := utils.Marshal, upcastRegistry.Unmarshaler(utils.Unmarshal)
+	if encryptor != nil {
+		marshaler, unmarshaler = encryptor.Marshaler(utils.Marshal), upcastRegistry.Unmarshaler(encryptor.Unmarshaler(utils.Unmarshal))
+	}
 	switch config.Use {
 	case database.MongoDB:
-		s, err := mongodb.New(ctx, config.MongoDB, fileWatcher, logger, tracerProvider, mongodb.WithUnmarshaler(utils.Unmarshal), mongodb.WithMarshaler(utils.Marshal))
+		s, err := mongodb.New(ctx, config.MongoDB, fileWatcher, logger, tracerProvider, mongodb.WithUnmarshaler(unmarshaler), mongodb.WithMarshaler(marshaler))
 		if err != nil {
 			return nil, fmt.Errorf("mongodb: %w", err)
 		}
 		return s, nil
 	case database.CqlDB:
-		s, err := cqldb.New(ctx, config.CqlDB, fileWatcher, logger, tracerProvider, cqldb.WithUnmarshaler(utils.Unmarshal), cqldb.WithMarshaler(utils.Marshal))
+		s, err := cqldb.New(ctx, config.CqlDB, fileWatcher, logger, tracerProvider, cqldb.WithUnmarshaler(unmarshaler), cqldb.WithMarshaler(marshaler))
 		if err != nil {
 			return nil, fmt.Errorf("cqldb: %w", err)
 		}
 		return s, nil
+	case database.PostgreSQL:
+		s, err := postgres.New(ctx, config.PostgreSQL, fileWatcher, logger, tracerProvider, postgres.WithUnmarshaler(unmarshaler), postgres.WithMarshaler(marshaler))
+		if err != nil {
+			return nil, fmt.Errorf("postgres: %w", err)
+		}
+		return s, nil
+	case database.Memory:
+		s, err := memory.Open(ctx, config.Memory, logger, memory.WithUnmarshaler(unmarshaler), memory.WithMarshaler(marshaler))
+		if err != nil {
+			return nil, fmt.Errorf("memory: %w", err)
+		}
+		return s, nil
 	}
 	return nil, fmt.Errorf("invalid eventstore use('%v')", config.Use)
 }
 
+// newHistoryStore creates the history store and subscribes the archiver to the resource changes. When the history
+// is disabled, nil store is returned. The archived content is encrypted by the encryptor of the eventstore, so
+// shredding the data keys of the owner covers the history too.
+func newHistoryStore(ctx context.Context, config history.Config, encryptor *encryption.Encryptor, resourceSubscriber eventbus.Subscriber, fileWatcher *fsnotify.Watcher, logger log.Logger, tracerProvider trace.TracerProvider) (*history.Store, func(), error) {
+	if !config.Enabled {
+		return nil, func() {}, nil
+	}
+	var opts []history.Option
+	if encryptor != nil {
+		opts = append(opts, history.WithMarshaler(encryptor.Marshaler(utils.Marshal)), history.WithUnmarshaler(encryptor.Unmarshaler(utils.Unmarshal)))
+	}
+	store, err := history.NewStore(ctx, &config.MongoDB, fileWatcher, logger, tracerProvider, opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+	closeStore := func() {
+		if errC := store.Close(ctx); errC != nil {
+			logger.Errorf("error occurs during close connection to history store: %w", errC)
+		}
+	}
+	observer, err := resourceSubscriber.Subscribe(ctx, history.SubscriptionID, history.Subjects(resourceSubscriber), history.NewArchiver(store, config.Retention, logger))
+	if err != nil {
+		closeStore()
+		return nil, nil, fmt.Errorf("cannot subscribe to resource changes: %w", err)
+	}
+	return store, func() {
+		// the archiver must be stopped before the store is closed
+		if errC := observer.Close(); errC != nil {
+			logger.Errorf("error occurs during close history subscription: %w", errC)
+		}
+		closeStore()
+	}, nil
+}
+
 func newRequestHandlerFromConfig(ctx context.Context, config Config, publicConfiguration PublicConfiguration, fileWatcher *fsnotify.Watcher, logger log.Logger, tracerProvider trace.TracerProvider, goroutinePoolGo func(func()) error) (*RequestHandler, error) {
 	var closeFunc fn.FuncList
 	if publicConfiguration.CAPool != "" {
@@ -112,7 +174,21 @@ func newRequestHandlerFromConfig(ctx context.Context, config Config, publicConfi
 	}
 	closeFunc.AddFunc(closeIsClient)
 
-	eventstore, err := createEventStore(ctx, config.Clients.Eventstore.Connection, fileWatcher, logger, tracerProvider)
+	var encryptor *encryption.Encryptor
+	if config.Clients.Eventstore.Connection.Encryption.Enabled {
+		encryptor, err = encryption.New(ctx, config.Clients.Eventstore.Connection.Encryption, fileWatcher, logger, tracerProvider)
+		if err != nil {
+			closeFunc.Execute()
+			return nil, fmt.Errorf("cannot create eventstore encryptor: %w", err)
+		}
+		closeFunc.AddFunc(func() {
+			if errC := encryptor.Close(ctx); errC != nil {
+				logger.Errorf("error occurs during close eventstore encryptor: %w", errC)
+			}
+		})
+	}
+
+	eventstore, err := createEventStore(ctx, config.Clients.Eventstore.Connection, encryptor, fileWatcher, logger, tracerProvider)
 	if err != nil {
 		closeFunc.Execute()
 		return nil, fmt.Errorf("cannot create resource eventstore %w", err)
@@ -123,18 +199,16 @@ func newRequestHandlerFromConfig(ctx context.Context, config Config, publicConfi
 		}
 	})
 
-	natsClient, err := naClient.New(config.Clients.Eventbus.NATS.Config, fileWatcher, logger, tracerProvider)
+	projUUID, err := uuid.NewRandom()
 	if err != nil {
 		closeFunc.Execute()
-		return nil, fmt.Errorf("cannot create nats client: %w", err)
+		return nil, fmt.Errorf("cannot create uuid for projection %w", err)
 	}
-	closeFunc.AddFunc(natsClient.Close)
-
-	resourceSubscriber, err := subscriber.New(natsClient.GetConn(),
-		config.Clients.Eventbus.NATS.PendingLimits, config.Clients.Eventbus.NATS.LeadResourceType.IsEnabled(),
-		logger,
-		subscriber.WithGoPool(goroutinePoolGo),
-		subscriber.WithUnmarshaler(utils.Unmarshal),
+	// the projection doesn't miss the events published during the reconnection to the eventbus
+	projectionEventsID, projectionLabelsID := projectionSubscriptionIDs(projUUID.String())
+	resourceSubscriber, err := eventbusConfig.NewSubscriber(config.Clients.Eventbus.ConfigSubscriber, fileWatcher, logger, tracerProvider,
+		eventbusConfig.WithGoPool(goroutinePoolGo),
+		eventbusConfig.WithDurableSubscriptions(history.SubscriptionID, projectionEventsID, projectionLabelsID),
 	)
 	if err != nil {
 		closeFunc.Execute()
@@ -142,21 +216,24 @@ func newRequestHandlerFromConfig(ctx context.Context, config Config, publicConfi
 	}
 	closeFunc.AddFunc(resourceSubscriber.Close)
 
-	mf := NewEventStoreModelFactory()
-	projUUID, err := uuid.NewRandom()
+	historyStore, closeHistory, err := newHistoryStore(ctx, config.Clients.History, encryptor, resourceSubscriber, fileWatcher, logger, tracerProvider)
 	if err != nil {
 		closeFunc.Execute()
-		return nil, fmt.Errorf("cannot create uuid for projection %w", err)
+		return nil, fmt.Errorf("cannot create history store: %w", err)
 	}
+	closeFunc.AddFunc(closeHistory)
+
+	mf := NewEventStoreModelFactory()
 	resourceProjection, err := NewProjection(ctx, projUUID.String(), eventstore, resourceSubscriber, mf, config.Clients.Eventstore.ProjectionCacheExpiration)
 	if err != nil {
 		closeFunc.Execute()
 		return nil, fmt.Errorf("cannot create projection over resource aggregate events: %w", err)
 	}
+	closeFunc.AddFunc(resourceProjection.Close)
 
 	ownerCache := clientIS.NewOwnerCache(config.APIs.GRPC.Authorization.OwnerClaim,
 		config.APIs.GRPC.OwnerCacheExpiration,
-		natsClient.GetConn(),
+		resourceSubscriber,
 		isClient, func(err error) {
 			log.Errorf("ownerCache error: %w", err)
 		},
@@ -166,8 +243,10 @@ func newRequestHandlerFromConfig(ctx context.Context, config Config, publicConfi
 
 	h := NewRequestHandler(
 		config.HubID,
+		config.APIs.GRPC.MaxPageSize,
 		resourceProjection,
 		eventstore,
+		historyStore,
 		publicConfiguration,
 		ownerCache,
 		closeFunc,
@@ -178,16 +257,20 @@ func newRequestHandlerFromConfig(ctx context.Context, config Config, publicConfi
 // NewRequestHandler factory for new RequestHandler.
 func NewRequestHandler(
 	hubID string,
+	maxPageSize int64,
 	resourceProjection *Projection,
 	eventstore eventstore.EventStore,
+	historyStore *history.Store,
 	publicConfiguration PublicConfiguration,
 	ownerCache *clientIS.OwnerCache,
 	closeFunc fn.FuncList,
 ) *RequestHandler {
 	return &RequestHandler{
 		hubID:               hubID,
+		maxPageSize:         maxPageSize,
 		resourceProjection:  resourceProjection,
 		eventStore:          eventstore,
+		historyStore:        historyStore,
 		publicConfiguration: publicConfiguration,
 		ownerCache:          ownerCache,
 		closeFunc:           closeFunc,
@@ -0,0 +1,147 @@
+package service
+
+import (
+	"context"
+	"sync"
+
+	"github.com/plgd-dev/hub/v2/pkg/labels"
+	"github.com/plgd-dev/hub/v2/pkg/log"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/events"
+	"github.com/plgd-dev/kit/v2/strings"
+)
+
+type indexedLabels struct {
+	version uint64
+	labels  map[string]string
+}
+
+// labelIndex indexes the labels of the devices, so the devices can be selected by the label selector
+// without loading them to the projection. The index is updated by DeviceLabelsUpdated events from the eventbus.
+type labelIndex struct {
+	lock    sync.RWMutex
+	devices map[string]indexedLabels          // deviceID -> labels
+	values  map[string]map[string]strings.Set // label key -> label value -> deviceIDs
+}
+
+func newLabelIndex() *labelIndex {
+	return &labelIndex{
+		devices: make(map[string]indexedLabels),
+		values:  make(map[string]map[string]strings.Set),
+	}
+}
+
+func (i *labelIndex) removeLocked(deviceID string) {
+	for k, v := range i.devices[deviceID].labels {
+		deviceIDs := i.values[k][v]
+		delete(deviceIDs, deviceID)
+		if len(deviceIDs) > 0 {
+			continue
+		}
+		delete(i.values[k], v)
+		if len(i.values[k]) == 0 {
+			delete(i.values, k)
+		}
+	}
+	delete(i.devices, deviceID)
+}
+
+// update sets the labels of the device when the version is newer than the indexed one.
+// The version 0 is used for devices without labels.
+func (i *labelIndex) update(deviceID string, version uint64, deviceLabels map[string]string) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	if d, ok := i.devices[deviceID]; ok && d.version >= version {
+		return
+	}
+	i.removeLocked(deviceID)
+	i.devices[deviceID] = indexedLabels{version: version, labels: deviceLabels}
+	for k, v := range deviceLabels {
+		if _, ok := i.values[k]; !ok {
+			i.values[k] = make(map[string]strings.Set)
+		}
+		if _, ok := i.values[k][v]; !ok {
+			i.values[k][v] = make(strings.Set)
+		}
+		i.values[k][v].Add(deviceID)
+	}
+}
+
+// notIndexed returns the devices which are not indexed yet.
+func (i *labelIndex) notIndexed(deviceIDs strings.Set) strings.Set {
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+	res := make(strings.Set)
+	for deviceID := range deviceIDs {
+		if _, ok := i.devices[deviceID]; !ok {
+			res.Add(deviceID)
+		}
+	}
+	return res
+}
+
+// devicesWithRequirementLocked returns the devices which can satisfy the positive requirement.
+func (i *labelIndex) devicesWithRequirementLocked(r labels.Requirement) strings.Set {
+	res := make(strings.Set)
+	for v, deviceIDs := range i.values[r.Key] {
+		if r.Matches(map[string]string{r.Key: v}) {
+			for deviceID := range deviceIDs {
+				res.Add(deviceID)
+			}
+		}
+	}
+	return res
+}
+
+func intersect(a, b strings.Set) strings.Set {
+	if len(a) > len(b) {
+		a, b = b, a
+	}
+	res := make(strings.Set)
+	for v := range a {
+		if b.HasOneOf(v) {
+			res.Add(v)
+		}
+	}
+	return res
+}
+
+// match returns the devices which match the selector. The candidates are narrowed by the positive requirements
+// (=, in, exists) from the index and then checked by the whole selector.
+func (i *labelIndex) match(deviceIDs strings.Set, selector labels.Selector) strings.Set {
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+	candidates := deviceIDs
+	for _, r := range selector {
+		if r.Operator.Positive() {
+			candidates = intersect(candidates, i.devicesWithRequirementLocked(r))
+		}
+	}
+	res := make(strings.Set)
+	for deviceID := range candidates {
+		if selector.Matches(i.devices[deviceID].labels) {
+			res.Add(deviceID)
+		}
+	}
+	return res
+}
+
+// Handle updates the index by DeviceLabelsUpdated events from the eventbus.
+func (i *labelIndex) Handle(ctx context.Context, iter eventbus.Iter) error {
+	for {
+		eu, ok := iter.Next(ctx)
+		if !ok {
+			break
+		}
+		if eu.EventType() != (&events.DeviceLabelsUpdated{}).EventType() {
+			continue
+		}
+		var e events.DeviceLabelsUpdated
+		if err := eu.Unmarshal(&e); err != nil {
+			log.Errorf("cannot unmarshal event %v of device %v: %w", eu.EventType(), eu.GroupID(), err)
+			continue
+		}
+		i.update(e.GetDeviceId(), e.Version(), e.GetLabels())
+	}
+	return iter.Err()
+}
@@ -0,0 +1,58 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/plgd-dev/hub/v2/pkg/labels"
+	"github.com/plgd-dev/kit/v2/strings"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLabelIndexMatch(t *testing.T) {
+	i := newLabelIndex()
+	i.update("d1", 1, map[string]string{"site": "brno", "rack": "r1"})
+	i.update("d2", 1, map[string]string{"site": "brno", "rack": "r2"})
+	i.update("d3", 1, map[string]string{"site": "praha"})
+	i.update("d4", 0, nil)
+
+	all := strings.MakeSet("d1", "d2", "d3", "d4", "d5")
+	tests := []struct {
+		selector string
+		want     strings.Set
+	}{
+		{selector: "site=brno", want: strings.MakeSet("d1", "d2")},
+		{selector: "site=brno,rack!=r1", want: strings.MakeSet("d2")},
+		{selector: "rack in (r1,r2)", want: strings.MakeSet("d1", "d2")},
+		{selector: "rack", want: strings.MakeSet("d1", "d2")},
+		{selector: "!rack", want: strings.MakeSet("d3", "d4", "d5")},
+		{selector: "site notin (brno)", want: strings.MakeSet("d3", "d4", "d5")},
+		{selector: "site=wien", want: strings.MakeSet()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.selector, func(t *testing.T) {
+			s, err := labels.Parse(tt.selector)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, i.match(all, s))
+		})
+	}
+	// only the requested devices are returned
+	s, err := labels.Parse("site=brno")
+	require.NoError(t, err)
+	require.Equal(t, strings.MakeSet("d2"), i.match(strings.MakeSet("d2", "d3"), s))
+}
+
+func TestLabelIndexUpdate(t *testing.T) {
+	i := newLabelIndex()
+	i.update("d1", 2, map[string]string{"site": "brno"})
+	// older version is ignored
+	i.update("d1", 1, map[string]string{"site": "praha"})
+	require.Equal(t, map[string]string{"site": "brno"}, i.devices["d1"].labels)
+
+	i.update("d1", 3, map[string]string{"rack": "r1"})
+	require.Equal(t, map[string]string{"rack": "r1"}, i.devices["d1"].labels)
+	// the previous labels are removed from the index
+	require.NotContains(t, i.values, "site")
+	require.Equal(t, strings.MakeSet("d1"), i.values["rack"]["r1"])
+
+	require.Equal(t, strings.MakeSet("d2"), i.notIndexed(strings.MakeSet("d1", "d2")))
+}
@@ -0,0 +1,196 @@
+package service
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/plgd-dev/hub/v2/grpc-gateway/pb"
+	"github.com/plgd-dev/kit/v2/strings"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// pageToken is the position of the last item of the page. The devices and the resource links are ordered
+// by the deviceID, the resources by the deviceID and the href and the events by the aggregateID and the version.
+type pageToken struct {
+	DeviceID    string `json:"d,omitempty"`
+	Href        string `json:"h,omitempty"`
+	AggregateID string `json:"a,omitempty"`
+	Version     uint64 `json:"v,omitempty"`
+}
+
+func (t pageToken) less(v pageToken) bool {
+	if t.DeviceID != v.DeviceID {
+		return t.DeviceID < v.DeviceID
+	}
+	if t.Href != v.Href {
+		return t.Href < v.Href
+	}
+	if t.AggregateID != v.AggregateID {
+		return t.AggregateID < v.AggregateID
+	}
+	return t.Version < v.Version
+}
+
+func (t pageToken) encode() string {
+	data, err := json.Marshal(t)
+	if err != nil {
+		// pageToken contains only strings and numbers
+		panic(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodePageToken(token string) (*pageToken, error) {
+	if token == "" {
+		return nil, nil //nolint:nilnil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+	}
+	var t pageToken
+	if err = json.Unmarshal(data, &t); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+	}
+	return &t, nil
+}
+
+type pageItem[T any] struct {
+	key   pageToken
+	value T
+}
+
+// pager collects the items of the page. One more item than the page size is collected
+// to find out whether the next page exists.
+type pager[T any] struct {
+	size  int64
+	after *pageToken
+	items []pageItem[T]
+}
+
+// validatePageSize checks that the page size requested by the client doesn't exceed the maximal page size.
+func validatePageSize(pageSize, maxPageSize int64) error {
+	if pageSize < 0 || pageSize > maxPageSize {
+		return fmt.Errorf("invalid page size(%v) - must be between 0 and %v", pageSize, maxPageSize)
+	}
+	return nil
+}
+
+// pageSize returns the page size requested by the client or the maximal page size when it is not set.
+func (r *RequestHandler) pageSize(pageSize int64) int64 {
+	if pageSize == 0 {
+		return r.maxPageSize
+	}
+	return pageSize
+}
+
+func newPager[T any](pageSize int64, pageTokenValue string) (*pager[T], error) {
+	if pageSize < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid page size(%v)", pageSize)
+	}
+	after, err := decodePageToken(pageTokenValue)
+	if err != nil {
+		return nil, err
+	}
+	// the items are appended as they are loaded, the page can be smaller than the page size
+	return &pager[T]{
+		size:  pageSize,
+		after: after,
+	}, nil
+}
+
+// isAfterToken returns true when the item is after the page token.
+func (p *pager[T]) isAfterToken(key pageToken) bool {
+	return p.after == nil || p.after.less(key)
+}
+
+// add adds the item when it is after the page token.
+func (p *pager[T]) add(key pageToken, value T) {
+	if !p.isAfterToken(key) {
+		return
+	}
+	p.items = append(p.items, pageItem[T]{key: key, value: value})
+}
+
+// missing returns the number of items which are needed to fill the page.
+func (p *pager[T]) missing() int64 {
+	return p.size + 1 - int64(len(p.items))
+}
+
+func (p *pager[T]) full() bool {
+	return p.missing() <= 0
+}
+
+// send sends the page sorted by the keys. When the next page exists, its token is set to the response header
+// and the response trailer.
+func (p *pager[T]) send(stream grpc.ServerStream, send func(T) error) error {
+	sort.SliceStable(p.items, func(i, j int) bool {
+		return p.items[i].key.less(p.items[j].key)
+	})
+	items := p.items
+	if int64(len(items)) > p.size {
+		items = items[:p.size]
+		md := metadata.Pairs(pb.NextPageTokenKey, items[len(items)-1].key.encode())
+		if err := stream.SetHeader(md); err != nil {
+			return status.Errorf(codes.Canceled, "cannot set next page token: %v", err)
+		}
+		stream.SetTrailer(md)
+	}
+	for _, item := range items {
+		if err := send(item.value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sortedDeviceIDs returns the device IDs which can contain the items of the page in ascending order.
+func (p *pager[T]) sortedDeviceIDs(deviceIDs strings.Set) []string {
+	res := make([]string, 0, len(deviceIDs))
+	for deviceID := range deviceIDs {
+		if p.after != nil && deviceID < p.after.DeviceID {
+			continue
+		}
+		res = append(res, deviceID)
+	}
+	slices.Sort(res)
+	return res
+}
+
+// loadDevices calls load for the chunks of the devices in ascending order until the page is full.
+func (p *pager[T]) loadDevices(deviceIDs strings.Set, load func(deviceIDs strings.Set) error) error {
+	sorted := p.sortedDeviceIDs(deviceIDs)
+	for len(sorted) > 0 && !p.full() {
+		n := min(int(p.missing()), len(sorted))
+		if err := load(strings.MakeSet(sorted[:n]...)); err != nil {
+			return err
+		}
+		sorted = sorted[n:]
+	}
+	return nil
+}
+
+// sendPage sends the page of the items which load adds for the devices after the page token. The devices
+// are loaded in ascending order until the page is full.
+func sendPage[T any](stream grpc.ServerStream, pageSize int64, pageTokenValue string, deviceIDs strings.Set, load func(deviceIDs strings.Set, add func(key pageToken, value T) error) error, send func(T) error) error {
+	p, err := newPager[T](pageSize, pageTokenValue)
+	if err != nil {
+		return err
+	}
+	err = p.loadDevices(deviceIDs, func(deviceIDs strings.Set) error {
+		return load(deviceIDs, func(key pageToken, value T) error {
+			p.add(key, value)
+			return nil
+		})
+	})
+	if err != nil {
+		return err
+	}
+	return p.send(stream, send)
+}
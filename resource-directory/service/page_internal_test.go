@@ -0,0 +1,126 @@
+package service
+
+import (
+	"math"
+	"testing"
+
+	"github.com/plgd-dev/hub/v2/grpc-gateway/pb"
+	"github.com/plgd-dev/kit/v2/strings"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type headerServerStream struct {
+	grpc.ServerStream
+	header  metadata.MD
+	trailer metadata.MD
+}
+
+func (s *headerServerStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func (s *headerServerStream) SetTrailer(md metadata.MD) {
+	s.trailer = metadata.Join(s.trailer, md)
+}
+
+func TestPageToken(t *testing.T) {
+	token := pageToken{DeviceID: "d", Href: "/light/1"}
+	got, err := decodePageToken(token.encode())
+	require.NoError(t, err)
+	require.Equal(t, token, *got)
+
+	got, err = decodePageToken("")
+	require.NoError(t, err)
+	require.Nil(t, got)
+
+	_, err = decodePageToken("invalid token")
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = newPager[string](-1, "")
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestValidatePageSize(t *testing.T) {
+	require.NoError(t, validatePageSize(0, 100))
+	require.NoError(t, validatePageSize(100, 100))
+	require.Error(t, validatePageSize(-1, 100))
+	require.Error(t, validatePageSize(101, 100))
+	require.Error(t, validatePageSize(1<<40, 100))
+	require.Error(t, validatePageSize(math.MaxInt64, 100))
+
+	r := &RequestHandler{maxPageSize: 100}
+	require.Equal(t, int64(100), r.pageSize(0))
+	require.Equal(t, int64(5), r.pageSize(5))
+}
+
+func TestSendPageHugePageSize(t *testing.T) {
+	deviceIDs := strings.MakeSet("b", "a")
+	var got []string
+	stream := &headerServerStream{}
+	// the page isn't preallocated by the page size
+	err := sendPage(stream, 1<<40, "", deviceIDs, func(deviceIDs strings.Set, add func(key pageToken, value string) error) error {
+		for deviceID := range deviceIDs {
+			if err := add(pageToken{DeviceID: deviceID}, deviceID); err != nil {
+				return err
+			}
+		}
+		return nil
+	}, func(v string) error {
+		got = append(got, v)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b"}, got)
+	require.Empty(t, pb.GetNextPageToken(stream.header))
+	require.Empty(t, pb.GetNextPageToken(stream.trailer))
+}
+
+func TestSendPage(t *testing.T) {
+	// each device has two resources, the device "c" has no resource
+	deviceIDs := strings.MakeSet("e", "a", "d", "b", "c")
+	loaded := make(strings.Set)
+	load := func(deviceIDs strings.Set, add func(key pageToken, value string) error) error {
+		for deviceID := range deviceIDs {
+			loaded.Add(deviceID)
+			if deviceID == "c" {
+				continue
+			}
+			for _, href := range []string{"/2", "/1"} {
+				if err := add(pageToken{DeviceID: deviceID, Href: href}, deviceID+href); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+
+	var got []string
+	var token string
+	pages := 0
+	for {
+		loaded = make(strings.Set)
+		var page []string
+		stream := &headerServerStream{}
+		err := sendPage(stream, 3, token, deviceIDs, load, func(v string) error {
+			page = append(page, v)
+			return nil
+		})
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(page), 3)
+		// the devices after the page are not loaded
+		require.Less(t, len(loaded), len(deviceIDs))
+		got = append(got, page...)
+		pages++
+		token = pb.GetNextPageToken(stream.trailer)
+		require.Equal(t, token, pb.GetNextPageToken(stream.header))
+		if token == "" {
+			break
+		}
+	}
+	require.Equal(t, 3, pages)
+	require.Equal(t, []string{"a/1", "a/2", "b/1", "b/2", "d/1", "d/2", "e/1", "e/2"}, got)
+}
@@ -7,11 +7,13 @@ import (
 
 	cache "github.com/plgd-dev/go-coap/v3/pkg/cache"
 	"github.com/plgd-dev/go-coap/v3/pkg/runner/periodic"
+	"github.com/plgd-dev/hub/v2/pkg/labels"
 	"github.com/plgd-dev/hub/v2/pkg/log"
 	"github.com/plgd-dev/hub/v2/resource-aggregate/commands"
 	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus"
 	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore"
 	projectionRA "github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/projection"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/utils"
 	"github.com/plgd-dev/hub/v2/resource-aggregate/events"
 	"github.com/plgd-dev/kit/v2/strings"
 )
@@ -30,8 +32,17 @@ func hasMatchingType(resourceTypes []string, typeFilter strings.Set) bool {
 
 type Projection struct {
 	*projectionRA.Projection
-	expiration time.Duration
-	cache      *cache.Cache[string, string]
+	expiration     time.Duration
+	cache          *cache.Cache[string, string]
+	labelIndex     *labelIndex
+	labelsObserver eventbus.Observer
+}
+
+// projectionSubscriptionIDs returns the subscription IDs of the projection. The name of the projection is unique for
+// each instance of the service, so each instance receives all events.
+func projectionSubscriptionIDs(name string) (events, labels string) {
+	// the names of the JetStream consumers cannot contain dots
+	return name, name + "-labels"
 }
 
 func NewProjection(ctx context.Context, name string, store eventstore.EventStore, subscriber eventbus.Subscriber, newModelFunc eventstore.FactoryModelFunc, expiration time.Duration) (*Projection, error) {
@@ -52,7 +63,47 @@ func NewProjection(ctx context.Context, name string, store eventstore.EventStore
 		cache.CheckExpirations(now)
 		return true
 	})
-	return &Projection{Projection: projection, cache: cache, expiration: expiration}, nil
+	labelIndex := newLabelIndex()
+	// the labels of all devices are indexed, so the devices don't need to be loaded to select them by labels
+	_, labelsSubscriptionID := projectionSubscriptionIDs(name)
+	labelsObserver, err := subscriber.Subscribe(ctx, labelsSubscriptionID, utils.GetDeviceMetadataEventSubject("*", "*", (&events.DeviceLabelsUpdated{}).EventType()), labelIndex)
+	if err != nil {
+		return nil, fmt.Errorf("cannot subscribe to labels of devices: %w", err)
+	}
+	return &Projection{Projection: projection, cache: cache, expiration: expiration, labelIndex: labelIndex, labelsObserver: labelsObserver}, nil
+}
+
+func (p *Projection) Close() {
+	if err := p.labelsObserver.Close(); err != nil {
+		log.Errorf("cannot close subscription to labels of devices: %w", err)
+	}
+}
+
+func (p *Projection) indexDeviceLabels(ctx context.Context, deviceIDs strings.Set) {
+	index := func(m *deviceMetadataProjection) error {
+		l := m.GetDeviceLabelsUpdated()
+		p.labelIndex.update(m.GetDeviceID(), l.Version(), l.GetLabels())
+		return nil
+	}
+	toReloadDevices := make(strings.Set)
+	_ = p.LoadDevicesMetadata(deviceIDs, toReloadDevices, index)
+	if len(toReloadDevices) > 0 {
+		p.ReloadDevices(ctx, toReloadDevices)
+		_ = p.LoadDevicesMetadata(toReloadDevices, nil, index)
+	}
+}
+
+// FilterDevicesByLabels returns the devices which match the label selector. The devices which are not indexed yet
+// are loaded to the projection.
+func (p *Projection) FilterDevicesByLabels(ctx context.Context, deviceIDs []string, selector labels.Selector) []string {
+	if selector.Empty() {
+		return deviceIDs
+	}
+	deviceIDsSet := strings.MakeSet(deviceIDs...)
+	if notIndexed := p.labelIndex.notIndexed(deviceIDsSet); len(notIndexed) > 0 {
+		p.indexDeviceLabels(ctx, notIndexed)
+	}
+	return p.labelIndex.match(deviceIDsSet, selector).ToSlice()
 }
 
 func (p *Projection) LoadResourceLinks(deviceIDFilter, toReloadDevices strings.Set, onResourceLinkProjection func(m *resourceLinksProjection) error) error {
@@ -1,8 +1,11 @@
 package service
 
 import (
+	"context"
+
 	"github.com/plgd-dev/hub/v2/grpc-gateway/pb"
 	"github.com/plgd-dev/hub/v2/pkg/log"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/events"
 	"github.com/plgd-dev/kit/v2/strings"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -20,18 +23,37 @@ func NewResourceDirectory(projection *Projection, deviceIds []string) *ResourceD
 	return &ResourceDirectory{projection: projection, userDeviceIds: mapDeviceIds}
 }
 
-func (rd *ResourceDirectory) sendResourceLinks(srv pb.GrpcGateway_GetResourceLinksServer, deviceIDs, typeFilter, toReloadDevices strings.Set) error {
+func (rd *ResourceDirectory) sendResourceLinks(deviceIDs, typeFilter strings.Set, add func(key pageToken, links *events.ResourceLinksPublished) error, toReloadDevices strings.Set) error {
 	return rd.projection.LoadResourceLinks(deviceIDs, toReloadDevices, func(m *resourceLinksProjection) error {
 		toSend := m.ToResourceLinksPublished(typeFilter)
 		if toSend == nil {
 			return nil
 		}
-		err := srv.Send(toSend)
+		return add(pageToken{DeviceID: m.GetDeviceID()}, toSend)
+	})
+}
+
+func (rd *ResourceDirectory) loadResourceLinks(ctx context.Context, deviceIDs, typeFilter strings.Set, add func(key pageToken, links *events.ResourceLinksPublished) error) error {
+	toReloadDevices := make(strings.Set)
+	err := rd.sendResourceLinks(deviceIDs, typeFilter, add, toReloadDevices)
+	if err != nil {
+		return err
+	}
+	if len(toReloadDevices) > 0 {
+		rd.projection.ReloadDevices(ctx, toReloadDevices)
+		return rd.sendResourceLinks(toReloadDevices, typeFilter, add, nil)
+	}
+	return nil
+}
+
+func newResourceLinksSender(srv pb.GrpcGateway_GetResourceLinksServer) func(links *events.ResourceLinksPublished) error {
+	return func(links *events.ResourceLinksPublished) error {
+		err := srv.Send(links)
 		if err != nil {
 			return status.Errorf(codes.Canceled, "cannot send resource link %v", err)
 		}
 		return nil
-	})
+	}
 }
 
 func (rd *ResourceDirectory) GetResourceLinks(in *pb.GetResourceLinksRequest, srv pb.GrpcGateway_GetResourceLinksServer) error {
@@ -44,15 +66,7 @@ func (rd *ResourceDirectory) GetResourceLinks(in *pb.GetResourceLinksRequest, sr
 	typeFilter := make(strings.Set)
 	typeFilter.Add(in.GetTypeFilter()...)
 
-	toReloadDevices := make(strings.Set)
-	err := rd.sendResourceLinks(srv, deviceIDs, typeFilter, toReloadDevices)
-	if err != nil {
-		return err
-	}
-	if len(toReloadDevices) > 0 {
-		rd.projection.ReloadDevices(srv.Context(), toReloadDevices)
-		return rd.sendResourceLinks(srv, toReloadDevices, typeFilter, nil)
-	}
-
-	return nil
+	return sendPage(srv, in.GetPageSize(), in.GetPageToken(), deviceIDs, func(deviceIDs strings.Set, add func(key pageToken, links *events.ResourceLinksPublished) error) error {
+		return rd.loadResourceLinks(srv.Context(), deviceIDs, typeFilter, add)
+	}, newResourceLinksSender(srv))
 }
@@ -84,6 +84,8 @@ func TestResourceDirectoryGetResourceLinks(t *testing.T) {
 	for _, tt := range tests {
 		fn := func(t *testing.T) {
 			var s testGrpcGateway_GetResourceLinksServer
+			// the page size is set by the RequestHandler
+			tt.args.request.PageSize = 1000
 			err := rd.GetResourceLinks(tt.args.request, &s)
 			require.NoError(t, err)
 			test.CheckProtobufs(t, tt.want, s.got, test.RequireToCheckFunc(require.Equal))
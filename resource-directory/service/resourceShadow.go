@@ -112,16 +112,57 @@ func updateContentIfETagMatched(resourceIDsFilter []*pb.ResourceIdFilter, val *p
 	}
 }
 
-func (rd *ResourceTwin) getResources(resourceIDsFilter []*pb.ResourceIdFilter, typeFilter []string, srv pb.GrpcGateway_GetResourcesServer, toReloadDevices strings.Set) error {
-	return rd.filterResources(srv.Context(), resourceIdFilterToSimple(resourceIDsFilter), typeFilter, false, toReloadDevices, func(resource *Resource) error {
+func resourceKey(val *pb.Resource) pageToken {
+	return pageToken{DeviceID: val.GetData().GetResourceId().GetDeviceId(), Href: val.GetData().GetResourceId().GetHref()}
+}
+
+func (rd *ResourceTwin) getResources(ctx context.Context, resourceIDsFilter []*pb.ResourceIdFilter, typeFilter []string, add func(key pageToken, val *pb.Resource) error, toReloadDevices strings.Set) error {
+	return rd.filterResources(ctx, resourceIdFilterToSimple(resourceIDsFilter), typeFilter, false, toReloadDevices, func(resource *Resource) error {
 		val := toResourceValue(resource)
 		updateContentIfETagMatched(resourceIDsFilter, val)
+		return add(resourceKey(val), val)
+	})
+}
+
+func filterResourceIDsByDevices(resourceIDsFilter []*pb.ResourceIdFilter, deviceIDs strings.Set) []*pb.ResourceIdFilter {
+	newResourceIDsFilter := make([]*pb.ResourceIdFilter, 0, len(resourceIDsFilter))
+	for i := range resourceIDsFilter {
+		if deviceIDs.HasOneOf(resourceIDsFilter[i].GetResourceId().GetDeviceId()) {
+			newResourceIDsFilter = append(newResourceIDsFilter, resourceIDsFilter[i])
+		}
+	}
+	return newResourceIDsFilter
+}
+
+func resourceIDsFilterToDevices(resourceIDsFilter []*pb.ResourceIdFilter) strings.Set {
+	deviceIDs := make(strings.Set)
+	for _, r := range resourceIDsFilter {
+		deviceIDs.Add(r.GetResourceId().GetDeviceId())
+	}
+	return deviceIDs
+}
+
+func (rd *ResourceTwin) loadResources(ctx context.Context, resourceIDsFilter []*pb.ResourceIdFilter, typeFilter []string, add func(key pageToken, val *pb.Resource) error) error {
+	toReloadDevices := make(strings.Set)
+	err := rd.getResources(ctx, resourceIDsFilter, typeFilter, add, toReloadDevices)
+	if err != nil {
+		return err
+	}
+	if len(toReloadDevices) > 0 {
+		rd.projection.ReloadDevices(ctx, toReloadDevices)
+		return rd.getResources(ctx, filterResourceIDsByDevices(resourceIDsFilter, toReloadDevices), typeFilter, add, nil)
+	}
+	return nil
+}
+
+func newResourceSender(srv pb.GrpcGateway_GetResourcesServer) func(val *pb.Resource) error {
+	return func(val *pb.Resource) error {
 		err := srv.Send(val)
 		if err != nil {
 			return status.Errorf(codes.Canceled, "cannot send resource value %v: %v", val, err)
 		}
 		return nil
-	})
+	}
 }
 
 func (rd *ResourceTwin) GetResources(req *pb.GetResourcesRequest, srv pb.GrpcGateway_GetResourcesServer) error {
@@ -129,22 +170,9 @@ func (rd *ResourceTwin) GetResources(req *pb.GetResourcesRequest, srv pb.GrpcGat
 	req.ResourceIdFilter = append(req.ResourceIdFilter, req.ConvertHTTPResourceIDFilter()...)
 
 	resourceIDsFilter := rd.convertToResourceIDs(req.GetResourceIdFilter(), req.GetDeviceIdFilter())
-	toReloadDevices := make(strings.Set)
-	err := rd.getResources(resourceIDsFilter, req.GetTypeFilter(), srv, toReloadDevices)
-	if err != nil {
-		return err
-	}
-	if len(toReloadDevices) > 0 {
-		rd.projection.ReloadDevices(srv.Context(), toReloadDevices)
-		newResourceIDsFilter := make([]*pb.ResourceIdFilter, 0, len(resourceIDsFilter))
-		for i := range resourceIDsFilter {
-			if toReloadDevices.HasOneOf(resourceIDsFilter[i].GetResourceId().GetDeviceId()) {
-				newResourceIDsFilter = append(newResourceIDsFilter, resourceIDsFilter[i])
-			}
-		}
-		return rd.getResources(newResourceIDsFilter, req.GetTypeFilter(), srv, nil)
-	}
-	return nil
+	return sendPage(srv, req.GetPageSize(), req.GetPageToken(), resourceIDsFilterToDevices(resourceIDsFilter), func(deviceIDs strings.Set, add func(key pageToken, val *pb.Resource) error) error {
+		return rd.loadResources(srv.Context(), filterResourceIDsByDevices(resourceIDsFilter, deviceIDs), req.GetTypeFilter(), add)
+	}, newResourceSender(srv))
 }
 
 func toPendingCommands(resource *Resource, commandFilter subscription.FilterBitmask, now time.Time) []*pb.PendingCommand {
@@ -218,13 +246,7 @@ func (rd *ResourceTwin) GetPendingCommands(req *pb.GetPendingCommandsRequest, sr
 	}
 	if len(toReloadDevices) > 0 {
 		rd.projection.ReloadDevices(srv.Context(), toReloadDevices)
-		newResourceIDsFilter := make([]*pb.ResourceIdFilter, 0, len(resourceIDsFilter))
-		for i := range resourceIDsFilter {
-			if toReloadDevices.HasOneOf(resourceIDsFilter[i].GetResourceId().GetDeviceId()) {
-				newResourceIDsFilter = append(newResourceIDsFilter, resourceIDsFilter[i])
-			}
-		}
-		return rd.sendPendingCommands(srv, newResourceIDsFilter, req.GetTypeFilter(), filterCmds, req.GetIncludeHiddenResources(), now, nil)
+		return rd.sendPendingCommands(srv, filterResourceIDsByDevices(resourceIDsFilter, toReloadDevices), req.GetTypeFilter(), filterCmds, req.GetIncludeHiddenResources(), now, nil)
 	}
 	return nil
 }
@@ -244,6 +244,8 @@ func TestResourceTwinGetResources(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			fmt.Println(tt.name)
 			var s testGrpcGateway_GetResourcesServer
+			// the page size is set by the RequestHandler
+			tt.args.req.PageSize = 1000
 			err := rd.GetResources(tt.args.req, &s)
 			require.NoError(t, err)
 			test.CheckProtobufs(t, tt.want, s.got, test.RequireToCheckFunc(require.Equal))
@@ -19,6 +19,7 @@ func MakeConfig(t require.TestingT) service.Config {
 
 	cfg.APIs.GRPC.Config = config.MakeGrpcServerConfig(config.RESOURCE_DIRECTORY_HOST)
 	cfg.APIs.GRPC.OwnerCacheExpiration = time.Minute
+	cfg.APIs.GRPC.MaxPageSize = 1000
 
 	cfg.Clients.IdentityStore.Connection = config.MakeGrpcClientConfig(config.IDENTITY_STORE_HOST)
 
@@ -28,6 +29,7 @@ func MakeConfig(t require.TestingT) service.Config {
 	cfg.Clients.Eventstore.Connection.Use = config.ACTIVE_DATABASE()
 	cfg.Clients.Eventstore.Connection.MongoDB = config.MakeEventsStoreMongoDBConfig()
 	cfg.Clients.Eventstore.Connection.CqlDB = config.MakeEventsStoreCqlDBConfig()
+	cfg.Clients.Eventstore.Connection.PostgreSQL = config.MakeEventsStorePostgreSQLConfig()
 	cfg.Clients.Eventstore.ProjectionCacheExpiration = time.Second * 120
 
 	cfg.HubID = config.HubID()
@@ -1,23 +1,56 @@
 package jq
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/itchyny/gojq"
 )
 
-func EvalJQCondition(jq string, v any) (bool, error) {
+// Condition is a compiled jq query which evaluates to a boolean.
+type Condition struct {
+	jq   string
+	code *gojq.Code
+}
+
+// ParseJQCondition parses and compiles the jq query, so it can be evaluated repeatedly.
+func ParseJQCondition(jq string) (*Condition, error) {
 	q, err := gojq.Parse(jq)
 	if err != nil {
-		return false, fmt.Errorf("cannot parse jq query(%v): %w", jq, err)
+		return nil, fmt.Errorf("cannot parse jq query(%v): %w", jq, err)
+	}
+	code, err := gojq.Compile(q)
+	if err != nil {
+		return nil, fmt.Errorf("cannot compile jq query(%v): %w", jq, err)
 	}
-	iter := q.Run(v)
+	return &Condition{
+		jq:   jq,
+		code: code,
+	}, nil
+}
+
+// Eval evaluates the condition for the value. Only the first result of the query is used and the evaluation is
+// canceled with the context, so the caller can bound the evaluation of a query which doesn't terminate.
+func (c *Condition) Eval(ctx context.Context, v any) (bool, error) {
+	iter := c.code.RunWithContext(ctx, v)
 	val, ok := iter.Next()
 	if !ok {
-		return false, fmt.Errorf("jq query(%v) returned no result", jq)
+		return false, fmt.Errorf("jq query(%v) returned no result", c.jq)
+	}
+	if err, ok := val.(error); ok {
+		return false, fmt.Errorf("jq query(%v) failed: %w", c.jq, err)
 	}
 	if result, ok := val.(bool); ok {
 		return result, nil
 	}
-	return false, fmt.Errorf("invalid jq result: %v", val)
+	// the result is not printed, it can be large
+	return false, fmt.Errorf("invalid jq result type: %T", val)
+}
+
+func EvalJQCondition(ctx context.Context, jq string, v any) (bool, error) {
+	c, err := ParseJQCondition(jq)
+	if err != nil {
+		return false, err
+	}
+	return c.Eval(ctx, v)
 }
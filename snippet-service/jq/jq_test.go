@@ -1,7 +1,9 @@
 package jq_test
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/plgd-dev/go-coap/v3/message"
 	"github.com/plgd-dev/hub/v2/resource-aggregate/commands"
@@ -117,7 +119,7 @@ func TestEvalJQCondition(t *testing.T) {
 				err = commands.DecodeContent(tt.content, &json)
 			}
 			require.NoError(t, err)
-			got, err := jq.EvalJQCondition(tt.jq, json)
+			got, err := jq.EvalJQCondition(context.Background(), tt.jq, json)
 			if tt.wantErr {
 				require.Error(t, err)
 				return
@@ -127,3 +129,15 @@ func TestEvalJQCondition(t *testing.T) {
 		})
 	}
 }
+
+func TestEvalJQConditionTimeout(t *testing.T) {
+	c, err := jq.ParseJQCondition("last(range(1e12)) > 0")
+	require.NoError(t, err)
+	timeout := 100 * time.Millisecond
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	start := time.Now()
+	_, err = c.Eval(ctx, map[string]any{})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Less(t, time.Since(start), timeout*10)
+}
@@ -10,7 +10,7 @@ import (
 	grpcClient "github.com/plgd-dev/hub/v2/pkg/net/grpc/client"
 	httpServer "github.com/plgd-dev/hub/v2/pkg/net/http/server"
 	otelClient "github.com/plgd-dev/hub/v2/pkg/opentelemetry/collector/client"
-	natsClient "github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/nats/client"
+	eventbusConfig "github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/config"
 	grpcService "github.com/plgd-dev/hub/v2/snippet-service/service/grpc"
 	storeConfig "github.com/plgd-dev/hub/v2/snippet-service/store/config"
 )
@@ -44,13 +44,14 @@ func (c *APIsConfig) Validate() error {
 }
 
 type EventBusConfig struct {
-	NATS           natsClient.ConfigSubscriber `yaml:"nats" json:"nats"`
-	SubscriptionID string                      `yaml:"subscriptionID" json:"subscriptionID"`
+	eventbusConfig.ConfigSubscriber `yaml:",inline" json:",inline"`
+
+	SubscriptionID string `yaml:"subscriptionID" json:"subscriptionID"`
 }
 
 func (c *EventBusConfig) Validate() error {
-	if err := c.NATS.Validate(); err != nil {
-		return fmt.Errorf("nats.%w", err)
+	if err := c.ConfigSubscriber.Validate(); err != nil {
+		return err
 	}
 	if c.SubscriptionID == "" {
 		return fmt.Errorf("subscriptionID('%v')", c.SubscriptionID)
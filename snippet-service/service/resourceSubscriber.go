@@ -8,32 +8,20 @@ import (
 	"github.com/plgd-dev/hub/v2/pkg/log"
 	"github.com/plgd-dev/hub/v2/resource-aggregate/commands"
 	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus"
-	natsClient "github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/nats/client"
-	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/nats/subscriber"
-	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/utils"
+	eventbusConfig "github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/config"
 	"github.com/plgd-dev/hub/v2/resource-aggregate/events"
 	"go.opentelemetry.io/otel/trace"
 )
 
 type ResourceSubscriber struct {
-	natsClient          *natsClient.Client
 	subscriptionHandler eventbus.Handler
-	subscriber          *subscriber.Subscriber
+	subscriber          eventbusConfig.Subscriber
 	observer            eventbus.Observer
 }
 
-func NewResourceSubscriber(ctx context.Context, config natsClient.ConfigSubscriber, subscriptionID string, fileWatcher *fsnotify.Watcher, logger log.Logger, tracerProvider trace.TracerProvider, handler eventbus.Handler) (*ResourceSubscriber, error) {
-	nats, err := natsClient.New(config.Config, fileWatcher, logger, tracerProvider)
+func NewResourceSubscriber(ctx context.Context, config eventbusConfig.ConfigSubscriber, subscriptionID string, fileWatcher *fsnotify.Watcher, logger log.Logger, tracerProvider trace.TracerProvider, handler eventbus.Handler) (*ResourceSubscriber, error) {
+	subscriber, err := eventbusConfig.NewSubscriber(config, fileWatcher, logger, tracerProvider, eventbusConfig.WithDurableSubscriptions(subscriptionID))
 	if err != nil {
-		return nil, fmt.Errorf("cannot create nats client: %w", err)
-	}
-
-	subscriber, err := subscriber.New(nats.GetConn(),
-		config.PendingLimits, config.LeadResourceType.IsEnabled(),
-		logger,
-		subscriber.WithUnmarshaler(utils.Unmarshal))
-	if err != nil {
-		nats.Close()
 		return nil, fmt.Errorf("cannot create resource subscriber: %w", err)
 	}
 
@@ -43,12 +31,10 @@ func NewResourceSubscriber(ctx context.Context, config natsClient.ConfigSubscrib
 	observer, err := subscriber.Subscribe(ctx, subscriptionID, append(subjectsResourceChanged, subjectsResourceUpdated...), handler)
 	if err != nil {
 		subscriber.Close()
-		nats.Close()
 		return nil, fmt.Errorf("cannot subscribe to resource change events: %w", err)
 	}
 
 	return &ResourceSubscriber{
-		natsClient:          nats,
 		subscriptionHandler: handler,
 		subscriber:          subscriber,
 		observer:            observer,
@@ -58,6 +44,5 @@ func NewResourceSubscriber(ctx context.Context, config natsClient.ConfigSubscrib
 func (r *ResourceSubscriber) Close() error {
 	err := r.observer.Close()
 	r.subscriber.Close()
-	r.natsClient.Close()
 	return err
 }
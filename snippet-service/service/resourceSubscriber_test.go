@@ -64,7 +64,7 @@ func TestResourceSubscriber(t *testing.T) {
 		ch: make(chan *events.ResourceChanged, 8),
 	}
 	cfg := test.MakeConfig(t)
-	rs, err := service.NewResourceSubscriber(ctx, cfg.Clients.EventBus.NATS, cfg.Clients.EventBus.SubscriptionID, fileWatcher, logger, noop.NewTracerProvider(), &h)
+	rs, err := service.NewResourceSubscriber(ctx, cfg.Clients.EventBus.ConfigSubscriber, cfg.Clients.EventBus.SubscriptionID, fileWatcher, logger, noop.NewTracerProvider(), &h)
 	require.NoError(t, err)
 	defer rs.Close()
 
@@ -9,6 +9,7 @@ import (
 	"github.com/plgd-dev/hub/v2/pkg/fsnotify"
 	"github.com/plgd-dev/hub/v2/pkg/log"
 	"github.com/plgd-dev/hub/v2/pkg/mongodb"
+	eventbusConfig "github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/config"
 	"github.com/plgd-dev/hub/v2/snippet-service/service"
 	"github.com/plgd-dev/hub/v2/snippet-service/store"
 	storeConfig "github.com/plgd-dev/hub/v2/snippet-service/store/config"
@@ -51,7 +52,9 @@ func MakeClientsConfig() service.ClientsConfig {
 		Storage:                MakeStoreConfig(),
 		OpenTelemetryCollector: config.MakeOpenTelemetryCollectorClient(),
 		EventBus: service.EventBusConfig{
-			NATS:           config.MakeSubscriberConfig(),
+			ConfigSubscriber: eventbusConfig.ConfigSubscriber{
+				NATS: config.MakeSubscriberConfig(),
+			},
 			SubscriptionID: "snippet-service",
 		},
 		ResourceAggregate: MakeResourceAggregateConfig(),
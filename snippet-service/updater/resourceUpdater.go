@@ -473,7 +473,7 @@ func (h *ResourceUpdater) applyConfigurationsByConditions(ctx context.Context, r
 		if jqe == "" {
 			return true
 		}
-		ok, errE := jq.EvalJQCondition(jqe, rcData)
+		ok, errE := jq.EvalJQCondition(ctx, jqe, rcData)
 		if errE != nil {
 			h.logger.Error(errE)
 			return false
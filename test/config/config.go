@@ -22,6 +22,7 @@ import (
 	httpServer "github.com/plgd-dev/hub/v2/pkg/net/http/server"
 	"github.com/plgd-dev/hub/v2/pkg/net/listener"
 	otelClient "github.com/plgd-dev/hub/v2/pkg/opentelemetry/collector/client"
+	pkgPostgres "github.com/plgd-dev/hub/v2/pkg/postgres"
 	"github.com/plgd-dev/hub/v2/pkg/security/certManager/client"
 	"github.com/plgd-dev/hub/v2/pkg/security/certManager/server"
 	"github.com/plgd-dev/hub/v2/pkg/security/jwt/validator"
@@ -31,6 +32,7 @@ import (
 	natsClient "github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/nats/client"
 	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore/cqldb"
 	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore/mongodb"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore/postgres"
 	"github.com/plgd-dev/hub/v2/test/http"
 	"github.com/plgd-dev/hub/v2/test/oauth-server/uri"
 	"github.com/stretchr/testify/require"
@@ -71,6 +73,7 @@ var (
 	KEY_FILE                 = urischeme.URIScheme(os.Getenv("LISTEN_FILE_CERT_DIR_PATH") + "/" + os.Getenv("LISTEN_FILE_CERT_KEY_NAME"))
 	CERT_FILE                = urischeme.URIScheme(os.Getenv("LISTEN_FILE_CERT_DIR_PATH") + "/" + os.Getenv("LISTEN_FILE_CERT_NAME"))
 	MONGODB_URI              = "mongodb://localhost:27017"
+	POSTGRES_URI             = "postgres://postgres@localhost:5432/postgres?sslmode=verify-full"
 	NATS_URL                 = "nats://localhost:4222"
 	OWNER_CLAIM              = "sub"
 	COAP_GATEWAY_UDP_ENABLED = os.Getenv("TEST_COAP_GATEWAY_UDP_ENABLED") == TRUE_STRING
@@ -306,6 +309,23 @@ func MakeEventsStoreCqlDBConfig() *cqldb.Config {
 	}
 }
 
+func MakePostgreSQLConfig() pkgPostgres.Config {
+	return pkgPostgres.Config{
+		URI:             POSTGRES_URI,
+		MaxConns:        16,
+		MaxConnIdleTime: 4 * time.Minute,
+		ConnectTimeout:  time.Second * 10,
+		TLS:             MakeTLSClientConfig(),
+	}
+}
+
+func MakeEventsStorePostgreSQLConfig() *postgres.Config {
+	return &postgres.Config{
+		Table:    "events",
+		Embedded: MakePostgreSQLConfig(),
+	}
+}
+
 func MakeValidatorConfig() validator.Config {
 	return validator.Config{
 		Audience: http.HTTPS_SCHEME + OAUTH_MANAGER_AUDIENCE,
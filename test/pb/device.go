@@ -74,9 +74,29 @@ func CleanUpDeviceMetadataUpdated(e *events.DeviceMetadataUpdated, resetCorrelat
 
 func CleanUpDeviceMetadataSnapshotTaken(e *events.DeviceMetadataSnapshotTaken, resetCorrelationID bool) {
 	CleanUpDeviceMetadataUpdated(e.GetDeviceMetadataUpdated(), resetCorrelationID)
+	CleanUpDeviceLabelsUpdated(e.GetDeviceLabelsUpdated(), resetCorrelationID)
 	e.EventMetadata = nil
 }
 
+func CleanUpDeviceLabelsUpdated(e *events.DeviceLabelsUpdated, resetCorrelationID bool) *events.DeviceLabelsUpdated {
+	if e == nil {
+		return nil
+	}
+	if e.GetAuditContext() != nil && resetCorrelationID {
+		e.GetAuditContext().CorrelationId = ""
+	}
+	e.EventMetadata = nil
+	e.OpenTelemetryCarrier = nil
+	return e
+}
+
+func CmpDeviceLabelsUpdated(t *testing.T, expected, got *events.DeviceLabelsUpdated) {
+	resetCorrelationID := expected.GetAuditContext().GetCorrelationId() == ""
+	CleanUpDeviceLabelsUpdated(expected, resetCorrelationID)
+	CleanUpDeviceLabelsUpdated(got, resetCorrelationID)
+	test.CheckProtobufs(t, expected, got, test.RequireToCheckFunc(require.Equal))
+}
+
 func CmpDeviceMetadataUpdated(t *testing.T, expected, got *events.DeviceMetadataUpdated) {
 	resetCorrelationID := expected.GetAuditContext().GetCorrelationId() == ""
 	CleanUpDeviceMetadataUpdated(expected, resetCorrelationID)
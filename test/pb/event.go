@@ -93,6 +93,9 @@ var makeEventFromDataFn = map[string]func(e interface{}) *pb.Event{
 	getTypeName(&events.DeviceMetadataUpdated{}): func(e interface{}) *pb.Event {
 		return &pb.Event{Type: &pb.Event_DeviceMetadataUpdated{DeviceMetadataUpdated: e.(*events.DeviceMetadataUpdated)}}
 	},
+	getTypeName(&events.DeviceLabelsUpdated{}): func(e interface{}) *pb.Event {
+		return &pb.Event{Type: &pb.Event_DeviceLabelsUpdated{DeviceLabelsUpdated: e.(*events.DeviceLabelsUpdated)}}
+	},
 }
 
 func getTypeName(v interface{}) string {
@@ -148,6 +151,8 @@ func GetEventID(ev *pb.Event) string {
 		return fmt.Sprintf("%T:%v", ev.GetType(), v.DeviceMetadataUpdatePending.GetDeviceId())
 	case *pb.Event_DeviceMetadataUpdated:
 		return fmt.Sprintf("%T:%v:%v:%v:%v", ev.GetType(), v.DeviceMetadataUpdated.GetDeviceId(), v.DeviceMetadataUpdated.GetTwinEnabled(), v.DeviceMetadataUpdated.GetConnection().GetStatus(), v.DeviceMetadataUpdated.GetTwinSynchronization().GetState())
+	case *pb.Event_DeviceLabelsUpdated:
+		return fmt.Sprintf("%T:%v:%v", ev.GetType(), v.DeviceLabelsUpdated.GetDeviceId(), v.DeviceLabelsUpdated.GetLabels())
 	}
 	return ""
 }
@@ -212,6 +217,9 @@ var cleanupEventFn = map[string]func(ev *pb.Event){
 	getTypeName(&pb.Event_DeviceMetadataUpdated{}): func(ev *pb.Event) {
 		CleanUpDeviceMetadataUpdated(ev.GetDeviceMetadataUpdated(), false)
 	},
+	getTypeName(&pb.Event_DeviceLabelsUpdated{}): func(ev *pb.Event) {
+		CleanUpDeviceLabelsUpdated(ev.GetDeviceLabelsUpdated(), false)
+	},
 }
 
 // Remove fields with unpredictable values
@@ -309,6 +317,9 @@ var compareEventFn = map[string]func(t *testing.T, e, g *pb.Event, cmpInterface
 	getTypeName(&pb.Event_DeviceMetadataUpdated{}): func(t *testing.T, e, g *pb.Event, _ string) {
 		CmpDeviceMetadataUpdated(t, e.GetDeviceMetadataUpdated(), g.GetDeviceMetadataUpdated())
 	},
+	getTypeName(&pb.Event_DeviceLabelsUpdated{}): func(t *testing.T, e, g *pb.Event, _ string) {
+		CmpDeviceLabelsUpdated(t, e.GetDeviceLabelsUpdated(), g.GetDeviceLabelsUpdated())
+	},
 }
 
 func CmpEvent(t *testing.T, expected, got *pb.Event, cmpInterface string) {
@@ -414,6 +425,9 @@ var getWrappedEventFromEventResponseFn = map[string]func(v *pb.GetEventsResponse
 	getTypeName(&pb.GetEventsResponse_DeviceMetadataSnapshotTaken{}): func(v *pb.GetEventsResponse) interface{} {
 		return v.GetDeviceMetadataSnapshotTaken()
 	},
+	getTypeName(&pb.GetEventsResponse_DeviceLabelsUpdated{}): func(v *pb.GetEventsResponse) interface{} {
+		return v.GetDeviceLabelsUpdated()
+	},
 }
 
 func GetWrappedEvent(v *pb.GetEventsResponse) interface{} {
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventbus/pb"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore/upcast"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/utils"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/events"
+)
+
+// decoder decodes archived events to the events of the hub, so the eventstore stores metadata of the events.
+type decoder struct {
+	unmarshal upcast.UnmarshalerFunc
+}
+
+// newDecoder creates the decoder of the events stored by the unmarshal, eg. the decrypting unmarshaler of the encrypted
+// eventstore.
+func newDecoder(unmarshal upcast.UnmarshalerFunc) (*decoder, error) {
+	registry, err := events.NewUpcastRegistry()
+	if err != nil {
+		return nil, err
+	}
+	return &decoder{
+		unmarshal: registry.Unmarshaler(unmarshal),
+	}, nil
+}
+
+func (d *decoder) decodeData(eventType string, data []byte) (events.Event, error) {
+	ev, ok := events.NewEvent(eventType)
+	if !ok {
+		return nil, fmt.Errorf("unknown event type('%v')", eventType)
+	}
+	if err := d.unmarshal(data, ev); err != nil {
+		return nil, err
+	}
+	return ev, nil
+}
+
+// Decrypt implements backup.TransformFunc. The stored event is replaced by the decoded event, so the archive contains
+// the decrypted content which can be restored to the eventstore encrypted by other keys.
+func (d *decoder) Decrypt(e *pb.Event) error {
+	ev, err := d.decodeData(e.GetEventType(), e.GetData())
+	if err != nil {
+		return err
+	}
+	data, err := utils.Marshal(ev)
+	if err != nil {
+		return err
+	}
+	e.Data = data
+	return nil
+}
+
+// Decode implements backup.DecodeFunc.
+func (d *decoder) Decode(e *pb.Event, version uint64) (eventstore.Event, error) {
+	ev, err := d.decodeData(e.GetEventType(), e.GetData())
+	if err != nil {
+		return nil, err
+	}
+	if ev.GetEventMetadata() == nil {
+		return nil, fmt.Errorf("event('%v', %v) has no metadata", e.GetAggregateId(), e.GetVersion())
+	}
+	ev.GetEventMetadata().Version = version
+	return ev, nil
+}
@@ -0,0 +1,262 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	flags "github.com/jessevdk/go-flags"
+	"github.com/plgd-dev/hub/v2/pkg/build"
+	"github.com/plgd-dev/hub/v2/pkg/config"
+	"github.com/plgd-dev/hub/v2/pkg/config/database"
+	"github.com/plgd-dev/hub/v2/pkg/fsnotify"
+	"github.com/plgd-dev/hub/v2/pkg/log"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore/backup"
+	eventstoreConfig "github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore/config"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore/cqldb"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore/encryption"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore/mongodb"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore/postgres"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/utils"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+type ExportCommand struct {
+	Output    string   `long:"output" short:"o" description:"path to the archive" required:"true"`
+	Owner     string   `long:"owner" description:"export only devices of the owner"`
+	DeviceIDs []string `long:"deviceId" description:"export only the device, can be repeated"`
+	Since     string   `long:"since" description:"export only events after the time in RFC3339 format"`
+	Until     string   `long:"until" description:"export only events up to the time in RFC3339 format"`
+}
+
+type RestoreCommand struct {
+	Input        string `long:"input" short:"i" description:"path to the archive" required:"true"`
+	KeepVersions bool   `long:"keepVersions" description:"keep versions of the events to append the archive to the stored devices"`
+}
+
+type Options struct {
+	Config  string         `long:"config" description:"path to the yaml config file" required:"true"`
+	Export  ExportCommand  `command:"export" description:"export events from the eventstore to the archive"`
+	Restore RestoreCommand `command:"restore" description:"restore events from the archive to the eventstore"`
+}
+
+func parseTime(v string) (time.Time, error) {
+	if v == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339, v)
+}
+
+func createEventStore(ctx context.Context, config eventstoreConfig.Config, marshaler backup.MarshalerFunc, unmarshaler backup.UnmarshalerFunc, fileWatcher *fsnotify.Watcher, logger log.Logger) (eventstore.EventStore, error) {
+	tracerProvider := noop.NewTracerProvider()
+	switch config.Use {
+	case database.MongoDB:
+		s, err := mongodb.New(ctx, config.MongoDB, fileWatcher, logger, tracerProvider, mongodb.WithUnmarshaler(unmarshaler), mongodb.WithMarshaler(marshaler))
+		if err != nil {
+			return nil, fmt.Errorf("mongodb: %w", err)
+		}
+		return s, nil
+	case database.CqlDB:
+		s, err := cqldb.New(ctx, config.CqlDB, fileWatcher, logger, tracerProvider, cqldb.WithUnmarshaler(unmarshaler), cqldb.WithMarshaler(marshaler))
+		if err != nil {
+			return nil, fmt.Errorf("cqldb: %w", err)
+		}
+		return s, nil
+	case database.PostgreSQL:
+		s, err := postgres.New(ctx, config.PostgreSQL, fileWatcher, logger, tracerProvider, postgres.WithUnmarshaler(unmarshaler), postgres.WithMarshaler(marshaler))
+		if err != nil {
+			return nil, fmt.Errorf("postgres: %w", err)
+		}
+		return s, nil
+	}
+	return nil, fmt.Errorf("invalid eventstore use('%v')", config.Use)
+}
+
+type app struct {
+	config      Config
+	fileWatcher *fsnotify.Watcher
+	logger      log.Logger
+	// encryptor of the eventstore content, nil when the encryption is disabled
+	encryptor *encryption.Encryptor
+}
+
+// unmarshaler returns the unmarshaler of the stored events, which decrypts the content of the encrypted eventstore.
+func (a *app) unmarshaler() backup.UnmarshalerFunc {
+	if a.encryptor == nil {
+		return utils.Unmarshal
+	}
+	return a.encryptor.Unmarshaler(utils.Unmarshal)
+}
+
+// marshaler returns the marshaler of the restored events, which encrypts the content by the configured key store.
+func (a *app) marshaler() backup.MarshalerFunc {
+	if a.encryptor == nil {
+		return utils.Marshal
+	}
+	return a.encryptor.Marshaler(utils.Marshal)
+}
+
+func (a *app) export(ctx context.Context, cmd ExportCommand) (err error) {
+	filter := backup.Filter{
+		GroupIDs: cmd.DeviceIDs,
+	}
+	if filter.Since, err = parseTime(cmd.Since); err != nil {
+		return fmt.Errorf("invalid since: %w", err)
+	}
+	if filter.Until, err = parseTime(cmd.Until); err != nil {
+		return fmt.Errorf("invalid until: %w", err)
+	}
+	// the stored data are exported without decoding
+	store, err := createEventStore(ctx, a.config.Clients.Eventstore, utils.Marshal, backup.UnmarshalRaw, a.fileWatcher, a.logger)
+	if err != nil {
+		return fmt.Errorf("cannot create eventstore: %w", err)
+	}
+	defer func() {
+		_ = store.Close(ctx)
+	}()
+	d, err := newDecoder(a.unmarshaler())
+	if err != nil {
+		return err
+	}
+	var opts []backup.ExportOption
+	if a.encryptor != nil {
+		// the archive must not depend on the data keys of the source deployment
+		opts = append(opts, backup.WithTransform(d.Decrypt))
+	}
+	if cmd.Owner != "" {
+		filter.GroupIDs, err = getOwnerDevices(ctx, store, d, cmd.Owner, cmd.DeviceIDs)
+		if err != nil {
+			return err
+		}
+		if len(filter.GroupIDs) == 0 {
+			return fmt.Errorf("no device of owner('%v') found", cmd.Owner)
+		}
+	}
+
+	f, err := os.Create(cmd.Output)
+	if err != nil {
+		return fmt.Errorf("cannot create archive: %w", err)
+	}
+	w, err := backup.NewWriter(f)
+	if err != nil {
+		_ = f.Close()
+		return err
+	}
+	var errors *multierror.Error
+	n, err := backup.Export(ctx, store, filter, w, opts...)
+	if err != nil {
+		errors = multierror.Append(errors, err)
+	}
+	if err = w.Close(); err != nil {
+		errors = multierror.Append(errors, fmt.Errorf("cannot close archive: %w", err))
+	}
+	if err = f.Close(); err != nil {
+		errors = multierror.Append(errors, fmt.Errorf("cannot close archive: %w", err))
+	}
+	if errors.ErrorOrNil() != nil {
+		return errors
+	}
+	a.logger.Infof("%v events were exported to %v", n, cmd.Output)
+	return nil
+}
+
+func (a *app) restore(ctx context.Context, cmd RestoreCommand) error {
+	// the archive contains the decrypted content
+	d, err := newDecoder(utils.Unmarshal)
+	if err != nil {
+		return err
+	}
+	f, err := os.Open(cmd.Input)
+	if err != nil {
+		return fmt.Errorf("cannot open archive: %w", err)
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	r, err := backup.NewReader(f)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = r.Close()
+	}()
+	store, err := createEventStore(ctx, a.config.Clients.Eventstore, a.marshaler(), a.unmarshaler(), a.fileWatcher, a.logger)
+	if err != nil {
+		return fmt.Errorf("cannot create eventstore: %w", err)
+	}
+	defer func() {
+		_ = store.Close(ctx)
+	}()
+	opts := []backup.RestoreOption{backup.WithDecoder(d.Decode)}
+	if cmd.KeepVersions {
+		opts = append(opts, backup.WithKeepVersions())
+	}
+	stats, err := backup.Restore(ctx, store, r, opts...)
+	a.logger.Infof("%v events of %v aggregates were restored, %v aggregates failed", stats.Events, stats.Aggregates, stats.FailedAggregates)
+	return err
+}
+
+func run(opts Options, command string) error {
+	var cfg Config
+	if err := config.Read(opts.Config, &cfg); err != nil {
+		return fmt.Errorf("cannot load config: %w", err)
+	}
+	if err := cfg.Validate(); err != nil {
+		return fmt.Errorf("invalid config: %w", err)
+	}
+	logger := log.NewLogger(cfg.Log)
+	log.Set(logger)
+	logger.Debugf("version: %v, buildDate: %v, buildRevision %v", build.Version, build.BuildDate, build.CommitHash)
+	fileWatcher, err := fsnotify.NewWatcher(logger)
+	if err != nil {
+		return fmt.Errorf("cannot create file watcher: %w", err)
+	}
+	defer func() {
+		_ = fileWatcher.Close()
+	}()
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	a := app{
+		config:      cfg,
+		fileWatcher: fileWatcher,
+		logger:      logger,
+	}
+	if cfg.Clients.Eventstore.Encryption.Enabled {
+		a.encryptor, err = encryption.New(ctx, cfg.Clients.Eventstore.Encryption, fileWatcher, logger, noop.NewTracerProvider())
+		if err != nil {
+			return fmt.Errorf("cannot create encryptor: %w", err)
+		}
+		defer func() {
+			_ = a.encryptor.Close(context.Background())
+		}()
+	}
+	switch command {
+	case "export":
+		return a.export(ctx, opts.Export)
+	case "restore":
+		return a.restore(ctx, opts.Restore)
+	}
+	return fmt.Errorf("unknown command('%v')", command)
+}
+
+func main() {
+	var opts Options
+	parser := flags.NewParser(&opts, flags.Default)
+	if _, err := parser.Parse(); err != nil {
+		var flagsErr *flags.Error
+		if errors.As(err, &flagsErr) && flagsErr.Type == flags.ErrHelp {
+			os.Exit(0)
+		}
+		os.Exit(1)
+	}
+	if err := run(opts, parser.Active.Name); err != nil {
+		log.Fatalf("%v", err)
+	}
+}
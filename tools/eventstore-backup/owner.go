@@ -0,0 +1,60 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore/backup"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/events"
+)
+
+type ownerHandler struct {
+	decoder *decoder
+	owner   string
+	devices map[string]struct{}
+}
+
+func (h *ownerHandler) Handle(ctx context.Context, iter eventstore.Iter) error {
+	for {
+		eu, ok := iter.Next(ctx)
+		if !ok {
+			break
+		}
+		if _, ok = h.devices[eu.GroupID()]; ok {
+			continue
+		}
+		var data []byte
+		if err := eu.Unmarshal(&data); err != nil {
+			return err
+		}
+		ev, err := h.decoder.decodeData(eu.EventType(), data)
+		if err != nil {
+			return fmt.Errorf("cannot decode event('%v', %v): %w", eu.AggregateID(), eu.Version(), err)
+		}
+		if events.GetOwner(ev) == h.owner {
+			h.devices[eu.GroupID()] = struct{}{}
+		}
+	}
+	return iter.Err()
+}
+
+// getOwnerDevices returns devices of the owner. The owner is resolved from the audit context of the stored events.
+func getOwnerDevices(ctx context.Context, store eventstore.EventStore, d *decoder, owner string, deviceIDs []string) ([]string, error) {
+	h := ownerHandler{
+		decoder: d,
+		owner:   owner,
+		devices: make(map[string]struct{}),
+	}
+	queries := backup.Filter{GroupIDs: deviceIDs}.Queries()
+	if err := store.GetEvents(ctx, queries, 0, &h); err != nil {
+		return nil, fmt.Errorf("cannot get devices of owner('%v'): %w", owner, err)
+	}
+	devices := make([]string, 0, len(h.devices))
+	for deviceID := range h.devices {
+		devices = append(devices, deviceID)
+	}
+	sort.Strings(devices)
+	return devices, nil
+}
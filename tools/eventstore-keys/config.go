@@ -0,0 +1,49 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/plgd-dev/hub/v2/pkg/config"
+	"github.com/plgd-dev/hub/v2/pkg/log"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore/encryption"
+)
+
+type ClientsConfig struct {
+	Encryption encryption.Config `yaml:"encryption" json:"encryption"`
+}
+
+func (c *ClientsConfig) Validate() error {
+	// the tool always manages the keys, so the enabled flag and the cache are not configured
+	c.Encryption.Enabled = true
+	if c.Encryption.KeyCacheExpiration <= 0 {
+		c.Encryption.KeyCacheExpiration = time.Minute
+	}
+	if c.Encryption.KeyStoreTimeout <= 0 {
+		c.Encryption.KeyStoreTimeout = encryption.DefaultKeyStoreTimeout
+	}
+	if err := c.Encryption.Validate(); err != nil {
+		return fmt.Errorf("encryption.%w", err)
+	}
+	return nil
+}
+
+type Config struct {
+	Log     log.Config    `yaml:"log" json:"log"`
+	Clients ClientsConfig `yaml:"clients" json:"clients"`
+}
+
+func (c *Config) Validate() error {
+	if err := c.Log.Validate(); err != nil {
+		return fmt.Errorf("log.%w", err)
+	}
+	if err := c.Clients.Validate(); err != nil {
+		return fmt.Errorf("clients.%w", err)
+	}
+	return nil
+}
+
+// String return string representation of Config
+func (c Config) String() string {
+	return config.ToString(c)
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	flags "github.com/jessevdk/go-flags"
+	"github.com/plgd-dev/hub/v2/pkg/build"
+	"github.com/plgd-dev/hub/v2/pkg/config"
+	"github.com/plgd-dev/hub/v2/pkg/fsnotify"
+	"github.com/plgd-dev/hub/v2/pkg/log"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore/encryption"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+type RotateCommand struct {
+	Owner string `long:"owner" description:"owner of the data key" required:"true"`
+}
+
+type RewrapCommand struct{}
+
+type ShredCommand struct {
+	Owner string `long:"owner" description:"owner of the data keys" required:"true"`
+}
+
+type Options struct {
+	Config string        `long:"config" description:"path to the yaml config file" required:"true"`
+	Rotate RotateCommand `command:"rotate" description:"create a new version of the owner's data key for the new content"`
+	Rewrap RewrapCommand `command:"rewrap" description:"wrap all data keys by the first master key"`
+	Shred  ShredCommand  `command:"shred" description:"delete all data keys of the owner, the stored content of the owner becomes unreadable"`
+}
+
+func execute(ctx context.Context, e *encryption.Encryptor, opts Options, command string, logger log.Logger) error {
+	switch command {
+	case "rotate":
+		version, err := e.RotateKey(ctx, opts.Rotate.Owner)
+		if err != nil {
+			return err
+		}
+		logger.Infof("data key of owner('%v') was rotated to version %v", opts.Rotate.Owner, version)
+		return nil
+	case "rewrap":
+		count, err := e.RewrapKeys(ctx)
+		logger.Infof("%v data keys were rewrapped", count)
+		return err
+	case "shred":
+		if err := e.ShredKeys(ctx, opts.Shred.Owner); err != nil {
+			return err
+		}
+		logger.Infof("data keys of owner('%v') were shredded", opts.Shred.Owner)
+		return nil
+	}
+	return fmt.Errorf("unknown command('%v')", command)
+}
+
+func run(opts Options, command string) error {
+	var cfg Config
+	if err := config.Read(opts.Config, &cfg); err != nil {
+		return fmt.Errorf("cannot load config: %w", err)
+	}
+	if err := cfg.Validate(); err != nil {
+		return fmt.Errorf("invalid config: %w", err)
+	}
+	logger := log.NewLogger(cfg.Log)
+	log.Set(logger)
+	logger.Debugf("version: %v, buildDate: %v, buildRevision %v", build.Version, build.BuildDate, build.CommitHash)
+	fileWatcher, err := fsnotify.NewWatcher(logger)
+	if err != nil {
+		return fmt.Errorf("cannot create file watcher: %w", err)
+	}
+	defer func() {
+		_ = fileWatcher.Close()
+	}()
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	e, err := encryption.New(ctx, cfg.Clients.Encryption, fileWatcher, logger, noop.NewTracerProvider())
+	if err != nil {
+		return fmt.Errorf("cannot create encryptor: %w", err)
+	}
+	defer func() {
+		_ = e.Close(ctx)
+	}()
+	return execute(ctx, e, opts, command, logger)
+}
+
+func main() {
+	var opts Options
+	parser := flags.NewParser(&opts, flags.Default)
+	if _, err := parser.Parse(); err != nil {
+		var flagsErr *flags.Error
+		if errors.As(err, &flagsErr) && flagsErr.Type == flags.ErrHelp {
+			os.Exit(0)
+		}
+		os.Exit(1)
+	}
+	if err := run(opts, parser.Active.Name); err != nil {
+		log.Fatalf("%v", err)
+	}
+}
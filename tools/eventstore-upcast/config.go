@@ -0,0 +1,40 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/plgd-dev/hub/v2/pkg/config"
+	"github.com/plgd-dev/hub/v2/pkg/log"
+	eventstoreConfig "github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore/config"
+)
+
+type ClientsConfig struct {
+	Eventstore eventstoreConfig.Config `yaml:"eventStore" json:"eventStore"`
+}
+
+func (c *ClientsConfig) Validate() error {
+	if err := c.Eventstore.Validate(); err != nil {
+		return fmt.Errorf("eventStore.%w", err)
+	}
+	return nil
+}
+
+type Config struct {
+	Log     log.Config    `yaml:"log" json:"log"`
+	Clients ClientsConfig `yaml:"clients" json:"clients"`
+}
+
+func (c *Config) Validate() error {
+	if err := c.Log.Validate(); err != nil {
+		return fmt.Errorf("log.%w", err)
+	}
+	if err := c.Clients.Validate(); err != nil {
+		return fmt.Errorf("clients.%w", err)
+	}
+	return nil
+}
+
+// String return string representation of Config
+func (c Config) String() string {
+	return config.ToString(c)
+}
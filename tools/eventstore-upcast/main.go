@@ -0,0 +1,115 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	flags "github.com/jessevdk/go-flags"
+	"github.com/plgd-dev/hub/v2/pkg/build"
+	"github.com/plgd-dev/hub/v2/pkg/config"
+	"github.com/plgd-dev/hub/v2/pkg/config/database"
+	"github.com/plgd-dev/hub/v2/pkg/fsnotify"
+	"github.com/plgd-dev/hub/v2/pkg/log"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore"
+	eventstoreConfig "github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore/config"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore/cqldb"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore/mongodb"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore/postgres"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/eventstore/upcast"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/cqrs/utils"
+	"github.com/plgd-dev/hub/v2/resource-aggregate/events"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+type Options struct {
+	Config string `long:"config" description:"path to the yaml config file" required:"true"`
+}
+
+func createEventStore(ctx context.Context, config eventstoreConfig.Config, fileWatcher *fsnotify.Watcher, logger log.Logger) (eventstore.EventStore, error) {
+	tracerProvider := noop.NewTracerProvider()
+	switch config.Use {
+	case database.MongoDB:
+		s, err := mongodb.New(ctx, config.MongoDB, fileWatcher, logger, tracerProvider, mongodb.WithUnmarshaler(utils.Unmarshal), mongodb.WithMarshaler(utils.Marshal))
+		if err != nil {
+			return nil, fmt.Errorf("mongodb: %w", err)
+		}
+		return s, nil
+	case database.CqlDB:
+		s, err := cqldb.New(ctx, config.CqlDB, fileWatcher, logger, tracerProvider, cqldb.WithUnmarshaler(utils.Unmarshal), cqldb.WithMarshaler(utils.Marshal))
+		if err != nil {
+			return nil, fmt.Errorf("cqldb: %w", err)
+		}
+		return s, nil
+	case database.PostgreSQL:
+		s, err := postgres.New(ctx, config.PostgreSQL, fileWatcher, logger, tracerProvider, postgres.WithUnmarshaler(utils.Unmarshal), postgres.WithMarshaler(utils.Marshal))
+		if err != nil {
+			return nil, fmt.Errorf("postgres: %w", err)
+		}
+		return s, nil
+	}
+	return nil, fmt.Errorf("invalid eventstore use('%v')", config.Use)
+}
+
+// rewriteUpcastedEvents migrates the stored events to the current shape, so the upcasting is not needed during
+// loading. The rewrite is idempotent, so the interrupted tool can be started again.
+func rewriteUpcastedEvents(ctx context.Context, cfg Config, fileWatcher *fsnotify.Watcher, logger log.Logger) error {
+	registry, err := events.NewUpcastRegistry()
+	if err != nil {
+		return fmt.Errorf("cannot create upcast registry: %w", err)
+	}
+	// the encrypted content is not changed by the upcasters, so the events are rewritten without the decryption
+	store, err := createEventStore(ctx, cfg.Clients.Eventstore, fileWatcher, logger)
+	if err != nil {
+		return fmt.Errorf("cannot create eventstore: %w", err)
+	}
+	defer func() {
+		_ = store.Close(ctx)
+	}()
+	count, err := upcast.RewriteEvents(ctx, store, registry, utils.Unmarshal, utils.Marshal)
+	if err != nil {
+		return fmt.Errorf("cannot rewrite upcasted events (rewritten %v events): %w", count, err)
+	}
+	logger.Infof("upcasted events were rewritten: %v events", count)
+	return nil
+}
+
+func run(opts Options) error {
+	var cfg Config
+	if err := config.Read(opts.Config, &cfg); err != nil {
+		return fmt.Errorf("cannot load config: %w", err)
+	}
+	if err := cfg.Validate(); err != nil {
+		return fmt.Errorf("invalid config: %w", err)
+	}
+	logger := log.NewLogger(cfg.Log)
+	log.Set(logger)
+	logger.Debugf("version: %v, buildDate: %v, buildRevision %v", build.Version, build.BuildDate, build.CommitHash)
+	fileWatcher, err := fsnotify.NewWatcher(logger)
+	if err != nil {
+		return fmt.Errorf("cannot create file watcher: %w", err)
+	}
+	defer func() {
+		_ = fileWatcher.Close()
+	}()
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+	return rewriteUpcastedEvents(ctx, cfg, fileWatcher, logger)
+}
+
+func main() {
+	var opts Options
+	if _, err := flags.NewParser(&opts, flags.Default).Parse(); err != nil {
+		var flagsErr *flags.Error
+		if errors.As(err, &flagsErr) && flagsErr.Type == flags.ErrHelp {
+			os.Exit(0)
+		}
+		os.Exit(1)
+	}
+	if err := run(opts); err != nil {
+		log.Fatalf("%v", err)
+	}
+}